@@ -10,6 +10,7 @@ import (
 	db "github.com/diegoclair/leaderpro/infra/data/mysql"
 	"github.com/diegoclair/leaderpro/infra/shutdown"
 	"github.com/diegoclair/leaderpro/internal/application/service"
+	"github.com/diegoclair/leaderpro/internal/application/worker"
 	"github.com/diegoclair/leaderpro/internal/domain"
 	"github.com/diegoclair/leaderpro/internal/transport/rest"
 	"github.com/diegoclair/leaderpro/migrator/mysql"
@@ -37,6 +38,9 @@ func main() {
 		domain.WithLogger(log),
 		domain.WithCrypto(cfg.GetCrypto()),
 		domain.WithValidator(cfg.GetValidator()),
+		domain.WithJobQueue(cfg.GetJobQueue()),
+		domain.WithNoteSearchEngine(cfg.GetNoteSearchEngine()),
+		domain.WithBlobStorage(cfg.GetBlobStorage()),
 	)
 
 	log.Info(ctx, "Running the migrations...")
@@ -47,13 +51,25 @@ func main() {
 	}
 	log.Info(ctx, "Migrations completed successfully")
 
-	apps, err := service.New(infra, cfg.GetAIManager().GetDefaultProvider(), cfg.App.Auth.AccessTokenDuration)
+	apps, err := service.New(infra, cfg.GetAIManager().GetDefaultProvider(), cfg.App.Auth.AccessTokenDuration, cfg.App.AdminToken)
 	if err != nil {
 		log.Errorw(ctx, "error to get domain services", logger.Err(err))
 		return
 	}
 
+	outboxWorker := worker.New("outbox", domain.OutboxPollIntervalSecs*time.Second, log, func(ctx context.Context) error {
+		_, err := apps.Outbox.ProcessOutbox(ctx)
+		return err
+	})
+	outboxWorker.Start(ctx)
+
+	jobPool := worker.NewPool(apps.Job, log, domain.JobPollIntervalSecs*time.Second)
+	jobPool.Start(ctx)
+
 	server := rest.StartRestServer(ctx, cfg, infra, apps, appName, cfg.GetHttpPort())
 
-	shutdown.GracefulShutdown(ctx, log, shutdown.WithRestServer(server.Router.Echo()))
+	shutdown.GracefulShutdown(ctx, log,
+		shutdown.WithRestServer(server.Router.Echo()),
+		shutdown.WithWorkers(outboxWorker, jobPool),
+	)
 }
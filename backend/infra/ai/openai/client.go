@@ -93,7 +93,7 @@ func (p *Provider) Chat(ctx context.Context, req entity.ChatRequest, systemPromp
 }
 
 // ExtractAttributes extracts text attributes using AI
-func (p *Provider) ExtractAttributes(ctx context.Context, req entity.ExtractionRequest, extractionPrompt string) (map[string]string, entity.AIUsage, error) {
+func (p *Provider) ExtractAttributes(ctx context.Context, req entity.ExtractionRequest, extractionPrompt string) (map[string]entity.ExtractedAttribute, entity.AIUsage, error) {
 	fullPrompt := fmt.Sprintf("%s\n\nNOTAS:\n%s", extractionPrompt, req.Content)
 	
 	request := openai.ChatCompletionRequest{
@@ -125,16 +125,34 @@ func (p *Provider) ExtractAttributes(ctx context.Context, req entity.ExtractionR
 		CostUSD:      p.CalculateCost(resp.Usage.PromptTokens, resp.Usage.CompletionTokens),
 	}
 	
-	var attributes map[string]string
-	content := resp.Choices[0].Message.Content
-	
-	err = json.Unmarshal([]byte(content), &attributes)
-	if err != nil {
-		// If unable to parse, return empty (AI didn't return valid JSON)
-		return map[string]string{}, usage, nil
+	return parseExtractedAttributes(resp.Choices[0].Message.Content), usage, nil
+}
+
+// parseExtractedAttributes accepts both the flat format {"key": "value"} (prompt v1)
+// and the format with confidence {"key": {"value": "...", "confidence": 0.9}} (prompt v2).
+// Invalid JSON returns an empty map, as the AI didn't return a usable response
+func parseExtractedAttributes(content string) map[string]entity.ExtractedAttribute {
+	attributes := map[string]entity.ExtractedAttribute{}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(content), &raw); err != nil {
+		return attributes
 	}
-	
-	return attributes, usage, nil
+
+	for key, value := range raw {
+		var plain string
+		if err := json.Unmarshal(value, &plain); err == nil {
+			attributes[key] = entity.ExtractedAttribute{Value: plain}
+			continue
+		}
+
+		var withConfidence entity.ExtractedAttribute
+		if err := json.Unmarshal(value, &withConfidence); err == nil && withConfidence.Value != "" {
+			attributes[key] = withConfidence
+		}
+	}
+
+	return attributes
 }
 
 // GetProviderName returns the provider name
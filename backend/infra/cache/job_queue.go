@@ -0,0 +1,348 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/redis/go-redis/v9"
+)
+
+const defaultJobQueuePrefix = "jobs:"
+
+// jobQueue stores each job as JSON in its own key, and the UUIDs of the jobs of each queue in three
+// sorted sets: pending (scored by run at), running (scored by the end of the lease) and dead (scored by failed at).
+// The scripts keep the job and the sets consistent
+type jobQueue struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewJobQueue returns the job queue stored in redis, with the keys under the prefix. The prefix becomes the hash tag
+// of every key, so the scripts, which build the job keys from the UUIDs of the sets, touch a single slot of a redis cluster
+func NewJobQueue(client redis.UniversalClient, prefix string) contract.JobQueue {
+	if prefix == "" {
+		prefix = defaultJobQueuePrefix
+	}
+	if !strings.Contains(prefix, "{") {
+		prefix = "{" + strings.TrimSuffix(prefix, ":") + "}:"
+	}
+	return &jobQueue{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+type redisJob struct {
+	UUID        string  `json:"uuid"`
+	Queue       string  `json:"queue"`
+	Type        string  `json:"type"`
+	Payload     string  `json:"payload"`
+	Status      string  `json:"status"`
+	Attempts    int     `json:"attempts"`
+	MaxAttempts int     `json:"max_attempts"`
+	RunAt       int64   `json:"run_at"`
+	LastError   *string `json:"last_error"`
+	CreatedAt   int64   `json:"created_at"`
+	FailedAt    *int64  `json:"failed_at"`
+}
+
+func newRedisJob(job entity.Job) redisJob {
+	return redisJob{
+		UUID:        job.UUID,
+		Queue:       job.Queue,
+		Type:        job.Type,
+		Payload:     job.Payload,
+		Status:      domain.JobStatusPending,
+		MaxAttempts: job.MaxAttempts,
+		RunAt:       job.RunAt.Unix(),
+		CreatedAt:   time.Now().Unix(),
+	}
+}
+
+func (j redisJob) toEntity() entity.Job {
+	job := entity.Job{
+		UUID:        j.UUID,
+		Queue:       j.Queue,
+		Type:        j.Type,
+		Payload:     j.Payload,
+		Status:      j.Status,
+		Attempts:    j.Attempts,
+		MaxAttempts: j.MaxAttempts,
+		RunAt:       time.Unix(j.RunAt, 0),
+		LastError:   j.LastError,
+		CreatedAt:   time.Unix(j.CreatedAt, 0),
+	}
+	if j.FailedAt != nil {
+		failedAt := time.Unix(*j.FailedAt, 0)
+		job.FailedAt = &failedAt
+	}
+	return job
+}
+
+func (q *jobQueue) jobKey(jobUUID string) string {
+	return q.prefix + "job:" + jobUUID
+}
+
+func (q *jobQueue) queueKey(queue, status string) string {
+	return q.prefix + "queue:" + queue + ":" + status
+}
+
+func score(t time.Time) string {
+	return strconv.FormatInt(t.Unix(), 10)
+}
+
+var enqueueScript = redis.NewScript(`
+if redis.call('SET', KEYS[1], ARGV[1], 'NX') then
+	redis.call('ZADD', KEYS[2], ARGV[2], ARGV[3])
+end
+return 1
+`)
+
+func (q *jobQueue) Enqueue(ctx context.Context, job entity.Job) (err error) {
+	data, err := json.Marshal(newRedisJob(job))
+	if err != nil {
+		return err
+	}
+
+	keys := []string{q.jobKey(job.UUID), q.queueKey(job.Queue, domain.JobStatusPending)}
+	return enqueueScript.Run(ctx, q.client, keys, data, score(job.RunAt), job.UUID).Err()
+}
+
+// dequeueScript claims the due jobs of the running set, whose lease expired, and then of the pending set.
+// A job whose lease expired with no attempts left goes to the dead set (KEYS[3]) instead, as a failed attempt would
+var dequeueScript = redis.NewScript(`
+local claimed = {}
+local limit = tonumber(ARGV[3])
+for _, key in ipairs({KEYS[2], KEYS[1]}) do
+	if #claimed >= limit then
+		break
+	end
+	local ids = redis.call('ZRANGEBYSCORE', key, '-inf', ARGV[1], 'LIMIT', 0, limit - #claimed)
+	for _, id in ipairs(ids) do
+		redis.call('ZREM', key, id)
+		local data = redis.call('GET', ARGV[4] .. id)
+		if data then
+			local job = cjson.decode(data)
+			if key == KEYS[2] and job.attempts >= job.max_attempts then
+				job.status = ARGV[6]
+				job.last_error = ARGV[7]
+				job.failed_at = tonumber(ARGV[1])
+				redis.call('SET', ARGV[4] .. id, cjson.encode(job))
+				redis.call('ZADD', KEYS[3], ARGV[1], id)
+			else
+				job.status = ARGV[5]
+				job.attempts = job.attempts + 1
+				job.run_at = tonumber(ARGV[2])
+				data = cjson.encode(job)
+				redis.call('SET', ARGV[4] .. id, data)
+				redis.call('ZADD', KEYS[2], ARGV[2], id)
+				table.insert(claimed, data)
+			end
+		end
+	end
+end
+return claimed
+`)
+
+func (q *jobQueue) Dequeue(ctx context.Context, queue string, now, leaseUntil time.Time, limit int) (jobs []entity.Job, err error) {
+	keys := []string{
+		q.queueKey(queue, domain.JobStatusPending),
+		q.queueKey(queue, domain.JobStatusRunning),
+		q.queueKey(queue, domain.JobStatusDead),
+	}
+
+	claimed, err := dequeueScript.Run(ctx, q.client, keys,
+		score(now), score(leaseUntil), limit, q.jobKey(""), domain.JobStatusRunning,
+		domain.JobStatusDead, domain.JobLeaseExpiredError,
+	).StringSlice()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, data := range claimed {
+		var job redisJob
+		if err := json.Unmarshal([]byte(data), &job); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job.toEntity())
+	}
+
+	return jobs, nil
+}
+
+// finishScript ends the attempt of the running job KEYS[1]: it deletes the job when there is no KEYS[3], or moves it to
+// the set of KEYS[3] with the status and error of the arguments, setting the time field ARGV[7] to the score ARGV[6].
+// The attempts work as a version, so a worker that lost the lease does not change the job
+var finishScript = redis.NewScript(`
+local data = redis.call('GET', KEYS[1])
+if not data then
+	return 0
+end
+local job = cjson.decode(data)
+if job.status ~= ARGV[2] or job.attempts ~= tonumber(ARGV[3]) then
+	return 0
+end
+redis.call('ZREM', KEYS[2], ARGV[1])
+if #KEYS == 2 then
+	redis.call('DEL', KEYS[1])
+	return 1
+end
+job.status = ARGV[4]
+job.last_error = ARGV[5]
+job[ARGV[7]] = tonumber(ARGV[6])
+redis.call('SET', KEYS[1], cjson.encode(job))
+redis.call('ZADD', KEYS[3], ARGV[6], ARGV[1])
+return 1
+`)
+
+func (q *jobQueue) finish(ctx context.Context, job entity.Job, status, lastError, timeField string, at time.Time) error {
+	keys := []string{q.jobKey(job.UUID), q.queueKey(job.Queue, domain.JobStatusRunning)}
+	if status != "" {
+		keys = append(keys, q.queueKey(job.Queue, status))
+	}
+
+	finished, err := finishScript.Run(ctx, q.client, keys,
+		job.UUID, domain.JobStatusRunning, job.Attempts,
+		status, lastError, score(at), timeField,
+	).Int()
+	if err != nil {
+		return err
+	}
+
+	if finished == 0 {
+		return domain.ErrJobNotFound
+	}
+	return nil
+}
+
+func (q *jobQueue) Complete(ctx context.Context, job entity.Job) (err error) {
+	return q.finish(ctx, job, "", "", "", time.Now())
+}
+
+func (q *jobQueue) Retry(ctx context.Context, job entity.Job, lastError string, runAt time.Time) (err error) {
+	return q.finish(ctx, job, domain.JobStatusPending, lastError, "run_at", runAt)
+}
+
+func (q *jobQueue) DeadLetter(ctx context.Context, job entity.Job, lastError string, now time.Time) (err error) {
+	return q.finish(ctx, job, domain.JobStatusDead, lastError, "failed_at", now)
+}
+
+var requeueScript = redis.NewScript(`
+local data = redis.call('GET', KEYS[1])
+if not data then
+	return 0
+end
+local job = cjson.decode(data)
+if job.status ~= ARGV[2] then
+	return 0
+end
+redis.call('ZREM', KEYS[2], ARGV[1])
+job.status = ARGV[3]
+job.attempts = 0
+job.run_at = tonumber(ARGV[4])
+job.failed_at = cjson.null
+redis.call('SET', KEYS[1], cjson.encode(job))
+redis.call('ZADD', KEYS[3], ARGV[4], ARGV[1])
+return 1
+`)
+
+func (q *jobQueue) Requeue(ctx context.Context, jobUUID string, now time.Time) (err error) {
+	job, err := q.getJob(ctx, jobUUID)
+	if err != nil {
+		return err
+	}
+
+	keys := []string{q.jobKey(jobUUID), q.queueKey(job.Queue, domain.JobStatusDead), q.queueKey(job.Queue, domain.JobStatusPending)}
+	requeued, err := requeueScript.Run(ctx, q.client, keys,
+		jobUUID, domain.JobStatusDead, domain.JobStatusPending, score(now),
+	).Int()
+	if err != nil {
+		return err
+	}
+
+	if requeued == 0 {
+		return domain.ErrJobNotFound
+	}
+	return nil
+}
+
+func (q *jobQueue) getJob(ctx context.Context, jobUUID string) (job redisJob, err error) {
+	data, err := q.client.Get(ctx, q.jobKey(jobUUID)).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return job, domain.ErrJobNotFound
+	}
+	if err != nil {
+		return job, err
+	}
+
+	err = json.Unmarshal(data, &job)
+	return job, err
+}
+
+func (q *jobQueue) GetStats(ctx context.Context, queue string, now time.Time) (stats entity.JobQueueStats, err error) {
+	stats.Queue = queue
+	pendingKey := q.queueKey(queue, domain.JobStatusPending)
+
+	pipe := q.client.Pipeline()
+	pending := pipe.ZCount(ctx, pendingKey, "-inf", score(now))
+	scheduled := pipe.ZCount(ctx, pendingKey, "("+score(now), "+inf")
+	running := pipe.ZCard(ctx, q.queueKey(queue, domain.JobStatusRunning))
+	dead := pipe.ZCard(ctx, q.queueKey(queue, domain.JobStatusDead))
+	oldest := pipe.ZRangeWithScores(ctx, pendingKey, 0, 0)
+
+	_, err = pipe.Exec(ctx)
+	if err != nil {
+		return stats, err
+	}
+
+	stats.Pending = pending.Val()
+	stats.Scheduled = scheduled.Val()
+	stats.Running = running.Val()
+	stats.Dead = dead.Val()
+
+	if first := oldest.Val(); len(first) > 0 && int64(first[0].Score) <= now.Unix() {
+		oldestPendingAt := time.Unix(int64(first[0].Score), 0)
+		stats.OldestPendingAt = &oldestPendingAt
+	}
+
+	return stats, nil
+}
+
+func (q *jobQueue) GetDeadJobs(ctx context.Context, queue string, limit int) (jobs []entity.Job, err error) {
+	uuids, err := q.client.ZRevRange(ctx, q.queueKey(queue, domain.JobStatusDead), 0, int64(limit-1)).Result()
+	if err != nil || len(uuids) == 0 {
+		return nil, err
+	}
+
+	keys := make([]string, len(uuids))
+	for i, jobUUID := range uuids {
+		keys[i] = q.jobKey(jobUUID)
+	}
+
+	values, err := q.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			continue // deleted meanwhile
+		}
+
+		var job redisJob
+		if err := json.Unmarshal([]byte(data), &job); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job.toEntity())
+	}
+
+	return jobs, nil
+}
@@ -7,11 +7,13 @@ import (
 	"testing"
 
 	"github.com/diegoclair/leaderpro/infra/configmock"
+	"github.com/redis/go-redis/v9"
 )
 
 var (
-	testRedis *CacheManager
-	cfg       *configmock.ConfigMock = configmock.New()
+	testRedis       *CacheManager
+	testRedisClient *redis.Client
+	cfg             *configmock.ConfigMock = configmock.New()
 )
 
 func TestMain(m *testing.M) {
@@ -30,6 +32,7 @@ func TestMain(m *testing.M) {
 	defer client.Close()
 
 	testRedis = redis
+	testRedisClient = client
 
 	os.Exit(m.Run())
 }
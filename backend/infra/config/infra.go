@@ -13,7 +13,9 @@ import (
 	infraContract "github.com/diegoclair/leaderpro/infra/contract"
 	"github.com/diegoclair/leaderpro/infra/crypto"
 	"github.com/diegoclair/leaderpro/infra/data/mysql"
+	"github.com/diegoclair/leaderpro/infra/storage"
 	infraLogger "github.com/diegoclair/leaderpro/infra/logger"
+	"github.com/diegoclair/leaderpro/internal/domain"
 	"github.com/diegoclair/leaderpro/internal/domain/contract"
 	"github.com/redis/go-redis/v9"
 	"go.opentelemetry.io/otel"
@@ -71,6 +73,7 @@ func (c *Config) GetAuthToken() infraContract.AuthToken {
 
 var (
 	cacheManager contract.CacheManager
+	redisClient  *redis.Client
 	cacheOnce    sync.Once
 )
 
@@ -78,13 +81,12 @@ var (
 func (c *Config) GetCacheManager() contract.CacheManager {
 	cacheOnce.Do(func() {
 		var (
-			client *redis.Client
-			log    logger.Logger = c.GetLogger()
-			err    error
+			log logger.Logger = c.GetLogger()
+			err error
 		)
 
 		log.Infof(c.ctx, "Connecting to the cache server at %s:%d.", c.Cache.Redis.Host, c.Cache.Redis.Port)
-		cacheManager, client, err = cache.NewRedisCache(c.ctx,
+		cacheManager, redisClient, err = cache.NewRedisCache(c.ctx,
 			fmt.Sprintf("%s:%d", c.Cache.Redis.Host, c.Cache.Redis.Port),
 			c.Cache.Redis.Pass,
 			c.Cache.Redis.DB,
@@ -96,7 +98,7 @@ func (c *Config) GetCacheManager() contract.CacheManager {
 
 		c.AddCloser(func() {
 			log.Info(c.ctx, "Closing redis connection...")
-			if err := client.Close(); err != nil {
+			if err := redisClient.Close(); err != nil {
 				log.Errorw(c.ctx, "Error closing redis connection", logger.Err(err))
 			}
 		})
@@ -153,6 +155,83 @@ func (c *Config) GetDataManager() contract.DataManager {
 	return dataManager
 }
 
+var (
+	jobQueue     contract.JobQueue
+	jobQueueOnce sync.Once
+)
+
+// GetJobQueue returns the job queue of the configured backend, mysql by default
+func (c *Config) GetJobQueue() contract.JobQueue {
+	jobQueueOnce.Do(func() {
+		switch c.Queue.Backend {
+		case domain.JobQueueBackendRedis:
+			c.GetCacheManager()
+			jobQueue = cache.NewJobQueue(redisClient, c.Cache.Redis.Prefix)
+		case domain.JobQueueBackendMySQL, "":
+			jobQueue = mysql.NewJobQueue(c.GetDataManager().(*mysql.MysqlConn).DB())
+		default:
+			c.GetLogger().Fatalf(c.ctx, "Invalid job queue backend: %s", c.Queue.Backend)
+		}
+	})
+
+	return jobQueue
+}
+
+var (
+	noteSearchEngine     contract.NoteSearchEngine
+	noteSearchEngineOnce sync.Once
+)
+
+// GetNoteSearchEngine returns the note search of the configured engine, mysql by default
+func (c *Config) GetNoteSearchEngine() contract.NoteSearchEngine {
+	noteSearchEngineOnce.Do(func() {
+		switch c.Search.Engine {
+		case domain.NoteSearchEngineMySQL, "":
+			noteSearchEngine = mysql.NewNoteSearchEngine(c.GetDataManager().(*mysql.MysqlConn).DB())
+		default:
+			c.GetLogger().Fatalf(c.ctx, "Invalid note search engine: %s", c.Search.Engine)
+		}
+	})
+
+	return noteSearchEngine
+}
+
+var (
+	blobStorage     contract.BlobStorage
+	blobStorageOnce sync.Once
+)
+
+// GetBlobStorage returns the blob storage of the configured backend, the local filesystem by default
+func (c *Config) GetBlobStorage() contract.BlobStorage {
+	blobStorageOnce.Do(func() {
+		var (
+			err error
+			log logger.Logger = c.GetLogger()
+		)
+
+		switch c.Storage.Backend {
+		case domain.BlobStorageBackendLocal, "":
+			blobStorage, err = storage.NewLocalStorage(c.Storage.LocalPath)
+		case domain.BlobStorageBackendS3:
+			blobStorage, err = storage.NewS3Storage(storage.S3Config{
+				Endpoint:  c.Storage.S3.Endpoint,
+				Region:    c.Storage.S3.Region,
+				Bucket:    c.Storage.S3.Bucket,
+				AccessKey: c.Storage.S3.AccessKey,
+				SecretKey: c.Storage.S3.SecretKey,
+				PathStyle: c.Storage.S3.PathStyle,
+			})
+		default:
+			log.Fatalf(c.ctx, "Invalid blob storage backend: %s", c.Storage.Backend)
+		}
+		if err != nil {
+			log.Fatalw(c.ctx, "Failed to create blob storage", logger.Err(err))
+		}
+	})
+
+	return blobStorage
+}
+
 var (
 	l       logger.Logger
 	logOnce sync.Once
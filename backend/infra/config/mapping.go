@@ -13,6 +13,9 @@ type Config struct {
 	DB       DBConfig    `mapstructure:"db"`
 	Log      LogConfig   `mapstructure:"log"`
 	AI       AIConfig    `mapstructure:"ai"`
+	Queue    QueueConfig `mapstructure:"queue"`
+	Search   SearchConfig `mapstructure:"search"`
+	Storage  StorageConfig `mapstructure:"storage"`
 	closers  []func()
 	closerMu sync.Mutex
 	ctx      context.Context
@@ -38,6 +41,7 @@ type AppConfig struct {
 	Name        string     `mapstructure:"name"`
 	Environment string     `mapstructure:"environment"`
 	Port        string     `mapstructure:"port"`
+	AdminToken  string     `mapstructure:"admin-token"` // token of the admin endpoints, they are disabled when empty
 	Auth        AuthConfig `mapstructure:"auth"`
 }
 type AuthConfig struct {
@@ -69,6 +73,29 @@ func (c *Config) GetMysqlDsn() string {
 	return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8&parseTime=true", c.DB.MySQL.Username, c.DB.MySQL.Password, c.DB.MySQL.Host, c.DB.MySQL.Port, c.DB.MySQL.DBName)
 }
 
+type QueueConfig struct {
+	Backend string `mapstructure:"backend"` // "mysql" or "redis"
+}
+
+type SearchConfig struct {
+	Engine string `mapstructure:"engine"` // "mysql"
+}
+
+type StorageConfig struct {
+	Backend   string          `mapstructure:"backend"`    // "local" or "s3"
+	LocalPath string          `mapstructure:"local-path"` // directory of the files of the local backend
+	S3        S3StorageConfig `mapstructure:"s3"`
+}
+
+type S3StorageConfig struct {
+	Endpoint  string `mapstructure:"endpoint"`
+	Region    string `mapstructure:"region"`
+	Bucket    string `mapstructure:"bucket"`
+	AccessKey string `mapstructure:"access-key"`
+	SecretKey string `mapstructure:"secret-key"`
+	PathStyle bool   `mapstructure:"path-style"` // bucket in the path instead of the host, for MinIO and other self hosted services
+}
+
 type LogConfig struct {
 	Debug     bool   `mapstructure:"debug"`
 	LogToFile bool   `mapstructure:"log-to-file"`
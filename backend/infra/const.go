@@ -15,4 +15,7 @@ const (
 
 const (
 	TokenKeyDescription = "User access token"
+
+	AdminTokenHeader            = "X-Admin-Token"
+	AdminTokenHeaderDescription = "Admin token, set in the app.admin-token config"
 )
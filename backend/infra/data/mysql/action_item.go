@@ -0,0 +1,318 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type actionItemRepo struct {
+	db dbConn
+}
+
+func newActionItemRepo(db dbConn) contract.ActionItemRepo {
+	return &actionItemRepo{
+		db: db,
+	}
+}
+
+const actionItemSelectBase string = `
+	SELECT
+		a.action_item_id,
+		a.action_item_uuid,
+		a.company_id,
+		a.person_id,
+		p.person_uuid,
+		p.name,
+		a.user_id,
+		a.owner,
+		a.description,
+		a.due_date,
+		a.status,
+		n.note_id,
+		n.note_uuid,
+		m.meeting_id,
+		m.meeting_uuid,
+		a.completed_at,
+		a.created_at,
+		a.updated_at
+
+	FROM tab_action_item a
+	INNER JOIN tab_person p
+		ON p.person_id = a.person_id
+	LEFT JOIN tab_note n
+		ON n.note_id = a.source_note_id
+		AND n.deleted_at IS NULL
+	LEFT JOIN tab_meeting m
+		ON m.meeting_id = a.source_meeting_id
+`
+
+func (r *actionItemRepo) parseActionItem(row scanner) (item entity.ActionItem, err error) {
+	err = row.Scan(
+		&item.ID,
+		&item.UUID,
+		&item.CompanyID,
+		&item.PersonID,
+		&item.PersonUUID,
+		&item.PersonName,
+		&item.UserID,
+		&item.Owner,
+		&item.Description,
+		&item.DueDate,
+		&item.Status,
+		&item.SourceNoteID,
+		&item.SourceNoteUUID,
+		&item.SourceMeetingID,
+		&item.SourceMeetingUUID,
+		&item.CompletedAt,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+	)
+	if err != nil {
+		return item, err
+	}
+
+	return item, nil
+}
+
+func (r *actionItemRepo) queryActionItems(ctx context.Context, query string, args ...any) (items []entity.ActionItem, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return items, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return items, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item, err := r.parseActionItem(rows)
+		if err != nil {
+			return items, mysqlutils.HandleMySQLError(err)
+		}
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return items, mysqlutils.HandleMySQLError(err)
+	}
+
+	return items, nil
+}
+
+func (r *actionItemRepo) CreateActionItem(ctx context.Context, item entity.ActionItem) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_action_item (
+			action_item_uuid,
+			company_id,
+			person_id,
+			user_id,
+			owner,
+			description,
+			due_date,
+			status,
+			source_note_id,
+			source_meeting_id
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		item.UUID,
+		item.CompanyID,
+		item.PersonID,
+		item.UserID,
+		item.Owner,
+		item.Description,
+		item.DueDate,
+		item.Status,
+		item.SourceNoteID,
+		item.SourceMeetingID,
+	)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+func (r *actionItemRepo) GetActionItemByUUID(ctx context.Context, itemUUID string) (item entity.ActionItem, err error) {
+	query := actionItemSelectBase + `
+		WHERE a.action_item_uuid = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return item, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, itemUUID)
+	item, err = r.parseActionItem(row)
+	if err != nil {
+		return item, mysqlutils.HandleMySQLError(err)
+	}
+
+	return item, nil
+}
+
+func (r *actionItemRepo) GetActionItemsByPerson(ctx context.Context, personID int64, status string, take, skip int64) (items []entity.ActionItem, totalRecords int64, err error) {
+	where := `
+		WHERE a.person_id = ?
+	`
+	args := []any{personID}
+	if status != "" {
+		where += `
+		  AND a.status = ?
+		`
+		args = append(args, status)
+	}
+
+	countQuery := `
+		SELECT COUNT(*)
+		FROM tab_action_item a
+	` + where
+
+	stmt, err := r.db.PrepareContext(ctx, countQuery)
+	if err != nil {
+		return items, 0, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, args...).Scan(&totalRecords)
+	if err != nil {
+		return items, 0, mysqlutils.HandleMySQLError(err)
+	}
+
+	query := actionItemSelectBase + where + `
+		ORDER BY a.due_date IS NULL, a.due_date ASC, a.created_at DESC
+		LIMIT ? OFFSET ?
+	`
+
+	items, err = r.queryActionItems(ctx, query, append(args, take, skip)...)
+	if err != nil {
+		return items, totalRecords, err
+	}
+
+	return items, totalRecords, nil
+}
+
+func (r *actionItemRepo) GetOpenActionItemsByPerson(ctx context.Context, personID int64) (items []entity.ActionItem, err error) {
+	query := actionItemSelectBase + `
+		WHERE a.person_id = ?
+		  AND a.status    = ?
+		ORDER BY a.due_date IS NULL, a.due_date ASC, a.created_at ASC
+	`
+
+	return r.queryActionItems(ctx, query, personID, domain.ActionItemStatusOpen)
+}
+
+func (r *actionItemRepo) GetActionItemsToCarryOver(ctx context.Context, personID int64) (items []entity.ActionItem, err error) {
+	query := actionItemSelectBase + `
+		WHERE a.person_id = ?
+		  AND a.status    = ?
+		  AND NOT EXISTS (
+			SELECT 1
+			FROM tab_meeting_agenda_item ai
+			INNER JOIN tab_meeting sm
+				ON sm.meeting_id = ai.meeting_id
+			WHERE ai.action_item_id = a.action_item_id
+			  AND sm.status         = ?
+		  )
+		ORDER BY a.due_date IS NULL, a.due_date ASC, a.created_at ASC
+	`
+
+	return r.queryActionItems(ctx, query, personID, domain.ActionItemStatusOpen, domain.MeetingStatusScheduled)
+}
+
+func (r *actionItemRepo) GetOverdueActionItemsByCompany(ctx context.Context, companyID int64, today time.Time) (items []entity.ActionItem, err error) {
+	query := actionItemSelectBase + `
+		WHERE a.company_id = ?
+		  AND a.status     = ?
+		  AND a.due_date   < ?
+		  AND p.active     = 1
+		ORDER BY a.due_date ASC, a.created_at ASC
+	`
+
+	return r.queryActionItems(ctx, query, companyID, domain.ActionItemStatusOpen, today.Format("2006-01-02"))
+}
+
+func (r *actionItemRepo) UpdateActionItem(ctx context.Context, itemID int64, item entity.ActionItem) (err error) {
+	query := `
+		UPDATE tab_action_item
+		SET
+			owner        = ?,
+			description  = ?,
+			due_date     = ?,
+			status       = ?,
+			completed_at = ?
+		WHERE action_item_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		item.Owner,
+		item.Description,
+		item.DueDate,
+		item.Status,
+		item.CompletedAt,
+		itemID,
+	)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *actionItemRepo) DeleteActionItem(ctx context.Context, itemID int64) (err error) {
+	query := `
+		DELETE FROM tab_action_item
+		WHERE action_item_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, itemID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+func createRandomActionItem(t *testing.T, person entity.Person, dueDate *time.Time, sourceMeetingID *int64) entity.ActionItem {
+	item := entity.ActionItem{
+		UUID:            uuid.NewV4().String(),
+		CompanyID:       person.CompanyID,
+		PersonID:        person.ID,
+		UserID:          person.CreatedBy,
+		Owner:           domain.ActionItemOwnerManager,
+		Description:     "Share the promotion criteria",
+		DueDate:         dueDate,
+		Status:          domain.ActionItemStatusOpen,
+		SourceMeetingID: sourceMeetingID,
+	}
+
+	itemID, err := testMysql.ActionItem().CreateActionItem(context.Background(), item)
+	require.NoError(t, err)
+	require.NotZero(t, itemID)
+
+	item.ID = itemID
+	return item
+}
+
+func TestCreateAndGetActionItem(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	heldAt := time.Now().Truncate(time.Second)
+	meeting := createRandomMeeting(t, person, domain.MeetingStatusHeld, &heldAt)
+	dueDate := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	item := createRandomActionItem(t, person, &dueDate, &meeting.ID)
+
+	result, err := testMysql.ActionItem().GetActionItemByUUID(ctx, item.UUID)
+	require.NoError(t, err)
+	require.Equal(t, item.ID, result.ID)
+	require.Equal(t, person.UUID, result.PersonUUID)
+	require.Equal(t, domain.ActionItemStatusOpen, result.Status)
+	require.Equal(t, "2025-03-14", result.DueDate.Format("2006-01-02"))
+	require.Equal(t, meeting.UUID, *result.SourceMeetingUUID)
+	require.Nil(t, result.SourceNoteUUID)
+}
+
+func TestUpdateActionItem(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	item := createRandomActionItem(t, person, nil, nil)
+
+	completedAt := time.Now().Truncate(time.Second)
+	item.Status = domain.ActionItemStatusDone
+	item.Owner = domain.ActionItemOwnerReport
+	item.CompletedAt = &completedAt
+
+	err := testMysql.ActionItem().UpdateActionItem(ctx, item.ID, item)
+	require.NoError(t, err)
+
+	result, err := testMysql.ActionItem().GetActionItemByUUID(ctx, item.UUID)
+	require.NoError(t, err)
+	require.Equal(t, domain.ActionItemStatusDone, result.Status)
+	require.Equal(t, domain.ActionItemOwnerReport, result.Owner)
+	require.NotNil(t, result.CompletedAt)
+
+	items, totalRecords, err := testMysql.ActionItem().GetActionItemsByPerson(ctx, person.ID, domain.ActionItemStatusOpen, 10, 0)
+	require.NoError(t, err)
+	require.Zero(t, totalRecords)
+	require.Empty(t, items)
+}
+
+func TestGetActionItemsToCarryOver(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	carried := createRandomActionItem(t, person, nil, nil)
+	pending := createRandomActionItem(t, person, nil, nil)
+
+	meeting := createRandomMeeting(t, person, domain.MeetingStatusScheduled, nil)
+	_, err := testMysql.Meeting().CreateAgendaItem(ctx, entity.MeetingAgendaItem{
+		UUID:         uuid.NewV4().String(),
+		MeetingID:    meeting.ID,
+		ActionItemID: &carried.ID,
+		AddedBy:      domain.AgendaItemAddedByManager,
+		Content:      carried.Description,
+	})
+	require.NoError(t, err)
+
+	items, err := testMysql.ActionItem().GetActionItemsToCarryOver(ctx, person.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.Equal(t, pending.UUID, items[0].UUID)
+
+	agenda, err := testMysql.Meeting().GetAgendaItemsByMeeting(ctx, meeting.ID)
+	require.NoError(t, err)
+	require.Len(t, agenda, 1)
+	require.Equal(t, carried.UUID, *agenda[0].ActionItemUUID)
+
+	next, err := testMysql.Meeting().GetNextScheduledMeeting(ctx, person.ID)
+	require.NoError(t, err)
+	require.Equal(t, meeting.UUID, next.UUID)
+
+	open, err := testMysql.ActionItem().GetOpenActionItemsByPerson(ctx, person.ID)
+	require.NoError(t, err)
+	require.Len(t, open, 2)
+}
+
+func TestGetOverdueActionItemsByCompany(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	today := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC)
+	yesterday := today.AddDate(0, 0, -1)
+
+	overdue := createRandomActionItem(t, person, &yesterday, nil)
+	createRandomActionItem(t, person, &today, nil)
+	createRandomActionItem(t, person, nil, nil)
+
+	items, err := testMysql.ActionItem().GetOverdueActionItemsByCompany(ctx, person.CompanyID, today)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.Equal(t, overdue.UUID, items[0].UUID)
+}
+
+func TestDeleteActionItem(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	item := createRandomActionItem(t, person, nil, nil)
+
+	err := testMysql.ActionItem().DeleteActionItem(ctx, item.ID)
+	require.NoError(t, err)
+
+	_, err = testMysql.ActionItem().GetActionItemByUUID(ctx, item.UUID)
+	require.Error(t, err)
+}
+
+// Error tests with mocks
+func TestCreateActionItemErrorsWithMock(t *testing.T) {
+	testForInsertErrorsWithMock(t, func(db *sql.DB) error {
+		_, err := newActionItemRepo(db).CreateActionItem(context.Background(), entity.ActionItem{})
+		return err
+	})
+}
+
+func TestGetActionItemByUUIDErrorsWithMock(t *testing.T) {
+	testForSelectErrorsWithMock(t, "action_item_id", func(db *sql.DB) error {
+		_, err := newActionItemRepo(db).GetActionItemByUUID(context.Background(), "action-item-uuid")
+		return err
+	})
+}
+
+func TestUpdateActionItemErrorsWithMock(t *testing.T) {
+	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
+		return newActionItemRepo(db).UpdateActionItem(context.Background(), 1, entity.ActionItem{})
+	})
+}
+
+func TestDeleteActionItemErrorsWithMock(t *testing.T) {
+	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
+		return newActionItemRepo(db).DeleteActionItem(context.Background(), 1)
+	})
+}
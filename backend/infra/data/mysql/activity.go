@@ -0,0 +1,254 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type activityRepo struct {
+	db dbConn
+}
+
+func newActivityRepo(db dbConn) contract.ActivityRepo {
+	return &activityRepo{
+		db: db,
+	}
+}
+
+const activitySelectBase string = `
+	SELECT
+		a.activity_id,
+		a.activity_uuid,
+		a.company_id,
+		a.person_id,
+		a.user_id,
+		a.type,
+		a.detail,
+		a.reference_uuid,
+		a.created_at,
+		p.person_uuid,
+		p.name,
+		COALESCE(u.name, '')
+
+	FROM tab_activity a
+	INNER JOIN tab_person p ON p.person_id = a.person_id
+	LEFT JOIN tab_user u ON u.user_id = a.user_id
+`
+
+func (r *activityRepo) parseActivity(row scanner) (activity entity.Activity, err error) {
+	err = row.Scan(
+		&activity.ID,
+		&activity.UUID,
+		&activity.CompanyID,
+		&activity.PersonID,
+		&activity.UserID,
+		&activity.Type,
+		&activity.Detail,
+		&activity.ReferenceUUID,
+		&activity.CreatedAt,
+		&activity.PersonUUID,
+		&activity.PersonName,
+		&activity.UserName,
+	)
+	if err != nil {
+		return activity, err
+	}
+
+	return activity, nil
+}
+
+func (r *activityRepo) CreateActivity(ctx context.Context, activity entity.Activity) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_activity (
+			activity_uuid,
+			company_id,
+			person_id,
+			user_id,
+			type,
+			detail,
+			reference_uuid,
+			created_at
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		activity.UUID,
+		activity.CompanyID,
+		activity.PersonID,
+		activity.UserID,
+		activity.Type,
+		activity.Detail,
+		activity.ReferenceUUID,
+		activity.CreatedAt,
+	)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+func (r *activityRepo) GetActivityByUUID(ctx context.Context, activityUUID string) (activity entity.Activity, err error) {
+	query := activitySelectBase + `
+		WHERE a.activity_uuid = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return activity, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	activity, err = r.parseActivity(stmt.QueryRowContext(ctx, activityUUID))
+	if err != nil {
+		return activity, mysqlutils.HandleMySQLError(err)
+	}
+
+	return activity, nil
+}
+
+func (r *activityRepo) GetActivities(ctx context.Context, query entity.ActivityQuery, page entity.CursorPage) (activities []entity.Activity, info entity.CursorPageInfo, err error) {
+	where := ` WHERE a.company_id = ?`
+	args := []any{query.CompanyID}
+
+	if query.PersonID != nil {
+		where += ` AND a.person_id = ?`
+		args = append(args, *query.PersonID)
+	}
+
+	if query.Team != "" {
+		where += ` AND p.department = ?`
+		args = append(args, query.Team)
+	}
+
+	if len(query.Types) > 0 {
+		placeholders := make([]string, len(query.Types))
+		for i, activityType := range query.Types {
+			placeholders[i] = "?"
+			args = append(args, activityType)
+		}
+		where += ` AND a.type IN (` + strings.Join(placeholders, ",") + `)`
+	}
+
+	if page.WithCount {
+		totalRecords, err := r.count(ctx, `SELECT COUNT(*) FROM tab_activity a INNER JOIN tab_person p ON p.person_id = a.person_id`+where, args...)
+		if err != nil {
+			return activities, info, err
+		}
+		info.TotalRecords = &totalRecords
+	}
+
+	condition, cursorArgs := cursorCondition("a.created_at", "a.activity_id", page.After, false)
+	args = append(args, cursorArgs...)
+	args = append(args, page.Limit+1) // one more to know whether there is a next page
+
+	stmt, err := r.db.PrepareContext(ctx, activitySelectBase+where+condition+`
+		ORDER BY a.created_at DESC, a.activity_id DESC
+		LIMIT ?
+	`)
+	if err != nil {
+		return activities, info, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return activities, info, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		activity, err := r.parseActivity(rows)
+		if err != nil {
+			return activities, info, mysqlutils.HandleMySQLError(err)
+		}
+		activities = append(activities, activity)
+	}
+
+	if err = rows.Err(); err != nil {
+		return activities, info, mysqlutils.HandleMySQLError(err)
+	}
+
+	activities, info.Next = cursorPageItems(activities, page.Limit, func(activity entity.Activity) entity.PageCursor {
+		return entity.PageCursor{CreatedAt: activity.CreatedAt, ID: activity.ID}
+	})
+	return activities, info, nil
+}
+
+func (r *activityRepo) GetLatestActivityID(ctx context.Context, companyID int64) (activityID int64, err error) {
+	return r.count(ctx, `SELECT COALESCE(MAX(activity_id), 0) FROM tab_activity WHERE company_id = ?`, companyID)
+}
+
+func (r *activityRepo) CountActivitiesAfter(ctx context.Context, companyID, activityID int64) (count int64, err error) {
+	return r.count(ctx, `SELECT COUNT(*) FROM tab_activity WHERE company_id = ? AND activity_id > ?`, companyID, activityID)
+}
+
+func (r *activityRepo) GetLastSeenActivityID(ctx context.Context, userID, companyID int64) (activityID int64, err error) {
+	activityID, err = r.count(ctx, `
+		SELECT last_seen_activity_id
+		FROM tab_activity_seen
+		WHERE user_id = ? AND company_id = ?
+	`, userID, companyID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return activityID, nil
+}
+
+func (r *activityRepo) SetLastSeenActivityID(ctx context.Context, userID, companyID, activityID int64) (err error) {
+	query := `
+		INSERT INTO tab_activity_seen (user_id, company_id, last_seen_activity_id)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			last_seen_activity_id = GREATEST(last_seen_activity_id, VALUES(last_seen_activity_id))
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, userID, companyID, activityID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+// count runs a query returning a single number
+func (r *activityRepo) count(ctx context.Context, query string, args ...any) (count int64, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return count, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, args...).Scan(&count)
+	if err != nil {
+		return count, mysqlutils.HandleMySQLError(err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,128 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+func TestActivities(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+
+	colleague := person
+	colleague.UUID = uuid.NewV4().String()
+	colleague.Email = "colleague" + uuid.NewV4().String()[:8] + "@example.com"
+	colleague.Department = "Sales"
+	colleagueID, err := testMysql.Person().CreatePerson(ctx, colleague)
+	require.NoError(t, err)
+	colleague.ID = colleagueID
+
+	createActivity := func(personID int64, userID *int64, activityType string, createdAt time.Time) entity.Activity {
+		activity := entity.Activity{
+			UUID:      uuid.NewV4().String(),
+			CompanyID: person.CompanyID,
+			PersonID:  personID,
+			UserID:    userID,
+			Type:      activityType,
+			Detail:    "detail",
+			CreatedAt: createdAt,
+		}
+
+		activity.ID, err = testMysql.Activity().CreateActivity(ctx, activity)
+		require.NoError(t, err)
+		return activity
+	}
+
+	start := time.Date(2025, time.May, 5, 9, 0, 0, 0, time.UTC)
+	note := createActivity(person.ID, &person.CreatedBy, domain.ActivityTypeNoteCreated, start)
+	extracted := createActivity(person.ID, nil, domain.ActivityTypeAttributeExtracted, start.Add(time.Minute))
+	goal := createActivity(colleague.ID, &person.CreatedBy, domain.ActivityTypeGoalUpdated, start.Add(time.Hour))
+	sameTime := createActivity(colleague.ID, &person.CreatedBy, domain.ActivityTypeProfileUpdated, goal.CreatedAt)
+
+	getUUIDs := func(query entity.ActivityQuery) []string {
+		query.CompanyID = person.CompanyID
+		activities, _, err := testMysql.Activity().GetActivities(ctx, query, entity.CursorPage{Limit: 10})
+		require.NoError(t, err)
+
+		uuids := make([]string, len(activities))
+		for i, activity := range activities {
+			uuids[i] = activity.UUID
+		}
+		return uuids
+	}
+
+	t.Run("Should get the activity with its person and user", func(t *testing.T) {
+		activity, err := testMysql.Activity().GetActivityByUUID(ctx, note.UUID)
+		require.NoError(t, err)
+		require.Equal(t, note.ID, activity.ID)
+		require.Equal(t, person.UUID, activity.PersonUUID)
+		require.Equal(t, person.Name, activity.PersonName)
+		require.NotEmpty(t, activity.UserName)
+
+		activity, err = testMysql.Activity().GetActivityByUUID(ctx, extracted.UUID)
+		require.NoError(t, err)
+		require.Nil(t, activity.UserID)
+		require.Empty(t, activity.UserName)
+	})
+
+	t.Run("Should return the newest activities first", func(t *testing.T) {
+		require.Equal(t, []string{sameTime.UUID, goal.UUID, extracted.UUID, note.UUID}, getUUIDs(entity.ActivityQuery{}))
+	})
+
+	t.Run("Should filter by person, team and type", func(t *testing.T) {
+		require.Equal(t, []string{extracted.UUID, note.UUID}, getUUIDs(entity.ActivityQuery{PersonID: &person.ID}))
+		require.Equal(t, []string{sameTime.UUID, goal.UUID}, getUUIDs(entity.ActivityQuery{Team: "Sales"}))
+
+		uuids := getUUIDs(entity.ActivityQuery{Types: []string{domain.ActivityTypeNoteCreated, domain.ActivityTypeGoalUpdated}})
+		require.Equal(t, []string{goal.UUID, note.UUID}, uuids)
+	})
+
+	t.Run("Should page by cursor without skipping or repeating activities", func(t *testing.T) {
+		page := entity.CursorPage{Limit: 3, WithCount: true}
+		activities, info, err := testMysql.Activity().GetActivities(ctx, entity.ActivityQuery{CompanyID: person.CompanyID}, page)
+		require.NoError(t, err)
+		require.Equal(t, int64(4), *info.TotalRecords)
+		require.Len(t, activities, 3)
+		require.NotNil(t, info.Next)
+
+		page = entity.CursorPage{After: info.Next, Limit: 3}
+		activities, info, err = testMysql.Activity().GetActivities(ctx, entity.ActivityQuery{CompanyID: person.CompanyID}, page)
+		require.NoError(t, err)
+		require.Nil(t, info.Next)
+		require.Len(t, activities, 1)
+		require.Equal(t, note.UUID, activities[0].UUID)
+	})
+
+	t.Run("Should keep the last seen activity of the user", func(t *testing.T) {
+		userID := person.CreatedBy
+
+		lastSeenID, err := testMysql.Activity().GetLastSeenActivityID(ctx, userID, person.CompanyID)
+		require.NoError(t, err)
+		require.Zero(t, lastSeenID)
+
+		latestID, err := testMysql.Activity().GetLatestActivityID(ctx, person.CompanyID)
+		require.NoError(t, err)
+		require.Equal(t, sameTime.ID, latestID)
+
+		err = testMysql.Activity().SetLastSeenActivityID(ctx, userID, person.CompanyID, extracted.ID)
+		require.NoError(t, err)
+
+		unread, err := testMysql.Activity().CountActivitiesAfter(ctx, person.CompanyID, extracted.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), unread)
+
+		// an older activity does not move the marker back
+		err = testMysql.Activity().SetLastSeenActivityID(ctx, userID, person.CompanyID, note.ID)
+		require.NoError(t, err)
+
+		lastSeenID, err = testMysql.Activity().GetLastSeenActivityID(ctx, userID, person.CompanyID)
+		require.NoError(t, err)
+		require.Equal(t, extracted.ID, lastSeenID)
+	})
+}
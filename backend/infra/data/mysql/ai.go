@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/diegoclair/go_utils/mysqlutils"
 	"github.com/diegoclair/leaderpro/internal/domain/contract"
@@ -229,3 +230,29 @@ func (r *aiRepo) CreateConversation(ctx context.Context, conversation entity.AIC
 	conversation.ID = id
 	return conversation, nil
 }
+
+func (r *aiRepo) DeleteExpiredConversations(ctx context.Context, now time.Time, limit int) (deleted int64, err error) {
+	query := `
+		DELETE FROM ai_conversations
+		WHERE expires_at < ?
+		LIMIT ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return deleted, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, now, limit)
+	if err != nil {
+		return deleted, mysqlutils.HandleMySQLError(err)
+	}
+
+	deleted, err = result.RowsAffected()
+	if err != nil {
+		return deleted, mysqlutils.HandleMySQLError(err)
+	}
+
+	return deleted, nil
+}
@@ -178,6 +178,41 @@ func (r *companyRepo) GetCompaniesByUser(ctx context.Context, userID int64) (com
 }
 
 
+func (r *companyRepo) GetCompaniesAfterID(ctx context.Context, afterID int64, limit int) (companies []entity.Company, err error) {
+	query := companySelectBase + `
+		WHERE c.company_id > ?
+		  AND c.active     = 1
+		ORDER BY c.company_id
+		LIMIT ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return companies, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, afterID, limit)
+	if err != nil {
+		return companies, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		company, err := r.parseCompany(rows)
+		if err != nil {
+			return companies, mysqlutils.HandleMySQLError(err)
+		}
+		companies = append(companies, company)
+	}
+
+	if err = rows.Err(); err != nil {
+		return companies, mysqlutils.HandleMySQLError(err)
+	}
+
+	return companies, nil
+}
+
 func (r *companyRepo) UpdateCompany(ctx context.Context, companyID int64, company entity.Company) (err error) {
 	query := `
 		UPDATE tab_company
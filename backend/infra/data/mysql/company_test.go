@@ -128,6 +128,24 @@ func TestGetCompaniesByUser(t *testing.T) {
 	require.True(t, companyUUIDs[company2.UUID])
 }
 
+func TestGetCompaniesAfterID(t *testing.T) {
+	ctx := context.Background()
+	company1 := createRandomCompany(t)
+	company2 := createRandomCompany(t)
+
+	companies, err := testMysql.Company().GetCompaniesAfterID(ctx, company1.ID-1, 2)
+	require.NoError(t, err)
+	require.Len(t, companies, 2)
+	require.Equal(t, company1.UUID, companies[0].UUID)
+	require.Equal(t, company2.UUID, companies[1].UUID)
+
+	companies, err = testMysql.Company().GetCompaniesAfterID(ctx, company2.ID, 10)
+	require.NoError(t, err)
+	for _, company := range companies {
+		require.Greater(t, company.ID, company2.ID)
+	}
+}
+
 func TestUpdateCompany(t *testing.T) {
 	ctx := context.Background()
 	company := createRandomCompany(t)
@@ -187,6 +205,13 @@ func TestGetCompaniesByUserErrorsWithMock(t *testing.T) {
 	})
 }
 
+func TestGetCompaniesAfterIDErrorsWithMock(t *testing.T) {
+	testForSelectErrorsWithMock(t, "company_id", func(db *sql.DB) error {
+		_, err := newCompanyRepo(db).GetCompaniesAfterID(context.Background(), 0, 10)
+		return err
+	})
+}
+
 func TestUpdateCompanyErrorsWithMock(t *testing.T) {
 	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
 		return newCompanyRepo(db).UpdateCompany(context.Background(), 1, entity.Company{})
@@ -0,0 +1,873 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type competencyRepo struct {
+	db dbConn
+}
+
+func newCompetencyRepo(db dbConn) contract.CompetencyRepo {
+	return &competencyRepo{
+		db: db,
+	}
+}
+
+// insert runs an insert, returning the id of the created row
+func (r *competencyRepo) insert(ctx context.Context, query string, args ...any) (createdID int64, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+// delete runs a delete by id, returning sql.ErrNoRows when nothing was deleted
+func (r *competencyRepo) delete(ctx context.Context, query string, id int64) (err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, id)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+const competencyFrameworkSelectBase string = `
+	SELECT
+		f.framework_id,
+		f.framework_uuid,
+		f.company_id,
+		f.name,
+		f.description,
+		f.created_at,
+		f.updated_at
+
+	FROM tab_competency_framework f
+`
+
+func (r *competencyRepo) parseFramework(row scanner) (framework entity.CompetencyFramework, err error) {
+	var description sql.NullString
+
+	err = row.Scan(
+		&framework.ID,
+		&framework.UUID,
+		&framework.CompanyID,
+		&framework.Name,
+		&description,
+		&framework.CreatedAt,
+		&framework.UpdatedAt,
+	)
+	if err != nil {
+		return framework, err
+	}
+
+	framework.Description = description.String
+
+	return framework, nil
+}
+
+func (r *competencyRepo) getFramework(ctx context.Context, query string, arg any) (framework entity.CompetencyFramework, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return framework, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, arg)
+	framework, err = r.parseFramework(row)
+	if err != nil {
+		return framework, mysqlutils.HandleMySQLError(err)
+	}
+
+	return framework, nil
+}
+
+func (r *competencyRepo) CreateFramework(ctx context.Context, framework entity.CompetencyFramework) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_competency_framework (
+			framework_uuid,
+			company_id,
+			name,
+			description
+		) VALUES (?, ?, ?, ?)
+	`
+
+	return r.insert(ctx, query,
+		framework.UUID,
+		framework.CompanyID,
+		framework.Name,
+		nullableString(framework.Description),
+	)
+}
+
+func (r *competencyRepo) GetFrameworkByUUID(ctx context.Context, frameworkUUID string) (framework entity.CompetencyFramework, err error) {
+	query := competencyFrameworkSelectBase + `
+		WHERE f.framework_uuid = ?
+	`
+
+	return r.getFramework(ctx, query, frameworkUUID)
+}
+
+func (r *competencyRepo) GetFrameworkByID(ctx context.Context, frameworkID int64) (framework entity.CompetencyFramework, err error) {
+	query := competencyFrameworkSelectBase + `
+		WHERE f.framework_id = ?
+	`
+
+	return r.getFramework(ctx, query, frameworkID)
+}
+
+func (r *competencyRepo) GetFrameworksByCompany(ctx context.Context, companyID int64) (frameworks []entity.CompetencyFramework, err error) {
+	query := competencyFrameworkSelectBase + `
+		WHERE f.company_id = ?
+		ORDER BY f.name ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return frameworks, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, companyID)
+	if err != nil {
+		return frameworks, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		framework, err := r.parseFramework(rows)
+		if err != nil {
+			return frameworks, mysqlutils.HandleMySQLError(err)
+		}
+		frameworks = append(frameworks, framework)
+	}
+
+	if err = rows.Err(); err != nil {
+		return frameworks, mysqlutils.HandleMySQLError(err)
+	}
+
+	return frameworks, nil
+}
+
+func (r *competencyRepo) DeleteFramework(ctx context.Context, frameworkID int64) (err error) {
+	query := `
+		DELETE FROM tab_competency_framework
+		WHERE framework_id = ?
+	`
+
+	return r.delete(ctx, query, frameworkID)
+}
+
+func (r *competencyRepo) CreateLevel(ctx context.Context, level entity.CompetencyLevel) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_competency_level (
+			level_uuid,
+			framework_id,
+			name,
+			description,
+			position
+		)
+		SELECT ?, ?, ?, ?, COALESCE(MAX(position), 0) + 1
+		FROM tab_competency_level
+		WHERE framework_id = ?
+	`
+
+	return r.insert(ctx, query,
+		level.UUID,
+		level.FrameworkID,
+		level.Name,
+		nullableString(level.Description),
+		level.FrameworkID,
+	)
+}
+
+func (r *competencyRepo) GetLevelsByFramework(ctx context.Context, frameworkID int64) (levels []entity.CompetencyLevel, err error) {
+	query := `
+		SELECT
+			cl.level_id,
+			cl.level_uuid,
+			cl.framework_id,
+			cl.name,
+			cl.description,
+			cl.position
+
+		FROM tab_competency_level cl
+		WHERE cl.framework_id = ?
+		ORDER BY cl.position ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return levels, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, frameworkID)
+	if err != nil {
+		return levels, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var level entity.CompetencyLevel
+		var description sql.NullString
+
+		err = rows.Scan(
+			&level.ID,
+			&level.UUID,
+			&level.FrameworkID,
+			&level.Name,
+			&description,
+			&level.Position,
+		)
+		if err != nil {
+			return levels, mysqlutils.HandleMySQLError(err)
+		}
+
+		level.Description = description.String
+		levels = append(levels, level)
+	}
+
+	if err = rows.Err(); err != nil {
+		return levels, mysqlutils.HandleMySQLError(err)
+	}
+
+	return levels, nil
+}
+
+const competencySelectBase string = `
+	SELECT
+		c.competency_id,
+		c.competency_uuid,
+		c.framework_id,
+		f.framework_uuid,
+		f.company_id,
+		c.name,
+		c.description,
+		c.position
+
+	FROM tab_competency c
+	INNER JOIN tab_competency_framework f ON f.framework_id = c.framework_id
+`
+
+func (r *competencyRepo) parseCompetency(row scanner) (competency entity.Competency, err error) {
+	var description sql.NullString
+
+	err = row.Scan(
+		&competency.ID,
+		&competency.UUID,
+		&competency.FrameworkID,
+		&competency.FrameworkUUID,
+		&competency.CompanyID,
+		&competency.Name,
+		&description,
+		&competency.Position,
+	)
+	if err != nil {
+		return competency, err
+	}
+
+	competency.Description = description.String
+
+	return competency, nil
+}
+
+func (r *competencyRepo) queryCompetencies(ctx context.Context, query string, arg any) (competencies []entity.Competency, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return competencies, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, arg)
+	if err != nil {
+		return competencies, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		competency, err := r.parseCompetency(rows)
+		if err != nil {
+			return competencies, mysqlutils.HandleMySQLError(err)
+		}
+		competencies = append(competencies, competency)
+	}
+
+	if err = rows.Err(); err != nil {
+		return competencies, mysqlutils.HandleMySQLError(err)
+	}
+
+	return competencies, nil
+}
+
+func (r *competencyRepo) CreateCompetency(ctx context.Context, competency entity.Competency) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_competency (
+			competency_uuid,
+			framework_id,
+			name,
+			description,
+			position
+		)
+		SELECT ?, ?, ?, ?, COALESCE(MAX(position), 0) + 1
+		FROM tab_competency
+		WHERE framework_id = ?
+	`
+
+	return r.insert(ctx, query,
+		competency.UUID,
+		competency.FrameworkID,
+		competency.Name,
+		nullableString(competency.Description),
+		competency.FrameworkID,
+	)
+}
+
+func (r *competencyRepo) GetCompetencyByUUID(ctx context.Context, competencyUUID string) (competency entity.Competency, err error) {
+	query := competencySelectBase + `
+		WHERE c.competency_uuid = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return competency, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, competencyUUID)
+	competency, err = r.parseCompetency(row)
+	if err != nil {
+		return competency, mysqlutils.HandleMySQLError(err)
+	}
+
+	return competency, nil
+}
+
+func (r *competencyRepo) GetCompetenciesByFramework(ctx context.Context, frameworkID int64) (competencies []entity.Competency, err error) {
+	query := competencySelectBase + `
+		WHERE c.framework_id = ?
+		ORDER BY c.position ASC
+	`
+
+	return r.queryCompetencies(ctx, query, frameworkID)
+}
+
+func (r *competencyRepo) CreateDescriptor(ctx context.Context, descriptor entity.CompetencyDescriptor) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_competency_descriptor (
+			competency_id,
+			level_id,
+			description
+		) VALUES (?, ?, ?)
+	`
+
+	return r.insert(ctx, query,
+		descriptor.CompetencyID,
+		descriptor.LevelID,
+		descriptor.Description,
+	)
+}
+
+func (r *competencyRepo) GetDescriptorsByFramework(ctx context.Context, frameworkID int64) (descriptors []entity.CompetencyDescriptor, err error) {
+	query := `
+		SELECT
+			cd.descriptor_id,
+			cd.competency_id,
+			cd.level_id,
+			cl.level_uuid,
+			cl.position,
+			cd.description
+
+		FROM tab_competency_descriptor cd
+		INNER JOIN tab_competency_level cl ON cl.level_id = cd.level_id
+		WHERE cl.framework_id = ?
+		ORDER BY cd.competency_id ASC, cl.position ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return descriptors, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, frameworkID)
+	if err != nil {
+		return descriptors, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var descriptor entity.CompetencyDescriptor
+
+		err = rows.Scan(
+			&descriptor.ID,
+			&descriptor.CompetencyID,
+			&descriptor.LevelID,
+			&descriptor.LevelUUID,
+			&descriptor.LevelPosition,
+			&descriptor.Description,
+		)
+		if err != nil {
+			return descriptors, mysqlutils.HandleMySQLError(err)
+		}
+
+		descriptors = append(descriptors, descriptor)
+	}
+
+	if err = rows.Err(); err != nil {
+		return descriptors, mysqlutils.HandleMySQLError(err)
+	}
+
+	return descriptors, nil
+}
+
+const competencyAssessmentSelectBase string = `
+	SELECT
+		a.assessment_id,
+		a.assessment_uuid,
+		a.company_id,
+		a.person_id,
+		p.person_uuid,
+		a.framework_id,
+		f.framework_uuid,
+		f.name,
+		a.expected_level_id,
+		el.level_uuid,
+		el.name,
+		a.user_id,
+		a.notes,
+		a.assessed_at,
+		a.created_at
+
+	FROM tab_competency_assessment a
+	INNER JOIN tab_person p ON p.person_id = a.person_id
+	INNER JOIN tab_competency_framework f ON f.framework_id = a.framework_id
+	INNER JOIN tab_competency_level el ON el.level_id = a.expected_level_id
+`
+
+func (r *competencyRepo) parseAssessment(row scanner) (assessment entity.CompetencyAssessment, err error) {
+	var notes sql.NullString
+
+	err = row.Scan(
+		&assessment.ID,
+		&assessment.UUID,
+		&assessment.CompanyID,
+		&assessment.PersonID,
+		&assessment.PersonUUID,
+		&assessment.FrameworkID,
+		&assessment.FrameworkUUID,
+		&assessment.FrameworkName,
+		&assessment.ExpectedLevelID,
+		&assessment.ExpectedLevelUUID,
+		&assessment.ExpectedLevelName,
+		&assessment.UserID,
+		&notes,
+		&assessment.AssessedAt,
+		&assessment.CreatedAt,
+	)
+	if err != nil {
+		return assessment, err
+	}
+
+	assessment.Notes = notes.String
+
+	return assessment, nil
+}
+
+func (r *competencyRepo) CreateAssessment(ctx context.Context, assessment entity.CompetencyAssessment) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_competency_assessment (
+			assessment_uuid,
+			company_id,
+			person_id,
+			framework_id,
+			expected_level_id,
+			user_id,
+			notes,
+			assessed_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	return r.insert(ctx, query,
+		assessment.UUID,
+		assessment.CompanyID,
+		assessment.PersonID,
+		assessment.FrameworkID,
+		assessment.ExpectedLevelID,
+		assessment.UserID,
+		nullableString(assessment.Notes),
+		assessment.AssessedAt,
+	)
+}
+
+func (r *competencyRepo) GetAssessmentByUUID(ctx context.Context, assessmentUUID string) (assessment entity.CompetencyAssessment, err error) {
+	query := competencyAssessmentSelectBase + `
+		WHERE a.assessment_uuid = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return assessment, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, assessmentUUID)
+	assessment, err = r.parseAssessment(row)
+	if err != nil {
+		return assessment, mysqlutils.HandleMySQLError(err)
+	}
+
+	return assessment, nil
+}
+
+func (r *competencyRepo) GetAssessmentsByPerson(ctx context.Context, personID, frameworkID int64) (assessments []entity.CompetencyAssessment, err error) {
+	query := competencyAssessmentSelectBase + `
+		WHERE a.person_id = ?`
+
+	args := []any{personID}
+	if frameworkID > 0 {
+		query += ` AND a.framework_id = ?`
+		args = append(args, frameworkID)
+	}
+
+	query += ` ORDER BY a.assessed_at DESC, a.assessment_id DESC`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return assessments, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return assessments, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		assessment, err := r.parseAssessment(rows)
+		if err != nil {
+			return assessments, mysqlutils.HandleMySQLError(err)
+		}
+		assessments = append(assessments, assessment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return assessments, mysqlutils.HandleMySQLError(err)
+	}
+
+	return assessments, nil
+}
+
+func (r *competencyRepo) CountAssessmentsByFramework(ctx context.Context, frameworkID int64) (count int64, err error) {
+	query := `
+		SELECT COUNT(*)
+		FROM tab_competency_assessment
+		WHERE framework_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return count, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, frameworkID).Scan(&count)
+	if err != nil {
+		return count, mysqlutils.HandleMySQLError(err)
+	}
+
+	return count, nil
+}
+
+func (r *competencyRepo) DeleteAssessment(ctx context.Context, assessmentID int64) (err error) {
+	query := `
+		DELETE FROM tab_competency_assessment
+		WHERE assessment_id = ?
+	`
+
+	return r.delete(ctx, query, assessmentID)
+}
+
+func (r *competencyRepo) CreateRating(ctx context.Context, rating entity.CompetencyRating) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_competency_assessment_rating (
+			assessment_id,
+			competency_id,
+			level_id,
+			comment
+		) VALUES (?, ?, ?, ?)
+	`
+
+	return r.insert(ctx, query,
+		rating.AssessmentID,
+		rating.CompetencyID,
+		rating.LevelID,
+		nullableString(rating.Comment),
+	)
+}
+
+func (r *competencyRepo) GetRatingsByAssessment(ctx context.Context, assessmentID int64) (ratings []entity.CompetencyRating, err error) {
+	query := `
+		SELECT
+			ar.rating_id,
+			ar.assessment_id,
+			ar.competency_id,
+			c.competency_uuid,
+			c.name,
+			ar.level_id,
+			cl.level_uuid,
+			cl.name,
+			cl.position,
+			ar.comment
+
+		FROM tab_competency_assessment_rating ar
+		INNER JOIN tab_competency c ON c.competency_id = ar.competency_id
+		INNER JOIN tab_competency_level cl ON cl.level_id = ar.level_id
+		WHERE ar.assessment_id = ?
+		ORDER BY c.position ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return ratings, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, assessmentID)
+	if err != nil {
+		return ratings, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rating entity.CompetencyRating
+		var comment sql.NullString
+
+		err = rows.Scan(
+			&rating.ID,
+			&rating.AssessmentID,
+			&rating.CompetencyID,
+			&rating.CompetencyUUID,
+			&rating.CompetencyName,
+			&rating.LevelID,
+			&rating.LevelUUID,
+			&rating.LevelName,
+			&rating.LevelPosition,
+			&comment,
+		)
+		if err != nil {
+			return ratings, mysqlutils.HandleMySQLError(err)
+		}
+
+		rating.Comment = comment.String
+		ratings = append(ratings, rating)
+	}
+
+	if err = rows.Err(); err != nil {
+		return ratings, mysqlutils.HandleMySQLError(err)
+	}
+
+	return ratings, nil
+}
+
+func (r *competencyRepo) DeleteNoteCompetencies(ctx context.Context, noteID int64) (err error) {
+	query := `
+		DELETE FROM tab_note_competency
+		WHERE note_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, noteID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *competencyRepo) AddNoteCompetency(ctx context.Context, noteID, competencyID int64) (err error) {
+	query := `
+		INSERT IGNORE INTO tab_note_competency (
+			note_id,
+			competency_id
+		) VALUES (?, ?)
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, noteID, competencyID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *competencyRepo) GetNoteCompetencies(ctx context.Context, noteID int64) (competencies []entity.Competency, err error) {
+	query := competencySelectBase + `
+		INNER JOIN tab_note_competency nc ON nc.competency_id = c.competency_id
+		WHERE nc.note_id = ?
+		ORDER BY f.name ASC, c.position ASC
+	`
+
+	return r.queryCompetencies(ctx, query, noteID)
+}
+
+func (r *competencyRepo) GetEvidenceCounts(ctx context.Context, personID, frameworkID int64) (counts []entity.CompetencyEvidenceCount, err error) {
+	query := `
+		SELECT
+			nc.competency_id,
+			COUNT(*),
+			MAX(n.created_at)
+
+		FROM tab_note_competency nc
+		INNER JOIN tab_competency c ON c.competency_id = nc.competency_id
+		INNER JOIN tab_note n ON n.note_id = nc.note_id
+		WHERE n.person_id = ?
+		  AND c.framework_id = ?
+		  AND n.deleted_at IS NULL
+		GROUP BY nc.competency_id
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return counts, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, personID, frameworkID)
+	if err != nil {
+		return counts, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var count entity.CompetencyEvidenceCount
+
+		err = rows.Scan(
+			&count.CompetencyID,
+			&count.Count,
+			&count.LastEvidenceAt,
+		)
+		if err != nil {
+			return counts, mysqlutils.HandleMySQLError(err)
+		}
+
+		counts = append(counts, count)
+	}
+
+	if err = rows.Err(); err != nil {
+		return counts, mysqlutils.HandleMySQLError(err)
+	}
+
+	return counts, nil
+}
+
+func (r *competencyRepo) GetCompetencyEvidence(ctx context.Context, personID, competencyID int64) (evidence []entity.UnifiedTimelineEntry, err error) {
+	query := `
+		SELECT
+			n.note_uuid,
+			n.type,
+			n.content,
+			COALESCE(n.respondent_name, u.name),
+			n.created_at,
+			n.feedback_type,
+			n.feedback_category
+
+		FROM tab_note_competency nc
+		INNER JOIN tab_note n ON n.note_id = nc.note_id
+		INNER JOIN tab_user u ON u.user_id = n.user_id
+		WHERE nc.competency_id = ?
+		  AND n.person_id = ?
+		  AND n.deleted_at IS NULL
+		ORDER BY n.created_at DESC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return evidence, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, competencyID, personID)
+	if err != nil {
+		return evidence, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var entry entity.UnifiedTimelineEntry
+		var feedbackType, feedbackCategory sql.NullString
+
+		err = rows.Scan(
+			&entry.UUID,
+			&entry.Type,
+			&entry.Content,
+			&entry.AuthorName,
+			&entry.CreatedAt,
+			&feedbackType,
+			&feedbackCategory,
+		)
+		if err != nil {
+			return evidence, mysqlutils.HandleMySQLError(err)
+		}
+
+		if feedbackType.Valid {
+			entry.FeedbackType = &feedbackType.String
+		}
+		if feedbackCategory.Valid {
+			entry.FeedbackCategory = &feedbackCategory.String
+		}
+
+		evidence = append(evidence, entry)
+	}
+
+	if err = rows.Err(); err != nil {
+		return evidence, mysqlutils.HandleMySQLError(err)
+	}
+
+	return evidence, nil
+}
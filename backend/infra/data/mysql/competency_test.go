@@ -0,0 +1,239 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+func createRandomCompetencyFramework(t *testing.T, companyID int64) entity.CompetencyFramework {
+	ctx := context.Background()
+	framework := entity.CompetencyFramework{
+		UUID:      uuid.NewV4().String(),
+		CompanyID: companyID,
+		Name:      "Engineering " + uuid.NewV4().String()[:8],
+	}
+
+	frameworkID, err := testMysql.Competency().CreateFramework(ctx, framework)
+	require.NoError(t, err)
+	require.NotZero(t, frameworkID)
+	framework.ID = frameworkID
+
+	for _, name := range []string{"Engineer", "Senior Engineer"} {
+		level := entity.CompetencyLevel{UUID: uuid.NewV4().String(), FrameworkID: frameworkID, Name: name}
+
+		level.ID, err = testMysql.Competency().CreateLevel(ctx, level)
+		require.NoError(t, err)
+		framework.Levels = append(framework.Levels, level)
+	}
+
+	for _, name := range []string{"Code quality", "Communication"} {
+		competency := entity.Competency{UUID: uuid.NewV4().String(), FrameworkID: frameworkID, Name: name}
+
+		competency.ID, err = testMysql.Competency().CreateCompetency(ctx, competency)
+		require.NoError(t, err)
+
+		_, err = testMysql.Competency().CreateDescriptor(ctx, entity.CompetencyDescriptor{
+			CompetencyID: competency.ID,
+			LevelID:      framework.Levels[1].ID,
+			Description:  "Sets the bar of the team",
+		})
+		require.NoError(t, err)
+
+		framework.Competencies = append(framework.Competencies, competency)
+	}
+
+	return framework
+}
+
+func createRandomCompetencyAssessment(t *testing.T, person entity.Person, framework entity.CompetencyFramework) entity.CompetencyAssessment {
+	ctx := context.Background()
+	assessment := entity.CompetencyAssessment{
+		UUID:            uuid.NewV4().String(),
+		CompanyID:       person.CompanyID,
+		PersonID:        person.ID,
+		FrameworkID:     framework.ID,
+		ExpectedLevelID: framework.Levels[1].ID,
+		UserID:          person.CreatedBy,
+		AssessedAt:      time.Date(2025, time.July, 10, 0, 0, 0, 0, time.UTC),
+	}
+
+	assessmentID, err := testMysql.Competency().CreateAssessment(ctx, assessment)
+	require.NoError(t, err)
+	require.NotZero(t, assessmentID)
+	assessment.ID = assessmentID
+
+	_, err = testMysql.Competency().CreateRating(ctx, entity.CompetencyRating{
+		AssessmentID: assessmentID,
+		CompetencyID: framework.Competencies[0].ID,
+		LevelID:      framework.Levels[0].ID,
+		Comment:      "Needs more tests",
+	})
+	require.NoError(t, err)
+
+	return assessment
+}
+
+func TestCompetencyFrameworks(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	framework := createRandomCompetencyFramework(t, person.CompanyID)
+
+	result, err := testMysql.Competency().GetFrameworkByUUID(ctx, framework.UUID)
+	require.NoError(t, err)
+	require.Equal(t, framework.ID, result.ID)
+	require.Equal(t, framework.Name, result.Name)
+	require.Empty(t, result.Description)
+
+	levels, err := testMysql.Competency().GetLevelsByFramework(ctx, framework.ID)
+	require.NoError(t, err)
+	require.Len(t, levels, 2)
+	require.Equal(t, 1, levels[0].Position)
+	require.Equal(t, "Senior Engineer", levels[1].Name)
+	require.Equal(t, 2, levels[1].Position)
+
+	competencies, err := testMysql.Competency().GetCompetenciesByFramework(ctx, framework.ID)
+	require.NoError(t, err)
+	require.Len(t, competencies, 2)
+	require.Equal(t, framework.UUID, competencies[0].FrameworkUUID)
+	require.Equal(t, person.CompanyID, competencies[0].CompanyID)
+	require.Equal(t, 2, competencies[1].Position)
+
+	descriptors, err := testMysql.Competency().GetDescriptorsByFramework(ctx, framework.ID)
+	require.NoError(t, err)
+	require.Len(t, descriptors, 2)
+	require.Equal(t, levels[1].UUID, descriptors[0].LevelUUID)
+
+	frameworks, err := testMysql.Competency().GetFrameworksByCompany(ctx, person.CompanyID)
+	require.NoError(t, err)
+	require.NotEmpty(t, frameworks)
+
+	err = testMysql.Competency().DeleteFramework(ctx, framework.ID)
+	require.NoError(t, err)
+
+	_, err = testMysql.Competency().GetFrameworkByID(ctx, framework.ID)
+	require.Error(t, err)
+
+	err = testMysql.Competency().DeleteFramework(ctx, framework.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestCompetencyAssessments(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	framework := createRandomCompetencyFramework(t, person.CompanyID)
+	assessment := createRandomCompetencyAssessment(t, person, framework)
+
+	result, err := testMysql.Competency().GetAssessmentByUUID(ctx, assessment.UUID)
+	require.NoError(t, err)
+	require.Equal(t, assessment.ID, result.ID)
+	require.Equal(t, person.UUID, result.PersonUUID)
+	require.Equal(t, framework.UUID, result.FrameworkUUID)
+	require.Equal(t, "Senior Engineer", result.ExpectedLevelName)
+	require.Equal(t, "2025-07-10", result.AssessedAt.Format("2006-01-02"))
+
+	ratings, err := testMysql.Competency().GetRatingsByAssessment(ctx, assessment.ID)
+	require.NoError(t, err)
+	require.Len(t, ratings, 1)
+	require.Equal(t, "Code quality", ratings[0].CompetencyName)
+	require.Equal(t, "Engineer", ratings[0].LevelName)
+	require.Equal(t, "Needs more tests", ratings[0].Comment)
+
+	assessments, err := testMysql.Competency().GetAssessmentsByPerson(ctx, person.ID, framework.ID)
+	require.NoError(t, err)
+	require.Len(t, assessments, 1)
+
+	assessments, err = testMysql.Competency().GetAssessmentsByPerson(ctx, person.ID, 0)
+	require.NoError(t, err)
+	require.Len(t, assessments, 1)
+
+	count, err := testMysql.Competency().CountAssessmentsByFramework(ctx, framework.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	err = testMysql.Competency().DeleteAssessment(ctx, assessment.ID)
+	require.NoError(t, err)
+
+	err = testMysql.Competency().DeleteAssessment(ctx, assessment.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestNoteCompetencies(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	framework := createRandomCompetencyFramework(t, person.CompanyID)
+
+	feedbackType := domain.FeedbackTypePositive
+	note := entity.Note{
+		UUID:         uuid.NewV4().String(),
+		CompanyID:    person.CompanyID,
+		PersonID:     person.ID,
+		UserID:       person.CreatedBy,
+		Type:         domain.NoteTypeFeedback,
+		Content:      "Great code reviews",
+		FeedbackType: &feedbackType,
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
+	}
+
+	noteID, err := testMysql.Note().CreateNote(ctx, note)
+	require.NoError(t, err)
+
+	for _, competency := range framework.Competencies {
+		err = testMysql.Competency().AddNoteCompetency(ctx, noteID, competency.ID)
+		require.NoError(t, err)
+	}
+
+	// Adding the same competency again is ignored
+	err = testMysql.Competency().AddNoteCompetency(ctx, noteID, framework.Competencies[0].ID)
+	require.NoError(t, err)
+
+	competencies, err := testMysql.Competency().GetNoteCompetencies(ctx, noteID)
+	require.NoError(t, err)
+	require.Len(t, competencies, 2)
+
+	counts, err := testMysql.Competency().GetEvidenceCounts(ctx, person.ID, framework.ID)
+	require.NoError(t, err)
+	require.Len(t, counts, 2)
+	require.Equal(t, 1, counts[0].Count)
+
+	evidence, err := testMysql.Competency().GetCompetencyEvidence(ctx, person.ID, framework.Competencies[0].ID)
+	require.NoError(t, err)
+	require.Len(t, evidence, 1)
+	require.Equal(t, note.UUID, evidence[0].UUID)
+	require.Equal(t, feedbackType, *evidence[0].FeedbackType)
+
+	err = testMysql.Competency().DeleteNoteCompetencies(ctx, noteID)
+	require.NoError(t, err)
+
+	competencies, err = testMysql.Competency().GetNoteCompetencies(ctx, noteID)
+	require.NoError(t, err)
+	require.Empty(t, competencies)
+}
+
+// Error tests with mocks
+func TestCreateCompetencyFrameworkErrorsWithMock(t *testing.T) {
+	testForInsertErrorsWithMock(t, func(db *sql.DB) error {
+		_, err := newCompetencyRepo(db).CreateFramework(context.Background(), entity.CompetencyFramework{})
+		return err
+	})
+}
+
+func TestGetCompetencyAssessmentByUUIDErrorsWithMock(t *testing.T) {
+	testForSelectErrorsWithMock(t, "assessment_id", func(db *sql.DB) error {
+		_, err := newCompetencyRepo(db).GetAssessmentByUUID(context.Background(), "assessment-uuid")
+		return err
+	})
+}
+
+func TestDeleteCompetencyAssessmentErrorsWithMock(t *testing.T) {
+	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
+		return newCompetencyRepo(db).DeleteAssessment(context.Background(), 1)
+	})
+}
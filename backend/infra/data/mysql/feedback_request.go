@@ -0,0 +1,435 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type feedbackRequestRepo struct {
+	db dbConn
+}
+
+func newFeedbackRequestRepo(db dbConn) contract.FeedbackRequestRepo {
+	return &feedbackRequestRepo{
+		db: db,
+	}
+}
+
+// insert runs an insert, returning the id of the created row
+func (r *feedbackRequestRepo) insert(ctx context.Context, query string, args ...any) (createdID int64, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+// update runs an update or delete, returning sql.ErrNoRows when no row was affected
+func (r *feedbackRequestRepo) update(ctx context.Context, query string, args ...any) (err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+const feedbackRequestSelectBase string = `
+	SELECT
+		fr.request_id,
+		fr.request_uuid,
+		fr.company_id,
+		c.name,
+		fr.person_id,
+		p.person_uuid,
+		p.name,
+		fr.user_id,
+		u.name,
+		fr.message,
+		fr.anonymous,
+		fr.expires_at,
+		fr.created_at
+
+	FROM tab_feedback_request fr
+	INNER JOIN tab_company c ON c.company_id = fr.company_id
+	INNER JOIN tab_person p ON p.person_id = fr.person_id
+	INNER JOIN tab_user u ON u.user_id = fr.user_id
+`
+
+func (r *feedbackRequestRepo) parseRequest(row scanner) (request entity.FeedbackRequest, err error) {
+	var message sql.NullString
+
+	err = row.Scan(
+		&request.ID,
+		&request.UUID,
+		&request.CompanyID,
+		&request.CompanyName,
+		&request.PersonID,
+		&request.PersonUUID,
+		&request.PersonName,
+		&request.UserID,
+		&request.RequesterName,
+		&message,
+		&request.Anonymous,
+		&request.ExpiresAt,
+		&request.CreatedAt,
+	)
+	if err != nil {
+		return request, err
+	}
+
+	request.Message = message.String
+
+	return request, nil
+}
+
+func (r *feedbackRequestRepo) getRequest(ctx context.Context, query string, arg any) (request entity.FeedbackRequest, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return request, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, arg)
+	request, err = r.parseRequest(row)
+	if err != nil {
+		return request, mysqlutils.HandleMySQLError(err)
+	}
+
+	return request, nil
+}
+
+func (r *feedbackRequestRepo) CreateRequest(ctx context.Context, request entity.FeedbackRequest) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_feedback_request (
+			request_uuid,
+			company_id,
+			person_id,
+			user_id,
+			message,
+			anonymous,
+			expires_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	return r.insert(ctx, query,
+		request.UUID,
+		request.CompanyID,
+		request.PersonID,
+		request.UserID,
+		nullableString(request.Message),
+		request.Anonymous,
+		request.ExpiresAt,
+	)
+}
+
+func (r *feedbackRequestRepo) GetRequestByUUID(ctx context.Context, requestUUID string) (request entity.FeedbackRequest, err error) {
+	query := feedbackRequestSelectBase + `
+		WHERE fr.request_uuid = ?
+	`
+
+	return r.getRequest(ctx, query, requestUUID)
+}
+
+func (r *feedbackRequestRepo) GetRequestByID(ctx context.Context, requestID int64) (request entity.FeedbackRequest, err error) {
+	query := feedbackRequestSelectBase + `
+		WHERE fr.request_id = ?
+	`
+
+	return r.getRequest(ctx, query, requestID)
+}
+
+func (r *feedbackRequestRepo) GetRequestsByPerson(ctx context.Context, personID int64) (requests []entity.FeedbackRequest, err error) {
+	query := feedbackRequestSelectBase + `
+		WHERE fr.person_id = ?
+		ORDER BY fr.created_at DESC, fr.request_id DESC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return requests, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, personID)
+	if err != nil {
+		return requests, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		request, err := r.parseRequest(rows)
+		if err != nil {
+			return requests, mysqlutils.HandleMySQLError(err)
+		}
+		requests = append(requests, request)
+	}
+
+	if err = rows.Err(); err != nil {
+		return requests, mysqlutils.HandleMySQLError(err)
+	}
+
+	return requests, nil
+}
+
+func (r *feedbackRequestRepo) DeleteRequest(ctx context.Context, requestID int64) (err error) {
+	query := `
+		DELETE FROM tab_feedback_request
+		WHERE request_id = ?
+	`
+
+	return r.update(ctx, query, requestID)
+}
+
+func (r *feedbackRequestRepo) CreateQuestion(ctx context.Context, question entity.FeedbackQuestion) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_feedback_question (
+			question_uuid,
+			request_id,
+			text,
+			type,
+			required,
+			position
+		)
+		SELECT ?, ?, ?, ?, ?, COALESCE(MAX(position), 0) + 1
+		FROM tab_feedback_question
+		WHERE request_id = ?
+	`
+
+	return r.insert(ctx, query,
+		question.UUID,
+		question.RequestID,
+		question.Text,
+		question.Type,
+		question.Required,
+		question.RequestID,
+	)
+}
+
+func (r *feedbackRequestRepo) GetQuestionsByRequest(ctx context.Context, requestID int64) (questions []entity.FeedbackQuestion, err error) {
+	query := `
+		SELECT
+			fq.question_id,
+			fq.question_uuid,
+			fq.request_id,
+			fq.text,
+			fq.type,
+			fq.required,
+			fq.position
+
+		FROM tab_feedback_question fq
+		WHERE fq.request_id = ?
+		ORDER BY fq.position ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return questions, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, requestID)
+	if err != nil {
+		return questions, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var question entity.FeedbackQuestion
+
+		err = rows.Scan(
+			&question.ID,
+			&question.UUID,
+			&question.RequestID,
+			&question.Text,
+			&question.Type,
+			&question.Required,
+			&question.Position,
+		)
+		if err != nil {
+			return questions, mysqlutils.HandleMySQLError(err)
+		}
+
+		questions = append(questions, question)
+	}
+
+	if err = rows.Err(); err != nil {
+		return questions, mysqlutils.HandleMySQLError(err)
+	}
+
+	return questions, nil
+}
+
+const feedbackRespondentSelectBase string = `
+	SELECT
+		fr.respondent_id,
+		fr.respondent_uuid,
+		fr.request_id,
+		fr.person_id,
+		p.person_uuid,
+		fr.name,
+		fr.email,
+		fr.token_hash,
+		fr.responded_at,
+		fr.note_id,
+		fr.created_at
+
+	FROM tab_feedback_respondent fr
+	LEFT JOIN tab_person p ON p.person_id = fr.person_id
+`
+
+func (r *feedbackRequestRepo) parseRespondent(row scanner) (respondent entity.FeedbackRespondent, err error) {
+	var personID, noteID sql.NullInt64
+	var personUUID, email sql.NullString
+	var respondedAt sql.NullTime
+
+	err = row.Scan(
+		&respondent.ID,
+		&respondent.UUID,
+		&respondent.RequestID,
+		&personID,
+		&personUUID,
+		&respondent.Name,
+		&email,
+		&respondent.TokenHash,
+		&respondedAt,
+		&noteID,
+		&respondent.CreatedAt,
+	)
+	if err != nil {
+		return respondent, err
+	}
+
+	if personID.Valid {
+		respondent.PersonID = &personID.Int64
+	}
+	if noteID.Valid {
+		respondent.NoteID = &noteID.Int64
+	}
+	if respondedAt.Valid {
+		respondent.RespondedAt = &respondedAt.Time
+	}
+	respondent.PersonUUID = personUUID.String
+	respondent.Email = email.String
+
+	return respondent, nil
+}
+
+func (r *feedbackRequestRepo) CreateRespondent(ctx context.Context, respondent entity.FeedbackRespondent) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_feedback_respondent (
+			respondent_uuid,
+			request_id,
+			person_id,
+			name,
+			email,
+			token_hash
+		) VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	return r.insert(ctx, query,
+		respondent.UUID,
+		respondent.RequestID,
+		respondent.PersonID,
+		respondent.Name,
+		nullableString(respondent.Email),
+		respondent.TokenHash,
+	)
+}
+
+func (r *feedbackRequestRepo) GetRespondentsByRequest(ctx context.Context, requestID int64) (respondents []entity.FeedbackRespondent, err error) {
+	query := feedbackRespondentSelectBase + `
+		WHERE fr.request_id = ?
+		ORDER BY fr.respondent_id ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return respondents, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, requestID)
+	if err != nil {
+		return respondents, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		respondent, err := r.parseRespondent(rows)
+		if err != nil {
+			return respondents, mysqlutils.HandleMySQLError(err)
+		}
+		respondents = append(respondents, respondent)
+	}
+
+	if err = rows.Err(); err != nil {
+		return respondents, mysqlutils.HandleMySQLError(err)
+	}
+
+	return respondents, nil
+}
+
+func (r *feedbackRequestRepo) GetRespondentByTokenHash(ctx context.Context, tokenHash string) (respondent entity.FeedbackRespondent, err error) {
+	query := feedbackRespondentSelectBase + `
+		WHERE fr.token_hash = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return respondent, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, tokenHash)
+	respondent, err = r.parseRespondent(row)
+	if err != nil {
+		return respondent, mysqlutils.HandleMySQLError(err)
+	}
+
+	return respondent, nil
+}
+
+func (r *feedbackRequestRepo) MarkRespondentResponded(ctx context.Context, respondentID int64, noteID *int64, respondedAt time.Time) (err error) {
+	query := `
+		UPDATE tab_feedback_respondent
+		SET responded_at = ?,
+			note_id = ?
+		WHERE respondent_id = ?
+		  AND responded_at IS NULL
+	`
+
+	return r.update(ctx, query, respondedAt, noteID, respondentID)
+}
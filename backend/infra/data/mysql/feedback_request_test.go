@@ -0,0 +1,184 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+func createRandomFeedbackRequest(t *testing.T, person entity.Person, anonymous bool) entity.FeedbackRequest {
+	ctx := context.Background()
+	request := entity.FeedbackRequest{
+		UUID:      uuid.NewV4().String(),
+		CompanyID: person.CompanyID,
+		PersonID:  person.ID,
+		UserID:    person.CreatedBy,
+		Message:   "Help me to prepare the review",
+		Anonymous: anonymous,
+		ExpiresAt: time.Now().Add(24 * time.Hour).Truncate(time.Second),
+	}
+
+	requestID, err := testMysql.FeedbackRequest().CreateRequest(ctx, request)
+	require.NoError(t, err)
+	require.NotZero(t, requestID)
+	request.ID = requestID
+
+	for _, question := range []entity.FeedbackQuestion{
+		{Text: "What should they keep doing?", Type: domain.FeedbackQuestionTypeText, Required: true},
+		{Text: "How was working with them?", Type: domain.FeedbackQuestionTypeRating},
+	} {
+		question.UUID = uuid.NewV4().String()
+		question.RequestID = requestID
+
+		_, err = testMysql.FeedbackRequest().CreateQuestion(ctx, question)
+		require.NoError(t, err)
+	}
+
+	return request
+}
+
+func createRandomFeedbackRespondent(t *testing.T, requestID int64, personID *int64) entity.FeedbackRespondent {
+	respondent := entity.FeedbackRespondent{
+		UUID:      uuid.NewV4().String(),
+		RequestID: requestID,
+		PersonID:  personID,
+		Name:      "Maria",
+		TokenHash: uuid.NewV4().String(),
+	}
+	if personID == nil {
+		respondent.Email = "maria" + uuid.NewV4().String()[:8] + "@client.com"
+	}
+
+	respondentID, err := testMysql.FeedbackRequest().CreateRespondent(context.Background(), respondent)
+	require.NoError(t, err)
+	require.NotZero(t, respondentID)
+
+	respondent.ID = respondentID
+	return respondent
+}
+
+func TestFeedbackRequests(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	request := createRandomFeedbackRequest(t, person, true)
+
+	result, err := testMysql.FeedbackRequest().GetRequestByUUID(ctx, request.UUID)
+	require.NoError(t, err)
+	require.Equal(t, request.ID, result.ID)
+	require.Equal(t, person.UUID, result.PersonUUID)
+	require.Equal(t, person.Name, result.PersonName)
+	require.NotEmpty(t, result.CompanyName)
+	require.NotEmpty(t, result.RequesterName)
+	require.True(t, result.Anonymous)
+	require.Equal(t, request.ExpiresAt.Unix(), result.ExpiresAt.Unix())
+
+	questions, err := testMysql.FeedbackRequest().GetQuestionsByRequest(ctx, request.ID)
+	require.NoError(t, err)
+	require.Len(t, questions, 2)
+	require.True(t, questions[0].Required)
+	require.Equal(t, domain.FeedbackQuestionTypeRating, questions[1].Type)
+	require.Equal(t, 2, questions[1].Position)
+
+	requests, err := testMysql.FeedbackRequest().GetRequestsByPerson(ctx, person.ID)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+
+	err = testMysql.FeedbackRequest().DeleteRequest(ctx, request.ID)
+	require.NoError(t, err)
+
+	_, err = testMysql.FeedbackRequest().GetRequestByID(ctx, request.ID)
+	require.Error(t, err)
+
+	err = testMysql.FeedbackRequest().DeleteRequest(ctx, request.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestFeedbackRespondents(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	request := createRandomFeedbackRequest(t, person, false)
+
+	external := createRandomFeedbackRespondent(t, request.ID, nil)
+	peer := createRandomFeedbackRespondent(t, request.ID, &person.ID)
+
+	respondents, err := testMysql.FeedbackRequest().GetRespondentsByRequest(ctx, request.ID)
+	require.NoError(t, err)
+	require.Len(t, respondents, 2)
+	require.Nil(t, respondents[0].PersonID)
+	require.Equal(t, external.Email, respondents[0].Email)
+	require.Equal(t, person.UUID, respondents[1].PersonUUID)
+	require.Empty(t, respondents[1].Email)
+
+	result, err := testMysql.FeedbackRequest().GetRespondentByTokenHash(ctx, peer.TokenHash)
+	require.NoError(t, err)
+	require.Equal(t, peer.ID, result.ID)
+	require.False(t, result.HasResponded())
+
+	respondedAt := time.Now().Truncate(time.Second)
+	err = testMysql.FeedbackRequest().MarkRespondentResponded(ctx, peer.ID, nil, respondedAt)
+	require.NoError(t, err)
+
+	err = testMysql.FeedbackRequest().MarkRespondentResponded(ctx, peer.ID, nil, respondedAt)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	result, err = testMysql.FeedbackRequest().GetRespondentByTokenHash(ctx, peer.TokenHash)
+	require.NoError(t, err)
+	require.True(t, result.HasResponded())
+	require.Nil(t, result.NoteID)
+}
+
+func TestFeedbackResponseInTimeline(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+
+	feedbackType := domain.FeedbackTypePositive
+	respondentName := "Maria"
+	note := entity.Note{
+		UUID:           uuid.NewV4().String(),
+		CompanyID:      person.CompanyID,
+		PersonID:       person.ID,
+		UserID:         person.CreatedBy,
+		Type:           domain.NoteTypeFeedback,
+		Content:        "What should they keep doing?\nSharing knowledge",
+		FeedbackType:   &feedbackType,
+		RespondentName: &respondentName,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	_, err := testMysql.Note().CreateNote(ctx, note)
+	require.NoError(t, err)
+
+	timeline, totalRecords, err := testMysql.Note().GetPersonTimeline(ctx, person.ID, entity.TimelineFilters{SearchQuery: "Maria"}, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), totalRecords)
+	require.Equal(t, note.UUID, timeline[0].UUID)
+	require.Equal(t, respondentName, timeline[0].AuthorName)
+}
+
+// Error tests with mocks
+func TestCreateFeedbackRequestErrorsWithMock(t *testing.T) {
+	testForInsertErrorsWithMock(t, func(db *sql.DB) error {
+		_, err := newFeedbackRequestRepo(db).CreateRequest(context.Background(), entity.FeedbackRequest{})
+		return err
+	})
+}
+
+func TestGetFeedbackRequestByUUIDErrorsWithMock(t *testing.T) {
+	testForSelectErrorsWithMock(t, "request_id", func(db *sql.DB) error {
+		_, err := newFeedbackRequestRepo(db).GetRequestByUUID(context.Background(), "request-uuid")
+		return err
+	})
+}
+
+func TestMarkRespondentRespondedErrorsWithMock(t *testing.T) {
+	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
+		return newFeedbackRequestRepo(db).MarkRespondentResponded(context.Background(), 1, nil, time.Now())
+	})
+}
@@ -0,0 +1,574 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type goalRepo struct {
+	db dbConn
+}
+
+func newGoalRepo(db dbConn) contract.GoalRepo {
+	return &goalRepo{
+		db: db,
+	}
+}
+
+const goalSelectBase string = `
+	SELECT
+		g.goal_id,
+		g.goal_uuid,
+		g.company_id,
+		g.person_id,
+		p.person_uuid,
+		p.name,
+		g.user_id,
+		g.title,
+		g.description,
+		g.type,
+		g.status,
+		g.progress,
+		g.target_date,
+		g.completed_at,
+		g.created_at,
+		g.updated_at
+
+	FROM tab_goal g
+	INNER JOIN tab_person p
+		ON p.person_id = g.person_id
+`
+
+func (r *goalRepo) parseGoal(row scanner) (goal entity.Goal, err error) {
+	var description sql.NullString
+
+	err = row.Scan(
+		&goal.ID,
+		&goal.UUID,
+		&goal.CompanyID,
+		&goal.PersonID,
+		&goal.PersonUUID,
+		&goal.PersonName,
+		&goal.UserID,
+		&goal.Title,
+		&description,
+		&goal.Type,
+		&goal.Status,
+		&goal.Progress,
+		&goal.TargetDate,
+		&goal.CompletedAt,
+		&goal.CreatedAt,
+		&goal.UpdatedAt,
+	)
+	if err != nil {
+		return goal, err
+	}
+
+	goal.Description = description.String
+
+	return goal, nil
+}
+
+func (r *goalRepo) CreateGoal(ctx context.Context, goal entity.Goal) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_goal (
+			goal_uuid,
+			company_id,
+			person_id,
+			user_id,
+			title,
+			description,
+			type,
+			status,
+			progress,
+			target_date
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		goal.UUID,
+		goal.CompanyID,
+		goal.PersonID,
+		goal.UserID,
+		goal.Title,
+		nullableString(goal.Description),
+		goal.Type,
+		goal.Status,
+		goal.Progress,
+		goal.TargetDate,
+	)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+func (r *goalRepo) GetGoalByUUID(ctx context.Context, goalUUID string) (goal entity.Goal, err error) {
+	query := goalSelectBase + `
+		WHERE g.goal_uuid = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return goal, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, goalUUID)
+	goal, err = r.parseGoal(row)
+	if err != nil {
+		return goal, mysqlutils.HandleMySQLError(err)
+	}
+
+	return goal, nil
+}
+
+func (r *goalRepo) GetGoalsByPerson(ctx context.Context, personID int64, status string) (goals []entity.Goal, err error) {
+	query := goalSelectBase + `
+		WHERE g.person_id = ?
+	`
+	args := []any{personID}
+	if status != "" {
+		query += `
+		  AND g.status = ?
+		`
+		args = append(args, status)
+	}
+	query += `
+		ORDER BY g.status = 'active' DESC, g.target_date IS NULL, g.target_date ASC, g.created_at DESC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return goals, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return goals, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		goal, err := r.parseGoal(rows)
+		if err != nil {
+			return goals, mysqlutils.HandleMySQLError(err)
+		}
+		goals = append(goals, goal)
+	}
+
+	if err = rows.Err(); err != nil {
+		return goals, mysqlutils.HandleMySQLError(err)
+	}
+
+	return goals, nil
+}
+
+func (r *goalRepo) UpdateGoal(ctx context.Context, goalID int64, goal entity.Goal) (err error) {
+	query := `
+		UPDATE tab_goal
+		SET
+			title        = ?,
+			description  = ?,
+			type         = ?,
+			status       = ?,
+			progress     = ?,
+			target_date  = ?,
+			completed_at = ?
+		WHERE goal_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		goal.Title,
+		nullableString(goal.Description),
+		goal.Type,
+		goal.Status,
+		goal.Progress,
+		goal.TargetDate,
+		goal.CompletedAt,
+		goalID,
+	)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *goalRepo) DeleteGoal(ctx context.Context, goalID int64) (err error) {
+	query := `
+		DELETE FROM tab_goal
+		WHERE goal_id = ?
+	`
+
+	return r.delete(ctx, query, goalID)
+}
+
+// delete runs a delete by id, returning sql.ErrNoRows when nothing was deleted
+func (r *goalRepo) delete(ctx context.Context, query string, id int64) (err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, id)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+const keyResultSelectBase string = `
+	SELECT
+		kr.key_result_id,
+		kr.key_result_uuid,
+		kr.goal_id,
+		kr.title,
+		kr.start_value,
+		kr.target_value,
+		kr.current_value,
+		kr.unit,
+		kr.position,
+		kr.created_at,
+		kr.updated_at
+
+	FROM tab_goal_key_result kr
+`
+
+func (r *goalRepo) parseKeyResult(row scanner) (keyResult entity.GoalKeyResult, err error) {
+	var unit sql.NullString
+
+	err = row.Scan(
+		&keyResult.ID,
+		&keyResult.UUID,
+		&keyResult.GoalID,
+		&keyResult.Title,
+		&keyResult.StartValue,
+		&keyResult.TargetValue,
+		&keyResult.CurrentValue,
+		&unit,
+		&keyResult.Position,
+		&keyResult.CreatedAt,
+		&keyResult.UpdatedAt,
+	)
+	if err != nil {
+		return keyResult, err
+	}
+
+	keyResult.Unit = unit.String
+
+	return keyResult, nil
+}
+
+func (r *goalRepo) queryKeyResults(ctx context.Context, query string, args ...any) (keyResults []entity.GoalKeyResult, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return keyResults, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return keyResults, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		keyResult, err := r.parseKeyResult(rows)
+		if err != nil {
+			return keyResults, mysqlutils.HandleMySQLError(err)
+		}
+		keyResults = append(keyResults, keyResult)
+	}
+
+	if err = rows.Err(); err != nil {
+		return keyResults, mysqlutils.HandleMySQLError(err)
+	}
+
+	return keyResults, nil
+}
+
+func (r *goalRepo) CreateKeyResult(ctx context.Context, keyResult entity.GoalKeyResult) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_goal_key_result (
+			key_result_uuid,
+			goal_id,
+			title,
+			start_value,
+			target_value,
+			current_value,
+			unit,
+			position
+		)
+		SELECT ?, ?, ?, ?, ?, ?, ?, COALESCE(MAX(position), 0) + 1
+		FROM tab_goal_key_result
+		WHERE goal_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		keyResult.UUID,
+		keyResult.GoalID,
+		keyResult.Title,
+		keyResult.StartValue,
+		keyResult.TargetValue,
+		keyResult.CurrentValue,
+		nullableString(keyResult.Unit),
+		keyResult.GoalID,
+	)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+func (r *goalRepo) GetKeyResultsByGoal(ctx context.Context, goalID int64) (keyResults []entity.GoalKeyResult, err error) {
+	query := keyResultSelectBase + `
+		WHERE kr.goal_id = ?
+		ORDER BY kr.position ASC
+	`
+
+	return r.queryKeyResults(ctx, query, goalID)
+}
+
+func (r *goalRepo) GetKeyResultsByPerson(ctx context.Context, personID int64) (keyResults []entity.GoalKeyResult, err error) {
+	query := keyResultSelectBase + `
+		INNER JOIN tab_goal g
+			ON g.goal_id = kr.goal_id
+		WHERE g.person_id = ?
+		ORDER BY kr.goal_id ASC, kr.position ASC
+	`
+
+	return r.queryKeyResults(ctx, query, personID)
+}
+
+func (r *goalRepo) UpdateKeyResult(ctx context.Context, keyResultID int64, keyResult entity.GoalKeyResult) (err error) {
+	query := `
+		UPDATE tab_goal_key_result
+		SET
+			title         = ?,
+			start_value   = ?,
+			target_value  = ?,
+			current_value = ?,
+			unit          = ?
+		WHERE key_result_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		keyResult.Title,
+		keyResult.StartValue,
+		keyResult.TargetValue,
+		keyResult.CurrentValue,
+		nullableString(keyResult.Unit),
+		keyResultID,
+	)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *goalRepo) DeleteKeyResult(ctx context.Context, keyResultID int64) (err error) {
+	query := `
+		DELETE FROM tab_goal_key_result
+		WHERE key_result_id = ?
+	`
+
+	return r.delete(ctx, query, keyResultID)
+}
+
+const checkInSelectBase string = `
+	SELECT
+		ci.check_in_id,
+		ci.check_in_uuid,
+		ci.goal_id,
+		ci.user_id,
+		ci.check_in_date,
+		ci.progress,
+		ci.confidence,
+		ci.comment,
+		n.note_id,
+		n.note_uuid,
+		ci.created_at
+
+	FROM tab_goal_check_in ci
+	LEFT JOIN tab_note n
+		ON n.note_id = ci.note_id
+		AND n.deleted_at IS NULL
+`
+
+func (r *goalRepo) parseCheckIn(row scanner) (checkIn entity.GoalCheckIn, err error) {
+	var comment sql.NullString
+
+	err = row.Scan(
+		&checkIn.ID,
+		&checkIn.UUID,
+		&checkIn.GoalID,
+		&checkIn.UserID,
+		&checkIn.Date,
+		&checkIn.Progress,
+		&checkIn.Confidence,
+		&comment,
+		&checkIn.NoteID,
+		&checkIn.NoteUUID,
+		&checkIn.CreatedAt,
+	)
+	if err != nil {
+		return checkIn, err
+	}
+
+	checkIn.Comment = comment.String
+
+	return checkIn, nil
+}
+
+func (r *goalRepo) queryCheckIns(ctx context.Context, query string, args ...any) (checkIns []entity.GoalCheckIn, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return checkIns, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return checkIns, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		checkIn, err := r.parseCheckIn(rows)
+		if err != nil {
+			return checkIns, mysqlutils.HandleMySQLError(err)
+		}
+		checkIns = append(checkIns, checkIn)
+	}
+
+	if err = rows.Err(); err != nil {
+		return checkIns, mysqlutils.HandleMySQLError(err)
+	}
+
+	return checkIns, nil
+}
+
+func (r *goalRepo) CreateCheckIn(ctx context.Context, checkIn entity.GoalCheckIn) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_goal_check_in (
+			check_in_uuid,
+			goal_id,
+			user_id,
+			check_in_date,
+			progress,
+			confidence,
+			comment,
+			note_id
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		checkIn.UUID,
+		checkIn.GoalID,
+		checkIn.UserID,
+		checkIn.Date,
+		checkIn.Progress,
+		checkIn.Confidence,
+		nullableString(checkIn.Comment),
+		checkIn.NoteID,
+	)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+func (r *goalRepo) GetCheckInsByGoal(ctx context.Context, goalID int64) (checkIns []entity.GoalCheckIn, err error) {
+	query := checkInSelectBase + `
+		WHERE ci.goal_id = ?
+		ORDER BY ci.check_in_date DESC, ci.created_at DESC
+	`
+
+	return r.queryCheckIns(ctx, query, goalID)
+}
+
+func (r *goalRepo) GetCheckInsByPerson(ctx context.Context, personID int64) (checkIns []entity.GoalCheckIn, err error) {
+	query := checkInSelectBase + `
+		INNER JOIN tab_goal g
+			ON g.goal_id = ci.goal_id
+		WHERE g.person_id = ?
+		ORDER BY ci.check_in_date DESC, ci.created_at DESC
+	`
+
+	return r.queryCheckIns(ctx, query, personID)
+}
+
+func (r *goalRepo) DeleteCheckIn(ctx context.Context, checkInID int64) (err error) {
+	query := `
+		DELETE FROM tab_goal_check_in
+		WHERE check_in_id = ?
+	`
+
+	return r.delete(ctx, query, checkInID)
+}
@@ -0,0 +1,177 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+func createRandomGoal(t *testing.T, person entity.Person, status string) entity.Goal {
+	targetDate := time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC)
+	goal := entity.Goal{
+		UUID:        uuid.NewV4().String(),
+		CompanyID:   person.CompanyID,
+		PersonID:    person.ID,
+		UserID:      person.CreatedBy,
+		Title:       "Become tech lead",
+		Description: "Lead the team technical decisions",
+		Type:        domain.GoalTypeCareer,
+		Status:      status,
+		TargetDate:  &targetDate,
+	}
+
+	goalID, err := testMysql.Goal().CreateGoal(context.Background(), goal)
+	require.NoError(t, err)
+	require.NotZero(t, goalID)
+
+	goal.ID = goalID
+	return goal
+}
+
+func TestCreateAndGetGoal(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	goal := createRandomGoal(t, person, domain.GoalStatusActive)
+
+	result, err := testMysql.Goal().GetGoalByUUID(ctx, goal.UUID)
+	require.NoError(t, err)
+	require.Equal(t, goal.ID, result.ID)
+	require.Equal(t, person.UUID, result.PersonUUID)
+	require.Equal(t, goal.Description, result.Description)
+	require.Equal(t, domain.GoalTypeCareer, result.Type)
+	require.Equal(t, "2025-12-31", result.TargetDate.Format("2006-01-02"))
+	require.Nil(t, result.CompletedAt)
+}
+
+func TestGetGoalsByPersonAndUpdateGoal(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	createRandomGoal(t, person, domain.GoalStatusActive)
+	closed := createRandomGoal(t, person, domain.GoalStatusActive)
+
+	completedAt := time.Now().Truncate(time.Second)
+	closed.Status = domain.GoalStatusAchieved
+	closed.Progress = 100
+	closed.CompletedAt = &completedAt
+	err := testMysql.Goal().UpdateGoal(ctx, closed.ID, closed)
+	require.NoError(t, err)
+
+	goals, err := testMysql.Goal().GetGoalsByPerson(ctx, person.ID, "")
+	require.NoError(t, err)
+	require.Len(t, goals, 2)
+	require.Equal(t, domain.GoalStatusAchieved, goals[1].Status)
+	require.Equal(t, 100, goals[1].Progress)
+
+	goals, err = testMysql.Goal().GetGoalsByPerson(ctx, person.ID, domain.GoalStatusActive)
+	require.NoError(t, err)
+	require.Len(t, goals, 1)
+}
+
+func TestGoalKeyResults(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	goal := createRandomGoal(t, person, domain.GoalStatusActive)
+
+	for _, title := range []string{"Lead projects", "Mentor engineers"} {
+		_, err := testMysql.Goal().CreateKeyResult(ctx, entity.GoalKeyResult{
+			UUID:        uuid.NewV4().String(),
+			GoalID:      goal.ID,
+			Title:       title,
+			TargetValue: 2,
+			Unit:        "projects",
+		})
+		require.NoError(t, err)
+	}
+
+	keyResults, err := testMysql.Goal().GetKeyResultsByGoal(ctx, goal.ID)
+	require.NoError(t, err)
+	require.Len(t, keyResults, 2)
+	require.Equal(t, 1, keyResults[0].Position)
+	require.Equal(t, 2, keyResults[1].Position)
+
+	keyResults[0].CurrentValue = 1.5
+	err = testMysql.Goal().UpdateKeyResult(ctx, keyResults[0].ID, keyResults[0])
+	require.NoError(t, err)
+
+	keyResults, err = testMysql.Goal().GetKeyResultsByPerson(ctx, person.ID)
+	require.NoError(t, err)
+	require.Len(t, keyResults, 2)
+	require.Equal(t, 1.5, keyResults[0].CurrentValue)
+
+	err = testMysql.Goal().DeleteKeyResult(ctx, keyResults[1].ID)
+	require.NoError(t, err)
+
+	err = testMysql.Goal().DeleteKeyResult(ctx, keyResults[1].ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestGoalCheckIns(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	goal := createRandomGoal(t, person, domain.GoalStatusActive)
+
+	for _, day := range []int{3, 10} {
+		_, err := testMysql.Goal().CreateCheckIn(ctx, entity.GoalCheckIn{
+			UUID:       uuid.NewV4().String(),
+			GoalID:     goal.ID,
+			UserID:     person.CreatedBy,
+			Date:       time.Date(2025, time.March, day, 0, 0, 0, 0, time.UTC),
+			Progress:   day * 5,
+			Confidence: domain.GoalConfidenceOnTrack,
+			Comment:    "Progressing",
+		})
+		require.NoError(t, err)
+	}
+
+	checkIns, err := testMysql.Goal().GetCheckInsByGoal(ctx, goal.ID)
+	require.NoError(t, err)
+	require.Len(t, checkIns, 2)
+	require.Equal(t, "2025-03-10", checkIns[0].Date.Format("2006-01-02"))
+	require.Equal(t, 50, checkIns[0].Progress)
+	require.Nil(t, checkIns[0].NoteUUID)
+
+	checkIns, err = testMysql.Goal().GetCheckInsByPerson(ctx, person.ID)
+	require.NoError(t, err)
+	require.Len(t, checkIns, 2)
+
+	err = testMysql.Goal().DeleteGoal(ctx, goal.ID)
+	require.NoError(t, err)
+
+	checkIns, err = testMysql.Goal().GetCheckInsByGoal(ctx, goal.ID)
+	require.NoError(t, err)
+	require.Empty(t, checkIns)
+}
+
+// Error tests with mocks
+func TestCreateGoalErrorsWithMock(t *testing.T) {
+	testForInsertErrorsWithMock(t, func(db *sql.DB) error {
+		_, err := newGoalRepo(db).CreateGoal(context.Background(), entity.Goal{})
+		return err
+	})
+}
+
+func TestGetGoalByUUIDErrorsWithMock(t *testing.T) {
+	testForSelectErrorsWithMock(t, "goal_id", func(db *sql.DB) error {
+		_, err := newGoalRepo(db).GetGoalByUUID(context.Background(), "goal-uuid")
+		return err
+	})
+}
+
+func TestCreateCheckInErrorsWithMock(t *testing.T) {
+	testForInsertErrorsWithMock(t, func(db *sql.DB) error {
+		_, err := newGoalRepo(db).CreateCheckIn(context.Background(), entity.GoalCheckIn{})
+		return err
+	})
+}
+
+func TestDeleteGoalErrorsWithMock(t *testing.T) {
+	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
+		return newGoalRepo(db).DeleteGoal(context.Background(), 1)
+	})
+}
@@ -23,12 +23,23 @@ var (
 type MysqlConn struct {
 	db *sql.DB
 
-	userRepo    contract.UserRepo
-	authRepo    contract.AuthRepo
-	companyRepo contract.CompanyRepo
-	personRepo  contract.PersonRepo
-	noteRepo    contract.NoteRepo
-	aiRepo      contract.AIRepo
+	userRepo       contract.UserRepo
+	authRepo       contract.AuthRepo
+	companyRepo    contract.CompanyRepo
+	personRepo     contract.PersonRepo
+	noteRepo       contract.NoteRepo
+	meetingRepo    contract.MeetingRepo
+	actionItemRepo contract.ActionItemRepo
+	goalRepo       contract.GoalRepo
+	reviewRepo     contract.ReviewRepo
+	feedbackRepo   contract.FeedbackRequestRepo
+	competencyRepo contract.CompetencyRepo
+	templateRepo   contract.NoteTemplateRepo
+	tagRepo        contract.TagRepo
+	activityRepo   contract.ActivityRepo
+	outboxRepo     contract.OutboxRepo
+	aiRepo         contract.AIRepo
+	scimRepo       contract.SCIMRepo
 }
 
 // helps test the Instance function
@@ -86,12 +97,23 @@ func instance(ctx context.Context, dsn, dbName string, log logger.Logger, getMys
 
 func repoInstances(dbConn dbConn) *MysqlConn {
 	return &MysqlConn{
-		userRepo:    newUserRepo(dbConn),
-		authRepo:    newAuthRepo(dbConn),
-		companyRepo: newCompanyRepo(dbConn),
-		personRepo:  newPersonRepo(dbConn),
-		noteRepo:    newNoteRepo(dbConn),
-		aiRepo:      newAIRepo(dbConn),
+		userRepo:       newUserRepo(dbConn),
+		authRepo:       newAuthRepo(dbConn),
+		companyRepo:    newCompanyRepo(dbConn),
+		personRepo:     newPersonRepo(dbConn),
+		noteRepo:       newNoteRepo(dbConn),
+		meetingRepo:    newMeetingRepo(dbConn),
+		actionItemRepo: newActionItemRepo(dbConn),
+		goalRepo:       newGoalRepo(dbConn),
+		reviewRepo:     newReviewRepo(dbConn),
+		feedbackRepo:   newFeedbackRequestRepo(dbConn),
+		competencyRepo: newCompetencyRepo(dbConn),
+		templateRepo:   newNoteTemplateRepo(dbConn),
+		tagRepo:        newTagRepo(dbConn),
+		activityRepo:   newActivityRepo(dbConn),
+		outboxRepo:     newOutboxRepo(dbConn),
+		aiRepo:         newAIRepo(dbConn),
+		scimRepo:       newSCIMRepo(dbConn),
 	}
 }
 
@@ -143,6 +165,50 @@ func (c *MysqlConn) Note() contract.NoteRepo {
 	return c.noteRepo
 }
 
+func (c *MysqlConn) Meeting() contract.MeetingRepo {
+	return c.meetingRepo
+}
+
+func (c *MysqlConn) ActionItem() contract.ActionItemRepo {
+	return c.actionItemRepo
+}
+
+func (c *MysqlConn) Goal() contract.GoalRepo {
+	return c.goalRepo
+}
+
+func (c *MysqlConn) Review() contract.ReviewRepo {
+	return c.reviewRepo
+}
+
+func (c *MysqlConn) FeedbackRequest() contract.FeedbackRequestRepo {
+	return c.feedbackRepo
+}
+
+func (c *MysqlConn) Competency() contract.CompetencyRepo {
+	return c.competencyRepo
+}
+
+func (c *MysqlConn) NoteTemplate() contract.NoteTemplateRepo {
+	return c.templateRepo
+}
+
+func (c *MysqlConn) Tag() contract.TagRepo {
+	return c.tagRepo
+}
+
+func (c *MysqlConn) Activity() contract.ActivityRepo {
+	return c.activityRepo
+}
+
+func (c *MysqlConn) Outbox() contract.OutboxRepo {
+	return c.outboxRepo
+}
+
 func (c *MysqlConn) AI() contract.AIRepo {
 	return c.aiRepo
 }
+
+func (c *MysqlConn) SCIM() contract.SCIMRepo {
+	return c.scimRepo
+}
@@ -0,0 +1,359 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type jobQueue struct {
+	db *sql.DB
+}
+
+// NewJobQueue returns the job queue stored in the tab_job table
+func NewJobQueue(db *sql.DB) contract.JobQueue {
+	return &jobQueue{
+		db: db,
+	}
+}
+
+// update runs an update or delete, returning domain.ErrJobNotFound when no row was affected
+func (q *jobQueue) update(ctx context.Context, query string, args ...any) (err error) {
+	stmt, err := q.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return domain.ErrJobNotFound
+	}
+
+	return nil
+}
+
+const jobSelectBase string = `
+	SELECT
+		j.job_id,
+		j.job_uuid,
+		j.queue,
+		j.job_type,
+		j.payload,
+		j.status,
+		j.attempts,
+		j.max_attempts,
+		j.run_at,
+		j.last_error,
+		j.created_at,
+		j.failed_at
+
+	FROM tab_job j
+`
+
+func (q *jobQueue) parseJob(row scanner) (jobID int64, job entity.Job, err error) {
+	var failedAt sql.NullTime
+
+	err = row.Scan(
+		&jobID,
+		&job.UUID,
+		&job.Queue,
+		&job.Type,
+		&job.Payload,
+		&job.Status,
+		&job.Attempts,
+		&job.MaxAttempts,
+		&job.RunAt,
+		&job.LastError,
+		&job.CreatedAt,
+		&failedAt,
+	)
+	if err != nil {
+		return jobID, job, err
+	}
+
+	if failedAt.Valid {
+		job.FailedAt = &failedAt.Time
+	}
+
+	return jobID, job, nil
+}
+
+func (q *jobQueue) Enqueue(ctx context.Context, job entity.Job) (err error) {
+	// The unique job_uuid makes enqueueing the same job twice a no-op
+	query := `
+		INSERT IGNORE INTO tab_job (
+			job_uuid,
+			queue,
+			job_type,
+			payload,
+			status,
+			max_attempts,
+			run_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	stmt, err := q.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		job.UUID,
+		job.Queue,
+		job.Type,
+		job.Payload,
+		domain.JobStatusPending,
+		job.MaxAttempts,
+		job.RunAt,
+	)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (q *jobQueue) Dequeue(ctx context.Context, queue string, now, leaseUntil time.Time, limit int) (jobs []entity.Job, err error) {
+	tx, err := q.db.BeginTx(ctx, nil)
+	if err != nil {
+		return jobs, mysqlutils.HandleMySQLError(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// SKIP LOCKED lets the workers of other instances claim other jobs instead of waiting for these
+	query := jobSelectBase + `
+		WHERE j.queue = ?
+		  AND j.status IN (?, ?)
+		  AND j.run_at <= ?
+		ORDER BY j.run_at ASC, j.job_id ASC
+		LIMIT ?
+		FOR UPDATE SKIP LOCKED
+	`
+
+	rows, err := tx.QueryContext(ctx, query, queue, domain.JobStatusPending, domain.JobStatusRunning, now, limit)
+	if err != nil {
+		return nil, mysqlutils.HandleMySQLError(err)
+	}
+
+	var jobIDs, deadJobIDs []any
+	for rows.Next() {
+		jobID, job, err := q.parseJob(rows)
+		if err != nil {
+			rows.Close()
+			return nil, mysqlutils.HandleMySQLError(err)
+		}
+
+		// a running job here lost its lease: the worker crashed or hung. With no attempts left it goes to the dead jobs,
+		// as a failed attempt would, instead of being claimed forever
+		if job.Status == domain.JobStatusRunning && job.Attempts >= job.MaxAttempts {
+			deadJobIDs = append(deadJobIDs, jobID)
+			continue
+		}
+
+		job.Status = domain.JobStatusRunning
+		job.Attempts++
+		job.RunAt = leaseUntil
+
+		jobIDs = append(jobIDs, jobID)
+		jobs = append(jobs, job)
+	}
+	rows.Close()
+
+	if len(deadJobIDs) > 0 {
+		deadLetter := `
+			UPDATE tab_job
+			SET
+				status = ?,
+				last_error = ?,
+				failed_at = ?
+			WHERE job_id IN (?` + strings.Repeat(", ?", len(deadJobIDs)-1) + `)
+		`
+
+		_, err = tx.ExecContext(ctx, deadLetter, append([]any{domain.JobStatusDead, domain.JobLeaseExpiredError, now}, deadJobIDs...)...)
+		if err != nil {
+			return nil, mysqlutils.HandleMySQLError(err)
+		}
+	}
+
+	if len(jobs) == 0 {
+		return nil, tx.Commit()
+	}
+
+	update := `
+		UPDATE tab_job
+		SET
+			status = ?,
+			attempts = attempts + 1,
+			run_at = ?
+		WHERE job_id IN (?` + strings.Repeat(", ?", len(jobIDs)-1) + `)
+	`
+
+	_, err = tx.ExecContext(ctx, update, append([]any{domain.JobStatusRunning, leaseUntil}, jobIDs...)...)
+	if err != nil {
+		return nil, mysqlutils.HandleMySQLError(err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, mysqlutils.HandleMySQLError(err)
+	}
+
+	return jobs, nil
+}
+
+// The attempts of the claimed job work as a version, so a worker that lost the lease does not change the job
+
+func (q *jobQueue) Complete(ctx context.Context, job entity.Job) (err error) {
+	query := `
+		DELETE FROM tab_job
+		WHERE job_uuid = ?
+		  AND status = ?
+		  AND attempts = ?
+	`
+
+	return q.update(ctx, query, job.UUID, domain.JobStatusRunning, job.Attempts)
+}
+
+func (q *jobQueue) Retry(ctx context.Context, job entity.Job, lastError string, runAt time.Time) (err error) {
+	query := `
+		UPDATE tab_job
+		SET
+			status = ?,
+			last_error = ?,
+			run_at = ?
+		WHERE job_uuid = ?
+		  AND status = ?
+		  AND attempts = ?
+	`
+
+	return q.update(ctx, query, domain.JobStatusPending, lastError, runAt, job.UUID, domain.JobStatusRunning, job.Attempts)
+}
+
+func (q *jobQueue) DeadLetter(ctx context.Context, job entity.Job, lastError string, now time.Time) (err error) {
+	query := `
+		UPDATE tab_job
+		SET
+			status = ?,
+			last_error = ?,
+			failed_at = ?
+		WHERE job_uuid = ?
+		  AND status = ?
+		  AND attempts = ?
+	`
+
+	return q.update(ctx, query, domain.JobStatusDead, lastError, now, job.UUID, domain.JobStatusRunning, job.Attempts)
+}
+
+func (q *jobQueue) Requeue(ctx context.Context, jobUUID string, now time.Time) (err error) {
+	query := `
+		UPDATE tab_job
+		SET
+			status = ?,
+			attempts = 0,
+			run_at = ?,
+			failed_at = NULL
+		WHERE job_uuid = ?
+		  AND status = ?
+	`
+
+	return q.update(ctx, query, domain.JobStatusPending, now, jobUUID, domain.JobStatusDead)
+}
+
+func (q *jobQueue) GetStats(ctx context.Context, queue string, now time.Time) (stats entity.JobQueueStats, err error) {
+	query := `
+		SELECT
+			COALESCE(SUM(j.status = ? AND j.run_at <= ?), 0),
+			COALESCE(SUM(j.status = ? AND j.run_at > ?), 0),
+			COALESCE(SUM(j.status = ?), 0),
+			COALESCE(SUM(j.status = ?), 0),
+			MIN(CASE WHEN j.status = ? AND j.run_at <= ? THEN j.run_at END)
+
+		FROM tab_job j
+		WHERE j.queue = ?
+	`
+
+	stmt, err := q.db.PrepareContext(ctx, query)
+	if err != nil {
+		return stats, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	var oldestPendingAt sql.NullTime
+	stats.Queue = queue
+
+	err = stmt.QueryRowContext(ctx,
+		domain.JobStatusPending, now,
+		domain.JobStatusPending, now,
+		domain.JobStatusRunning,
+		domain.JobStatusDead,
+		domain.JobStatusPending, now,
+		queue,
+	).Scan(
+		&stats.Pending,
+		&stats.Scheduled,
+		&stats.Running,
+		&stats.Dead,
+		&oldestPendingAt,
+	)
+	if err != nil {
+		return stats, mysqlutils.HandleMySQLError(err)
+	}
+
+	if oldestPendingAt.Valid {
+		stats.OldestPendingAt = &oldestPendingAt.Time
+	}
+
+	return stats, nil
+}
+
+func (q *jobQueue) GetDeadJobs(ctx context.Context, queue string, limit int) (jobs []entity.Job, err error) {
+	query := jobSelectBase + `
+		WHERE j.queue = ?
+		  AND j.status = ?
+		ORDER BY j.failed_at DESC, j.job_id DESC
+		LIMIT ?
+	`
+
+	stmt, err := q.db.PrepareContext(ctx, query)
+	if err != nil {
+		return jobs, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, queue, domain.JobStatusDead, limit)
+	if err != nil {
+		return jobs, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		_, job, err := q.parseJob(rows)
+		if err != nil {
+			return jobs, mysqlutils.HandleMySQLError(err)
+		}
+		jobs = append(jobs, job)
+	}
+
+	return jobs, nil
+}
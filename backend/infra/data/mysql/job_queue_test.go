@@ -0,0 +1,142 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+func newTestJob(queue string, runAt time.Time) entity.Job {
+	return entity.Job{
+		UUID:        uuid.NewV4().String(),
+		Queue:       queue,
+		Type:        "test.job",
+		Payload:     `{"note_id": 1}`,
+		Status:      domain.JobStatusPending,
+		MaxAttempts: 2,
+		RunAt:       runAt,
+	}
+}
+
+func TestJobQueue(t *testing.T) {
+	ctx := context.Background()
+	queue := NewJobQueue(testMysql.(*MysqlConn).DB())
+	queueName := "test-" + uuid.NewV4().String()[:8]
+	now := time.Now().Truncate(time.Second)
+
+	job := newTestJob(queueName, now)
+	require.NoError(t, queue.Enqueue(ctx, job))
+	// enqueueing the same job again does nothing
+	require.NoError(t, queue.Enqueue(ctx, job))
+	require.NoError(t, queue.Enqueue(ctx, newTestJob(queueName, now.Add(time.Hour))))
+
+	stats, err := queue.GetStats(ctx, queueName, now)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), stats.Pending)
+	require.Equal(t, int64(1), stats.Scheduled)
+	require.NotNil(t, stats.OldestPendingAt)
+
+	lease := now.Add(time.Minute)
+	jobs, err := queue.Dequeue(ctx, queueName, now, lease, 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, job.UUID, jobs[0].UUID)
+	require.Equal(t, domain.JobStatusRunning, jobs[0].Status)
+	require.Equal(t, 1, jobs[0].Attempts)
+	require.JSONEq(t, job.Payload, jobs[0].Payload)
+
+	// a running job is claimed again only when its lease expires
+	jobs, err = queue.Dequeue(ctx, queueName, now, lease, 10)
+	require.NoError(t, err)
+	require.Empty(t, jobs)
+
+	jobs, err = queue.Dequeue(ctx, queueName, lease.Add(time.Second), lease.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, 2, jobs[0].Attempts)
+	claimed := jobs[0]
+
+	// the worker that lost the lease can't finish the job
+	stale := claimed
+	stale.Attempts = 1
+	require.ErrorIs(t, queue.Complete(ctx, stale), domain.ErrJobNotFound)
+
+	require.NoError(t, queue.DeadLetter(ctx, claimed, "provider unavailable", now))
+
+	dead, err := queue.GetDeadJobs(ctx, queueName, 10)
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	require.Equal(t, domain.JobStatusDead, dead[0].Status)
+	require.Equal(t, "provider unavailable", *dead[0].LastError)
+	require.NotNil(t, dead[0].FailedAt)
+
+	require.NoError(t, queue.Requeue(ctx, job.UUID, now))
+	require.ErrorIs(t, queue.Requeue(ctx, job.UUID, now), domain.ErrJobNotFound)
+
+	jobs, err = queue.Dequeue(ctx, queueName, now, lease, 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, 1, jobs[0].Attempts)
+
+	require.NoError(t, queue.Retry(ctx, jobs[0], "timeout", now.Add(time.Hour)))
+
+	stats, err = queue.GetStats(ctx, queueName, now)
+	require.NoError(t, err)
+	require.Zero(t, stats.Pending)
+	require.Equal(t, int64(2), stats.Scheduled)
+	require.Zero(t, stats.Running)
+	require.Zero(t, stats.Dead)
+
+	jobs, err = queue.Dequeue(ctx, queueName, now.Add(time.Hour), lease.Add(time.Hour), 10)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	for _, job := range jobs {
+		require.NoError(t, queue.Complete(ctx, job))
+	}
+
+	stats, err = queue.GetStats(ctx, queueName, now.Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, entity.JobQueueStats{Queue: queueName}, stats)
+}
+
+func TestJobQueue_leaseExpired(t *testing.T) {
+	ctx := context.Background()
+	queue := NewJobQueue(testMysql.(*MysqlConn).DB())
+	queueName := "test-" + uuid.NewV4().String()[:8]
+	now := time.Now().Truncate(time.Second)
+
+	job := newTestJob(queueName, now)
+	require.NoError(t, queue.Enqueue(ctx, job))
+
+	// the worker never finishes: each lease expires until there are no attempts left
+	runAt := now
+	for attempt := 1; attempt <= job.MaxAttempts; attempt++ {
+		jobs, err := queue.Dequeue(ctx, queueName, runAt, runAt.Add(time.Minute), 10)
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		require.Equal(t, attempt, jobs[0].Attempts)
+		runAt = runAt.Add(time.Minute + time.Second)
+	}
+
+	jobs, err := queue.Dequeue(ctx, queueName, runAt, runAt.Add(time.Minute), 10)
+	require.NoError(t, err)
+	require.Empty(t, jobs)
+
+	dead, err := queue.GetDeadJobs(ctx, queueName, 10)
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	require.Equal(t, job.UUID, dead[0].UUID)
+	require.Equal(t, domain.JobStatusDead, dead[0].Status)
+	require.Equal(t, domain.JobLeaseExpiredError, *dead[0].LastError)
+	require.NotNil(t, dead[0].FailedAt)
+
+	stats, err := queue.GetStats(ctx, queueName, runAt)
+	require.NoError(t, err)
+	require.Zero(t, stats.Running)
+	require.Equal(t, int64(1), stats.Dead)
+}
@@ -0,0 +1,669 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type meetingRepo struct {
+	db dbConn
+}
+
+func newMeetingRepo(db dbConn) contract.MeetingRepo {
+	return &meetingRepo{
+		db: db,
+	}
+}
+
+const meetingSelectBase string = `
+	SELECT
+		m.meeting_id,
+		m.meeting_uuid,
+		m.company_id,
+		m.person_id,
+		p.person_uuid,
+		p.name,
+		m.user_id,
+		m.status,
+		m.origin,
+		m.scheduled_at,
+		m.held_at,
+		m.duration_minutes,
+		n.note_id,
+		n.note_uuid,
+		m.ical_uid,
+		m.created_at,
+		m.updated_at
+
+	FROM tab_meeting m
+	INNER JOIN tab_person p
+		ON p.person_id = m.person_id
+	LEFT JOIN tab_note n
+		ON n.note_id = m.note_id
+		AND n.deleted_at IS NULL
+`
+
+const agendaItemSelectBase string = `
+	SELECT
+		ai.agenda_item_id,
+		ai.agenda_item_uuid,
+		ai.meeting_id,
+		act.action_item_id,
+		act.action_item_uuid,
+		ai.added_by,
+		ai.content,
+		COALESCE(ai.notes, ''),
+		ai.discussed,
+		ai.position,
+		ai.created_at,
+		ai.updated_at
+
+	FROM tab_meeting_agenda_item ai
+	LEFT JOIN tab_action_item act
+		ON act.action_item_id = ai.action_item_id
+`
+
+func (r *meetingRepo) parseMeeting(row scanner) (meeting entity.Meeting, err error) {
+	err = row.Scan(
+		&meeting.ID,
+		&meeting.UUID,
+		&meeting.CompanyID,
+		&meeting.PersonID,
+		&meeting.PersonUUID,
+		&meeting.PersonName,
+		&meeting.UserID,
+		&meeting.Status,
+		&meeting.Origin,
+		&meeting.ScheduledAt,
+		&meeting.HeldAt,
+		&meeting.DurationMinutes,
+		&meeting.NoteID,
+		&meeting.NoteUUID,
+		&meeting.ICalUID,
+		&meeting.CreatedAt,
+		&meeting.UpdatedAt,
+	)
+	if err != nil {
+		return meeting, err
+	}
+
+	return meeting, nil
+}
+
+func (r *meetingRepo) parseAgendaItem(row scanner) (item entity.MeetingAgendaItem, err error) {
+	err = row.Scan(
+		&item.ID,
+		&item.UUID,
+		&item.MeetingID,
+		&item.ActionItemID,
+		&item.ActionItemUUID,
+		&item.AddedBy,
+		&item.Content,
+		&item.Notes,
+		&item.Discussed,
+		&item.Position,
+		&item.CreatedAt,
+		&item.UpdatedAt,
+	)
+	if err != nil {
+		return item, err
+	}
+
+	return item, nil
+}
+
+func (r *meetingRepo) CreateMeeting(ctx context.Context, meeting entity.Meeting) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_meeting (
+			meeting_uuid,
+			company_id,
+			person_id,
+			user_id,
+			status,
+			origin,
+			scheduled_at,
+			held_at,
+			duration_minutes,
+			note_id,
+			ical_uid
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		meeting.UUID,
+		meeting.CompanyID,
+		meeting.PersonID,
+		meeting.UserID,
+		meeting.Status,
+		meeting.Origin,
+		meeting.ScheduledAt,
+		meeting.HeldAt,
+		meeting.DurationMinutes,
+		meeting.NoteID,
+		meeting.ICalUID,
+	)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+func (r *meetingRepo) GetMeetingByUUID(ctx context.Context, meetingUUID string) (meeting entity.Meeting, err error) {
+	query := meetingSelectBase + `
+		WHERE m.meeting_uuid = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return meeting, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, meetingUUID)
+	meeting, err = r.parseMeeting(row)
+	if err != nil {
+		return meeting, mysqlutils.HandleMySQLError(err)
+	}
+
+	return meeting, nil
+}
+
+func (r *meetingRepo) GetMeetingsByPerson(ctx context.Context, personID int64, status string, take, skip int64) (meetings []entity.Meeting, totalRecords int64, err error) {
+	where := `
+		WHERE m.person_id = ?
+	`
+	args := []any{personID}
+	if status != "" {
+		where += `
+		  AND m.status = ?
+		`
+		args = append(args, status)
+	}
+
+	countQuery := `
+		SELECT COUNT(*)
+		FROM tab_meeting m
+	` + where
+
+	stmt, err := r.db.PrepareContext(ctx, countQuery)
+	if err != nil {
+		return meetings, 0, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, args...).Scan(&totalRecords)
+	if err != nil {
+		return meetings, 0, mysqlutils.HandleMySQLError(err)
+	}
+
+	query := meetingSelectBase + where + `
+		ORDER BY COALESCE(m.held_at, m.scheduled_at) DESC
+		LIMIT ? OFFSET ?
+	`
+
+	stmt2, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return meetings, totalRecords, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt2.Close()
+
+	rows, err := stmt2.QueryContext(ctx, append(args, take, skip)...)
+	if err != nil {
+		return meetings, totalRecords, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		meeting, err := r.parseMeeting(rows)
+		if err != nil {
+			return meetings, totalRecords, mysqlutils.HandleMySQLError(err)
+		}
+		meetings = append(meetings, meeting)
+	}
+
+	if err = rows.Err(); err != nil {
+		return meetings, totalRecords, mysqlutils.HandleMySQLError(err)
+	}
+
+	return meetings, totalRecords, nil
+}
+
+func (r *meetingRepo) UpdateMeeting(ctx context.Context, meetingID int64, meeting entity.Meeting) (err error) {
+	query := `
+		UPDATE tab_meeting
+		SET
+			status           = ?,
+			scheduled_at     = ?,
+			held_at          = ?,
+			duration_minutes = ?,
+			note_id          = ?,
+			ical_uid         = ?
+		WHERE meeting_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		meeting.Status,
+		meeting.ScheduledAt,
+		meeting.HeldAt,
+		meeting.DurationMinutes,
+		meeting.NoteID,
+		meeting.ICalUID,
+		meetingID,
+	)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+// DeleteMeetingsFromNote deletes the meetings that were registered from the given one_on_one note
+func (r *meetingRepo) DeleteMeetingsFromNote(ctx context.Context, noteID int64) (err error) {
+	query := `
+		DELETE FROM tab_meeting
+		WHERE note_id = ?
+		  AND origin  = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, noteID, domain.MeetingOriginNote)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+// GetNextScheduledMeeting returns the scheduled meeting of the person with the earliest date
+func (r *meetingRepo) GetNextScheduledMeeting(ctx context.Context, personID int64) (meeting entity.Meeting, err error) {
+	query := meetingSelectBase + `
+		WHERE m.person_id = ?
+		  AND m.status    = ?
+		ORDER BY m.scheduled_at ASC
+		LIMIT 1
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return meeting, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, personID, domain.MeetingStatusScheduled)
+	meeting, err = r.parseMeeting(row)
+	if err != nil {
+		return meeting, mysqlutils.HandleMySQLError(err)
+	}
+
+	return meeting, nil
+}
+
+func (r *meetingRepo) GetNextScheduledDatesByCompany(ctx context.Context, companyID int64) (dates map[int64]time.Time, err error) {
+	query := `
+		SELECT
+			m.person_id,
+			MIN(m.scheduled_at)
+		FROM tab_meeting m
+		WHERE m.company_id = ?
+		  AND m.status     = ?
+		GROUP BY m.person_id
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return dates, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, companyID, domain.MeetingStatusScheduled)
+	if err != nil {
+		return dates, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	dates = make(map[int64]time.Time)
+	for rows.Next() {
+		var (
+			personID    int64
+			scheduledAt time.Time
+		)
+		if err := rows.Scan(&personID, &scheduledAt); err != nil {
+			return dates, mysqlutils.HandleMySQLError(err)
+		}
+		dates[personID] = scheduledAt
+	}
+
+	if err = rows.Err(); err != nil {
+		return dates, mysqlutils.HandleMySQLError(err)
+	}
+
+	return dates, nil
+}
+
+// GetMeetingsByICalUID returns the meetings of the user imported from the calendar event, a recurring event
+// has one meeting per imported occurrence
+func (r *meetingRepo) GetMeetingsByICalUID(ctx context.Context, userID int64, icalUID string) (meetings []entity.Meeting, err error) {
+	query := meetingSelectBase + `
+		WHERE m.user_id  = ?
+		  AND m.ical_uid = ?
+		ORDER BY m.scheduled_at ASC
+	`
+
+	return r.queryMeetings(ctx, query, userID, icalUID)
+}
+
+// GetScheduledMeetingsByUser returns the scheduled meetings of the user with active people, from the given date
+func (r *meetingRepo) GetScheduledMeetingsByUser(ctx context.Context, userID int64, from time.Time) (meetings []entity.Meeting, err error) {
+	query := meetingSelectBase + `
+		WHERE m.user_id      = ?
+		  AND m.status       = ?
+		  AND m.scheduled_at >= ?
+		  AND p.active       = 1
+		ORDER BY m.scheduled_at ASC
+	`
+
+	return r.queryMeetings(ctx, query, userID, domain.MeetingStatusScheduled, from)
+}
+
+func (r *meetingRepo) queryMeetings(ctx context.Context, query string, args ...any) (meetings []entity.Meeting, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return meetings, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return meetings, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		meeting, err := r.parseMeeting(rows)
+		if err != nil {
+			return meetings, mysqlutils.HandleMySQLError(err)
+		}
+		meetings = append(meetings, meeting)
+	}
+
+	if err = rows.Err(); err != nil {
+		return meetings, mysqlutils.HandleMySQLError(err)
+	}
+
+	return meetings, nil
+}
+
+func (r *meetingRepo) CreateAgendaItem(ctx context.Context, item entity.MeetingAgendaItem) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_meeting_agenda_item (
+			agenda_item_uuid,
+			meeting_id,
+			action_item_id,
+			added_by,
+			content,
+			notes,
+			discussed,
+			position
+		)
+		SELECT ?, ?, ?, ?, ?, ?, ?, COALESCE(MAX(position), 0) + 1
+		FROM tab_meeting_agenda_item
+		WHERE meeting_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		item.UUID,
+		item.MeetingID,
+		item.ActionItemID,
+		item.AddedBy,
+		item.Content,
+		nullableString(item.Notes),
+		item.Discussed,
+		item.MeetingID,
+	)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+func (r *meetingRepo) GetAgendaItemsByMeeting(ctx context.Context, meetingID int64) (items []entity.MeetingAgendaItem, err error) {
+	query := agendaItemSelectBase + `
+		WHERE ai.meeting_id = ?
+		ORDER BY ai.position ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return items, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, meetingID)
+	if err != nil {
+		return items, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item, err := r.parseAgendaItem(rows)
+		if err != nil {
+			return items, mysqlutils.HandleMySQLError(err)
+		}
+		items = append(items, item)
+	}
+
+	if err = rows.Err(); err != nil {
+		return items, mysqlutils.HandleMySQLError(err)
+	}
+
+	return items, nil
+}
+
+func (r *meetingRepo) GetAgendaItemByUUID(ctx context.Context, meetingID int64, itemUUID string) (item entity.MeetingAgendaItem, err error) {
+	query := agendaItemSelectBase + `
+		WHERE ai.meeting_id       = ?
+		  AND ai.agenda_item_uuid = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return item, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, meetingID, itemUUID)
+	item, err = r.parseAgendaItem(row)
+	if err != nil {
+		return item, mysqlutils.HandleMySQLError(err)
+	}
+
+	return item, nil
+}
+
+func (r *meetingRepo) UpdateAgendaItem(ctx context.Context, itemID int64, item entity.MeetingAgendaItem) (err error) {
+	query := `
+		UPDATE tab_meeting_agenda_item
+		SET
+			content   = ?,
+			notes     = ?,
+			discussed = ?
+		WHERE agenda_item_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		item.Content,
+		nullableString(item.Notes),
+		item.Discussed,
+		itemID,
+	)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *meetingRepo) DeleteAgendaItem(ctx context.Context, itemID int64) (err error) {
+	query := `
+		DELETE FROM tab_meeting_agenda_item
+		WHERE agenda_item_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, itemID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *meetingRepo) GetOneOnOnesCountThisMonth(ctx context.Context, companyID int64) (count int64, err error) {
+	query := `
+		SELECT COUNT(*)
+		FROM tab_meeting m
+		INNER JOIN tab_person p ON m.person_id = p.person_id
+		WHERE m.company_id = ?
+		AND p.active = 1
+		AND m.status = ?
+		AND YEAR(m.held_at) = YEAR(NOW())
+		AND MONTH(m.held_at) = MONTH(NOW())
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return count, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, companyID, domain.MeetingStatusHeld)
+	err = row.Scan(&count)
+	if err != nil {
+		return count, mysqlutils.HandleMySQLError(err)
+	}
+
+	return count, nil
+}
+
+func (r *meetingRepo) GetAverageFrequencyDays(ctx context.Context, companyID int64) (avgDays float64, err error) {
+	query := `
+		SELECT COALESCE(AVG(day_diff), 0) as avg_frequency
+		FROM (
+			SELECT DATEDIFF(
+				LEAD(m.held_at) OVER (PARTITION BY m.person_id ORDER BY m.held_at),
+				m.held_at
+			) as day_diff
+			FROM tab_meeting m
+			INNER JOIN tab_person p ON m.person_id = p.person_id
+			WHERE m.company_id = ?
+			AND p.active = 1
+			AND m.status = ?
+		) as frequency_data
+		WHERE day_diff IS NOT NULL
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return avgDays, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, companyID, domain.MeetingStatusHeld)
+	err = row.Scan(&avgDays)
+	if err != nil {
+		return avgDays, mysqlutils.HandleMySQLError(err)
+	}
+
+	return avgDays, nil
+}
+
+func (r *meetingRepo) GetLastMeetingDate(ctx context.Context, companyID int64) (lastDate *time.Time, err error) {
+	query := `
+		SELECT MAX(m.held_at) as last_meeting_date
+		FROM tab_meeting m
+		INNER JOIN tab_person p ON m.person_id = p.person_id
+		WHERE m.company_id = ?
+		AND p.active = 1
+		AND m.status = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return lastDate, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	var nullableDate sql.NullTime
+	row := stmt.QueryRowContext(ctx, companyID, domain.MeetingStatusHeld)
+	err = row.Scan(&nullableDate)
+	if err != nil {
+		return lastDate, mysqlutils.HandleMySQLError(err)
+	}
+
+	if nullableDate.Valid {
+		lastDate = &nullableDate.Time
+	}
+
+	return lastDate, nil
+}
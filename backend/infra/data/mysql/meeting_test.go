@@ -0,0 +1,233 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+func createRandomMeeting(t *testing.T, person entity.Person, status string, heldAt *time.Time) entity.Meeting {
+	meeting := entity.Meeting{
+		UUID:        uuid.NewV4().String(),
+		CompanyID:   person.CompanyID,
+		PersonID:    person.ID,
+		UserID:      person.CreatedBy,
+		Status:      status,
+		Origin:      domain.MeetingOriginScheduled,
+		ScheduledAt: time.Now().Truncate(time.Second),
+		HeldAt:      heldAt,
+	}
+
+	meetingID, err := testMysql.Meeting().CreateMeeting(context.Background(), meeting)
+	require.NoError(t, err)
+	require.NotZero(t, meetingID)
+
+	meeting.ID = meetingID
+	return meeting
+}
+
+func TestCreateAndGetMeeting(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	meeting := createRandomMeeting(t, person, domain.MeetingStatusScheduled, nil)
+
+	result, err := testMysql.Meeting().GetMeetingByUUID(ctx, meeting.UUID)
+	require.NoError(t, err)
+	require.Equal(t, meeting.ID, result.ID)
+	require.Equal(t, person.UUID, result.PersonUUID)
+	require.Equal(t, person.Name, result.PersonName)
+	require.Equal(t, domain.MeetingStatusScheduled, result.Status)
+	require.Nil(t, result.HeldAt)
+	require.Nil(t, result.NoteID)
+}
+
+func TestUpdateMeetingDerivesLastOneOnOneDate(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	meeting := createRandomMeeting(t, person, domain.MeetingStatusScheduled, nil)
+
+	heldAt := time.Date(2025, 3, 10, 14, 0, 0, 0, time.UTC)
+	duration := 45
+	meeting.Status = domain.MeetingStatusHeld
+	meeting.HeldAt = &heldAt
+	meeting.DurationMinutes = &duration
+
+	err := testMysql.Meeting().UpdateMeeting(ctx, meeting.ID, meeting)
+	require.NoError(t, err)
+
+	result, err := testMysql.Meeting().GetMeetingByUUID(ctx, meeting.UUID)
+	require.NoError(t, err)
+	require.Equal(t, domain.MeetingStatusHeld, result.Status)
+	require.Equal(t, 45, *result.DurationMinutes)
+
+	updatedPerson, err := testMysql.Person().GetPersonByUUID(ctx, person.UUID)
+	require.NoError(t, err)
+	require.NotNil(t, updatedPerson.LastOneOnOneDate)
+	require.True(t, heldAt.Equal(*updatedPerson.LastOneOnOneDate))
+}
+
+func TestGetMeetingsByPerson(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	heldAt := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	createRandomMeeting(t, person, domain.MeetingStatusHeld, &heldAt)
+	createRandomMeeting(t, person, domain.MeetingStatusScheduled, nil)
+
+	meetings, totalRecords, err := testMysql.Meeting().GetMeetingsByPerson(ctx, person.ID, "", 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), totalRecords)
+	require.Len(t, meetings, 2)
+
+	meetings, totalRecords, err = testMysql.Meeting().GetMeetingsByPerson(ctx, person.ID, domain.MeetingStatusHeld, 10, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), totalRecords)
+	require.Equal(t, domain.MeetingStatusHeld, meetings[0].Status)
+}
+
+func TestAgendaItems(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	meeting := createRandomMeeting(t, person, domain.MeetingStatusScheduled, nil)
+
+	first := entity.MeetingAgendaItem{UUID: uuid.NewV4().String(), MeetingID: meeting.ID, AddedBy: domain.AgendaItemAddedByManager, Content: "Feedback on the launch"}
+	second := entity.MeetingAgendaItem{UUID: uuid.NewV4().String(), MeetingID: meeting.ID, AddedBy: domain.AgendaItemAddedByReport, Content: "Career plan"}
+
+	_, err := testMysql.Meeting().CreateAgendaItem(ctx, first)
+	require.NoError(t, err)
+	_, err = testMysql.Meeting().CreateAgendaItem(ctx, second)
+	require.NoError(t, err)
+
+	items, err := testMysql.Meeting().GetAgendaItemsByMeeting(ctx, meeting.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	require.Equal(t, 1, items[0].Position)
+	require.Equal(t, 2, items[1].Position)
+	require.Equal(t, domain.AgendaItemAddedByReport, items[1].AddedBy)
+
+	item := items[1]
+	item.Notes = "Wants to lead the platform team"
+	item.Discussed = true
+	err = testMysql.Meeting().UpdateAgendaItem(ctx, item.ID, item)
+	require.NoError(t, err)
+
+	result, err := testMysql.Meeting().GetAgendaItemByUUID(ctx, meeting.ID, second.UUID)
+	require.NoError(t, err)
+	require.Equal(t, "Wants to lead the platform team", result.Notes)
+	require.True(t, result.Discussed)
+
+	err = testMysql.Meeting().DeleteAgendaItem(ctx, result.ID)
+	require.NoError(t, err)
+
+	_, err = testMysql.Meeting().GetAgendaItemByUUID(ctx, meeting.ID, second.UUID)
+	require.Error(t, err)
+}
+
+func TestMeetingDashboardStats(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+
+	now := time.Now().Truncate(time.Second)
+	previous := now.AddDate(0, 0, -14)
+	createRandomMeeting(t, person, domain.MeetingStatusHeld, &previous)
+	createRandomMeeting(t, person, domain.MeetingStatusHeld, &now)
+	createRandomMeeting(t, person, domain.MeetingStatusCanceled, nil)
+
+	avgDays, err := testMysql.Meeting().GetAverageFrequencyDays(ctx, person.CompanyID)
+	require.NoError(t, err)
+	require.Equal(t, float64(14), avgDays)
+
+	lastDate, err := testMysql.Meeting().GetLastMeetingDate(ctx, person.CompanyID)
+	require.NoError(t, err)
+	require.NotNil(t, lastDate)
+	require.True(t, now.Equal(*lastDate))
+
+	count, err := testMysql.Meeting().GetOneOnOnesCountThisMonth(ctx, person.CompanyID)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, count, int64(1))
+}
+
+// Error tests with mocks
+func TestCreateMeetingErrorsWithMock(t *testing.T) {
+	testForInsertErrorsWithMock(t, func(db *sql.DB) error {
+		_, err := newMeetingRepo(db).CreateMeeting(context.Background(), entity.Meeting{})
+		return err
+	})
+}
+
+func TestGetMeetingByUUIDErrorsWithMock(t *testing.T) {
+	testForSelectErrorsWithMock(t, "meeting_id", func(db *sql.DB) error {
+		_, err := newMeetingRepo(db).GetMeetingByUUID(context.Background(), "meeting-uuid")
+		return err
+	})
+}
+
+func TestUpdateMeetingErrorsWithMock(t *testing.T) {
+	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
+		return newMeetingRepo(db).UpdateMeeting(context.Background(), 1, entity.Meeting{})
+	})
+}
+
+func TestDeleteAgendaItemErrorsWithMock(t *testing.T) {
+	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
+		return newMeetingRepo(db).DeleteAgendaItem(context.Background(), 1)
+	})
+}
+
+func TestGetNextScheduledDatesByCompany(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	first := createRandomMeeting(t, person, domain.MeetingStatusScheduled, nil)
+	later := createRandomMeeting(t, person, domain.MeetingStatusScheduled, nil)
+
+	later.ScheduledAt = first.ScheduledAt.AddDate(0, 0, 7)
+	err := testMysql.Meeting().UpdateMeeting(ctx, later.ID, later)
+	require.NoError(t, err)
+
+	dates, err := testMysql.Meeting().GetNextScheduledDatesByCompany(ctx, person.CompanyID)
+	require.NoError(t, err)
+	require.True(t, first.ScheduledAt.Equal(dates[person.ID]))
+}
+
+func TestGetMeetingsByICalUID(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	createRandomMeeting(t, person, domain.MeetingStatusScheduled, nil)
+
+	icalUID := uuid.NewV4().String() + "@google.com"
+	meeting := entity.Meeting{
+		UUID:        uuid.NewV4().String(),
+		CompanyID:   person.CompanyID,
+		PersonID:    person.ID,
+		UserID:      person.CreatedBy,
+		Status:      domain.MeetingStatusScheduled,
+		Origin:      domain.MeetingOriginScheduled,
+		ScheduledAt: time.Now().AddDate(0, 0, 7).Truncate(time.Second),
+		ICalUID:     &icalUID,
+	}
+	_, err := testMysql.Meeting().CreateMeeting(ctx, meeting)
+	require.NoError(t, err)
+
+	meetings, err := testMysql.Meeting().GetMeetingsByICalUID(ctx, person.CreatedBy, icalUID)
+	require.NoError(t, err)
+	require.Len(t, meetings, 1)
+	require.Equal(t, meeting.UUID, meetings[0].UUID)
+	require.Equal(t, icalUID, *meetings[0].ICalUID)
+
+	scheduled, err := testMysql.Meeting().GetScheduledMeetingsByUser(ctx, person.CreatedBy, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, scheduled, 2)
+	require.Equal(t, meeting.UUID, scheduled[1].UUID)
+}
+
+func TestGetMeetingsByICalUIDErrorsWithMock(t *testing.T) {
+	testForSelectErrorsWithMock(t, "meeting_id", func(db *sql.DB) error {
+		_, err := newMeetingRepo(db).GetMeetingsByICalUID(context.Background(), 1, "uid@google.com")
+		return err
+	})
+}
@@ -3,7 +3,6 @@ package mysql
 import (
 	"context"
 	"database/sql"
-	"time"
 
 	"github.com/diegoclair/go_utils/mysqlutils"
 	"github.com/diegoclair/leaderpro/internal/domain"
@@ -32,10 +31,12 @@ func (r *noteRepo) CreateNote(ctx context.Context, note entity.Note) (createdID
 			content,
 			feedback_type,
 			feedback_category,
+			respondent_name,
+			template_id,
 			created_at,
 			updated_at
 
-		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 	`
 
 	stmt, err := r.db.PrepareContext(ctx, query)
@@ -53,6 +54,8 @@ func (r *noteRepo) CreateNote(ctx context.Context, note entity.Note) (createdID
 		note.Content,
 		note.FeedbackType,
 		note.FeedbackCategory,
+		note.RespondentName,
+		note.TemplateID,
 		note.CreatedAt,
 		note.UpdatedAt,
 	)
@@ -80,6 +83,7 @@ func (r *noteRepo) GetNoteByUUID(ctx context.Context, noteUUID string) (note ent
 			content,
 			feedback_type,
 			feedback_category,
+			template_id,
 			created_at,
 			updated_at
 
@@ -105,6 +109,7 @@ func (r *noteRepo) GetNoteByUUID(ctx context.Context, noteUUID string) (note ent
 		&note.Content,
 		&note.FeedbackType,
 		&note.FeedbackCategory,
+		&note.TemplateID,
 		&note.CreatedAt,
 		&note.UpdatedAt,
 	)
@@ -127,6 +132,7 @@ func (r *noteRepo) GetNoteByID(ctx context.Context, noteID int64) (note entity.N
 			content,
 			feedback_type,
 			feedback_category,
+			template_id,
 			created_at,
 			updated_at
 		
@@ -152,6 +158,7 @@ func (r *noteRepo) GetNoteByID(ctx context.Context, noteID int64) (note entity.N
 		&note.Content,
 		&note.FeedbackType,
 		&note.FeedbackCategory,
+		&note.TemplateID,
 		&note.CreatedAt,
 		&note.UpdatedAt,
 	)
@@ -481,16 +488,73 @@ func (r *noteRepo) GetMentionsByPerson(ctx context.Context, mentionedPersonID in
 }
 
 func (r *noteRepo) GetPersonTimeline(ctx context.Context, personID int64, filters entity.TimelineFilters, take, skip int64) (timeline []entity.UnifiedTimelineEntry, totalRecords int64, err error) {
+	query, args := personTimelineQuery(personID, filters)
+
+	totalRecords, err = r.countPersonTimeline(ctx, query, args)
+	if err != nil {
+		return timeline, totalRecords, err
+	}
+
+	// Add ordering and pagination
+	query += personTimelineOrder(filters)
+	if take > 0 {
+		query += ` LIMIT ?`
+		args = append(args, take)
+		if skip > 0 {
+			query += ` OFFSET ?`
+			args = append(args, skip)
+		}
+	}
+
+	timeline, err = r.queryPersonTimeline(ctx, query, args)
+	if err != nil {
+		return timeline, totalRecords, err
+	}
+
+	return timeline, totalRecords, nil
+}
+
+// GetPersonTimelinePage returns the timeline entries after the cursor of the page, keyed on the creation time and the note id
+func (r *noteRepo) GetPersonTimelinePage(ctx context.Context, personID int64, filters entity.TimelineFilters, page entity.CursorPage) (timeline []entity.UnifiedTimelineEntry, info entity.CursorPageInfo, err error) {
+	query, args := personTimelineQuery(personID, filters)
+
+	if page.WithCount {
+		totalRecords, err := r.countPersonTimeline(ctx, query, args)
+		if err != nil {
+			return timeline, info, err
+		}
+		info.TotalRecords = &totalRecords
+	}
+
+	condition, cursorArgs := cursorCondition("n.created_at", "n.note_id", page.After, filters.Sort == domain.TimelineSortOldest)
+	query += condition + personTimelineOrder(filters) + ` LIMIT ?`
+	args = append(args, cursorArgs...)
+	args = append(args, page.Limit+1) // one more to know whether there is a next page
+
+	timeline, err = r.queryPersonTimeline(ctx, query, args)
+	if err != nil {
+		return timeline, info, err
+	}
+
+	timeline, info.Next = cursorPageItems(timeline, page.Limit, func(entry entity.UnifiedTimelineEntry) entity.PageCursor {
+		return entity.PageCursor{CreatedAt: entry.CreatedAt, ID: entry.NoteID}
+	})
+	return timeline, info, nil
+}
+
+// personTimelineQuery returns the query of the timeline entries of the person matching the filters, without ordering
+func personTimelineQuery(personID int64, filters entity.TimelineFilters) (query string, args []any) {
 	// Single query with LEFT JOIN - much simpler!
-	query := `
+	query = `
 		SELECT 
+			n.note_id,
 			n.note_uuid as uuid,
 			CASE 
 				WHEN nm.mention_id IS NOT NULL THEN 'mention'
 				ELSE n.type
 			END as type,
 			n.content,
-			u.name as author_name,
+			COALESCE(n.respondent_name, u.name) as author_name,
 			n.created_at,
 			n.feedback_type,
 			n.feedback_category,
@@ -508,11 +572,11 @@ func (r *noteRepo) GetPersonTimeline(ctx context.Context, personID int64, filter
 		LEFT JOIN tab_person mp ON n.person_id = mp.person_id
 		WHERE (n.person_id = ? OR nm.mentioned_person_id = ?) AND n.deleted_at IS NULL`
 
-	args := []any{personID, personID, personID}
+	args = []any{personID, personID, personID}
 
 	// Apply filters
 	if filters.SearchQuery != "" {
-		searchCondition := ` AND (n.content LIKE ? OR u.name LIKE ? OR n.feedback_category LIKE ? OR n.feedback_type LIKE ?)`
+		searchCondition := ` AND (n.content LIKE ? OR COALESCE(n.respondent_name, u.name) LIKE ? OR n.feedback_category LIKE ? OR n.feedback_type LIKE ?)`
 		searchValue := "%" + filters.SearchQuery + "%"
 		query += searchCondition
 		args = append(args, searchValue, searchValue, searchValue, searchValue)
@@ -565,65 +629,104 @@ func (r *noteRepo) GetPersonTimeline(ctx context.Context, personID int64, filter
 		query += ` AND n.feedback_type IN (` + joinStringSlice(placeholders, ",") + `)`
 	}
 
-	// Apply period filter
-	if filters.Period != "" && filters.Period != "all" {
-		var dateCondition string
-		switch filters.Period {
-		case "7d":
-			dateCondition = ` AND n.created_at >= DATE_SUB(NOW(), INTERVAL 7 DAY)`
-		case "30d":
-			dateCondition = ` AND n.created_at >= DATE_SUB(NOW(), INTERVAL 30 DAY)`
-		case "3m":
-			dateCondition = ` AND n.created_at >= DATE_SUB(NOW(), INTERVAL 3 MONTH)`
-		case "6m":
-			dateCondition = ` AND n.created_at >= DATE_SUB(NOW(), INTERVAL 6 MONTH)`
-		case "1y":
-			dateCondition = ` AND n.created_at >= DATE_SUB(NOW(), INTERVAL 1 YEAR)`
+	// Apply feedback category filters
+	if len(filters.FeedbackCategories) > 0 {
+		placeholders := make([]string, len(filters.FeedbackCategories))
+		for i, category := range filters.FeedbackCategories {
+			placeholders[i] = "?"
+			args = append(args, category)
 		}
-		if dateCondition != "" {
-			query += dateCondition
+		query += ` AND n.feedback_category IN (` + joinStringSlice(placeholders, ",") + `)`
+	}
+
+	// Apply author filter
+	if filters.AuthorUserID != nil {
+		query += ` AND n.user_id = ?`
+		args = append(args, *filters.AuthorUserID)
+	}
+
+	// Apply direction filter. A note about the person mentioning other people is feedback they gave about others,
+	// the other notes about the person and the mentions of them in other notes are feedback about them
+	mentionsOthers := ` EXISTS (
+			SELECT 1
+			FROM tab_note_mention om
+			WHERE om.note_id = n.note_id
+			  AND om.mentioned_person_id <> ?
+		)`
+	switch filters.Direction {
+	case domain.TimelineDirectionAboutPerson:
+		query += ` AND (nm.mention_id IS NOT NULL OR NOT` + mentionsOthers + `)`
+		args = append(args, personID)
+	case domain.TimelineDirectionFromPerson:
+		query += ` AND nm.mention_id IS NULL AND` + mentionsOthers
+		args = append(args, personID)
+	case domain.TimelineDirectionBilateral:
+		query += ` AND (nm.mention_id IS NOT NULL OR` + mentionsOthers + `)`
+		args = append(args, personID)
+	}
+
+	// Apply date range filter
+	if filters.From != nil {
+		query += ` AND n.created_at >= ?`
+		args = append(args, *filters.From)
+	}
+	if filters.To != nil {
+		query += ` AND n.created_at < ?`
+		args = append(args, *filters.To)
+	}
+
+	// Apply tag filter, notes with any of the tags
+	if len(filters.Tags) > 0 {
+		placeholders := make([]string, len(filters.Tags))
+		for i, tag := range filters.Tags {
+			placeholders[i] = "?"
+			args = append(args, tag)
 		}
+		query += ` AND EXISTS (
+			SELECT 1
+			FROM tab_note_tag nt
+			INNER JOIN tab_tag t ON t.tag_id = nt.tag_id
+			WHERE nt.note_id = n.note_id
+			  AND t.name IN (` + joinStringSlice(placeholders, ",") + `)
+		)`
 	}
 
-	// Count total records (simplified)
-	countQuery := query
-	countQuerySelect := `SELECT COUNT(*) FROM (`
-	countQueryEnd := `) as subquery`
-	fullCountQuery := countQuerySelect + countQuery + countQueryEnd
+	return query, args
+}
+
+// personTimelineOrder returns the ordering of the timeline, the note id breaks the ties of notes created in the same second
+func personTimelineOrder(filters entity.TimelineFilters) string {
+	if filters.Sort == domain.TimelineSortOldest {
+		return ` ORDER BY n.created_at ASC, n.note_id ASC`
+	}
+	return ` ORDER BY n.created_at DESC, n.note_id DESC`
+}
 
-	stmt, err := r.db.PrepareContext(ctx, fullCountQuery)
+func (r *noteRepo) countPersonTimeline(ctx context.Context, query string, args []any) (totalRecords int64, err error) {
+	stmt, err := r.db.PrepareContext(ctx, `SELECT COUNT(*) FROM (`+query+`) as subquery`)
 	if err != nil {
-		return timeline, totalRecords, mysqlutils.HandleMySQLError(err)
+		return totalRecords, mysqlutils.HandleMySQLError(err)
 	}
 	defer stmt.Close()
 
-	row := stmt.QueryRowContext(ctx, args...)
-	err = row.Scan(&totalRecords)
+	err = stmt.QueryRowContext(ctx, args...).Scan(&totalRecords)
 	if err != nil {
-		return timeline, totalRecords, mysqlutils.HandleMySQLError(err)
+		return totalRecords, mysqlutils.HandleMySQLError(err)
 	}
 
-	// Add ordering and pagination
-	query += ` ORDER BY n.created_at DESC`
-	if take > 0 {
-		query += ` LIMIT ?`
-		args = append(args, take)
-		if skip > 0 {
-			query += ` OFFSET ?`
-			args = append(args, skip)
-		}
-	}
+	return totalRecords, nil
+}
 
-	// Execute main query
-	stmt2, err := r.db.PrepareContext(ctx, query)
+func (r *noteRepo) queryPersonTimeline(ctx context.Context, query string, args []any) (timeline []entity.UnifiedTimelineEntry, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
 	if err != nil {
-		return timeline, totalRecords, mysqlutils.HandleMySQLError(err)
+		return timeline, mysqlutils.HandleMySQLError(err)
 	}
-	defer stmt2.Close()
+	defer stmt.Close()
 
-	rows, err := stmt2.QueryContext(ctx, args...)
+	rows, err := stmt.QueryContext(ctx, args...)
 	if err != nil {
-		return timeline, totalRecords, mysqlutils.HandleMySQLError(err)
+		return timeline, mysqlutils.HandleMySQLError(err)
 	}
 	defer rows.Close()
 
@@ -633,13 +736,13 @@ func (r *noteRepo) GetPersonTimeline(ctx context.Context, personID int64, filter
 		var mentionedByPersonUUID, mentionedByPersonName sql.NullString
 
 		err = rows.Scan(
-			&entry.UUID, &entry.Type, &entry.Content,
+			&entry.NoteID, &entry.UUID, &entry.Type, &entry.Content,
 			&entry.AuthorName, &entry.CreatedAt,
 			&feedbackType, &feedbackCategory,
 			&mentionedByPersonUUID, &mentionedByPersonName,
 		)
 		if err != nil {
-			return timeline, totalRecords, mysqlutils.HandleMySQLError(err)
+			return timeline, mysqlutils.HandleMySQLError(err)
 		}
 
 		// Handle nullable fields
@@ -660,10 +763,10 @@ func (r *noteRepo) GetPersonTimeline(ctx context.Context, personID int64, filter
 	}
 
 	if err = rows.Err(); err != nil {
-		return timeline, totalRecords, mysqlutils.HandleMySQLError(err)
+		return timeline, mysqlutils.HandleMySQLError(err)
 	}
 
-	return timeline, totalRecords, nil
+	return timeline, nil
 }
 
 // Helper function to join string slice
@@ -700,7 +803,74 @@ func (r *noteRepo) updateMentionsContent(ctx context.Context, noteID int64, newC
 	return err
 }
 
+// personMentionsQuery selects the not deleted notes where the person was mentioned
+const personMentionsQuery = `
+		SELECT 
+			n.note_id,
+			n.note_uuid,
+			n.type,
+			n.content,
+			n.feedback_type,
+			n.feedback_category,
+			n.created_at,
+			p.person_uuid as person_id,
+			p.name as person_name
+		FROM tab_note_mention nm
+		INNER JOIN tab_note n ON nm.note_id = n.note_id
+		INNER JOIN tab_person p ON n.person_id = p.person_id
+		WHERE nm.mentioned_person_id = ? AND n.deleted_at IS NULL`
+
 func (r *noteRepo) GetPersonMentions(ctx context.Context, mentionedPersonID int64, take, skip int64) (mentions []entity.MentionEntry, totalRecords int64, err error) {
+	totalRecords, err = r.countPersonMentions(ctx, mentionedPersonID)
+	if err != nil {
+		return mentions, 0, err
+	}
+
+	// Main query - get notes where this person was mentioned
+	query := personMentionsQuery + `
+		ORDER BY n.created_at DESC, n.note_id DESC
+		LIMIT ? OFFSET ?
+	`
+
+	mentions, err = r.queryPersonMentions(ctx, query, mentionedPersonID, take, skip)
+	if err != nil {
+		return mentions, totalRecords, err
+	}
+
+	return mentions, totalRecords, nil
+}
+
+// GetPersonMentionsPage returns the mentions of the person after the cursor of the page, newest first
+func (r *noteRepo) GetPersonMentionsPage(ctx context.Context, mentionedPersonID int64, page entity.CursorPage) (mentions []entity.MentionEntry, info entity.CursorPageInfo, err error) {
+	if page.WithCount {
+		totalRecords, err := r.countPersonMentions(ctx, mentionedPersonID)
+		if err != nil {
+			return mentions, info, err
+		}
+		info.TotalRecords = &totalRecords
+	}
+
+	condition, cursorArgs := cursorCondition("n.created_at", "n.note_id", page.After, false)
+	query := personMentionsQuery + condition + `
+		ORDER BY n.created_at DESC, n.note_id DESC
+		LIMIT ?
+	`
+
+	args := append([]any{mentionedPersonID}, cursorArgs...)
+	args = append(args, page.Limit+1) // one more to know whether there is a next page
+
+	mentions, err = r.queryPersonMentions(ctx, query, args...)
+	if err != nil {
+		return mentions, info, err
+	}
+
+	mentions, info.Next = cursorPageItems(mentions, page.Limit, func(mention entity.MentionEntry) entity.PageCursor {
+		return entity.PageCursor{CreatedAt: mention.CreatedAt, ID: mention.NoteID}
+	})
+	return mentions, info, nil
+}
+
+func (r *noteRepo) countPersonMentions(ctx context.Context, mentionedPersonID int64) (totalRecords int64, err error) {
 	// Count query - count notes where this person was mentioned
 	countQuery := `
 		SELECT COUNT(*)
@@ -711,44 +881,29 @@ func (r *noteRepo) GetPersonMentions(ctx context.Context, mentionedPersonID int6
 
 	stmt, err := r.db.PrepareContext(ctx, countQuery)
 	if err != nil {
-		return mentions, 0, mysqlutils.HandleMySQLError(err)
+		return 0, mysqlutils.HandleMySQLError(err)
 	}
 	defer stmt.Close()
 
 	row := stmt.QueryRowContext(ctx, mentionedPersonID)
 	err = row.Scan(&totalRecords)
 	if err != nil {
-		return mentions, 0, mysqlutils.HandleMySQLError(err)
+		return 0, mysqlutils.HandleMySQLError(err)
 	}
 
-	// Main query - get notes where this person was mentioned
-	query := `
-		SELECT 
-			n.note_uuid,
-			n.type,
-			n.content,
-			n.feedback_type,
-			n.feedback_category,
-			n.created_at,
-			p.person_uuid as person_id,
-			p.name as person_name
-		FROM tab_note_mention nm
-		INNER JOIN tab_note n ON nm.note_id = n.note_id
-		INNER JOIN tab_person p ON n.person_id = p.person_id
-		WHERE nm.mentioned_person_id = ? AND n.deleted_at IS NULL
-		ORDER BY n.created_at DESC
-		LIMIT ? OFFSET ?
-	`
+	return totalRecords, nil
+}
 
-	stmt2, err := r.db.PrepareContext(ctx, query)
+func (r *noteRepo) queryPersonMentions(ctx context.Context, query string, args ...any) (mentions []entity.MentionEntry, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
 	if err != nil {
-		return mentions, totalRecords, mysqlutils.HandleMySQLError(err)
+		return mentions, mysqlutils.HandleMySQLError(err)
 	}
-	defer stmt2.Close()
+	defer stmt.Close()
 
-	rows, err := stmt2.QueryContext(ctx, mentionedPersonID, take, skip)
+	rows, err := stmt.QueryContext(ctx, args...)
 	if err != nil {
-		return mentions, totalRecords, mysqlutils.HandleMySQLError(err)
+		return mentions, mysqlutils.HandleMySQLError(err)
 	}
 	defer rows.Close()
 
@@ -757,12 +912,12 @@ func (r *noteRepo) GetPersonMentions(ctx context.Context, mentionedPersonID int6
 		var feedbackType, feedbackCategory sql.NullString
 
 		err = rows.Scan(
-			&mention.UUID, &mention.Type, &mention.Content,
+			&mention.NoteID, &mention.UUID, &mention.Type, &mention.Content,
 			&feedbackType, &feedbackCategory, &mention.CreatedAt,
 			&mention.PersonID, &mention.PersonName,
 		)
 		if err != nil {
-			return mentions, totalRecords, mysqlutils.HandleMySQLError(err)
+			return mentions, mysqlutils.HandleMySQLError(err)
 		}
 
 		// Handle nullable fields
@@ -780,10 +935,10 @@ func (r *noteRepo) GetPersonMentions(ctx context.Context, mentionedPersonID int6
 	}
 
 	if err = rows.Err(); err != nil {
-		return mentions, totalRecords, mysqlutils.HandleMySQLError(err)
+		return mentions, mysqlutils.HandleMySQLError(err)
 	}
 
-	return mentions, totalRecords, nil
+	return mentions, nil
 }
 
 func (r *noteRepo) DeleteMentionsByNote(ctx context.Context, noteID int64) (err error) {
@@ -802,95 +957,3 @@ func (r *noteRepo) DeleteMentionsByNote(ctx context.Context, noteID int64) (err
 
 	return nil
 }
-
-func (r *noteRepo) GetOneOnOnesCountThisMonth(ctx context.Context, companyID int64) (count int64, err error) {
-	query := `
-		SELECT COUNT(*) 
-		FROM tab_note n
-		INNER JOIN tab_person p ON n.person_id = p.person_id
-		WHERE p.company_id = ? 
-		AND p.active = 1
-		AND n.type = ?
-		AND n.deleted_at IS NULL
-		AND YEAR(n.created_at) = YEAR(NOW()) 
-		AND MONTH(n.created_at) = MONTH(NOW())
-	`
-
-	stmt, err := r.db.PrepareContext(ctx, query)
-	if err != nil {
-		return count, mysqlutils.HandleMySQLError(err)
-	}
-	defer stmt.Close()
-
-	row := stmt.QueryRowContext(ctx, companyID, domain.NoteTypeOneOnOne)
-	err = row.Scan(&count)
-	if err != nil {
-		return count, mysqlutils.HandleMySQLError(err)
-	}
-
-	return count, nil
-}
-
-func (r *noteRepo) GetAverageFrequencyDays(ctx context.Context, companyID int64) (avgDays float64, err error) {
-	query := `
-		SELECT COALESCE(AVG(day_diff), 0) as avg_frequency
-		FROM (
-			SELECT DATEDIFF(
-				LEAD(n.created_at) OVER (PARTITION BY n.person_id ORDER BY n.created_at),
-				n.created_at
-			) as day_diff
-			FROM tab_note n
-			INNER JOIN tab_person p ON n.person_id = p.person_id
-			WHERE p.company_id = ? 
-			AND p.active = 1
-			AND n.type = ?
-			AND n.deleted_at IS NULL
-		) as frequency_data
-		WHERE day_diff IS NOT NULL
-	`
-
-	stmt, err := r.db.PrepareContext(ctx, query)
-	if err != nil {
-		return avgDays, mysqlutils.HandleMySQLError(err)
-	}
-	defer stmt.Close()
-
-	row := stmt.QueryRowContext(ctx, companyID, domain.NoteTypeOneOnOne)
-	err = row.Scan(&avgDays)
-	if err != nil {
-		return avgDays, mysqlutils.HandleMySQLError(err)
-	}
-
-	return avgDays, nil
-}
-
-func (r *noteRepo) GetLastMeetingDate(ctx context.Context, companyID int64) (lastDate *time.Time, err error) {
-	query := `
-		SELECT MAX(n.created_at) as last_meeting_date
-		FROM tab_note n
-		INNER JOIN tab_person p ON n.person_id = p.person_id
-		WHERE p.company_id = ? 
-		AND p.active = 1
-		AND n.type = ?
-		AND n.deleted_at IS NULL
-	`
-
-	stmt, err := r.db.PrepareContext(ctx, query)
-	if err != nil {
-		return lastDate, mysqlutils.HandleMySQLError(err)
-	}
-	defer stmt.Close()
-
-	var nullableDate sql.NullTime
-	row := stmt.QueryRowContext(ctx, companyID, domain.NoteTypeOneOnOne)
-	err = row.Scan(&nullableDate)
-	if err != nil {
-		return lastDate, mysqlutils.HandleMySQLError(err)
-	}
-
-	if nullableDate.Valid {
-		lastDate = &nullableDate.Time
-	}
-
-	return lastDate, nil
-}
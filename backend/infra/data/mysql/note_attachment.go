@@ -0,0 +1,228 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+const noteAttachmentSelectBase string = `
+	SELECT
+		a.attachment_id,
+		a.attachment_uuid,
+		a.note_id,
+		a.company_id,
+		a.user_id,
+		u.name,
+		a.file_name,
+		a.content_type,
+		a.size_bytes,
+		a.storage_key,
+		a.created_at,
+		a.deleted_at
+
+	FROM tab_note_attachment a
+	INNER JOIN tab_user u ON u.user_id = a.user_id
+`
+
+func (r *noteRepo) parseNoteAttachment(row scanner) (attachment entity.NoteAttachment, err error) {
+	err = row.Scan(
+		&attachment.ID,
+		&attachment.UUID,
+		&attachment.NoteID,
+		&attachment.CompanyID,
+		&attachment.UserID,
+		&attachment.UserName,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.SizeBytes,
+		&attachment.StorageKey,
+		&attachment.CreatedAt,
+		&attachment.DeletedAt,
+	)
+	if err != nil {
+		return attachment, err
+	}
+
+	return attachment, nil
+}
+
+func (r *noteRepo) queryNoteAttachments(ctx context.Context, query string, args ...any) (attachments []entity.NoteAttachment, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return attachments, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return attachments, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		attachment, err := r.parseNoteAttachment(rows)
+		if err != nil {
+			return attachments, mysqlutils.HandleMySQLError(err)
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	if err = rows.Err(); err != nil {
+		return attachments, mysqlutils.HandleMySQLError(err)
+	}
+
+	return attachments, nil
+}
+
+func (r *noteRepo) CreateNoteAttachment(ctx context.Context, attachment entity.NoteAttachment) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_note_attachment (
+			attachment_uuid,
+			note_id,
+			company_id,
+			user_id,
+			file_name,
+			content_type,
+			size_bytes,
+			storage_key
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		attachment.UUID,
+		attachment.NoteID,
+		attachment.CompanyID,
+		attachment.UserID,
+		attachment.FileName,
+		attachment.ContentType,
+		attachment.SizeBytes,
+		attachment.StorageKey,
+	)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+func (r *noteRepo) GetNoteAttachmentByUUID(ctx context.Context, attachmentUUID string) (attachment entity.NoteAttachment, err error) {
+	query := noteAttachmentSelectBase + `
+		WHERE a.attachment_uuid = ?
+		  AND a.deleted_at IS NULL
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return attachment, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, attachmentUUID)
+	attachment, err = r.parseNoteAttachment(row)
+	if err != nil {
+		return attachment, mysqlutils.HandleMySQLError(err)
+	}
+
+	return attachment, nil
+}
+
+func (r *noteRepo) GetNoteAttachments(ctx context.Context, noteID int64) (attachments []entity.NoteAttachment, err error) {
+	query := noteAttachmentSelectBase + `
+		WHERE a.note_id = ?
+		  AND a.deleted_at IS NULL
+		ORDER BY a.created_at ASC, a.attachment_id ASC
+	`
+
+	return r.queryNoteAttachments(ctx, query, noteID)
+}
+
+func (r *noteRepo) CountNoteAttachments(ctx context.Context, noteID int64) (count int64, err error) {
+	query := `
+		SELECT COUNT(*)
+		FROM tab_note_attachment
+		WHERE note_id = ?
+		  AND deleted_at IS NULL
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return count, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, noteID).Scan(&count)
+	if err != nil {
+		return count, mysqlutils.HandleMySQLError(err)
+	}
+
+	return count, nil
+}
+
+func (r *noteRepo) DeleteNoteAttachment(ctx context.Context, attachmentID int64) (err error) {
+	query := `
+		UPDATE tab_note_attachment
+		SET deleted_at = NOW()
+		WHERE attachment_id = ? AND deleted_at IS NULL
+	`
+
+	return r.execNoteAttachment(ctx, query, attachmentID)
+}
+
+func (r *noteRepo) GetExpiredNoteAttachments(ctx context.Context, noteDeletedBefore time.Time, limit int) (attachments []entity.NoteAttachment, err error) {
+	query := noteAttachmentSelectBase + `
+		INNER JOIN tab_note n ON n.note_id = a.note_id
+		WHERE a.deleted_at IS NOT NULL
+		   OR n.deleted_at < ?
+		ORDER BY a.attachment_id ASC
+		LIMIT ?
+	`
+
+	return r.queryNoteAttachments(ctx, query, noteDeletedBefore, limit)
+}
+
+func (r *noteRepo) PurgeNoteAttachment(ctx context.Context, attachmentID int64) (err error) {
+	query := `DELETE FROM tab_note_attachment WHERE attachment_id = ?`
+
+	return r.execNoteAttachment(ctx, query, attachmentID)
+}
+
+// execNoteAttachment runs a statement changing one attachment, returning sql.ErrNoRows when it was not changed
+func (r *noteRepo) execNoteAttachment(ctx context.Context, query string, attachmentID int64) (err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, attachmentID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
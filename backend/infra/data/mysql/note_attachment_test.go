@@ -0,0 +1,134 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+func TestNoteAttachments(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+
+	createNote := func() entity.Note {
+		note := entity.Note{
+			UUID:      uuid.NewV4().String(),
+			CompanyID: person.CompanyID,
+			PersonID:  person.ID,
+			UserID:    person.CreatedBy,
+			Type:      domain.NoteTypeOneOnOne,
+			Content:   "Reviewed the PR together",
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+		noteID, err := testMysql.Note().CreateNote(ctx, note)
+		require.NoError(t, err)
+		note.ID = noteID
+		return note
+	}
+
+	createAttachment := func(note entity.Note, fileName string) entity.NoteAttachment {
+		attachment := entity.NoteAttachment{
+			UUID:        uuid.NewV4().String(),
+			NoteID:      note.ID,
+			CompanyID:   note.CompanyID,
+			UserID:      person.CreatedBy,
+			FileName:    fileName,
+			ContentType: "image/png",
+			SizeBytes:   1024,
+		}
+		attachment.StorageKey = "notes/" + attachment.UUID
+		id, err := testMysql.Note().CreateNoteAttachment(ctx, attachment)
+		require.NoError(t, err)
+		attachment.ID = id
+		return attachment
+	}
+
+	note := createNote()
+	first := createAttachment(note, "review.png")
+	second := createAttachment(note, "board.png")
+
+	t.Run("Should return the attachments of the note", func(t *testing.T) {
+		attachments, err := testMysql.Note().GetNoteAttachments(ctx, note.ID)
+		require.NoError(t, err)
+		require.Len(t, attachments, 2)
+		require.Equal(t, first.UUID, attachments[0].UUID)
+		require.NotEmpty(t, attachments[0].UserName)
+
+		attachment, err := testMysql.Note().GetNoteAttachmentByUUID(ctx, second.UUID)
+		require.NoError(t, err)
+		require.Equal(t, "board.png", attachment.FileName)
+		require.Equal(t, int64(1024), attachment.SizeBytes)
+		require.Equal(t, second.StorageKey, attachment.StorageKey)
+
+		count, err := testMysql.Note().CountNoteAttachments(ctx, note.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), count)
+	})
+
+	t.Run("Should hide the deleted attachment until it is purged", func(t *testing.T) {
+		require.NoError(t, testMysql.Note().DeleteNoteAttachment(ctx, first.ID))
+		require.ErrorIs(t, testMysql.Note().DeleteNoteAttachment(ctx, first.ID), sql.ErrNoRows)
+
+		_, err := testMysql.Note().GetNoteAttachmentByUUID(ctx, first.UUID)
+		require.Error(t, err)
+
+		count, err := testMysql.Note().CountNoteAttachments(ctx, note.ID)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), count)
+	})
+
+	t.Run("Should return the attachments to clean up", func(t *testing.T) {
+		deletedNote := createNote()
+		ofDeletedNote := createAttachment(deletedNote, "old.pdf")
+		require.NoError(t, testMysql.Note().DeleteNote(ctx, deletedNote.ID))
+
+		expiredUUIDs := func(noteDeletedBefore time.Time) []string {
+			attachments, err := testMysql.Note().GetExpiredNoteAttachments(ctx, noteDeletedBefore, 1000)
+			require.NoError(t, err)
+
+			var uuids []string
+			for _, attachment := range attachments {
+				if attachment.CompanyID == person.CompanyID {
+					uuids = append(uuids, attachment.UUID)
+				}
+			}
+			return uuids
+		}
+
+		// the note can still be restored
+		require.Equal(t, []string{first.UUID}, expiredUUIDs(time.Now().Add(-time.Hour)))
+		require.Equal(t, []string{first.UUID, ofDeletedNote.UUID}, expiredUUIDs(time.Now().Add(time.Hour)))
+
+		require.NoError(t, testMysql.Note().PurgeNoteAttachment(ctx, first.ID))
+		require.ErrorIs(t, testMysql.Note().PurgeNoteAttachment(ctx, first.ID), sql.ErrNoRows)
+		require.Equal(t, []string{ofDeletedNote.UUID}, expiredUUIDs(time.Now().Add(time.Hour)))
+	})
+}
+
+// Error tests with mocks
+func TestCreateNoteAttachmentErrorsWithMock(t *testing.T) {
+	testForInsertErrorsWithMock(t, func(db *sql.DB) error {
+		_, err := newNoteRepo(db).CreateNoteAttachment(context.Background(), entity.NoteAttachment{})
+		return err
+	})
+}
+
+func TestGetNoteAttachmentByUUIDErrorsWithMock(t *testing.T) {
+	testForSelectErrorsWithMock(t, "attachment_id", func(db *sql.DB) error {
+		_, err := newNoteRepo(db).GetNoteAttachmentByUUID(context.Background(), "attachment-uuid")
+		return err
+	})
+}
+
+func TestDeleteNoteAttachmentErrorsWithMock(t *testing.T) {
+	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
+		return newNoteRepo(db).DeleteNoteAttachment(context.Background(), 1)
+	})
+}
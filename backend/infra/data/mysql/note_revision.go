@@ -0,0 +1,239 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+const noteRevisionSelectBase string = `
+	SELECT
+		r.revision_id,
+		r.revision_uuid,
+		r.note_id,
+		r.revision_number,
+		r.user_id,
+		u.name,
+		r.type,
+		r.content,
+		r.feedback_type,
+		r.feedback_category,
+		r.restored_from,
+		r.created_at
+
+	FROM tab_note_revision r
+	INNER JOIN tab_user u ON u.user_id = r.user_id
+`
+
+func (r *noteRepo) parseNoteRevision(row scanner) (revision entity.NoteRevision, err error) {
+	var restoredFrom sql.NullInt64
+
+	err = row.Scan(
+		&revision.ID,
+		&revision.UUID,
+		&revision.NoteID,
+		&revision.Number,
+		&revision.UserID,
+		&revision.UserName,
+		&revision.Type,
+		&revision.Content,
+		&revision.FeedbackType,
+		&revision.FeedbackCategory,
+		&restoredFrom,
+		&revision.CreatedAt,
+	)
+	if err != nil {
+		return revision, err
+	}
+
+	if restoredFrom.Valid {
+		number := int(restoredFrom.Int64)
+		revision.RestoredFrom = &number
+	}
+
+	return revision, nil
+}
+
+func (r *noteRepo) CreateNoteRevision(ctx context.Context, revision entity.NoteRevision) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_note_revision (
+			revision_uuid,
+			note_id,
+			revision_number,
+			user_id,
+			type,
+			content,
+			feedback_type,
+			feedback_category,
+			restored_from
+		)
+		SELECT ?, ?, COALESCE(MAX(revision_number), 0) + 1, ?, ?, ?, ?, ?, ?
+		FROM tab_note_revision
+		WHERE note_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		revision.UUID,
+		revision.NoteID,
+		revision.UserID,
+		revision.Type,
+		revision.Content,
+		revision.FeedbackType,
+		revision.FeedbackCategory,
+		revision.RestoredFrom,
+		revision.NoteID,
+	)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+func (r *noteRepo) GetNoteRevisions(ctx context.Context, noteID int64) (revisions []entity.NoteRevision, err error) {
+	query := noteRevisionSelectBase + `
+		WHERE r.note_id = ?
+		ORDER BY r.revision_number DESC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return revisions, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, noteID)
+	if err != nil {
+		return revisions, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		revision, err := r.parseNoteRevision(rows)
+		if err != nil {
+			return revisions, mysqlutils.HandleMySQLError(err)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err = rows.Err(); err != nil {
+		return revisions, mysqlutils.HandleMySQLError(err)
+	}
+
+	return revisions, nil
+}
+
+func (r *noteRepo) GetNoteRevisionByNumber(ctx context.Context, noteID int64, number int) (revision entity.NoteRevision, err error) {
+	query := noteRevisionSelectBase + `
+		WHERE r.note_id = ?
+		  AND r.revision_number = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return revision, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, noteID, number)
+	revision, err = r.parseNoteRevision(row)
+	if err != nil {
+		return revision, mysqlutils.HandleMySQLError(err)
+	}
+
+	return revision, nil
+}
+
+func (r *noteRepo) GetDeletedNoteByUUID(ctx context.Context, noteUUID string) (note entity.Note, err error) {
+	query := `
+		SELECT
+			note_id,
+			note_uuid,
+			company_id,
+			person_id,
+			user_id,
+			type,
+			content,
+			feedback_type,
+			feedback_category,
+			template_id,
+			created_at,
+			updated_at,
+			deleted_at
+
+		FROM tab_note
+		WHERE note_uuid = ?
+		  AND deleted_at IS NOT NULL
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return note, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, noteUUID)
+	err = row.Scan(
+		&note.ID,
+		&note.UUID,
+		&note.CompanyID,
+		&note.PersonID,
+		&note.UserID,
+		&note.Type,
+		&note.Content,
+		&note.FeedbackType,
+		&note.FeedbackCategory,
+		&note.TemplateID,
+		&note.CreatedAt,
+		&note.UpdatedAt,
+		&note.DeletedAt,
+	)
+	if err != nil {
+		return note, mysqlutils.HandleMySQLError(err)
+	}
+
+	return note, nil
+}
+
+func (r *noteRepo) UndeleteNote(ctx context.Context, noteID int64) (err error) {
+	query := `
+		UPDATE tab_note
+		SET deleted_at = NULL
+		WHERE note_id = ? AND deleted_at IS NOT NULL
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, noteID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+func TestNoteRevisions(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+
+	note := entity.Note{
+		UUID:      uuid.NewV4().String(),
+		CompanyID: person.CompanyID,
+		PersonID:  person.ID,
+		UserID:    person.CreatedBy,
+		Type:      domain.NoteTypeObservation,
+		Content:   "First version",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	noteID, err := testMysql.Note().CreateNote(ctx, note)
+	require.NoError(t, err)
+
+	for _, content := range []string{"First version", "Second version"} {
+		_, err = testMysql.Note().CreateNoteRevision(ctx, entity.NoteRevision{
+			UUID:    uuid.NewV4().String(),
+			NoteID:  noteID,
+			UserID:  person.CreatedBy,
+			Type:    domain.NoteTypeObservation,
+			Content: content,
+		})
+		require.NoError(t, err)
+	}
+
+	restoredFrom := 1
+	_, err = testMysql.Note().CreateNoteRevision(ctx, entity.NoteRevision{
+		UUID:         uuid.NewV4().String(),
+		NoteID:       noteID,
+		UserID:       person.CreatedBy,
+		Type:         domain.NoteTypeObservation,
+		Content:      "First version",
+		RestoredFrom: &restoredFrom,
+	})
+	require.NoError(t, err)
+
+	revisions, err := testMysql.Note().GetNoteRevisions(ctx, noteID)
+	require.NoError(t, err)
+	require.Len(t, revisions, 3)
+	require.Equal(t, 3, revisions[0].Number)
+	require.Equal(t, 1, *revisions[0].RestoredFrom)
+	require.Nil(t, revisions[1].RestoredFrom)
+	require.NotEmpty(t, revisions[0].UserName)
+
+	revision, err := testMysql.Note().GetNoteRevisionByNumber(ctx, noteID, 2)
+	require.NoError(t, err)
+	require.Equal(t, "Second version", revision.Content)
+	require.Nil(t, revision.FeedbackType)
+
+	_, err = testMysql.Note().GetNoteRevisionByNumber(ctx, noteID, 4)
+	require.Error(t, err)
+}
+
+func TestUndeleteNote(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+
+	note := entity.Note{
+		UUID:      uuid.NewV4().String(),
+		CompanyID: person.CompanyID,
+		PersonID:  person.ID,
+		UserID:    person.CreatedBy,
+		Type:      domain.NoteTypeOneOnOne,
+		Content:   "Talked about the roadmap",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	noteID, err := testMysql.Note().CreateNote(ctx, note)
+	require.NoError(t, err)
+
+	_, err = testMysql.Note().GetDeletedNoteByUUID(ctx, note.UUID)
+	require.Error(t, err)
+
+	err = testMysql.Note().DeleteNote(ctx, noteID)
+	require.NoError(t, err)
+
+	deleted, err := testMysql.Note().GetDeletedNoteByUUID(ctx, note.UUID)
+	require.NoError(t, err)
+	require.Equal(t, noteID, deleted.ID)
+	require.NotNil(t, deleted.DeletedAt)
+
+	err = testMysql.Note().UndeleteNote(ctx, noteID)
+	require.NoError(t, err)
+
+	_, err = testMysql.Note().GetNoteByUUID(ctx, note.UUID)
+	require.NoError(t, err)
+
+	err = testMysql.Note().UndeleteNote(ctx, noteID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+// Error tests with mocks
+func TestCreateNoteRevisionErrorsWithMock(t *testing.T) {
+	testForInsertErrorsWithMock(t, func(db *sql.DB) error {
+		_, err := newNoteRepo(db).CreateNoteRevision(context.Background(), entity.NoteRevision{})
+		return err
+	})
+}
+
+func TestGetNoteRevisionByNumberErrorsWithMock(t *testing.T) {
+	testForSelectErrorsWithMock(t, "revision_id", func(db *sql.DB) error {
+		_, err := newNoteRepo(db).GetNoteRevisionByNumber(context.Background(), 1, 1)
+		return err
+	})
+}
+
+func TestUndeleteNoteErrorsWithMock(t *testing.T) {
+	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
+		return newNoteRepo(db).UndeleteNote(context.Background(), 1)
+	})
+}
@@ -0,0 +1,167 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"unicode"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/text"
+)
+
+type noteSearchEngine struct {
+	db *sql.DB
+}
+
+// NewNoteSearchEngine returns the note search backed by the FULLTEXT indexes of tab_note and tab_person
+func NewNoteSearchEngine(db *sql.DB) contract.NoteSearchEngine {
+	return &noteSearchEngine{
+		db: db,
+	}
+}
+
+// booleanModeQuery turns the search into a FULLTEXT boolean mode query where any term matches, also as a
+// prefix ("migr" finds "migration"). The operators of the boolean mode typed by the user are removed
+func booleanModeQuery(search string) string {
+	terms := strings.FieldsFunc(text.Normalize(search), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for i, term := range terms {
+		terms[i] = term + "*"
+	}
+
+	return strings.Join(terms, " ")
+}
+
+// noteSearchScore sums the relevance of the content and feedback category, of the name of the person and
+// of the names of the mentioned people, so a note matching more of them comes first
+const noteSearchScore string = `
+	MATCH(n.content, n.feedback_category) AGAINST(? IN BOOLEAN MODE)
+	+ MATCH(p.name) AGAINST(? IN BOOLEAN MODE)
+	+ EXISTS (
+		SELECT 1
+		FROM tab_note_mention nm
+		INNER JOIN tab_person mp ON mp.person_id = nm.mentioned_person_id
+		WHERE nm.note_id = n.note_id
+		  AND MATCH(mp.name) AGAINST(? IN BOOLEAN MODE)
+	)`
+
+func (e *noteSearchEngine) SearchNotes(ctx context.Context, search entity.NoteSearchQuery) (hits []entity.NoteSearchHit, totalRecords int64, err error) {
+	against := booleanModeQuery(search.Text)
+	if against == "" {
+		return hits, 0, nil
+	}
+
+	query := `
+		SELECT * FROM (
+			SELECT
+				n.note_uuid,
+				n.type,
+				n.content,
+				n.feedback_type,
+				n.feedback_category,
+				p.person_uuid,
+				p.name AS person_name,
+				u.user_uuid,
+				COALESCE(n.respondent_name, u.name) AS author_name,
+				n.created_at,
+				` + noteSearchScore + ` AS score
+
+			FROM tab_note n
+			INNER JOIN tab_person p ON p.person_id = n.person_id
+			INNER JOIN tab_user u ON u.user_id = n.user_id
+			WHERE n.company_id = ?
+			  AND n.deleted_at IS NULL`
+
+	args := []any{against, against, against, search.CompanyID}
+
+	if search.PersonID != nil {
+		query += `
+			  AND (
+				n.person_id = ?
+				OR EXISTS (SELECT 1 FROM tab_note_mention fm WHERE fm.note_id = n.note_id AND fm.mentioned_person_id = ?)
+			  )`
+		args = append(args, *search.PersonID, *search.PersonID)
+	}
+
+	if search.AuthorUserID != nil {
+		query += ` AND n.user_id = ?`
+		args = append(args, *search.AuthorUserID)
+	}
+
+	if len(search.Types) > 0 {
+		placeholders := make([]string, len(search.Types))
+		for i, noteType := range search.Types {
+			placeholders[i] = "?"
+			args = append(args, noteType)
+		}
+		query += ` AND n.type IN (` + strings.Join(placeholders, ",") + `)`
+	}
+
+	if search.From != nil {
+		query += ` AND n.created_at >= ?`
+		args = append(args, *search.From)
+	}
+	if search.To != nil {
+		query += ` AND n.created_at < ?`
+		args = append(args, *search.To)
+	}
+
+	query += `
+		) s
+		WHERE s.score > 0`
+
+	totalRecords, err = getTotalRecordsFromQuery(ctx, e.db, query, args...)
+	if err != nil {
+		return hits, totalRecords, mysqlutils.HandleMySQLError(err)
+	}
+
+	query += ` ORDER BY s.score DESC, s.created_at DESC`
+	if search.Take > 0 {
+		query += ` LIMIT ? OFFSET ?`
+		args = append(args, search.Take, search.Skip)
+	}
+
+	stmt, err := e.db.PrepareContext(ctx, query)
+	if err != nil {
+		return hits, totalRecords, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return hits, totalRecords, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var hit entity.NoteSearchHit
+		err = rows.Scan(
+			&hit.NoteUUID,
+			&hit.Type,
+			&hit.Content,
+			&hit.FeedbackType,
+			&hit.FeedbackCategory,
+			&hit.PersonUUID,
+			&hit.PersonName,
+			&hit.AuthorUUID,
+			&hit.AuthorName,
+			&hit.CreatedAt,
+			&hit.Score,
+		)
+		if err != nil {
+			return hits, totalRecords, mysqlutils.HandleMySQLError(err)
+		}
+		hits = append(hits, hit)
+	}
+
+	if err = rows.Err(); err != nil {
+		return hits, totalRecords, mysqlutils.HandleMySQLError(err)
+	}
+
+	return hits, totalRecords, nil
+}
@@ -0,0 +1,111 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+// randomSearchWord returns a word made only of letters, so the FULLTEXT parser keeps it as a single token
+func randomSearchWord() string {
+	return "zq" + strings.Map(func(r rune) rune {
+		switch {
+		case r >= '0' && r <= '9':
+			return 'a' + (r - '0')
+		case r == '-':
+			return -1
+		default:
+			return r
+		}
+	}, uuid.NewV4().String())
+}
+
+func Test_booleanModeQuery(t *testing.T) {
+	require.Equal(t, "migration* projeto*", booleanModeQuery(`+Migration -"projeto"`))
+	require.Equal(t, "maria* acme* com*", booleanModeQuery("maria@acme.com"))
+	require.Empty(t, booleanModeQuery(" *() "))
+}
+
+func TestNoteSearchEngine_SearchNotes(t *testing.T) {
+	ctx := context.Background()
+	engine := NewNoteSearchEngine(testMysql.(*MysqlConn).DB())
+	person := createRandomPerson(t)
+	word := randomSearchWord()
+
+	createNote := func(noteType, content string, createdAt time.Time) entity.Note {
+		note := entity.Note{
+			UUID:      uuid.NewV4().String(),
+			CompanyID: person.CompanyID,
+			PersonID:  person.ID,
+			UserID:    person.CreatedBy,
+			Type:      noteType,
+			Content:   content,
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		}
+		noteID, err := testMysql.Note().CreateNote(ctx, note)
+		require.NoError(t, err)
+		note.ID = noteID
+		return note
+	}
+
+	now := time.Now().Truncate(time.Second)
+	once := createNote(domain.NoteTypeOneOnOne, "Talked about the "+word+" project", now.Add(-48*time.Hour))
+	twice := createNote(domain.NoteTypeObservation, word+" is late, the "+word+" needs help", now.Add(-time.Hour))
+	deleted := createNote(domain.NoteTypeObservation, "Deleted "+word, now)
+	require.NoError(t, testMysql.Note().DeleteNote(ctx, deleted.ID))
+	createNote(domain.NoteTypeObservation, "Unrelated note", now)
+
+	t.Run("Should return the matching notes of the company, most relevant first", func(t *testing.T) {
+		hits, totalRecords, err := engine.SearchNotes(ctx, entity.NoteSearchQuery{CompanyID: person.CompanyID, Text: word, Take: 10})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), totalRecords)
+		require.Len(t, hits, 2)
+		require.Equal(t, twice.UUID, hits[0].NoteUUID)
+		require.Equal(t, once.UUID, hits[1].NoteUUID)
+		require.Greater(t, hits[0].Score, hits[1].Score)
+		require.Equal(t, person.UUID, hits[0].PersonUUID)
+		require.Equal(t, person.Name, hits[0].PersonName)
+		require.NotEmpty(t, hits[0].AuthorUUID)
+	})
+
+	t.Run("Should match a prefix of the words", func(t *testing.T) {
+		hits, _, err := engine.SearchNotes(ctx, entity.NoteSearchQuery{CompanyID: person.CompanyID, Text: word[:len(word)-4], Take: 10})
+		require.NoError(t, err)
+		require.Len(t, hits, 2)
+	})
+
+	t.Run("Should apply the filters", func(t *testing.T) {
+		from := now.Add(-24 * time.Hour)
+		hits, totalRecords, err := engine.SearchNotes(ctx, entity.NoteSearchQuery{
+			CompanyID:    person.CompanyID,
+			Text:         word,
+			PersonID:     &person.ID,
+			AuthorUserID: &person.CreatedBy,
+			Types:        []string{domain.NoteTypeObservation},
+			From:         &from,
+			Take:         10,
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(1), totalRecords)
+		require.Equal(t, twice.UUID, hits[0].NoteUUID)
+
+		otherCompany := person.CompanyID + 1000000
+		hits, totalRecords, err = engine.SearchNotes(ctx, entity.NoteSearchQuery{CompanyID: otherCompany, Text: word, Take: 10})
+		require.NoError(t, err)
+		require.Zero(t, totalRecords)
+		require.Empty(t, hits)
+	})
+
+	t.Run("Should find the notes by the name of the person", func(t *testing.T) {
+		hits, _, err := engine.SearchNotes(ctx, entity.NoteSearchQuery{CompanyID: person.CompanyID, Text: person.Name, Take: 10})
+		require.NoError(t, err)
+		require.Len(t, hits, 3)
+	})
+}
@@ -0,0 +1,294 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type noteTemplateRepo struct {
+	db dbConn
+}
+
+func newNoteTemplateRepo(db dbConn) contract.NoteTemplateRepo {
+	return &noteTemplateRepo{
+		db: db,
+	}
+}
+
+// insert runs an insert, returning the id of the created row
+func (r *noteTemplateRepo) insert(ctx context.Context, query string, args ...any) (createdID int64, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+const noteTemplateSelectBase string = `
+	SELECT
+		t.template_id,
+		t.template_uuid,
+		t.company_id,
+		t.user_id,
+		t.note_type,
+		t.name,
+		t.description,
+		t.created_at,
+		t.updated_at
+
+	FROM tab_note_template t
+`
+
+func (r *noteTemplateRepo) parseTemplate(row scanner, extra ...any) (template entity.NoteTemplate, err error) {
+	var companyID, userID sql.NullInt64
+	var description sql.NullString
+
+	dest := []any{
+		&template.ID,
+		&template.UUID,
+		&companyID,
+		&userID,
+		&template.NoteType,
+		&template.Name,
+		&description,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	}
+
+	err = row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return template, err
+	}
+
+	if companyID.Valid {
+		template.CompanyID = &companyID.Int64
+	}
+	if userID.Valid {
+		template.UserID = &userID.Int64
+	}
+	template.Description = description.String
+
+	return template, nil
+}
+
+func (r *noteTemplateRepo) CreateTemplate(ctx context.Context, template entity.NoteTemplate) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_note_template (
+			template_uuid,
+			company_id,
+			user_id,
+			note_type,
+			name,
+			description
+		) VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	return r.insert(ctx, query,
+		template.UUID,
+		template.CompanyID,
+		template.UserID,
+		template.NoteType,
+		template.Name,
+		nullableString(template.Description),
+	)
+}
+
+func (r *noteTemplateRepo) GetTemplateByUUID(ctx context.Context, templateUUID string) (template entity.NoteTemplate, err error) {
+	query := noteTemplateSelectBase + `
+		WHERE t.template_uuid = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return template, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, templateUUID)
+	template, err = r.parseTemplate(row)
+	if err != nil {
+		return template, mysqlutils.HandleMySQLError(err)
+	}
+
+	return template, nil
+}
+
+func (r *noteTemplateRepo) GetAvailableTemplates(ctx context.Context, companyID, userID int64) (templates []entity.NoteTemplate, err error) {
+	query := `
+		SELECT
+			t.template_id,
+			t.template_uuid,
+			t.company_id,
+			t.user_id,
+			t.note_type,
+			t.name,
+			t.description,
+			t.created_at,
+			t.updated_at,
+			(
+				SELECT COUNT(*)
+				FROM tab_note n
+				WHERE n.template_id = t.template_id
+				  AND n.company_id = ?
+				  AND n.deleted_at IS NULL
+			) AS usage_count
+
+		FROM tab_note_template t
+		WHERE t.company_id IS NULL
+		   OR (t.company_id = ? AND (t.user_id IS NULL OR t.user_id = ?))
+		ORDER BY t.company_id IS NULL ASC, t.note_type ASC, t.name ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return templates, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, companyID, companyID, userID)
+	if err != nil {
+		return templates, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var usageCount int64
+
+		template, err := r.parseTemplate(rows, &usageCount)
+		if err != nil {
+			return templates, mysqlutils.HandleMySQLError(err)
+		}
+
+		template.UsageCount = usageCount
+		templates = append(templates, template)
+	}
+
+	if err = rows.Err(); err != nil {
+		return templates, mysqlutils.HandleMySQLError(err)
+	}
+
+	return templates, nil
+}
+
+func (r *noteTemplateRepo) DeleteTemplate(ctx context.Context, templateID int64) (err error) {
+	query := `
+		DELETE FROM tab_note_template
+		WHERE template_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, templateID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *noteTemplateRepo) CreateSection(ctx context.Context, section entity.NoteTemplateSection) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_note_template_section (
+			template_id,
+			title,
+			placeholder,
+			content,
+			position
+		)
+		SELECT ?, ?, ?, ?, COALESCE(MAX(position), 0) + 1
+		FROM tab_note_template_section
+		WHERE template_id = ?
+	`
+
+	return r.insert(ctx, query,
+		section.TemplateID,
+		section.Title,
+		nullableString(section.Placeholder),
+		nullableString(section.Content),
+		section.TemplateID,
+	)
+}
+
+func (r *noteTemplateRepo) GetSectionsByTemplate(ctx context.Context, templateID int64) (sections []entity.NoteTemplateSection, err error) {
+	query := `
+		SELECT
+			s.section_id,
+			s.template_id,
+			s.title,
+			s.placeholder,
+			s.content,
+			s.position
+
+		FROM tab_note_template_section s
+		WHERE s.template_id = ?
+		ORDER BY s.position ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return sections, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, templateID)
+	if err != nil {
+		return sections, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var section entity.NoteTemplateSection
+		var placeholder, content sql.NullString
+
+		err = rows.Scan(
+			&section.ID,
+			&section.TemplateID,
+			&section.Title,
+			&placeholder,
+			&content,
+			&section.Position,
+		)
+		if err != nil {
+			return sections, mysqlutils.HandleMySQLError(err)
+		}
+
+		section.Placeholder = placeholder.String
+		section.Content = content.String
+		sections = append(sections, section)
+	}
+
+	if err = rows.Err(); err != nil {
+		return sections, mysqlutils.HandleMySQLError(err)
+	}
+
+	return sections, nil
+}
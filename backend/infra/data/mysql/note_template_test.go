@@ -0,0 +1,137 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+func createRandomNoteTemplate(t *testing.T, companyID int64, userID *int64) entity.NoteTemplate {
+	ctx := context.Background()
+	template := entity.NoteTemplate{
+		UUID:      uuid.NewV4().String(),
+		CompanyID: &companyID,
+		UserID:    userID,
+		NoteType:  domain.NoteTypeOneOnOne,
+		Name:      "Weekly " + uuid.NewV4().String()[:8],
+	}
+
+	templateID, err := testMysql.NoteTemplate().CreateTemplate(ctx, template)
+	require.NoError(t, err)
+	require.NotZero(t, templateID)
+	template.ID = templateID
+
+	for _, title := range []string{"Wins", "Blockers"} {
+		section := entity.NoteTemplateSection{TemplateID: templateID, Title: title, Placeholder: "How is {{person_first_name}}?"}
+
+		section.ID, err = testMysql.NoteTemplate().CreateSection(ctx, section)
+		require.NoError(t, err)
+		template.Sections = append(template.Sections, section)
+	}
+
+	return template
+}
+
+func TestNoteTemplates(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	template := createRandomNoteTemplate(t, person.CompanyID, nil)
+	personal := createRandomNoteTemplate(t, person.CompanyID, &person.CreatedBy)
+
+	result, err := testMysql.NoteTemplate().GetTemplateByUUID(ctx, template.UUID)
+	require.NoError(t, err)
+	require.Equal(t, template.ID, result.ID)
+	require.Equal(t, person.CompanyID, *result.CompanyID)
+	require.Nil(t, result.UserID)
+	require.Empty(t, result.Description)
+
+	sections, err := testMysql.NoteTemplate().GetSectionsByTemplate(ctx, template.ID)
+	require.NoError(t, err)
+	require.Len(t, sections, 2)
+	require.Equal(t, "Wins", sections[0].Title)
+	require.Equal(t, 1, sections[0].Position)
+	require.Equal(t, 2, sections[1].Position)
+	require.Empty(t, sections[1].Content)
+
+	note := entity.Note{
+		UUID:       uuid.NewV4().String(),
+		CompanyID:  person.CompanyID,
+		PersonID:   person.ID,
+		UserID:     person.CreatedBy,
+		Type:       domain.NoteTypeOneOnOne,
+		Content:    "## Wins\nShipped the release",
+		TemplateID: &template.ID,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}
+	noteID, err := testMysql.Note().CreateNote(ctx, note)
+	require.NoError(t, err)
+
+	savedNote, err := testMysql.Note().GetNoteByID(ctx, noteID)
+	require.NoError(t, err)
+	require.Equal(t, template.ID, *savedNote.TemplateID)
+
+	templates, err := testMysql.NoteTemplate().GetAvailableTemplates(ctx, person.CompanyID, person.CreatedBy)
+	require.NoError(t, err)
+
+	found := map[string]entity.NoteTemplate{}
+	builtIn := 0
+	for _, item := range templates {
+		found[item.UUID] = item
+		if item.CompanyID == nil {
+			builtIn++
+		}
+	}
+	require.Equal(t, int64(1), found[template.UUID].UsageCount)
+	require.Equal(t, int64(0), found[personal.UUID].UsageCount)
+	require.Equal(t, 3, builtIn)
+	require.Nil(t, templates[len(templates)-1].CompanyID, "built-in templates come last")
+
+	// Personal templates of other users are not available
+	templates, err = testMysql.NoteTemplate().GetAvailableTemplates(ctx, person.CompanyID, person.CreatedBy+1)
+	require.NoError(t, err)
+	for _, item := range templates {
+		require.NotEqual(t, personal.UUID, item.UUID)
+	}
+
+	err = testMysql.NoteTemplate().DeleteTemplate(ctx, template.ID)
+	require.NoError(t, err)
+
+	_, err = testMysql.NoteTemplate().GetTemplateByUUID(ctx, template.UUID)
+	require.Error(t, err)
+
+	// The note is kept without the template
+	savedNote, err = testMysql.Note().GetNoteByID(ctx, noteID)
+	require.NoError(t, err)
+	require.Nil(t, savedNote.TemplateID)
+
+	err = testMysql.NoteTemplate().DeleteTemplate(ctx, template.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+// Error tests with mocks
+func TestCreateNoteTemplateErrorsWithMock(t *testing.T) {
+	testForInsertErrorsWithMock(t, func(db *sql.DB) error {
+		_, err := newNoteTemplateRepo(db).CreateTemplate(context.Background(), entity.NoteTemplate{})
+		return err
+	})
+}
+
+func TestGetNoteTemplateByUUIDErrorsWithMock(t *testing.T) {
+	testForSelectErrorsWithMock(t, "template_id", func(db *sql.DB) error {
+		_, err := newNoteTemplateRepo(db).GetTemplateByUUID(context.Background(), "template-uuid")
+		return err
+	})
+}
+
+func TestDeleteNoteTemplateErrorsWithMock(t *testing.T) {
+	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
+		return newNoteTemplateRepo(db).DeleteTemplate(context.Background(), 1)
+	})
+}
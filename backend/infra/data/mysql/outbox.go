@@ -0,0 +1,224 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type outboxRepo struct {
+	db dbConn
+}
+
+func newOutboxRepo(db dbConn) contract.OutboxRepo {
+	return &outboxRepo{
+		db: db,
+	}
+}
+
+// update runs an update, returning sql.ErrNoRows when no row was changed
+func (r *outboxRepo) update(ctx context.Context, query string, args ...any) (err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+const outboxEventSelectBase string = `
+	SELECT
+		e.event_id,
+		e.event_uuid,
+		e.event_type,
+		e.payload,
+		e.status,
+		e.attempts,
+		e.max_attempts,
+		e.next_attempt_at,
+		e.last_error,
+		e.created_at,
+		e.processed_at
+
+	FROM tab_outbox_event e
+`
+
+func (r *outboxRepo) parseEvent(row scanner) (event entity.OutboxEvent, err error) {
+	var processedAt sql.NullTime
+
+	err = row.Scan(
+		&event.ID,
+		&event.UUID,
+		&event.EventType,
+		&event.Payload,
+		&event.Status,
+		&event.Attempts,
+		&event.MaxAttempts,
+		&event.NextAttemptAt,
+		&event.LastError,
+		&event.CreatedAt,
+		&processedAt,
+	)
+	if err != nil {
+		return event, err
+	}
+
+	if processedAt.Valid {
+		event.ProcessedAt = &processedAt.Time
+	}
+
+	return event, nil
+}
+
+func (r *outboxRepo) CreateEvent(ctx context.Context, event entity.OutboxEvent) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_outbox_event (
+			event_uuid,
+			event_type,
+			payload,
+			status,
+			max_attempts,
+			next_attempt_at
+		) VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		event.UUID,
+		event.EventType,
+		event.Payload,
+		domain.OutboxStatusPending,
+		event.MaxAttempts,
+		event.NextAttemptAt,
+	)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+func (r *outboxRepo) GetDueEvents(ctx context.Context, now time.Time, limit int) (events []entity.OutboxEvent, err error) {
+	query := outboxEventSelectBase + `
+		WHERE e.status IN (?, ?)
+		  AND e.next_attempt_at <= ?
+		ORDER BY e.next_attempt_at ASC, e.event_id ASC
+		LIMIT ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return events, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, domain.OutboxStatusPending, domain.OutboxStatusProcessing, now, limit)
+	if err != nil {
+		return events, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		event, err := r.parseEvent(rows)
+		if err != nil {
+			return events, mysqlutils.HandleMySQLError(err)
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (r *outboxRepo) ClaimEvent(ctx context.Context, event entity.OutboxEvent, now, leaseUntil time.Time) (err error) {
+	// The attempts of the read event work as a version, so only one worker claims each attempt
+	query := `
+		UPDATE tab_outbox_event
+		SET
+			status = ?,
+			attempts = attempts + 1,
+			next_attempt_at = ?
+		WHERE event_id = ?
+		  AND attempts = ?
+		  AND status IN (?, ?)
+		  AND next_attempt_at <= ?
+	`
+
+	return r.update(ctx, query,
+		domain.OutboxStatusProcessing,
+		leaseUntil,
+		event.ID,
+		event.Attempts,
+		domain.OutboxStatusPending,
+		domain.OutboxStatusProcessing,
+		now,
+	)
+}
+
+func (r *outboxRepo) MarkEventDone(ctx context.Context, eventID int64) (err error) {
+	query := `
+		UPDATE tab_outbox_event
+		SET
+			status = ?,
+			last_error = NULL,
+			processed_at = CURRENT_TIMESTAMP
+		WHERE event_id = ?
+	`
+
+	return r.update(ctx, query, domain.OutboxStatusDone, eventID)
+}
+
+func (r *outboxRepo) MarkEventFailed(ctx context.Context, eventID int64, lastError string, nextAttemptAt *time.Time) (err error) {
+	if nextAttemptAt == nil {
+		query := `
+			UPDATE tab_outbox_event
+			SET
+				status = ?,
+				last_error = ?,
+				processed_at = CURRENT_TIMESTAMP
+			WHERE event_id = ?
+		`
+
+		return r.update(ctx, query, domain.OutboxStatusFailed, lastError, eventID)
+	}
+
+	query := `
+		UPDATE tab_outbox_event
+		SET
+			status = ?,
+			last_error = ?,
+			next_attempt_at = ?
+		WHERE event_id = ?
+	`
+
+	return r.update(ctx, query, domain.OutboxStatusPending, lastError, *nextAttemptAt, eventID)
+}
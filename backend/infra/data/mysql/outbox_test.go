@@ -0,0 +1,123 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+// findDueOutboxEvent returns the event among the due events, which may include events of other tests
+func findDueOutboxEvent(t *testing.T, now time.Time, eventUUID string) (entity.OutboxEvent, bool) {
+	events, err := testMysql.Outbox().GetDueEvents(context.Background(), now, 1000)
+	require.NoError(t, err)
+
+	for _, event := range events {
+		if event.UUID == eventUUID {
+			return event, true
+		}
+	}
+	return entity.OutboxEvent{}, false
+}
+
+func TestOutboxEvents(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+
+	event := entity.OutboxEvent{
+		UUID:          uuid.NewV4().String(),
+		EventType:     domain.OutboxEventNoteAttributesExtraction,
+		Payload:       `{"note_id": 1}`,
+		MaxAttempts:   2,
+		NextAttemptAt: now,
+	}
+	eventID, err := testMysql.Outbox().CreateEvent(ctx, event)
+	require.NoError(t, err)
+	require.NotZero(t, eventID)
+
+	_, found := findDueOutboxEvent(t, now.Add(-time.Minute), event.UUID)
+	require.False(t, found)
+
+	due, found := findDueOutboxEvent(t, now, event.UUID)
+	require.True(t, found)
+	require.Equal(t, eventID, due.ID)
+	require.Equal(t, domain.OutboxStatusPending, due.Status)
+	require.Zero(t, due.Attempts)
+	require.JSONEq(t, event.Payload, due.Payload)
+
+	// Only one worker claims each attempt
+	lease := now.Add(time.Minute)
+	require.NoError(t, testMysql.Outbox().ClaimEvent(ctx, due, now, lease))
+	require.ErrorIs(t, testMysql.Outbox().ClaimEvent(ctx, due, now, lease), sql.ErrNoRows)
+
+	_, found = findDueOutboxEvent(t, now, event.UUID)
+	require.False(t, found)
+
+	// A processing event whose lease expired is due again
+	claimed, found := findDueOutboxEvent(t, lease, event.UUID)
+	require.True(t, found)
+	require.Equal(t, domain.OutboxStatusProcessing, claimed.Status)
+	require.Equal(t, 1, claimed.Attempts)
+
+	retryAt := now.Add(time.Hour)
+	require.NoError(t, testMysql.Outbox().MarkEventFailed(ctx, eventID, "provider unavailable", &retryAt))
+
+	retried, found := findDueOutboxEvent(t, retryAt, event.UUID)
+	require.True(t, found)
+	require.Equal(t, domain.OutboxStatusPending, retried.Status)
+	require.Equal(t, "provider unavailable", *retried.LastError)
+
+	require.NoError(t, testMysql.Outbox().ClaimEvent(ctx, retried, retryAt, retryAt.Add(time.Minute)))
+	require.NoError(t, testMysql.Outbox().MarkEventDone(ctx, eventID))
+
+	_, found = findDueOutboxEvent(t, retryAt.Add(24*time.Hour), event.UUID)
+	require.False(t, found)
+
+	require.ErrorIs(t, testMysql.Outbox().MarkEventDone(ctx, 0), sql.ErrNoRows)
+}
+
+func TestOutboxEventFailedForGood(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+
+	event := entity.OutboxEvent{
+		UUID:          uuid.NewV4().String(),
+		EventType:     domain.OutboxEventNoteAttributesExtraction,
+		Payload:       `{}`,
+		MaxAttempts:   1,
+		NextAttemptAt: now,
+	}
+	eventID, err := testMysql.Outbox().CreateEvent(ctx, event)
+	require.NoError(t, err)
+
+	require.NoError(t, testMysql.Outbox().MarkEventFailed(ctx, eventID, "invalid payload", nil))
+
+	_, found := findDueOutboxEvent(t, now.Add(24*time.Hour), event.UUID)
+	require.False(t, found)
+}
+
+// Error tests with mocks
+func TestCreateOutboxEventErrorsWithMock(t *testing.T) {
+	testForInsertErrorsWithMock(t, func(db *sql.DB) error {
+		_, err := newOutboxRepo(db).CreateEvent(context.Background(), entity.OutboxEvent{})
+		return err
+	})
+}
+
+func TestGetDueOutboxEventsErrorsWithMock(t *testing.T) {
+	testForSelectErrorsWithMock(t, "event_id", func(db *sql.DB) error {
+		_, err := newOutboxRepo(db).GetDueEvents(context.Background(), time.Now(), 10)
+		return err
+	})
+}
+
+func TestMarkOutboxEventDoneErrorsWithMock(t *testing.T) {
+	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
+		return newOutboxRepo(db).MarkEventDone(context.Background(), 1)
+	})
+}
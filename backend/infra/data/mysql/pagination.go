@@ -0,0 +1,30 @@
+package mysql
+
+import "github.com/diegoclair/leaderpro/internal/domain/entity"
+
+// cursorCondition returns the condition of the items after the cursor in a list ordered by the creation time
+// and id columns, in descending order unless ascending. Without a cursor there is no condition
+func cursorCondition(createdAtColumn, idColumn string, after *entity.PageCursor, ascending bool) (condition string, args []any) {
+	if after == nil {
+		return "", nil
+	}
+
+	operator := "<"
+	if ascending {
+		operator = ">"
+	}
+
+	condition = ` AND (` + createdAtColumn + ` ` + operator + ` ? OR (` + createdAtColumn + ` = ? AND ` + idColumn + ` ` + operator + ` ?))`
+	return condition, []any{after.CreatedAt, after.CreatedAt, after.ID}
+}
+
+// cursorPageItems trims the extra item fetched beyond the limit, returning the cursor of the next page when it was there
+func cursorPageItems[T any](items []T, limit int64, cursorOf func(item T) entity.PageCursor) ([]T, *entity.PageCursor) {
+	if limit < 1 || int64(len(items)) <= limit {
+		return items, nil
+	}
+
+	items = items[:limit]
+	next := cursorOf(items[limit-1])
+	return items, &next
+}
@@ -2,8 +2,10 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/diegoclair/go_utils/mysqlutils"
 	"github.com/diegoclair/leaderpro/internal/domain"
@@ -41,12 +43,13 @@ func getPersonSelectBase() string {
 		p.gender,
 		p.interests,
 		p.personality,
+		p.one_on_one_cadence,
+		p.one_on_one_cadence_days,
 		(
-			SELECT MAX(n.created_at) 
-			FROM tab_note n 
-			WHERE n.person_id = p.person_id 
-			AND n.type = '` + domain.NoteTypeOneOnOne + `' 
-			AND n.deleted_at IS NULL
+			SELECT MAX(m.held_at) 
+			FROM tab_meeting m 
+			WHERE m.person_id = p.person_id 
+			AND m.status = '` + domain.MeetingStatusHeld + `'
 		) as last_one_on_one_date,
 		p.created_at,
 		p.updated_at,
@@ -76,6 +79,8 @@ func (r *personRepo) parsePerson(row scanner) (person entity.Person, err error)
 		&person.Gender,
 		&person.Interests,
 		&person.Personality,
+		&person.OneOnOneCadence,
+		&person.OneOnOneCadenceDays,
 		&person.LastOneOnOneDate,
 		&person.CreatedAt,
 		&person.UpdatedAt,
@@ -301,6 +306,29 @@ func (r *personRepo) DeletePerson(ctx context.Context, personID int64) (err erro
 	return nil
 }
 
+func (r *personRepo) ReactivatePerson(ctx context.Context, personID int64) (err error) {
+	query := `
+		UPDATE tab_person
+		SET 
+			active = 1,
+			updated_at = NOW()
+		WHERE person_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, personID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
 func (r *personRepo) SearchPeople(ctx context.Context, companyID int64, search string) (people []entity.Person, err error) {
 	query := getPersonSelectBase() + `
 		WHERE p.company_id = ? AND p.active = 1
@@ -338,6 +366,97 @@ func (r *personRepo) SearchPeople(ctx context.Context, companyID int64, search s
 	return people, nil
 }
 
+// UpdatePersonManager sets only the manager of a person, used when the manager is created after the person
+func (r *personRepo) UpdatePersonManager(ctx context.Context, personID int64, managerID *int64) (err error) {
+	query := `
+		UPDATE tab_person
+		  SET  manager_id = ?,
+		       updated_at = NOW()
+
+		WHERE person_id = ?
+		  AND active    = 1
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, managerID, personID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *personRepo) UpdatePersonCadence(ctx context.Context, personID int64, cadence *string, cadenceDays *int) (err error) {
+	query := `
+		UPDATE tab_person
+		  SET  one_on_one_cadence      = ?,
+		       one_on_one_cadence_days = ?,
+		       updated_at              = NOW()
+
+		WHERE person_id = ?
+		  AND active    = 1
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, cadence, cadenceDays, personID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *personRepo) CreatePersonAddress(ctx context.Context, address entity.Address) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_address (
+			address_uuid,
+			person_id,
+			city,
+			state,
+			country,
+			is_primary,
+			active
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?);
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		address.UUID,
+		address.PersonID,
+		address.City,
+		address.State,
+		address.Country,
+		address.IsPrimary,
+		address.Active,
+	)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
 func (r *personRepo) GetPeopleCountByCompany(ctx context.Context, companyID int64) (count int64, err error) {
 	query := `
 		SELECT COUNT(*) 
@@ -362,10 +481,49 @@ func (r *personRepo) GetPeopleCountByCompany(ctx context.Context, companyID int6
 
 // ========== Person Attributes ==========
 
+func getPersonAttributeSelectBase() string {
+	return `
+	SELECT
+		pa.id,
+		pa.person_id,
+		pa.attribute_key,
+		pa.attribute_value,
+		pa.source,
+		pa.confidence,
+		pa.extracted_from_note_id,
+		n.note_uuid,
+		pa.created_at,
+		pa.updated_at
+
+	FROM person_attributes pa
+	LEFT JOIN tab_note n ON n.note_id = pa.extracted_from_note_id
+`
+}
+
+func (r *personRepo) parsePersonAttribute(row scanner) (attr entity.PersonAttribute, err error) {
+	err = row.Scan(
+		&attr.ID,
+		&attr.PersonID,
+		&attr.AttributeKey,
+		&attr.AttributeValue,
+		&attr.Source,
+		&attr.Confidence,
+		&attr.ExtractedFromNoteID,
+		&attr.ExtractedFromNoteUUID,
+		&attr.CreatedAt,
+		&attr.UpdatedAt,
+	)
+	if err != nil {
+		return attr, err
+	}
+
+	return attr, nil
+}
+
 func (r *personRepo) CreatePersonAttribute(ctx context.Context, attr entity.PersonAttribute) (entity.PersonAttribute, error) {
 	query := `
-		INSERT INTO person_attributes (person_id, attribute_key, attribute_value, source, extracted_from_note_id)
-		VALUES (?, ?, ?, ?, ?)
+		INSERT INTO person_attributes (person_id, attribute_key, attribute_value, source, confidence, extracted_from_note_id)
+		VALUES (?, ?, ?, ?, ?, ?)
 	`
 	
 	stmt, err := r.db.PrepareContext(ctx, query)
@@ -374,7 +532,7 @@ func (r *personRepo) CreatePersonAttribute(ctx context.Context, attr entity.Pers
 	}
 	defer stmt.Close()
 
-	result, err := stmt.ExecContext(ctx, attr.PersonID, attr.AttributeKey, attr.AttributeValue, attr.Source, attr.ExtractedFromNoteID)
+	result, err := stmt.ExecContext(ctx, attr.PersonID, attr.AttributeKey, attr.AttributeValue, attr.Source, attr.Confidence, attr.ExtractedFromNoteID)
 	if err != nil {
 		return entity.PersonAttribute{}, mysqlutils.HandleMySQLError(err)
 	}
@@ -388,6 +546,87 @@ func (r *personRepo) CreatePersonAttribute(ctx context.Context, attr entity.Pers
 	return attr, nil
 }
 
+func (r *personRepo) GetPersonAttributes(ctx context.Context, personID int64) (attributes []entity.PersonAttribute, err error) {
+	query := getPersonAttributeSelectBase() + `
+		WHERE pa.person_id = ?
+		ORDER BY pa.attribute_key ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return attributes, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, personID)
+	if err != nil {
+		return attributes, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		attr, err := r.parsePersonAttribute(rows)
+		if err != nil {
+			return attributes, mysqlutils.HandleMySQLError(err)
+		}
+		attributes = append(attributes, attr)
+	}
+
+	return attributes, nil
+}
+
+func (r *personRepo) GetPersonAttribute(ctx context.Context, personID int64, attributeKey string) (attr entity.PersonAttribute, err error) {
+	query := getPersonAttributeSelectBase() + `
+		WHERE pa.person_id     = ?
+		  AND pa.attribute_key = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return attr, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, personID, attributeKey)
+	attr, err = r.parsePersonAttribute(row)
+	if err != nil {
+		return attr, mysqlutils.HandleMySQLError(err)
+	}
+
+	return attr, nil
+}
+
+// GetAttributesByCompany returns the attributes of all the active people of a company
+func (r *personRepo) GetAttributesByCompany(ctx context.Context, companyID int64) (attributes []entity.PersonAttribute, err error) {
+	query := getPersonAttributeSelectBase() + `
+		INNER JOIN tab_person p ON p.person_id = pa.person_id
+		WHERE p.company_id = ?
+		  AND p.active     = 1
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return attributes, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, companyID)
+	if err != nil {
+		return attributes, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		attr, err := r.parsePersonAttribute(rows)
+		if err != nil {
+			return attributes, mysqlutils.HandleMySQLError(err)
+		}
+		attributes = append(attributes, attr)
+	}
+
+	return attributes, nil
+}
+
 func (r *personRepo) GetPersonAttributesMap(ctx context.Context, personID int64) (map[string]string, error) {
 	query := `
 		SELECT attribute_key, attribute_value
@@ -421,29 +660,99 @@ func (r *personRepo) GetPersonAttributesMap(ctx context.Context, personID int64)
 	return attributes, nil
 }
 
-func (r *personRepo) BulkUpsertPersonAttributes(ctx context.Context, personID int64, attributes map[string]string, source string, sourceNoteID *int64) error {
+// UpsertPersonAttribute creates or replaces a single attribute, regardless of the current source.
+// It's used for manual edits, where the manager is always the source of truth
+func (r *personRepo) UpsertPersonAttribute(ctx context.Context, attr entity.PersonAttribute) (err error) {
+	query := `
+		INSERT INTO person_attributes (person_id, attribute_key, attribute_value, source, confidence, extracted_from_note_id)
+		VALUES (?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			attribute_value 		= VALUES(attribute_value),
+			source 					= VALUES(source),
+			confidence 				= VALUES(confidence),
+			extracted_from_note_id 	= VALUES(extracted_from_note_id),
+			updated_at 				= CURRENT_TIMESTAMP
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		attr.PersonID,
+		attr.AttributeKey,
+		attr.AttributeValue,
+		attr.Source,
+		attr.Confidence,
+		attr.ExtractedFromNoteID,
+	)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *personRepo) DeletePersonAttribute(ctx context.Context, personID int64, attributeKey string) (err error) {
+	query := `
+		DELETE FROM person_attributes
+		WHERE person_id     = ?
+		  AND attribute_key = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, personID, attributeKey)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// BulkUpsertPersonAttributes inserts or updates many attributes from the same source.
+// Manual values are never overwritten by other sources: the ON DUPLICATE KEY UPDATE keeps
+// the current row when it's manual. The source column is assigned last, so the IF conditions
+// of the previous columns still see the old source value.
+func (r *personRepo) BulkUpsertPersonAttributes(ctx context.Context, personID int64, attributes map[string]entity.ExtractedAttribute, source string, sourceNoteID *int64) error {
 	if len(attributes) == 0 {
 		return nil
 	}
 	
 	// Build upsert query (INSERT ... ON DUPLICATE KEY UPDATE)
 	query := `
-		INSERT INTO person_attributes (person_id, attribute_key, attribute_value, source, extracted_from_note_id)
+		INSERT INTO person_attributes (person_id, attribute_key, attribute_value, source, confidence, extracted_from_note_id)
 		VALUES %s
 		ON DUPLICATE KEY UPDATE
-			attribute_value = VALUES(attribute_value),
-			source = VALUES(source),
-			extracted_from_note_id = VALUES(extracted_from_note_id),
-			updated_at = CURRENT_TIMESTAMP
+			attribute_value 		= IF(source = 'manual', attribute_value, VALUES(attribute_value)),
+			confidence 				= IF(source = 'manual', confidence, VALUES(confidence)),
+			extracted_from_note_id 	= IF(source = 'manual', extracted_from_note_id, VALUES(extracted_from_note_id)),
+			updated_at 				= IF(source = 'manual', updated_at, CURRENT_TIMESTAMP),
+			source 					= IF(source = 'manual', source, VALUES(source))
 	`
 	
 	// Build placeholders and values
 	var placeholders []string
 	var args []interface{}
 	
-	for key, value := range attributes {
-		placeholders = append(placeholders, "(?, ?, ?, ?, ?)")
-		args = append(args, personID, key, value, source, sourceNoteID)
+	for key, attr := range attributes {
+		placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?)")
+		args = append(args, personID, key, attr.Value, source, attr.Confidence, sourceNoteID)
 	}
 	
 	finalQuery := fmt.Sprintf(query, strings.Join(placeholders, ", "))
@@ -460,4 +769,299 @@ func (r *personRepo) BulkUpsertPersonAttributes(ctx context.Context, personID in
 	}
 	
 	return nil
-}
\ No newline at end of file
+}
+
+// ========== Person Attribute History ==========
+
+func getPersonAttributeHistorySelectBase() string {
+	return `
+	SELECT
+		h.id,
+		h.person_id,
+		h.attribute_key,
+		h.attribute_value,
+		h.source,
+		h.confidence,
+		h.extracted_from_note_id,
+		n.note_uuid,
+		h.valid_from,
+		h.valid_to,
+		h.created_at
+
+	FROM person_attribute_history h
+	LEFT JOIN tab_note n ON n.note_id = h.extracted_from_note_id
+`
+}
+
+func (r *personRepo) parsePersonAttributeHistory(row scanner) (history entity.PersonAttributeHistory, err error) {
+	err = row.Scan(
+		&history.ID,
+		&history.PersonID,
+		&history.AttributeKey,
+		&history.AttributeValue,
+		&history.Source,
+		&history.Confidence,
+		&history.ExtractedFromNoteID,
+		&history.ExtractedFromNoteUUID,
+		&history.ValidFrom,
+		&history.ValidTo,
+		&history.CreatedAt,
+	)
+	if err != nil {
+		return history, err
+	}
+
+	return history, nil
+}
+
+func (r *personRepo) queryPersonAttributeHistory(ctx context.Context, query string, args ...any) (history []entity.PersonAttributeHistory, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return history, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return history, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		item, err := r.parsePersonAttributeHistory(rows)
+		if err != nil {
+			return history, mysqlutils.HandleMySQLError(err)
+		}
+		history = append(history, item)
+	}
+
+	return history, nil
+}
+
+// AddPersonAttributeHistory closes the current version of the attribute (if any) and
+// opens a new one starting at changedAt. Should run in the same transaction as the attribute write
+func (r *personRepo) AddPersonAttributeHistory(ctx context.Context, attr entity.PersonAttribute, changedAt time.Time) (err error) {
+	err = r.ClosePersonAttributeHistory(ctx, attr.PersonID, attr.AttributeKey, changedAt)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO person_attribute_history (person_id, attribute_key, attribute_value, source, confidence, extracted_from_note_id, valid_from)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		attr.PersonID,
+		attr.AttributeKey,
+		attr.AttributeValue,
+		attr.Source,
+		attr.Confidence,
+		attr.ExtractedFromNoteID,
+		changedAt,
+	)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+// ClosePersonAttributeHistory ends the validity of the current version of the attribute
+func (r *personRepo) ClosePersonAttributeHistory(ctx context.Context, personID int64, attributeKey string, closedAt time.Time) (err error) {
+	query := `
+		UPDATE person_attribute_history
+		SET valid_to = ?
+		WHERE person_id     = ?
+		  AND attribute_key = ?
+		  AND valid_to      IS NULL
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, closedAt, personID, attributeKey)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *personRepo) GetPersonAttributeHistory(ctx context.Context, personID int64, attributeKey string) (history []entity.PersonAttributeHistory, err error) {
+	query := getPersonAttributeHistorySelectBase() + `
+		WHERE h.person_id     = ?
+		  AND h.attribute_key = ?
+		ORDER BY h.valid_from DESC, h.id DESC
+	`
+
+	return r.queryPersonAttributeHistory(ctx, query, personID, attributeKey)
+}
+
+// GetPersonAttributesAsOf returns the attribute versions that were valid at the given date
+func (r *personRepo) GetPersonAttributesAsOf(ctx context.Context, personID int64, asOf time.Time) (history []entity.PersonAttributeHistory, err error) {
+	query := getPersonAttributeHistorySelectBase() + `
+		WHERE h.person_id  = ?
+		  AND h.valid_from <= ?
+		  AND (h.valid_to IS NULL OR h.valid_to > ?)
+		ORDER BY h.attribute_key ASC
+	`
+
+	return r.queryPersonAttributeHistory(ctx, query, personID, asOf, asOf)
+}
+
+// GetPersonAttributeChanges returns the versions replaced or removed since the given date, newest first
+func (r *personRepo) GetPersonAttributeChanges(ctx context.Context, personID int64, since time.Time, limit int64) (history []entity.PersonAttributeHistory, err error) {
+	query := getPersonAttributeHistorySelectBase() + `
+		WHERE h.person_id = ?
+		  AND h.valid_to  >= ?
+		ORDER BY h.valid_to DESC, h.id DESC
+		LIMIT ?
+	`
+
+	return r.queryPersonAttributeHistory(ctx, query, personID, since, limit)
+}
+
+// ========== Person Import ==========
+
+func (r *personRepo) CreatePersonImport(ctx context.Context, personImport entity.PersonImport) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_person_import (
+			import_uuid,
+			company_id,
+			user_id,
+			file_name,
+			dry_run,
+			status,
+			total_rows,
+			imported_rows,
+			error_rows,
+			report,
+			file_data
+		)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		personImport.UUID,
+		personImport.CompanyID,
+		personImport.UserID,
+		personImport.FileName,
+		personImport.DryRun,
+		personImport.Status,
+		personImport.TotalRows,
+		personImport.ImportedRows,
+		personImport.ErrorRows,
+		personImport.Report,
+		personImport.FileData,
+	)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+func (r *personRepo) GetPersonImportByUUID(ctx context.Context, importUUID string) (personImport entity.PersonImport, err error) {
+	query := `
+		SELECT
+			pi.import_id,
+			pi.import_uuid,
+			pi.company_id,
+			pi.user_id,
+			pi.file_name,
+			pi.dry_run,
+			pi.status,
+			pi.total_rows,
+			pi.imported_rows,
+			pi.error_rows,
+			pi.report,
+			pi.file_data,
+			pi.created_at
+
+		FROM tab_person_import pi
+		WHERE pi.import_uuid = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return personImport, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, importUUID)
+	err = row.Scan(
+		&personImport.ID,
+		&personImport.UUID,
+		&personImport.CompanyID,
+		&personImport.UserID,
+		&personImport.FileName,
+		&personImport.DryRun,
+		&personImport.Status,
+		&personImport.TotalRows,
+		&personImport.ImportedRows,
+		&personImport.ErrorRows,
+		&personImport.Report,
+		&personImport.FileData,
+		&personImport.CreatedAt,
+	)
+	if err != nil {
+		return personImport, mysqlutils.HandleMySQLError(err)
+	}
+
+	return personImport, nil
+}
+
+func (r *personRepo) UpdatePersonImportResult(ctx context.Context, personImport entity.PersonImport) (err error) {
+	query := `
+		UPDATE tab_person_import
+		  SET  status        = ?,
+		       total_rows    = ?,
+		       imported_rows = ?,
+		       error_rows    = ?,
+		       report        = ?,
+		       file_data     = NULL
+
+		WHERE import_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		personImport.Status,
+		personImport.TotalRows,
+		personImport.ImportedRows,
+		personImport.ErrorRows,
+		personImport.Report,
+		personImport.ID,
+	)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/diegoclair/leaderpro/internal/domain"
 	"github.com/diegoclair/leaderpro/internal/domain/entity"
 	"github.com/stretchr/testify/require"
 	"github.com/twinj/uuid"
@@ -200,6 +201,29 @@ func TestUpdatePerson(t *testing.T) {
 	require.Equal(t, updatedPerson.Personality, retrievedPerson.Personality)
 }
 
+func TestUpdatePersonCadence(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+
+	cadence := domain.OneOnOneCadenceCustom
+	days := 21
+	err := testMysql.Person().UpdatePersonCadence(ctx, person.ID, &cadence, &days)
+	require.NoError(t, err)
+
+	retrievedPerson, err := testMysql.Person().GetPersonByUUID(ctx, person.UUID)
+	require.NoError(t, err)
+	require.Equal(t, cadence, *retrievedPerson.OneOnOneCadence)
+	require.Equal(t, days, *retrievedPerson.OneOnOneCadenceDays)
+
+	err = testMysql.Person().UpdatePersonCadence(ctx, person.ID, nil, nil)
+	require.NoError(t, err)
+
+	retrievedPerson, err = testMysql.Person().GetPersonByUUID(ctx, person.UUID)
+	require.NoError(t, err)
+	require.Nil(t, retrievedPerson.OneOnOneCadence)
+	require.Nil(t, retrievedPerson.OneOnOneCadenceDays)
+}
+
 func TestDeletePerson(t *testing.T) {
 	ctx := context.Background()
 	person := createRandomPerson(t)
@@ -286,6 +310,12 @@ func TestUpdatePersonErrorsWithMock(t *testing.T) {
 	})
 }
 
+func TestUpdatePersonCadenceErrorsWithMock(t *testing.T) {
+	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
+		return newPersonRepo(db).UpdatePersonCadence(context.Background(), 1, nil, nil)
+	})
+}
+
 func TestDeletePersonErrorsWithMock(t *testing.T) {
 	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
 		return newPersonRepo(db).DeletePerson(context.Background(), 1)
@@ -0,0 +1,766 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type reviewRepo struct {
+	db dbConn
+}
+
+func newReviewRepo(db dbConn) contract.ReviewRepo {
+	return &reviewRepo{
+		db: db,
+	}
+}
+
+// insert runs an insert, returning the id of the created row
+func (r *reviewRepo) insert(ctx context.Context, query string, args ...any) (createdID int64, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+// delete runs a delete by id, returning sql.ErrNoRows when nothing was deleted
+func (r *reviewRepo) delete(ctx context.Context, query string, id int64) (err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, id)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+const reviewTemplateSelectBase string = `
+	SELECT
+		t.template_id,
+		t.template_uuid,
+		t.company_id,
+		t.name,
+		t.description,
+		t.created_at,
+		t.updated_at
+
+	FROM tab_review_template t
+`
+
+func (r *reviewRepo) parseTemplate(row scanner) (template entity.ReviewTemplate, err error) {
+	var description sql.NullString
+
+	err = row.Scan(
+		&template.ID,
+		&template.UUID,
+		&template.CompanyID,
+		&template.Name,
+		&description,
+		&template.CreatedAt,
+		&template.UpdatedAt,
+	)
+	if err != nil {
+		return template, err
+	}
+
+	template.Description = description.String
+
+	return template, nil
+}
+
+func (r *reviewRepo) getTemplate(ctx context.Context, query string, arg any) (template entity.ReviewTemplate, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return template, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, arg)
+	template, err = r.parseTemplate(row)
+	if err != nil {
+		return template, mysqlutils.HandleMySQLError(err)
+	}
+
+	return template, nil
+}
+
+func (r *reviewRepo) CreateTemplate(ctx context.Context, template entity.ReviewTemplate) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_review_template (
+			template_uuid,
+			company_id,
+			name,
+			description
+		) VALUES (?, ?, ?, ?)
+	`
+
+	return r.insert(ctx, query,
+		template.UUID,
+		template.CompanyID,
+		template.Name,
+		nullableString(template.Description),
+	)
+}
+
+func (r *reviewRepo) GetTemplateByUUID(ctx context.Context, templateUUID string) (template entity.ReviewTemplate, err error) {
+	query := reviewTemplateSelectBase + `
+		WHERE t.template_uuid = ?
+	`
+
+	return r.getTemplate(ctx, query, templateUUID)
+}
+
+func (r *reviewRepo) GetTemplateByID(ctx context.Context, templateID int64) (template entity.ReviewTemplate, err error) {
+	query := reviewTemplateSelectBase + `
+		WHERE t.template_id = ?
+	`
+
+	return r.getTemplate(ctx, query, templateID)
+}
+
+func (r *reviewRepo) GetTemplatesByCompany(ctx context.Context, companyID int64) (templates []entity.ReviewTemplate, err error) {
+	query := reviewTemplateSelectBase + `
+		WHERE t.company_id = ?
+		ORDER BY t.name ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return templates, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, companyID)
+	if err != nil {
+		return templates, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		template, err := r.parseTemplate(rows)
+		if err != nil {
+			return templates, mysqlutils.HandleMySQLError(err)
+		}
+		templates = append(templates, template)
+	}
+
+	if err = rows.Err(); err != nil {
+		return templates, mysqlutils.HandleMySQLError(err)
+	}
+
+	return templates, nil
+}
+
+func (r *reviewRepo) DeleteTemplate(ctx context.Context, templateID int64) (err error) {
+	query := `
+		DELETE FROM tab_review_template
+		WHERE template_id = ?
+	`
+
+	return r.delete(ctx, query, templateID)
+}
+
+func (r *reviewRepo) CreateCompetency(ctx context.Context, competency entity.ReviewCompetency) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_review_competency (
+			competency_uuid,
+			template_id,
+			name,
+			description,
+			position
+		)
+		SELECT ?, ?, ?, ?, COALESCE(MAX(position), 0) + 1
+		FROM tab_review_competency
+		WHERE template_id = ?
+	`
+
+	return r.insert(ctx, query,
+		competency.UUID,
+		competency.TemplateID,
+		competency.Name,
+		nullableString(competency.Description),
+		competency.TemplateID,
+	)
+}
+
+func (r *reviewRepo) GetCompetenciesByTemplate(ctx context.Context, templateID int64) (competencies []entity.ReviewCompetency, err error) {
+	query := `
+		SELECT
+			rc.competency_id,
+			rc.competency_uuid,
+			rc.template_id,
+			rc.name,
+			rc.description,
+			rc.position
+
+		FROM tab_review_competency rc
+		WHERE rc.template_id = ?
+		ORDER BY rc.position ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return competencies, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, templateID)
+	if err != nil {
+		return competencies, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var competency entity.ReviewCompetency
+		var description sql.NullString
+
+		err = rows.Scan(
+			&competency.ID,
+			&competency.UUID,
+			&competency.TemplateID,
+			&competency.Name,
+			&description,
+			&competency.Position,
+		)
+		if err != nil {
+			return competencies, mysqlutils.HandleMySQLError(err)
+		}
+
+		competency.Description = description.String
+		competencies = append(competencies, competency)
+	}
+
+	if err = rows.Err(); err != nil {
+		return competencies, mysqlutils.HandleMySQLError(err)
+	}
+
+	return competencies, nil
+}
+
+func (r *reviewRepo) CreateRatingLevel(ctx context.Context, level entity.ReviewRatingLevel) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_review_rating_level (
+			template_id,
+			value,
+			label,
+			description
+		) VALUES (?, ?, ?, ?)
+	`
+
+	return r.insert(ctx, query,
+		level.TemplateID,
+		level.Value,
+		level.Label,
+		nullableString(level.Description),
+	)
+}
+
+func (r *reviewRepo) GetRatingLevelsByTemplate(ctx context.Context, templateID int64) (levels []entity.ReviewRatingLevel, err error) {
+	query := `
+		SELECT
+			rl.rating_level_id,
+			rl.template_id,
+			rl.value,
+			rl.label,
+			rl.description
+
+		FROM tab_review_rating_level rl
+		WHERE rl.template_id = ?
+		ORDER BY rl.value ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return levels, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, templateID)
+	if err != nil {
+		return levels, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var level entity.ReviewRatingLevel
+		var description sql.NullString
+
+		err = rows.Scan(
+			&level.ID,
+			&level.TemplateID,
+			&level.Value,
+			&level.Label,
+			&description,
+		)
+		if err != nil {
+			return levels, mysqlutils.HandleMySQLError(err)
+		}
+
+		level.Description = description.String
+		levels = append(levels, level)
+	}
+
+	if err = rows.Err(); err != nil {
+		return levels, mysqlutils.HandleMySQLError(err)
+	}
+
+	return levels, nil
+}
+
+const reviewCycleSelectBase string = `
+	SELECT
+		c.cycle_id,
+		c.cycle_uuid,
+		c.company_id,
+		c.template_id,
+		t.template_uuid,
+		t.name,
+		c.user_id,
+		c.name,
+		c.period_start,
+		c.period_end,
+		c.due_date,
+		c.created_at,
+		c.updated_at
+
+	FROM tab_review_cycle c
+	INNER JOIN tab_review_template t
+		ON t.template_id = c.template_id
+`
+
+func (r *reviewRepo) parseCycle(row scanner) (cycle entity.ReviewCycle, err error) {
+	err = row.Scan(
+		&cycle.ID,
+		&cycle.UUID,
+		&cycle.CompanyID,
+		&cycle.TemplateID,
+		&cycle.TemplateUUID,
+		&cycle.TemplateName,
+		&cycle.UserID,
+		&cycle.Name,
+		&cycle.PeriodStart,
+		&cycle.PeriodEnd,
+		&cycle.DueDate,
+		&cycle.CreatedAt,
+		&cycle.UpdatedAt,
+	)
+	if err != nil {
+		return cycle, err
+	}
+
+	return cycle, nil
+}
+
+func (r *reviewRepo) CreateCycle(ctx context.Context, cycle entity.ReviewCycle) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_review_cycle (
+			cycle_uuid,
+			company_id,
+			template_id,
+			user_id,
+			name,
+			period_start,
+			period_end,
+			due_date
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	return r.insert(ctx, query,
+		cycle.UUID,
+		cycle.CompanyID,
+		cycle.TemplateID,
+		cycle.UserID,
+		cycle.Name,
+		cycle.PeriodStart,
+		cycle.PeriodEnd,
+		cycle.DueDate,
+	)
+}
+
+func (r *reviewRepo) GetCycleByUUID(ctx context.Context, cycleUUID string) (cycle entity.ReviewCycle, err error) {
+	query := reviewCycleSelectBase + `
+		WHERE c.cycle_uuid = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return cycle, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, cycleUUID)
+	cycle, err = r.parseCycle(row)
+	if err != nil {
+		return cycle, mysqlutils.HandleMySQLError(err)
+	}
+
+	return cycle, nil
+}
+
+func (r *reviewRepo) GetCyclesByCompany(ctx context.Context, companyID int64) (cycles []entity.ReviewCycle, err error) {
+	query := reviewCycleSelectBase + `
+		WHERE c.company_id = ?
+		ORDER BY c.period_end DESC, c.created_at DESC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return cycles, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, companyID)
+	if err != nil {
+		return cycles, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		cycle, err := r.parseCycle(rows)
+		if err != nil {
+			return cycles, mysqlutils.HandleMySQLError(err)
+		}
+		cycles = append(cycles, cycle)
+	}
+
+	if err = rows.Err(); err != nil {
+		return cycles, mysqlutils.HandleMySQLError(err)
+	}
+
+	return cycles, nil
+}
+
+func (r *reviewRepo) DeleteCycle(ctx context.Context, cycleID int64) (err error) {
+	query := `
+		DELETE FROM tab_review_cycle
+		WHERE cycle_id = ?
+	`
+
+	return r.delete(ctx, query, cycleID)
+}
+
+const reviewSelectBase string = `
+	SELECT
+		r.review_id,
+		r.review_uuid,
+		r.cycle_id,
+		c.cycle_uuid,
+		c.name,
+		c.template_id,
+		c.period_start,
+		c.period_end,
+		r.company_id,
+		r.person_id,
+		p.person_uuid,
+		p.name,
+		r.user_id,
+		r.status,
+		r.overall_rating,
+		r.summary,
+		r.strengths,
+		r.improvements,
+		r.submitted_at,
+		r.shared_at,
+		r.created_at,
+		r.updated_at
+
+	FROM tab_review r
+	INNER JOIN tab_review_cycle c
+		ON c.cycle_id = r.cycle_id
+	INNER JOIN tab_person p
+		ON p.person_id = r.person_id
+`
+
+func (r *reviewRepo) parseReview(row scanner) (review entity.Review, err error) {
+	var summary, strengths, improvements sql.NullString
+
+	err = row.Scan(
+		&review.ID,
+		&review.UUID,
+		&review.CycleID,
+		&review.CycleUUID,
+		&review.CycleName,
+		&review.TemplateID,
+		&review.PeriodStart,
+		&review.PeriodEnd,
+		&review.CompanyID,
+		&review.PersonID,
+		&review.PersonUUID,
+		&review.PersonName,
+		&review.UserID,
+		&review.Status,
+		&review.OverallRating,
+		&summary,
+		&strengths,
+		&improvements,
+		&review.SubmittedAt,
+		&review.SharedAt,
+		&review.CreatedAt,
+		&review.UpdatedAt,
+	)
+	if err != nil {
+		return review, err
+	}
+
+	review.Summary = summary.String
+	review.Strengths = strengths.String
+	review.Improvements = improvements.String
+
+	return review, nil
+}
+
+func (r *reviewRepo) queryReviews(ctx context.Context, query string, args ...any) (reviews []entity.Review, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return reviews, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return reviews, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		review, err := r.parseReview(rows)
+		if err != nil {
+			return reviews, mysqlutils.HandleMySQLError(err)
+		}
+		reviews = append(reviews, review)
+	}
+
+	if err = rows.Err(); err != nil {
+		return reviews, mysqlutils.HandleMySQLError(err)
+	}
+
+	return reviews, nil
+}
+
+func (r *reviewRepo) CreateReview(ctx context.Context, review entity.Review) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_review (
+			review_uuid,
+			cycle_id,
+			company_id,
+			person_id,
+			user_id,
+			status
+		) VALUES (?, ?, ?, ?, ?, ?)
+	`
+
+	return r.insert(ctx, query,
+		review.UUID,
+		review.CycleID,
+		review.CompanyID,
+		review.PersonID,
+		review.UserID,
+		review.Status,
+	)
+}
+
+func (r *reviewRepo) GetReviewByUUID(ctx context.Context, reviewUUID string) (review entity.Review, err error) {
+	query := reviewSelectBase + `
+		WHERE r.review_uuid = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return review, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	row := stmt.QueryRowContext(ctx, reviewUUID)
+	review, err = r.parseReview(row)
+	if err != nil {
+		return review, mysqlutils.HandleMySQLError(err)
+	}
+
+	return review, nil
+}
+
+func (r *reviewRepo) GetReviewsByCycle(ctx context.Context, cycleID int64) (reviews []entity.Review, err error) {
+	query := reviewSelectBase + `
+		WHERE r.cycle_id = ?
+		ORDER BY p.name ASC
+	`
+
+	return r.queryReviews(ctx, query, cycleID)
+}
+
+func (r *reviewRepo) GetReviewsByPerson(ctx context.Context, personID int64) (reviews []entity.Review, err error) {
+	query := reviewSelectBase + `
+		WHERE r.person_id = ?
+		ORDER BY c.period_end DESC, r.created_at DESC
+	`
+
+	return r.queryReviews(ctx, query, personID)
+}
+
+func (r *reviewRepo) UpdateReview(ctx context.Context, reviewID int64, review entity.Review) (err error) {
+	query := `
+		UPDATE tab_review
+		SET
+			status         = ?,
+			overall_rating = ?,
+			summary        = ?,
+			strengths      = ?,
+			improvements   = ?,
+			submitted_at   = ?,
+			shared_at      = ?
+		WHERE review_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		review.Status,
+		review.OverallRating,
+		nullableString(review.Summary),
+		nullableString(review.Strengths),
+		nullableString(review.Improvements),
+		review.SubmittedAt,
+		review.SharedAt,
+		reviewID,
+	)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *reviewRepo) DeleteReview(ctx context.Context, reviewID int64) (err error) {
+	query := `
+		DELETE FROM tab_review
+		WHERE review_id = ?
+	`
+
+	return r.delete(ctx, query, reviewID)
+}
+
+func (r *reviewRepo) SaveRating(ctx context.Context, rating entity.ReviewRating) (err error) {
+	query := `
+		INSERT INTO tab_review_rating (
+			review_id,
+			competency_id,
+			rating,
+			comment
+		)
+		VALUES (?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			rating  = VALUES(rating),
+			comment = VALUES(comment)
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		rating.ReviewID,
+		rating.CompetencyID,
+		rating.Rating,
+		nullableString(rating.Comment),
+	)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *reviewRepo) GetRatingsByReview(ctx context.Context, reviewID int64) (ratings []entity.ReviewRating, err error) {
+	query := `
+		SELECT
+			rr.review_rating_id,
+			rr.review_id,
+			rr.competency_id,
+			rc.competency_uuid,
+			rc.name,
+			rr.rating,
+			rr.comment
+
+		FROM tab_review_rating rr
+		INNER JOIN tab_review_competency rc
+			ON rc.competency_id = rr.competency_id
+		WHERE rr.review_id = ?
+		ORDER BY rc.position ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return ratings, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, reviewID)
+	if err != nil {
+		return ratings, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rating entity.ReviewRating
+		var comment sql.NullString
+
+		err = rows.Scan(
+			&rating.ID,
+			&rating.ReviewID,
+			&rating.CompetencyID,
+			&rating.CompetencyUUID,
+			&rating.CompetencyName,
+			&rating.Rating,
+			&comment,
+		)
+		if err != nil {
+			return ratings, mysqlutils.HandleMySQLError(err)
+		}
+
+		rating.Comment = comment.String
+		ratings = append(ratings, rating)
+	}
+
+	if err = rows.Err(); err != nil {
+		return ratings, mysqlutils.HandleMySQLError(err)
+	}
+
+	return ratings, nil
+}
@@ -0,0 +1,217 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+func createRandomReviewTemplate(t *testing.T, companyID int64) entity.ReviewTemplate {
+	ctx := context.Background()
+	template := entity.ReviewTemplate{
+		UUID:      uuid.NewV4().String(),
+		CompanyID: companyID,
+		Name:      "Engineering review",
+	}
+
+	templateID, err := testMysql.Review().CreateTemplate(ctx, template)
+	require.NoError(t, err)
+	require.NotZero(t, templateID)
+	template.ID = templateID
+
+	for _, name := range []string{"Delivery", "Collaboration"} {
+		_, err = testMysql.Review().CreateCompetency(ctx, entity.ReviewCompetency{
+			UUID:       uuid.NewV4().String(),
+			TemplateID: templateID,
+			Name:       name,
+		})
+		require.NoError(t, err)
+	}
+
+	for value, label := range map[int]string{1: "Below expectations", 2: "Meets expectations", 3: "Exceeds expectations"} {
+		_, err = testMysql.Review().CreateRatingLevel(ctx, entity.ReviewRatingLevel{
+			TemplateID: templateID,
+			Value:      value,
+			Label:      label,
+		})
+		require.NoError(t, err)
+	}
+
+	return template
+}
+
+func createRandomReviewCycle(t *testing.T, person entity.Person) entity.ReviewCycle {
+	template := createRandomReviewTemplate(t, person.CompanyID)
+	cycle := entity.ReviewCycle{
+		UUID:        uuid.NewV4().String(),
+		CompanyID:   person.CompanyID,
+		TemplateID:  template.ID,
+		UserID:      person.CreatedBy,
+		Name:        "H1 2025",
+		PeriodStart: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		PeriodEnd:   time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC),
+	}
+
+	cycleID, err := testMysql.Review().CreateCycle(context.Background(), cycle)
+	require.NoError(t, err)
+	require.NotZero(t, cycleID)
+
+	cycle.ID = cycleID
+	return cycle
+}
+
+func createRandomReview(t *testing.T, cycle entity.ReviewCycle, person entity.Person) entity.Review {
+	review := entity.Review{
+		UUID:      uuid.NewV4().String(),
+		CycleID:   cycle.ID,
+		CompanyID: person.CompanyID,
+		PersonID:  person.ID,
+		UserID:    person.CreatedBy,
+		Status:    domain.ReviewStatusDraft,
+	}
+
+	reviewID, err := testMysql.Review().CreateReview(context.Background(), review)
+	require.NoError(t, err)
+	require.NotZero(t, reviewID)
+
+	review.ID = reviewID
+	return review
+}
+
+func TestReviewTemplates(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	template := createRandomReviewTemplate(t, person.CompanyID)
+
+	result, err := testMysql.Review().GetTemplateByUUID(ctx, template.UUID)
+	require.NoError(t, err)
+	require.Equal(t, template.ID, result.ID)
+	require.Equal(t, "Engineering review", result.Name)
+
+	competencies, err := testMysql.Review().GetCompetenciesByTemplate(ctx, template.ID)
+	require.NoError(t, err)
+	require.Len(t, competencies, 2)
+	require.Equal(t, "Delivery", competencies[0].Name)
+	require.Equal(t, 2, competencies[1].Position)
+
+	levels, err := testMysql.Review().GetRatingLevelsByTemplate(ctx, template.ID)
+	require.NoError(t, err)
+	require.Len(t, levels, 3)
+	require.Equal(t, 1, levels[0].Value)
+	require.Equal(t, "Exceeds expectations", levels[2].Label)
+
+	templates, err := testMysql.Review().GetTemplatesByCompany(ctx, person.CompanyID)
+	require.NoError(t, err)
+	require.NotEmpty(t, templates)
+
+	err = testMysql.Review().DeleteTemplate(ctx, template.ID)
+	require.NoError(t, err)
+
+	_, err = testMysql.Review().GetTemplateByID(ctx, template.ID)
+	require.Error(t, err)
+}
+
+func TestReviewCycleAndReviews(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	cycle := createRandomReviewCycle(t, person)
+
+	result, err := testMysql.Review().GetCycleByUUID(ctx, cycle.UUID)
+	require.NoError(t, err)
+	require.Equal(t, "Engineering review", result.TemplateName)
+	require.Equal(t, "2025-06-30", result.PeriodEnd.Format("2006-01-02"))
+	require.Nil(t, result.DueDate)
+
+	review := createRandomReview(t, cycle, person)
+
+	reviews, err := testMysql.Review().GetReviewsByCycle(ctx, cycle.ID)
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+	require.Equal(t, person.UUID, reviews[0].PersonUUID)
+	require.Equal(t, cycle.TemplateID, reviews[0].TemplateID)
+
+	submittedAt := time.Now().Truncate(time.Second)
+	overall := 3
+	review.Status = domain.ReviewStatusSubmitted
+	review.OverallRating = &overall
+	review.Summary = "Great half"
+	review.SubmittedAt = &submittedAt
+	err = testMysql.Review().UpdateReview(ctx, review.ID, review)
+	require.NoError(t, err)
+
+	updated, err := testMysql.Review().GetReviewByUUID(ctx, review.UUID)
+	require.NoError(t, err)
+	require.Equal(t, domain.ReviewStatusSubmitted, updated.Status)
+	require.Equal(t, 3, *updated.OverallRating)
+	require.Equal(t, "Great half", updated.Summary)
+	require.Empty(t, updated.Strengths)
+	require.Nil(t, updated.SharedAt)
+
+	reviews, err = testMysql.Review().GetReviewsByPerson(ctx, person.ID)
+	require.NoError(t, err)
+	require.Len(t, reviews, 1)
+
+	err = testMysql.Review().DeleteCycle(ctx, cycle.ID)
+	require.NoError(t, err)
+
+	_, err = testMysql.Review().GetReviewByUUID(ctx, review.UUID)
+	require.Error(t, err)
+}
+
+func TestReviewRatings(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	cycle := createRandomReviewCycle(t, person)
+	review := createRandomReview(t, cycle, person)
+
+	competencies, err := testMysql.Review().GetCompetenciesByTemplate(ctx, cycle.TemplateID)
+	require.NoError(t, err)
+
+	rating := 2
+	err = testMysql.Review().SaveRating(ctx, entity.ReviewRating{ReviewID: review.ID, CompetencyID: competencies[1].ID, Rating: &rating})
+	require.NoError(t, err)
+
+	rating = 3
+	err = testMysql.Review().SaveRating(ctx, entity.ReviewRating{ReviewID: review.ID, CompetencyID: competencies[1].ID, Rating: &rating, Comment: "Great partner"})
+	require.NoError(t, err)
+
+	ratings, err := testMysql.Review().GetRatingsByReview(ctx, review.ID)
+	require.NoError(t, err)
+	require.Len(t, ratings, 1)
+	require.Equal(t, competencies[1].UUID, ratings[0].CompetencyUUID)
+	require.Equal(t, 3, *ratings[0].Rating)
+	require.Equal(t, "Great partner", ratings[0].Comment)
+
+	err = testMysql.Review().DeleteReview(ctx, review.ID)
+	require.NoError(t, err)
+
+	err = testMysql.Review().DeleteReview(ctx, review.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+// Error tests with mocks
+func TestCreateReviewTemplateErrorsWithMock(t *testing.T) {
+	testForInsertErrorsWithMock(t, func(db *sql.DB) error {
+		_, err := newReviewRepo(db).CreateTemplate(context.Background(), entity.ReviewTemplate{})
+		return err
+	})
+}
+
+func TestGetReviewByUUIDErrorsWithMock(t *testing.T) {
+	testForSelectErrorsWithMock(t, "review_id", func(db *sql.DB) error {
+		_, err := newReviewRepo(db).GetReviewByUUID(context.Background(), "review-uuid")
+		return err
+	})
+}
+
+func TestDeleteReviewErrorsWithMock(t *testing.T) {
+	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
+		return newReviewRepo(db).DeleteReview(context.Background(), 1)
+	})
+}
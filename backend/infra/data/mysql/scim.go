@@ -0,0 +1,845 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type scimRepo struct {
+	db dbConn
+}
+
+func newSCIMRepo(db dbConn) contract.SCIMRepo {
+	return &scimRepo{
+		db: db,
+	}
+}
+
+func (r *scimRepo) SaveSCIMToken(ctx context.Context, companyID int64, tokenHash string, createdBy int64) (err error) {
+	query := `
+		INSERT INTO tab_company_scim_token (
+			company_id,
+			token_hash,
+			created_by
+		)
+		VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			token_hash = VALUES(token_hash),
+			created_by = VALUES(created_by),
+			created_at = NOW()
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, companyID, tokenHash, createdBy)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *scimRepo) GetSCIMTokenHash(ctx context.Context, companyID int64) (tokenHash string, err error) {
+	query := `
+		SELECT token_hash
+
+		FROM  tab_company_scim_token
+		WHERE company_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return tokenHash, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, companyID).Scan(&tokenHash)
+	if err != nil {
+		return tokenHash, mysqlutils.HandleMySQLError(err)
+	}
+
+	return tokenHash, nil
+}
+
+func (r *scimRepo) DeleteSCIMToken(ctx context.Context, companyID int64) (err error) {
+	query := `
+		DELETE FROM tab_company_scim_token
+		WHERE company_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, companyID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	if rowsAffected == 0 {
+		return mysqlutils.HandleMySQLError(sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+// scimUserSelectBase lists inactive people too, the HR system needs to see deactivated users
+const scimUserSelectBase string = `
+	SELECT
+		p.person_id,
+		p.person_uuid,
+		COALESCE(s.external_id, ''),
+		COALESCE(s.user_name, p.email, ''),
+		p.name,
+		COALESCE(p.email, ''),
+		COALESCE(p.phone, ''),
+		COALESCE(p.position, ''),
+		COALESCE(p.department, ''),
+		COALESCE(m.person_uuid, ''),
+		p.manager_id,
+		p.active,
+		p.created_at,
+		p.updated_at
+
+	FROM tab_person p
+	LEFT JOIN tab_person_sync s
+		ON s.person_id = p.person_id
+	LEFT JOIN tab_person m
+		ON m.person_id = p.manager_id
+`
+
+func (r *scimRepo) parseSCIMUser(row scanner) (user entity.SCIMUser, err error) {
+	err = row.Scan(
+		&user.PersonID,
+		&user.ID,
+		&user.ExternalID,
+		&user.UserName,
+		&user.Name,
+		&user.Email,
+		&user.Phone,
+		&user.Position,
+		&user.Department,
+		&user.ManagerRef,
+		&user.ManagerID,
+		&user.Active,
+		&user.CreatedAt,
+		&user.UpdatedAt,
+	)
+
+	if err != nil {
+		return user, err
+	}
+
+	return user, nil
+}
+
+// getSCIMUserFilterCondition returns the where condition of a SCIM users filter
+func getSCIMUserFilterCondition(filter *entity.SCIMFilter) (condition string, args []any, err error) {
+	if filter == nil {
+		return "", nil, nil
+	}
+
+	switch filter.Attribute {
+	case domain.SCIMFilterUserName:
+		return " AND COALESCE(s.user_name, p.email) = ?", []any{filter.Value}, nil
+	case domain.SCIMFilterExternalID:
+		return " AND s.external_id = ?", []any{filter.Value}, nil
+	case domain.SCIMFilterEmail:
+		return " AND p.email = ?", []any{filter.Value}, nil
+	}
+
+	return "", nil, fmt.Errorf("unsupported SCIM users filter attribute: %s", filter.Attribute)
+}
+
+func (r *scimRepo) GetSCIMUsers(ctx context.Context, companyID int64, filter *entity.SCIMFilter, take, skip int64) (users []entity.SCIMUser, totalRecords int64, err error) {
+	condition, filterArgs, err := getSCIMUserFilterCondition(filter)
+	if err != nil {
+		return users, 0, err
+	}
+
+	args := append([]any{companyID}, filterArgs...)
+
+	countQuery := `
+		SELECT COUNT(*)
+
+		FROM tab_person p
+		LEFT JOIN tab_person_sync s
+			ON s.person_id = p.person_id
+		WHERE p.company_id = ?
+	` + condition
+
+	stmt, err := r.db.PrepareContext(ctx, countQuery)
+	if err != nil {
+		return users, 0, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, args...).Scan(&totalRecords)
+	if err != nil {
+		return users, 0, mysqlutils.HandleMySQLError(err)
+	}
+
+	query := scimUserSelectBase + `
+		WHERE p.company_id = ?
+	` + condition + `
+		ORDER BY p.person_id
+		LIMIT ? OFFSET ?
+	`
+
+	stmt2, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return users, totalRecords, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt2.Close()
+
+	rows, err := stmt2.QueryContext(ctx, append(args, take, skip)...)
+	if err != nil {
+		return users, totalRecords, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		user, err := r.parseSCIMUser(rows)
+		if err != nil {
+			return users, totalRecords, mysqlutils.HandleMySQLError(err)
+		}
+		users = append(users, user)
+	}
+
+	if err = rows.Err(); err != nil {
+		return users, totalRecords, mysqlutils.HandleMySQLError(err)
+	}
+
+	return users, totalRecords, nil
+}
+
+func (r *scimRepo) GetSCIMUserByPersonUUID(ctx context.Context, companyID int64, personUUID string) (user entity.SCIMUser, err error) {
+	query := scimUserSelectBase + `
+		WHERE p.company_id  = ?
+		  AND p.person_uuid = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return user, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	user, err = r.parseSCIMUser(stmt.QueryRowContext(ctx, companyID, personUUID))
+	if err != nil {
+		return user, mysqlutils.HandleMySQLError(err)
+	}
+
+	return user, nil
+}
+
+func (r *scimRepo) GetSCIMUserByPersonID(ctx context.Context, personID int64) (user entity.SCIMUser, err error) {
+	query := scimUserSelectBase + `
+		WHERE p.person_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return user, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	user, err = r.parseSCIMUser(stmt.QueryRowContext(ctx, personID))
+	if err != nil {
+		return user, mysqlutils.HandleMySQLError(err)
+	}
+
+	return user, nil
+}
+
+// GetUnsyncedPersonIDByEmail returns the active person with the email that is not linked to the HR system yet
+func (r *scimRepo) GetUnsyncedPersonIDByEmail(ctx context.Context, companyID int64, email string) (personID int64, err error) {
+	query := `
+		SELECT p.person_id
+
+		FROM tab_person p
+		LEFT JOIN tab_person_sync s
+			ON s.person_id = p.person_id
+		WHERE p.company_id = ?
+		  AND p.email      = ?
+		  AND p.active     = 1
+		  AND s.person_sync_id IS NULL
+		ORDER BY p.person_id
+		LIMIT 1
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return personID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, companyID, email).Scan(&personID)
+	if err != nil {
+		return personID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return personID, nil
+}
+
+const personSyncSelectBase string = `
+	SELECT
+		s.person_sync_id,
+		s.person_id,
+		s.company_id,
+		s.external_id,
+		s.user_name,
+		s.snapshot,
+		s.pending_manager_ref,
+		s.last_synced_at,
+		s.created_at
+
+	FROM tab_person_sync s
+`
+
+func (r *scimRepo) parsePersonSync(row scanner) (personSync entity.PersonSync, err error) {
+	var snapshot []byte
+
+	err = row.Scan(
+		&personSync.ID,
+		&personSync.PersonID,
+		&personSync.CompanyID,
+		&personSync.ExternalID,
+		&personSync.UserName,
+		&snapshot,
+		&personSync.PendingManagerRef,
+		&personSync.LastSyncedAt,
+		&personSync.CreatedAt,
+	)
+	if err != nil {
+		return personSync, err
+	}
+
+	if len(snapshot) > 0 {
+		personSync.Snapshot = &entity.PersonSyncSnapshot{}
+		err = json.Unmarshal(snapshot, personSync.Snapshot)
+		if err != nil {
+			return personSync, err
+		}
+	}
+
+	return personSync, nil
+}
+
+func marshalPersonSyncSnapshot(snapshot *entity.PersonSyncSnapshot) (any, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(data), nil
+}
+
+func (r *scimRepo) CreatePersonSync(ctx context.Context, personSync entity.PersonSync) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_person_sync (
+			person_id,
+			company_id,
+			external_id,
+			user_name,
+			snapshot,
+			pending_manager_ref
+		)
+		VALUES (?, ?, ?, ?, ?, ?);
+	`
+
+	snapshot, err := marshalPersonSyncSnapshot(personSync.Snapshot)
+	if err != nil {
+		return createdID, err
+	}
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		personSync.PersonID,
+		personSync.CompanyID,
+		personSync.ExternalID,
+		personSync.UserName,
+		snapshot,
+		personSync.PendingManagerRef,
+	)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+func (r *scimRepo) UpdatePersonSync(ctx context.Context, personSync entity.PersonSync) (err error) {
+	query := `
+		UPDATE tab_person_sync
+		  SET  external_id         = ?,
+		       user_name           = ?,
+		       snapshot            = ?,
+		       pending_manager_ref = ?,
+		       last_synced_at      = NOW()
+
+		WHERE person_sync_id = ?
+	`
+
+	snapshot, err := marshalPersonSyncSnapshot(personSync.Snapshot)
+	if err != nil {
+		return err
+	}
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx,
+		personSync.ExternalID,
+		personSync.UserName,
+		snapshot,
+		personSync.PendingManagerRef,
+		personSync.ID,
+	)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *scimRepo) GetPersonSyncByExternalID(ctx context.Context, companyID int64, externalID string) (personSync entity.PersonSync, err error) {
+	query := personSyncSelectBase + `
+		WHERE s.company_id  = ?
+		  AND s.external_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return personSync, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	personSync, err = r.parsePersonSync(stmt.QueryRowContext(ctx, companyID, externalID))
+	if err != nil {
+		return personSync, mysqlutils.HandleMySQLError(err)
+	}
+
+	return personSync, nil
+}
+
+func (r *scimRepo) GetPersonSyncByPersonID(ctx context.Context, personID int64) (personSync entity.PersonSync, err error) {
+	query := personSyncSelectBase + `
+		WHERE s.person_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return personSync, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	personSync, err = r.parsePersonSync(stmt.QueryRowContext(ctx, personID))
+	if err != nil {
+		return personSync, mysqlutils.HandleMySQLError(err)
+	}
+
+	return personSync, nil
+}
+
+// GetPendingManagerSyncs returns the people waiting for a manager identified by one of the references
+func (r *scimRepo) GetPendingManagerSyncs(ctx context.Context, companyID int64, managerRefs []string) (syncs []entity.PersonSync, err error) {
+	if len(managerRefs) == 0 {
+		return syncs, nil
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(managerRefs)), ",")
+	query := personSyncSelectBase + `
+		WHERE s.company_id = ?
+		  AND s.pending_manager_ref IN (` + placeholders + `)
+	`
+
+	args := []any{companyID}
+	for _, ref := range managerRefs {
+		args = append(args, ref)
+	}
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return syncs, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return syncs, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		personSync, err := r.parsePersonSync(rows)
+		if err != nil {
+			return syncs, mysqlutils.HandleMySQLError(err)
+		}
+		syncs = append(syncs, personSync)
+	}
+
+	if err = rows.Err(); err != nil {
+		return syncs, mysqlutils.HandleMySQLError(err)
+	}
+
+	return syncs, nil
+}
+
+const scimGroupSelectBase string = `
+	SELECT
+		g.group_id,
+		g.group_uuid,
+		g.company_id,
+		COALESCE(g.external_id, ''),
+		g.display_name,
+		g.created_at,
+		g.updated_at
+
+	FROM tab_scim_group g
+`
+
+func (r *scimRepo) parseSCIMGroup(row scanner) (group entity.SCIMGroup, err error) {
+	err = row.Scan(
+		&group.ID,
+		&group.UUID,
+		&group.CompanyID,
+		&group.ExternalID,
+		&group.DisplayName,
+		&group.CreatedAt,
+		&group.UpdatedAt,
+	)
+
+	if err != nil {
+		return group, err
+	}
+
+	return group, nil
+}
+
+// nullableString stores empty strings as NULL, so optional unique columns don't conflict
+func nullableString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func (r *scimRepo) CreateSCIMGroup(ctx context.Context, group entity.SCIMGroup) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_scim_group (
+			group_uuid,
+			company_id,
+			external_id,
+			display_name
+		)
+		VALUES (?, ?, ?, ?);
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx,
+		group.UUID,
+		group.CompanyID,
+		nullableString(group.ExternalID),
+		group.DisplayName,
+	)
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+func (r *scimRepo) GetSCIMGroupByUUID(ctx context.Context, companyID int64, groupUUID string) (group entity.SCIMGroup, err error) {
+	query := scimGroupSelectBase + `
+		WHERE g.company_id = ?
+		  AND g.group_uuid = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return group, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	group, err = r.parseSCIMGroup(stmt.QueryRowContext(ctx, companyID, groupUUID))
+	if err != nil {
+		return group, mysqlutils.HandleMySQLError(err)
+	}
+
+	return group, nil
+}
+
+func (r *scimRepo) GetSCIMGroupByExternalID(ctx context.Context, companyID int64, externalID string) (group entity.SCIMGroup, err error) {
+	query := scimGroupSelectBase + `
+		WHERE g.company_id  = ?
+		  AND g.external_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return group, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	group, err = r.parseSCIMGroup(stmt.QueryRowContext(ctx, companyID, externalID))
+	if err != nil {
+		return group, mysqlutils.HandleMySQLError(err)
+	}
+
+	return group, nil
+}
+
+func (r *scimRepo) GetSCIMGroups(ctx context.Context, companyID int64, filter *entity.SCIMFilter, take, skip int64) (groups []entity.SCIMGroup, totalRecords int64, err error) {
+	condition := ""
+	args := []any{companyID}
+	if filter != nil {
+		switch filter.Attribute {
+		case domain.SCIMFilterDisplayName:
+			condition = " AND g.display_name = ?"
+		case domain.SCIMFilterExternalID:
+			condition = " AND g.external_id = ?"
+		default:
+			return groups, 0, fmt.Errorf("unsupported SCIM groups filter attribute: %s", filter.Attribute)
+		}
+		args = append(args, filter.Value)
+	}
+
+	countQuery := `
+		SELECT COUNT(*)
+
+		FROM  tab_scim_group g
+		WHERE g.company_id = ?
+	` + condition
+
+	stmt, err := r.db.PrepareContext(ctx, countQuery)
+	if err != nil {
+		return groups, 0, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, args...).Scan(&totalRecords)
+	if err != nil {
+		return groups, 0, mysqlutils.HandleMySQLError(err)
+	}
+
+	query := scimGroupSelectBase + `
+		WHERE g.company_id = ?
+	` + condition + `
+		ORDER BY g.group_id
+		LIMIT ? OFFSET ?
+	`
+
+	stmt2, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return groups, totalRecords, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt2.Close()
+
+	rows, err := stmt2.QueryContext(ctx, append(args, take, skip)...)
+	if err != nil {
+		return groups, totalRecords, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		group, err := r.parseSCIMGroup(rows)
+		if err != nil {
+			return groups, totalRecords, mysqlutils.HandleMySQLError(err)
+		}
+		groups = append(groups, group)
+	}
+
+	if err = rows.Err(); err != nil {
+		return groups, totalRecords, mysqlutils.HandleMySQLError(err)
+	}
+
+	return groups, totalRecords, nil
+}
+
+func (r *scimRepo) UpdateSCIMGroup(ctx context.Context, groupID int64, group entity.SCIMGroup) (err error) {
+	query := `
+		UPDATE tab_scim_group
+		  SET  external_id  = ?,
+		       display_name = ?,
+		       updated_at   = NOW()
+
+		WHERE group_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, nullableString(group.ExternalID), group.DisplayName, groupID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *scimRepo) DeleteSCIMGroup(ctx context.Context, groupID int64) (err error) {
+	query := `
+		DELETE FROM tab_scim_group
+		WHERE group_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, groupID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	if rowsAffected == 0 {
+		return mysqlutils.HandleMySQLError(sql.ErrNoRows)
+	}
+
+	return nil
+}
+
+// ReplaceSCIMGroupMembers sets the members of the group, removing the people that are not in the list
+func (r *scimRepo) ReplaceSCIMGroupMembers(ctx context.Context, groupID int64, personIDs []int64) (err error) {
+	deleteQuery := `
+		DELETE FROM tab_scim_group_member
+		WHERE group_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, deleteQuery)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, groupID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	if len(personIDs) == 0 {
+		return nil
+	}
+
+	values := strings.TrimSuffix(strings.Repeat("(?, ?),", len(personIDs)), ",")
+	insertQuery := `
+		INSERT IGNORE INTO tab_scim_group_member (group_id, person_id)
+		VALUES ` + values
+
+	args := make([]any, 0, len(personIDs)*2)
+	for _, personID := range personIDs {
+		args = append(args, groupID, personID)
+	}
+
+	stmt2, err := r.db.PrepareContext(ctx, insertQuery)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt2.Close()
+
+	_, err = stmt2.ExecContext(ctx, args...)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *scimRepo) GetSCIMGroupMembers(ctx context.Context, groupID int64) (members []entity.SCIMGroupMember, err error) {
+	query := `
+		SELECT
+			p.person_id,
+			p.person_uuid,
+			p.name
+
+		FROM tab_scim_group_member gm
+		INNER JOIN tab_person p
+			ON p.person_id = gm.person_id
+		WHERE gm.group_id = ?
+		ORDER BY p.name
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return members, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, groupID)
+	if err != nil {
+		return members, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var member entity.SCIMGroupMember
+		err = rows.Scan(&member.PersonID, &member.PersonUUID, &member.Name)
+		if err != nil {
+			return members, mysqlutils.HandleMySQLError(err)
+		}
+		members = append(members, member)
+	}
+
+	if err = rows.Err(); err != nil {
+		return members, mysqlutils.HandleMySQLError(err)
+	}
+
+	return members, nil
+}
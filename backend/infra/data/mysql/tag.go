@@ -0,0 +1,481 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type tagRepo struct {
+	db dbConn
+}
+
+func newTagRepo(db dbConn) contract.TagRepo {
+	return &tagRepo{
+		db: db,
+	}
+}
+
+// exec runs a statement that returns no rows
+func (r *tagRepo) exec(ctx context.Context, query string, args ...any) (result sql.Result, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return result, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err = stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return result, mysqlutils.HandleMySQLError(err)
+	}
+
+	return result, nil
+}
+
+const tagSelectBase string = `
+	SELECT
+		t.tag_id,
+		t.tag_uuid,
+		t.company_id,
+		t.name,
+		t.description,
+		t.curated,
+		t.created_at
+
+	FROM tab_tag t
+`
+
+func (r *tagRepo) getTag(ctx context.Context, query string, args ...any) (tag entity.Tag, err error) {
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return tag, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, args...).Scan(
+		&tag.ID,
+		&tag.UUID,
+		&tag.CompanyID,
+		&tag.Name,
+		&tag.Description,
+		&tag.Curated,
+		&tag.CreatedAt,
+	)
+	if err != nil {
+		return tag, mysqlutils.HandleMySQLError(err)
+	}
+
+	return tag, nil
+}
+
+func (r *tagRepo) CreateTag(ctx context.Context, tag entity.Tag) (createdID int64, err error) {
+	query := `
+		INSERT INTO tab_tag (
+			tag_uuid,
+			company_id,
+			name,
+			description,
+			curated
+		) VALUES (?, ?, ?, ?, ?)
+	`
+
+	result, err := r.exec(ctx, query, tag.UUID, tag.CompanyID, tag.Name, tag.Description, tag.Curated)
+	if err != nil {
+		return createdID, err
+	}
+
+	createdID, err = result.LastInsertId()
+	if err != nil {
+		return createdID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return createdID, nil
+}
+
+func (r *tagRepo) GetOrCreateTag(ctx context.Context, tag entity.Tag) (tagID int64, err error) {
+	// LAST_INSERT_ID(tag_id) makes the id of the existing tag the result of the insert
+	query := `
+		INSERT INTO tab_tag (
+			tag_uuid,
+			company_id,
+			name
+		) VALUES (?, ?, ?)
+		ON DUPLICATE KEY UPDATE tag_id = LAST_INSERT_ID(tag_id)
+	`
+
+	result, err := r.exec(ctx, query, tag.UUID, tag.CompanyID, tag.Name)
+	if err != nil {
+		return tagID, err
+	}
+
+	tagID, err = result.LastInsertId()
+	if err != nil {
+		return tagID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return tagID, nil
+}
+
+func (r *tagRepo) GetTagByUUID(ctx context.Context, tagUUID string) (tag entity.Tag, err error) {
+	query := tagSelectBase + `
+		WHERE t.tag_uuid = ?
+	`
+
+	return r.getTag(ctx, query, tagUUID)
+}
+
+func (r *tagRepo) GetTagByName(ctx context.Context, companyID int64, name string) (tag entity.Tag, err error) {
+	query := tagSelectBase + `
+		WHERE t.company_id = ?
+		  AND t.name = ?
+	`
+
+	return r.getTag(ctx, query, companyID, name)
+}
+
+func (r *tagRepo) GetTagsByCompany(ctx context.Context, companyID int64) (tags []entity.Tag, err error) {
+	query := `
+		SELECT
+			t.tag_id,
+			t.tag_uuid,
+			t.company_id,
+			t.name,
+			t.description,
+			t.curated,
+			t.created_at,
+			(
+				SELECT COUNT(DISTINCT nt.note_id)
+				FROM tab_note_tag nt
+				INNER JOIN tab_note n ON n.note_id = nt.note_id
+				WHERE nt.tag_id = t.tag_id
+				  AND n.deleted_at IS NULL
+			) AS notes_count
+
+		FROM tab_tag t
+		WHERE t.company_id = ?
+		ORDER BY t.name ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return tags, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, companyID)
+	if err != nil {
+		return tags, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tag entity.Tag
+		err = rows.Scan(
+			&tag.ID,
+			&tag.UUID,
+			&tag.CompanyID,
+			&tag.Name,
+			&tag.Description,
+			&tag.Curated,
+			&tag.CreatedAt,
+			&tag.NotesCount,
+		)
+		if err != nil {
+			return tags, mysqlutils.HandleMySQLError(err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err = rows.Err(); err != nil {
+		return tags, mysqlutils.HandleMySQLError(err)
+	}
+
+	return tags, nil
+}
+
+func (r *tagRepo) UpdateTag(ctx context.Context, tagID int64, tag entity.Tag) (err error) {
+	query := `
+		UPDATE tab_tag
+		SET description = ?,
+			curated = ?
+		WHERE tag_id = ?
+	`
+
+	_, err = r.exec(ctx, query, tag.Description, tag.Curated, tagID)
+	return err
+}
+
+func (r *tagRepo) DeleteTag(ctx context.Context, tagID int64) (err error) {
+	query := `
+		DELETE FROM tab_tag
+		WHERE tag_id = ?
+	`
+
+	result, err := r.exec(ctx, query, tagID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func (r *tagRepo) DeleteNoteTags(ctx context.Context, noteID int64, source string) (err error) {
+	query := `
+		DELETE FROM tab_note_tag
+		WHERE note_id = ?
+		  AND source = ?
+	`
+
+	_, err = r.exec(ctx, query, noteID, source)
+	return err
+}
+
+func (r *tagRepo) AddNoteTag(ctx context.Context, noteID, tagID int64, source string) (err error) {
+	query := `
+		INSERT IGNORE INTO tab_note_tag (
+			note_id,
+			tag_id,
+			source
+		) VALUES (?, ?, ?)
+	`
+
+	_, err = r.exec(ctx, query, noteID, tagID, source)
+	return err
+}
+
+func (r *tagRepo) GetNoteTags(ctx context.Context, noteID int64) (tags []entity.Tag, err error) {
+	query := tagSelectBase + `
+		WHERE t.tag_id IN (SELECT nt.tag_id FROM tab_note_tag nt WHERE nt.note_id = ?)
+		ORDER BY t.name ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return tags, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, noteID)
+	if err != nil {
+		return tags, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tag entity.Tag
+		err = rows.Scan(
+			&tag.ID,
+			&tag.UUID,
+			&tag.CompanyID,
+			&tag.Name,
+			&tag.Description,
+			&tag.Curated,
+			&tag.CreatedAt,
+		)
+		if err != nil {
+			return tags, mysqlutils.HandleMySQLError(err)
+		}
+		tags = append(tags, tag)
+	}
+
+	if err = rows.Err(); err != nil {
+		return tags, mysqlutils.HandleMySQLError(err)
+	}
+
+	return tags, nil
+}
+
+func (r *tagRepo) GetTagNamesByNotes(ctx context.Context, noteUUIDs []string) (names map[string][]string, err error) {
+	names = make(map[string][]string, len(noteUUIDs))
+	if len(noteUUIDs) == 0 {
+		return names, nil
+	}
+
+	placeholders := make([]string, len(noteUUIDs))
+	args := make([]any, len(noteUUIDs))
+	for i, noteUUID := range noteUUIDs {
+		placeholders[i] = "?"
+		args[i] = noteUUID
+	}
+
+	query := `
+		SELECT DISTINCT
+			n.note_uuid,
+			t.name
+
+		FROM tab_note_tag nt
+		INNER JOIN tab_note n ON n.note_id = nt.note_id
+		INNER JOIN tab_tag t ON t.tag_id = nt.tag_id
+		WHERE n.note_uuid IN (` + strings.Join(placeholders, ",") + `)
+		ORDER BY n.note_uuid ASC, t.name ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return names, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return names, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var noteUUID, name string
+		err = rows.Scan(&noteUUID, &name)
+		if err != nil {
+			return names, mysqlutils.HandleMySQLError(err)
+		}
+		names[noteUUID] = append(names[noteUUID], name)
+	}
+
+	if err = rows.Err(); err != nil {
+		return names, mysqlutils.HandleMySQLError(err)
+	}
+
+	return names, nil
+}
+
+// tagAnalyticsFrom returns the joins and the conditions selecting the tagged notes counted by the analytics
+func tagAnalyticsFrom(companyID int64, filters entity.TagAnalyticsFilters) (from string, args []any) {
+	from = `
+		FROM tab_note_tag nt
+		INNER JOIN tab_tag t ON t.tag_id = nt.tag_id
+		INNER JOIN tab_note n ON n.note_id = nt.note_id
+		INNER JOIN tab_person p ON p.person_id = n.person_id
+		WHERE t.company_id = ?
+		  AND n.deleted_at IS NULL`
+	args = []any{companyID}
+
+	if len(filters.TagNames) > 0 {
+		placeholders := make([]string, len(filters.TagNames))
+		for i, name := range filters.TagNames {
+			placeholders[i] = "?"
+			args = append(args, name)
+		}
+		from += ` AND t.name IN (` + strings.Join(placeholders, ",") + `)`
+	}
+
+	if filters.From != nil {
+		from += ` AND n.created_at >= ?`
+		args = append(args, *filters.From)
+	}
+	if filters.To != nil {
+		from += ` AND n.created_at < ?`
+		args = append(args, *filters.To)
+	}
+
+	return from, args
+}
+
+func (r *tagRepo) GetTagCountsByPerson(ctx context.Context, companyID int64, filters entity.TagAnalyticsFilters) (counts []entity.TagPersonCount, err error) {
+	from, args := tagAnalyticsFrom(companyID, filters)
+	query := `
+		SELECT
+			t.name,
+			p.person_uuid,
+			p.name,
+			COUNT(DISTINCT n.note_id) AS notes_count
+		` + from + `
+		GROUP BY t.tag_id, t.name, p.person_id, p.person_uuid, p.name
+		ORDER BY notes_count DESC, t.name ASC, p.name ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return counts, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return counts, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var count entity.TagPersonCount
+		err = rows.Scan(
+			&count.TagName,
+			&count.PersonUUID,
+			&count.PersonName,
+			&count.Count,
+		)
+		if err != nil {
+			return counts, mysqlutils.HandleMySQLError(err)
+		}
+		counts = append(counts, count)
+	}
+
+	if err = rows.Err(); err != nil {
+		return counts, mysqlutils.HandleMySQLError(err)
+	}
+
+	return counts, nil
+}
+
+func (r *tagRepo) GetTagCountsOverTime(ctx context.Context, companyID int64, filters entity.TagAnalyticsFilters) (counts []entity.TagPeriodCount, err error) {
+	// weeks start on Monday and months on the first day
+	periodStart := `DATE_SUB(DATE(n.created_at), INTERVAL WEEKDAY(n.created_at) DAY)`
+	if filters.Interval == domain.TagAnalyticsIntervalMonth {
+		periodStart = `DATE_SUB(DATE(n.created_at), INTERVAL DAYOFMONTH(n.created_at) - 1 DAY)`
+	}
+
+	from, args := tagAnalyticsFrom(companyID, filters)
+	query := `
+		SELECT
+			t.name,
+			` + periodStart + ` AS period_start,
+			COUNT(DISTINCT n.note_id)
+		` + from + `
+		GROUP BY t.tag_id, t.name, period_start
+		ORDER BY period_start ASC, t.name ASC
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return counts, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return counts, mysqlutils.HandleMySQLError(err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var count entity.TagPeriodCount
+		err = rows.Scan(
+			&count.TagName,
+			&count.PeriodStart,
+			&count.Count,
+		)
+		if err != nil {
+			return counts, mysqlutils.HandleMySQLError(err)
+		}
+		counts = append(counts, count)
+	}
+
+	if err = rows.Err(); err != nil {
+		return counts, mysqlutils.HandleMySQLError(err)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,149 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+func TestTagRepo(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+
+	createNote := func(content string, createdAt time.Time) entity.Note {
+		note := entity.Note{
+			UUID:      uuid.NewV4().String(),
+			CompanyID: person.CompanyID,
+			PersonID:  person.ID,
+			UserID:    person.CreatedBy,
+			Type:      domain.NoteTypeObservation,
+			Content:   content,
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		}
+		noteID, err := testMysql.Note().CreateNote(ctx, note)
+		require.NoError(t, err)
+		note.ID = noteID
+		return note
+	}
+
+	getOrCreate := func(name string) int64 {
+		tagID, err := testMysql.Tag().GetOrCreateTag(ctx, entity.Tag{UUID: uuid.NewV4().String(), CompanyID: person.CompanyID, Name: name})
+		require.NoError(t, err)
+		return tagID
+	}
+
+	t.Run("Should create a tag only once", func(t *testing.T) {
+		first := getOrCreate("promotion")
+		require.Equal(t, first, getOrCreate("promotion"))
+
+		tag, err := testMysql.Tag().GetTagByName(ctx, person.CompanyID, "promotion")
+		require.NoError(t, err)
+		require.Equal(t, first, tag.ID)
+		require.False(t, tag.Curated)
+	})
+
+	t.Run("Should curate a tag", func(t *testing.T) {
+		tag, err := testMysql.Tag().GetTagByName(ctx, person.CompanyID, "promotion")
+		require.NoError(t, err)
+
+		tag.Curated = true
+		tag.Description = "Promotion cases"
+		require.NoError(t, testMysql.Tag().UpdateTag(ctx, tag.ID, tag))
+
+		updated, err := testMysql.Tag().GetTagByUUID(ctx, tag.UUID)
+		require.NoError(t, err)
+		require.True(t, updated.Curated)
+		require.Equal(t, "Promotion cases", updated.Description)
+	})
+
+	march := time.Date(2025, time.March, 5, 10, 0, 0, 0, time.UTC)
+	promotionID := getOrCreate("promotion")
+	hiringID := getOrCreate("hiring")
+
+	first := createNote("#promotion and #hiring", march)
+	second := createNote("#promotion again", march.AddDate(0, 1, 0))
+	deleted := createNote("#promotion deleted", march)
+
+	require.NoError(t, testMysql.Tag().AddNoteTag(ctx, first.ID, promotionID, domain.NoteTagSourceContent))
+	require.NoError(t, testMysql.Tag().AddNoteTag(ctx, first.ID, promotionID, domain.NoteTagSourceManual))
+	require.NoError(t, testMysql.Tag().AddNoteTag(ctx, first.ID, hiringID, domain.NoteTagSourceContent))
+	require.NoError(t, testMysql.Tag().AddNoteTag(ctx, second.ID, promotionID, domain.NoteTagSourceContent))
+	require.NoError(t, testMysql.Tag().AddNoteTag(ctx, deleted.ID, promotionID, domain.NoteTagSourceContent))
+	require.NoError(t, testMysql.Note().DeleteNote(ctx, deleted.ID))
+
+	t.Run("Should return the tags of the notes once per name", func(t *testing.T) {
+		tags, err := testMysql.Tag().GetNoteTags(ctx, first.ID)
+		require.NoError(t, err)
+		require.Len(t, tags, 2)
+		require.Equal(t, "hiring", tags[0].Name)
+		require.Equal(t, "promotion", tags[1].Name)
+
+		names, err := testMysql.Tag().GetTagNamesByNotes(ctx, []string{first.UUID, second.UUID})
+		require.NoError(t, err)
+		require.Equal(t, []string{"hiring", "promotion"}, names[first.UUID])
+		require.Equal(t, []string{"promotion"}, names[second.UUID])
+	})
+
+	t.Run("Should count the not deleted notes of each tag", func(t *testing.T) {
+		tags, err := testMysql.Tag().GetTagsByCompany(ctx, person.CompanyID)
+		require.NoError(t, err)
+		require.Len(t, tags, 2)
+		require.Equal(t, "hiring", tags[0].Name)
+		require.Equal(t, int64(1), tags[0].NotesCount)
+		require.Equal(t, "promotion", tags[1].Name)
+		require.Equal(t, int64(2), tags[1].NotesCount)
+	})
+
+	t.Run("Should filter the timeline by tag", func(t *testing.T) {
+		timeline, totalRecords, err := testMysql.Note().GetPersonTimeline(ctx, person.ID, entity.TimelineFilters{Tags: []string{"hiring"}}, 10, 0)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), totalRecords)
+		require.Equal(t, first.UUID, timeline[0].UUID)
+	})
+
+	t.Run("Should count the notes per person and over time", func(t *testing.T) {
+		filters := entity.TagAnalyticsFilters{TagNames: []string{"promotion"}, Interval: domain.TagAnalyticsIntervalMonth}
+
+		byPerson, err := testMysql.Tag().GetTagCountsByPerson(ctx, person.CompanyID, filters)
+		require.NoError(t, err)
+		require.Equal(t, []entity.TagPersonCount{
+			{TagName: "promotion", PersonUUID: person.UUID, PersonName: person.Name, Count: 2},
+		}, byPerson)
+
+		overTime, err := testMysql.Tag().GetTagCountsOverTime(ctx, person.CompanyID, filters)
+		require.NoError(t, err)
+		require.Len(t, overTime, 2)
+		require.Equal(t, "2025-03-01", overTime[0].PeriodStart.Format("2006-01-02"))
+		require.Equal(t, int64(1), overTime[0].Count)
+		require.Equal(t, "2025-04-01", overTime[1].PeriodStart.Format("2006-01-02"))
+
+		filters.Interval = domain.TagAnalyticsIntervalWeek
+		to := march.AddDate(0, 0, 7)
+		filters.To = &to
+		overTime, err = testMysql.Tag().GetTagCountsOverTime(ctx, person.CompanyID, filters)
+		require.NoError(t, err)
+		require.Len(t, overTime, 1)
+		require.Equal(t, "2025-03-03", overTime[0].PeriodStart.Format("2006-01-02")) // Monday
+	})
+
+	t.Run("Should remove only the tags of the source", func(t *testing.T) {
+		require.NoError(t, testMysql.Tag().DeleteNoteTags(ctx, first.ID, domain.NoteTagSourceContent))
+
+		tags, err := testMysql.Tag().GetNoteTags(ctx, first.ID)
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		require.Equal(t, "promotion", tags[0].Name)
+	})
+
+	t.Run("Should delete the tag", func(t *testing.T) {
+		require.NoError(t, testMysql.Tag().DeleteTag(ctx, hiringID))
+		require.ErrorIs(t, testMysql.Tag().DeleteTag(ctx, hiringID), sql.ErrNoRows)
+	})
+}
@@ -0,0 +1,176 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"github.com/twinj/uuid"
+)
+
+func TestGetPersonTimeline(t *testing.T) {
+	ctx := context.Background()
+	person := createRandomPerson(t)
+	other := createRandomPerson(t)
+	otherAuthor := createRandomUserForTests(t)
+
+	createNote := func(personID, userID int64, noteType, category string, createdAt time.Time, mentioned ...int64) entity.Note {
+		note := entity.Note{
+			UUID:      uuid.NewV4().String(),
+			CompanyID: person.CompanyID,
+			PersonID:  personID,
+			UserID:    userID,
+			Type:      noteType,
+			Content:   "timeline note",
+			CreatedAt: createdAt,
+			UpdatedAt: createdAt,
+		}
+		if category != "" {
+			feedbackType := domain.FeedbackTypePositive
+			note.FeedbackType = &feedbackType
+			note.FeedbackCategory = &category
+		}
+
+		noteID, err := testMysql.Note().CreateNote(ctx, note)
+		require.NoError(t, err)
+		note.ID = noteID
+
+		for _, mentionedID := range mentioned {
+			_, err = testMysql.Note().CreateNoteMention(ctx, entity.NoteMention{
+				UUID:              uuid.NewV4().String(),
+				NoteID:            noteID,
+				MentionedPersonID: mentionedID,
+				SourcePersonID:    personID,
+				FullContent:       note.Content,
+			})
+			require.NoError(t, err)
+		}
+		return note
+	}
+
+	january := time.Date(2025, time.January, 10, 9, 0, 0, 0, time.UTC)
+	observation := createNote(person.ID, person.CreatedBy, domain.NoteTypeObservation, "", january)
+	givenFeedback := createNote(person.ID, otherAuthor.ID, domain.NoteTypeFeedback, domain.FeedbackCategorySkill, january.AddDate(0, 0, 10), other.ID)
+	mention := createNote(other.ID, person.CreatedBy, domain.NoteTypeFeedback, domain.FeedbackCategoryBehavior, january.AddDate(0, 0, 26), person.ID)
+	oneOnOne := createNote(person.ID, person.CreatedBy, domain.NoteTypeOneOnOne, "", january.AddDate(0, 2, 0))
+	sameTime := createNote(person.ID, person.CreatedBy, domain.NoteTypeObservation, "", oneOnOne.CreatedAt)
+
+	getUUIDs := func(filters entity.TimelineFilters) []string {
+		timeline, totalRecords, err := testMysql.Note().GetPersonTimeline(ctx, person.ID, filters, 10, 0)
+		require.NoError(t, err)
+		require.Equal(t, int64(len(timeline)), totalRecords)
+
+		uuids := make([]string, len(timeline))
+		for i, entry := range timeline {
+			uuids[i] = entry.UUID
+		}
+		return uuids
+	}
+
+	t.Run("Should return the newest entries first by default", func(t *testing.T) {
+		require.Equal(t, []string{sameTime.UUID, oneOnOne.UUID, mention.UUID, givenFeedback.UUID, observation.UUID}, getUUIDs(entity.TimelineFilters{}))
+	})
+
+	t.Run("Should return the oldest entries first", func(t *testing.T) {
+		uuids := getUUIDs(entity.TimelineFilters{Sort: domain.TimelineSortOldest})
+		require.Equal(t, []string{observation.UUID, givenFeedback.UUID, mention.UUID, oneOnOne.UUID, sameTime.UUID}, uuids)
+	})
+
+	t.Run("Should filter by direction", func(t *testing.T) {
+		uuids := getUUIDs(entity.TimelineFilters{Direction: domain.TimelineDirectionAboutPerson})
+		require.Equal(t, []string{sameTime.UUID, oneOnOne.UUID, mention.UUID, observation.UUID}, uuids)
+
+		uuids = getUUIDs(entity.TimelineFilters{Direction: domain.TimelineDirectionFromPerson})
+		require.Equal(t, []string{givenFeedback.UUID}, uuids)
+
+		uuids = getUUIDs(entity.TimelineFilters{Direction: domain.TimelineDirectionBilateral})
+		require.Equal(t, []string{mention.UUID, givenFeedback.UUID}, uuids)
+
+		uuids = getUUIDs(entity.TimelineFilters{Direction: domain.TimelineDirectionAll})
+		require.Len(t, uuids, 5)
+	})
+
+	t.Run("Should filter by date range", func(t *testing.T) {
+		from := january.AddDate(0, 0, 10)
+		to := mention.CreatedAt
+		require.Equal(t, []string{givenFeedback.UUID}, getUUIDs(entity.TimelineFilters{From: &from, To: &to}))
+
+		to = to.Add(time.Second)
+		require.Equal(t, []string{mention.UUID, givenFeedback.UUID}, getUUIDs(entity.TimelineFilters{From: &from, To: &to}))
+	})
+
+	t.Run("Should filter by author", func(t *testing.T) {
+		require.Equal(t, []string{givenFeedback.UUID}, getUUIDs(entity.TimelineFilters{AuthorUserID: &otherAuthor.ID}))
+	})
+
+	t.Run("Should filter by feedback category", func(t *testing.T) {
+		uuids := getUUIDs(entity.TimelineFilters{FeedbackCategories: []string{domain.FeedbackCategoryBehavior}})
+		require.Equal(t, []string{mention.UUID}, uuids)
+
+		uuids = getUUIDs(entity.TimelineFilters{FeedbackCategories: []string{domain.FeedbackCategoryBehavior, domain.FeedbackCategorySkill}})
+		require.Equal(t, []string{mention.UUID, givenFeedback.UUID}, uuids)
+	})
+
+	t.Run("Should combine the filters with the pagination", func(t *testing.T) {
+		filters := entity.TimelineFilters{Direction: domain.TimelineDirectionAboutPerson, Sort: domain.TimelineSortOldest}
+		timeline, totalRecords, err := testMysql.Note().GetPersonTimeline(ctx, person.ID, filters, 2, 2)
+		require.NoError(t, err)
+		require.Equal(t, int64(4), totalRecords)
+		require.Len(t, timeline, 2)
+		require.Equal(t, oneOnOne.UUID, timeline[0].UUID)
+		require.Equal(t, sameTime.UUID, timeline[1].UUID)
+	})
+
+	t.Run("Should page by cursor without skipping or repeating entries", func(t *testing.T) {
+		page := entity.CursorPage{Limit: 2, WithCount: true}
+		var uuids []string
+		for pages := 1; ; pages++ {
+			timeline, info, err := testMysql.Note().GetPersonTimelinePage(ctx, person.ID, entity.TimelineFilters{}, page)
+			require.NoError(t, err)
+			require.Equal(t, int64(5), *info.TotalRecords)
+			for _, entry := range timeline {
+				uuids = append(uuids, entry.UUID)
+			}
+
+			if info.Next == nil {
+				require.Equal(t, 3, pages)
+				break
+			}
+			page.After = info.Next
+		}
+		require.Equal(t, []string{sameTime.UUID, oneOnOne.UUID, mention.UUID, givenFeedback.UUID, observation.UUID}, uuids)
+	})
+
+	t.Run("Should page by cursor in the oldest first order with the filters", func(t *testing.T) {
+		filters := entity.TimelineFilters{Direction: domain.TimelineDirectionAboutPerson, Sort: domain.TimelineSortOldest}
+		after := entity.PageCursor{CreatedAt: mention.CreatedAt, ID: mention.ID}
+
+		timeline, info, err := testMysql.Note().GetPersonTimelinePage(ctx, person.ID, filters, entity.CursorPage{After: &after, Limit: 2})
+		require.NoError(t, err)
+		require.Nil(t, info.Next)
+		require.Nil(t, info.TotalRecords)
+		require.Len(t, timeline, 2)
+		require.Equal(t, oneOnOne.UUID, timeline[0].UUID)
+		require.Equal(t, sameTime.UUID, timeline[1].UUID)
+	})
+
+	t.Run("Should page the mentions by cursor", func(t *testing.T) {
+		sameTimeMention := createNote(other.ID, person.CreatedBy, domain.NoteTypeObservation, "", mention.CreatedAt, person.ID)
+
+		mentions, info, err := testMysql.Note().GetPersonMentionsPage(ctx, person.ID, entity.CursorPage{Limit: 1, WithCount: true})
+		require.NoError(t, err)
+		require.Equal(t, int64(2), *info.TotalRecords)
+		require.Len(t, mentions, 1)
+		require.Equal(t, sameTimeMention.UUID, mentions[0].UUID)
+		require.NotNil(t, info.Next)
+
+		mentions, info, err = testMysql.Note().GetPersonMentionsPage(ctx, person.ID, entity.CursorPage{After: info.Next, Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, mentions, 1)
+		require.Equal(t, mention.UUID, mentions[0].UUID)
+		require.Nil(t, info.Next)
+	})
+}
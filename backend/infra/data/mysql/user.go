@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/diegoclair/go_utils/mysqlutils"
 	"github.com/diegoclair/leaderpro/internal/domain/contract"
@@ -44,6 +45,7 @@ const userPreferencesSelectBase string = `
 		up.id,
 		up.user_id,
 		up.theme,
+		up.timezone,
 		up.created_at,
 		up.updated_at
 	
@@ -258,6 +260,7 @@ func (r *userRepo) parseUserPreferences(row scanner) (preferences entity.UserPre
 		&preferences.ID,
 		&preferences.UserID,
 		&preferences.Theme,
+		&preferences.Timezone,
 		&preferences.CreatedAt,
 		&preferences.UpdatedAt,
 	)
@@ -293,9 +296,10 @@ func (r *userRepo) CreateUserPreferences(ctx context.Context, preferences entity
 	query := `
 		INSERT INTO user_preferences (
 			user_id,
-			theme
+			theme,
+			timezone
 		) 
-		VALUES (?, ?);
+		VALUES (?, ?, ?);
 	`
 
 	stmt, err := r.db.PrepareContext(ctx, query)
@@ -307,6 +311,7 @@ func (r *userRepo) CreateUserPreferences(ctx context.Context, preferences entity
 	result, err := stmt.ExecContext(ctx,
 		preferences.UserID,
 		preferences.Theme,
+		preferences.Timezone,
 	)
 	if err != nil {
 		return createdID, mysqlutils.HandleMySQLError(err)
@@ -324,7 +329,8 @@ func (r *userRepo) UpdateUserPreferences(ctx context.Context, userID int64, pref
 	query := `
 		UPDATE user_preferences
 		SET 
-			theme = ?
+			theme = ?,
+			timezone = ?
 		WHERE user_id = ?
 	`
 
@@ -336,6 +342,7 @@ func (r *userRepo) UpdateUserPreferences(ctx context.Context, userID int64, pref
 
 	_, err = stmt.ExecContext(ctx,
 		preferences.Theme,
+		preferences.Timezone,
 		userID,
 	)
 	if err != nil {
@@ -344,3 +351,83 @@ func (r *userRepo) UpdateUserPreferences(ctx context.Context, userID int64, pref
 
 	return nil
 }
+
+func (r *userRepo) SaveCalendarFeedToken(ctx context.Context, userID int64, tokenHash string) (err error) {
+	query := `
+		INSERT INTO tab_user_calendar_feed (
+			user_id,
+			token_hash
+		)
+		VALUES (?, ?)
+		ON DUPLICATE KEY UPDATE
+			token_hash = VALUES(token_hash),
+			created_at = NOW()
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	_, err = stmt.ExecContext(ctx, userID, tokenHash)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	return nil
+}
+
+func (r *userRepo) GetUserIDByCalendarFeedToken(ctx context.Context, tokenHash string) (userID int64, err error) {
+	query := `
+		SELECT cf.user_id
+
+		FROM  tab_user_calendar_feed cf
+		INNER JOIN tab_user u
+			ON u.user_id = cf.user_id
+		WHERE cf.token_hash = ?
+		  AND u.active      = 1
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return userID, mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	err = stmt.QueryRowContext(ctx, tokenHash).Scan(&userID)
+	if err != nil {
+		return userID, mysqlutils.HandleMySQLError(err)
+	}
+
+	return userID, nil
+}
+
+func (r *userRepo) DeleteCalendarFeedToken(ctx context.Context, userID int64) (err error) {
+	query := `
+		DELETE FROM tab_user_calendar_feed
+		WHERE user_id = ?
+	`
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+	defer stmt.Close()
+
+	result, err := stmt.ExecContext(ctx, userID)
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return mysqlutils.HandleMySQLError(err)
+	}
+
+	if rowsAffected == 0 {
+		return mysqlutils.HandleMySQLError(sql.ErrNoRows)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"testing"
 	"time"
 
@@ -247,3 +248,31 @@ func TestUpdateLastLoginErrorsWithMock(t *testing.T) {
 		return newUserRepo(db).UpdateLastLogin(context.Background(), 1)
 	})
 }
+
+func TestCalendarFeedToken(t *testing.T) {
+	ctx := context.Background()
+	user := createRandomUser(t)
+	tokenHash := strings.ReplaceAll(uuid.NewV4().String()+uuid.NewV4().String(), "-", "")
+
+	err := testMysql.User().SaveCalendarFeedToken(ctx, user.ID, tokenHash)
+	require.NoError(t, err)
+
+	userID, err := testMysql.User().GetUserIDByCalendarFeedToken(ctx, tokenHash)
+	require.NoError(t, err)
+	require.Equal(t, user.ID, userID)
+
+	err = testMysql.User().DeleteCalendarFeedToken(ctx, user.ID)
+	require.NoError(t, err)
+
+	_, err = testMysql.User().GetUserIDByCalendarFeedToken(ctx, tokenHash)
+	require.Error(t, err)
+
+	err = testMysql.User().DeleteCalendarFeedToken(ctx, user.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestDeleteCalendarFeedTokenErrorsWithMock(t *testing.T) {
+	testForUpdateDeleteErrorsWithMock(t, func(db *sql.DB) error {
+		return newUserRepo(db).DeleteCalendarFeedToken(context.Background(), 1)
+	})
+}
@@ -17,10 +17,16 @@ const gracefulShutdownTimeout = 10 * time.Second
 
 type ShutdownOptions func(s *shutdown)
 
+// Worker is a background process stopped after the servers, so it can finish its current work
+type Worker interface {
+	Stop(ctx context.Context) error
+}
+
 type shutdown struct {
 	restServer *echo.Echo
 	grpcServer *grpc.Server
 	listener   net.Listener
+	workers    []Worker
 }
 
 func GracefulShutdown(ctx context.Context, log logger.Logger, opts ...ShutdownOptions) {
@@ -59,6 +65,15 @@ func GracefulShutdown(ctx context.Context, log logger.Logger, opts ...ShutdownOp
 	if s.listener != nil {
 		s.listener.Close()
 	}
+
+	for _, worker := range s.workers {
+		ctx, cancel := context.WithTimeout(context.Background(), gracefulShutdownTimeout)
+		err := worker.Stop(ctx)
+		cancel()
+		if err != nil {
+			log.Errorw(ctx, "Failed to stop worker", logger.Err(err))
+		}
+	}
 }
 
 func WithRestServer(restServer *echo.Echo) ShutdownOptions {
@@ -78,3 +93,9 @@ func WithListener(listener net.Listener) ShutdownOptions {
 		s.listener = listener
 	}
 }
+
+func WithWorkers(workers ...Worker) ShutdownOptions {
+	return func(s *shutdown) {
+		s.workers = append(s.workers, workers...)
+	}
+}
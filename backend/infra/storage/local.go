@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+)
+
+// LocalStorage keeps the blobs as files under a root directory, the key being the relative path of the file
+type LocalStorage struct {
+	root string
+}
+
+// NewLocalStorage returns a blob storage in the root directory, creating it when it does not exist
+func NewLocalStorage(root string) (*LocalStorage, error) {
+	if root == "" {
+		return nil, errors.New("local storage path is required")
+	}
+
+	root, err := filepath.Abs(root)
+	if err != nil {
+		return nil, fmt.Errorf("invalid local storage path: %w", err)
+	}
+
+	err = os.MkdirAll(root, 0o750)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create local storage directory: %w", err)
+	}
+
+	return &LocalStorage{root: root}, nil
+}
+
+// path returns the file of the key, refusing keys that would leave the root directory
+func (s *LocalStorage) path(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("invalid blob key: %q", key)
+	}
+
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return "", fmt.Errorf("invalid blob key: %q", key)
+		}
+	}
+
+	return filepath.Join(s.root, filepath.FromSlash(key)), nil
+}
+
+// Put writes the content to a temporary file renamed at the end, so a failed upload never leaves a partial file
+func (s *LocalStorage) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(path)
+	err = os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return fmt.Errorf("failed to create blob directory: %w", err)
+	}
+
+	file, err := os.CreateTemp(dir, ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to create blob file: %w", err)
+	}
+	defer os.Remove(file.Name()) // no-op after the rename
+
+	written, err := io.Copy(file, content)
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	err = file.Close()
+	if err != nil {
+		return fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	if size >= 0 && written != size {
+		return fmt.Errorf("blob has %d bytes, expected %d", written, size)
+	}
+
+	err = os.Rename(file.Name(), path)
+	if err != nil {
+		return fmt.Errorf("failed to save blob: %w", err)
+	}
+
+	return nil
+}
+
+func (s *LocalStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, domain.ErrBlobNotFound
+		}
+		return nil, fmt.Errorf("failed to open blob: %w", err)
+	}
+
+	return file, nil
+}
+
+func (s *LocalStorage) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+
+	s, err := NewLocalStorage(filepath.Join(root, "blobs"))
+	require.NoError(t, err)
+
+	t.Run("Should put, get and delete a blob", func(t *testing.T) {
+		key := "notes/1/attachment-uuid"
+		require.NoError(t, s.Put(ctx, key, strings.NewReader("screenshot"), 10, "image/png"))
+
+		content, err := s.Get(ctx, key)
+		require.NoError(t, err)
+		data, err := io.ReadAll(content)
+		require.NoError(t, err)
+		require.NoError(t, content.Close())
+		require.Equal(t, "screenshot", string(data))
+
+		require.NoError(t, s.Delete(ctx, key))
+		_, err = s.Get(ctx, key)
+		require.ErrorIs(t, err, domain.ErrBlobNotFound)
+
+		// deleting again is not an error
+		require.NoError(t, s.Delete(ctx, key))
+	})
+
+	t.Run("Should not keep a blob with a different size", func(t *testing.T) {
+		key := "notes/1/partial"
+		require.Error(t, s.Put(ctx, key, strings.NewReader("short"), 10, "text/plain"))
+
+		_, err := s.Get(ctx, key)
+		require.ErrorIs(t, err, domain.ErrBlobNotFound)
+
+		entries, err := os.ReadDir(filepath.Join(root, "blobs", "notes", "1"))
+		require.NoError(t, err)
+		require.Empty(t, entries, "the temporary file must be removed")
+	})
+
+	t.Run("Should refuse keys out of the root directory", func(t *testing.T) {
+		for _, key := range []string{"", "/etc/passwd", "../outside", "notes/../../outside", "notes//1", `notes\1`} {
+			require.Error(t, s.Put(ctx, key, strings.NewReader("x"), 1, "text/plain"), key)
+		}
+
+		_, err := os.Stat(filepath.Join(root, "outside"))
+		require.True(t, os.IsNotExist(err))
+	})
+}
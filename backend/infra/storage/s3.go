@@ -0,0 +1,267 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+)
+
+const (
+	s3Service         = "s3"
+	s3SigningAlgo     = "AWS4-HMAC-SHA256"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	s3EmptyPayload    = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855" // sha256 of no content
+	s3RequestTimeout  = 5 * time.Minute
+	s3MaxErrorBody    = 1024
+)
+
+// S3Config is the bucket used by the S3 storage. The endpoint can be AWS or any S3 compatible service, like MinIO.
+// PathStyle sends the bucket in the path (endpoint/bucket/key) instead of the host (bucket.endpoint/key),
+// which is what most self hosted services expect
+type S3Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	PathStyle bool
+}
+
+// S3Storage keeps the blobs as objects of a bucket of an S3 compatible service,
+// calling its REST API with requests signed with AWS Signature Version 4
+type S3Storage struct {
+	cfg      S3Config
+	endpoint *url.URL
+	client   *http.Client
+	now      func() time.Time
+}
+
+// NewS3Storage returns a blob storage in the bucket of the configuration
+func NewS3Storage(cfg S3Config) (*S3Storage, error) {
+	if cfg.Endpoint == "" || cfg.Region == "" || cfg.Bucket == "" {
+		return nil, errors.New("s3 endpoint, region and bucket are required")
+	}
+	if cfg.AccessKey == "" || cfg.SecretKey == "" {
+		return nil, errors.New("s3 access key and secret key are required")
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(cfg.Endpoint, "/"))
+	if err != nil || endpoint.Host == "" || (endpoint.Scheme != "http" && endpoint.Scheme != "https") {
+		return nil, fmt.Errorf("invalid s3 endpoint: %s", cfg.Endpoint)
+	}
+
+	return &S3Storage{
+		cfg:      cfg,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: s3RequestTimeout},
+		now:      time.Now,
+	}, nil
+}
+
+// objectURL returns the URL of the object of the key
+func (s *S3Storage) objectURL(key string) *url.URL {
+	objectURL := *s.endpoint
+	objectPath := "/" + key
+	if s.cfg.PathStyle {
+		objectPath = "/" + s.cfg.Bucket + objectPath
+	} else {
+		objectURL.Host = s.cfg.Bucket + "." + objectURL.Host
+	}
+
+	objectURL.Path = s.endpoint.Path + objectPath
+	objectURL.RawPath = s3EscapePath(objectURL.Path)
+	return &objectURL
+}
+
+// do signs and sends the request, returning an error for the statuses out of 2xx other than the accepted ones
+func (s *S3Storage) do(req *http.Request, payloadHash string, acceptedStatuses ...int) (*http.Response, error) {
+	signS3Request(req, payloadHash, s.cfg.AccessKey, s.cfg.SecretKey, s.cfg.Region, s.now())
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("s3 request failed: %w", err)
+	}
+
+	if resp.StatusCode/100 == 2 {
+		return resp, nil
+	}
+	for _, status := range acceptedStatuses {
+		if resp.StatusCode == status {
+			return resp, nil
+		}
+	}
+
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, s3MaxErrorBody))
+	return nil, fmt.Errorf("s3 %s returned %d: %s", req.Method, resp.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// Put uploads the content without hashing it first, so it does not need to be kept in memory.
+// The transport is still protected by TLS and the size by the content length
+func (s *S3Storage) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	if size == 0 {
+		content = http.NoBody // otherwise the body would be sent chunked, which S3 does not accept
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key).String(), content)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := s.do(req, s3UnsignedPayload)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectURL(key).String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.do(req, s3EmptyPayload, http.StatusNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, domain.ErrBlobNotFound
+	}
+
+	return resp.Body, nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(key).String(), nil)
+	if err != nil {
+		return err
+	}
+
+	// S3 already answers 204 for a missing key, some compatible services answer 404
+	resp, err := s.do(req, s3EmptyPayload, http.StatusNotFound)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return nil
+}
+
+// signS3Request adds the AWS Signature Version 4 authorization to the request, signing the host and all its headers
+func signS3Request(req *http.Request, payloadHash, accessKey, secretKey, region string, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	day := amzDate[:8]
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+
+	headers := map[string]string{"host": host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		s3EscapePath(req.URL.Path),
+		s3CanonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := day + "/" + region + "/" + s3Service + "/aws4_request"
+	stringToSign := strings.Join([]string{s3SigningAlgo, amzDate, scope, sha256Hex(canonicalRequest)}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+secretKey), day)
+	signingKey = hmacSHA256(signingKey, region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3SigningAlgo, accessKey, scope, signedHeaders, signature))
+}
+
+// s3CanonicalQuery returns the query sorted by name with the values escaped as S3 expects
+func s3CanonicalQuery(query url.Values) string {
+	params := make([]string, 0, len(query))
+	for name, values := range query {
+		for _, value := range values {
+			params = append(params, s3Escape(name, true)+"="+s3Escape(value, true))
+		}
+	}
+	sort.Strings(params)
+	return strings.Join(params, "&")
+}
+
+// s3EscapePath escapes the path keeping its slashes
+func s3EscapePath(path string) string {
+	return s3Escape(path, false)
+}
+
+// s3Escape escapes everything but the unreserved characters, the slash included only when escapeSlash is true
+func s3Escape(value string, escapeSlash bool) string {
+	var escaped strings.Builder
+	for _, b := range []byte(value) {
+		switch {
+		case b >= 'A' && b <= 'Z', b >= 'a' && b <= 'z', b >= '0' && b <= '9',
+			b == '-', b == '_', b == '.', b == '~':
+			escaped.WriteByte(b)
+		case b == '/' && !escapeSlash:
+			escaped.WriteByte(b)
+		default:
+			fmt.Fprintf(&escaped, "%%%02X", b)
+		}
+	}
+	return escaped.String()
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sha256Hex(data string) string {
+	hash := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/date"
+	"github.com/twinj/uuid"
+)
+
+type actionItemApp struct {
+	dm        contract.DataManager
+	log       logger.Logger
+	authApp   contract.AuthApp
+	userApp   contract.UserApp
+	personApp *personApp
+}
+
+func newActionItemApp(infra domain.Infrastructure, authApp contract.AuthApp, userApp contract.UserApp, personApp *personApp) contract.ActionItemApp {
+	return &actionItemApp{
+		dm:        infra.DataManager(),
+		log:       infra.Logger(),
+		authApp:   authApp,
+		userApp:   userApp,
+		personApp: personApp,
+	}
+}
+
+// getAuthorizedActionItem loads an action item by UUID and checks that the logged user owns the item's company
+func (s *actionItemApp) getAuthorizedActionItem(ctx context.Context, itemUUID string) (entity.ActionItem, error) {
+	item, err := s.dm.ActionItem().GetActionItemByUUID(ctx, itemUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return item, resterrors.NewNotFoundError("action item not found")
+		}
+		s.log.Errorw(ctx, "error getting action item by UUID", logger.Err(err))
+		return item, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return item, err
+	}
+
+	_, err = s.personApp.validateUserCompanyAccess(ctx, userID, item.CompanyID)
+	if err != nil {
+		return item, err
+	}
+
+	return item, nil
+}
+
+// normalizeActionItem trims the description and validates the owner and the status, applying their defaults
+func normalizeActionItem(item *entity.ActionItem) error {
+	item.Description = strings.TrimSpace(item.Description)
+	if item.Description == "" {
+		return resterrors.NewBadRequestError("description is required")
+	}
+
+	if item.Owner == "" {
+		item.Owner = domain.ActionItemOwnerManager
+	}
+	if item.Owner != domain.ActionItemOwnerManager && item.Owner != domain.ActionItemOwnerReport {
+		return resterrors.NewBadRequestError("owner must be manager or report")
+	}
+
+	if item.Status == "" {
+		item.Status = domain.ActionItemStatusOpen
+	}
+	switch item.Status {
+	case domain.ActionItemStatusOpen, domain.ActionItemStatusDone, domain.ActionItemStatusCanceled:
+	default:
+		return resterrors.NewBadRequestError("status must be open, done or canceled")
+	}
+
+	if item.DueDate != nil {
+		dueDate := date.Day(*item.DueDate)
+		item.DueDate = &dueDate
+	}
+
+	return nil
+}
+
+// actionItemAgendaItem builds the agenda item that carries an open action item into a meeting
+func actionItemAgendaItem(meetingID int64, item entity.ActionItem) entity.MeetingAgendaItem {
+	return entity.MeetingAgendaItem{
+		UUID:         uuid.NewV4().String(),
+		MeetingID:    meetingID,
+		ActionItemID: &item.ID,
+		AddedBy:      domain.AgendaItemAddedByManager,
+		Content:      item.Description,
+	}
+}
+
+func (s *actionItemApp) CreateActionItem(ctx context.Context, personUUID string, item entity.ActionItem) (entity.ActionItem, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return item, err
+	}
+
+	item.Status = domain.ActionItemStatusOpen
+	if err := normalizeActionItem(&item); err != nil {
+		return item, err
+	}
+
+	if item.SourceNoteUUID != nil && *item.SourceNoteUUID != "" {
+		note, err := s.dm.Note().GetNoteByUUID(ctx, *item.SourceNoteUUID)
+		if err != nil {
+			if mysqlutils.SQLNotFound(err.Error()) {
+				return item, resterrors.NewNotFoundError("note not found")
+			}
+			s.log.Errorw(ctx, "error getting note by UUID", logger.Err(err))
+			return item, err
+		}
+		if note.PersonID != person.ID {
+			return item, resterrors.NewBadRequestError("note does not belong to this person")
+		}
+		item.SourceNoteID = &note.ID
+	}
+
+	if item.SourceMeetingUUID != nil && *item.SourceMeetingUUID != "" {
+		meeting, err := s.dm.Meeting().GetMeetingByUUID(ctx, *item.SourceMeetingUUID)
+		if err != nil {
+			if mysqlutils.SQLNotFound(err.Error()) {
+				return item, resterrors.NewNotFoundError("meeting not found")
+			}
+			s.log.Errorw(ctx, "error getting meeting by UUID", logger.Err(err))
+			return item, err
+		}
+		if meeting.PersonID != person.ID {
+			return item, resterrors.NewBadRequestError("meeting does not belong to this person")
+		}
+		item.SourceMeetingID = &meeting.ID
+	}
+
+	item.UUID = uuid.NewV4().String()
+	item.CompanyID = person.CompanyID
+	item.PersonID = person.ID
+	item.UserID, err = s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return item, err
+	}
+
+	// Create the item with its agenda entry in the next scheduled 1:1
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) (err error) {
+		item.ID, err = tx.ActionItem().CreateActionItem(ctx, item)
+		if err != nil {
+			return err
+		}
+
+		return addToNextMeetingAgenda(ctx, tx, item)
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error creating action item", logger.Err(err))
+		return item, err
+	}
+
+	s.log.Infow(ctx, "action item created successfully",
+		logger.String("action_item_uuid", item.UUID),
+		logger.String("person_uuid", personUUID),
+	)
+
+	created, err := s.dm.ActionItem().GetActionItemByUUID(ctx, item.UUID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting created action item", logger.Err(err))
+		return item, err
+	}
+
+	return created, nil
+}
+
+// addToNextMeetingAgenda carries the new item into the agenda of the next scheduled 1:1, when there is one.
+// Items created without a scheduled meeting are carried when the next meeting is scheduled
+func addToNextMeetingAgenda(ctx context.Context, tx contract.DataManager, item entity.ActionItem) error {
+	meeting, err := tx.Meeting().GetNextScheduledMeeting(ctx, item.PersonID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return nil
+		}
+		return err
+	}
+
+	// the item was created during this same meeting, before it was marked as held
+	if item.SourceMeetingID != nil && *item.SourceMeetingID == meeting.ID {
+		return nil
+	}
+
+	_, err = tx.Meeting().CreateAgendaItem(ctx, actionItemAgendaItem(meeting.ID, item))
+	return err
+}
+
+func (s *actionItemApp) GetPersonActionItems(ctx context.Context, personUUID, status string, take, skip int64) ([]entity.ActionItem, int64, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	items, totalRecords, err := s.dm.ActionItem().GetActionItemsByPerson(ctx, person.ID, status, take, skip)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting person action items", logger.Err(err))
+		return nil, 0, err
+	}
+
+	return items, totalRecords, nil
+}
+
+func (s *actionItemApp) UpdateActionItem(ctx context.Context, itemUUID string, item entity.ActionItem) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	existing, err := s.getAuthorizedActionItem(ctx, itemUUID)
+	if err != nil {
+		return err
+	}
+
+	if item.Owner == "" {
+		item.Owner = existing.Owner
+	}
+	if item.Status == "" {
+		item.Status = existing.Status
+	}
+
+	if err := normalizeActionItem(&item); err != nil {
+		return err
+	}
+
+	switch {
+	case item.Status == domain.ActionItemStatusDone && existing.Status != domain.ActionItemStatusDone:
+		completedAt := time.Now()
+		existing.CompletedAt = &completedAt
+	case item.Status != domain.ActionItemStatusDone:
+		existing.CompletedAt = nil
+	}
+
+	existing.Owner = item.Owner
+	existing.Description = item.Description
+	existing.DueDate = item.DueDate
+	existing.Status = item.Status
+
+	err = s.dm.ActionItem().UpdateActionItem(ctx, existing.ID, existing)
+	if err != nil {
+		s.log.Errorw(ctx, "error updating action item", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *actionItemApp) DeleteActionItem(ctx context.Context, itemUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	item, err := s.getAuthorizedActionItem(ctx, itemUUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.ActionItem().DeleteActionItem(ctx, item.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error deleting action item", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *actionItemApp) GetOverdueActionItems(ctx context.Context) ([]entity.ActionItem, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := s.userApp.GetUserPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	today := date.Today(time.Now(), preferences.Location())
+
+	items, err := s.dm.ActionItem().GetOverdueActionItemsByCompany(ctx, company.ID, today)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting overdue action items", logger.Err(err))
+		return nil, err
+	}
+
+	return items, nil
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_normalizeActionItem(t *testing.T) {
+	t.Run("Should apply the defaults and drop the time of the due date", func(t *testing.T) {
+		dueDate := time.Date(2025, time.March, 10, 18, 30, 0, 0, time.UTC)
+		item := entity.ActionItem{Description: "  Share the promotion criteria  ", DueDate: &dueDate}
+
+		err := normalizeActionItem(&item)
+		require.NoError(t, err)
+		require.Equal(t, "Share the promotion criteria", item.Description)
+		require.Equal(t, domain.ActionItemOwnerManager, item.Owner)
+		require.Equal(t, domain.ActionItemStatusOpen, item.Status)
+		require.Equal(t, *datePointer(2025, time.March, 10), *item.DueDate)
+	})
+
+	t.Run("Should return error when the description is empty", func(t *testing.T) {
+		item := entity.ActionItem{Description: "   "}
+		require.Error(t, normalizeActionItem(&item))
+	})
+
+	t.Run("Should return error when the owner is invalid", func(t *testing.T) {
+		item := entity.ActionItem{Description: "Review the PDI", Owner: "team"}
+		require.Error(t, normalizeActionItem(&item))
+	})
+
+	t.Run("Should return error when the status is invalid", func(t *testing.T) {
+		item := entity.ActionItem{Description: "Review the PDI", Status: "blocked"}
+		require.Error(t, normalizeActionItem(&item))
+	})
+}
+
+func Test_ActionItemIsOverdue(t *testing.T) {
+	today := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		item     entity.ActionItem
+		expected bool
+	}{
+		{
+			name:     "open item due yesterday",
+			item:     entity.ActionItem{Status: domain.ActionItemStatusOpen, DueDate: datePointer(2025, time.March, 9)},
+			expected: true,
+		},
+		{
+			name:     "open item due today",
+			item:     entity.ActionItem{Status: domain.ActionItemStatusOpen, DueDate: datePointer(2025, time.March, 10)},
+			expected: false,
+		},
+		{
+			name:     "done item due yesterday",
+			item:     entity.ActionItem{Status: domain.ActionItemStatusDone, DueDate: datePointer(2025, time.March, 9)},
+			expected: false,
+		},
+		{
+			name:     "open item without due date",
+			item:     entity.ActionItem{Status: domain.ActionItemStatusOpen},
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.item.IsOverdue(today))
+		})
+	}
+}
+
+func Test_addToNextMeetingAgenda(t *testing.T) {
+	ctx := context.Background()
+	item := entity.ActionItem{ID: 7, UUID: "item-uuid", PersonID: 3, Description: "Review the PDI"}
+
+	t.Run("Should add the item to the agenda of the next scheduled meeting", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		meetingRepo := mocks.NewMockMeetingRepo(ctrl)
+		m.mockDataManager.EXPECT().Meeting().Return(meetingRepo).AnyTimes()
+
+		meetingRepo.EXPECT().GetNextScheduledMeeting(ctx, item.PersonID).Return(entity.Meeting{ID: 20}, nil).Times(1)
+		meetingRepo.EXPECT().CreateAgendaItem(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, agendaItem entity.MeetingAgendaItem) (int64, error) {
+			require.Equal(t, int64(20), agendaItem.MeetingID)
+			require.Equal(t, item.ID, *agendaItem.ActionItemID)
+			require.Equal(t, item.Description, agendaItem.Content)
+			return 1, nil
+		}).Times(1)
+
+		require.NoError(t, addToNextMeetingAgenda(ctx, m.mockDataManager, item))
+	})
+
+	t.Run("Should do nothing when the item was created in the next meeting", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		meetingRepo := mocks.NewMockMeetingRepo(ctrl)
+		m.mockDataManager.EXPECT().Meeting().Return(meetingRepo).AnyTimes()
+
+		meetingID := int64(20)
+		fromMeeting := item
+		fromMeeting.SourceMeetingID = &meetingID
+		meetingRepo.EXPECT().GetNextScheduledMeeting(ctx, item.PersonID).Return(entity.Meeting{ID: meetingID}, nil).Times(1)
+
+		require.NoError(t, addToNextMeetingAgenda(ctx, m.mockDataManager, fromMeeting))
+	})
+
+	t.Run("Should do nothing without a scheduled meeting", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		meetingRepo := mocks.NewMockMeetingRepo(ctrl)
+		m.mockDataManager.EXPECT().Meeting().Return(meetingRepo).AnyTimes()
+
+		meetingRepo.EXPECT().GetNextScheduledMeeting(ctx, item.PersonID).Return(entity.Meeting{}, sql.ErrNoRows).Times(1)
+
+		require.NoError(t, addToNextMeetingAgenda(ctx, m.mockDataManager, item))
+	})
+
+	t.Run("Should return the error of the agenda so the item is rolled back", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		meetingRepo := mocks.NewMockMeetingRepo(ctrl)
+		m.mockDataManager.EXPECT().Meeting().Return(meetingRepo).AnyTimes()
+
+		meetingRepo.EXPECT().GetNextScheduledMeeting(ctx, item.PersonID).Return(entity.Meeting{ID: 20}, nil).Times(1)
+		meetingRepo.EXPECT().CreateAgendaItem(ctx, gomock.Any()).Return(int64(0), errors.New("db error")).Times(1)
+
+		require.Error(t, addToNextMeetingAgenda(ctx, m.mockDataManager, item))
+	})
+}
+
+func Test_carryOverActionItems(t *testing.T) {
+	ctx := context.Background()
+	items := []entity.ActionItem{{ID: 7, UUID: "item-uuid", Description: "Review the PDI"}, {ID: 8, UUID: "other-uuid", Description: "Share the criteria"}}
+
+	t.Run("Should add the open items to the agenda of the meeting", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		meetingRepo := mocks.NewMockMeetingRepo(ctrl)
+		actionItemRepo := mocks.NewMockActionItemRepo(ctrl)
+		m.mockDataManager.EXPECT().Meeting().Return(meetingRepo).AnyTimes()
+		m.mockDataManager.EXPECT().ActionItem().Return(actionItemRepo).AnyTimes()
+
+		var agenda []entity.MeetingAgendaItem
+		actionItemRepo.EXPECT().GetActionItemsToCarryOver(ctx, int64(3)).Return(items, nil).Times(1)
+		meetingRepo.EXPECT().CreateAgendaItem(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, agendaItem entity.MeetingAgendaItem) (int64, error) {
+			require.Equal(t, int64(20), agendaItem.MeetingID)
+			require.Equal(t, items[len(agenda)].ID, *agendaItem.ActionItemID)
+			agenda = append(agenda, agendaItem)
+			return int64(len(agenda)), nil
+		}).Times(2)
+		meetingRepo.EXPECT().GetAgendaItemsByMeeting(ctx, int64(20)).DoAndReturn(func(context.Context, int64) ([]entity.MeetingAgendaItem, error) {
+			return agenda, nil
+		}).Times(1)
+
+		meeting := entity.Meeting{ID: 20, PersonID: 3}
+		require.NoError(t, carryOverActionItems(ctx, m.mockDataManager, &meeting))
+		require.Equal(t, agenda, meeting.AgendaItems)
+	})
+
+	t.Run("Should return the error of the agenda so the meeting is rolled back", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		meetingRepo := mocks.NewMockMeetingRepo(ctrl)
+		actionItemRepo := mocks.NewMockActionItemRepo(ctrl)
+		m.mockDataManager.EXPECT().Meeting().Return(meetingRepo).AnyTimes()
+		m.mockDataManager.EXPECT().ActionItem().Return(actionItemRepo).AnyTimes()
+
+		actionItemRepo.EXPECT().GetActionItemsToCarryOver(ctx, int64(3)).Return(items, nil).Times(1)
+		meetingRepo.EXPECT().CreateAgendaItem(ctx, gomock.Any()).Return(int64(0), errors.New("db error")).Times(1)
+
+		meeting := entity.Meeting{ID: 20, PersonID: 3}
+		require.Error(t, carryOverActionItems(ctx, m.mockDataManager, &meeting))
+	})
+}
@@ -0,0 +1,260 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/twinj/uuid"
+)
+
+var activityTypes = []string{
+	domain.ActivityTypeNoteCreated,
+	domain.ActivityTypeFeedbackCreated,
+	domain.ActivityTypePersonMentioned,
+	domain.ActivityTypeGoalUpdated,
+	domain.ActivityTypeProfileUpdated,
+	domain.ActivityTypeAttributeExtracted,
+	domain.ActivityTypeReminder,
+}
+
+type activityApp struct {
+	dm        contract.DataManager
+	log       logger.Logger
+	authApp   contract.AuthApp
+	personApp *personApp
+}
+
+func newActivityApp(infra domain.Infrastructure, authApp contract.AuthApp, personApp *personApp) contract.ActivityApp {
+	return &activityApp{
+		dm:        infra.DataManager(),
+		log:       infra.Logger(),
+		authApp:   authApp,
+		personApp: personApp,
+	}
+}
+
+// recordActivity adds an event to the feed of the company. It runs in the transaction that writes what the event is about,
+// so the feed never shows something that was rolled back
+func recordActivity(ctx context.Context, tx contract.DataManager, activity entity.Activity) error {
+	if activity.UUID == "" {
+		activity.UUID = uuid.NewV4().String()
+	}
+	activity.CreatedAt = time.Now()
+	if detail := []rune(activity.Detail); len(detail) > domain.ActivityDetailMaxLength {
+		activity.Detail = string(detail[:domain.ActivityDetailMaxLength])
+	}
+
+	_, err := tx.Activity().CreateActivity(ctx, activity)
+	return err
+}
+
+// recordNoteActivities adds the creation of the note to the feed of its person and a mention event to the feed
+// of each mentioned person
+func recordNoteActivities(ctx context.Context, tx contract.DataManager, note entity.Note, person entity.Person, mentioned []entity.Person) error {
+	activity := entity.Activity{
+		CompanyID:     note.CompanyID,
+		PersonID:      note.PersonID,
+		UserID:        &note.UserID,
+		Type:          domain.ActivityTypeNoteCreated,
+		Detail:        note.Type,
+		ReferenceUUID: &note.UUID,
+	}
+	if note.IsFeedback() {
+		activity.Type = domain.ActivityTypeFeedbackCreated
+		activity.Detail = ""
+		if note.FeedbackType != nil {
+			activity.Detail = *note.FeedbackType
+		}
+	}
+
+	err := recordActivity(ctx, tx, activity)
+	if err != nil {
+		return err
+	}
+
+	for _, mentionedPerson := range mentioned {
+		activity.PersonID = mentionedPerson.ID
+		activity.Type = domain.ActivityTypePersonMentioned
+		activity.Detail = person.Name
+		err = recordActivity(ctx, tx, activity)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordGoalActivity adds a goal event of the person to the feed, the event is one of the GoalTimeline constants
+func recordGoalActivity(ctx context.Context, tx contract.DataManager, goal entity.Goal, userID int64, event string) error {
+	return recordActivity(ctx, tx, entity.Activity{
+		CompanyID:     goal.CompanyID,
+		PersonID:      goal.PersonID,
+		UserID:        &userID,
+		Type:          domain.ActivityTypeGoalUpdated,
+		Detail:        event,
+		ReferenceUUID: &goal.UUID,
+	})
+}
+
+// changedPersonFields returns the profile fields that differ between the stored person and the update
+func changedPersonFields(existing, updated entity.Person) []string {
+	var changed []string
+	add := func(field string, differs bool) {
+		if differs {
+			changed = append(changed, field)
+		}
+	}
+
+	add("name", existing.Name != updated.Name)
+	add("email", existing.Email != updated.Email)
+	add("position", existing.Position != updated.Position)
+	add("department", existing.Department != updated.Department)
+	add("phone", existing.Phone != updated.Phone)
+	add("birthday", !equalTimePtr(existing.Birthday, updated.Birthday))
+	add("start_date", !equalTimePtr(existing.StartDate, updated.StartDate))
+	add("is_manager", existing.IsManager != updated.IsManager)
+	add("manager", !equalPtr(existing.ManagerID, updated.ManagerID))
+	add("notes", existing.Notes != updated.Notes)
+	add("has_kids", existing.HasKids != updated.HasKids)
+	add("gender", !equalPtr(existing.Gender, updated.Gender))
+	add("interests", existing.Interests != updated.Interests)
+	add("personality", existing.Personality != updated.Personality)
+	return changed
+}
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func equalTimePtr(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func (s *activityApp) GetActivityFeed(ctx context.Context, filters entity.ActivityFeedFilters, page entity.CursorPage) (entity.ActivityFeed, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	var feed entity.ActivityFeed
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return feed, err
+	}
+
+	for _, activityType := range filters.Types {
+		if !slices.Contains(activityTypes, activityType) {
+			return feed, resterrors.NewBadRequestError("invalid activity type: " + activityType)
+		}
+	}
+
+	query := entity.ActivityQuery{
+		CompanyID: company.ID,
+		Team:      strings.TrimSpace(filters.Team),
+		Types:     filters.Types,
+	}
+
+	if filters.PersonUUID != "" {
+		person, err := s.personApp.getAuthorizedPerson(ctx, filters.PersonUUID)
+		if err != nil {
+			return feed, err
+		}
+		if person.CompanyID != company.ID {
+			return feed, resterrors.NewNotFoundError("person not found")
+		}
+		query.PersonID = &person.ID
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return feed, err
+	}
+
+	lastSeenID, err := s.dm.Activity().GetLastSeenActivityID(ctx, userID, company.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting last seen activity", logger.Err(err))
+		return feed, err
+	}
+
+	feed.Activities, feed.Page, err = s.dm.Activity().GetActivities(ctx, query, page)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting activities", logger.Err(err))
+		return feed, err
+	}
+
+	for i := range feed.Activities {
+		feed.Activities[i].Unread = feed.Activities[i].ID > lastSeenID
+	}
+
+	feed.UnreadCount, err = s.dm.Activity().CountActivitiesAfter(ctx, company.ID, lastSeenID)
+	if err != nil {
+		s.log.Errorw(ctx, "error counting unread activities", logger.Err(err))
+		return feed, err
+	}
+
+	return feed, nil
+}
+
+func (s *activityApp) MarkActivitySeen(ctx context.Context, activityUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	var activityID int64
+	if activityUUID == "" {
+		activityID, err = s.dm.Activity().GetLatestActivityID(ctx, company.ID)
+		if err != nil {
+			s.log.Errorw(ctx, "error getting latest activity", logger.Err(err))
+			return err
+		}
+	} else {
+		activity, err := s.dm.Activity().GetActivityByUUID(ctx, activityUUID)
+		if err != nil {
+			if mysqlutils.SQLNotFound(err.Error()) {
+				return resterrors.NewNotFoundError("activity not found")
+			}
+			s.log.Errorw(ctx, "error getting activity by UUID", logger.Err(err))
+			return err
+		}
+		if activity.CompanyID != company.ID {
+			return resterrors.NewNotFoundError("activity not found")
+		}
+		activityID = activity.ID
+	}
+
+	if activityID == 0 {
+		return nil
+	}
+
+	// the marker never moves back, marking an older activity as seen keeps the newer ones read
+	err = s.dm.Activity().SetLastSeenActivityID(ctx, userID, company.ID, activityID)
+	if err != nil {
+		s.log.Errorw(ctx, "error setting last seen activity", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
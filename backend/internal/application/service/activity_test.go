@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_changedPersonFields(t *testing.T) {
+	startDate := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	gender := "female"
+	existing := entity.Person{Name: "Ana", Department: "Sales", StartDate: &startDate, Gender: &gender}
+
+	t.Run("Should return nothing when the profile did not change", func(t *testing.T) {
+		sameDate := startDate.In(time.FixedZone("BRT", -3*60*60))
+		sameGender := "female"
+		updated := entity.Person{Name: "Ana", Department: "Sales", StartDate: &sameDate, Gender: &sameGender}
+		require.Empty(t, changedPersonFields(existing, updated))
+	})
+
+	t.Run("Should return the changed fields", func(t *testing.T) {
+		updated := entity.Person{Name: "Ana", Department: "Marketing", Position: "Lead", IsManager: true}
+		require.Equal(t, []string{"position", "department", "start_date", "is_manager", "gender"}, changedPersonFields(existing, updated))
+	})
+}
+
+func Test_recordNoteActivities(t *testing.T) {
+	ctx := context.Background()
+	person := entity.Person{ID: 3, Name: "Ana"}
+	mentioned := []entity.Person{{ID: 4, Name: "Bruno"}, {ID: 5, Name: "Carla"}}
+
+	t.Run("Should record the note and the mentions", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		activityRepo := mocks.NewMockActivityRepo(ctrl)
+		m.mockDataManager.EXPECT().Activity().Return(activityRepo).AnyTimes()
+
+		var recorded []entity.Activity
+		activityRepo.EXPECT().CreateActivity(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, activity entity.Activity) (int64, error) {
+			require.NotEmpty(t, activity.UUID)
+			require.False(t, activity.CreatedAt.IsZero())
+			recorded = append(recorded, activity)
+			return int64(len(recorded)), nil
+		}).Times(3)
+
+		note := entity.Note{UUID: "note-uuid", CompanyID: 10, PersonID: 3, UserID: 7, Type: domain.NoteTypeOneOnOne}
+		err := recordNoteActivities(ctx, m.mockDataManager, note, person, mentioned)
+		require.NoError(t, err)
+
+		require.Equal(t, domain.ActivityTypeNoteCreated, recorded[0].Type)
+		require.Equal(t, domain.NoteTypeOneOnOne, recorded[0].Detail)
+		require.Equal(t, int64(3), recorded[0].PersonID)
+		require.Equal(t, int64(7), *recorded[0].UserID)
+		require.Equal(t, "note-uuid", *recorded[0].ReferenceUUID)
+
+		for i, mention := range recorded[1:] {
+			require.Equal(t, domain.ActivityTypePersonMentioned, mention.Type)
+			require.Equal(t, mentioned[i].ID, mention.PersonID)
+			require.Equal(t, "Ana", mention.Detail)
+			require.Equal(t, int64(10), mention.CompanyID)
+		}
+	})
+
+	t.Run("Should record the feedback with its type", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		activityRepo := mocks.NewMockActivityRepo(ctrl)
+		m.mockDataManager.EXPECT().Activity().Return(activityRepo).AnyTimes()
+
+		feedbackType := domain.FeedbackTypePositive
+		note := entity.Note{UUID: "note-uuid", CompanyID: 10, PersonID: 3, UserID: 7, Type: domain.NoteTypeFeedback, FeedbackType: &feedbackType}
+
+		activityRepo.EXPECT().CreateActivity(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, activity entity.Activity) (int64, error) {
+			require.Equal(t, domain.ActivityTypeFeedbackCreated, activity.Type)
+			require.Equal(t, domain.FeedbackTypePositive, activity.Detail)
+			return 1, nil
+		})
+
+		err := recordNoteActivities(ctx, m.mockDataManager, note, person, nil)
+		require.NoError(t, err)
+	})
+}
+
+func Test_recordActivity(t *testing.T) {
+	ctx := context.Background()
+	m, ctrl := newServiceTestMock(t)
+	defer ctrl.Finish()
+
+	activityRepo := mocks.NewMockActivityRepo(ctrl)
+	m.mockDataManager.EXPECT().Activity().Return(activityRepo).AnyTimes()
+
+	activityRepo.EXPECT().CreateActivity(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, activity entity.Activity) (int64, error) {
+		require.Equal(t, strings.Repeat("é", domain.ActivityDetailMaxLength), activity.Detail)
+		return 1, nil
+	})
+
+	err := recordActivity(ctx, m.mockDataManager, entity.Activity{
+		Type:   domain.ActivityTypeAttributeExtracted,
+		Detail: strings.Repeat("é", domain.ActivityDetailMaxLength+10),
+	})
+	require.NoError(t, err)
+}
@@ -3,6 +3,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"math"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/diegoclair/go_utils/logger"
@@ -12,6 +15,7 @@ import (
 )
 
 type aiApp struct {
+	cache      contract.CacheManager
 	dm         contract.DataManager
 	aiProvider contract.AIProvider
 	authApp    contract.AuthApp
@@ -20,6 +24,7 @@ type aiApp struct {
 
 func newAIApp(infra domain.Infrastructure, aiProvider contract.AIProvider, authApp contract.AuthApp) contract.AIApp {
 	return &aiApp{
+		cache:      infra.CacheManager(),
 		dm:         infra.DataManager(),
 		aiProvider: aiProvider,
 		authApp:    authApp,
@@ -171,24 +176,75 @@ func (s *aiApp) ExtractAttributesFromNote(ctx context.Context, noteID int64) (en
 		s.log.Errorw(ctx, "Failed to create usage record", logger.Err(err))
 	}
 
+	currentAttributes, err := s.dm.Person().GetPersonAttributes(ctx, note.PersonID)
+	if err != nil {
+		s.log.Errorw(ctx, "failed to get current person attributes", logger.Err(err))
+		return entity.AttributesResponse{}, fmt.Errorf("failed to get current person attributes: %w", err)
+	}
+
+	current := make(map[string]entity.PersonAttribute, len(currentAttributes))
+	for _, attr := range currentAttributes {
+		current[attr.AttributeKey] = attr
+	}
+	extractedAttributes = s.filterExtractedAttributes(current, extractedAttributes)
+
 	var attributes []entity.PersonAttribute
 	if len(extractedAttributes) > 0 {
-		err = s.dm.Person().BulkUpsertPersonAttributes(ctx, note.PersonID, extractedAttributes, "ai_extracted", &noteID)
+		for key, extracted := range extractedAttributes {
+			attr := entity.PersonAttribute{
+				PersonID:              note.PersonID,
+				AttributeKey:          key,
+				AttributeValue:        extracted.Value,
+				Source:                domain.AttributeSourceAIExtracted,
+				Confidence:            extracted.Confidence,
+				ExtractedFromNoteID:   &noteID,
+				ExtractedFromNoteUUID: &note.UUID,
+			}
+			attributes = append(attributes, attr)
+		}
+
+		now := time.Now()
+		err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+			err := tx.Person().BulkUpsertPersonAttributes(ctx, note.PersonID, extractedAttributes, domain.AttributeSourceAIExtracted, &noteID)
+			if err != nil {
+				return err
+			}
+
+			// only a new value opens a new version, a repeated extraction just refreshes the current row
+			var changedKeys []string
+			for _, attr := range attributes {
+				previous, exists := current[attr.AttributeKey]
+				if exists && previous.AttributeValue == attr.AttributeValue {
+					continue
+				}
+
+				err = tx.Person().AddPersonAttributeHistory(ctx, attr, now)
+				if err != nil {
+					return err
+				}
+				changedKeys = append(changedKeys, attr.AttributeKey)
+			}
+
+			if len(changedKeys) == 0 {
+				return nil
+			}
+
+			// extracted in background, so the event has no user
+			sort.Strings(changedKeys)
+			return recordActivity(ctx, tx, entity.Activity{
+				CompanyID:     companyID,
+				PersonID:      note.PersonID,
+				Type:          domain.ActivityTypeAttributeExtracted,
+				Detail:        strings.Join(changedKeys, ","),
+				ReferenceUUID: &note.UUID,
+			})
+		})
 		if err != nil {
 			s.log.Errorw(ctx, "failed to save extracted attributes", logger.Err(err))
 			return entity.AttributesResponse{}, fmt.Errorf("failed to save extracted attributes: %w", err)
 		}
 
-		for key, value := range extractedAttributes {
-			attr := entity.PersonAttribute{
-				PersonID:            note.PersonID,
-				AttributeKey:        key,
-				AttributeValue:      value,
-				Source:              "ai_extracted",
-				ExtractedFromNoteID: &noteID,
-			}
-			attributes = append(attributes, attr)
-		}
+		invalidatePeopleSearchIndex(ctx, s.cache, s.log, companyID)
 	}
 
 	return entity.AttributesResponse{
@@ -198,6 +254,28 @@ func (s *aiApp) ExtractAttributesFromNote(ctx context.Context, noteID int64) (en
 	}, nil
 }
 
+// filterExtractedAttributes drops the attributes that were manually set by the manager,
+// so the AI never overwrites them, and keeps the confidence inside the 0.0 - 1.0 range
+func (s *aiApp) filterExtractedAttributes(current map[string]entity.PersonAttribute, extracted map[string]entity.ExtractedAttribute) map[string]entity.ExtractedAttribute {
+	filtered := make(map[string]entity.ExtractedAttribute, len(extracted))
+	for key, attr := range extracted {
+		if existing, ok := current[key]; ok && existing.IsManual() {
+			continue
+		}
+		if strings.TrimSpace(attr.Value) == "" {
+			continue
+		}
+
+		if attr.Confidence != nil {
+			confidence := math.Max(0, math.Min(1, *attr.Confidence))
+			attr.Confidence = &confidence
+		}
+		filtered[key] = attr
+	}
+
+	return filtered
+}
+
 func (s *aiApp) GetPersonContext(ctx context.Context, personID int64) (entity.PersonAIContext, error) {
 	person, err := s.dm.Person().GetPersonByID(ctx, personID)
 	if err != nil {
@@ -211,6 +289,12 @@ func (s *aiApp) GetPersonContext(ctx context.Context, personID int64) (entity.Pe
 		attributes = make(map[string]string)
 	}
 
+	attributeChanges, err := s.dm.Person().GetPersonAttributeChanges(ctx, personID, time.Now().AddDate(-1, 0, 0), 10)
+	if err != nil {
+		s.log.Errorw(ctx, "failed to get person attribute changes", logger.Err(err))
+		attributeChanges = []entity.PersonAttributeHistory{}
+	}
+
 	notes, err := s.dm.Note().GetNotesByPersonIDPaginated(ctx, personID, 1, 50)
 	if err != nil {
 		s.log.Errorw(ctx, "failed to get person notes", logger.Err(err))
@@ -228,11 +312,26 @@ func (s *aiApp) GetPersonContext(ctx context.Context, personID int64) (entity.Pe
 		}
 	}
 
+	openActionItems, err := s.dm.ActionItem().GetOpenActionItemsByPerson(ctx, personID)
+	if err != nil {
+		s.log.Errorw(ctx, "failed to get person open action items", logger.Err(err))
+		openActionItems = []entity.ActionItem{}
+	}
+
+	activeGoals, err := getPersonGoalsWithDetails(ctx, s.dm, personID, domain.GoalStatusActive)
+	if err != nil {
+		s.log.Errorw(ctx, "failed to get person active goals", logger.Err(err))
+		activeGoals = []entity.Goal{}
+	}
+
 	return entity.PersonAIContext{
 		Person:      person,
-		Attributes:  attributes,
-		RecentNotes: notes,
-		LastMeeting: lastMeeting,
+		Attributes:       attributes,
+		AttributeChanges: attributeChanges,
+		RecentNotes:      notes,
+		LastMeeting:      lastMeeting,
+		OpenActionItems:  openActionItems,
+		ActiveGoals:      activeGoals,
 	}, nil
 }
 
@@ -266,6 +365,18 @@ func (s *aiApp) buildContextPrompt(context entity.PersonAIContext) string {
 		}
 	}
 
+	if len(context.AttributeChanges) > 0 {
+		prompt += "\nPREVIOUS ATTRIBUTE VALUES (changed in the last year):\n"
+		for _, change := range context.AttributeChanges {
+			prompt += fmt.Sprintf("- %s: %s (from %s to %s)\n",
+				change.AttributeKey,
+				change.AttributeValue,
+				change.ValidFrom.Format("2006-01-02"),
+				change.ValidTo.Format("2006-01-02"),
+			)
+		}
+	}
+
 	if len(context.RecentNotes) > 0 {
 		prompt += "\nRECENT EVENTS:\n"
 		count := 0
@@ -282,5 +393,38 @@ func (s *aiApp) buildContextPrompt(context entity.PersonAIContext) string {
 		prompt += fmt.Sprintf("\nLAST 1:1 MEETING: %s\n", context.LastMeeting.Date.Format("2006-01-02"))
 	}
 
+	if len(context.OpenActionItems) > 0 {
+		prompt += "\nOPEN ACTION ITEMS (follow-ups from previous 1:1s):\n"
+		for _, item := range context.OpenActionItems {
+			line := fmt.Sprintf("- %s (owner: %s", item.Description, item.Owner)
+			if item.DueDate != nil {
+				line += fmt.Sprintf(", due: %s", item.DueDate.Format("2006-01-02"))
+			}
+			prompt += line + ")\n"
+		}
+	}
+
+	if len(context.ActiveGoals) > 0 {
+		prompt += "\nACTIVE GOALS:\n"
+		for _, goal := range context.ActiveGoals {
+			line := fmt.Sprintf("- [%s] %s (progress: %d%%", goal.Type, goal.Title, goal.Progress)
+			if goal.TargetDate != nil {
+				line += fmt.Sprintf(", target: %s", goal.TargetDate.Format("2006-01-02"))
+			}
+			prompt += line + ")\n"
+
+			for _, keyResult := range goal.KeyResults {
+				value := strings.TrimSpace(fmt.Sprintf("%g of %g %s", keyResult.CurrentValue, keyResult.TargetValue, keyResult.Unit))
+				prompt += fmt.Sprintf("  - Key result: %s (%s)\n", keyResult.Title, value)
+			}
+
+			if len(goal.CheckIns) > 0 {
+				lastCheckIn := goal.CheckIns[0]
+				prompt += fmt.Sprintf("  - Last check-in %s (%s): %s\n",
+					lastCheckIn.Date.Format("2006-01-02"), lastCheckIn.Confidence, lastCheckIn.Comment)
+			}
+		}
+	}
+
 	return prompt
 }
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/date"
+)
+
+type cadenceApp struct {
+	dm        contract.DataManager
+	log       logger.Logger
+	userApp   contract.UserApp
+	personApp *personApp
+}
+
+func newCadenceApp(infra domain.Infrastructure, userApp contract.UserApp, personApp *personApp) contract.CadenceApp {
+	return &cadenceApp{
+		dm:        infra.DataManager(),
+		log:       infra.Logger(),
+		userApp:   userApp,
+		personApp: personApp,
+	}
+}
+
+// validateCadence checks the cadence and returns the values to be stored, both nil when the cadence is removed
+func validateCadence(cadence entity.OneOnOneCadence) (*string, *int, error) {
+	switch cadence.Cadence {
+	case "":
+		return nil, nil, nil
+	case domain.OneOnOneCadenceWeekly, domain.OneOnOneCadenceBiweekly, domain.OneOnOneCadenceMonthly:
+		return &cadence.Cadence, nil, nil
+	case domain.OneOnOneCadenceCustom:
+		if cadence.Days == nil || *cadence.Days < 1 || *cadence.Days > domain.OneOnOneCadenceMaxCustomDays {
+			return nil, nil, resterrors.NewBadRequestError("days must be between 1 and 365 for the custom cadence")
+		}
+		return &cadence.Cadence, cadence.Days, nil
+	default:
+		return nil, nil, resterrors.NewBadRequestError("cadence must be weekly, biweekly, monthly or custom")
+	}
+}
+
+func (s *cadenceApp) UpdatePersonCadence(ctx context.Context, personUUID string, cadence entity.OneOnOneCadence) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return err
+	}
+
+	value, days, err := validateCadence(cadence)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.Person().UpdatePersonCadence(ctx, person.ID, value, days)
+	if err != nil {
+		s.log.Errorw(ctx, "error updating person cadence", logger.Err(err))
+		return err
+	}
+
+	s.log.Infow(ctx, "person cadence updated",
+		logger.String("person_uuid", personUUID),
+		logger.String("cadence", cadence.Cadence),
+	)
+
+	return nil
+}
+
+func (s *cadenceApp) GetOneOnOnesDue(ctx context.Context, days int) ([]entity.OneOnOneDue, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := s.userApp.GetUserPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetCompanyOneOnOnesDue(ctx, company.ID, preferences.Location(), days)
+}
+
+// GetCompanyOneOnOnesDue does not depend on the logged user, so it can also be used by background jobs
+// that send the overdue 1:1s as notifications
+func (s *cadenceApp) GetCompanyOneOnOnesDue(ctx context.Context, companyID int64, loc *time.Location, days int) ([]entity.OneOnOneDue, error) {
+	people, err := s.dm.Person().GetPersonsByCompany(ctx, companyID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting company people", logger.Err(err))
+		return nil, err
+	}
+
+	scheduled, err := s.dm.Meeting().GetNextScheduledDatesByCompany(ctx, companyID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting scheduled meetings", logger.Err(err))
+		return nil, err
+	}
+
+	due := buildOneOnOnesDue(people, scheduled, date.Today(time.Now(), loc), loc, getOneOnOnesDueDays(days))
+
+	s.log.Infow(ctx, "1:1s due computed",
+		logger.Int64("company_id", companyID),
+		logger.String("timezone", loc.String()),
+		logger.Int("due_count", len(due)),
+	)
+
+	return due, nil
+}
+
+// getOneOnOnesDueDays returns the upcoming window, using the default when it is not set and limiting it to the max
+func getOneOnOnesDueDays(days int) int {
+	if days <= 0 {
+		return domain.OneOnOnesDueDefaultDays
+	}
+	if days > domain.OneOnOnesDueMaxDays {
+		return domain.OneOnOnesDueMaxDays
+	}
+	return days
+}
+
+// buildOneOnOnesDue returns the overdue 1:1s and the ones due from today until today plus days (inclusive),
+// most overdue first. People without cadence are ignored
+func buildOneOnOnesDue(people []entity.Person, scheduled map[int64]time.Time, today time.Time, loc *time.Location, days int) []entity.OneOnOneDue {
+	due := []entity.OneOnOneDue{}
+
+	for _, person := range people {
+		dueDate := person.NextOneOnOneDate(loc)
+		if dueDate == nil {
+			continue
+		}
+
+		daysUntil := date.DaysBetween(today, *dueDate)
+		if daysUntil > days {
+			continue
+		}
+
+		item := entity.OneOnOneDue{
+			PersonID:         person.ID,
+			PersonUUID:       person.UUID,
+			PersonName:       person.Name,
+			Cadence:          *person.OneOnOneCadence,
+			CadenceDays:      person.OneOnOneCadenceDays,
+			LastOneOnOneDate: person.LastOneOnOneDate,
+			DueDate:          *dueDate,
+			DaysUntil:        daysUntil,
+			Overdue:          daysUntil < 0,
+		}
+		if scheduledAt, ok := scheduled[person.ID]; ok {
+			item.ScheduledAt = &scheduledAt
+		}
+
+		due = append(due, item)
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		if due[i].DaysUntil != due[j].DaysUntil {
+			return due[i].DaysUntil < due[j].DaysUntil
+		}
+		return due[i].PersonName < due[j].PersonName
+	})
+
+	return due
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func stringPointer(value string) *string {
+	return &value
+}
+
+func intPointer(value int) *int {
+	return &value
+}
+
+func Test_validateCadence(t *testing.T) {
+	t.Run("Should remove the cadence when it is empty", func(t *testing.T) {
+		cadence, days, err := validateCadence(entity.OneOnOneCadence{Days: intPointer(10)})
+		require.NoError(t, err)
+		require.Nil(t, cadence)
+		require.Nil(t, days)
+	})
+
+	t.Run("Should ignore the days of a fixed cadence", func(t *testing.T) {
+		cadence, days, err := validateCadence(entity.OneOnOneCadence{Cadence: domain.OneOnOneCadenceBiweekly, Days: intPointer(10)})
+		require.NoError(t, err)
+		require.Equal(t, domain.OneOnOneCadenceBiweekly, *cadence)
+		require.Nil(t, days)
+	})
+
+	t.Run("Should keep the days of the custom cadence", func(t *testing.T) {
+		cadence, days, err := validateCadence(entity.OneOnOneCadence{Cadence: domain.OneOnOneCadenceCustom, Days: intPointer(21)})
+		require.NoError(t, err)
+		require.Equal(t, domain.OneOnOneCadenceCustom, *cadence)
+		require.Equal(t, 21, *days)
+	})
+
+	t.Run("Should return error when the custom cadence has no days", func(t *testing.T) {
+		_, _, err := validateCadence(entity.OneOnOneCadence{Cadence: domain.OneOnOneCadenceCustom})
+		require.Error(t, err)
+	})
+
+	t.Run("Should return error when the cadence is invalid", func(t *testing.T) {
+		_, _, err := validateCadence(entity.OneOnOneCadence{Cadence: "daily"})
+		require.Error(t, err)
+	})
+}
+
+func Test_buildOneOnOnesDue(t *testing.T) {
+	today := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+	lastMeeting := func(year int, month time.Month, day, hour int) *time.Time {
+		value := time.Date(year, month, day, hour, 0, 0, 0, time.UTC)
+		return &value
+	}
+
+	t.Run("Should return overdue and upcoming 1:1s sorted by due date", func(t *testing.T) {
+		scheduledAt := time.Date(2025, time.March, 11, 14, 0, 0, 0, time.UTC)
+		people := []entity.Person{
+			{ID: 1, UUID: "uuid-1", Name: "Carlos", OneOnOneCadence: stringPointer(domain.OneOnOneCadenceWeekly), LastOneOnOneDate: lastMeeting(2025, time.February, 17, 10)},
+			{ID: 2, UUID: "uuid-2", Name: "Ana", OneOnOneCadence: stringPointer(domain.OneOnOneCadenceMonthly), LastOneOnOneDate: lastMeeting(2025, time.February, 12, 10)},
+			{ID: 3, UUID: "uuid-3", Name: "Bruno", OneOnOneCadence: stringPointer(domain.OneOnOneCadenceBiweekly), LastOneOnOneDate: lastMeeting(2025, time.March, 5, 10)},
+			{ID: 4, UUID: "uuid-4", Name: "Diego", LastOneOnOneDate: lastMeeting(2024, time.January, 1, 10)},
+			{ID: 5, UUID: "uuid-5", Name: "Eva", OneOnOneCadence: stringPointer(domain.OneOnOneCadenceCustom), OneOnOneCadenceDays: intPointer(3), LastOneOnOneDate: lastMeeting(2025, time.March, 7, 10)},
+		}
+
+		due := buildOneOnOnesDue(people, map[int64]time.Time{1: scheduledAt}, today, time.UTC, 7)
+
+		require.Len(t, due, 3)
+
+		require.Equal(t, "Carlos", due[0].PersonName)
+		require.Equal(t, time.Date(2025, time.February, 24, 0, 0, 0, 0, time.UTC), due[0].DueDate)
+		require.Equal(t, -14, due[0].DaysUntil)
+		require.True(t, due[0].Overdue)
+		require.Equal(t, scheduledAt, *due[0].ScheduledAt)
+
+		require.Equal(t, "Eva", due[1].PersonName)
+		require.Equal(t, 0, due[1].DaysUntil)
+		require.False(t, due[1].Overdue)
+
+		require.Equal(t, "Ana", due[2].PersonName)
+		require.Equal(t, time.Date(2025, time.March, 12, 0, 0, 0, 0, time.UTC), due[2].DueDate)
+		require.Equal(t, 2, due[2].DaysUntil)
+		require.Nil(t, due[2].ScheduledAt)
+	})
+
+	t.Run("Should be due since the person was added when there was no 1:1 yet", func(t *testing.T) {
+		people := []entity.Person{
+			{ID: 1, Name: "New", OneOnOneCadence: stringPointer(domain.OneOnOneCadenceWeekly), CreatedAt: time.Date(2025, time.March, 3, 9, 0, 0, 0, time.UTC)},
+		}
+
+		due := buildOneOnOnesDue(people, nil, today, time.UTC, 7)
+
+		require.Len(t, due, 1)
+		require.Equal(t, -7, due[0].DaysUntil)
+		require.True(t, due[0].Overdue)
+	})
+
+	t.Run("Should count the days from the last 1:1 in the user timezone", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/Sao_Paulo")
+		require.NoError(t, err)
+
+		// March 4 01:00 UTC is still March 3 in Sao Paulo
+		people := []entity.Person{
+			{ID: 1, Name: "Late", OneOnOneCadence: stringPointer(domain.OneOnOneCadenceWeekly), LastOneOnOneDate: lastMeeting(2025, time.March, 4, 1)},
+		}
+
+		due := buildOneOnOnesDue(people, nil, today, loc, 7)
+
+		require.Len(t, due, 1)
+		require.Equal(t, time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC), due[0].DueDate)
+		require.Equal(t, 0, due[0].DaysUntil)
+	})
+}
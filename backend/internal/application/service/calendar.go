@@ -0,0 +1,538 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/date"
+	"github.com/diegoclair/leaderpro/util/ics"
+)
+
+const (
+	calendarProdID          = "-//LeaderPro//LeaderPro Calendar//EN"
+	calendarName            = "LeaderPro"
+	calendarRefreshInterval = time.Hour
+	calendarUIDDomain       = "@leaderpro"
+)
+
+type calendarApp struct {
+	dm          contract.DataManager
+	log         logger.Logger
+	authApp     contract.AuthApp
+	userApp     contract.UserApp
+	personApp   *personApp
+	meetingApp  contract.MeetingApp
+	reminderApp contract.ReminderApp
+	cadenceApp  contract.CadenceApp
+}
+
+func newCalendarApp(infra domain.Infrastructure, authApp contract.AuthApp, userApp contract.UserApp, personApp *personApp,
+	meetingApp contract.MeetingApp, reminderApp contract.ReminderApp, cadenceApp contract.CadenceApp) contract.CalendarApp {
+	return &calendarApp{
+		dm:          infra.DataManager(),
+		log:         infra.Logger(),
+		authApp:     authApp,
+		userApp:     userApp,
+		personApp:   personApp,
+		meetingApp:  meetingApp,
+		reminderApp: reminderApp,
+		cadenceApp:  cadenceApp,
+	}
+}
+
+func (s *calendarApp) GenerateFeedToken(ctx context.Context) (string, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := generateSecretToken()
+	if err != nil {
+		s.log.Errorw(ctx, "error generating calendar feed token", logger.Err(err))
+		return "", err
+	}
+
+	err = s.dm.User().SaveCalendarFeedToken(ctx, userID, hashSecretToken(token))
+	if err != nil {
+		s.log.Errorw(ctx, "error saving calendar feed token", logger.Err(err))
+		return "", err
+	}
+
+	s.log.Infow(ctx, "calendar feed token generated", logger.Int64("user_id", userID))
+
+	return token, nil
+}
+
+func (s *calendarApp) RevokeFeedToken(ctx context.Context) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.User().DeleteCalendarFeedToken(ctx, userID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return resterrors.NewNotFoundError("calendar feed not found")
+		}
+		s.log.Errorw(ctx, "error deleting calendar feed token", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// GetFeed is called by calendar apps without a session, the secret token identifies the user
+func (s *calendarApp) GetFeed(ctx context.Context, token string) ([]byte, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	if token == "" {
+		return nil, resterrors.NewNotFoundError("calendar feed not found")
+	}
+
+	userID, err := s.dm.User().GetUserIDByCalendarFeedToken(ctx, hashSecretToken(token))
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return nil, resterrors.NewNotFoundError("calendar feed not found")
+		}
+		s.log.Errorw(ctx, "error getting calendar feed token", logger.Err(err))
+		return nil, err
+	}
+
+	loc := s.getUserLocation(ctx, userID)
+	now := time.Now()
+	today := date.Today(now, loc)
+
+	feed := calendarFeedData{}
+
+	feed.meetings, err = s.dm.Meeting().GetScheduledMeetingsByUser(ctx, userID, now.AddDate(0, 0, -domain.CalendarFeedPastDays))
+	if err != nil {
+		s.log.Errorw(ctx, "error getting scheduled meetings", logger.Err(err))
+		return nil, err
+	}
+
+	companies, err := s.dm.Company().GetCompaniesByUser(ctx, userID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting user companies", logger.Err(err))
+		return nil, err
+	}
+
+	for _, company := range companies {
+		reminders, err := s.reminderApp.GetCompanyReminders(ctx, company.ID, loc, domain.CalendarFeedUpcomingDays)
+		if err != nil {
+			return nil, err
+		}
+		feed.reminders = append(feed.reminders, reminders...)
+
+		actionItems, err := s.dm.ActionItem().GetOverdueActionItemsByCompany(ctx, company.ID, today)
+		if err != nil {
+			s.log.Errorw(ctx, "error getting overdue action items", logger.Err(err))
+			return nil, err
+		}
+		feed.overdueActionItems = append(feed.overdueActionItems, actionItems...)
+
+		due, err := s.cadenceApp.GetCompanyOneOnOnesDue(ctx, company.ID, loc, domain.CalendarFeedUpcomingDays)
+		if err != nil {
+			return nil, err
+		}
+		feed.oneOnOnesDue = append(feed.oneOnOnesDue, due...)
+	}
+
+	calendar := buildCalendarFeed(feed, today)
+
+	s.log.Infow(ctx, "calendar feed built",
+		logger.Int64("user_id", userID),
+		logger.Int("events_count", len(calendar.Events)),
+	)
+
+	return calendar.Marshal(now), nil
+}
+
+// getUserLocation returns the timezone of the user preferences, UTC when the user has no preferences yet
+func (s *calendarApp) getUserLocation(ctx context.Context, userID int64) *time.Location {
+	preferences, err := s.dm.User().GetUserPreferences(ctx, userID)
+	if err != nil {
+		if !mysqlutils.SQLNotFound(err.Error()) {
+			s.log.Errorw(ctx, "error getting user preferences, using UTC", logger.Err(err))
+		}
+		return time.UTC
+	}
+	return preferences.Location()
+}
+
+// calendarFeedData is what is published in the feed of a user, from all the companies they own
+type calendarFeedData struct {
+	meetings           []entity.Meeting
+	reminders          []entity.PersonReminder
+	overdueActionItems []entity.ActionItem
+	oneOnOnesDue       []entity.OneOnOneDue
+}
+
+// buildCalendarFeed returns the feed events. Reminders, overdue action items and 1:1s due are all day events,
+// the overdue ones are shown today. 1:1s due that already have a scheduled meeting are not repeated
+func buildCalendarFeed(feed calendarFeedData, today time.Time) ics.Calendar {
+	calendar := ics.Calendar{
+		ProdID:          calendarProdID,
+		Name:            calendarName,
+		RefreshInterval: calendarRefreshInterval,
+		Events:          []ics.Event{},
+	}
+
+	for _, meeting := range feed.meetings {
+		duration := domain.MeetingDefaultDurationMins
+		if meeting.DurationMinutes != nil {
+			duration = *meeting.DurationMinutes
+		}
+
+		calendar.Events = append(calendar.Events, ics.Event{
+			UID:     "meeting-" + meeting.UUID + calendarUIDDomain,
+			Summary: "1:1 with " + meeting.PersonName,
+			Start:   meeting.ScheduledAt,
+			End:     meeting.ScheduledAt.Add(time.Duration(duration) * time.Minute),
+			Status:  "CONFIRMED",
+		})
+	}
+
+	for _, reminder := range feed.reminders {
+		summary := fmt.Sprintf("%s's birthday (%d years)", reminder.PersonName, reminder.Years)
+		if reminder.Type == domain.ReminderTypeWorkAnniversary {
+			summary = fmt.Sprintf("%s's work anniversary (%d years)", reminder.PersonName, reminder.Years)
+		}
+
+		calendar.Events = append(calendar.Events, ics.Event{
+			UID:     fmt.Sprintf("%s-%s-%d%s", strings.ReplaceAll(reminder.Type, "_", "-"), reminder.PersonUUID, reminder.Date.Year(), calendarUIDDomain),
+			Summary: summary,
+			Start:   reminder.Date,
+			AllDay:  true,
+		})
+	}
+
+	for _, item := range feed.overdueActionItems {
+		description := ""
+		if item.DueDate != nil {
+			description = "Due on " + item.DueDate.Format("2006-01-02")
+		}
+
+		calendar.Events = append(calendar.Events, ics.Event{
+			UID:         "action-item-" + item.UUID + calendarUIDDomain,
+			Summary:     fmt.Sprintf("Overdue follow-up with %s: %s", item.PersonName, item.Description),
+			Description: description,
+			Start:       today,
+			AllDay:      true,
+		})
+	}
+
+	for _, due := range feed.oneOnOnesDue {
+		if due.ScheduledAt != nil {
+			continue
+		}
+
+		summary := "1:1 due with " + due.PersonName
+		day := due.DueDate
+		if due.Overdue {
+			summary = "Overdue 1:1 with " + due.PersonName
+			day = today
+		}
+
+		calendar.Events = append(calendar.Events, ics.Event{
+			UID:     "one-on-one-due-" + due.PersonUUID + calendarUIDDomain,
+			Summary: summary,
+			Start:   day,
+			AllDay:  true,
+		})
+	}
+
+	return calendar
+}
+
+func (s *calendarApp) ImportMeetings(ctx context.Context, input entity.CalendarImportInput) (entity.CalendarImportResult, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	result := entity.CalendarImportResult{DryRun: input.DryRun, Events: []entity.CalendarImportEvent{}}
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	user, err := s.userApp.GetLoggedUser(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	preferences, err := s.userApp.GetUserPreferences(ctx)
+	if err != nil {
+		return result, err
+	}
+	loc := preferences.Location()
+
+	events, err := ics.Parse(input.Data, loc)
+	if err != nil {
+		return result, resterrors.NewBadRequestError(err.Error())
+	}
+	if len(events) == 0 {
+		return result, resterrors.NewBadRequestError("file has no events")
+	}
+	if len(events) > domain.CalendarImportMaxEvents {
+		return result, resterrors.NewBadRequestError(fmt.Sprintf("file has more than %d events", domain.CalendarImportMaxEvents))
+	}
+
+	people, err := s.dm.Person().GetPersonsByCompany(ctx, company.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting company people", logger.Err(err))
+		return result, err
+	}
+	peopleByEmail := indexPeopleByEmail(people)
+
+	now := time.Now()
+	for _, event := range events {
+		item, err := s.importEvent(ctx, event, user, peopleByEmail, now, loc, input.DryRun)
+		if err != nil {
+			return result, err
+		}
+		result.Events = append(result.Events, item)
+
+		switch item.Status {
+		case domain.CalendarImportStatusCreated:
+			result.Created++
+		case domain.CalendarImportStatusLinked:
+			result.Linked++
+		case domain.CalendarImportStatusUpdated:
+			result.Updated++
+		case domain.CalendarImportStatusCanceled:
+			result.Canceled++
+		case domain.CalendarImportStatusUnchanged:
+			result.Unchanged++
+		default:
+			result.Skipped++
+		}
+	}
+
+	s.log.Infow(ctx, "calendar imported",
+		logger.String("file_name", input.FileName),
+		logger.Bool("dry_run", input.DryRun),
+		logger.Int("created", result.Created),
+		logger.Int("linked", result.Linked),
+		logger.Int("updated", result.Updated),
+		logger.Int("canceled", result.Canceled),
+		logger.Int("skipped", result.Skipped),
+	)
+
+	return result, nil
+}
+
+// importEvent creates, links, updates or cancels the meeting of one event, without writing anything on dry runs
+func (s *calendarApp) importEvent(ctx context.Context, event ics.Event, user entity.User, peopleByEmail map[string]entity.Person,
+	now time.Time, loc *time.Location, dryRun bool) (entity.CalendarImportEvent, error) {
+
+	item := entity.CalendarImportEvent{
+		UID:     event.UID,
+		Summary: event.Summary,
+		Status:  domain.CalendarImportStatusSkipped,
+	}
+
+	if event.UID == "" {
+		item.Reason = "event has no UID"
+		return item, nil
+	}
+	if event.Start.IsZero() {
+		item.Reason = "event has no valid start"
+		return item, nil
+	}
+	if event.AllDay {
+		item.Reason = "all day events are not meetings"
+		return item, nil
+	}
+
+	person, reason := matchEventPerson(event, peopleByEmail, user.Email)
+	if reason != "" {
+		item.Reason = reason
+		return item, nil
+	}
+	item.PersonUUID = person.UUID
+	item.PersonName = person.Name
+
+	imported, err := s.dm.Meeting().GetMeetingsByICalUID(ctx, user.ID, event.UID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting imported meetings", logger.Err(err))
+		return item, err
+	}
+
+	var next *entity.Meeting
+	nextMeeting, err := s.dm.Meeting().GetNextScheduledMeeting(ctx, person.ID)
+	if err != nil && !mysqlutils.SQLNotFound(err.Error()) {
+		s.log.Errorw(ctx, "error getting next scheduled meeting", logger.Err(err))
+		return item, err
+	}
+	if err == nil {
+		next = &nextMeeting
+	}
+
+	plan := planCalendarEvent(event, imported, next, now, loc)
+	item.Status = plan.status
+	item.Reason = plan.reason
+	if !plan.occurrence.IsZero() {
+		item.Start = &plan.occurrence
+	}
+	if plan.meeting.UUID != "" {
+		item.MeetingUUID = plan.meeting.UUID
+	}
+
+	if dryRun {
+		return item, nil
+	}
+
+	switch plan.status {
+	case domain.CalendarImportStatusCreated:
+		created, err := s.meetingApp.ScheduleMeeting(ctx, person.UUID, plan.meeting)
+		if err != nil {
+			return item, err
+		}
+		item.MeetingUUID = created.UUID
+	case domain.CalendarImportStatusLinked, domain.CalendarImportStatusUpdated:
+		err = s.dm.Meeting().UpdateMeeting(ctx, plan.meeting.ID, plan.meeting)
+		if err != nil {
+			s.log.Errorw(ctx, "error updating imported meeting", logger.Err(err))
+			return item, err
+		}
+	case domain.CalendarImportStatusCanceled:
+		err = s.meetingApp.CancelMeeting(ctx, plan.meeting.UUID)
+		if err != nil {
+			return item, err
+		}
+	}
+
+	return item, nil
+}
+
+// indexPeopleByEmail returns the people of the company by their lower case email, people without email are ignored
+func indexPeopleByEmail(people []entity.Person) map[string]entity.Person {
+	peopleByEmail := make(map[string]entity.Person, len(people))
+	for _, person := range people {
+		email := strings.ToLower(strings.TrimSpace(person.Email))
+		if email != "" {
+			peopleByEmail[email] = person
+		}
+	}
+	return peopleByEmail
+}
+
+// matchEventPerson returns the only person of the company among the attendees and the organizer, ignoring the
+// user. When no person or more than one person is found it returns the reason the event is skipped
+func matchEventPerson(event ics.Event, peopleByEmail map[string]entity.Person, userEmail string) (entity.Person, string) {
+	participants := event.Attendees
+	if event.Organizer != nil {
+		participants = append([]ics.Attendee{*event.Organizer}, participants...)
+	}
+
+	userEmail = strings.ToLower(strings.TrimSpace(userEmail))
+	matched := map[int64]entity.Person{}
+	for _, participant := range participants {
+		if participant.Email == userEmail {
+			continue
+		}
+		if person, ok := peopleByEmail[participant.Email]; ok {
+			matched[person.ID] = person
+		}
+	}
+
+	switch len(matched) {
+	case 0:
+		return entity.Person{}, "no attendee email matches a person of the company"
+	case 1:
+		for _, person := range matched {
+			return person, ""
+		}
+	}
+
+	return entity.Person{}, "more than one person of the company attends, only 1:1s are imported"
+}
+
+type calendarEventPlan struct {
+	status     string
+	reason     string
+	occurrence time.Time
+	meeting    entity.Meeting // meeting to create, link, update or cancel
+}
+
+// planCalendarEvent decides what to do with the event of a person, given the meetings already imported from the
+// same event (recurring events have one meeting per imported occurrence) and the next scheduled meeting of the
+// person. A scheduled meeting on the same day of the occurrence that was not imported yet is linked to the event
+func planCalendarEvent(event ics.Event, imported []entity.Meeting, next *entity.Meeting, now time.Time, loc *time.Location) calendarEventPlan {
+	var scheduled *entity.Meeting
+	for i := range imported {
+		if imported[i].IsScheduled() {
+			scheduled = &imported[i]
+			break
+		}
+	}
+
+	if event.IsCancelled() {
+		if scheduled == nil {
+			return calendarEventPlan{status: domain.CalendarImportStatusSkipped, reason: "event is canceled"}
+		}
+		return calendarEventPlan{status: domain.CalendarImportStatusCanceled, meeting: *scheduled}
+	}
+
+	occurrence, ok := event.NextOccurrence(now)
+	if !ok {
+		return calendarEventPlan{status: domain.CalendarImportStatusSkipped, reason: "event already happened"}
+	}
+
+	var duration *int
+	if minutes := int(event.Duration().Minutes()); minutes > 0 {
+		duration = &minutes
+	}
+
+	// the occurrence was already imported, it can be scheduled, held or canceled in the app
+	for _, meeting := range imported {
+		if meeting.ScheduledAt.Equal(occurrence) {
+			return calendarEventPlan{status: domain.CalendarImportStatusUnchanged, occurrence: occurrence, meeting: meeting}
+		}
+	}
+
+	if scheduled != nil {
+		meeting := *scheduled
+		meeting.ScheduledAt = occurrence
+		if duration != nil {
+			meeting.DurationMinutes = duration
+		}
+		return calendarEventPlan{status: domain.CalendarImportStatusUpdated, occurrence: occurrence, meeting: meeting}
+	}
+
+	uid := event.UID
+	if next != nil && next.ICalUID == nil && date.Day(next.ScheduledAt.In(loc)).Equal(date.Day(occurrence.In(loc))) {
+		meeting := *next
+		meeting.ScheduledAt = occurrence
+		meeting.ICalUID = &uid
+		if duration != nil {
+			meeting.DurationMinutes = duration
+		}
+		return calendarEventPlan{status: domain.CalendarImportStatusLinked, occurrence: occurrence, meeting: meeting}
+	}
+
+	return calendarEventPlan{
+		status:     domain.CalendarImportStatusCreated,
+		occurrence: occurrence,
+		meeting: entity.Meeting{
+			ScheduledAt:     occurrence,
+			DurationMinutes: duration,
+			ICalUID:         &uid,
+		},
+	}
+}
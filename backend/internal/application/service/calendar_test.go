@@ -0,0 +1,174 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/ics"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_buildCalendarFeed(t *testing.T) {
+	today := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+	scheduledAt := time.Date(2025, time.March, 11, 14, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2025, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+	calendar := buildCalendarFeed(calendarFeedData{
+		meetings: []entity.Meeting{
+			{UUID: "meeting-1", PersonName: "Ana", ScheduledAt: scheduledAt},
+			{UUID: "meeting-2", PersonName: "Bruno", ScheduledAt: scheduledAt, DurationMinutes: intPointer(60)},
+		},
+		reminders: []entity.PersonReminder{
+			{Type: domain.ReminderTypeWorkAnniversary, PersonUUID: "person-ana", PersonName: "Ana", Date: time.Date(2025, time.March, 12, 0, 0, 0, 0, time.UTC), Years: 3},
+		},
+		overdueActionItems: []entity.ActionItem{
+			{UUID: "item-1", PersonName: "Bruno", Description: "Share the promotion criteria", DueDate: &dueDate},
+		},
+		oneOnOnesDue: []entity.OneOnOneDue{
+			{PersonUUID: "person-carlos", PersonName: "Carlos", DueDate: dueDate, Overdue: true},
+			{PersonUUID: "person-ana", PersonName: "Ana", DueDate: scheduledAt, ScheduledAt: &scheduledAt},
+		},
+	}, today)
+
+	require.Len(t, calendar.Events, 5)
+
+	require.Equal(t, "meeting-meeting-1@leaderpro", calendar.Events[0].UID)
+	require.Equal(t, "1:1 with Ana", calendar.Events[0].Summary)
+	require.Equal(t, 30*time.Minute, calendar.Events[0].Duration())
+	require.Equal(t, time.Hour, calendar.Events[1].Duration())
+
+	require.Equal(t, "work-anniversary-person-ana-2025@leaderpro", calendar.Events[2].UID)
+	require.Equal(t, "Ana's work anniversary (3 years)", calendar.Events[2].Summary)
+	require.True(t, calendar.Events[2].AllDay)
+
+	require.Equal(t, "Overdue follow-up with Bruno: Share the promotion criteria", calendar.Events[3].Summary)
+	require.Equal(t, today, calendar.Events[3].Start)
+
+	require.Equal(t, "Overdue 1:1 with Carlos", calendar.Events[4].Summary)
+	require.Equal(t, today, calendar.Events[4].Start)
+}
+
+func Test_matchEventPerson(t *testing.T) {
+	peopleByEmail := indexPeopleByEmail([]entity.Person{
+		{ID: 1, Name: "Ana", Email: "Ana@Empresa.com"},
+		{ID: 2, Name: "Bruno", Email: "bruno@empresa.com"},
+		{ID: 3, Name: "No email"},
+	})
+
+	t.Run("Should match the only person among the attendees ignoring the user", func(t *testing.T) {
+		event := ics.Event{
+			Organizer: &ics.Attendee{Email: "diego@empresa.com"},
+			Attendees: []ics.Attendee{{Email: "diego@empresa.com"}, {Email: "ana@empresa.com"}, {Email: "room@empresa.com"}},
+		}
+
+		person, reason := matchEventPerson(event, peopleByEmail, "Diego@empresa.com")
+		require.Empty(t, reason)
+		require.Equal(t, "Ana", person.Name)
+	})
+
+	t.Run("Should match the organizer when the report created the event", func(t *testing.T) {
+		event := ics.Event{Organizer: &ics.Attendee{Email: "bruno@empresa.com"}}
+
+		person, reason := matchEventPerson(event, peopleByEmail, "diego@empresa.com")
+		require.Empty(t, reason)
+		require.Equal(t, "Bruno", person.Name)
+	})
+
+	t.Run("Should skip events without people of the company", func(t *testing.T) {
+		_, reason := matchEventPerson(ics.Event{Attendees: []ics.Attendee{{Email: "other@gmail.com"}}}, peopleByEmail, "diego@empresa.com")
+		require.NotEmpty(t, reason)
+	})
+
+	t.Run("Should skip events with more than one person", func(t *testing.T) {
+		event := ics.Event{Attendees: []ics.Attendee{{Email: "ana@empresa.com"}, {Email: "bruno@empresa.com"}}}
+
+		_, reason := matchEventPerson(event, peopleByEmail, "diego@empresa.com")
+		require.NotEmpty(t, reason)
+	})
+}
+
+func Test_planCalendarEvent(t *testing.T) {
+	now := time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2025, time.March, 3, 14, 0, 0, 0, time.UTC)
+	nextOccurrence := start.AddDate(0, 0, 7)
+	uid := "1on1-ana@google.com"
+
+	weekly := ics.Event{
+		UID:   uid,
+		Start: start,
+		End:   start.Add(45 * time.Minute),
+		RRule: &ics.RRule{Freq: ics.FreqWeekly, Interval: 1},
+	}
+
+	t.Run("Should create a meeting for the next occurrence", func(t *testing.T) {
+		plan := planCalendarEvent(weekly, nil, nil, now, time.UTC)
+
+		require.Equal(t, domain.CalendarImportStatusCreated, plan.status)
+		require.Equal(t, nextOccurrence, plan.occurrence)
+		require.Equal(t, nextOccurrence, plan.meeting.ScheduledAt)
+		require.Equal(t, 45, *plan.meeting.DurationMinutes)
+		require.Equal(t, uid, *plan.meeting.ICalUID)
+	})
+
+	t.Run("Should link the scheduled meeting on the same day", func(t *testing.T) {
+		next := &entity.Meeting{ID: 7, UUID: "meeting-7", Status: domain.MeetingStatusScheduled, ScheduledAt: nextOccurrence.Add(-2 * time.Hour)}
+
+		plan := planCalendarEvent(weekly, nil, next, now, time.UTC)
+
+		require.Equal(t, domain.CalendarImportStatusLinked, plan.status)
+		require.Equal(t, int64(7), plan.meeting.ID)
+		require.Equal(t, nextOccurrence, plan.meeting.ScheduledAt)
+		require.Equal(t, uid, *plan.meeting.ICalUID)
+	})
+
+	t.Run("Should not link a meeting on another day", func(t *testing.T) {
+		next := &entity.Meeting{ID: 7, Status: domain.MeetingStatusScheduled, ScheduledAt: nextOccurrence.AddDate(0, 0, 1)}
+
+		plan := planCalendarEvent(weekly, nil, next, now, time.UTC)
+
+		require.Equal(t, domain.CalendarImportStatusCreated, plan.status)
+	})
+
+	t.Run("Should move the scheduled meeting imported from the event", func(t *testing.T) {
+		imported := []entity.Meeting{
+			{ID: 1, Status: domain.MeetingStatusHeld, ScheduledAt: start, ICalUID: &uid},
+			{ID: 2, Status: domain.MeetingStatusScheduled, ScheduledAt: nextOccurrence.Add(time.Hour), ICalUID: &uid},
+		}
+
+		plan := planCalendarEvent(weekly, imported, nil, now, time.UTC)
+
+		require.Equal(t, domain.CalendarImportStatusUpdated, plan.status)
+		require.Equal(t, int64(2), plan.meeting.ID)
+		require.Equal(t, nextOccurrence, plan.meeting.ScheduledAt)
+	})
+
+	t.Run("Should keep the occurrence already imported", func(t *testing.T) {
+		imported := []entity.Meeting{{ID: 3, Status: domain.MeetingStatusCanceled, ScheduledAt: nextOccurrence, ICalUID: &uid}}
+
+		plan := planCalendarEvent(weekly, imported, nil, now, time.UTC)
+
+		require.Equal(t, domain.CalendarImportStatusUnchanged, plan.status)
+	})
+
+	t.Run("Should cancel the scheduled meeting of a canceled event", func(t *testing.T) {
+		canceled := weekly
+		canceled.Status = "CANCELLED"
+		imported := []entity.Meeting{{ID: 2, UUID: "meeting-2", Status: domain.MeetingStatusScheduled, ScheduledAt: nextOccurrence, ICalUID: &uid}}
+
+		plan := planCalendarEvent(canceled, imported, nil, now, time.UTC)
+		require.Equal(t, domain.CalendarImportStatusCanceled, plan.status)
+		require.Equal(t, "meeting-2", plan.meeting.UUID)
+
+		plan = planCalendarEvent(canceled, nil, nil, now, time.UTC)
+		require.Equal(t, domain.CalendarImportStatusSkipped, plan.status)
+	})
+
+	t.Run("Should skip events that already happened", func(t *testing.T) {
+		plan := planCalendarEvent(ics.Event{UID: uid, Start: start}, nil, nil, now, time.UTC)
+
+		require.Equal(t, domain.CalendarImportStatusSkipped, plan.status)
+		require.NotEmpty(t, plan.reason)
+	})
+}
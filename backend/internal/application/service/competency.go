@@ -0,0 +1,695 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/date"
+	"github.com/twinj/uuid"
+)
+
+type competencyApp struct {
+	dm        contract.DataManager
+	log       logger.Logger
+	authApp   contract.AuthApp
+	userApp   contract.UserApp
+	personApp *personApp
+}
+
+func newCompetencyApp(infra domain.Infrastructure, authApp contract.AuthApp, userApp contract.UserApp, personApp *personApp) contract.CompetencyApp {
+	return &competencyApp{
+		dm:        infra.DataManager(),
+		log:       infra.Logger(),
+		authApp:   authApp,
+		userApp:   userApp,
+		personApp: personApp,
+	}
+}
+
+// loadFrameworkDetails sets the levels and the competencies of the framework, each competency with its descriptors
+func loadFrameworkDetails(ctx context.Context, dm contract.DataManager, framework *entity.CompetencyFramework) (err error) {
+	framework.Levels, err = dm.Competency().GetLevelsByFramework(ctx, framework.ID)
+	if err != nil {
+		return err
+	}
+
+	framework.Competencies, err = dm.Competency().GetCompetenciesByFramework(ctx, framework.ID)
+	if err != nil {
+		return err
+	}
+
+	descriptors, err := dm.Competency().GetDescriptorsByFramework(ctx, framework.ID)
+	if err != nil {
+		return err
+	}
+
+	descriptorsByCompetency := make(map[int64][]entity.CompetencyDescriptor, len(framework.Competencies))
+	for _, descriptor := range descriptors {
+		descriptorsByCompetency[descriptor.CompetencyID] = append(descriptorsByCompetency[descriptor.CompetencyID], descriptor)
+	}
+	for i := range framework.Competencies {
+		framework.Competencies[i].Descriptors = descriptorsByCompetency[framework.Competencies[i].ID]
+	}
+
+	return nil
+}
+
+// getAuthorizedFramework loads a framework by UUID with its matrix and checks that the logged user owns the framework's company
+func (s *competencyApp) getAuthorizedFramework(ctx context.Context, frameworkUUID string) (entity.CompetencyFramework, error) {
+	framework, err := s.dm.Competency().GetFrameworkByUUID(ctx, frameworkUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return framework, resterrors.NewNotFoundError("competency framework not found")
+		}
+		s.log.Errorw(ctx, "error getting competency framework by UUID", logger.Err(err))
+		return framework, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return framework, err
+	}
+
+	_, err = s.personApp.validateUserCompanyAccess(ctx, userID, framework.CompanyID)
+	if err != nil {
+		return framework, err
+	}
+
+	err = loadFrameworkDetails(ctx, s.dm, &framework)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting competency framework details", logger.Err(err))
+		return framework, err
+	}
+
+	return framework, nil
+}
+
+// getAuthorizedAssessment loads an assessment by UUID with its ratings and checks that the logged user owns the assessment's company
+func (s *competencyApp) getAuthorizedAssessment(ctx context.Context, assessmentUUID string) (entity.CompetencyAssessment, error) {
+	assessment, err := s.dm.Competency().GetAssessmentByUUID(ctx, assessmentUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return assessment, resterrors.NewNotFoundError("competency assessment not found")
+		}
+		s.log.Errorw(ctx, "error getting competency assessment by UUID", logger.Err(err))
+		return assessment, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return assessment, err
+	}
+
+	_, err = s.personApp.validateUserCompanyAccess(ctx, userID, assessment.CompanyID)
+	if err != nil {
+		return assessment, err
+	}
+
+	assessment.Ratings, err = s.dm.Competency().GetRatingsByAssessment(ctx, assessment.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting competency assessment ratings", logger.Err(err))
+		return assessment, err
+	}
+
+	return assessment, nil
+}
+
+// getCompanyCompetency loads a competency by UUID and checks that it belongs to the company
+func (s *competencyApp) getCompanyCompetency(ctx context.Context, competencyUUID string, companyID int64) (entity.Competency, error) {
+	competency, err := s.dm.Competency().GetCompetencyByUUID(ctx, competencyUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return competency, resterrors.NewNotFoundError("competency not found")
+		}
+		s.log.Errorw(ctx, "error getting competency by UUID", logger.Err(err))
+		return competency, err
+	}
+
+	if competency.CompanyID != companyID {
+		return competency, resterrors.NewBadRequestError("competency does not belong to this company")
+	}
+
+	return competency, nil
+}
+
+// normalizeCompetencyFramework trims the texts and validates the levels and the competencies of the framework.
+// The descriptors reference the levels by their 1-based position in the list of levels
+func normalizeCompetencyFramework(framework *entity.CompetencyFramework) error {
+	framework.Name = strings.TrimSpace(framework.Name)
+	if framework.Name == "" {
+		return resterrors.NewBadRequestError("name is required")
+	}
+	framework.Description = strings.TrimSpace(framework.Description)
+
+	if len(framework.Levels) == 0 || len(framework.Levels) > domain.CompetencyFrameworkMaxLevels {
+		return resterrors.NewBadRequestError(fmt.Sprintf("the framework must have from 1 to %d levels", domain.CompetencyFrameworkMaxLevels))
+	}
+	levelNames := make(map[string]bool, len(framework.Levels))
+	for i := range framework.Levels {
+		level := &framework.Levels[i]
+		level.Name = strings.TrimSpace(level.Name)
+		if level.Name == "" {
+			return resterrors.NewBadRequestError("level name is required")
+		}
+		level.Description = strings.TrimSpace(level.Description)
+		level.Position = i + 1
+
+		key := strings.ToLower(level.Name)
+		if levelNames[key] {
+			return resterrors.NewBadRequestError("level name is repeated: " + level.Name)
+		}
+		levelNames[key] = true
+	}
+
+	if len(framework.Competencies) == 0 || len(framework.Competencies) > domain.CompetencyFrameworkMaxCompetencies {
+		return resterrors.NewBadRequestError(fmt.Sprintf("the framework must have from 1 to %d competencies", domain.CompetencyFrameworkMaxCompetencies))
+	}
+	for i := range framework.Competencies {
+		competency := &framework.Competencies[i]
+		competency.Name = strings.TrimSpace(competency.Name)
+		if competency.Name == "" {
+			return resterrors.NewBadRequestError("competency name is required")
+		}
+		competency.Description = strings.TrimSpace(competency.Description)
+
+		descriptors := make([]entity.CompetencyDescriptor, 0, len(competency.Descriptors))
+		positions := make(map[int]bool, len(competency.Descriptors))
+		for _, descriptor := range competency.Descriptors {
+			if descriptor.LevelPosition < 1 || descriptor.LevelPosition > len(framework.Levels) {
+				return resterrors.NewBadRequestError(fmt.Sprintf("descriptor of %s references level %d, which does not exist", competency.Name, descriptor.LevelPosition))
+			}
+			if positions[descriptor.LevelPosition] {
+				return resterrors.NewBadRequestError(fmt.Sprintf("competency %s has more than one descriptor for level %d", competency.Name, descriptor.LevelPosition))
+			}
+			positions[descriptor.LevelPosition] = true
+
+			descriptor.Description = strings.TrimSpace(descriptor.Description)
+			if descriptor.Description == "" {
+				continue
+			}
+			descriptors = append(descriptors, descriptor)
+		}
+		competency.Descriptors = descriptors
+	}
+
+	return nil
+}
+
+// resolveCompetencyRatings validates the ratings against the framework, setting the ids of the competencies and levels
+func resolveCompetencyRatings(framework entity.CompetencyFramework, ratings []entity.CompetencyRating) ([]entity.CompetencyRating, error) {
+	if len(ratings) == 0 {
+		return ratings, resterrors.NewBadRequestError("at least one competency rating is required")
+	}
+
+	rated := make(map[int64]bool, len(ratings))
+	for i := range ratings {
+		rating := &ratings[i]
+
+		competency, found := framework.CompetencyByUUID(rating.CompetencyUUID)
+		if !found {
+			return ratings, resterrors.NewBadRequestError("competency is not in the framework: " + rating.CompetencyUUID)
+		}
+		if rated[competency.ID] {
+			return ratings, resterrors.NewBadRequestError("competency is rated more than once: " + competency.Name)
+		}
+		rated[competency.ID] = true
+
+		level, found := framework.LevelByUUID(rating.LevelUUID)
+		if !found {
+			return ratings, resterrors.NewBadRequestError("level is not in the framework: " + rating.LevelUUID)
+		}
+
+		rating.CompetencyID = competency.ID
+		rating.LevelID = level.ID
+		rating.Comment = strings.TrimSpace(rating.Comment)
+	}
+
+	return ratings, nil
+}
+
+// buildCompetencyGaps compares, for each competency of the framework, the level rated in the assessment with the
+// target level, adding what is expected at the target level and how much feedback evidence there is
+func buildCompetencyGaps(framework entity.CompetencyFramework, assessment *entity.CompetencyAssessment, target entity.CompetencyLevel, evidence []entity.CompetencyEvidenceCount) []entity.CompetencyGap {
+	ratingsByCompetency := make(map[int64]entity.CompetencyRating)
+	if assessment != nil {
+		for _, rating := range assessment.Ratings {
+			ratingsByCompetency[rating.CompetencyID] = rating
+		}
+	}
+
+	evidenceByCompetency := make(map[int64]entity.CompetencyEvidenceCount, len(evidence))
+	for _, count := range evidence {
+		evidenceByCompetency[count.CompetencyID] = count
+	}
+
+	gaps := make([]entity.CompetencyGap, 0, len(framework.Competencies))
+	for _, competency := range framework.Competencies {
+		gap := entity.CompetencyGap{Competency: competency}
+
+		for _, descriptor := range competency.Descriptors {
+			if descriptor.LevelID == target.ID {
+				gap.Descriptor = descriptor.Description
+				break
+			}
+		}
+
+		if rating, found := ratingsByCompetency[competency.ID]; found {
+			if level, found := framework.LevelByID(rating.LevelID); found {
+				difference := level.Position - target.Position
+				gap.AssessedLevel = &level
+				gap.Gap = &difference
+			}
+		}
+
+		if count, found := evidenceByCompetency[competency.ID]; found {
+			lastEvidenceAt := count.LastEvidenceAt
+			gap.EvidenceCount = count.Count
+			gap.LastEvidenceAt = &lastEvidenceAt
+		}
+
+		gaps = append(gaps, gap)
+	}
+
+	return gaps
+}
+
+func (s *competencyApp) CreateCompetencyFramework(ctx context.Context, framework entity.CompetencyFramework) (entity.CompetencyFramework, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return framework, err
+	}
+
+	if err := normalizeCompetencyFramework(&framework); err != nil {
+		return framework, err
+	}
+
+	framework.UUID = uuid.NewV4().String()
+	framework.CompanyID = company.ID
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		framework.ID, err = tx.Competency().CreateFramework(ctx, framework)
+		if err != nil {
+			return err
+		}
+
+		levelIDs := make([]int64, len(framework.Levels))
+		for i, level := range framework.Levels {
+			level.UUID = uuid.NewV4().String()
+			level.FrameworkID = framework.ID
+
+			levelIDs[i], err = tx.Competency().CreateLevel(ctx, level)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, competency := range framework.Competencies {
+			competency.UUID = uuid.NewV4().String()
+			competency.FrameworkID = framework.ID
+
+			competency.ID, err = tx.Competency().CreateCompetency(ctx, competency)
+			if err != nil {
+				return err
+			}
+
+			for _, descriptor := range competency.Descriptors {
+				descriptor.CompetencyID = competency.ID
+				descriptor.LevelID = levelIDs[descriptor.LevelPosition-1]
+
+				_, err = tx.Competency().CreateDescriptor(ctx, descriptor)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error creating competency framework", logger.Err(err))
+		return framework, err
+	}
+
+	s.log.Infow(ctx, "competency framework created successfully",
+		logger.String("framework_uuid", framework.UUID),
+		logger.Int("levels_count", len(framework.Levels)),
+		logger.Int("competencies_count", len(framework.Competencies)),
+	)
+
+	return s.getAuthorizedFramework(ctx, framework.UUID)
+}
+
+func (s *competencyApp) GetCompetencyFrameworks(ctx context.Context) ([]entity.CompetencyFramework, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	frameworks, err := s.dm.Competency().GetFrameworksByCompany(ctx, company.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting competency frameworks", logger.Err(err))
+		return nil, err
+	}
+
+	for i := range frameworks {
+		err = loadFrameworkDetails(ctx, s.dm, &frameworks[i])
+		if err != nil {
+			s.log.Errorw(ctx, "error getting competency framework details", logger.Err(err))
+			return nil, err
+		}
+	}
+
+	return frameworks, nil
+}
+
+func (s *competencyApp) GetCompetencyFramework(ctx context.Context, frameworkUUID string) (entity.CompetencyFramework, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	return s.getAuthorizedFramework(ctx, frameworkUUID)
+}
+
+func (s *competencyApp) DeleteCompetencyFramework(ctx context.Context, frameworkUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	framework, err := s.getAuthorizedFramework(ctx, frameworkUUID)
+	if err != nil {
+		return err
+	}
+
+	count, err := s.dm.Competency().CountAssessmentsByFramework(ctx, framework.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error counting competency assessments", logger.Err(err))
+		return err
+	}
+	if count > 0 {
+		return resterrors.NewBadRequestError("competency framework is used by assessments")
+	}
+
+	err = s.dm.Competency().DeleteFramework(ctx, framework.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error deleting competency framework", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *competencyApp) CreateCompetencyAssessment(ctx context.Context, personUUID string, assessment entity.CompetencyAssessment) (entity.CompetencyAssessment, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return assessment, err
+	}
+
+	framework, err := s.getAuthorizedFramework(ctx, assessment.FrameworkUUID)
+	if err != nil {
+		return assessment, err
+	}
+	if framework.CompanyID != person.CompanyID {
+		return assessment, resterrors.NewBadRequestError("competency framework does not belong to the company of the person")
+	}
+
+	expectedLevel, found := framework.LevelByUUID(assessment.ExpectedLevelUUID)
+	if !found {
+		return assessment, resterrors.NewBadRequestError("expected level is not in the framework")
+	}
+
+	assessment.Ratings, err = resolveCompetencyRatings(framework, assessment.Ratings)
+	if err != nil {
+		return assessment, err
+	}
+
+	preferences, err := s.userApp.GetUserPreferences(ctx)
+	if err != nil {
+		return assessment, err
+	}
+
+	today := date.Today(time.Now(), preferences.Location())
+	if assessment.AssessedAt.IsZero() {
+		assessment.AssessedAt = today
+	}
+	assessment.AssessedAt = date.Day(assessment.AssessedAt)
+	if assessment.AssessedAt.After(today) {
+		return assessment, resterrors.NewBadRequestError("assessed_at can not be in the future")
+	}
+
+	assessment.UUID = uuid.NewV4().String()
+	assessment.CompanyID = person.CompanyID
+	assessment.PersonID = person.ID
+	assessment.FrameworkID = framework.ID
+	assessment.ExpectedLevelID = expectedLevel.ID
+	assessment.Notes = strings.TrimSpace(assessment.Notes)
+	assessment.UserID, err = s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return assessment, err
+	}
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		assessment.ID, err = tx.Competency().CreateAssessment(ctx, assessment)
+		if err != nil {
+			return err
+		}
+
+		for _, rating := range assessment.Ratings {
+			rating.AssessmentID = assessment.ID
+
+			_, err = tx.Competency().CreateRating(ctx, rating)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error creating competency assessment", logger.Err(err))
+		return assessment, err
+	}
+
+	s.log.Infow(ctx, "competency assessment created successfully",
+		logger.String("assessment_uuid", assessment.UUID),
+		logger.String("person_uuid", personUUID),
+		logger.Int("ratings_count", len(assessment.Ratings)),
+	)
+
+	return s.getAuthorizedAssessment(ctx, assessment.UUID)
+}
+
+func (s *competencyApp) GetPersonCompetencyAssessments(ctx context.Context, personUUID, frameworkUUID string) ([]entity.CompetencyAssessment, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var frameworkID int64
+	if frameworkUUID != "" {
+		framework, err := s.getAuthorizedFramework(ctx, frameworkUUID)
+		if err != nil {
+			return nil, err
+		}
+		frameworkID = framework.ID
+	}
+
+	assessments, err := s.dm.Competency().GetAssessmentsByPerson(ctx, person.ID, frameworkID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting competency assessments by person", logger.Err(err))
+		return nil, err
+	}
+
+	for i := range assessments {
+		assessments[i].Ratings, err = s.dm.Competency().GetRatingsByAssessment(ctx, assessments[i].ID)
+		if err != nil {
+			s.log.Errorw(ctx, "error getting competency assessment ratings", logger.Err(err))
+			return nil, err
+		}
+	}
+
+	return assessments, nil
+}
+
+func (s *competencyApp) GetCompetencyAssessment(ctx context.Context, assessmentUUID string) (entity.CompetencyAssessment, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	return s.getAuthorizedAssessment(ctx, assessmentUUID)
+}
+
+func (s *competencyApp) DeleteCompetencyAssessment(ctx context.Context, assessmentUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	assessment, err := s.getAuthorizedAssessment(ctx, assessmentUUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.Competency().DeleteAssessment(ctx, assessment.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error deleting competency assessment", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *competencyApp) GetPersonCompetencyGaps(ctx context.Context, personUUID, frameworkUUID, targetLevelUUID string) (analysis entity.CompetencyGapAnalysis, err error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	if frameworkUUID == "" {
+		return analysis, resterrors.NewBadRequestError("framework_uuid is required")
+	}
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return analysis, err
+	}
+
+	analysis.Framework, err = s.getAuthorizedFramework(ctx, frameworkUUID)
+	if err != nil {
+		return analysis, err
+	}
+	if analysis.Framework.CompanyID != person.CompanyID {
+		return analysis, resterrors.NewBadRequestError("competency framework does not belong to the company of the person")
+	}
+
+	assessments, err := s.dm.Competency().GetAssessmentsByPerson(ctx, person.ID, analysis.Framework.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting competency assessments by person", logger.Err(err))
+		return analysis, err
+	}
+
+	if len(assessments) > 0 {
+		latest := assessments[0]
+		latest.Ratings, err = s.dm.Competency().GetRatingsByAssessment(ctx, latest.ID)
+		if err != nil {
+			s.log.Errorw(ctx, "error getting competency assessment ratings", logger.Err(err))
+			return analysis, err
+		}
+		analysis.Assessment = &latest
+	}
+
+	var found bool
+	switch {
+	case targetLevelUUID != "":
+		analysis.TargetLevel, found = analysis.Framework.LevelByUUID(targetLevelUUID)
+		if !found {
+			return analysis, resterrors.NewBadRequestError("target level is not in the framework")
+		}
+	case analysis.Assessment != nil:
+		analysis.TargetLevel, _ = analysis.Framework.LevelByID(analysis.Assessment.ExpectedLevelID)
+	default:
+		return analysis, resterrors.NewBadRequestError("target_level_uuid is required when the person was never assessed in the framework")
+	}
+
+	evidence, err := s.dm.Competency().GetEvidenceCounts(ctx, person.ID, analysis.Framework.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting competency evidence counts", logger.Err(err))
+		return analysis, err
+	}
+
+	analysis.Gaps = buildCompetencyGaps(analysis.Framework, analysis.Assessment, analysis.TargetLevel, evidence)
+
+	return analysis, nil
+}
+
+func (s *competencyApp) SetNoteCompetencies(ctx context.Context, noteUUID string, competencyUUIDs []string) ([]entity.Competency, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	note, _, err := s.personApp.getAuthorizedNote(ctx, noteUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !note.IsFeedback() {
+		return nil, resterrors.NewBadRequestError("only feedback notes can be tagged with competencies")
+	}
+
+	competencyIDs := make([]int64, 0, len(competencyUUIDs))
+	seen := make(map[int64]bool, len(competencyUUIDs))
+	for _, competencyUUID := range competencyUUIDs {
+		competency, err := s.getCompanyCompetency(ctx, competencyUUID, note.CompanyID)
+		if err != nil {
+			return nil, err
+		}
+
+		if seen[competency.ID] {
+			continue
+		}
+		seen[competency.ID] = true
+		competencyIDs = append(competencyIDs, competency.ID)
+	}
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		err := tx.Competency().DeleteNoteCompetencies(ctx, note.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, competencyID := range competencyIDs {
+			err = tx.Competency().AddNoteCompetency(ctx, note.ID, competencyID)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error setting note competencies", logger.Err(err))
+		return nil, err
+	}
+
+	competencies, err := s.dm.Competency().GetNoteCompetencies(ctx, note.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting note competencies", logger.Err(err))
+		return nil, err
+	}
+
+	return competencies, nil
+}
+
+func (s *competencyApp) GetCompetencyEvidence(ctx context.Context, personUUID, competencyUUID string) ([]entity.UnifiedTimelineEntry, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	competency, err := s.getCompanyCompetency(ctx, competencyUUID, person.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	evidence, err := s.dm.Competency().GetCompetencyEvidence(ctx, person.ID, competency.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting competency evidence", logger.Err(err))
+		return nil, err
+	}
+
+	return evidence, nil
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_normalizeCompetencyFramework(t *testing.T) {
+	newFramework := func() entity.CompetencyFramework {
+		return entity.CompetencyFramework{
+			Name:   " Engineering ",
+			Levels: []entity.CompetencyLevel{{Name: " Engineer "}, {Name: "Senior Engineer"}},
+			Competencies: []entity.Competency{
+				{
+					Name: " Code quality ",
+					Descriptors: []entity.CompetencyDescriptor{
+						{LevelPosition: 1, Description: " Writes tested code "},
+						{LevelPosition: 2, Description: "  "},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("Should trim the texts, set the positions and drop empty descriptors", func(t *testing.T) {
+		framework := newFramework()
+
+		err := normalizeCompetencyFramework(&framework)
+		require.NoError(t, err)
+		require.Equal(t, "Engineering", framework.Name)
+		require.Equal(t, "Engineer", framework.Levels[0].Name)
+		require.Equal(t, 1, framework.Levels[0].Position)
+		require.Equal(t, 2, framework.Levels[1].Position)
+		require.Equal(t, "Code quality", framework.Competencies[0].Name)
+		require.Len(t, framework.Competencies[0].Descriptors, 1)
+		require.Equal(t, "Writes tested code", framework.Competencies[0].Descriptors[0].Description)
+	})
+
+	t.Run("Should return error when there are no levels", func(t *testing.T) {
+		framework := newFramework()
+		framework.Levels = nil
+		require.Error(t, normalizeCompetencyFramework(&framework))
+	})
+
+	t.Run("Should return error when a level name is repeated", func(t *testing.T) {
+		framework := newFramework()
+		framework.Levels[1].Name = "engineer"
+		require.Error(t, normalizeCompetencyFramework(&framework))
+	})
+
+	t.Run("Should return error when there are no competencies", func(t *testing.T) {
+		framework := newFramework()
+		framework.Competencies = nil
+		require.Error(t, normalizeCompetencyFramework(&framework))
+	})
+
+	t.Run("Should return error when a descriptor references a level that does not exist", func(t *testing.T) {
+		framework := newFramework()
+		framework.Competencies[0].Descriptors[1].LevelPosition = 3
+		require.Error(t, normalizeCompetencyFramework(&framework))
+	})
+
+	t.Run("Should return error when a competency has two descriptors for the same level", func(t *testing.T) {
+		framework := newFramework()
+		framework.Competencies[0].Descriptors[1].LevelPosition = 1
+		require.Error(t, normalizeCompetencyFramework(&framework))
+	})
+}
+
+func testCompetencyFramework() entity.CompetencyFramework {
+	return entity.CompetencyFramework{
+		Levels: []entity.CompetencyLevel{
+			{ID: 1, UUID: "level-1", Name: "Engineer", Position: 1},
+			{ID: 2, UUID: "level-2", Name: "Senior Engineer", Position: 2},
+			{ID: 3, UUID: "level-3", Name: "Staff Engineer", Position: 3},
+		},
+		Competencies: []entity.Competency{
+			{
+				ID:   10,
+				UUID: "competency-1",
+				Name: "Code quality",
+				Descriptors: []entity.CompetencyDescriptor{
+					{LevelID: 2, Description: "Sets the quality bar of the team"},
+				},
+			},
+			{ID: 20, UUID: "competency-2", Name: "Communication"},
+			{ID: 30, UUID: "competency-3", Name: "Mentoring"},
+		},
+	}
+}
+
+func Test_resolveCompetencyRatings(t *testing.T) {
+	framework := testCompetencyFramework()
+
+	t.Run("Should set the ids of the competencies and levels", func(t *testing.T) {
+		ratings, err := resolveCompetencyRatings(framework, []entity.CompetencyRating{
+			{CompetencyUUID: "competency-1", LevelUUID: "level-2", Comment: " Great reviews "},
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(10), ratings[0].CompetencyID)
+		require.Equal(t, int64(2), ratings[0].LevelID)
+		require.Equal(t, "Great reviews", ratings[0].Comment)
+	})
+
+	t.Run("Should return error when there are no ratings", func(t *testing.T) {
+		_, err := resolveCompetencyRatings(framework, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("Should return error when the competency is not in the framework", func(t *testing.T) {
+		_, err := resolveCompetencyRatings(framework, []entity.CompetencyRating{{CompetencyUUID: "other", LevelUUID: "level-1"}})
+		require.Error(t, err)
+	})
+
+	t.Run("Should return error when the level is not in the framework", func(t *testing.T) {
+		_, err := resolveCompetencyRatings(framework, []entity.CompetencyRating{{CompetencyUUID: "competency-1", LevelUUID: "other"}})
+		require.Error(t, err)
+	})
+
+	t.Run("Should return error when a competency is rated twice", func(t *testing.T) {
+		_, err := resolveCompetencyRatings(framework, []entity.CompetencyRating{
+			{CompetencyUUID: "competency-1", LevelUUID: "level-1"},
+			{CompetencyUUID: "competency-1", LevelUUID: "level-2"},
+		})
+		require.Error(t, err)
+	})
+}
+
+func Test_buildCompetencyGaps(t *testing.T) {
+	framework := testCompetencyFramework()
+	target := framework.Levels[1]
+	lastEvidenceAt := time.Date(2025, time.July, 10, 12, 0, 0, 0, time.UTC)
+
+	assessment := &entity.CompetencyAssessment{
+		Ratings: []entity.CompetencyRating{
+			{CompetencyID: 10, LevelID: 1},
+			{CompetencyID: 20, LevelID: 3},
+		},
+	}
+
+	gaps := buildCompetencyGaps(framework, assessment, target, []entity.CompetencyEvidenceCount{
+		{CompetencyID: 30, Count: 2, LastEvidenceAt: lastEvidenceAt},
+	})
+	require.Len(t, gaps, 3)
+
+	require.Equal(t, "Engineer", gaps[0].AssessedLevel.Name)
+	require.Equal(t, -1, *gaps[0].Gap)
+	require.Equal(t, "Sets the quality bar of the team", gaps[0].Descriptor)
+	require.Zero(t, gaps[0].EvidenceCount)
+
+	require.Equal(t, 1, *gaps[1].Gap)
+	require.Empty(t, gaps[1].Descriptor)
+
+	require.Nil(t, gaps[2].AssessedLevel)
+	require.Nil(t, gaps[2].Gap)
+	require.Equal(t, 2, gaps[2].EvidenceCount)
+	require.Equal(t, lastEvidenceAt, *gaps[2].LastEvidenceAt)
+
+	t.Run("Should return the competencies without gaps when the person was never assessed", func(t *testing.T) {
+		gaps := buildCompetencyGaps(framework, nil, target, nil)
+		require.Len(t, gaps, 3)
+		for _, gap := range gaps {
+			require.Nil(t, gap.Gap)
+		}
+	})
+}
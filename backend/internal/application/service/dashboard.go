@@ -12,18 +12,24 @@ import (
 )
 
 type dashboardService struct {
-	dm        contract.DataManager
-	log       logger.Logger
-	authApp   contract.AuthApp
-	personApp contract.PersonApp
+	dm            contract.DataManager
+	log           logger.Logger
+	authApp       contract.AuthApp
+	personApp     contract.PersonApp
+	reminderApp   contract.ReminderApp
+	actionItemApp contract.ActionItemApp
+	cadenceApp    contract.CadenceApp
 }
 
-func newDashboardService(infra domain.Infrastructure, authApp contract.AuthApp, personApp contract.PersonApp) contract.DashboardApp {
+func newDashboardService(infra domain.Infrastructure, authApp contract.AuthApp, personApp contract.PersonApp, reminderApp contract.ReminderApp, actionItemApp contract.ActionItemApp, cadenceApp contract.CadenceApp) contract.DashboardApp {
 	return &dashboardService{
-		dm:        infra.DataManager(),
-		log:       infra.Logger(),
-		authApp:   authApp,
-		personApp: personApp,
+		dm:            infra.DataManager(),
+		log:           infra.Logger(),
+		authApp:       authApp,
+		personApp:     personApp,
+		reminderApp:   reminderApp,
+		actionItemApp: actionItemApp,
+		cadenceApp:    cadenceApp,
 	}
 }
 
@@ -40,10 +46,11 @@ func (s *dashboardService) GetDashboardData(ctx context.Context, companyUUID str
 	var (
 		wg                                                                  sync.WaitGroup
 		peopleErr, totalPeopleErr, oneOnOnesErr, avgFreqErr, lastMeetingErr error
+		remindersErr, actionItemsErr, oneOnOnesDueErr                       error
 	)
 
 	// Execute all operations in parallel using goroutines
-	wg.Add(5)
+	wg.Add(8)
 
 	// Get people data
 	go func() {
@@ -70,7 +77,7 @@ func (s *dashboardService) GetDashboardData(ctx context.Context, companyUUID str
 	// Get one-on-ones this month
 	go func() {
 		defer wg.Done()
-		count, err := s.dm.Note().GetOneOnOnesCountThisMonth(ctx, company.ID)
+		count, err := s.dm.Meeting().GetOneOnOnesCountThisMonth(ctx, company.ID)
 		if err != nil {
 			oneOnOnesErr = err
 			return
@@ -81,7 +88,7 @@ func (s *dashboardService) GetDashboardData(ctx context.Context, companyUUID str
 	// Get average frequency
 	go func() {
 		defer wg.Done()
-		avgDays, err := s.dm.Note().GetAverageFrequencyDays(ctx, company.ID)
+		avgDays, err := s.dm.Meeting().GetAverageFrequencyDays(ctx, company.ID)
 		if err != nil {
 			avgFreqErr = err
 			return
@@ -92,7 +99,7 @@ func (s *dashboardService) GetDashboardData(ctx context.Context, companyUUID str
 	// Get last meeting date
 	go func() {
 		defer wg.Done()
-		lastDate, err := s.dm.Note().GetLastMeetingDate(ctx, company.ID)
+		lastDate, err := s.dm.Meeting().GetLastMeetingDate(ctx, company.ID)
 		if err != nil {
 			lastMeetingErr = err
 			return
@@ -100,6 +107,39 @@ func (s *dashboardService) GetDashboardData(ctx context.Context, companyUUID str
 		dashboard.Stats.LastMeetingDate = lastDate
 	}()
 
+	// Get upcoming birthdays and work anniversaries
+	go func() {
+		defer wg.Done()
+		reminders, err := s.reminderApp.GetUpcomingReminders(ctx, domain.RemindersDashboardDays)
+		if err != nil {
+			remindersErr = err
+			return
+		}
+		dashboard.UpcomingReminders = reminders
+	}()
+
+	// Get overdue action items
+	go func() {
+		defer wg.Done()
+		items, err := s.actionItemApp.GetOverdueActionItems(ctx)
+		if err != nil {
+			actionItemsErr = err
+			return
+		}
+		dashboard.OverdueActionItems = items
+	}()
+
+	// Get overdue and upcoming 1:1s by the people cadence
+	go func() {
+		defer wg.Done()
+		due, err := s.cadenceApp.GetOneOnOnesDue(ctx, domain.OneOnOnesDueDashboardDays)
+		if err != nil {
+			oneOnOnesDueErr = err
+			return
+		}
+		dashboard.OneOnOnesDue = due
+	}()
+
 	wg.Wait()
 
 	// Check for errors (only fail on critical ones, log others)
@@ -129,12 +169,30 @@ func (s *dashboardService) GetDashboardData(ctx context.Context, companyUUID str
 		dashboard.Stats.LastMeetingDate = nil
 	}
 
+	if remindersErr != nil {
+		s.log.Errorw(ctx, "error getting upcoming reminders", logger.Err(remindersErr))
+		dashboard.UpcomingReminders = []entity.PersonReminder{}
+	}
+
+	if actionItemsErr != nil {
+		s.log.Errorw(ctx, "error getting overdue action items", logger.Err(actionItemsErr))
+		dashboard.OverdueActionItems = []entity.ActionItem{}
+	}
+
+	if oneOnOnesDueErr != nil {
+		s.log.Errorw(ctx, "error getting 1:1s due", logger.Err(oneOnOnesDueErr))
+		dashboard.OneOnOnesDue = []entity.OneOnOneDue{}
+	}
+
 	s.log.Infow(ctx, "dashboard data retrieved successfully",
 		logger.String("company_uuid", companyUUID),
 		logger.Int("people_count", len(dashboard.People)),
 		logger.Int64("total_people", dashboard.Stats.TotalPeople),
 		logger.Int64("one_on_ones_this_month", dashboard.Stats.OneOnOnesThisMonth),
 		logger.Float64("average_frequency_days", dashboard.Stats.AverageFrequency),
+		logger.Int("upcoming_reminders", len(dashboard.UpcomingReminders)),
+		logger.Int("overdue_action_items", len(dashboard.OverdueActionItems)),
+		logger.Int("one_on_ones_due", len(dashboard.OneOnOnesDue)),
 	)
 
 	return dashboard, nil
@@ -0,0 +1,543 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/twinj/uuid"
+)
+
+type feedbackRequestApp struct {
+	dm        contract.DataManager
+	log       logger.Logger
+	authApp   contract.AuthApp
+	personApp *personApp
+}
+
+func newFeedbackRequestApp(infra domain.Infrastructure, authApp contract.AuthApp, personApp *personApp) contract.FeedbackRequestApp {
+	return &feedbackRequestApp{
+		dm:        infra.DataManager(),
+		log:       infra.Logger(),
+		authApp:   authApp,
+		personApp: personApp,
+	}
+}
+
+// defaultFeedbackQuestions is the questionnaire of the requests created without questions
+func defaultFeedbackQuestions() []entity.FeedbackQuestion {
+	return []entity.FeedbackQuestion{
+		{Text: "What is this person doing well that they should keep doing?", Type: domain.FeedbackQuestionTypeText, Required: true},
+		{Text: "What could this person do differently to be more effective?", Type: domain.FeedbackQuestionTypeText, Required: true},
+		{Text: "How would you rate your overall experience working with this person?", Type: domain.FeedbackQuestionTypeRating},
+	}
+}
+
+// normalizeFeedbackQuestions trims and validates the questionnaire, using the default one when it is empty
+func normalizeFeedbackQuestions(questions []entity.FeedbackQuestion) ([]entity.FeedbackQuestion, error) {
+	if len(questions) == 0 {
+		return defaultFeedbackQuestions(), nil
+	}
+
+	if len(questions) > domain.FeedbackRequestMaxQuestions {
+		return questions, resterrors.NewBadRequestError(fmt.Sprintf("a feedback request can have at most %d questions", domain.FeedbackRequestMaxQuestions))
+	}
+
+	for i := range questions {
+		question := &questions[i]
+
+		question.Text = strings.TrimSpace(question.Text)
+		if question.Text == "" {
+			return questions, resterrors.NewBadRequestError("question text is required")
+		}
+
+		switch question.Type {
+		case "":
+			question.Type = domain.FeedbackQuestionTypeText
+		case domain.FeedbackQuestionTypeText, domain.FeedbackQuestionTypeRating:
+		default:
+			return questions, resterrors.NewBadRequestError("invalid question type: " + question.Type)
+		}
+	}
+
+	return questions, nil
+}
+
+// feedbackRequestExpiration returns when the links of a request created now expire
+func feedbackRequestExpiration(now time.Time, expiresInDays int) (time.Time, error) {
+	if expiresInDays == 0 {
+		expiresInDays = domain.FeedbackRequestDefaultExpirationDays
+	}
+
+	if expiresInDays < 0 || expiresInDays > domain.FeedbackRequestMaxExpirationDays {
+		return time.Time{}, resterrors.NewBadRequestError(fmt.Sprintf("expires_in_days must be between 1 and %d", domain.FeedbackRequestMaxExpirationDays))
+	}
+
+	return now.AddDate(0, 0, expiresInDays), nil
+}
+
+// normalizeExternalRespondent trims and validates a respondent that is not a person of the company
+func normalizeExternalRespondent(respondent *entity.FeedbackRespondent) error {
+	respondent.Name = strings.TrimSpace(respondent.Name)
+	respondent.Email = strings.ToLower(strings.TrimSpace(respondent.Email))
+
+	if respondent.Email == "" {
+		return resterrors.NewBadRequestError("respondent email is required when it is not a person of the company")
+	}
+
+	address, err := mail.ParseAddress(respondent.Email)
+	if err != nil || address.Address != respondent.Email {
+		return resterrors.NewBadRequestError("invalid respondent email: " + respondent.Email)
+	}
+
+	if respondent.Name == "" {
+		respondent.Name = respondent.Email
+	}
+
+	return nil
+}
+
+// normalizeFeedbackResponse validates the feedback type and category chosen by the respondent, the type defaults to neutral
+func normalizeFeedbackResponse(response entity.FeedbackResponse) (feedbackType string, feedbackCategory *string, err error) {
+	feedbackType = response.FeedbackType
+	switch feedbackType {
+	case "":
+		feedbackType = domain.FeedbackTypeNeutral
+	case domain.FeedbackTypePositive, domain.FeedbackTypeConstructive, domain.FeedbackTypeNeutral:
+	default:
+		return feedbackType, nil, resterrors.NewBadRequestError("invalid feedback type: " + feedbackType)
+	}
+
+	switch response.FeedbackCategory {
+	case "":
+		return feedbackType, nil, nil
+	case domain.FeedbackCategoryPerformance, domain.FeedbackCategoryBehavior, domain.FeedbackCategorySkill, domain.FeedbackCategoryCollaboration:
+		category := response.FeedbackCategory
+		return feedbackType, &category, nil
+	default:
+		return feedbackType, nil, resterrors.NewBadRequestError("invalid feedback category: " + response.FeedbackCategory)
+	}
+}
+
+// buildFeedbackNoteContent validates the answers against the questionnaire and writes them
+// as the content of the feedback note, each question followed by its answer with its mentions and #tags escaped
+func buildFeedbackNoteContent(questions []entity.FeedbackQuestion, answers []entity.FeedbackAnswer) (string, error) {
+	answersByQuestion := make(map[string]entity.FeedbackAnswer, len(answers))
+	for _, answer := range answers {
+		answersByQuestion[answer.QuestionUUID] = answer
+	}
+
+	var blocks []string
+	for _, question := range questions {
+		answer, found := answersByQuestion[question.UUID]
+		delete(answersByQuestion, question.UUID)
+
+		var text string
+		switch question.Type {
+		case domain.FeedbackQuestionTypeRating:
+			if found && answer.Rating != nil {
+				if *answer.Rating < domain.FeedbackRatingMin || *answer.Rating > domain.FeedbackRatingMax {
+					return "", resterrors.NewBadRequestError(fmt.Sprintf("rating must be between %d and %d", domain.FeedbackRatingMin, domain.FeedbackRatingMax))
+				}
+				text = fmt.Sprintf("%d/%d", *answer.Rating, domain.FeedbackRatingMax)
+			}
+		default:
+			// the respondent is not a user of the company, the answer can't create tags or mention people
+			text = entity.EscapeNoteText(strings.TrimSpace(answer.Text))
+		}
+
+		if text == "" {
+			if question.Required {
+				return "", resterrors.NewBadRequestError("answer is required for question: " + question.Text)
+			}
+			continue
+		}
+
+		blocks = append(blocks, question.Text+"\n"+text)
+	}
+
+	if len(answersByQuestion) > 0 {
+		return "", resterrors.NewBadRequestError("answer to a question that is not in the feedback request")
+	}
+
+	if len(blocks) == 0 {
+		return "", resterrors.NewBadRequestError("at least one answer is required")
+	}
+
+	return strings.Join(blocks, "\n\n"), nil
+}
+
+// getAuthorizedRequest loads a request by UUID and checks that the logged user owns the request's company
+func (s *feedbackRequestApp) getAuthorizedRequest(ctx context.Context, requestUUID string) (entity.FeedbackRequest, error) {
+	request, err := s.dm.FeedbackRequest().GetRequestByUUID(ctx, requestUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return request, resterrors.NewNotFoundError("feedback request not found")
+		}
+		s.log.Errorw(ctx, "error getting feedback request by UUID", logger.Err(err))
+		return request, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return request, err
+	}
+
+	_, err = s.personApp.validateUserCompanyAccess(ctx, userID, request.CompanyID)
+	if err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
+
+// loadRequestDetails sets the questions and the respondents of the request
+func (s *feedbackRequestApp) loadRequestDetails(ctx context.Context, request *entity.FeedbackRequest) (err error) {
+	request.Questions, err = s.dm.FeedbackRequest().GetQuestionsByRequest(ctx, request.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting feedback request questions", logger.Err(err))
+		return err
+	}
+
+	request.Respondents, err = s.dm.FeedbackRequest().GetRespondentsByRequest(ctx, request.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting feedback request respondents", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// resolveRespondents validates the respondents of a request about the person, taking the name and email
+// of the peers from the company people, and generates the token of each link
+func (s *feedbackRequestApp) resolveRespondents(ctx context.Context, person entity.Person, respondents []entity.FeedbackRespondent) ([]entity.FeedbackRespondent, error) {
+	if len(respondents) == 0 {
+		return respondents, resterrors.NewBadRequestError("at least one respondent is required")
+	}
+
+	if len(respondents) > domain.FeedbackRequestMaxRespondents {
+		return respondents, resterrors.NewBadRequestError(fmt.Sprintf("a feedback request can have at most %d respondents", domain.FeedbackRequestMaxRespondents))
+	}
+
+	seen := make(map[string]bool, len(respondents))
+	for i := range respondents {
+		respondent := &respondents[i]
+
+		if respondent.PersonUUID != "" {
+			peer, err := s.dm.Person().GetPersonByUUID(ctx, respondent.PersonUUID)
+			if err != nil {
+				if mysqlutils.SQLNotFound(err.Error()) {
+					return respondents, resterrors.NewNotFoundError("respondent person not found")
+				}
+				s.log.Errorw(ctx, "error getting respondent person by UUID", logger.Err(err))
+				return respondents, err
+			}
+
+			if peer.CompanyID != person.CompanyID {
+				return respondents, resterrors.NewBadRequestError("respondent person does not belong to this company")
+			}
+
+			if peer.ID == person.ID {
+				return respondents, resterrors.NewBadRequestError("the person can not be a respondent of a feedback request about themselves")
+			}
+
+			respondent.PersonID = &peer.ID
+			respondent.Name = peer.Name
+			respondent.Email = strings.ToLower(strings.TrimSpace(peer.Email))
+		} else if err := normalizeExternalRespondent(respondent); err != nil {
+			return respondents, err
+		}
+
+		key := respondent.PersonUUID + "|" + respondent.Email
+		if seen[key] {
+			return respondents, resterrors.NewBadRequestError("repeated respondent: " + respondent.Name)
+		}
+		seen[key] = true
+
+		token, err := generateSecretToken()
+		if err != nil {
+			s.log.Errorw(ctx, "error generating feedback request token", logger.Err(err))
+			return respondents, err
+		}
+
+		respondent.UUID = uuid.NewV4().String()
+		respondent.Token = token
+		respondent.TokenHash = hashSecretToken(token)
+	}
+
+	return respondents, nil
+}
+
+func (s *feedbackRequestApp) CreateFeedbackRequest(ctx context.Context, personUUID string, request entity.FeedbackRequest, expiresInDays int) (entity.FeedbackRequest, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return request, err
+	}
+
+	request.Questions, err = normalizeFeedbackQuestions(request.Questions)
+	if err != nil {
+		return request, err
+	}
+
+	request.ExpiresAt, err = feedbackRequestExpiration(time.Now(), expiresInDays)
+	if err != nil {
+		return request, err
+	}
+
+	request.Respondents, err = s.resolveRespondents(ctx, person, request.Respondents)
+	if err != nil {
+		return request, err
+	}
+
+	request.UUID = uuid.NewV4().String()
+	request.CompanyID = person.CompanyID
+	request.PersonID = person.ID
+	request.Message = strings.TrimSpace(request.Message)
+	request.UserID, err = s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return request, err
+	}
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		request.ID, err = tx.FeedbackRequest().CreateRequest(ctx, request)
+		if err != nil {
+			return err
+		}
+
+		for _, question := range request.Questions {
+			question.UUID = uuid.NewV4().String()
+			question.RequestID = request.ID
+
+			_, err = tx.FeedbackRequest().CreateQuestion(ctx, question)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, respondent := range request.Respondents {
+			respondent.RequestID = request.ID
+
+			_, err = tx.FeedbackRequest().CreateRespondent(ctx, respondent)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error creating feedback request", logger.Err(err))
+		return request, err
+	}
+
+	s.log.Infow(ctx, "feedback request created successfully",
+		logger.String("request_uuid", request.UUID),
+		logger.String("person_uuid", personUUID),
+		logger.Int("respondents_count", len(request.Respondents)),
+	)
+
+	tokens := make(map[string]string, len(request.Respondents))
+	for _, respondent := range request.Respondents {
+		tokens[respondent.UUID] = respondent.Token
+	}
+
+	createdRequest, err := s.GetFeedbackRequest(ctx, request.UUID)
+	if err != nil {
+		return createdRequest, err
+	}
+
+	// The tokens are returned only once, so the manager can send the links
+	for i := range createdRequest.Respondents {
+		createdRequest.Respondents[i].Token = tokens[createdRequest.Respondents[i].UUID]
+	}
+
+	return createdRequest, nil
+}
+
+func (s *feedbackRequestApp) GetPersonFeedbackRequests(ctx context.Context, personUUID string) ([]entity.FeedbackRequest, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	requests, err := s.dm.FeedbackRequest().GetRequestsByPerson(ctx, person.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting feedback requests by person", logger.Err(err))
+		return nil, err
+	}
+
+	for i := range requests {
+		err = s.loadRequestDetails(ctx, &requests[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return requests, nil
+}
+
+func (s *feedbackRequestApp) GetFeedbackRequest(ctx context.Context, requestUUID string) (entity.FeedbackRequest, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	request, err := s.getAuthorizedRequest(ctx, requestUUID)
+	if err != nil {
+		return request, err
+	}
+
+	err = s.loadRequestDetails(ctx, &request)
+	if err != nil {
+		return request, err
+	}
+
+	return request, nil
+}
+
+func (s *feedbackRequestApp) DeleteFeedbackRequest(ctx context.Context, requestUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	request, err := s.getAuthorizedRequest(ctx, requestUUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.FeedbackRequest().DeleteRequest(ctx, request.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error deleting feedback request", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// getOpenForm loads the request and the respondent of a link that can still be answered
+func (s *feedbackRequestApp) getOpenForm(ctx context.Context, token string) (form entity.FeedbackForm, err error) {
+	if token == "" {
+		return form, resterrors.NewNotFoundError("feedback request not found")
+	}
+
+	form.Respondent, err = s.dm.FeedbackRequest().GetRespondentByTokenHash(ctx, hashSecretToken(token))
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return form, resterrors.NewNotFoundError("feedback request not found")
+		}
+		s.log.Errorw(ctx, "error getting feedback respondent by token", logger.Err(err))
+		return form, err
+	}
+
+	if form.Respondent.HasResponded() {
+		return form, resterrors.NewConflictError("feedback was already sent")
+	}
+
+	form.Request, err = s.dm.FeedbackRequest().GetRequestByID(ctx, form.Respondent.RequestID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting feedback request by ID", logger.Err(err))
+		return form, err
+	}
+
+	if form.Request.IsExpired(time.Now()) {
+		return form, resterrors.NewBadRequestError("feedback request has expired")
+	}
+
+	form.Request.Questions, err = s.dm.FeedbackRequest().GetQuestionsByRequest(ctx, form.Request.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting feedback request questions", logger.Err(err))
+		return form, err
+	}
+
+	return form, nil
+}
+
+func (s *feedbackRequestApp) GetFeedbackForm(ctx context.Context, token string) (entity.FeedbackForm, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	return s.getOpenForm(ctx, token)
+}
+
+func (s *feedbackRequestApp) SubmitFeedbackResponse(ctx context.Context, token string, response entity.FeedbackResponse) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	form, err := s.getOpenForm(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	feedbackType, feedbackCategory, err := normalizeFeedbackResponse(response)
+	if err != nil {
+		return err
+	}
+
+	content, err := buildFeedbackNoteContent(form.Request.Questions, response.Answers)
+	if err != nil {
+		return err
+	}
+
+	respondentName := form.Respondent.Name
+	if form.Request.Anonymous {
+		respondentName = domain.FeedbackAnonymousRespondentName
+	}
+
+	company, err := s.dm.Company().GetCompanyByID(ctx, form.Request.CompanyID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting company by ID", logger.Err(err))
+		return err
+	}
+
+	person, err := s.dm.Person().GetPersonByID(ctx, form.Request.PersonID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting person by ID", logger.Err(err))
+		return err
+	}
+
+	// Anonymous responses are not linked to the respondent, so the note can't be traced back to them
+	markResponded := func(tx contract.DataManager, note entity.Note) error {
+		var noteID *int64
+		if !form.Request.Anonymous {
+			noteID = &note.ID
+		}
+
+		err := tx.FeedbackRequest().MarkRespondentResponded(ctx, form.Respondent.ID, noteID, time.Now())
+		if err != nil && mysqlutils.SQLNotFound(err.Error()) {
+			// The link was answered concurrently, rolling back keeps only the first response
+			return resterrors.NewConflictError("feedback was already sent")
+		}
+		return err
+	}
+
+	// The note belongs to the manager that requested the feedback, showing the respondent as author.
+	// It is written with the respondent update, so a repeated response leaves no note, activity or outbox event
+	note, err := s.personApp.saveNote(ctx, company, person, form.Request.UserID, entity.Note{
+		Type:             domain.NoteTypeFeedback,
+		Content:          content,
+		FeedbackType:     &feedbackType,
+		FeedbackCategory: feedbackCategory,
+		RespondentName:   &respondentName,
+	}, markResponded)
+	if err != nil {
+		return err
+	}
+
+	s.log.Infow(ctx, "feedback response received",
+		logger.String("request_uuid", form.Request.UUID),
+		logger.String("note_uuid", note.UUID),
+	)
+
+	return nil
+}
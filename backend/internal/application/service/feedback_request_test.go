@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_normalizeFeedbackQuestions(t *testing.T) {
+	t.Run("Should use the default questionnaire when there are no questions", func(t *testing.T) {
+		questions, err := normalizeFeedbackQuestions(nil)
+		require.NoError(t, err)
+		require.Equal(t, defaultFeedbackQuestions(), questions)
+	})
+
+	t.Run("Should trim the texts and default the type to text", func(t *testing.T) {
+		questions, err := normalizeFeedbackQuestions([]entity.FeedbackQuestion{
+			{Text: "  What should they keep doing?  "},
+			{Text: "How was working with them?", Type: domain.FeedbackQuestionTypeRating},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "What should they keep doing?", questions[0].Text)
+		require.Equal(t, domain.FeedbackQuestionTypeText, questions[0].Type)
+		require.Equal(t, domain.FeedbackQuestionTypeRating, questions[1].Type)
+	})
+
+	t.Run("Should return error when a question has no text", func(t *testing.T) {
+		_, err := normalizeFeedbackQuestions([]entity.FeedbackQuestion{{Text: "  "}})
+		require.Error(t, err)
+	})
+
+	t.Run("Should return error when the type is invalid", func(t *testing.T) {
+		_, err := normalizeFeedbackQuestions([]entity.FeedbackQuestion{{Text: "Anything else?", Type: "choice"}})
+		require.Error(t, err)
+	})
+}
+
+func Test_feedbackRequestExpiration(t *testing.T) {
+	now := time.Date(2025, time.July, 10, 12, 0, 0, 0, time.UTC)
+
+	expiresAt, err := feedbackRequestExpiration(now, 0)
+	require.NoError(t, err)
+	require.Equal(t, now.AddDate(0, 0, domain.FeedbackRequestDefaultExpirationDays), expiresAt)
+
+	expiresAt, err = feedbackRequestExpiration(now, 3)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, time.July, 13, 12, 0, 0, 0, time.UTC), expiresAt)
+
+	_, err = feedbackRequestExpiration(now, domain.FeedbackRequestMaxExpirationDays+1)
+	require.Error(t, err)
+
+	_, err = feedbackRequestExpiration(now, -1)
+	require.Error(t, err)
+}
+
+func Test_normalizeExternalRespondent(t *testing.T) {
+	t.Run("Should use the email as name when there is no name", func(t *testing.T) {
+		respondent := entity.FeedbackRespondent{Email: " Ana@Client.com "}
+
+		err := normalizeExternalRespondent(&respondent)
+		require.NoError(t, err)
+		require.Equal(t, "ana@client.com", respondent.Email)
+		require.Equal(t, "ana@client.com", respondent.Name)
+	})
+
+	t.Run("Should return error when the email is missing", func(t *testing.T) {
+		require.Error(t, normalizeExternalRespondent(&entity.FeedbackRespondent{Name: "Ana"}))
+	})
+
+	t.Run("Should return error when the email is invalid", func(t *testing.T) {
+		require.Error(t, normalizeExternalRespondent(&entity.FeedbackRespondent{Email: "Ana <ana@client.com>"}))
+	})
+}
+
+func Test_normalizeFeedbackResponse(t *testing.T) {
+	feedbackType, category, err := normalizeFeedbackResponse(entity.FeedbackResponse{})
+	require.NoError(t, err)
+	require.Equal(t, domain.FeedbackTypeNeutral, feedbackType)
+	require.Nil(t, category)
+
+	feedbackType, category, err = normalizeFeedbackResponse(entity.FeedbackResponse{
+		FeedbackType:     domain.FeedbackTypePositive,
+		FeedbackCategory: domain.FeedbackCategoryCollaboration,
+	})
+	require.NoError(t, err)
+	require.Equal(t, domain.FeedbackTypePositive, feedbackType)
+	require.Equal(t, domain.FeedbackCategoryCollaboration, *category)
+
+	_, _, err = normalizeFeedbackResponse(entity.FeedbackResponse{FeedbackType: "great"})
+	require.Error(t, err)
+
+	_, _, err = normalizeFeedbackResponse(entity.FeedbackResponse{FeedbackCategory: "attitude"})
+	require.Error(t, err)
+}
+
+func Test_buildFeedbackNoteContent(t *testing.T) {
+	questions := []entity.FeedbackQuestion{
+		{UUID: "question-1", Text: "What should they keep doing?", Type: domain.FeedbackQuestionTypeText, Required: true},
+		{UUID: "question-2", Text: "Anything else?", Type: domain.FeedbackQuestionTypeText},
+		{UUID: "question-3", Text: "How was working with them?", Type: domain.FeedbackQuestionTypeRating},
+	}
+
+	t.Run("Should write the answered questions in order", func(t *testing.T) {
+		content, err := buildFeedbackNoteContent(questions, []entity.FeedbackAnswer{
+			{QuestionUUID: "question-3", Rating: intPointer(4)},
+			{QuestionUUID: "question-1", Text: " Sharing knowledge "},
+			{QuestionUUID: "question-2", Text: "   "},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "What should they keep doing?\nSharing knowledge\n\nHow was working with them?\n4/5", content)
+	})
+
+	t.Run("Should not create tags or mentions from the answers", func(t *testing.T) {
+		ctx := context.Background()
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		// only the deletes of the rebuild are expected, no tag is created and no person is looked up
+		noteRepo := mocks.NewMockNoteRepo(ctrl)
+		tagRepo := mocks.NewMockTagRepo(ctrl)
+		m.mockDataManager.EXPECT().Note().Return(noteRepo).AnyTimes()
+		m.mockDataManager.EXPECT().Tag().Return(tagRepo).AnyTimes()
+		noteRepo.EXPECT().DeleteMentionsByNote(ctx, int64(1)).Return(nil).Times(1)
+		tagRepo.EXPECT().DeleteNoteTags(ctx, int64(1), domain.NoteTagSourceContent).Return(nil).Times(1)
+
+		content, err := buildFeedbackNoteContent(questions, []entity.FeedbackAnswer{
+			{QuestionUUID: "question-1", Text: "#promotion for {{person:abc-1|Maria}}"},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "What should they keep doing?\n\\#promotion for \\{\\{person:abc-1|Maria}}", content)
+
+		s := newPersonApp(m.mockDomain, nil)
+		note := entity.Note{ID: 1, CompanyID: 10, Content: content}
+
+		mentioned, err := s.rebuildNoteMentions(ctx, m.mockDataManager, note)
+		require.NoError(t, err)
+		require.Empty(t, mentioned)
+
+		tagsCount, err := s.rebuildNoteTags(ctx, m.mockDataManager, note)
+		require.NoError(t, err)
+		require.Zero(t, tagsCount)
+	})
+
+	t.Run("Should return error when a required question is not answered", func(t *testing.T) {
+		_, err := buildFeedbackNoteContent(questions, []entity.FeedbackAnswer{{QuestionUUID: "question-3", Rating: intPointer(4)}})
+		require.Error(t, err)
+	})
+
+	t.Run("Should return error when the rating is out of the scale", func(t *testing.T) {
+		_, err := buildFeedbackNoteContent(questions, []entity.FeedbackAnswer{
+			{QuestionUUID: "question-1", Text: "Sharing knowledge"},
+			{QuestionUUID: "question-3", Rating: intPointer(domain.FeedbackRatingMax + 1)},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("Should return error when answering a question of another request", func(t *testing.T) {
+		_, err := buildFeedbackNoteContent(questions, []entity.FeedbackAnswer{
+			{QuestionUUID: "question-1", Text: "Sharing knowledge"},
+			{QuestionUUID: "other", Text: "Hi"},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("Should return error when nothing is answered", func(t *testing.T) {
+		_, err := buildFeedbackNoteContent(questions[1:], nil)
+		require.Error(t, err)
+	})
+}
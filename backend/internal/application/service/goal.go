@@ -0,0 +1,699 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/date"
+	"github.com/twinj/uuid"
+)
+
+type goalApp struct {
+	dm        contract.DataManager
+	log       logger.Logger
+	authApp   contract.AuthApp
+	userApp   contract.UserApp
+	personApp *personApp
+}
+
+func newGoalApp(infra domain.Infrastructure, authApp contract.AuthApp, userApp contract.UserApp, personApp *personApp) contract.GoalApp {
+	return &goalApp{
+		dm:        infra.DataManager(),
+		log:       infra.Logger(),
+		authApp:   authApp,
+		userApp:   userApp,
+		personApp: personApp,
+	}
+}
+
+// getAuthorizedGoal loads a goal by UUID and checks that the logged user owns the goal's company
+func (s *goalApp) getAuthorizedGoal(ctx context.Context, goalUUID string) (entity.Goal, error) {
+	goal, err := s.dm.Goal().GetGoalByUUID(ctx, goalUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return goal, resterrors.NewNotFoundError("goal not found")
+		}
+		s.log.Errorw(ctx, "error getting goal by UUID", logger.Err(err))
+		return goal, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return goal, err
+	}
+
+	_, err = s.personApp.validateUserCompanyAccess(ctx, userID, goal.CompanyID)
+	if err != nil {
+		return goal, err
+	}
+
+	goal.KeyResults, err = s.dm.Goal().GetKeyResultsByGoal(ctx, goal.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting goal key results", logger.Err(err))
+		return goal, err
+	}
+
+	return goal, nil
+}
+
+// findKeyResult returns the key result of the goal with the given UUID
+func findKeyResult(goal entity.Goal, keyResultUUID string) (entity.GoalKeyResult, error) {
+	for _, keyResult := range goal.KeyResults {
+		if keyResult.UUID == keyResultUUID {
+			return keyResult, nil
+		}
+	}
+	return entity.GoalKeyResult{}, resterrors.NewNotFoundError("key result not found")
+}
+
+// normalizeGoal trims the texts and validates the type and the status, applying their defaults
+func normalizeGoal(goal *entity.Goal) error {
+	goal.Title = strings.TrimSpace(goal.Title)
+	if goal.Title == "" {
+		return resterrors.NewBadRequestError("title is required")
+	}
+	goal.Description = strings.TrimSpace(goal.Description)
+
+	switch goal.Type {
+	case domain.GoalTypeCareer, domain.GoalTypePerformance, domain.GoalTypeLearning:
+	default:
+		return resterrors.NewBadRequestError("type must be career, performance or learning")
+	}
+
+	if goal.Status == "" {
+		goal.Status = domain.GoalStatusActive
+	}
+	switch goal.Status {
+	case domain.GoalStatusActive, domain.GoalStatusAchieved, domain.GoalStatusAbandoned:
+	default:
+		return resterrors.NewBadRequestError("status must be active, achieved or abandoned")
+	}
+
+	if goal.TargetDate != nil {
+		targetDate := date.Day(*goal.TargetDate)
+		goal.TargetDate = &targetDate
+	}
+
+	return nil
+}
+
+// normalizeKeyResult trims the texts and validates that the key result has a range to progress on
+func normalizeKeyResult(keyResult *entity.GoalKeyResult) error {
+	keyResult.Title = strings.TrimSpace(keyResult.Title)
+	if keyResult.Title == "" {
+		return resterrors.NewBadRequestError("key result title is required")
+	}
+	keyResult.Unit = strings.TrimSpace(keyResult.Unit)
+
+	if keyResult.TargetValue == keyResult.StartValue {
+		return resterrors.NewBadRequestError("key result target_value must be different from start_value")
+	}
+
+	return nil
+}
+
+// goalProgress returns the progress of the goal: the average of its key results or, for goals
+// without key results, the progress reported manually
+func goalProgress(goal entity.Goal, reported int) int {
+	if progress, ok := goal.KeyResultsProgress(); ok {
+		return progress
+	}
+	return reported
+}
+
+// attachGoalDetails sets the key results and the check-ins of each goal, keeping the order of the lists
+func attachGoalDetails(goals []entity.Goal, keyResults []entity.GoalKeyResult, checkIns []entity.GoalCheckIn) []entity.Goal {
+	indexByID := make(map[int64]int, len(goals))
+	for i, goal := range goals {
+		indexByID[goal.ID] = i
+	}
+
+	for _, keyResult := range keyResults {
+		if i, ok := indexByID[keyResult.GoalID]; ok {
+			goals[i].KeyResults = append(goals[i].KeyResults, keyResult)
+		}
+	}
+
+	for _, checkIn := range checkIns {
+		if i, ok := indexByID[checkIn.GoalID]; ok {
+			goals[i].CheckIns = append(goals[i].CheckIns, checkIn)
+		}
+	}
+
+	return goals
+}
+
+// buildGoalTimeline lists when each goal was created and closed and its check-ins, most recent first
+func buildGoalTimeline(goals []entity.Goal) []entity.GoalTimelineEntry {
+	timeline := []entity.GoalTimelineEntry{}
+
+	for _, goal := range goals {
+		entry := entity.GoalTimelineEntry{
+			GoalUUID:  goal.UUID,
+			GoalTitle: goal.Title,
+			GoalType:  goal.Type,
+		}
+
+		created := entry
+		created.Type = domain.GoalTimelineGoalCreated
+		created.Date = goal.CreatedAt
+		timeline = append(timeline, created)
+
+		for i := range goal.CheckIns {
+			checkIn := entry
+			checkIn.Type = domain.GoalTimelineCheckIn
+			checkIn.Date = goal.CheckIns[i].Date
+			checkIn.CheckIn = &goal.CheckIns[i]
+			timeline = append(timeline, checkIn)
+		}
+
+		if goal.CompletedAt != nil && !goal.IsActive() {
+			closed := entry
+			closed.Type = domain.GoalTimelineGoalAchieved
+			if goal.Status == domain.GoalStatusAbandoned {
+				closed.Type = domain.GoalTimelineGoalAbandoned
+			}
+			closed.Date = *goal.CompletedAt
+			timeline = append(timeline, closed)
+		}
+	}
+
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].Date.After(timeline[j].Date)
+	})
+
+	return timeline
+}
+
+// getPersonGoalsWithDetails loads the goals of the person with their key results and check-ins
+func getPersonGoalsWithDetails(ctx context.Context, dm contract.DataManager, personID int64, status string) ([]entity.Goal, error) {
+	goals, err := dm.Goal().GetGoalsByPerson(ctx, personID, status)
+	if err != nil {
+		return nil, err
+	}
+	if len(goals) == 0 {
+		return []entity.Goal{}, nil
+	}
+
+	keyResults, err := dm.Goal().GetKeyResultsByPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+
+	checkIns, err := dm.Goal().GetCheckInsByPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachGoalDetails(goals, keyResults, checkIns), nil
+}
+
+func (s *goalApp) CreateGoal(ctx context.Context, personUUID string, goal entity.Goal) (entity.Goal, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return goal, err
+	}
+
+	goal.Status = domain.GoalStatusActive
+	if err := normalizeGoal(&goal); err != nil {
+		return goal, err
+	}
+
+	for i := range goal.KeyResults {
+		if err := normalizeKeyResult(&goal.KeyResults[i]); err != nil {
+			return goal, err
+		}
+	}
+
+	goal.UUID = uuid.NewV4().String()
+	goal.CompanyID = person.CompanyID
+	goal.PersonID = person.ID
+	goal.Progress = goalProgress(goal, 0)
+	goal.UserID, err = s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return goal, err
+	}
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		goal.ID, err = tx.Goal().CreateGoal(ctx, goal)
+		if err != nil {
+			return err
+		}
+
+		for _, keyResult := range goal.KeyResults {
+			keyResult.UUID = uuid.NewV4().String()
+			keyResult.GoalID = goal.ID
+
+			_, err = tx.Goal().CreateKeyResult(ctx, keyResult)
+			if err != nil {
+				return err
+			}
+		}
+
+		return recordGoalActivity(ctx, tx, goal, goal.UserID, domain.GoalTimelineGoalCreated)
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error creating goal", logger.Err(err))
+		return goal, err
+	}
+
+	s.log.Infow(ctx, "goal created successfully",
+		logger.String("goal_uuid", goal.UUID),
+		logger.String("person_uuid", personUUID),
+	)
+
+	return s.getAuthorizedGoal(ctx, goal.UUID)
+}
+
+func (s *goalApp) GetGoal(ctx context.Context, goalUUID string) (entity.Goal, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	goal, err := s.getAuthorizedGoal(ctx, goalUUID)
+	if err != nil {
+		return goal, err
+	}
+
+	goal.CheckIns, err = s.dm.Goal().GetCheckInsByGoal(ctx, goal.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting goal check-ins", logger.Err(err))
+		return goal, err
+	}
+
+	return goal, nil
+}
+
+func (s *goalApp) GetPersonGoals(ctx context.Context, personUUID, status string) ([]entity.Goal, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	goals, err := getPersonGoalsWithDetails(ctx, s.dm, person.ID, status)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting person goals", logger.Err(err))
+		return nil, err
+	}
+
+	return goals, nil
+}
+
+func (s *goalApp) GetPersonGoalTimeline(ctx context.Context, personUUID string) ([]entity.GoalTimelineEntry, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	goals, err := getPersonGoalsWithDetails(ctx, s.dm, person.ID, "")
+	if err != nil {
+		s.log.Errorw(ctx, "error getting person goals", logger.Err(err))
+		return nil, err
+	}
+
+	return buildGoalTimeline(goals), nil
+}
+
+func (s *goalApp) UpdateGoal(ctx context.Context, goalUUID string, goal entity.Goal) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	existing, err := s.getAuthorizedGoal(ctx, goalUUID)
+	if err != nil {
+		return err
+	}
+
+	if goal.Type == "" {
+		goal.Type = existing.Type
+	}
+	if goal.Status == "" {
+		goal.Status = existing.Status
+	}
+
+	if err := normalizeGoal(&goal); err != nil {
+		return err
+	}
+
+	// achieving or abandoning the goal goes to the activity feed
+	var event string
+	if goal.Status != existing.Status {
+		switch goal.Status {
+		case domain.GoalStatusAchieved:
+			event = domain.GoalTimelineGoalAchieved
+		case domain.GoalStatusAbandoned:
+			event = domain.GoalTimelineGoalAbandoned
+		}
+	}
+
+	switch {
+	case goal.Status != domain.GoalStatusActive && existing.IsActive():
+		completedAt := time.Now()
+		existing.CompletedAt = &completedAt
+	case goal.Status == domain.GoalStatusActive:
+		existing.CompletedAt = nil
+	}
+
+	existing.Title = goal.Title
+	existing.Description = goal.Description
+	existing.Type = goal.Type
+	existing.Status = goal.Status
+	existing.TargetDate = goal.TargetDate
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		err := tx.Goal().UpdateGoal(ctx, existing.ID, existing)
+		if err != nil || event == "" {
+			return err
+		}
+
+		return recordGoalActivity(ctx, tx, existing, userID, event)
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error updating goal", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *goalApp) DeleteGoal(ctx context.Context, goalUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	goal, err := s.getAuthorizedGoal(ctx, goalUUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.Goal().DeleteGoal(ctx, goal.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error deleting goal", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// refreshGoalProgress recalculates the progress of the goal after its key results changed
+func refreshGoalProgress(ctx context.Context, dm contract.DataManager, goal entity.Goal) error {
+	keyResults, err := dm.Goal().GetKeyResultsByGoal(ctx, goal.ID)
+	if err != nil {
+		return err
+	}
+	goal.KeyResults = keyResults
+
+	progress := goalProgress(goal, goal.Progress)
+	if progress == goal.Progress {
+		return nil
+	}
+
+	goal.Progress = progress
+	return dm.Goal().UpdateGoal(ctx, goal.ID, goal)
+}
+
+func (s *goalApp) AddKeyResult(ctx context.Context, goalUUID string, keyResult entity.GoalKeyResult) (entity.GoalKeyResult, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	goal, err := s.getAuthorizedGoal(ctx, goalUUID)
+	if err != nil {
+		return keyResult, err
+	}
+
+	if err := normalizeKeyResult(&keyResult); err != nil {
+		return keyResult, err
+	}
+
+	keyResult.UUID = uuid.NewV4().String()
+	keyResult.GoalID = goal.ID
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		_, err := tx.Goal().CreateKeyResult(ctx, keyResult)
+		if err != nil {
+			return err
+		}
+
+		return refreshGoalProgress(ctx, tx, goal)
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error creating key result", logger.Err(err))
+		return keyResult, err
+	}
+
+	goal, err = s.getAuthorizedGoal(ctx, goalUUID)
+	if err != nil {
+		return keyResult, err
+	}
+
+	return findKeyResult(goal, keyResult.UUID)
+}
+
+func (s *goalApp) UpdateKeyResult(ctx context.Context, goalUUID, keyResultUUID string, keyResult entity.GoalKeyResult) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	goal, err := s.getAuthorizedGoal(ctx, goalUUID)
+	if err != nil {
+		return err
+	}
+
+	existing, err := findKeyResult(goal, keyResultUUID)
+	if err != nil {
+		return err
+	}
+
+	if err := normalizeKeyResult(&keyResult); err != nil {
+		return err
+	}
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		err := tx.Goal().UpdateKeyResult(ctx, existing.ID, keyResult)
+		if err != nil {
+			return err
+		}
+
+		return refreshGoalProgress(ctx, tx, goal)
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error updating key result", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *goalApp) DeleteKeyResult(ctx context.Context, goalUUID, keyResultUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	goal, err := s.getAuthorizedGoal(ctx, goalUUID)
+	if err != nil {
+		return err
+	}
+
+	keyResult, err := findKeyResult(goal, keyResultUUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		err := tx.Goal().DeleteKeyResult(ctx, keyResult.ID)
+		if err != nil {
+			return err
+		}
+
+		return refreshGoalProgress(ctx, tx, goal)
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error deleting key result", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// applyCheckIn validates the check-in and applies the reported key result values to the goal.
+// The progress of the check-in is the resulting goal progress
+func applyCheckIn(goal *entity.Goal, checkIn *entity.GoalCheckIn, today time.Time) error {
+	if !goal.IsActive() {
+		return resterrors.NewBadRequestError("check-ins can only be added to active goals")
+	}
+
+	if checkIn.Date.IsZero() {
+		checkIn.Date = today
+	}
+	checkIn.Date = date.Day(checkIn.Date)
+	if checkIn.Date.After(today) {
+		return resterrors.NewBadRequestError("check-in date cannot be in the future")
+	}
+
+	if checkIn.Confidence == "" {
+		checkIn.Confidence = domain.GoalConfidenceOnTrack
+	}
+	switch checkIn.Confidence {
+	case domain.GoalConfidenceOnTrack, domain.GoalConfidenceAtRisk, domain.GoalConfidenceOffTrack:
+	default:
+		return resterrors.NewBadRequestError("confidence must be on_track, at_risk or off_track")
+	}
+	checkIn.Comment = strings.TrimSpace(checkIn.Comment)
+
+	for _, value := range checkIn.KeyResultValues {
+		found := false
+		for i := range goal.KeyResults {
+			if goal.KeyResults[i].UUID == value.KeyResultUUID {
+				goal.KeyResults[i].CurrentValue = value.CurrentValue
+				found = true
+				break
+			}
+		}
+		if !found {
+			return resterrors.NewNotFoundError("key result not found")
+		}
+	}
+
+	if len(goal.KeyResults) == 0 && (checkIn.Progress < 0 || checkIn.Progress > 100) {
+		return resterrors.NewBadRequestError("progress must be between 0 and 100")
+	}
+
+	goal.Progress = goalProgress(*goal, checkIn.Progress)
+	checkIn.Progress = goal.Progress
+
+	return nil
+}
+
+func (s *goalApp) CheckIn(ctx context.Context, goalUUID string, checkIn entity.GoalCheckIn) (entity.GoalCheckIn, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	goal, err := s.getAuthorizedGoal(ctx, goalUUID)
+	if err != nil {
+		return checkIn, err
+	}
+
+	preferences, err := s.userApp.GetUserPreferences(ctx)
+	if err != nil {
+		return checkIn, err
+	}
+
+	today := date.Today(time.Now(), preferences.Location())
+	if err := applyCheckIn(&goal, &checkIn, today); err != nil {
+		return checkIn, err
+	}
+
+	if checkIn.NoteUUID != nil && *checkIn.NoteUUID != "" {
+		note, err := s.dm.Note().GetNoteByUUID(ctx, *checkIn.NoteUUID)
+		if err != nil {
+			if mysqlutils.SQLNotFound(err.Error()) {
+				return checkIn, resterrors.NewNotFoundError("note not found")
+			}
+			s.log.Errorw(ctx, "error getting note by UUID", logger.Err(err))
+			return checkIn, err
+		}
+		if note.PersonID != goal.PersonID {
+			return checkIn, resterrors.NewBadRequestError("note does not belong to this person")
+		}
+		checkIn.NoteID = &note.ID
+	}
+
+	checkIn.UUID = uuid.NewV4().String()
+	checkIn.GoalID = goal.ID
+	checkIn.UserID, err = s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return checkIn, err
+	}
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		for _, keyResult := range goal.KeyResults {
+			err := tx.Goal().UpdateKeyResult(ctx, keyResult.ID, keyResult)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := tx.Goal().UpdateGoal(ctx, goal.ID, goal)
+		if err != nil {
+			return err
+		}
+
+		checkIn.ID, err = tx.Goal().CreateCheckIn(ctx, checkIn)
+		if err != nil {
+			return err
+		}
+
+		return recordGoalActivity(ctx, tx, goal, checkIn.UserID, domain.GoalTimelineCheckIn)
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error creating goal check-in", logger.Err(err))
+		return checkIn, err
+	}
+
+	s.log.Infow(ctx, "goal check-in created successfully",
+		logger.String("goal_uuid", goalUUID),
+		logger.String("check_in_uuid", checkIn.UUID),
+	)
+
+	checkIns, err := s.dm.Goal().GetCheckInsByGoal(ctx, goal.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting goal check-ins", logger.Err(err))
+		return checkIn, err
+	}
+
+	for _, created := range checkIns {
+		if created.UUID == checkIn.UUID {
+			return created, nil
+		}
+	}
+
+	return checkIn, nil
+}
+
+func (s *goalApp) DeleteCheckIn(ctx context.Context, goalUUID, checkInUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	goal, err := s.getAuthorizedGoal(ctx, goalUUID)
+	if err != nil {
+		return err
+	}
+
+	checkIns, err := s.dm.Goal().GetCheckInsByGoal(ctx, goal.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting goal check-ins", logger.Err(err))
+		return err
+	}
+
+	for _, checkIn := range checkIns {
+		if checkIn.UUID != checkInUUID {
+			continue
+		}
+
+		err = s.dm.Goal().DeleteCheckIn(ctx, checkIn.ID)
+		if err != nil {
+			s.log.Errorw(ctx, "error deleting goal check-in", logger.Err(err))
+			return err
+		}
+		return nil
+	}
+
+	return resterrors.NewNotFoundError("check-in not found")
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_normalizeGoal(t *testing.T) {
+	t.Run("Should apply the defaults and drop the time of the target date", func(t *testing.T) {
+		targetDate := time.Date(2025, time.December, 31, 18, 30, 0, 0, time.UTC)
+		goal := entity.Goal{Title: "  Become tech lead  ", Type: domain.GoalTypeCareer, TargetDate: &targetDate}
+
+		err := normalizeGoal(&goal)
+		require.NoError(t, err)
+		require.Equal(t, "Become tech lead", goal.Title)
+		require.Equal(t, domain.GoalStatusActive, goal.Status)
+		require.Equal(t, *datePointer(2025, time.December, 31), *goal.TargetDate)
+	})
+
+	t.Run("Should return error when the title is empty", func(t *testing.T) {
+		goal := entity.Goal{Title: "  ", Type: domain.GoalTypeCareer}
+		require.Error(t, normalizeGoal(&goal))
+	})
+
+	t.Run("Should return error when the type is invalid", func(t *testing.T) {
+		goal := entity.Goal{Title: "Learn Go", Type: "hobby"}
+		require.Error(t, normalizeGoal(&goal))
+	})
+
+	t.Run("Should return error when the status is invalid", func(t *testing.T) {
+		goal := entity.Goal{Title: "Learn Go", Type: domain.GoalTypeLearning, Status: "paused"}
+		require.Error(t, normalizeGoal(&goal))
+	})
+}
+
+func Test_KeyResultProgress(t *testing.T) {
+	tests := []struct {
+		name      string
+		keyResult entity.GoalKeyResult
+		expected  int
+	}{
+		{
+			name:      "halfway to the target",
+			keyResult: entity.GoalKeyResult{StartValue: 0, TargetValue: 4, CurrentValue: 2},
+			expected:  50,
+		},
+		{
+			name:      "decreasing target",
+			keyResult: entity.GoalKeyResult{StartValue: 10, TargetValue: 2, CurrentValue: 4},
+			expected:  75,
+		},
+		{
+			name:      "beyond the target",
+			keyResult: entity.GoalKeyResult{StartValue: 0, TargetValue: 3, CurrentValue: 5},
+			expected:  100,
+		},
+		{
+			name:      "behind the start",
+			keyResult: entity.GoalKeyResult{StartValue: 20, TargetValue: 80, CurrentValue: 10},
+			expected:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.keyResult.Progress())
+		})
+	}
+}
+
+func Test_goalProgress(t *testing.T) {
+	t.Run("Should average the key results", func(t *testing.T) {
+		goal := entity.Goal{KeyResults: []entity.GoalKeyResult{
+			{StartValue: 0, TargetValue: 2, CurrentValue: 1},
+			{StartValue: 0, TargetValue: 3, CurrentValue: 3},
+		}}
+		require.Equal(t, 75, goalProgress(goal, 10))
+	})
+
+	t.Run("Should keep the reported progress of goals without key results", func(t *testing.T) {
+		require.Equal(t, 10, goalProgress(entity.Goal{}, 10))
+	})
+}
+
+func Test_applyCheckIn(t *testing.T) {
+	today := time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	newGoal := func() entity.Goal {
+		return entity.Goal{
+			Status: domain.GoalStatusActive,
+			KeyResults: []entity.GoalKeyResult{
+				{UUID: "kr-1", StartValue: 0, TargetValue: 4, CurrentValue: 0},
+				{UUID: "kr-2", StartValue: 0, TargetValue: 2, CurrentValue: 1},
+			},
+		}
+	}
+
+	t.Run("Should apply the key result values and derive the progress", func(t *testing.T) {
+		goal := newGoal()
+		checkIn := entity.GoalCheckIn{
+			Progress:        5,
+			KeyResultValues: []entity.GoalKeyResultValue{{KeyResultUUID: "kr-1", CurrentValue: 2}},
+		}
+
+		err := applyCheckIn(&goal, &checkIn, today)
+		require.NoError(t, err)
+		require.Equal(t, float64(2), goal.KeyResults[0].CurrentValue)
+		require.Equal(t, 50, goal.Progress)
+		require.Equal(t, 50, checkIn.Progress)
+		require.Equal(t, today, checkIn.Date)
+		require.Equal(t, domain.GoalConfidenceOnTrack, checkIn.Confidence)
+	})
+
+	t.Run("Should use the reported progress of goals without key results", func(t *testing.T) {
+		goal := entity.Goal{Status: domain.GoalStatusActive}
+		checkIn := entity.GoalCheckIn{Progress: 30, Confidence: domain.GoalConfidenceAtRisk, Date: time.Date(2025, time.March, 7, 15, 0, 0, 0, time.UTC)}
+
+		err := applyCheckIn(&goal, &checkIn, today)
+		require.NoError(t, err)
+		require.Equal(t, 30, goal.Progress)
+		require.Equal(t, *datePointer(2025, time.March, 7), checkIn.Date)
+	})
+
+	t.Run("Should return error when the goal is closed", func(t *testing.T) {
+		goal := newGoal()
+		goal.Status = domain.GoalStatusAchieved
+		require.Error(t, applyCheckIn(&goal, &entity.GoalCheckIn{}, today))
+	})
+
+	t.Run("Should return error when the date is in the future", func(t *testing.T) {
+		goal := newGoal()
+		require.Error(t, applyCheckIn(&goal, &entity.GoalCheckIn{Date: today.AddDate(0, 0, 1)}, today))
+	})
+
+	t.Run("Should return error when the key result is from another goal", func(t *testing.T) {
+		goal := newGoal()
+		checkIn := entity.GoalCheckIn{KeyResultValues: []entity.GoalKeyResultValue{{KeyResultUUID: "other", CurrentValue: 1}}}
+		require.Error(t, applyCheckIn(&goal, &checkIn, today))
+	})
+
+	t.Run("Should return error when the reported progress is out of range", func(t *testing.T) {
+		goal := entity.Goal{Status: domain.GoalStatusActive}
+		require.Error(t, applyCheckIn(&goal, &entity.GoalCheckIn{Progress: 120}, today))
+	})
+}
+
+func Test_buildGoalTimeline(t *testing.T) {
+	completedAt := time.Date(2025, time.March, 1, 10, 0, 0, 0, time.UTC)
+
+	goals := attachGoalDetails(
+		[]entity.Goal{
+			{ID: 1, UUID: "goal-1", Title: "Become tech lead", Type: domain.GoalTypeCareer, Status: domain.GoalStatusActive, CreatedAt: time.Date(2025, time.January, 10, 9, 0, 0, 0, time.UTC)},
+			{ID: 2, UUID: "goal-2", Title: "Learn Go", Type: domain.GoalTypeLearning, Status: domain.GoalStatusAbandoned, CreatedAt: time.Date(2025, time.January, 5, 9, 0, 0, 0, time.UTC), CompletedAt: &completedAt},
+		},
+		[]entity.GoalKeyResult{{GoalID: 1, UUID: "kr-1"}},
+		[]entity.GoalCheckIn{
+			{GoalID: 1, UUID: "check-in-2", Date: time.Date(2025, time.March, 5, 0, 0, 0, 0, time.UTC)},
+			{GoalID: 1, UUID: "check-in-1", Date: time.Date(2025, time.February, 5, 0, 0, 0, 0, time.UTC)},
+		},
+	)
+	require.Len(t, goals[0].KeyResults, 1)
+	require.Len(t, goals[0].CheckIns, 2)
+	require.Empty(t, goals[1].CheckIns)
+
+	timeline := buildGoalTimeline(goals)
+	require.Len(t, timeline, 5)
+
+	require.Equal(t, domain.GoalTimelineCheckIn, timeline[0].Type)
+	require.Equal(t, "check-in-2", timeline[0].CheckIn.UUID)
+	require.Equal(t, domain.GoalTimelineGoalAbandoned, timeline[1].Type)
+	require.Equal(t, "goal-2", timeline[1].GoalUUID)
+	require.Equal(t, "check-in-1", timeline[2].CheckIn.UUID)
+	require.Equal(t, domain.GoalTimelineGoalCreated, timeline[3].Type)
+	require.Equal(t, "goal-1", timeline[3].GoalUUID)
+	require.Equal(t, "goal-2", timeline[4].GoalUUID)
+}
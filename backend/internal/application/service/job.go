@@ -0,0 +1,449 @@
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/backoff"
+	"github.com/diegoclair/leaderpro/util/cron"
+	"github.com/diegoclair/leaderpro/util/date"
+	"github.com/twinj/uuid"
+)
+
+// jobHandler runs a job, an error means the job is retried
+type jobHandler func(ctx context.Context, job entity.Job) error
+
+type jobApp struct {
+	dm         contract.DataManager
+	log        logger.Logger
+	queue      contract.JobQueue
+	blobs      contract.BlobStorage
+	aiApp      contract.AIApp
+	personApp  *personApp
+	adminToken string
+	handlers   map[string]jobHandler
+}
+
+func newJobApp(infra domain.Infrastructure, aiApp contract.AIApp, personApp *personApp, adminToken string) *jobApp {
+	s := &jobApp{
+		dm:         infra.DataManager(),
+		log:        infra.Logger(),
+		queue:      infra.JobQueue(),
+		blobs:      infra.BlobStorage(),
+		aiApp:      aiApp,
+		personApp:  personApp,
+		adminToken: adminToken,
+		handlers:   make(map[string]jobHandler),
+	}
+
+	s.handlers[domain.JobTypeConversationCleanup] = s.cleanupConversations
+	s.handlers[domain.JobTypeAttachmentCleanup] = s.cleanupAttachments
+	s.handlers[domain.JobTypePeopleImport] = s.importPeople
+	s.handlers[domain.JobTypeReminders] = s.recordReminders
+	if aiApp != nil {
+		s.handlers[domain.JobTypeNoteAttributesExtraction] = s.extractNoteAttributes
+	}
+
+	return s
+}
+
+// jobQueues returns the queues run by the workers of each instance
+func jobQueues() []entity.JobQueueConfig {
+	return []entity.JobQueueConfig{
+		{Name: domain.JobQueueDefault, Concurrency: domain.JobDefaultConcurrency},
+		{Name: domain.JobQueueAI, Concurrency: domain.JobAIConcurrency},
+	}
+}
+
+// jobSchedules returns the jobs enqueued periodically, the times are in the server location
+func jobSchedules() []entity.JobSchedule {
+	return []entity.JobSchedule{
+		{Name: "ai-conversation-cleanup", Cron: "0 2 * * *", Queue: domain.JobQueueDefault, JobType: domain.JobTypeConversationCleanup},
+		{Name: "note-attachment-cleanup", Cron: "30 2 * * *", Queue: domain.JobQueueDefault, JobType: domain.JobTypeAttachmentCleanup},
+		{Name: "person-reminders", Cron: "0 6 * * *", Queue: domain.JobQueueDefault, JobType: domain.JobTypeReminders},
+	}
+}
+
+// newJob returns a pending job with the payload encoded as JSON, due at runAt
+func newJob(queue, jobType string, payload any, runAt time.Time) (entity.Job, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return entity.Job{}, fmt.Errorf("error encoding the payload of the %s job: %w", jobType, err)
+	}
+
+	return entity.Job{
+		UUID:        uuid.NewV4().String(),
+		Queue:       queue,
+		Type:        jobType,
+		Payload:     string(data),
+		Status:      domain.JobStatusPending,
+		MaxAttempts: domain.JobMaxAttempts,
+		RunAt:       runAt,
+	}, nil
+}
+
+// scheduledJobUUID returns the same UUID for a schedule and time in every instance,
+// so a scheduled job is enqueued only once
+func scheduledJobUUID(scheduleName string, at time.Time) string {
+	return uuid.NewV5(uuid.NameSpaceURL, "schedule:"+scheduleName+":"+at.UTC().Format(time.RFC3339)).String()
+}
+
+// jobRetryDelay returns how long to wait before retrying a job after its failed attempt
+func jobRetryDelay(attempts int) time.Duration {
+	return backoff.Exponential(attempts, domain.JobRetryBaseDelaySecs*time.Second, domain.JobRetryMaxDelaySecs*time.Second)
+}
+
+func (s *jobApp) GetQueues() []entity.JobQueueConfig {
+	return jobQueues()
+}
+
+func (s *jobApp) GetSchedules() []entity.JobSchedule {
+	return jobSchedules()
+}
+
+func (s *jobApp) EnqueueJob(ctx context.Context, job entity.Job) error {
+	err := s.queue.Enqueue(ctx, job)
+	if err != nil {
+		s.log.Errorw(ctx, "error enqueueing job", logger.Err(err), logger.String("job_type", job.Type))
+		return err
+	}
+
+	return nil
+}
+
+func (s *jobApp) EnqueueScheduledJob(ctx context.Context, schedule entity.JobSchedule, at time.Time) error {
+	job, err := newJob(schedule.Queue, schedule.JobType, struct{}{}, at)
+	if err != nil {
+		return err
+	}
+	job.UUID = scheduledJobUUID(schedule.Name, at)
+
+	return s.EnqueueJob(ctx, job)
+}
+
+func (s *jobApp) DequeueJobs(ctx context.Context, queue string, limit int) ([]entity.Job, error) {
+	now := time.Now()
+
+	jobs, err := s.queue.Dequeue(ctx, queue, now, now.Add(domain.JobLeaseSecs*time.Second), limit)
+	if err != nil {
+		s.log.Errorw(ctx, "error dequeueing jobs", logger.Err(err), logger.String("queue", queue))
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+func (s *jobApp) RunJob(ctx context.Context, job entity.Job) error {
+	runErr := fmt.Errorf("no handler for job type %s", job.Type)
+	if handler, ok := s.handlers[job.Type]; ok {
+		// A job running longer than its lease would run again in another worker
+		handlerCtx, cancel := context.WithTimeout(ctx, domain.JobLeaseSecs*time.Second)
+		runErr = handler(handlerCtx, job)
+		cancel()
+	}
+
+	var err error
+	switch {
+	case runErr == nil:
+		err = s.queue.Complete(ctx, job)
+
+	case job.Attempts < job.MaxAttempts:
+		s.log.Warnw(ctx, "job failed, it will be retried",
+			logger.Err(runErr),
+			logger.String("job_uuid", job.UUID),
+			logger.String("job_type", job.Type),
+			logger.Int("attempts", job.Attempts),
+		)
+		err = s.queue.Retry(ctx, job, runErr.Error(), time.Now().Add(jobRetryDelay(job.Attempts)))
+
+	default:
+		s.log.Errorw(ctx, "job failed with no attempts left, moving it to the dead letter queue",
+			logger.Err(runErr),
+			logger.String("job_uuid", job.UUID),
+			logger.String("job_type", job.Type),
+			logger.Int("attempts", job.Attempts),
+		)
+		err = s.queue.DeadLetter(ctx, job, runErr.Error(), time.Now())
+	}
+
+	if err != nil {
+		if errors.Is(err, domain.ErrJobNotFound) {
+			// the lease expired and another worker claimed the job
+			s.log.Warnw(ctx, "job lease lost", logger.String("job_uuid", job.UUID))
+			return nil
+		}
+		s.log.Errorw(ctx, "error finishing job", logger.Err(err), logger.String("job_uuid", job.UUID))
+		return err
+	}
+
+	return nil
+}
+
+func (s *jobApp) ValidateAdminToken(ctx context.Context, token string) error {
+	if s.adminToken == "" {
+		return resterrors.NewUnauthorizedError("admin endpoints are disabled")
+	}
+
+	if token == "" {
+		return resterrors.NewUnauthorizedError("admin token is required")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(s.adminToken), []byte(token)) != 1 {
+		s.log.Warn(ctx, "invalid admin token")
+		return resterrors.NewUnauthorizedError("invalid admin token")
+	}
+
+	return nil
+}
+
+func (s *jobApp) GetJobQueueState(ctx context.Context) (entity.JobQueueState, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	now := time.Now()
+	state := entity.JobQueueState{}
+
+	for _, queue := range jobQueues() {
+		stats, err := s.queue.GetStats(ctx, queue.Name, now)
+		if err != nil {
+			s.log.Errorw(ctx, "error getting job queue stats", logger.Err(err), logger.String("queue", queue.Name))
+			return state, err
+		}
+		stats.Concurrency = queue.Concurrency
+		state.Queues = append(state.Queues, stats)
+	}
+
+	for _, schedule := range jobSchedules() {
+		parsed, err := cron.Parse(schedule.Cron)
+		if err != nil {
+			return state, err
+		}
+		state.Schedules = append(state.Schedules, entity.JobScheduleState{JobSchedule: schedule, NextRunAt: parsed.Next(now)})
+	}
+
+	return state, nil
+}
+
+func (s *jobApp) GetDeadJobs(ctx context.Context, queue string) ([]entity.Job, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	if !isJobQueue(queue) {
+		return nil, resterrors.NewNotFoundError("job queue not found")
+	}
+
+	jobs, err := s.queue.GetDeadJobs(ctx, queue, domain.JobDeadListLimit)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting dead jobs", logger.Err(err))
+		return nil, err
+	}
+
+	return jobs, nil
+}
+
+func (s *jobApp) RequeueDeadJob(ctx context.Context, jobUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	err := s.queue.Requeue(ctx, jobUUID, time.Now())
+	if err != nil {
+		if errors.Is(err, domain.ErrJobNotFound) {
+			return resterrors.NewNotFoundError("dead job not found")
+		}
+		s.log.Errorw(ctx, "error requeueing dead job", logger.Err(err))
+		return err
+	}
+
+	s.log.Infow(ctx, "dead job requeued", logger.String("job_uuid", jobUUID))
+	return nil
+}
+
+func isJobQueue(name string) bool {
+	for _, queue := range jobQueues() {
+		if queue.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// extractNoteAttributes extracts the attributes of the person of a note with AI, on behalf of the author of the note
+func (s *jobApp) extractNoteAttributes(ctx context.Context, job entity.Job) error {
+	var payload entity.NoteAttributesExtractionPayload
+	err := json.Unmarshal([]byte(job.Payload), &payload)
+	if err != nil {
+		return fmt.Errorf("invalid payload: %w", err)
+	}
+
+	if payload.UserUUID != "" {
+		ctx = context.WithValue(ctx, infra.UserUUIDKey, payload.UserUUID)
+	}
+
+	_, err = s.aiApp.ExtractAttributesFromNote(ctx, payload.NoteID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			// the note was deleted before the extraction, there is nothing left to do
+			s.log.Infow(ctx, "note of the job not found, skipping", logger.Int64("note_id", payload.NoteID))
+			return nil
+		}
+		return err
+	}
+
+	s.log.Infow(ctx, "attributes extracted successfully from note", logger.Int64("note_id", payload.NoteID))
+	return nil
+}
+
+// importPeople creates the people of a pending import
+func (s *jobApp) importPeople(ctx context.Context, job entity.Job) error {
+	var payload entity.PersonImportPayload
+	err := json.Unmarshal([]byte(job.Payload), &payload)
+	if err != nil {
+		return fmt.Errorf("invalid payload: %w", err)
+	}
+
+	err = s.personApp.processPersonImport(ctx, payload)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			// the company was deleted with its imports, there is nothing left to do
+			s.log.Infow(ctx, "import of the job not found, skipping", logger.String("import_uuid", payload.ImportUUID))
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// recordReminders adds the birthdays and work anniversaries of today to the activity feed of each company, in batches
+func (s *jobApp) recordReminders(ctx context.Context, job entity.Job) error {
+	var afterID int64
+	var total int
+	for {
+		companies, err := s.dm.Company().GetCompaniesAfterID(ctx, afterID, domain.ReminderCompanyBatch)
+		if err != nil {
+			return err
+		}
+
+		for _, company := range companies {
+			recorded, err := s.recordCompanyReminders(ctx, company)
+			if err != nil {
+				return fmt.Errorf("error recording the reminders of company %s: %w", company.UUID, err)
+			}
+			total += recorded
+			afterID = company.ID
+		}
+
+		if len(companies) < domain.ReminderCompanyBatch {
+			break
+		}
+	}
+
+	s.log.Infow(ctx, "reminders recorded", logger.Int("recorded", total))
+	return nil
+}
+
+// recordCompanyReminders records the reminders of today in the timezone of the company owner, returning how many
+// were recorded. The reminders already recorded by a previous run of the same day are skipped
+func (s *jobApp) recordCompanyReminders(ctx context.Context, company entity.Company) (int, error) {
+	preferences, err := s.dm.User().GetUserPreferences(ctx, company.UserOwnerID)
+	if err != nil && !mysqlutils.SQLNotFound(err.Error()) {
+		return 0, err
+	}
+
+	people, err := s.dm.Person().GetPersonsByCompany(ctx, company.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	reminders := buildPersonReminders(people, date.Today(time.Now(), preferences.Location()), 0)
+	if len(reminders) == 0 {
+		return 0, nil
+	}
+
+	var recorded int
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		for _, reminder := range reminders {
+			activity := reminderActivity(company.ID, reminder)
+
+			_, err := tx.Activity().GetActivityByUUID(ctx, activity.UUID)
+			if err == nil {
+				continue
+			}
+			if !mysqlutils.SQLNotFound(err.Error()) {
+				return err
+			}
+
+			err = recordActivity(ctx, tx, activity)
+			if err != nil {
+				return err
+			}
+			recorded++
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return recorded, nil
+}
+
+// cleanupConversations deletes the expired AI conversations in batches
+func (s *jobApp) cleanupConversations(ctx context.Context, job entity.Job) error {
+	var total int64
+	for {
+		deleted, err := s.dm.AI().DeleteExpiredConversations(ctx, time.Now(), domain.ConversationCleanupBatch)
+		if err != nil {
+			return err
+		}
+
+		total += deleted
+		if deleted < domain.ConversationCleanupBatch {
+			break
+		}
+	}
+
+	s.log.Infow(ctx, "expired AI conversations deleted", logger.Int64("deleted", total))
+	return nil
+}
+
+// cleanupAttachments removes the content of the attachments deleted by the users and of the notes
+// that can no longer be restored, in batches
+func (s *jobApp) cleanupAttachments(ctx context.Context, job entity.Job) error {
+	noteDeletedBefore := time.Now().Add(-domain.NoteUndeleteRetentionDays * 24 * time.Hour)
+
+	var total int
+	for {
+		attachments, err := s.dm.Note().GetExpiredNoteAttachments(ctx, noteDeletedBefore, domain.NoteAttachmentCleanupBatch)
+		if err != nil {
+			return err
+		}
+
+		for _, attachment := range attachments {
+			// the next batch would return the same attachment, so the job is retried later instead
+			err = purgeNoteAttachment(ctx, s.dm, s.blobs, attachment)
+			if err != nil {
+				return fmt.Errorf("error removing attachment %s: %w", attachment.UUID, err)
+			}
+			total++
+		}
+
+		if len(attachments) < domain.NoteAttachmentCleanupBatch {
+			break
+		}
+	}
+
+	s.log.Infow(ctx, "expired note attachments removed", logger.Int("removed", total))
+	return nil
+}
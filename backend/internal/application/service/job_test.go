@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/mocks"
+	"github.com/diegoclair/leaderpro/util/cron"
+	"github.com/diegoclair/leaderpro/util/date"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_jobSchedules(t *testing.T) {
+	for _, schedule := range jobSchedules() {
+		_, err := cron.Parse(schedule.Cron)
+		require.NoError(t, err, schedule.Name)
+		require.True(t, isJobQueue(schedule.Queue), schedule.Name)
+	}
+}
+
+func Test_scheduledJobUUID(t *testing.T) {
+	at := time.Date(2025, time.March, 4, 2, 0, 0, 0, time.UTC)
+
+	require.Equal(t, scheduledJobUUID("cleanup", at), scheduledJobUUID("cleanup", at.In(time.FixedZone("BRT", -3*60*60))))
+	require.NotEqual(t, scheduledJobUUID("cleanup", at), scheduledJobUUID("cleanup", at.Add(24*time.Hour)))
+	require.NotEqual(t, scheduledJobUUID("cleanup", at), scheduledJobUUID("other", at))
+}
+
+func Test_jobRetryDelay(t *testing.T) {
+	base := domain.JobRetryBaseDelaySecs * time.Second
+
+	require.Equal(t, base, jobRetryDelay(1))
+	require.Equal(t, 2*base, jobRetryDelay(2))
+	require.Equal(t, domain.JobRetryMaxDelaySecs*time.Second, jobRetryDelay(50))
+}
+
+func TestJobApp_ValidateAdminToken(t *testing.T) {
+	m, ctrl := newServiceTestMock(t)
+	defer ctrl.Finish()
+
+	s := newJobApp(m.mockDomain, nil, nil, "admin-token")
+	require.NoError(t, s.ValidateAdminToken(context.Background(), "admin-token"))
+
+	for _, token := range []string{"", "wrong-token"} {
+		err := s.ValidateAdminToken(context.Background(), token)
+		require.Error(t, err)
+		require.Equal(t, http.StatusUnauthorized, err.(resterrors.RestErr).StatusCode())
+	}
+
+	disabled := newJobApp(m.mockDomain, nil, nil, "")
+	require.Error(t, disabled.ValidateAdminToken(context.Background(), ""))
+}
+
+func TestJobApp_RunJob(t *testing.T) {
+	newTestJob := func(t *testing.T, attempts int) entity.Job {
+		job, err := newJob(domain.JobQueueDefault, "test.job", struct{}{}, time.Now())
+		require.NoError(t, err)
+		job.Status = domain.JobStatusRunning
+		job.Attempts = attempts
+		return job
+	}
+
+	tests := []struct {
+		name       string
+		attempts   int
+		handlerErr error
+		buildMocks func(m allMocks, job entity.Job)
+		wantErr    bool
+	}{
+		{
+			name: "Should complete the job when the handler succeeds",
+			buildMocks: func(m allMocks, job entity.Job) {
+				m.mockJobQueue.EXPECT().Complete(gomock.Any(), job).Return(nil).Times(1)
+			},
+		},
+		{
+			name:       "Should retry the job with backoff when the handler fails",
+			attempts:   2,
+			handlerErr: fmt.Errorf("provider unavailable"),
+			buildMocks: func(m allMocks, job entity.Job) {
+				m.mockJobQueue.EXPECT().Retry(gomock.Any(), job, "provider unavailable", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ entity.Job, _ string, runAt time.Time) error {
+						require.WithinDuration(t, time.Now().Add(jobRetryDelay(2)), runAt, time.Second)
+						return nil
+					}).Times(1)
+			},
+		},
+		{
+			name:       "Should dead-letter the job when it has no attempts left",
+			attempts:   domain.JobMaxAttempts,
+			handlerErr: fmt.Errorf("provider unavailable"),
+			buildMocks: func(m allMocks, job entity.Job) {
+				m.mockJobQueue.EXPECT().DeadLetter(gomock.Any(), job, "provider unavailable", gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Should ignore the job when its lease was lost",
+			buildMocks: func(m allMocks, job entity.Job) {
+				m.mockJobQueue.EXPECT().Complete(gomock.Any(), job).Return(domain.ErrJobNotFound).Times(1)
+			},
+		},
+		{
+			name: "Should return error when the queue fails",
+			buildMocks: func(m allMocks, job entity.Job) {
+				m.mockJobQueue.EXPECT().Complete(gomock.Any(), job).Return(errors.New("connection refused")).Times(1)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, ctrl := newServiceTestMock(t)
+			defer ctrl.Finish()
+
+			s := newJobApp(m.mockDomain, nil, nil, "")
+			s.handlers["test.job"] = func(ctx context.Context, job entity.Job) error {
+				return tt.handlerErr
+			}
+
+			job := newTestJob(t, tt.attempts)
+			tt.buildMocks(m, job)
+
+			err := s.RunJob(context.Background(), job)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	t.Run("Should retry the job when there is no handler for its type", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		s := newJobApp(m.mockDomain, nil, nil, "")
+		job := newTestJob(t, 1)
+		job.Type = "unknown.job"
+
+		m.mockJobQueue.EXPECT().Retry(gomock.Any(), job, "no handler for job type unknown.job", gomock.Any()).Return(nil).Times(1)
+		require.NoError(t, s.RunJob(context.Background(), job))
+	})
+}
+
+func TestJobApp_RequeueDeadJob(t *testing.T) {
+	m, ctrl := newServiceTestMock(t)
+	defer ctrl.Finish()
+
+	s := newJobApp(m.mockDomain, nil, nil, "")
+
+	m.mockJobQueue.EXPECT().Requeue(gomock.Any(), "job-uuid", gomock.Any()).Return(domain.ErrJobNotFound).Times(1)
+	err := s.RequeueDeadJob(context.Background(), "job-uuid")
+	require.Error(t, err)
+	require.Equal(t, http.StatusNotFound, err.(resterrors.RestErr).StatusCode())
+
+	m.mockJobQueue.EXPECT().Requeue(gomock.Any(), "job-uuid", gomock.Any()).Return(nil).Times(1)
+	require.NoError(t, s.RequeueDeadJob(context.Background(), "job-uuid"))
+}
+
+func TestJobApp_GetDeadJobs(t *testing.T) {
+	m, ctrl := newServiceTestMock(t)
+	defer ctrl.Finish()
+
+	s := newJobApp(m.mockDomain, nil, nil, "")
+
+	_, err := s.GetDeadJobs(context.Background(), "unknown")
+	require.Error(t, err)
+	require.Equal(t, http.StatusNotFound, err.(resterrors.RestErr).StatusCode())
+
+	m.mockJobQueue.EXPECT().GetDeadJobs(gomock.Any(), domain.JobQueueAI, domain.JobDeadListLimit).Return([]entity.Job{{UUID: "job-uuid"}}, nil).Times(1)
+	jobs, err := s.GetDeadJobs(context.Background(), domain.JobQueueAI)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+}
+
+func TestJobApp_recordReminders(t *testing.T) {
+	ctx := context.Background()
+	company := entity.Company{ID: 1, UUID: "company-uuid", UserOwnerID: 10}
+	birthday := date.Today(time.Now(), time.UTC).AddDate(-30, 0, 0)
+	nextWeek := date.Today(time.Now(), time.UTC).AddDate(-2, 0, 7)
+	people := []entity.Person{
+		{ID: 1, UUID: "person-1", Name: "Ana", Birthday: &birthday},
+		{ID: 2, UUID: "person-2", Name: "Bruno", Birthday: &birthday},
+		{ID: 3, UUID: "person-3", Name: "Carla", StartDate: &nextWeek},
+	}
+
+	type repos struct {
+		company  *mocks.MockCompanyRepo
+		person   *mocks.MockPersonRepo
+		activity *mocks.MockActivityRepo
+	}
+
+	newRepos := func(m allMocks, ctrl *gomock.Controller) repos {
+		r := repos{
+			company:  mocks.NewMockCompanyRepo(ctrl),
+			person:   mocks.NewMockPersonRepo(ctrl),
+			activity: mocks.NewMockActivityRepo(ctrl),
+		}
+		m.mockDataManager.EXPECT().Company().Return(r.company).AnyTimes()
+		m.mockDataManager.EXPECT().Person().Return(r.person).AnyTimes()
+		m.mockDataManager.EXPECT().Activity().Return(r.activity).AnyTimes()
+		m.mockDataManager.EXPECT().WithTransaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(contract.DataManager) error) error {
+			return fn(m.mockDataManager)
+		}).AnyTimes()
+		return r
+	}
+
+	t.Run("Should record the reminders of today once", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+		r := newRepos(m, ctrl)
+
+		recorded := reminderActivity(company.ID, buildPersonReminders(people[:1], date.Today(time.Now(), time.UTC), 0)[0])
+
+		r.company.EXPECT().GetCompaniesAfterID(ctx, int64(0), domain.ReminderCompanyBatch).Return([]entity.Company{company}, nil).Times(1)
+		m.mockUserRepo.EXPECT().GetUserPreferences(ctx, company.UserOwnerID).Return(entity.UserPreferences{}, nil).Times(1)
+		r.person.EXPECT().GetPersonsByCompany(ctx, company.ID).Return(people, nil).Times(1)
+		r.activity.EXPECT().GetActivityByUUID(ctx, recorded.UUID).Return(recorded, nil).Times(1)
+		r.activity.EXPECT().GetActivityByUUID(ctx, gomock.Not(recorded.UUID)).Return(entity.Activity{}, errors.New("no rows in result set")).Times(1)
+		r.activity.EXPECT().CreateActivity(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, activity entity.Activity) (int64, error) {
+			require.Equal(t, int64(2), activity.PersonID)
+			require.Equal(t, domain.ActivityTypeReminder, activity.Type)
+			require.Equal(t, domain.ReminderTypeBirthday, activity.Detail)
+			return 1, nil
+		}).Times(1)
+
+		s := newJobApp(m.mockDomain, nil, nil, "")
+		require.NoError(t, s.recordReminders(ctx, entity.Job{}))
+	})
+
+	t.Run("Should go through the companies in batches", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+		r := newRepos(m, ctrl)
+
+		batch := make([]entity.Company, domain.ReminderCompanyBatch)
+		for i := range batch {
+			batch[i] = entity.Company{ID: int64(i + 1)}
+		}
+
+		gomock.InOrder(
+			r.company.EXPECT().GetCompaniesAfterID(ctx, int64(0), domain.ReminderCompanyBatch).Return(batch, nil),
+			r.company.EXPECT().GetCompaniesAfterID(ctx, int64(domain.ReminderCompanyBatch), domain.ReminderCompanyBatch).Return(nil, nil),
+		)
+		m.mockUserRepo.EXPECT().GetUserPreferences(ctx, gomock.Any()).Return(entity.UserPreferences{}, errors.New("no rows in result set")).Times(domain.ReminderCompanyBatch)
+		r.person.EXPECT().GetPersonsByCompany(ctx, gomock.Any()).Return(nil, nil).Times(domain.ReminderCompanyBatch)
+
+		s := newJobApp(m.mockDomain, nil, nil, "")
+		require.NoError(t, s.recordReminders(ctx, entity.Job{}))
+	})
+
+	t.Run("Should return error when the reminders of a company could not be recorded", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+		r := newRepos(m, ctrl)
+
+		r.company.EXPECT().GetCompaniesAfterID(ctx, int64(0), domain.ReminderCompanyBatch).Return([]entity.Company{company}, nil).Times(1)
+		m.mockUserRepo.EXPECT().GetUserPreferences(ctx, company.UserOwnerID).Return(entity.UserPreferences{}, nil).Times(1)
+		r.person.EXPECT().GetPersonsByCompany(ctx, company.ID).Return(nil, errors.New("connection refused")).Times(1)
+
+		s := newJobApp(m.mockDomain, nil, nil, "")
+		require.Error(t, s.recordReminders(ctx, entity.Job{}))
+	})
+}
@@ -0,0 +1,429 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/twinj/uuid"
+)
+
+type meetingApp struct {
+	dm        contract.DataManager
+	log       logger.Logger
+	authApp   contract.AuthApp
+	personApp *personApp
+}
+
+func newMeetingApp(infra domain.Infrastructure, authApp contract.AuthApp, personApp *personApp) contract.MeetingApp {
+	return &meetingApp{
+		dm:        infra.DataManager(),
+		log:       infra.Logger(),
+		authApp:   authApp,
+		personApp: personApp,
+	}
+}
+
+// getAuthorizedMeeting loads a meeting by UUID and checks that the logged user owns the meeting's company
+func (s *meetingApp) getAuthorizedMeeting(ctx context.Context, meetingUUID string) (entity.Meeting, entity.Company, error) {
+	meeting, err := s.dm.Meeting().GetMeetingByUUID(ctx, meetingUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return meeting, entity.Company{}, resterrors.NewNotFoundError("meeting not found")
+		}
+		s.log.Errorw(ctx, "error getting meeting by UUID", logger.Err(err))
+		return meeting, entity.Company{}, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return meeting, entity.Company{}, err
+	}
+
+	company, err := s.personApp.validateUserCompanyAccess(ctx, userID, meeting.CompanyID)
+	if err != nil {
+		return meeting, company, err
+	}
+
+	return meeting, company, nil
+}
+
+// getAgendaItem loads an agenda item of the meeting
+func (s *meetingApp) getAgendaItem(ctx context.Context, meetingID int64, itemUUID string) (entity.MeetingAgendaItem, error) {
+	item, err := s.dm.Meeting().GetAgendaItemByUUID(ctx, meetingID, itemUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return item, resterrors.NewNotFoundError("agenda item not found")
+		}
+		s.log.Errorw(ctx, "error getting agenda item by UUID", logger.Err(err))
+		return item, err
+	}
+
+	return item, nil
+}
+
+func validateMeetingDuration(durationMinutes *int) error {
+	if durationMinutes != nil && *durationMinutes <= 0 {
+		return resterrors.NewBadRequestError("duration must be greater than zero")
+	}
+	return nil
+}
+
+func (s *meetingApp) ScheduleMeeting(ctx context.Context, personUUID string, meeting entity.Meeting) (entity.Meeting, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return meeting, err
+	}
+
+	if meeting.ScheduledAt.IsZero() {
+		return meeting, resterrors.NewBadRequestError("scheduled_at is required")
+	}
+
+	if err := validateMeetingDuration(meeting.DurationMinutes); err != nil {
+		return meeting, err
+	}
+
+	meeting.UUID = uuid.NewV4().String()
+	meeting.CompanyID = person.CompanyID
+	meeting.PersonID = person.ID
+	meeting.PersonUUID = person.UUID
+	meeting.PersonName = person.Name
+	meeting.UserID, err = s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return meeting, err
+	}
+	meeting.Status = domain.MeetingStatusScheduled
+	meeting.Origin = domain.MeetingOriginScheduled
+	meeting.HeldAt = nil
+	meeting.NoteID = nil
+	meeting.AgendaItems = []entity.MeetingAgendaItem{}
+
+	// Create the meeting with the open action items of the person in its agenda
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) (err error) {
+		meeting.ID, err = tx.Meeting().CreateMeeting(ctx, meeting)
+		if err != nil {
+			return err
+		}
+
+		return carryOverActionItems(ctx, tx, &meeting)
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error creating meeting", logger.Err(err))
+		return meeting, err
+	}
+
+	s.log.Infow(ctx, "meeting scheduled successfully",
+		logger.String("meeting_uuid", meeting.UUID),
+		logger.String("person_uuid", personUUID),
+	)
+
+	return meeting, nil
+}
+
+// carryOverActionItems adds the open action items of the person to the agenda of the new meeting.
+// Items already in the agenda of another scheduled meeting are not repeated
+func carryOverActionItems(ctx context.Context, tx contract.DataManager, meeting *entity.Meeting) error {
+	items, err := tx.ActionItem().GetActionItemsToCarryOver(ctx, meeting.PersonID)
+	if err != nil {
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	for _, item := range items {
+		_, err := tx.Meeting().CreateAgendaItem(ctx, actionItemAgendaItem(meeting.ID, item))
+		if err != nil {
+			return err
+		}
+	}
+
+	meeting.AgendaItems, err = tx.Meeting().GetAgendaItemsByMeeting(ctx, meeting.ID)
+	return err
+}
+
+func (s *meetingApp) GetMeeting(ctx context.Context, meetingUUID string) (entity.Meeting, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	meeting, _, err := s.getAuthorizedMeeting(ctx, meetingUUID)
+	if err != nil {
+		return meeting, err
+	}
+
+	meeting.AgendaItems, err = s.dm.Meeting().GetAgendaItemsByMeeting(ctx, meeting.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting meeting agenda items", logger.Err(err))
+		return meeting, err
+	}
+
+	return meeting, nil
+}
+
+func (s *meetingApp) GetPersonMeetings(ctx context.Context, personUUID, status string, take, skip int64) ([]entity.Meeting, int64, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	meetings, totalRecords, err := s.dm.Meeting().GetMeetingsByPerson(ctx, person.ID, status, take, skip)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting person meetings", logger.Err(err))
+		return nil, 0, err
+	}
+
+	return meetings, totalRecords, nil
+}
+
+func (s *meetingApp) UpdateMeeting(ctx context.Context, meetingUUID string, meeting entity.Meeting) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	existing, _, err := s.getAuthorizedMeeting(ctx, meetingUUID)
+	if err != nil {
+		return err
+	}
+
+	if !existing.IsScheduled() {
+		return resterrors.NewBadRequestError("only scheduled meetings can be rescheduled")
+	}
+
+	if meeting.ScheduledAt.IsZero() {
+		return resterrors.NewBadRequestError("scheduled_at is required")
+	}
+
+	if err := validateMeetingDuration(meeting.DurationMinutes); err != nil {
+		return err
+	}
+
+	existing.ScheduledAt = meeting.ScheduledAt
+	existing.DurationMinutes = meeting.DurationMinutes
+
+	err = s.dm.Meeting().UpdateMeeting(ctx, existing.ID, existing)
+	if err != nil {
+		s.log.Errorw(ctx, "error updating meeting", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *meetingApp) CancelMeeting(ctx context.Context, meetingUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	meeting, _, err := s.getAuthorizedMeeting(ctx, meetingUUID)
+	if err != nil {
+		return err
+	}
+
+	if !meeting.IsScheduled() {
+		return resterrors.NewBadRequestError("only scheduled meetings can be canceled")
+	}
+
+	meeting.Status = domain.MeetingStatusCanceled
+
+	err = s.dm.Meeting().UpdateMeeting(ctx, meeting.ID, meeting)
+	if err != nil {
+		s.log.Errorw(ctx, "error canceling meeting", logger.Err(err))
+		return err
+	}
+
+	s.log.Infow(ctx, "meeting canceled", logger.String("meeting_uuid", meetingUUID))
+
+	return nil
+}
+
+// CompleteMeeting marks the meeting as held and creates the resulting one_on_one note from the summary
+func (s *meetingApp) CompleteMeeting(ctx context.Context, meetingUUID string, completion entity.MeetingCompletion) (entity.Meeting, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	meeting, company, err := s.getAuthorizedMeeting(ctx, meetingUUID)
+	if err != nil {
+		return meeting, err
+	}
+
+	if !meeting.IsScheduled() {
+		return meeting, resterrors.NewBadRequestError("only scheduled meetings can be completed")
+	}
+
+	if err := validateMeetingDuration(completion.DurationMinutes); err != nil {
+		return meeting, err
+	}
+
+	heldAt := completion.HeldAt
+	if heldAt.IsZero() {
+		heldAt = time.Now()
+	}
+
+	meeting.Status = domain.MeetingStatusHeld
+	meeting.HeldAt = &heldAt
+	if completion.DurationMinutes != nil {
+		meeting.DurationMinutes = completion.DurationMinutes
+	}
+
+	summary := strings.TrimSpace(completion.Summary)
+	if summary == "" {
+		err = s.dm.Meeting().UpdateMeeting(ctx, meeting.ID, meeting)
+	} else {
+		err = s.completeMeetingWithNote(ctx, company, &meeting, summary)
+	}
+	if err != nil {
+		s.log.Errorw(ctx, "error completing meeting", logger.Err(err))
+		return meeting, err
+	}
+
+	meeting.AgendaItems, err = s.dm.Meeting().GetAgendaItemsByMeeting(ctx, meeting.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting meeting agenda items", logger.Err(err))
+		return meeting, err
+	}
+
+	s.log.Infow(ctx, "meeting completed",
+		logger.String("meeting_uuid", meetingUUID),
+		logger.Bool("has_note", meeting.NoteID != nil),
+	)
+
+	return meeting, nil
+}
+
+// completeMeetingWithNote creates the one_on_one note of the summary and marks the meeting as held in the same
+// transaction, so a summary note never exists for a meeting that is still open
+func (s *meetingApp) completeMeetingWithNote(ctx context.Context, company entity.Company, meeting *entity.Meeting, summary string) error {
+	person, err := s.dm.Person().GetPersonByID(ctx, meeting.PersonID)
+	if err != nil {
+		return err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.personApp.saveNote(ctx, company, person, userID, entity.Note{
+		Type:    domain.NoteTypeOneOnOne,
+		Content: summary,
+	}, func(tx contract.DataManager, note entity.Note) error {
+		meeting.NoteID = &note.ID
+		meeting.NoteUUID = &note.UUID
+		return tx.Meeting().UpdateMeeting(ctx, meeting.ID, *meeting)
+	})
+	return err
+}
+
+func (s *meetingApp) AddAgendaItem(ctx context.Context, meetingUUID string, item entity.MeetingAgendaItem) (entity.MeetingAgendaItem, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	meeting, _, err := s.getAuthorizedMeeting(ctx, meetingUUID)
+	if err != nil {
+		return item, err
+	}
+
+	if !meeting.IsScheduled() {
+		return item, resterrors.NewBadRequestError("agenda items can only be added before the meeting")
+	}
+
+	item.Content = strings.TrimSpace(item.Content)
+	if item.Content == "" {
+		return item, resterrors.NewBadRequestError("content is required")
+	}
+
+	if item.AddedBy == "" {
+		item.AddedBy = domain.AgendaItemAddedByManager
+	}
+	if item.AddedBy != domain.AgendaItemAddedByManager && item.AddedBy != domain.AgendaItemAddedByReport {
+		return item, resterrors.NewBadRequestError("added_by must be manager or report")
+	}
+
+	item.UUID = uuid.NewV4().String()
+	item.MeetingID = meeting.ID
+
+	_, err = s.dm.Meeting().CreateAgendaItem(ctx, item)
+	if err != nil {
+		s.log.Errorw(ctx, "error creating agenda item", logger.Err(err))
+		return item, err
+	}
+
+	return s.getAgendaItem(ctx, meeting.ID, item.UUID)
+}
+
+// UpdateAgendaItem updates the content of an item before the meeting, and its notes and discussed flag at any time
+func (s *meetingApp) UpdateAgendaItem(ctx context.Context, meetingUUID, itemUUID string, item entity.MeetingAgendaItem) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	meeting, _, err := s.getAuthorizedMeeting(ctx, meetingUUID)
+	if err != nil {
+		return err
+	}
+
+	if meeting.Status == domain.MeetingStatusCanceled {
+		return resterrors.NewBadRequestError("the meeting was canceled")
+	}
+
+	existing, err := s.getAgendaItem(ctx, meeting.ID, itemUUID)
+	if err != nil {
+		return err
+	}
+
+	content := strings.TrimSpace(item.Content)
+	if content != "" && content != existing.Content {
+		if !meeting.IsScheduled() {
+			return resterrors.NewBadRequestError("the content of an agenda item can only be changed before the meeting")
+		}
+		existing.Content = content
+	}
+
+	existing.Notes = item.Notes
+	existing.Discussed = item.Discussed
+
+	err = s.dm.Meeting().UpdateAgendaItem(ctx, existing.ID, existing)
+	if err != nil {
+		s.log.Errorw(ctx, "error updating agenda item", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *meetingApp) DeleteAgendaItem(ctx context.Context, meetingUUID, itemUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	meeting, _, err := s.getAuthorizedMeeting(ctx, meetingUUID)
+	if err != nil {
+		return err
+	}
+
+	if !meeting.IsScheduled() {
+		return resterrors.NewBadRequestError("agenda items can only be removed before the meeting")
+	}
+
+	item, err := s.getAgendaItem(ctx, meeting.ID, itemUUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.Meeting().DeleteAgendaItem(ctx, item.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error deleting agenda item", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,368 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/twinj/uuid"
+)
+
+// noteAttachmentSniffBytes is how much of the content is read to detect its type
+const noteAttachmentSniffBytes = 512
+
+// noteAttachmentType is a kind of file that can be attached to a note: the content type it is served with
+// and the types its content can be detected as, so a file can't be uploaded with the extension of another kind
+type noteAttachmentType struct {
+	contentType string
+	detected    []string
+}
+
+// noteAttachmentTypes are the allowed attachments by extension. Formats that can run scripts in the browser, like SVG and HTML, are not allowed
+var noteAttachmentTypes = map[string]noteAttachmentType{
+	".png":  {contentType: "image/png", detected: []string{"image/png"}},
+	".jpg":  {contentType: "image/jpeg", detected: []string{"image/jpeg"}},
+	".jpeg": {contentType: "image/jpeg", detected: []string{"image/jpeg"}},
+	".gif":  {contentType: "image/gif", detected: []string{"image/gif"}},
+	".webp": {contentType: "image/webp", detected: []string{"image/webp"}},
+	".pdf":  {contentType: "application/pdf", detected: []string{"application/pdf"}},
+	".txt":  {contentType: "text/plain; charset=utf-8", detected: []string{"text/plain"}},
+	".md":   {contentType: "text/markdown; charset=utf-8", detected: []string{"text/plain"}},
+	".csv":  {contentType: "text/csv; charset=utf-8", detected: []string{"text/plain"}},
+	".docx": {contentType: "application/vnd.openxmlformats-officedocument.wordprocessingml.document", detected: []string{"application/zip"}},
+	".xlsx": {contentType: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", detected: []string{"application/zip"}},
+	".pptx": {contentType: "application/vnd.openxmlformats-officedocument.presentationml.presentation", detected: []string{"application/zip"}},
+}
+
+type noteAttachmentApp struct {
+	dm        contract.DataManager
+	log       logger.Logger
+	cache     contract.CacheManager
+	blobs     contract.BlobStorage
+	personApp *personApp
+}
+
+func newNoteAttachmentApp(infra domain.Infrastructure, personApp *personApp) contract.NoteAttachmentApp {
+	return &noteAttachmentApp{
+		dm:        infra.DataManager(),
+		log:       infra.Logger(),
+		cache:     infra.CacheManager(),
+		blobs:     infra.BlobStorage(),
+		personApp: personApp,
+	}
+}
+
+func noteAttachmentDownloadCacheKey(tokenHash string) string {
+	return "note-attachment-download:" + tokenHash
+}
+
+func noteAttachmentStorageKey(attachment entity.NoteAttachment) string {
+	return fmt.Sprintf("notes/%d/%s", attachment.CompanyID, attachment.UUID)
+}
+
+// sanitizeAttachmentFileName keeps only the name of the file, without its directories and control characters
+func sanitizeAttachmentFileName(fileName string) string {
+	fileName = path.Base(strings.ReplaceAll(fileName, "\\", "/"))
+	fileName = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, fileName)
+
+	fileName = strings.TrimSpace(fileName)
+	if fileName == "." || fileName == "/" {
+		return ""
+	}
+	return fileName
+}
+
+// validateAttachmentUpload checks the name and size of the file, returning the type of its extension
+func validateAttachmentUpload(upload entity.NoteAttachmentUpload) (entity.NoteAttachmentUpload, noteAttachmentType, error) {
+	upload.FileName = sanitizeAttachmentFileName(upload.FileName)
+	if upload.FileName == "" {
+		return upload, noteAttachmentType{}, resterrors.NewBadRequestError("file name is required")
+	}
+	if utf8.RuneCountInString(upload.FileName) > domain.NoteAttachmentMaxFileNameLength {
+		return upload, noteAttachmentType{}, resterrors.NewBadRequestError("file name is too long")
+	}
+
+	if upload.Size <= 0 {
+		return upload, noteAttachmentType{}, resterrors.NewBadRequestError("file is empty")
+	}
+	if upload.Size > domain.NoteAttachmentMaxSizeBytes {
+		return upload, noteAttachmentType{}, resterrors.NewBadRequestError(fmt.Sprintf("file is too large, the limit is %dMB", domain.NoteAttachmentMaxSizeBytes>>20))
+	}
+
+	attachmentType, ok := noteAttachmentTypes[strings.ToLower(path.Ext(upload.FileName))]
+	if !ok {
+		return upload, noteAttachmentType{}, resterrors.NewBadRequestError("file type not allowed, use images, PDF, text, CSV or Office documents")
+	}
+
+	return upload, attachmentType, nil
+}
+
+// sniffAttachmentContent checks that the beginning of the content matches the type of the extension,
+// returning a reader with the whole content
+func sniffAttachmentContent(content io.Reader, attachmentType noteAttachmentType) (io.Reader, error) {
+	head := make([]byte, noteAttachmentSniffBytes)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("error reading file: %w", err)
+	}
+	head = head[:n]
+
+	detected, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil || !slices.Contains(attachmentType.detected, detected) {
+		return nil, resterrors.NewBadRequestError("file content does not match its extension")
+	}
+
+	return io.MultiReader(bytes.NewReader(head), content), nil
+}
+
+// getAuthorizedAttachment loads an attachment of an authorized note
+func (s *noteAttachmentApp) getAuthorizedAttachment(ctx context.Context, noteUUID, attachmentUUID string) (entity.NoteAttachment, error) {
+	note, _, err := s.personApp.getAuthorizedNote(ctx, noteUUID)
+	if err != nil {
+		return entity.NoteAttachment{}, err
+	}
+
+	attachment, err := s.dm.Note().GetNoteAttachmentByUUID(ctx, attachmentUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return attachment, resterrors.NewNotFoundError("attachment not found")
+		}
+		s.log.Errorw(ctx, "error getting attachment by UUID", logger.Err(err))
+		return attachment, err
+	}
+
+	if attachment.NoteID != note.ID {
+		return attachment, resterrors.NewNotFoundError("attachment not found")
+	}
+
+	return attachment, nil
+}
+
+func (s *noteAttachmentApp) UploadAttachment(ctx context.Context, noteUUID string, upload entity.NoteAttachmentUpload) (entity.NoteAttachment, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	note, userID, err := s.personApp.getAuthorizedNote(ctx, noteUUID)
+	if err != nil {
+		return entity.NoteAttachment{}, err
+	}
+
+	upload, attachmentType, err := validateAttachmentUpload(upload)
+	if err != nil {
+		return entity.NoteAttachment{}, err
+	}
+
+	count, err := s.dm.Note().CountNoteAttachments(ctx, note.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error counting note attachments", logger.Err(err))
+		return entity.NoteAttachment{}, err
+	}
+	if count >= domain.NoteAttachmentMaxPerNote {
+		return entity.NoteAttachment{}, resterrors.NewBadRequestError(fmt.Sprintf("a note can have up to %d attachments", domain.NoteAttachmentMaxPerNote))
+	}
+
+	content, err := sniffAttachmentContent(upload.Content, attachmentType)
+	if err != nil {
+		return entity.NoteAttachment{}, err
+	}
+
+	attachment := entity.NoteAttachment{
+		UUID:        uuid.NewV4().String(),
+		NoteID:      note.ID,
+		CompanyID:   note.CompanyID,
+		UserID:      userID,
+		FileName:    upload.FileName,
+		ContentType: attachmentType.contentType,
+		SizeBytes:   upload.Size,
+		CreatedAt:   time.Now(),
+	}
+	attachment.StorageKey = noteAttachmentStorageKey(attachment)
+
+	err = s.blobs.Put(ctx, attachment.StorageKey, content, attachment.SizeBytes, attachment.ContentType)
+	if err != nil {
+		s.log.Errorw(ctx, "error storing attachment content", logger.Err(err))
+		return entity.NoteAttachment{}, err
+	}
+
+	attachment.ID, err = s.dm.Note().CreateNoteAttachment(ctx, attachment)
+	if err != nil {
+		s.log.Errorw(ctx, "error creating attachment", logger.Err(err))
+
+		// nothing references the content, so it would never be cleaned up
+		if deleteErr := s.blobs.Delete(ctx, attachment.StorageKey); deleteErr != nil {
+			s.log.Errorw(ctx, "error deleting content of attachment not created", logger.Err(deleteErr), logger.String("storage_key", attachment.StorageKey))
+		}
+		return entity.NoteAttachment{}, err
+	}
+
+	s.log.Infow(ctx, "attachment uploaded successfully",
+		logger.String("attachment_uuid", attachment.UUID),
+		logger.String("note_uuid", noteUUID),
+		logger.Int64("size_bytes", attachment.SizeBytes),
+	)
+
+	return attachment, nil
+}
+
+func (s *noteAttachmentApp) GetNoteAttachments(ctx context.Context, noteUUID string) ([]entity.NoteAttachment, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	note, _, err := s.personApp.getAuthorizedNote(ctx, noteUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := s.dm.Note().GetNoteAttachments(ctx, note.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting note attachments", logger.Err(err))
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+func (s *noteAttachmentApp) CreateDownloadURL(ctx context.Context, noteUUID, attachmentUUID string) (entity.NoteAttachmentDownloadURL, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	var downloadURL entity.NoteAttachmentDownloadURL
+
+	attachment, err := s.getAuthorizedAttachment(ctx, noteUUID, attachmentUUID)
+	if err != nil {
+		return downloadURL, err
+	}
+
+	token, err := generateSecretToken()
+	if err != nil {
+		s.log.Errorw(ctx, "error generating download token", logger.Err(err))
+		return downloadURL, err
+	}
+
+	ttl := domain.NoteAttachmentDownloadTTLMins * time.Minute
+	err = s.cache.SetStringWithExpiration(ctx, noteAttachmentDownloadCacheKey(hashSecretToken(token)), attachment.UUID, ttl)
+	if err != nil {
+		s.log.Errorw(ctx, "error saving download token", logger.Err(err))
+		return downloadURL, err
+	}
+
+	downloadURL.Token = token
+	downloadURL.ExpiresAt = time.Now().Add(ttl)
+
+	return downloadURL, nil
+}
+
+func (s *noteAttachmentApp) DownloadAttachment(ctx context.Context, token string) (entity.NoteAttachmentDownload, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	var download entity.NoteAttachmentDownload
+	notFound := resterrors.NewNotFoundError("download link is invalid or expired")
+
+	if token == "" {
+		return download, notFound
+	}
+
+	attachmentUUID, err := s.cache.GetString(ctx, noteAttachmentDownloadCacheKey(hashSecretToken(token)))
+	if err != nil || attachmentUUID == "" {
+		return download, notFound
+	}
+
+	attachment, err := s.dm.Note().GetNoteAttachmentByUUID(ctx, attachmentUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return download, notFound
+		}
+		s.log.Errorw(ctx, "error getting attachment by UUID", logger.Err(err))
+		return download, err
+	}
+
+	// the note may have been deleted after the link was created
+	_, err = s.dm.Note().GetNoteByID(ctx, attachment.NoteID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return download, notFound
+		}
+		s.log.Errorw(ctx, "error getting note by ID", logger.Err(err))
+		return download, err
+	}
+
+	content, err := s.blobs.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting attachment content", logger.Err(err), logger.String("attachment_uuid", attachment.UUID))
+		if errors.Is(err, domain.ErrBlobNotFound) {
+			return download, resterrors.NewNotFoundError("attachment content not found")
+		}
+		return download, err
+	}
+
+	download.Attachment = attachment
+	download.Content = content
+
+	return download, nil
+}
+
+func (s *noteAttachmentApp) DeleteAttachment(ctx context.Context, noteUUID, attachmentUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	attachment, err := s.getAuthorizedAttachment(ctx, noteUUID, attachmentUUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.Note().DeleteNoteAttachment(ctx, attachment.ID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return resterrors.NewNotFoundError("attachment not found")
+		}
+		s.log.Errorw(ctx, "error deleting attachment", logger.Err(err))
+		return err
+	}
+
+	// the attachment is already hidden, when removing its content fails the cleanup job tries again
+	err = purgeNoteAttachment(ctx, s.dm, s.blobs, attachment)
+	if err != nil {
+		s.log.Errorw(ctx, "error removing attachment content", logger.Err(err), logger.String("attachment_uuid", attachmentUUID))
+	}
+
+	s.log.Infow(ctx, "attachment deleted successfully", logger.String("attachment_uuid", attachmentUUID))
+
+	return nil
+}
+
+// purgeNoteAttachment removes the content of a deleted attachment and then the attachment itself
+func purgeNoteAttachment(ctx context.Context, dm contract.DataManager, blobs contract.BlobStorage, attachment entity.NoteAttachment) error {
+	err := blobs.Delete(ctx, attachment.StorageKey)
+	if err != nil {
+		return err
+	}
+
+	err = dm.Note().PurgeNoteAttachment(ctx, attachment.ID)
+	if err != nil && !mysqlutils.SQLNotFound(err.Error()) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const pngHeader = "\x89PNG\r\n\x1a\n"
+
+func Test_validateAttachmentUpload(t *testing.T) {
+	tests := []struct {
+		name            string
+		upload          entity.NoteAttachmentUpload
+		wantFileName    string
+		wantContentType string
+		wantErr         bool
+	}{
+		{
+			name:            "Should accept an image keeping only the file name",
+			upload:          entity.NoteAttachmentUpload{FileName: `C:\Users\maria\PR Review.PNG`, Size: 2048},
+			wantFileName:    "PR Review.PNG",
+			wantContentType: "image/png",
+		},
+		{
+			name:            "Should accept an Office document",
+			upload:          entity.NoteAttachmentUpload{FileName: "plan.docx", Size: 2048},
+			wantFileName:    "plan.docx",
+			wantContentType: "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+		},
+		{
+			name:    "Should refuse a file type that is not allowed",
+			upload:  entity.NoteAttachmentUpload{FileName: "diagram.svg", Size: 2048},
+			wantErr: true,
+		},
+		{
+			name:    "Should refuse a file without extension",
+			upload:  entity.NoteAttachmentUpload{FileName: "notes", Size: 2048},
+			wantErr: true,
+		},
+		{
+			name:    "Should refuse an empty file",
+			upload:  entity.NoteAttachmentUpload{FileName: "empty.txt", Size: 0},
+			wantErr: true,
+		},
+		{
+			name:    "Should refuse a file over the size limit",
+			upload:  entity.NoteAttachmentUpload{FileName: "video.pdf", Size: domain.NoteAttachmentMaxSizeBytes + 1},
+			wantErr: true,
+		},
+		{
+			name:    "Should refuse a file without name",
+			upload:  entity.NoteAttachmentUpload{FileName: "../", Size: 10},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upload, attachmentType, err := validateAttachmentUpload(tt.upload)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Equal(t, http.StatusBadRequest, err.(resterrors.RestErr).StatusCode())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.wantFileName, upload.FileName)
+			require.Equal(t, tt.wantContentType, attachmentType.contentType)
+		})
+	}
+}
+
+func Test_sniffAttachmentContent(t *testing.T) {
+	t.Run("Should return the whole content when it matches the extension", func(t *testing.T) {
+		content := pngHeader + strings.Repeat("x", 1000)
+
+		reader, err := sniffAttachmentContent(strings.NewReader(content), noteAttachmentTypes[".png"])
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.Equal(t, content, string(data))
+	})
+
+	t.Run("Should accept a short text file", func(t *testing.T) {
+		_, err := sniffAttachmentContent(strings.NewReader("name,role\nMaria,Engineer\n"), noteAttachmentTypes[".csv"])
+		require.NoError(t, err)
+	})
+
+	t.Run("Should refuse a content that does not match the extension", func(t *testing.T) {
+		_, err := sniffAttachmentContent(strings.NewReader("<html><script>alert(1)</script></html>"), noteAttachmentTypes[".png"])
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, err.(resterrors.RestErr).StatusCode())
+
+		_, err = sniffAttachmentContent(strings.NewReader(pngHeader+"image"), noteAttachmentTypes[".pdf"])
+		require.Error(t, err)
+	})
+}
+
+func TestNoteAttachmentApp_DownloadAttachment(t *testing.T) {
+	ctx := context.Background()
+	token := "download-token"
+	cacheKey := noteAttachmentDownloadCacheKey(hashSecretToken(token))
+	attachment := entity.NoteAttachment{ID: 3, UUID: "attachment-uuid", NoteID: 7, FileName: "review.png", ContentType: "image/png", StorageKey: "notes/1/attachment-uuid"}
+
+	tests := []struct {
+		name       string
+		buildMocks func(m allMocks, noteRepo *mocks.MockNoteRepo)
+		wantStatus int
+	}{
+		{
+			name: "Should return the content of the attachment",
+			buildMocks: func(m allMocks, noteRepo *mocks.MockNoteRepo) {
+				m.mockCacheManager.EXPECT().GetString(ctx, cacheKey).Return(attachment.UUID, nil).Times(1)
+				noteRepo.EXPECT().GetNoteAttachmentByUUID(ctx, attachment.UUID).Return(attachment, nil).Times(1)
+				noteRepo.EXPECT().GetNoteByID(ctx, attachment.NoteID).Return(entity.Note{ID: attachment.NoteID}, nil).Times(1)
+				m.mockBlobStorage.EXPECT().Get(ctx, attachment.StorageKey).Return(io.NopCloser(strings.NewReader("image")), nil).Times(1)
+			},
+		},
+		{
+			name: "Should return not found when the token expired",
+			buildMocks: func(m allMocks, noteRepo *mocks.MockNoteRepo) {
+				m.mockCacheManager.EXPECT().GetString(ctx, cacheKey).Return("", errors.New("cache miss: key not found")).Times(1)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "Should return not found when the note was deleted",
+			buildMocks: func(m allMocks, noteRepo *mocks.MockNoteRepo) {
+				m.mockCacheManager.EXPECT().GetString(ctx, cacheKey).Return(attachment.UUID, nil).Times(1)
+				noteRepo.EXPECT().GetNoteAttachmentByUUID(ctx, attachment.UUID).Return(attachment, nil).Times(1)
+				noteRepo.EXPECT().GetNoteByID(ctx, attachment.NoteID).Return(entity.Note{}, errors.New("sql: no rows in result set")).Times(1)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name: "Should return not found when the content is missing",
+			buildMocks: func(m allMocks, noteRepo *mocks.MockNoteRepo) {
+				m.mockCacheManager.EXPECT().GetString(ctx, cacheKey).Return(attachment.UUID, nil).Times(1)
+				noteRepo.EXPECT().GetNoteAttachmentByUUID(ctx, attachment.UUID).Return(attachment, nil).Times(1)
+				noteRepo.EXPECT().GetNoteByID(ctx, attachment.NoteID).Return(entity.Note{ID: attachment.NoteID}, nil).Times(1)
+				m.mockBlobStorage.EXPECT().Get(ctx, attachment.StorageKey).Return(nil, domain.ErrBlobNotFound).Times(1)
+			},
+			wantStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, ctrl := newServiceTestMock(t)
+			defer ctrl.Finish()
+
+			noteRepo := mocks.NewMockNoteRepo(ctrl)
+			m.mockDataManager.EXPECT().Note().Return(noteRepo).AnyTimes()
+			tt.buildMocks(m, noteRepo)
+
+			s := newNoteAttachmentApp(m.mockDomain, newPersonApp(m.mockDomain, nil))
+			download, err := s.DownloadAttachment(ctx, token)
+			if tt.wantStatus != 0 {
+				require.Error(t, err)
+				require.Equal(t, tt.wantStatus, err.(resterrors.RestErr).StatusCode())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, attachment.UUID, download.Attachment.UUID)
+
+			data, err := io.ReadAll(download.Content)
+			require.NoError(t, err)
+			require.Equal(t, "image", string(data))
+		})
+	}
+}
+
+func TestJobApp_cleanupAttachments(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Should remove the content and then the attachment", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		noteRepo := mocks.NewMockNoteRepo(ctrl)
+		m.mockDataManager.EXPECT().Note().Return(noteRepo).AnyTimes()
+
+		attachments := []entity.NoteAttachment{{ID: 1, UUID: "a", StorageKey: "notes/1/a"}, {ID: 2, UUID: "b", StorageKey: "notes/1/b"}}
+		gomock.InOrder(
+			noteRepo.EXPECT().GetExpiredNoteAttachments(ctx, gomock.Any(), domain.NoteAttachmentCleanupBatch).Return(attachments, nil),
+			m.mockBlobStorage.EXPECT().Delete(ctx, "notes/1/a").Return(nil),
+			noteRepo.EXPECT().PurgeNoteAttachment(ctx, int64(1)).Return(nil),
+			m.mockBlobStorage.EXPECT().Delete(ctx, "notes/1/b").Return(nil),
+			noteRepo.EXPECT().PurgeNoteAttachment(ctx, int64(2)).Return(nil),
+		)
+
+		s := newJobApp(m.mockDomain, nil, nil, "")
+		require.NoError(t, s.cleanupAttachments(ctx, entity.Job{}))
+	})
+
+	t.Run("Should keep the attachment when its content could not be removed", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		noteRepo := mocks.NewMockNoteRepo(ctrl)
+		m.mockDataManager.EXPECT().Note().Return(noteRepo).AnyTimes()
+
+		noteRepo.EXPECT().GetExpiredNoteAttachments(ctx, gomock.Any(), gomock.Any()).
+			Return([]entity.NoteAttachment{{ID: 1, UUID: "a", StorageKey: "notes/1/a"}}, nil).Times(1)
+		m.mockBlobStorage.EXPECT().Delete(ctx, "notes/1/a").Return(errors.New("storage unavailable")).Times(1)
+
+		s := newJobApp(m.mockDomain, nil, nil, "")
+		require.Error(t, s.cleanupAttachments(ctx, entity.Job{}))
+	})
+}
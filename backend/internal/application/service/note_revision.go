@@ -0,0 +1,322 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/text"
+	"github.com/twinj/uuid"
+)
+
+// newNoteRevision returns the revision with the current content of the note, written by the user.
+// Only feedback notes keep the feedback fields, as in the note itself
+func newNoteRevision(note entity.Note, userID int64) entity.NoteRevision {
+	revision := entity.NoteRevision{
+		UUID:    uuid.NewV4().String(),
+		NoteID:  note.ID,
+		UserID:  userID,
+		Type:    note.Type,
+		Content: note.Content,
+	}
+	if note.IsFeedback() {
+		revision.FeedbackType = note.FeedbackType
+		revision.FeedbackCategory = note.FeedbackCategory
+	}
+	return revision
+}
+
+// diffNoteRevisions compares the content of the revisions line by line and lists the other fields that changed
+func diffNoteRevisions(from, to entity.NoteRevision) entity.NoteRevisionDiff {
+	diff := entity.NoteRevisionDiff{
+		From:          from,
+		To:            to,
+		ChangedFields: []string{},
+	}
+
+	if from.Type != to.Type {
+		diff.ChangedFields = append(diff.ChangedFields, "type")
+	}
+	if stringValue(from.FeedbackType) != stringValue(to.FeedbackType) {
+		diff.ChangedFields = append(diff.ChangedFields, "feedback_type")
+	}
+	if stringValue(from.FeedbackCategory) != stringValue(to.FeedbackCategory) {
+		diff.ChangedFields = append(diff.ChangedFields, "feedback_category")
+	}
+
+	for _, line := range text.DiffLines(from.Content, to.Content) {
+		diff.Lines = append(diff.Lines, entity.NoteDiffLine{Op: line.Op, Text: line.Text})
+	}
+
+	return diff
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+// getAuthorizedNote returns a note of a company owned by the logged user, with the logged user ID
+func (s *personApp) getAuthorizedNote(ctx context.Context, noteUUID string) (entity.Note, int64, error) {
+	note, err := s.dm.Note().GetNoteByUUID(ctx, noteUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return note, 0, resterrors.NewNotFoundError("note not found")
+		}
+		s.log.Errorw(ctx, "error getting note by UUID", logger.Err(err))
+		return note, 0, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return note, 0, err
+	}
+
+	_, err = s.validateUserCompanyAccess(ctx, userID, note.CompanyID)
+	if err != nil {
+		return note, 0, err
+	}
+
+	return note, userID, nil
+}
+
+// getNoteRevision returns a revision of the note by its number
+func (s *personApp) getNoteRevision(ctx context.Context, noteID int64, number int) (entity.NoteRevision, error) {
+	revision, err := s.dm.Note().GetNoteRevisionByNumber(ctx, noteID, number)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return revision, resterrors.NewNotFoundError("note revision not found")
+		}
+		s.log.Errorw(ctx, "error getting note revision", logger.Err(err))
+		return revision, err
+	}
+
+	return revision, nil
+}
+
+// rebuildNoteMentions replaces the mentions of the note with the people of the company mentioned in its current content,
+// returning the mentioned people. It runs in the transaction that writes the note
+func (s *personApp) rebuildNoteMentions(ctx context.Context, tx contract.DataManager, note entity.Note) ([]entity.Person, error) {
+	err := tx.Note().DeleteMentionsByNote(ctx, note.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var mentioned []entity.Person
+	for _, mentionedUUID := range note.ExtractMentionUUIDs() {
+		mentionedPerson, err := tx.Person().GetPersonByUUID(ctx, mentionedUUID)
+		if err != nil {
+			if mysqlutils.SQLNotFound(err.Error()) {
+				s.log.Warnw(ctx, "mentioned person not found, skipping mention", logger.String("mentioned_uuid", mentionedUUID))
+				continue
+			}
+			return mentioned, err
+		}
+
+		if mentionedPerson.CompanyID != note.CompanyID {
+			s.log.Warnw(ctx, "mentioned person not in same company, skipping mention",
+				logger.String("mentioned_uuid", mentionedUUID),
+			)
+			continue
+		}
+
+		mention := entity.NoteMention{
+			UUID:              uuid.NewV4().String(),
+			NoteID:            note.ID,
+			MentionedPersonID: mentionedPerson.ID,
+			SourcePersonID:    note.PersonID,
+			FullContent:       note.Content,
+			CreatedAt:         time.Now(),
+		}
+
+		_, err = tx.Note().CreateNoteMention(ctx, mention)
+		if err != nil {
+			return mentioned, err
+		}
+		mentioned = append(mentioned, mentionedPerson)
+	}
+
+	return mentioned, nil
+}
+
+func (s *personApp) GetNoteRevisions(ctx context.Context, noteUUID string) ([]entity.NoteRevision, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	note, _, err := s.getAuthorizedNote(ctx, noteUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.dm.Note().GetNoteRevisions(ctx, note.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting note revisions", logger.Err(err))
+		return nil, err
+	}
+
+	return revisions, nil
+}
+
+func (s *personApp) GetNoteRevisionDiff(ctx context.Context, noteUUID string, from, to int) (entity.NoteRevisionDiff, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	note, _, err := s.getAuthorizedNote(ctx, noteUUID)
+	if err != nil {
+		return entity.NoteRevisionDiff{}, err
+	}
+
+	fromRevision, err := s.getNoteRevision(ctx, note.ID, from)
+	if err != nil {
+		return entity.NoteRevisionDiff{}, err
+	}
+
+	var toRevision entity.NoteRevision
+	if to == 0 {
+		revisions, err := s.dm.Note().GetNoteRevisions(ctx, note.ID)
+		if err != nil {
+			s.log.Errorw(ctx, "error getting note revisions", logger.Err(err))
+			return entity.NoteRevisionDiff{}, err
+		}
+		if len(revisions) == 0 {
+			return entity.NoteRevisionDiff{}, resterrors.NewNotFoundError("note revision not found")
+		}
+		toRevision = revisions[0]
+	} else {
+		toRevision, err = s.getNoteRevision(ctx, note.ID, to)
+		if err != nil {
+			return entity.NoteRevisionDiff{}, err
+		}
+	}
+
+	return diffNoteRevisions(fromRevision, toRevision), nil
+}
+
+func (s *personApp) RestoreNoteRevision(ctx context.Context, noteUUID string, number int) (entity.Note, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	note, userID, err := s.getAuthorizedNote(ctx, noteUUID)
+	if err != nil {
+		return note, err
+	}
+
+	revision, err := s.getNoteRevision(ctx, note.ID, number)
+	if err != nil {
+		return note, err
+	}
+
+	if newNoteRevision(note, userID).SameContent(revision) {
+		return note, resterrors.NewBadRequestError("the note already has the content of this revision")
+	}
+
+	wasOneOnOne := note.IsOneOnOne()
+	note.Type = revision.Type
+	note.Content = revision.Content
+	note.FeedbackType = revision.FeedbackType
+	note.FeedbackCategory = revision.FeedbackCategory
+	note.UpdatedAt = time.Now()
+
+	// The restore is a new revision, so the history is never rewritten
+	restored := newNoteRevision(note, userID)
+	restored.RestoredFrom = &revision.Number
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		err := tx.Note().UpdateNote(ctx, note.ID, note)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Note().CreateNoteRevision(ctx, restored)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.rebuildNoteMentions(ctx, tx, note)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.rebuildNoteTags(ctx, tx, note)
+		if err != nil {
+			return err
+		}
+
+		return s.syncNoteMeeting(ctx, tx, wasOneOnOne, note)
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error restoring note revision", logger.Err(err))
+		return note, err
+	}
+
+	s.log.Infow(ctx, "note revision restored successfully",
+		logger.String("note_uuid", noteUUID),
+		logger.Int("revision_number", number),
+	)
+
+	return note, nil
+}
+
+func (s *personApp) UndeleteNote(ctx context.Context, noteUUID string) (entity.Note, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	note, err := s.dm.Note().GetDeletedNoteByUUID(ctx, noteUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return note, resterrors.NewNotFoundError("deleted note not found")
+		}
+		s.log.Errorw(ctx, "error getting deleted note by UUID", logger.Err(err))
+		return note, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return note, err
+	}
+
+	_, err = s.validateUserCompanyAccess(ctx, userID, note.CompanyID)
+	if err != nil {
+		return note, err
+	}
+
+	retention := domain.NoteUndeleteRetentionDays * 24 * time.Hour
+	if note.DeletedAt != nil && time.Since(*note.DeletedAt) > retention {
+		return note, resterrors.NewBadRequestError(fmt.Sprintf("notes can only be restored up to %d days after being deleted", domain.NoteUndeleteRetentionDays))
+	}
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		err := tx.Note().UndeleteNote(ctx, note.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.rebuildNoteMentions(ctx, tx, note)
+		if err != nil || !note.IsOneOnOne() {
+			return err
+		}
+
+		// Deleting the note removed its registered meeting
+		return s.registerNoteMeeting(ctx, tx, note)
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error undeleting note", logger.Err(err))
+		return note, err
+	}
+	note.DeletedAt = nil
+
+	s.log.Infow(ctx, "note undeleted successfully",
+		logger.String("note_uuid", noteUUID),
+		logger.String("note_type", note.Type),
+	)
+
+	return note, nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_newNoteRevision(t *testing.T) {
+	feedbackType := domain.FeedbackTypePositive
+
+	t.Run("Should keep the feedback fields of feedback notes", func(t *testing.T) {
+		note := entity.Note{ID: 1, Type: domain.NoteTypeFeedback, Content: "Great demo", FeedbackType: &feedbackType}
+
+		revision := newNoteRevision(note, 2)
+		require.NotEmpty(t, revision.UUID)
+		require.Equal(t, int64(1), revision.NoteID)
+		require.Equal(t, int64(2), revision.UserID)
+		require.Equal(t, feedbackType, *revision.FeedbackType)
+	})
+
+	t.Run("Should drop the feedback fields of other notes", func(t *testing.T) {
+		note := entity.Note{ID: 1, Type: domain.NoteTypeObservation, Content: "Great demo", FeedbackType: &feedbackType}
+
+		revision := newNoteRevision(note, 2)
+		require.Nil(t, revision.FeedbackType)
+		require.True(t, revision.SameContent(newNoteRevision(entity.Note{Type: domain.NoteTypeObservation, Content: "Great demo"}, 3)))
+	})
+}
+
+func Test_diffNoteRevisions(t *testing.T) {
+	feedbackType := domain.FeedbackTypeConstructive
+	from := entity.NoteRevision{Number: 1, Type: domain.NoteTypeObservation, Content: "Wins\nShipped v1"}
+	to := entity.NoteRevision{Number: 2, Type: domain.NoteTypeFeedback, Content: "Wins\nShipped v2", FeedbackType: &feedbackType}
+
+	diff := diffNoteRevisions(from, to)
+	require.Equal(t, 1, diff.From.Number)
+	require.Equal(t, 2, diff.To.Number)
+	require.Equal(t, []string{"type", "feedback_type"}, diff.ChangedFields)
+	require.Equal(t, []entity.NoteDiffLine{
+		{Op: "equal", Text: "Wins"},
+		{Op: "removed", Text: "Shipped v1"},
+		{Op: "added", Text: "Shipped v2"},
+	}, diff.Lines)
+
+	diff = diffNoteRevisions(from, from)
+	require.Empty(t, diff.ChangedFields)
+	require.Len(t, diff.Lines, 2)
+}
+
+func TestPersonApp_syncNoteMeeting(t *testing.T) {
+	ctx := context.Background()
+	oneOnOne := entity.Note{ID: 7, UUID: "note-uuid", CompanyID: 10, PersonID: 3, UserID: 2, Type: domain.NoteTypeOneOnOne}
+	observation := oneOnOne
+	observation.Type = domain.NoteTypeObservation
+
+	t.Run("Should register the meeting when the note becomes a 1:1", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		meetingRepo := mocks.NewMockMeetingRepo(ctrl)
+		m.mockDataManager.EXPECT().Meeting().Return(meetingRepo).AnyTimes()
+		meetingRepo.EXPECT().CreateMeeting(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, meeting entity.Meeting) (int64, error) {
+			require.Equal(t, domain.MeetingStatusHeld, meeting.Status)
+			require.Equal(t, domain.MeetingOriginNote, meeting.Origin)
+			require.Equal(t, int64(7), *meeting.NoteID)
+			return 1, nil
+		})
+
+		s := newPersonApp(m.mockDomain, nil)
+		require.NoError(t, s.syncNoteMeeting(ctx, m.mockDataManager, false, oneOnOne))
+	})
+
+	t.Run("Should return the error so the note is rolled back", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		meetingRepo := mocks.NewMockMeetingRepo(ctrl)
+		m.mockDataManager.EXPECT().Meeting().Return(meetingRepo).AnyTimes()
+		meetingRepo.EXPECT().DeleteMeetingsFromNote(ctx, int64(7)).Return(errors.New("db error"))
+
+		s := newPersonApp(m.mockDomain, nil)
+		require.Error(t, s.syncNoteMeeting(ctx, m.mockDataManager, true, observation))
+	})
+
+	t.Run("Should do nothing when the note type did not change", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		s := newPersonApp(m.mockDomain, nil)
+		require.NoError(t, s.syncNoteMeeting(ctx, m.mockDataManager, true, oneOnOne))
+	})
+}
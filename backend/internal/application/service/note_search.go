@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/text"
+)
+
+var noteSearchTypes = []string{domain.NoteTypeOneOnOne, domain.NoteTypeFeedback, domain.NoteTypeObservation}
+
+// SearchNotes searches the notes of the company in the context by content, feedback category, person name
+// and mentioned people. The mentions are rendered with the current names before building the snippets
+func (s *personApp) SearchNotes(ctx context.Context, search string, filters entity.NoteSearchFilters, take, skip int64) ([]entity.NoteSearchResult, int64, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	search = strings.TrimSpace(search)
+	if search == "" {
+		return nil, 0, resterrors.NewBadRequestError("search text is required")
+	}
+
+	company, err := s.getAuthorizedCompany(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	query, err := s.buildNoteSearchQuery(ctx, company, search, filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	query.Take = take
+	query.Skip = skip
+
+	hits, totalRecords, err := s.noteSearch.SearchNotes(ctx, query)
+	if err != nil {
+		s.log.Errorw(ctx, "error searching notes", logger.Err(err))
+		return nil, 0, err
+	}
+
+	names := s.currentPersonNames(ctx, company.ID)
+	results := make([]entity.NoteSearchResult, 0, len(hits))
+	for _, hit := range hits {
+		hit.Content = entity.RenderMentions(hit.Content, names)
+		results = append(results, entity.NoteSearchResult{
+			NoteSearchHit: hit,
+			Snippet:       noteSnippet(hit.Content, search),
+			Mentions:      entity.ParseMentions(hit.Content),
+		})
+	}
+
+	s.log.Infow(ctx, "notes searched successfully",
+		logger.Int64("company_id", company.ID),
+		logger.Int64("total_records", totalRecords),
+		logger.Int("returned_records", len(results)),
+	)
+
+	return results, totalRecords, nil
+}
+
+// buildNoteSearchQuery validates the filters and resolves their UUIDs to the ids used by the search engine
+func (s *personApp) buildNoteSearchQuery(ctx context.Context, company entity.Company, search string, filters entity.NoteSearchFilters) (entity.NoteSearchQuery, error) {
+	query := entity.NoteSearchQuery{
+		CompanyID: company.ID,
+		Text:      search,
+		Types:     filters.Types,
+		From:      filters.From,
+		To:        filters.To,
+	}
+
+	for _, noteType := range filters.Types {
+		if !slices.Contains(noteSearchTypes, noteType) {
+			return query, resterrors.NewBadRequestError("invalid note type: " + noteType)
+		}
+	}
+
+	if filters.From != nil && filters.To != nil && !filters.From.Before(*filters.To) {
+		return query, resterrors.NewBadRequestError("from must be before to")
+	}
+
+	if filters.PersonUUID != "" {
+		person, err := s.dm.Person().GetPersonByUUID(ctx, filters.PersonUUID)
+		if err != nil {
+			if mysqlutils.SQLNotFound(err.Error()) {
+				return query, resterrors.NewNotFoundError("person not found")
+			}
+			s.log.Errorw(ctx, "error getting person by UUID", logger.Err(err))
+			return query, err
+		}
+		if person.CompanyID != company.ID {
+			return query, resterrors.NewNotFoundError("person not found")
+		}
+		query.PersonID = &person.ID
+	}
+
+	if filters.AuthorUUID != "" {
+		authorID, err := s.dm.User().GetUserIDByUUID(ctx, filters.AuthorUUID)
+		if err != nil {
+			if mysqlutils.SQLNotFound(err.Error()) {
+				return query, resterrors.NewNotFoundError("author not found")
+			}
+			s.log.Errorw(ctx, "error getting author by UUID", logger.Err(err))
+			return query, err
+		}
+		query.AuthorUserID = &authorID
+	}
+
+	return query, nil
+}
+
+// noteSnippet returns the excerpt of the content around the search matches, with the mentions as plain names
+func noteSnippet(content, search string) []entity.NoteSnippetPart {
+	parts := text.Snippet(entity.MentionsAsText(content), search, domain.NoteSearchSnippetSize)
+
+	snippet := make([]entity.NoteSnippetPart, len(parts))
+	for i, part := range parts {
+		snippet[i] = entity.NoteSnippetPart{Text: part.Text, Highlighted: part.Highlighted}
+	}
+	return snippet
+}
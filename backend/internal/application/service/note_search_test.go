@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_noteSnippet(t *testing.T) {
+	snippet := noteSnippet("{{person:abc-1|Maria Silva}} talked about the migration project", "migration")
+
+	require.Equal(t, []entity.NoteSnippetPart{
+		{Text: "Maria Silva talked about the "},
+		{Text: "migration", Highlighted: true},
+		{Text: " project"},
+	}, snippet)
+}
+
+func TestPersonApp_buildNoteSearchQuery(t *testing.T) {
+	ctx := context.Background()
+	company := entity.Company{ID: 10}
+
+	t.Run("Should resolve the person of the filters", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		personRepo := mocks.NewMockPersonRepo(ctrl)
+		m.mockDataManager.EXPECT().Person().Return(personRepo).AnyTimes()
+		personRepo.EXPECT().GetPersonByUUID(gomock.Any(), "person-uuid").Return(entity.Person{ID: 5, CompanyID: 10}, nil)
+
+		s := newPersonApp(m.mockDomain, nil)
+		query, err := s.buildNoteSearchQuery(ctx, company, "migration", entity.NoteSearchFilters{
+			PersonUUID: "person-uuid",
+			Types:      []string{domain.NoteTypeOneOnOne},
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(10), query.CompanyID)
+		require.Equal(t, int64(5), *query.PersonID)
+		require.Nil(t, query.AuthorUserID)
+		require.Equal(t, []string{domain.NoteTypeOneOnOne}, query.Types)
+	})
+
+	t.Run("Should return not found when the person is from another company", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		personRepo := mocks.NewMockPersonRepo(ctrl)
+		m.mockDataManager.EXPECT().Person().Return(personRepo).AnyTimes()
+		personRepo.EXPECT().GetPersonByUUID(gomock.Any(), "person-uuid").Return(entity.Person{ID: 5, CompanyID: 99}, nil)
+
+		s := newPersonApp(m.mockDomain, nil)
+		_, err := s.buildNoteSearchQuery(ctx, company, "migration", entity.NoteSearchFilters{PersonUUID: "person-uuid"})
+		require.Error(t, err)
+		require.Equal(t, http.StatusNotFound, err.(resterrors.RestErr).StatusCode())
+	})
+
+	t.Run("Should validate the types and the date range", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		s := newPersonApp(m.mockDomain, nil)
+		_, err := s.buildNoteSearchQuery(ctx, company, "migration", entity.NoteSearchFilters{Types: []string{"mention"}})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, err.(resterrors.RestErr).StatusCode())
+
+		from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+		_, err = s.buildNoteSearchQuery(ctx, company, "migration", entity.NoteSearchFilters{From: &from, To: &from})
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, err.(resterrors.RestErr).StatusCode())
+	})
+}
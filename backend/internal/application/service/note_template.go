@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/date"
+	"github.com/twinj/uuid"
+)
+
+type noteTemplateApp struct {
+	dm        contract.DataManager
+	log       logger.Logger
+	authApp   contract.AuthApp
+	userApp   contract.UserApp
+	personApp *personApp
+}
+
+func newNoteTemplateApp(infra domain.Infrastructure, authApp contract.AuthApp, userApp contract.UserApp, personApp *personApp) contract.NoteTemplateApp {
+	return &noteTemplateApp{
+		dm:        infra.DataManager(),
+		log:       infra.Logger(),
+		authApp:   authApp,
+		userApp:   userApp,
+		personApp: personApp,
+	}
+}
+
+// normalizeNoteTemplate trims the texts and validates the note type and the sections of the template
+func normalizeNoteTemplate(template *entity.NoteTemplate) error {
+	template.Name = strings.TrimSpace(template.Name)
+	if template.Name == "" {
+		return resterrors.NewBadRequestError("name is required")
+	}
+	template.Description = strings.TrimSpace(template.Description)
+
+	switch template.NoteType {
+	case domain.NoteTypeOneOnOne, domain.NoteTypeFeedback, domain.NoteTypeObservation:
+	default:
+		return resterrors.NewBadRequestError("invalid note type: " + template.NoteType)
+	}
+
+	if len(template.Sections) == 0 || len(template.Sections) > domain.NoteTemplateMaxSections {
+		return resterrors.NewBadRequestError(fmt.Sprintf("the template must have from 1 to %d sections", domain.NoteTemplateMaxSections))
+	}
+	for i := range template.Sections {
+		section := &template.Sections[i]
+		section.Title = strings.TrimSpace(section.Title)
+		if section.Title == "" {
+			return resterrors.NewBadRequestError("section title is required")
+		}
+		section.Placeholder = strings.TrimSpace(section.Placeholder)
+		section.Content = strings.TrimSpace(section.Content)
+		section.Position = i + 1
+	}
+
+	return nil
+}
+
+// noteTemplateVariables returns the values of the template variables for a note about the person
+func noteTemplateVariables(person entity.Person, company entity.Company, manager entity.User, today time.Time) map[string]string {
+	firstName := person.Name
+	if fields := strings.Fields(person.Name); len(fields) > 0 {
+		firstName = fields[0]
+	}
+
+	return map[string]string{
+		domain.NoteTemplateVariablePersonName:      person.Name,
+		domain.NoteTemplateVariablePersonFirstName: firstName,
+		domain.NoteTemplateVariableManagerName:     manager.Name,
+		domain.NoteTemplateVariableCompanyName:     company.Name,
+		domain.NoteTemplateVariableDate:            today.Format("2006-01-02"),
+	}
+}
+
+// renderNoteTemplate replaces the variables of the sections and writes them as the content of a note,
+// each section as a markdown heading followed by its initial text
+func renderNoteTemplate(template entity.NoteTemplate, variables map[string]string) entity.NoteTemplateInstance {
+	replacements := make([]string, 0, len(variables)*2)
+	for variable, value := range variables {
+		replacements = append(replacements, variable, value)
+	}
+	replacer := strings.NewReplacer(replacements...)
+
+	instance := entity.NoteTemplateInstance{
+		Template: template,
+		Sections: make([]entity.NoteTemplateSection, len(template.Sections)),
+	}
+
+	blocks := make([]string, len(template.Sections))
+	for i, section := range template.Sections {
+		section.Title = replacer.Replace(section.Title)
+		section.Placeholder = replacer.Replace(section.Placeholder)
+		section.Content = replacer.Replace(section.Content)
+		instance.Sections[i] = section
+
+		blocks[i] = "## " + section.Title
+		if section.Content != "" {
+			blocks[i] += "\n" + section.Content
+		}
+	}
+	instance.Content = strings.Join(blocks, "\n\n")
+
+	return instance
+}
+
+// getAvailableTemplate loads a template by UUID with its sections and checks that the logged user can use it in the company of the context
+func (s *noteTemplateApp) getAvailableTemplate(ctx context.Context, templateUUID string) (entity.NoteTemplate, entity.Company, error) {
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return entity.NoteTemplate{}, company, err
+	}
+
+	template, err := s.dm.NoteTemplate().GetTemplateByUUID(ctx, templateUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return template, company, resterrors.NewNotFoundError("note template not found")
+		}
+		s.log.Errorw(ctx, "error getting note template by UUID", logger.Err(err))
+		return template, company, err
+	}
+
+	if !template.IsAvailableTo(company.ID, company.UserOwnerID) {
+		return template, company, resterrors.NewNotFoundError("note template not found")
+	}
+
+	template.Sections, err = s.dm.NoteTemplate().GetSectionsByTemplate(ctx, template.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting note template sections", logger.Err(err))
+		return template, company, err
+	}
+
+	return template, company, nil
+}
+
+// resolveNoteTemplate sets the template of a note written with one, checking that the user can use
+// the template in the company and that it is a template of the type of the note
+func (s *personApp) resolveNoteTemplate(ctx context.Context, companyID, userID int64, note *entity.Note) error {
+	if note.TemplateUUID == nil || *note.TemplateUUID == "" {
+		note.TemplateUUID = nil
+		return nil
+	}
+
+	template, err := s.dm.NoteTemplate().GetTemplateByUUID(ctx, *note.TemplateUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return resterrors.NewNotFoundError("note template not found")
+		}
+		s.log.Errorw(ctx, "error getting note template by UUID", logger.Err(err))
+		return err
+	}
+
+	if !template.IsAvailableTo(companyID, userID) {
+		return resterrors.NewNotFoundError("note template not found")
+	}
+
+	if template.NoteType != note.Type {
+		return resterrors.NewBadRequestError(fmt.Sprintf("the template is for %s notes", template.NoteType))
+	}
+
+	note.TemplateID = &template.ID
+	return nil
+}
+
+func (s *noteTemplateApp) CreateNoteTemplate(ctx context.Context, template entity.NoteTemplate, personal bool) (entity.NoteTemplate, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return template, err
+	}
+
+	if err := normalizeNoteTemplate(&template); err != nil {
+		return template, err
+	}
+
+	template.UUID = uuid.NewV4().String()
+	template.CompanyID = &company.ID
+	template.UserID = nil
+	if personal {
+		userID, err := s.authApp.GetLoggedUserID(ctx)
+		if err != nil {
+			return template, err
+		}
+		template.UserID = &userID
+	}
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		template.ID, err = tx.NoteTemplate().CreateTemplate(ctx, template)
+		if err != nil {
+			return err
+		}
+
+		for _, section := range template.Sections {
+			section.TemplateID = template.ID
+
+			_, err = tx.NoteTemplate().CreateSection(ctx, section)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error creating note template", logger.Err(err))
+		return template, err
+	}
+
+	s.log.Infow(ctx, "note template created successfully",
+		logger.String("template_uuid", template.UUID),
+		logger.String("note_type", template.NoteType),
+	)
+
+	template, _, err = s.getAvailableTemplate(ctx, template.UUID)
+	return template, err
+}
+
+func (s *noteTemplateApp) GetNoteTemplates(ctx context.Context, noteType string) ([]entity.NoteTemplate, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := s.dm.NoteTemplate().GetAvailableTemplates(ctx, company.ID, company.UserOwnerID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting note templates", logger.Err(err))
+		return nil, err
+	}
+
+	result := make([]entity.NoteTemplate, 0, len(templates))
+	for _, template := range templates {
+		if noteType != "" && template.NoteType != noteType {
+			continue
+		}
+
+		template.Sections, err = s.dm.NoteTemplate().GetSectionsByTemplate(ctx, template.ID)
+		if err != nil {
+			s.log.Errorw(ctx, "error getting note template sections", logger.Err(err))
+			return nil, err
+		}
+		result = append(result, template)
+	}
+
+	return result, nil
+}
+
+func (s *noteTemplateApp) GetNoteTemplate(ctx context.Context, templateUUID string) (entity.NoteTemplate, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	template, _, err := s.getAvailableTemplate(ctx, templateUUID)
+	return template, err
+}
+
+func (s *noteTemplateApp) DeleteNoteTemplate(ctx context.Context, templateUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	template, _, err := s.getAvailableTemplate(ctx, templateUUID)
+	if err != nil {
+		return err
+	}
+
+	if template.CompanyID == nil {
+		return resterrors.NewBadRequestError("built-in note templates can not be deleted")
+	}
+
+	err = s.dm.NoteTemplate().DeleteTemplate(ctx, template.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error deleting note template", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *noteTemplateApp) InstantiateNoteTemplate(ctx context.Context, templateUUID, personUUID string) (entity.NoteTemplateInstance, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	template, company, err := s.getAvailableTemplate(ctx, templateUUID)
+	if err != nil {
+		return entity.NoteTemplateInstance{}, err
+	}
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return entity.NoteTemplateInstance{}, err
+	}
+	if person.CompanyID != company.ID {
+		return entity.NoteTemplateInstance{}, resterrors.NewBadRequestError("person does not belong to this company")
+	}
+
+	manager, err := s.userApp.GetLoggedUser(ctx)
+	if err != nil {
+		return entity.NoteTemplateInstance{}, err
+	}
+
+	preferences, err := s.userApp.GetUserPreferences(ctx)
+	if err != nil {
+		return entity.NoteTemplateInstance{}, err
+	}
+
+	today := date.Today(time.Now(), preferences.Location())
+
+	return renderNoteTemplate(template, noteTemplateVariables(person, company, manager, today)), nil
+}
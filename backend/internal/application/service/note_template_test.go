@@ -0,0 +1,97 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_normalizeNoteTemplate(t *testing.T) {
+	newTemplate := func() entity.NoteTemplate {
+		return entity.NoteTemplate{
+			Name:     " Weekly 1:1 ",
+			NoteType: domain.NoteTypeOneOnOne,
+			Sections: []entity.NoteTemplateSection{
+				{Title: " Wins ", Placeholder: " What went well? "},
+				{Title: "Blockers", Content: " - "},
+			},
+		}
+	}
+
+	t.Run("Should trim the texts and set the positions", func(t *testing.T) {
+		template := newTemplate()
+
+		err := normalizeNoteTemplate(&template)
+		require.NoError(t, err)
+		require.Equal(t, "Weekly 1:1", template.Name)
+		require.Equal(t, "Wins", template.Sections[0].Title)
+		require.Equal(t, "What went well?", template.Sections[0].Placeholder)
+		require.Equal(t, 1, template.Sections[0].Position)
+		require.Equal(t, "-", template.Sections[1].Content)
+		require.Equal(t, 2, template.Sections[1].Position)
+	})
+
+	t.Run("Should return error when the name is empty", func(t *testing.T) {
+		template := newTemplate()
+		template.Name = "  "
+		require.Error(t, normalizeNoteTemplate(&template))
+	})
+
+	t.Run("Should return error when the note type is invalid", func(t *testing.T) {
+		template := newTemplate()
+		template.NoteType = "meeting"
+		require.Error(t, normalizeNoteTemplate(&template))
+	})
+
+	t.Run("Should return error when there are no sections", func(t *testing.T) {
+		template := newTemplate()
+		template.Sections = nil
+		require.Error(t, normalizeNoteTemplate(&template))
+	})
+
+	t.Run("Should return error when there are too many sections", func(t *testing.T) {
+		template := newTemplate()
+		for len(template.Sections) <= domain.NoteTemplateMaxSections {
+			template.Sections = append(template.Sections, entity.NoteTemplateSection{Title: "Section"})
+		}
+		require.Error(t, normalizeNoteTemplate(&template))
+	})
+
+	t.Run("Should return error when a section has no title", func(t *testing.T) {
+		template := newTemplate()
+		template.Sections[1].Title = " "
+		require.Error(t, normalizeNoteTemplate(&template))
+	})
+}
+
+func Test_renderNoteTemplate(t *testing.T) {
+	template := entity.NoteTemplate{
+		UUID:     "template-uuid",
+		NoteType: domain.NoteTypeOneOnOne,
+		Sections: []entity.NoteTemplateSection{
+			{Title: "Getting to know {{person_first_name}}", Placeholder: "What motivates {{person_first_name}}?", Position: 1},
+			{Title: "Agreements", Content: "{{manager_name}} and {{person_name}} at {{company_name}} on {{date}}", Position: 2},
+		},
+	}
+
+	variables := noteTemplateVariables(
+		entity.Person{Name: "Maria Silva"},
+		entity.Company{Name: "Acme"},
+		entity.User{Name: "Diego"},
+		time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC),
+	)
+
+	instance := renderNoteTemplate(template, variables)
+	require.Equal(t, "template-uuid", instance.Template.UUID)
+	require.Len(t, instance.Sections, 2)
+	require.Equal(t, "Getting to know Maria", instance.Sections[0].Title)
+	require.Equal(t, "What motivates Maria?", instance.Sections[0].Placeholder)
+	require.Equal(t, "Diego and Maria Silva at Acme on 2025-03-03", instance.Sections[1].Content)
+	require.Equal(t, "## Getting to know Maria\n\n## Agreements\nDiego and Maria Silva at Acme on 2025-03-03", instance.Content)
+
+	// The template itself is not changed
+	require.Contains(t, template.Sections[0].Title, domain.NoteTemplateVariablePersonFirstName)
+}
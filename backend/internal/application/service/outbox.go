@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/backoff"
+	"github.com/twinj/uuid"
+)
+
+// outboxHandler runs the side effect of an event, an error means the event is retried
+type outboxHandler func(ctx context.Context, event entity.OutboxEvent) error
+
+type outboxApp struct {
+	dm       contract.DataManager
+	log      logger.Logger
+	jobApp   contract.JobApp
+	handlers map[string]outboxHandler
+}
+
+func newOutboxApp(infra domain.Infrastructure, jobApp contract.JobApp) contract.OutboxApp {
+	s := &outboxApp{
+		dm:       infra.DataManager(),
+		log:      infra.Logger(),
+		jobApp:   jobApp,
+		handlers: make(map[string]outboxHandler),
+	}
+
+	s.handlers[domain.OutboxEventNoteAttributesExtraction] = s.enqueueJob(domain.JobQueueAI, domain.JobTypeNoteAttributesExtraction)
+	s.handlers[domain.OutboxEventPeopleImport] = s.enqueueJob(domain.JobQueueDefault, domain.JobTypePeopleImport)
+
+	return s
+}
+
+// newOutboxEvent returns a pending event with the payload encoded as JSON, due right away
+func newOutboxEvent(eventType string, payload any, now time.Time) (entity.OutboxEvent, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return entity.OutboxEvent{}, fmt.Errorf("error encoding the payload of the %s event: %w", eventType, err)
+	}
+
+	return entity.OutboxEvent{
+		UUID:          uuid.NewV4().String(),
+		EventType:     eventType,
+		Payload:       string(data),
+		Status:        domain.OutboxStatusPending,
+		MaxAttempts:   domain.OutboxMaxAttempts,
+		NextAttemptAt: now,
+	}, nil
+}
+
+// outboxRetryDelay returns how long to wait before retrying an event after its failed attempt,
+// doubling the delay on each attempt up to the max delay
+func outboxRetryDelay(attempts int) time.Duration {
+	return backoff.Exponential(attempts, domain.OutboxRetryBaseDelaySecs*time.Second, domain.OutboxRetryMaxDelaySecs*time.Second)
+}
+
+// loggedUserUUID returns the UUID of the logged user of the context, empty outside of a request
+func loggedUserUUID(ctx context.Context) string {
+	userUUID, _ := ctx.Value(infra.UserUUIDKey).(string)
+	return userUUID
+}
+
+func (s *outboxApp) ProcessOutbox(ctx context.Context) (processed int, err error) {
+	now := time.Now()
+
+	events, err := s.dm.Outbox().GetDueEvents(ctx, now, domain.OutboxBatchSize)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting due outbox events", logger.Err(err))
+		return processed, err
+	}
+
+	for _, event := range events {
+		err = s.dm.Outbox().ClaimEvent(ctx, event, now, now.Add(domain.OutboxLeaseSecs*time.Second))
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				continue // claimed by another worker
+			}
+			s.log.Errorw(ctx, "error claiming outbox event", logger.Err(err), logger.Int64("event_id", event.ID))
+			return processed, err
+		}
+		event.Attempts++
+
+		err = s.processEvent(ctx, event)
+		if err != nil {
+			return processed, err
+		}
+		processed++
+	}
+
+	return processed, nil
+}
+
+// processEvent runs the handler of a claimed event and records the result, scheduling a retry while the event has attempts left
+func (s *outboxApp) processEvent(ctx context.Context, event entity.OutboxEvent) error {
+	handlerErr := fmt.Errorf("no handler for outbox event type %s", event.EventType)
+	if handler, ok := s.handlers[event.EventType]; ok {
+		handlerErr = handler(ctx, event)
+	}
+
+	if handlerErr == nil {
+		err := s.dm.Outbox().MarkEventDone(ctx, event.ID)
+		if err != nil {
+			s.log.Errorw(ctx, "error marking outbox event as done", logger.Err(err), logger.Int64("event_id", event.ID))
+			return err
+		}
+		return nil
+	}
+
+	var nextAttemptAt *time.Time
+	if event.Attempts < event.MaxAttempts {
+		next := time.Now().Add(outboxRetryDelay(event.Attempts))
+		nextAttemptAt = &next
+
+		s.log.Warnw(ctx, "outbox event failed, it will be retried",
+			logger.Err(handlerErr),
+			logger.String("event_uuid", event.UUID),
+			logger.String("event_type", event.EventType),
+			logger.Int("attempts", event.Attempts),
+		)
+	} else {
+		s.log.Errorw(ctx, "outbox event failed with no attempts left",
+			logger.Err(handlerErr),
+			logger.String("event_uuid", event.UUID),
+			logger.String("event_type", event.EventType),
+			logger.Int("attempts", event.Attempts),
+		)
+	}
+
+	err := s.dm.Outbox().MarkEventFailed(ctx, event.ID, handlerErr.Error(), nextAttemptAt)
+	if err != nil {
+		s.log.Errorw(ctx, "error marking outbox event as failed", logger.Err(err), logger.Int64("event_id", event.ID))
+		return err
+	}
+
+	return nil
+}
+
+// enqueueJob returns a handler that hands the events over to the job queue, as jobs with the UUID and payload of the
+// event. The UUID makes the handoff idempotent when an event is processed again
+func (s *outboxApp) enqueueJob(queue, jobType string) outboxHandler {
+	return func(ctx context.Context, event entity.OutboxEvent) error {
+		return s.jobApp.EnqueueJob(ctx, entity.Job{
+			UUID:        event.UUID,
+			Queue:       queue,
+			Type:        jobType,
+			Payload:     event.Payload,
+			Status:      domain.JobStatusPending,
+			MaxAttempts: domain.JobMaxAttempts,
+			RunAt:       time.Now(),
+		})
+	}
+}
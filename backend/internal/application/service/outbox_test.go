@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_newOutboxEvent(t *testing.T) {
+	now := time.Now()
+
+	event, err := newOutboxEvent(domain.OutboxEventNoteAttributesExtraction, entity.NoteAttributesExtractionPayload{NoteID: 7, UserUUID: "user-uuid"}, now)
+	require.NoError(t, err)
+	require.NotEmpty(t, event.UUID)
+	require.Equal(t, domain.OutboxStatusPending, event.Status)
+	require.Equal(t, domain.OutboxMaxAttempts, event.MaxAttempts)
+	require.Equal(t, now, event.NextAttemptAt)
+
+	var payload entity.NoteAttributesExtractionPayload
+	require.NoError(t, json.Unmarshal([]byte(event.Payload), &payload))
+	require.Equal(t, int64(7), payload.NoteID)
+	require.Equal(t, "user-uuid", payload.UserUUID)
+
+	_, err = newOutboxEvent(domain.OutboxEventNoteAttributesExtraction, make(chan int), now)
+	require.Error(t, err)
+}
+
+func Test_outboxRetryDelay(t *testing.T) {
+	base := domain.OutboxRetryBaseDelaySecs * time.Second
+
+	require.Equal(t, base, outboxRetryDelay(1))
+	require.Equal(t, 2*base, outboxRetryDelay(2))
+	require.Equal(t, 4*base, outboxRetryDelay(3))
+	require.Equal(t, domain.OutboxRetryMaxDelaySecs*time.Second, outboxRetryDelay(50))
+}
+
+func Test_loggedUserUUID(t *testing.T) {
+	require.Empty(t, loggedUserUUID(context.Background()))
+	require.Equal(t, "user-uuid", loggedUserUUID(context.WithValue(context.Background(), infra.UserUUIDKey, "user-uuid")))
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/diegoclair/go_utils/logger"
@@ -16,20 +17,24 @@ import (
 )
 
 type personApp struct {
-	dm        contract.DataManager
-	log       logger.Logger
-	validator validator.Validator
-	authApp   contract.AuthApp
-	aiApp     contract.AIApp
+	cache      contract.CacheManager
+	dm         contract.DataManager
+	log        logger.Logger
+	validator  validator.Validator
+	noteSearch contract.NoteSearchEngine
+	authApp    contract.AuthApp
+	aiApp      contract.AIApp
 }
 
 func newPersonApp(infra domain.Infrastructure, authApp contract.AuthApp) *personApp {
 	return &personApp{
-		dm:        infra.DataManager(),
-		log:       infra.Logger(),
-		validator: infra.Validator(),
-		authApp:   authApp,
-		aiApp:     nil, // Will be set later via SetAIApp
+		cache:      infra.CacheManager(),
+		dm:         infra.DataManager(),
+		log:        infra.Logger(),
+		validator:  infra.Validator(),
+		noteSearch: infra.NoteSearchEngine(),
+		authApp:    authApp,
+		aiApp:      nil, // Will be set later via SetAIApp
 	}
 }
 
@@ -63,6 +68,62 @@ func (s *personApp) validateUserCompanyAccess(ctx context.Context, userID, compa
 	return company, nil
 }
 
+// getAuthorizedCompany loads the company from the request context and checks that the logged user owns it
+func (s *personApp) getAuthorizedCompany(ctx context.Context) (entity.Company, error) {
+	companyUUID, err := s.authApp.GetCompanyFromContext(ctx)
+	if err != nil {
+		return entity.Company{}, fmt.Errorf("failed to get company UUID: %w", err)
+	}
+
+	company, err := s.dm.Company().GetCompanyByUUID(ctx, companyUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return company, resterrors.NewNotFoundError("company not found")
+		}
+		s.log.Errorw(ctx, "error getting company by UUID", logger.Err(err))
+		return company, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return company, err
+	}
+
+	if company.UserOwnerID != userID {
+		s.log.Errorw(ctx, "user trying to access company they don't own",
+			logger.Int64("company_owner_id", company.UserOwnerID),
+			logger.Int64("logged_user_id", userID),
+		)
+		return company, resterrors.NewUnauthorizedError("you don't have permission to access this company")
+	}
+
+	return company, nil
+}
+
+// getAuthorizedPerson loads a person by UUID and checks that the logged user owns the person's company
+func (s *personApp) getAuthorizedPerson(ctx context.Context, personUUID string) (entity.Person, error) {
+	person, err := s.dm.Person().GetPersonByUUID(ctx, personUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return person, resterrors.NewNotFoundError("person not found")
+		}
+		s.log.Errorw(ctx, "error getting person by UUID", logger.Err(err))
+		return person, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return person, err
+	}
+
+	_, err = s.validateUserCompanyAccess(ctx, userID, person.CompanyID)
+	if err != nil {
+		return person, err
+	}
+
+	return person, nil
+}
+
 func (s *personApp) CreatePerson(ctx context.Context, person entity.Person) (entity.Person, error) {
 	s.log.Info(ctx, "Process Started")
 	defer s.log.Info(ctx, "Process Finished")
@@ -112,6 +173,8 @@ func (s *personApp) CreatePerson(ctx context.Context, person entity.Person) (ent
 		return person, err
 	}
 
+	invalidatePeopleSearchIndex(ctx, s.cache, s.log, company.ID)
+
 	// Set the ID and timestamps for the response
 	person.ID = personID
 	person.CreatedAt = time.Now()
@@ -231,13 +294,33 @@ func (s *personApp) UpdatePerson(ctx context.Context, personUUID string, person
 		return err
 	}
 
-	// Update the person
-	err = s.dm.Person().UpdatePerson(ctx, existingPerson.ID, person)
+	// Update the person, the changed fields go to the activity feed
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		err := tx.Person().UpdatePerson(ctx, existingPerson.ID, person)
+		if err != nil {
+			return err
+		}
+
+		changed := changedPersonFields(existingPerson, person)
+		if len(changed) == 0 {
+			return nil
+		}
+
+		return recordActivity(ctx, tx, entity.Activity{
+			CompanyID: existingPerson.CompanyID,
+			PersonID:  existingPerson.ID,
+			UserID:    &userID,
+			Type:      domain.ActivityTypeProfileUpdated,
+			Detail:    strings.Join(changed, ","),
+		})
+	})
 	if err != nil {
 		s.log.Errorw(ctx, "error updating person", logger.Err(err))
 		return err
 	}
 
+	invalidatePeopleSearchIndex(ctx, s.cache, s.log, existingPerson.CompanyID)
+
 	s.log.Infow(ctx, "person updated successfully",
 		logger.String("person_uuid", personUUID),
 		logger.String("person_name", person.Name),
@@ -280,6 +363,8 @@ func (s *personApp) DeletePerson(ctx context.Context, personUUID string) error {
 		return err
 	}
 
+	invalidatePeopleSearchIndex(ctx, s.cache, s.log, existingPerson.CompanyID)
+
 	s.log.Infow(ctx, "person deleted successfully",
 		logger.String("person_uuid", personUUID),
 		logger.String("person_name", existingPerson.Name),
@@ -290,56 +375,6 @@ func (s *personApp) DeletePerson(ctx context.Context, personUUID string) error {
 	return nil
 }
 
-func (s *personApp) SearchPeople(ctx context.Context, search string) ([]entity.Person, error) {
-	s.log.Info(ctx, "Process Started")
-	defer s.log.Info(ctx, "Process Finished")
-
-	// Get company UUID from context
-	companyUUID, err := s.authApp.GetCompanyFromContext(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get company UUID: %w", err)
-	}
-
-	// Get company by UUID and validate it belongs to the logged user
-	company, err := s.dm.Company().GetCompanyByUUID(ctx, companyUUID)
-	if err != nil {
-		if mysqlutils.SQLNotFound(err.Error()) {
-			return nil, resterrors.NewNotFoundError("company not found")
-		}
-		s.log.Errorw(ctx, "error getting company by UUID", logger.Err(err))
-		return nil, err
-	}
-
-	// Validate that the company belongs to the logged user
-	userID, err := s.authApp.GetLoggedUserID(ctx)
-	if err != nil {
-		return nil, err
-	}
-
-	if company.UserOwnerID != userID {
-		s.log.Errorw(ctx, "user trying to search people from company they don't own",
-			logger.Int64("company_owner_id", company.UserOwnerID),
-			logger.Int64("logged_user_id", userID),
-		)
-		return nil, resterrors.NewUnauthorizedError("you don't have permission to search people from this company")
-	}
-
-	// Search people by company ID and search term
-	people, err := s.dm.Person().SearchPeople(ctx, company.ID, search)
-	if err != nil {
-		s.log.Errorw(ctx, "error searching people", logger.Err(err))
-		return nil, err
-	}
-
-	s.log.Infow(ctx, "people search completed successfully",
-		logger.Int("people_count", len(people)),
-		logger.String("search_term", search),
-		logger.Int64("company_id", company.ID),
-	)
-
-	return people, nil
-}
-
 // CreateNote creates a new note for a person
 func (s *personApp) CreateNote(ctx context.Context, note entity.Note, personUUID string) (entity.Note, error) {
 	s.log.Info(ctx, "Process Started")
@@ -391,6 +426,35 @@ func (s *personApp) CreateNote(ctx context.Context, note entity.Note, personUUID
 		return note, err
 	}
 
+	if err := s.resolveNoteTemplate(ctx, company.ID, userID, &note); err != nil {
+		return note, err
+	}
+
+	// A 1:1 written directly as a note is registered as a held meeting
+	note, err = s.saveNote(ctx, company, person, userID, note, func(tx contract.DataManager, note entity.Note) error {
+		if !note.IsOneOnOne() {
+			return nil
+		}
+		return s.registerNoteMeeting(ctx, tx, note)
+	})
+	if err != nil {
+		return note, err
+	}
+
+	s.log.Infow(ctx, "note created successfully",
+		logger.String("note_uuid", note.UUID),
+		logger.String("note_type", note.Type),
+		logger.String("person_uuid", personUUID),
+	)
+
+	return note, nil
+}
+
+// saveNote creates the note, its mentions and starts the AI attributes extraction.
+// The company, the person and the note data must be already validated. withinTx, when not nil, runs in the same
+// transaction after the note is written, so the caller's own writes about the note are committed or rolled back with it
+func (s *personApp) saveNote(ctx context.Context, company entity.Company, person entity.Person, userID int64, note entity.Note,
+	withinTx func(tx contract.DataManager, note entity.Note) error) (entity.Note, error) {
 	// Set note fields
 	note.UUID = uuid.NewV4().String()
 	note.CompanyID = company.ID
@@ -399,119 +463,111 @@ func (s *personApp) CreateNote(ctx context.Context, note entity.Note, personUUID
 	note.CreatedAt = time.Now()
 	note.UpdatedAt = time.Now()
 
-	// Create note
-	noteID, err := s.dm.Note().CreateNote(ctx, note)
-	if err != nil {
-		s.log.Errorw(ctx, "error creating note", logger.Err(err))
-		return note, err
-	}
-
-	note.ID = noteID
-
-	// Automatically extract attributes using AI (asynchronous)
-	if s.aiApp != nil {
-		go func() {
-			// Create a new context for the background task
-			bgCtx := context.Background()
-			_, extractionErr := s.aiApp.ExtractAttributesFromNote(bgCtx, noteID)
-			if extractionErr != nil {
-				s.log.Warnw(bgCtx, "failed to extract attributes from note",
-					logger.Err(extractionErr),
-					logger.Int64("note_id", noteID),
-					logger.String("note_uuid", note.UUID),
-				)
-			} else {
-				s.log.Infow(bgCtx, "attributes extracted successfully from note",
-					logger.Int64("note_id", noteID),
-					logger.String("note_uuid", note.UUID),
-				)
-			}
-		}()
-	}
+	// The note, its first revision, its mentions, its tags and its side effects are written together,
+	// so a side effect is never lost and a note never exists without its mentions
+	var mentioned []entity.Person
+	var tagsCount int
+	err := s.dm.WithTransaction(ctx, func(tx contract.DataManager) (err error) {
+		note.ID, err = tx.Note().CreateNote(ctx, note)
+		if err != nil {
+			return err
+		}
 
-	// Process mentions from content
-	mentionedUUIDs := note.ExtractMentionUUIDs()
-	for _, mentionedUUID := range mentionedUUIDs {
-		// Get mentioned person to validate they exist and belong to same company
-		mentionedPerson, err := s.dm.Person().GetPersonByUUID(ctx, mentionedUUID)
+		_, err = tx.Note().CreateNoteRevision(ctx, newNoteRevision(note, userID))
 		if err != nil {
-			s.log.Warnw(ctx, "mentioned person not found, skipping mention",
-				logger.String("mentioned_uuid", mentionedUUID),
-				logger.Err(err),
-			)
-			continue
+			return err
 		}
 
-		// Validate mentioned person belongs to same company
-		if mentionedPerson.CompanyID != company.ID {
-			s.log.Warnw(ctx, "mentioned person not in same company, skipping mention",
-				logger.String("mentioned_uuid", mentionedUUID),
-				logger.Int64("mentioned_person_company", mentionedPerson.CompanyID),
-				logger.Int64("note_company", company.ID),
-			)
-			continue
+		mentioned, err = s.rebuildNoteMentions(ctx, tx, note)
+		if err != nil {
+			return err
 		}
 
-		// Create mention
-		mention := entity.NoteMention{
-			UUID:              uuid.NewV4().String(),
-			NoteID:            noteID,
-			MentionedPersonID: mentionedPerson.ID,
-			SourcePersonID:    person.ID,
-			FullContent:       note.Content,
-			CreatedAt:         time.Now(),
+		tagsCount, err = s.rebuildNoteTags(ctx, tx, note)
+		if err != nil {
+			return err
 		}
 
-		_, err = s.dm.Note().CreateNoteMention(ctx, mention)
+		err = recordNoteActivities(ctx, tx, note, person, mentioned)
 		if err != nil {
-			s.log.Errorw(ctx, "error creating note mention",
-				logger.Err(err),
-				logger.String("mentioned_person_uuid", mentionedUUID),
-			)
-			// Continue processing other mentions even if one fails
+			return err
+		}
+
+		if withinTx != nil {
+			err = withinTx(tx, note)
+			if err != nil {
+				return err
+			}
 		}
+
+		// Automatically extract attributes using AI, processed by the outbox worker
+		if s.aiApp != nil {
+			payload := entity.NoteAttributesExtractionPayload{NoteID: note.ID, UserUUID: loggedUserUUID(ctx)}
+			event, err := newOutboxEvent(domain.OutboxEventNoteAttributesExtraction, payload, time.Now())
+			if err != nil {
+				return err
+			}
+
+			_, err = tx.Outbox().CreateEvent(ctx, event)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error creating note", logger.Err(err))
+		return note, err
 	}
 
-	s.log.Infow(ctx, "note created successfully",
+	s.log.Infow(ctx, "note saved",
 		logger.String("note_uuid", note.UUID),
-		logger.String("note_type", note.Type),
-		logger.String("person_uuid", personUUID),
-		logger.Int("mentions_count", len(mentionedUUIDs)),
+		logger.Int("mentions_count", len(mentioned)),
+		logger.Int("tags_count", tagsCount),
 	)
 
 	return note, nil
 }
 
+// registerNoteMeeting registers a held meeting for a one_on_one note written without a scheduled meeting.
+// It runs in the transaction that writes the note
+func (s *personApp) registerNoteMeeting(ctx context.Context, tx contract.DataManager, note entity.Note) error {
+	meeting := entity.Meeting{
+		UUID:        uuid.NewV4().String(),
+		CompanyID:   note.CompanyID,
+		PersonID:    note.PersonID,
+		UserID:      note.UserID,
+		Status:      domain.MeetingStatusHeld,
+		Origin:      domain.MeetingOriginNote,
+		ScheduledAt: note.CreatedAt,
+		HeldAt:      &note.CreatedAt,
+		NoteID:      &note.ID,
+	}
+
+	_, err := tx.Meeting().CreateMeeting(ctx, meeting)
+	return err
+}
+
 // GetPersonTimeline gets the complete timeline (notes + mentions) for a person
 func (s *personApp) GetPersonTimeline(ctx context.Context, personUUID string, filters entity.TimelineFilters, take, skip int64) ([]entity.UnifiedTimelineEntry, int64, error) {
 	s.log.Info(ctx, "Process Started")
 	defer s.log.Info(ctx, "Process Finished")
 
-	// Get person and validate ownership
-	person, err := s.dm.Person().GetPersonByUUID(ctx, personUUID)
+	person, filters, err := s.preparePersonTimeline(ctx, personUUID, filters)
 	if err != nil {
-		if mysqlutils.SQLNotFound(err.Error()) {
-			return nil, 0, resterrors.NewNotFoundError("person not found")
-		}
-		s.log.Errorw(ctx, "error getting person by UUID", logger.Err(err))
 		return nil, 0, err
 	}
 
-	// Validate user has access to this person's company
-	userID, err := s.authApp.GetLoggedUserID(ctx)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	_, err = s.validateUserCompanyAccess(ctx, userID, person.CompanyID)
+	// Get unified timeline from repository
+	timeline, totalRecords, err := s.dm.Note().GetPersonTimeline(ctx, person.ID, filters, take, skip)
 	if err != nil {
+		s.log.Errorw(ctx, "error getting person timeline", logger.Err(err))
 		return nil, 0, err
 	}
 
-	// Get unified timeline from repository
-	timeline, totalRecords, err := s.dm.Note().GetPersonTimeline(ctx, person.ID, filters, take, skip)
+	err = s.renderTimeline(ctx, person.CompanyID, timeline)
 	if err != nil {
-		s.log.Errorw(ctx, "error getting person timeline", logger.Err(err))
 		return nil, 0, err
 	}
 
@@ -522,33 +578,84 @@ func (s *personApp) GetPersonTimeline(ctx context.Context, personUUID string, fi
 		logger.String("search_query", filters.SearchQuery),
 		logger.String("types", fmt.Sprintf("%v", filters.Types)),
 		logger.String("period", filters.Period),
+		logger.String("direction", filters.Direction),
 	)
 
 	return timeline, totalRecords, nil
 }
 
-// GetPersonMentions gets notes where this person was mentioned (feedbacks received)
-func (s *personApp) GetPersonMentions(ctx context.Context, personUUID string, take, skip int64) ([]entity.MentionEntry, int64, error) {
+// GetPersonTimelinePage returns the timeline of the person after the cursor of the page, with the same filters of GetPersonTimeline
+func (s *personApp) GetPersonTimelinePage(ctx context.Context, personUUID string, filters entity.TimelineFilters, page entity.CursorPage) ([]entity.UnifiedTimelineEntry, entity.CursorPageInfo, error) {
 	s.log.Info(ctx, "Process Started")
 	defer s.log.Info(ctx, "Process Finished")
 
-	// Get person and validate ownership
-	person, err := s.dm.Person().GetPersonByUUID(ctx, personUUID)
+	person, filters, err := s.preparePersonTimeline(ctx, personUUID, filters)
 	if err != nil {
-		if mysqlutils.SQLNotFound(err.Error()) {
-			return nil, 0, resterrors.NewNotFoundError("person not found")
-		}
-		s.log.Errorw(ctx, "error getting person by UUID", logger.Err(err))
-		return nil, 0, err
+		return nil, entity.CursorPageInfo{}, err
 	}
 
-	// Validate user has access to this person's company
-	userID, err := s.authApp.GetLoggedUserID(ctx)
+	timeline, info, err := s.dm.Note().GetPersonTimelinePage(ctx, person.ID, filters, page)
 	if err != nil {
-		return nil, 0, err
+		s.log.Errorw(ctx, "error getting person timeline page", logger.Err(err))
+		return nil, info, err
 	}
 
-	_, err = s.validateUserCompanyAccess(ctx, userID, person.CompanyID)
+	err = s.renderTimeline(ctx, person.CompanyID, timeline)
+	if err != nil {
+		return nil, info, err
+	}
+
+	s.log.Infow(ctx, "timeline page retrieved successfully",
+		logger.String("person_uuid", personUUID),
+		logger.Int("returned_records", len(timeline)),
+		logger.Bool("has_next", info.Next != nil),
+	)
+
+	return timeline, info, nil
+}
+
+// preparePersonTimeline loads the person of the timeline checking the access of the logged user, and normalizes the filters
+func (s *personApp) preparePersonTimeline(ctx context.Context, personUUID string, filters entity.TimelineFilters) (entity.Person, entity.TimelineFilters, error) {
+	person, err := s.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return person, filters, err
+	}
+
+	filters.Tags, err = normalizeTagNames(filters.Tags)
+	if err != nil {
+		return person, filters, err
+	}
+
+	filters, err = s.normalizeTimelineFilters(ctx, filters, time.Now())
+	if err != nil {
+		return person, filters, err
+	}
+
+	return person, filters, nil
+}
+
+// renderTimeline fills the tags of the entries and renders their mentions with the current names
+func (s *personApp) renderTimeline(ctx context.Context, companyID int64, timeline []entity.UnifiedTimelineEntry) error {
+	err := s.fillTimelineTags(ctx, timeline)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting timeline tags", logger.Err(err))
+		return err
+	}
+
+	names := s.currentPersonNames(ctx, companyID)
+	for i := range timeline {
+		timeline[i].Content = entity.RenderMentions(timeline[i].Content, names)
+		timeline[i].Mentions = entity.ParseMentions(timeline[i].Content)
+	}
+
+	return nil
+}
+
+func (s *personApp) GetPersonMentions(ctx context.Context, personUUID string, take, skip int64) ([]entity.MentionEntry, int64, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.getAuthorizedPerson(ctx, personUUID)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -560,6 +667,8 @@ func (s *personApp) GetPersonMentions(ctx context.Context, personUUID string, ta
 		return nil, 0, err
 	}
 
+	s.renderMentionEntries(ctx, person.CompanyID, mentions)
+
 	s.log.Infow(ctx, "mentions retrieved successfully",
 		logger.String("person_uuid", personUUID),
 		logger.Int64("total_records", totalRecords),
@@ -569,6 +678,58 @@ func (s *personApp) GetPersonMentions(ctx context.Context, personUUID string, ta
 	return mentions, totalRecords, nil
 }
 
+// GetPersonMentionsPage returns the mentions of the person after the cursor of the page, newest first
+func (s *personApp) GetPersonMentionsPage(ctx context.Context, personUUID string, page entity.CursorPage) ([]entity.MentionEntry, entity.CursorPageInfo, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return nil, entity.CursorPageInfo{}, err
+	}
+
+	mentions, info, err := s.dm.Note().GetPersonMentionsPage(ctx, person.ID, page)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting person mentions page", logger.Err(err))
+		return nil, info, err
+	}
+
+	s.renderMentionEntries(ctx, person.CompanyID, mentions)
+
+	s.log.Infow(ctx, "mentions page retrieved successfully",
+		logger.String("person_uuid", personUUID),
+		logger.Int("returned_records", len(mentions)),
+		logger.Bool("has_next", info.Next != nil),
+	)
+
+	return mentions, info, nil
+}
+
+// renderMentionEntries renders the mentions of the entries with the current names
+func (s *personApp) renderMentionEntries(ctx context.Context, companyID int64, mentions []entity.MentionEntry) {
+	names := s.currentPersonNames(ctx, companyID)
+	for i := range mentions {
+		mentions[i].Content = entity.RenderMentions(mentions[i].Content, names)
+		mentions[i].Mentions = entity.ParseMentions(mentions[i].Content)
+	}
+}
+
+// currentPersonNames returns the current name of each person of the company by UUID, to render
+// the mentions with the name the person has now. Without the names the mentions keep the written ones
+func (s *personApp) currentPersonNames(ctx context.Context, companyID int64) map[string]string {
+	people, err := s.dm.Person().GetPersonsByCompany(ctx, companyID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting people to render mentions", logger.Err(err))
+		return nil
+	}
+
+	names := make(map[string]string, len(people))
+	for _, person := range people {
+		names[person.UUID] = person.Name
+	}
+	return names
+}
+
 // UpdateNote updates an existing note
 func (s *personApp) UpdateNote(ctx context.Context, noteUUID string, updatedNote entity.Note) error {
 	s.log.Info(ctx, "Process Started")
@@ -609,55 +770,36 @@ func (s *personApp) UpdateNote(ctx context.Context, noteUUID string, updatedNote
 	updatedNote.CreatedAt = existingNote.CreatedAt
 	updatedNote.UpdatedAt = time.Now()
 
-	// Update note
-	err = s.dm.Note().UpdateNote(ctx, existingNote.ID, updatedNote)
-	if err != nil {
-		s.log.Errorw(ctx, "error updating note", logger.Err(err))
-		return err
-	}
-
-	// Handle mention updates: delete old mentions and create new ones
-	err = s.dm.Note().DeleteMentionsByNote(ctx, existingNote.ID)
-	if err != nil {
-		s.log.Errorw(ctx, "error deleting old mentions", logger.Err(err))
-		// Continue with update even if mention deletion fails
-	}
-
-	// Process new mentions
-	mentionedUUIDs := updatedNote.ExtractMentionUUIDs()
-	for _, mentionedUUID := range mentionedUUIDs {
-		mentionedPerson, err := s.dm.Person().GetPersonByUUID(ctx, mentionedUUID)
+	// Update note, keeping the edit as a new revision
+	revision := newNoteRevision(updatedNote, userID)
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		err := tx.Note().UpdateNote(ctx, existingNote.ID, updatedNote)
 		if err != nil {
-			s.log.Warnw(ctx, "mentioned person not found during update, skipping mention",
-				logger.String("mentioned_uuid", mentionedUUID),
-				logger.Err(err),
-			)
-			continue
+			return err
 		}
 
-		if mentionedPerson.CompanyID != existingNote.CompanyID {
-			s.log.Warnw(ctx, "mentioned person not in same company during update, skipping mention",
-				logger.String("mentioned_uuid", mentionedUUID),
-			)
-			continue
+		if !newNoteRevision(existingNote, userID).SameContent(revision) {
+			_, err = tx.Note().CreateNoteRevision(ctx, revision)
+			if err != nil {
+				return err
+			}
 		}
 
-		mention := entity.NoteMention{
-			UUID:              uuid.NewV4().String(),
-			NoteID:            existingNote.ID,
-			MentionedPersonID: mentionedPerson.ID,
-			SourcePersonID:    existingNote.PersonID,
-			FullContent:       updatedNote.Content,
-			CreatedAt:         time.Now(),
+		_, err = s.rebuildNoteMentions(ctx, tx, updatedNote)
+		if err != nil {
+			return err
 		}
 
-		_, err = s.dm.Note().CreateNoteMention(ctx, mention)
+		_, err = s.rebuildNoteTags(ctx, tx, updatedNote)
 		if err != nil {
-			s.log.Errorw(ctx, "error creating mention during update",
-				logger.Err(err),
-				logger.String("mentioned_person_uuid", mentionedUUID),
-			)
+			return err
 		}
+
+		return s.syncNoteMeeting(ctx, tx, existingNote.IsOneOnOne(), updatedNote)
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error updating note", logger.Err(err))
+		return err
 	}
 
 	s.log.Infow(ctx, "note updated successfully",
@@ -694,8 +836,15 @@ func (s *personApp) DeleteNote(ctx context.Context, noteUUID string) error {
 		return err
 	}
 
-	// Delete note (this should cascade delete mentions via foreign key)
-	err = s.dm.Note().DeleteNote(ctx, existingNote.ID)
+	// Delete note (this should cascade delete mentions via foreign key) with its registered meetings
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		err := tx.Note().DeleteNote(ctx, existingNote.ID)
+		if err != nil || !existingNote.IsOneOnOne() {
+			return err
+		}
+
+		return s.deleteNoteMeetings(ctx, tx, existingNote)
+	})
 	if err != nil {
 		s.log.Errorw(ctx, "error deleting note", logger.Err(err))
 		return err
@@ -708,3 +857,194 @@ func (s *personApp) DeleteNote(ctx context.Context, noteUUID string) error {
 
 	return nil
 }
+
+// deleteNoteMeetings deletes the meetings registered from a one_on_one note. Scheduled meetings are kept.
+// It runs in the transaction that writes the note
+func (s *personApp) deleteNoteMeetings(ctx context.Context, tx contract.DataManager, note entity.Note) error {
+	return tx.Meeting().DeleteMeetingsFromNote(ctx, note.ID)
+}
+
+// syncNoteMeeting keeps the registered meeting in sync when the note becomes or stops being a 1:1.
+// It runs in the transaction that writes the note
+func (s *personApp) syncNoteMeeting(ctx context.Context, tx contract.DataManager, wasOneOnOne bool, note entity.Note) error {
+	switch {
+	case wasOneOnOne == note.IsOneOnOne():
+		return nil
+	case note.IsOneOnOne():
+		return s.registerNoteMeeting(ctx, tx, note)
+	default:
+		return s.deleteNoteMeetings(ctx, tx, note)
+	}
+}
+
+// GetPersonAttributes returns all the attributes of a person with their provenance
+func (s *personApp) GetPersonAttributes(ctx context.Context, personUUID string) ([]entity.PersonAttribute, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := s.dm.Person().GetPersonAttributes(ctx, person.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting person attributes", logger.Err(err))
+		return nil, err
+	}
+
+	return attributes, nil
+}
+
+// GetPersonAttributesAsOf returns the attributes of a person as they were at the given date
+func (s *personApp) GetPersonAttributesAsOf(ctx context.Context, personUUID string, asOf time.Time) ([]entity.PersonAttribute, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := s.dm.Person().GetPersonAttributesAsOf(ctx, person.ID, asOf)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting person attributes as of date", logger.Err(err))
+		return nil, err
+	}
+
+	attributes := make([]entity.PersonAttribute, 0, len(versions))
+	for _, version := range versions {
+		attributes = append(attributes, version.ToAttribute())
+	}
+
+	return attributes, nil
+}
+
+// GetPersonAttributeHistory returns every value an attribute had, newest first
+func (s *personApp) GetPersonAttributeHistory(ctx context.Context, personUUID, attributeKey string) ([]entity.PersonAttributeHistory, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.dm.Person().GetPersonAttributeHistory(ctx, person.ID, attributeKey)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting person attribute history", logger.Err(err))
+		return nil, err
+	}
+
+	if len(history) == 0 {
+		return nil, resterrors.NewNotFoundError("attribute not found")
+	}
+
+	return history, nil
+}
+
+// UpdatePersonAttribute sets an attribute value manually. Manual values have full confidence
+// and are never overwritten by the AI extraction
+func (s *personApp) UpdatePersonAttribute(ctx context.Context, personUUID, attributeKey, value string) (entity.PersonAttribute, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	attributeKey = strings.TrimSpace(attributeKey)
+	value = strings.TrimSpace(value)
+	if attributeKey == "" {
+		return entity.PersonAttribute{}, resterrors.NewBadRequestError("attribute key is required")
+	}
+	if value == "" {
+		return entity.PersonAttribute{}, resterrors.NewBadRequestError("attribute value is required")
+	}
+
+	person, err := s.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return entity.PersonAttribute{}, err
+	}
+
+	confidence := 1.0
+	attr := entity.PersonAttribute{
+		PersonID:       person.ID,
+		AttributeKey:   attributeKey,
+		AttributeValue: value,
+		Source:         domain.AttributeSourceManual,
+		Confidence:     &confidence,
+	}
+
+	previous, err := s.dm.Person().GetPersonAttribute(ctx, person.ID, attributeKey)
+	if err != nil && !mysqlutils.SQLNotFound(err.Error()) {
+		s.log.Errorw(ctx, "error getting current person attribute", logger.Err(err))
+		return entity.PersonAttribute{}, err
+	}
+	changed := err != nil || previous.AttributeValue != value || !previous.IsManual()
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		err := tx.Person().UpsertPersonAttribute(ctx, attr)
+		if err != nil {
+			return err
+		}
+
+		if !changed {
+			return nil
+		}
+
+		return tx.Person().AddPersonAttributeHistory(ctx, attr, time.Now())
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error updating person attribute", logger.Err(err))
+		return entity.PersonAttribute{}, err
+	}
+
+	invalidatePeopleSearchIndex(ctx, s.cache, s.log, person.CompanyID)
+
+	attr, err = s.dm.Person().GetPersonAttribute(ctx, person.ID, attributeKey)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting updated person attribute", logger.Err(err))
+		return entity.PersonAttribute{}, err
+	}
+
+	s.log.Infow(ctx, "person attribute updated successfully",
+		logger.String("person_uuid", personUUID),
+		logger.String("attribute_key", attributeKey),
+	)
+
+	return attr, nil
+}
+
+// DeletePersonAttribute removes an attribute from a person, whatever its source
+func (s *personApp) DeletePersonAttribute(ctx context.Context, personUUID, attributeKey string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		err := tx.Person().DeletePersonAttribute(ctx, person.ID, attributeKey)
+		if err != nil {
+			return err
+		}
+
+		// the history is kept, only the current version is closed
+		return tx.Person().ClosePersonAttributeHistory(ctx, person.ID, attributeKey, time.Now())
+	})
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return resterrors.NewNotFoundError("attribute not found")
+		}
+		s.log.Errorw(ctx, "error deleting person attribute", logger.Err(err))
+		return err
+	}
+
+	invalidatePeopleSearchIndex(ctx, s.cache, s.log, person.CompanyID)
+
+	s.log.Infow(ctx, "person attribute deleted successfully",
+		logger.String("person_uuid", personUUID),
+		logger.String("attribute_key", attributeKey),
+	)
+
+	return nil
+}
@@ -0,0 +1,598 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/spreadsheet"
+	"github.com/diegoclair/leaderpro/util/text"
+	"github.com/twinj/uuid"
+)
+
+const maxPersonImportRows = 1000
+
+// personImportFieldAliases are the column headers (already normalized) recognized by the automatic mapping
+var personImportFieldAliases = map[string][]string{
+	domain.PersonImportFieldName:         {"name", "nome", "nome completo", "full name"},
+	domain.PersonImportFieldEmail:        {"email", "e mail"},
+	domain.PersonImportFieldPosition:     {"position", "cargo", "funcao", "title", "job title"},
+	domain.PersonImportFieldDepartment:   {"department", "departamento", "area", "time", "team"},
+	domain.PersonImportFieldPhone:        {"phone", "telefone", "celular", "whatsapp"},
+	domain.PersonImportFieldBirthday:     {"birthday", "aniversario", "data de nascimento", "nascimento", "birth date"},
+	domain.PersonImportFieldStartDate:    {"start date", "data de admissao", "admissao", "data de inicio", "inicio"},
+	domain.PersonImportFieldIsManager:    {"is manager", "gestor", "e gestor", "lider", "manager"},
+	domain.PersonImportFieldManagerEmail: {"manager email", "email do gestor", "e mail do gestor", "gestor email", "email gestor"},
+	domain.PersonImportFieldGender:       {"gender", "genero", "sexo"},
+	domain.PersonImportFieldNotes:        {"notes", "observacoes", "notas", "obs"},
+	domain.PersonImportFieldCity:         {"city", "cidade"},
+	domain.PersonImportFieldState:        {"state", "estado", "uf"},
+	domain.PersonImportFieldCountry:      {"country", "pais"},
+}
+
+var personImportGenders = map[string]string{
+	"male": "male", "masculino": "male", "m": "male",
+	"female": "female", "feminino": "female", "f": "female",
+	"other": "other", "outro": "other", "outros": "other",
+}
+
+var personImportDateLayouts = []string{time.DateOnly, "02/01/2006", "2/1/2006", "02-01-2006"}
+
+// ImportPeople validates every row of a CSV or XLSX file to create people in bulk. The people are only created
+// when all rows are valid, by the people import job, inside a single transaction: the import is pending until then.
+// With DryRun nothing is created, the result only reports what would happen
+func (s *personApp) ImportPeople(ctx context.Context, input entity.PersonImportInput) (entity.PersonImportResult, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.getAuthorizedCompany(ctx)
+	if err != nil {
+		return entity.PersonImportResult{}, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return entity.PersonImportResult{}, err
+	}
+
+	rows, _, err := s.readPersonImportRows(ctx, company.ID, input.FileName, input.Data, input.Mapping)
+	if err != nil {
+		return entity.PersonImportResult{}, err
+	}
+
+	result := entity.PersonImportResult{
+		Import: entity.PersonImport{
+			UUID:      uuid.NewV4().String(),
+			CompanyID: company.ID,
+			UserID:    userID,
+			FileName:  input.FileName,
+			DryRun:    input.DryRun,
+			Status:    domain.PersonImportStatusCompleted,
+			TotalRows: len(rows),
+			ErrorRows: countPersonImportErrors(rows),
+			CreatedAt: time.Now(),
+		},
+		Rows: rows,
+	}
+
+	switch {
+	case result.Import.ErrorRows > 0:
+		result.Import.Status = domain.PersonImportStatusFailed
+		setPersonImportRowsStatus(result.Rows, domain.PersonImportRowStatusSkipped)
+
+	case input.DryRun:
+		setPersonImportRowsStatus(result.Rows, domain.PersonImportRowStatusValid)
+
+	default:
+		result.Import.Status = domain.PersonImportStatusPending
+		result.Import.FileData = input.Data
+		setPersonImportRowsStatus(result.Rows, domain.PersonImportRowStatusValid)
+
+		err = s.createPendingPersonImport(ctx, &result.Import, input.Mapping)
+		if err != nil {
+			s.log.Errorw(ctx, "error creating pending person import", logger.Err(err))
+			return entity.PersonImportResult{}, err
+		}
+
+		s.log.Infow(ctx, "people import queued",
+			logger.String("import_uuid", result.Import.UUID),
+			logger.Int64("company_id", company.ID),
+			logger.Int("total_rows", result.Import.TotalRows),
+		)
+
+		return result, nil
+	}
+
+	result.Import.Report, err = buildPersonImportReport(result.Rows)
+	if err != nil {
+		s.log.Errorw(ctx, "error building person import report", logger.Err(err))
+		return entity.PersonImportResult{}, err
+	}
+
+	result.Import.ID, err = s.dm.Person().CreatePersonImport(ctx, result.Import)
+	if err != nil {
+		// nothing was created, the only loss is the downloadable report
+		s.log.Errorw(ctx, "error saving person import", logger.Err(err))
+	}
+
+	s.log.Infow(ctx, "people import processed",
+		logger.String("import_uuid", result.Import.UUID),
+		logger.Int64("company_id", company.ID),
+		logger.Bool("dry_run", input.DryRun),
+		logger.String("status", result.Import.Status),
+		logger.Int("total_rows", result.Import.TotalRows),
+		logger.Int("error_rows", result.Import.ErrorRows),
+	)
+
+	return result, nil
+}
+
+// createPendingPersonImport saves the import with the event that queues its job, so a saved import is always processed
+func (s *personApp) createPendingPersonImport(ctx context.Context, personImport *entity.PersonImport, mapping map[string]string) error {
+	payload := entity.PersonImportPayload{ImportUUID: personImport.UUID, Mapping: mapping}
+	event, err := newOutboxEvent(domain.OutboxEventPeopleImport, payload, time.Now())
+	if err != nil {
+		return err
+	}
+
+	return s.dm.WithTransaction(ctx, func(tx contract.DataManager) (err error) {
+		personImport.ID, err = tx.Person().CreatePersonImport(ctx, *personImport)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Outbox().CreateEvent(ctx, event)
+		return err
+	})
+}
+
+// processPersonImport creates the people of a pending import, it runs in the people import job.
+// The file is validated again, as the people of the company may have changed since the upload,
+// and the people are created with the result of the import in a single transaction
+func (s *personApp) processPersonImport(ctx context.Context, payload entity.PersonImportPayload) error {
+	personImport, err := s.dm.Person().GetPersonImportByUUID(ctx, payload.ImportUUID)
+	if err != nil {
+		return err
+	}
+
+	if personImport.Status != domain.PersonImportStatusPending {
+		// processed by an earlier attempt of the job
+		return nil
+	}
+
+	rows, existingPeople, err := s.readPersonImportRows(ctx, personImport.CompanyID, personImport.FileName, personImport.FileData, payload.Mapping)
+	if err != nil {
+		return err
+	}
+
+	personImport.TotalRows = len(rows)
+	personImport.ErrorRows = countPersonImportErrors(rows)
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) (err error) {
+		if personImport.ErrorRows > 0 {
+			personImport.Status = domain.PersonImportStatusFailed
+			setPersonImportRowsStatus(rows, domain.PersonImportRowStatusSkipped)
+		} else {
+			err = createImportedPeople(ctx, tx, personImport.CompanyID, personImport.UserID, rows, existingPeople)
+			if err != nil {
+				return err
+			}
+
+			personImport.Status = domain.PersonImportStatusCompleted
+			personImport.ImportedRows = len(rows)
+			setPersonImportRowsStatus(rows, domain.PersonImportRowStatusCreated)
+		}
+
+		personImport.Report, err = buildPersonImportReport(rows)
+		if err != nil {
+			return err
+		}
+
+		return tx.Person().UpdatePersonImportResult(ctx, personImport)
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error processing person import", logger.Err(err), logger.String("import_uuid", personImport.UUID))
+		return err
+	}
+
+	if personImport.ImportedRows > 0 {
+		invalidatePeopleSearchIndex(ctx, s.cache, s.log, personImport.CompanyID)
+	}
+
+	s.log.Infow(ctx, "people import processed",
+		logger.String("import_uuid", personImport.UUID),
+		logger.Int64("company_id", personImport.CompanyID),
+		logger.String("status", personImport.Status),
+		logger.Int("total_rows", personImport.TotalRows),
+		logger.Int("error_rows", personImport.ErrorRows),
+	)
+
+	return nil
+}
+
+// readPersonImportRows reads the file and validates each row against the people of the company
+func (s *personApp) readPersonImportRows(ctx context.Context, companyID int64, fileName string, data []byte, mapping map[string]string) ([]entity.PersonImportRow, []entity.Person, error) {
+	records, err := spreadsheet.Read(fileName, data)
+	if err != nil {
+		return nil, nil, resterrors.NewBadRequestError(err.Error())
+	}
+
+	if len(records)-1 > maxPersonImportRows {
+		return nil, nil, resterrors.NewBadRequestError(fmt.Sprintf("the file can have at most %d rows", maxPersonImportRows))
+	}
+
+	columns, err := resolvePersonImportColumns(records[0], mapping)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	existingPeople, err := s.dm.Person().GetPersonsByCompany(ctx, companyID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting company people", logger.Err(err))
+		return nil, nil, err
+	}
+
+	return s.parsePersonImportRows(records[1:], columns, existingPeople), existingPeople, nil
+}
+
+// GetPersonImport returns an import of the logged user's company, including its report
+func (s *personApp) GetPersonImport(ctx context.Context, importUUID string) (entity.PersonImport, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.getAuthorizedCompany(ctx)
+	if err != nil {
+		return entity.PersonImport{}, err
+	}
+
+	personImport, err := s.dm.Person().GetPersonImportByUUID(ctx, importUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return personImport, resterrors.NewNotFoundError("import not found")
+		}
+		s.log.Errorw(ctx, "error getting person import by UUID", logger.Err(err))
+		return personImport, err
+	}
+
+	if personImport.CompanyID != company.ID {
+		return entity.PersonImport{}, resterrors.NewNotFoundError("import not found")
+	}
+
+	return personImport, nil
+}
+
+// createImportedPeople creates the people of the valid rows, it runs in the transaction that saves the result of the import
+func createImportedPeople(ctx context.Context, tx contract.DataManager, companyID, userID int64, rows []entity.PersonImportRow, existingPeople []entity.Person) error {
+	peopleByEmail := make(map[string]int64, len(existingPeople)+len(rows))
+	for _, person := range existingPeople {
+		if person.Email != "" {
+			peopleByEmail[strings.ToLower(person.Email)] = person.ID
+		}
+	}
+
+	for i := range rows {
+		person := &rows[i].Person
+		person.UUID = uuid.NewV4().String()
+		person.CompanyID = companyID
+		person.CreatedBy = userID
+		person.Active = true
+
+		if managerID, ok := peopleByEmail[rows[i].ManagerEmail]; ok {
+			person.ManagerID = &managerID
+		}
+
+		personID, err := tx.Person().CreatePerson(ctx, *person)
+		if err != nil {
+			return err
+		}
+		person.ID = personID
+
+		if person.Email != "" {
+			peopleByEmail[strings.ToLower(person.Email)] = personID
+		}
+
+		if rows[i].Address != nil {
+			rows[i].Address.UUID = uuid.NewV4().String()
+			rows[i].Address.PersonID = personID
+			_, err = tx.Person().CreatePersonAddress(ctx, *rows[i].Address)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// managers that are in the same file may come after their team, so they are linked at the end
+	for i := range rows {
+		if rows[i].ManagerEmail == "" || rows[i].Person.ManagerID != nil {
+			continue
+		}
+
+		managerID, ok := peopleByEmail[rows[i].ManagerEmail]
+		if !ok {
+			return fmt.Errorf("manager %s not found for line %d", rows[i].ManagerEmail, rows[i].Line)
+		}
+
+		rows[i].Person.ManagerID = &managerID
+		err := tx.Person().UpdatePersonManager(ctx, rows[i].Person.ID, &managerID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolvePersonImportColumns returns the column index of each mapped field
+func resolvePersonImportColumns(header []string, mapping map[string]string) (map[string]int, error) {
+	headerIndex := make(map[string]int, len(header))
+	for i, column := range header {
+		normalized := text.Normalize(column)
+		if _, exists := headerIndex[normalized]; !exists {
+			headerIndex[normalized] = i
+		}
+	}
+
+	columns := make(map[string]int)
+	if len(mapping) > 0 {
+		for field, column := range mapping {
+			if _, ok := personImportFieldAliases[field]; !ok {
+				return nil, resterrors.NewBadRequestError(fmt.Sprintf("unknown field %q in the mapping", field))
+			}
+
+			index, ok := headerIndex[text.Normalize(column)]
+			if !ok {
+				return nil, resterrors.NewBadRequestError(fmt.Sprintf("column %q of field %q not found in the file", column, field))
+			}
+			columns[field] = index
+		}
+	} else {
+		for field, aliases := range personImportFieldAliases {
+			for _, alias := range aliases {
+				if index, ok := headerIndex[alias]; ok {
+					columns[field] = index
+					break
+				}
+			}
+		}
+	}
+
+	if _, ok := columns[domain.PersonImportFieldName]; !ok {
+		return nil, resterrors.NewBadRequestError("the name column is required, check the file header or the mapping")
+	}
+
+	return columns, nil
+}
+
+func (s *personApp) parsePersonImportRows(records [][]string, columns map[string]int, existingPeople []entity.Person) []entity.PersonImportRow {
+	existingEmails := make(map[string]bool, len(existingPeople))
+	for _, person := range existingPeople {
+		if person.Email != "" {
+			existingEmails[strings.ToLower(person.Email)] = true
+		}
+	}
+
+	rows := make([]entity.PersonImportRow, 0, len(records))
+	fileEmails := make(map[string]int)
+	for i, record := range records {
+		row := s.parsePersonImportRow(record, columns)
+		row.Line = i + 2 // the header is the line 1
+
+		email := strings.ToLower(row.Person.Email)
+		if email != "" {
+			if existingEmails[email] {
+				row.AddError("a person with this email already exists in the company")
+			}
+			if line, ok := fileEmails[email]; ok {
+				row.AddError(fmt.Sprintf("email duplicated in line %d", line))
+			} else {
+				fileEmails[email] = row.Line
+			}
+		}
+
+		rows = append(rows, row)
+	}
+
+	// the manager can be an existing person or someone else in the file
+	for i := range rows {
+		managerEmail := rows[i].ManagerEmail
+		if managerEmail == "" {
+			continue
+		}
+
+		if managerEmail == strings.ToLower(rows[i].Person.Email) {
+			rows[i].AddError("a person cannot be their own manager")
+			continue
+		}
+
+		if _, inFile := fileEmails[managerEmail]; !inFile && !existingEmails[managerEmail] {
+			rows[i].AddError(fmt.Sprintf("manager with email %s not found", managerEmail))
+		}
+	}
+
+	return rows
+}
+
+func (s *personApp) parsePersonImportRow(record []string, columns map[string]int) entity.PersonImportRow {
+	row := entity.PersonImportRow{}
+	value := func(field string) string {
+		index, ok := columns[field]
+		if !ok || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	person := entity.Person{
+		Name:       value(domain.PersonImportFieldName),
+		Email:      strings.ToLower(value(domain.PersonImportFieldEmail)),
+		Position:   value(domain.PersonImportFieldPosition),
+		Department: value(domain.PersonImportFieldDepartment),
+		Phone:      value(domain.PersonImportFieldPhone),
+		Notes:      value(domain.PersonImportFieldNotes),
+	}
+
+	nameLength := len([]rune(person.Name))
+	if nameLength < 2 || nameLength > 450 {
+		row.AddError("name is required and must have between 2 and 450 characters")
+	}
+
+	if person.Email != "" && s.validator.Var(person.Email, "email") != nil {
+		row.AddError(fmt.Sprintf("invalid email %q", person.Email))
+	}
+
+	if len(person.Phone) > 20 {
+		row.AddError("phone must have at most 20 characters")
+	}
+
+	if person.Position != "" && len([]rune(person.Position)) > 200 {
+		row.AddError("position must have at most 200 characters")
+	}
+
+	if person.Department != "" && len([]rune(person.Department)) > 200 {
+		row.AddError("department must have at most 200 characters")
+	}
+
+	var err error
+	person.Birthday, err = parsePersonImportDate(value(domain.PersonImportFieldBirthday))
+	if err != nil {
+		row.AddError(fmt.Sprintf("invalid birthday: %s", err.Error()))
+	}
+
+	person.StartDate, err = parsePersonImportDate(value(domain.PersonImportFieldStartDate))
+	if err != nil {
+		row.AddError(fmt.Sprintf("invalid start date: %s", err.Error()))
+	}
+
+	if isManager := value(domain.PersonImportFieldIsManager); isManager != "" {
+		person.IsManager, err = parsePersonImportBool(isManager)
+		if err != nil {
+			row.AddError(fmt.Sprintf("invalid is_manager value %q", isManager))
+		}
+	}
+
+	if gender := value(domain.PersonImportFieldGender); gender != "" {
+		normalized, ok := personImportGenders[text.Normalize(gender)]
+		if !ok {
+			row.AddError(fmt.Sprintf("invalid gender %q, use male, female or other", gender))
+		} else {
+			person.Gender = &normalized
+		}
+	}
+
+	row.ManagerEmail = strings.ToLower(value(domain.PersonImportFieldManagerEmail))
+	if row.ManagerEmail != "" && s.validator.Var(row.ManagerEmail, "email") != nil {
+		row.AddError(fmt.Sprintf("invalid manager email %q", row.ManagerEmail))
+	}
+
+	city, state, country := value(domain.PersonImportFieldCity), value(domain.PersonImportFieldState), value(domain.PersonImportFieldCountry)
+	if city != "" || state != "" || country != "" {
+		if country == "" {
+			country = "Brazil"
+		}
+		row.Address = &entity.Address{
+			City:      city,
+			State:     state,
+			Country:   country,
+			IsPrimary: true,
+			Active:    true,
+		}
+	}
+
+	row.Person = person
+
+	return row
+}
+
+// parsePersonImportDate accepts ISO dates, brazilian dates (dd/mm/yyyy) and Excel date serial numbers
+func parsePersonImportDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	for _, layout := range personImportDateLayouts {
+		date, err := time.Parse(layout, value)
+		if err == nil {
+			return &date, nil
+		}
+	}
+
+	if serial, err := strconv.ParseFloat(value, 64); err == nil && serial > 0 && serial < 100000 {
+		date, err := spreadsheet.ExcelSerialToTime(value)
+		if err == nil {
+			return &date, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%q is not a valid date, use YYYY-MM-DD or DD/MM/YYYY", value)
+}
+
+func parsePersonImportBool(value string) (bool, error) {
+	switch text.Normalize(value) {
+	case "true", "1", "yes", "sim", "s", "y", "x":
+		return true, nil
+	case "false", "0", "no", "nao", "n":
+		return false, nil
+	}
+
+	return false, errors.New("invalid boolean")
+}
+
+// countPersonImportErrors returns how many rows have errors
+func countPersonImportErrors(rows []entity.PersonImportRow) int {
+	count := 0
+	for i := range rows {
+		if !rows[i].IsValid() {
+			count++
+		}
+	}
+	return count
+}
+
+// setPersonImportRowsStatus sets the status of the valid rows, rows with errors always have the error status
+func setPersonImportRowsStatus(rows []entity.PersonImportRow, status string) {
+	for i := range rows {
+		if !rows[i].IsValid() {
+			rows[i].Status = domain.PersonImportRowStatusError
+			continue
+		}
+		rows[i].Status = status
+	}
+}
+
+func buildPersonImportReport(rows []entity.PersonImportRow) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	writer := csv.NewWriter(buf)
+
+	err := writer.Write([]string{"line", "status", "name", "email", "person_uuid", "errors"})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		err = writer.Write([]string{
+			strconv.Itoa(row.Line),
+			row.Status,
+			row.Person.Name,
+			row.Person.Email,
+			row.Person.UUID,
+			strings.Join(row.Errors, "; "),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	return buf.Bytes(), writer.Error()
+}
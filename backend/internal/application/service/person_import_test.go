@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_resolvePersonImportColumns(t *testing.T) {
+	t.Run("Should map portuguese headers automatically", func(t *testing.T) {
+		columns, err := resolvePersonImportColumns([]string{"Nome", "E-mail", "Cargo", "Email do Gestor", "Cidade"}, nil)
+		require.NoError(t, err)
+		require.Equal(t, 0, columns[domain.PersonImportFieldName])
+		require.Equal(t, 1, columns[domain.PersonImportFieldEmail])
+		require.Equal(t, 2, columns[domain.PersonImportFieldPosition])
+		require.Equal(t, 3, columns[domain.PersonImportFieldManagerEmail])
+		require.Equal(t, 4, columns[domain.PersonImportFieldCity])
+	})
+
+	t.Run("Should use the given mapping", func(t *testing.T) {
+		columns, err := resolvePersonImportColumns([]string{"Colaborador", "Contato"}, map[string]string{
+			domain.PersonImportFieldName:  "colaborador",
+			domain.PersonImportFieldEmail: "Contato",
+		})
+		require.NoError(t, err)
+		require.Equal(t, 0, columns[domain.PersonImportFieldName])
+		require.Equal(t, 1, columns[domain.PersonImportFieldEmail])
+	})
+
+	t.Run("Should return error for unknown field", func(t *testing.T) {
+		_, err := resolvePersonImportColumns([]string{"Nome"}, map[string]string{"salary": "Nome"})
+		require.Error(t, err)
+	})
+
+	t.Run("Should return error when the column does not exist", func(t *testing.T) {
+		_, err := resolvePersonImportColumns([]string{"Nome"}, map[string]string{domain.PersonImportFieldName: "Name"})
+		require.Error(t, err)
+	})
+
+	t.Run("Should return error without name column", func(t *testing.T) {
+		_, err := resolvePersonImportColumns([]string{"Cargo"}, nil)
+		require.Error(t, err)
+	})
+}
+
+func Test_personApp_parsePersonImportRows(t *testing.T) {
+	m, ctrl := newServiceTestMock(t)
+	defer ctrl.Finish()
+
+	s := &personApp{validator: m.mockValidator}
+
+	header := []string{"nome", "email", "email do gestor", "data de admissao", "genero", "cidade"}
+	columns, err := resolvePersonImportColumns(header, nil)
+	require.NoError(t, err)
+
+	existing := []entity.Person{{ID: 1, Name: "Existing Manager", Email: "boss@empresa.com"}}
+
+	rows := s.parsePersonImportRows([][]string{
+		{"Ana Souza", "ana@empresa.com", "boss@empresa.com", "10/03/2022", "feminino", "Curitiba"},
+		{"Bruno Lima", "bruno@empresa.com", "ana@empresa.com", "2023-01-02", "", ""},
+		{"Carla", "ANA@empresa.com", "", "", "", ""},
+		{"Diego", "diego@empresa.com", "nobody@empresa.com", "31/31/2020", "robot", ""},
+		{"Eva", "boss@empresa.com", "", "45292", "", ""},
+	}, columns, existing)
+
+	require.Len(t, rows, 5)
+
+	require.True(t, rows[0].IsValid(), rows[0].Errors)
+	require.Equal(t, 2, rows[0].Line)
+	require.Equal(t, time.Date(2022, 3, 10, 0, 0, 0, 0, time.UTC), *rows[0].Person.StartDate)
+	require.Equal(t, "female", *rows[0].Person.Gender)
+	require.NotNil(t, rows[0].Address)
+	require.Equal(t, "Curitiba", rows[0].Address.City)
+	require.Equal(t, "Brazil", rows[0].Address.Country)
+
+	require.True(t, rows[1].IsValid(), rows[1].Errors) // manager is in the same file
+	require.Nil(t, rows[1].Address)
+
+	require.False(t, rows[2].IsValid()) // duplicated email in the file
+	require.Contains(t, rows[2].Errors[0], "line 2")
+
+	require.False(t, rows[3].IsValid())
+	require.Len(t, rows[3].Errors, 3) // date, gender and manager
+
+	require.False(t, rows[4].IsValid()) // email already exists in the company
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), *rows[4].Person.StartDate)
+}
+
+func Test_buildPersonImportReport(t *testing.T) {
+	rows := []entity.PersonImportRow{
+		{Line: 2, Status: domain.PersonImportRowStatusCreated, Person: entity.Person{Name: "Ana", Email: "ana@empresa.com", UUID: "uuid-1"}},
+		{Line: 3, Status: domain.PersonImportRowStatusError, Person: entity.Person{Name: "B"}, Errors: []string{"error 1", "error 2"}},
+	}
+
+	report, err := buildPersonImportReport(rows)
+	require.NoError(t, err)
+	require.Equal(t, "line,status,name,email,person_uuid,errors\n2,created,Ana,ana@empresa.com,uuid-1,\n3,error,B,,,error 1; error 2\n", string(report))
+}
+
+func TestPersonApp_processPersonImport(t *testing.T) {
+	ctx := context.Background()
+	payload := entity.PersonImportPayload{ImportUUID: "import-uuid"}
+	pending := entity.PersonImport{
+		ID:        7,
+		UUID:      "import-uuid",
+		CompanyID: 10,
+		UserID:    3,
+		FileName:  "people.csv",
+		Status:    domain.PersonImportStatusPending,
+		FileData:  []byte("name,email\nAna Souza,ana@empresa.com\n"),
+	}
+
+	setup := func(t *testing.T) (*personApp, *mocks.MockPersonRepo, *allMocks) {
+		m, ctrl := newServiceTestMock(t)
+		t.Cleanup(ctrl.Finish)
+
+		personRepo := mocks.NewMockPersonRepo(ctrl)
+		m.mockDataManager.EXPECT().Person().Return(personRepo).AnyTimes()
+		m.mockDataManager.EXPECT().WithTransaction(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, fn func(contract.DataManager) error) error {
+			return fn(m.mockDataManager)
+		}).AnyTimes()
+
+		return newPersonApp(m.mockDomain, nil), personRepo, &m
+	}
+
+	t.Run("Should create the people and save the result", func(t *testing.T) {
+		s, personRepo, m := setup(t)
+
+		personRepo.EXPECT().GetPersonImportByUUID(ctx, "import-uuid").Return(pending, nil).Times(1)
+		personRepo.EXPECT().GetPersonsByCompany(ctx, int64(10)).Return(nil, nil).Times(1)
+		personRepo.EXPECT().CreatePerson(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, person entity.Person) (int64, error) {
+			require.Equal(t, "Ana Souza", person.Name)
+			require.Equal(t, int64(10), person.CompanyID)
+			require.Equal(t, int64(3), person.CreatedBy)
+			return 20, nil
+		}).Times(1)
+		personRepo.EXPECT().UpdatePersonImportResult(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, personImport entity.PersonImport) error {
+			require.Equal(t, int64(7), personImport.ID)
+			require.Equal(t, domain.PersonImportStatusCompleted, personImport.Status)
+			require.Equal(t, 1, personImport.ImportedRows)
+			require.Contains(t, string(personImport.Report), "2,created,Ana Souza")
+			return nil
+		}).Times(1)
+		m.mockCacheManager.EXPECT().Delete(ctx, gomock.Any()).Return(nil).Times(1)
+
+		require.NoError(t, s.processPersonImport(ctx, payload))
+	})
+
+	t.Run("Should fail the import when the people of the company changed since the upload", func(t *testing.T) {
+		s, personRepo, _ := setup(t)
+
+		personRepo.EXPECT().GetPersonImportByUUID(ctx, "import-uuid").Return(pending, nil).Times(1)
+		personRepo.EXPECT().GetPersonsByCompany(ctx, int64(10)).Return([]entity.Person{{ID: 1, Email: "ana@empresa.com"}}, nil).Times(1)
+		personRepo.EXPECT().UpdatePersonImportResult(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, personImport entity.PersonImport) error {
+			require.Equal(t, domain.PersonImportStatusFailed, personImport.Status)
+			require.Equal(t, 1, personImport.ErrorRows)
+			require.Zero(t, personImport.ImportedRows)
+			return nil
+		}).Times(1)
+
+		require.NoError(t, s.processPersonImport(ctx, payload))
+	})
+
+	t.Run("Should skip an import processed by an earlier attempt", func(t *testing.T) {
+		s, personRepo, _ := setup(t)
+
+		processed := pending
+		processed.Status = domain.PersonImportStatusCompleted
+		personRepo.EXPECT().GetPersonImportByUUID(ctx, "import-uuid").Return(processed, nil).Times(1)
+
+		require.NoError(t, s.processPersonImport(ctx, payload))
+	})
+}
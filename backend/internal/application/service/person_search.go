@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/text"
+)
+
+// peopleSearchIndexTTL is short because attributes extracted in background only refresh the index when it expires
+const peopleSearchIndexTTL = 5 * time.Minute
+
+// field weights used to rank the search results, a match on the name is worth more than on an attribute
+var peopleSearchFieldWeights = map[string]float64{
+	domain.PersonSearchFieldName:       1.0,
+	domain.PersonSearchFieldEmail:      0.8,
+	domain.PersonSearchFieldPosition:   0.6,
+	domain.PersonSearchFieldDepartment: 0.5,
+	domain.PersonSearchFieldAttributes: 0.4,
+}
+
+func peopleSearchIndexCacheKey(companyID int64) string {
+	return fmt.Sprintf("people_search_index:%d", companyID)
+}
+
+// invalidatePeopleSearchIndex must be called after any change on people or attributes of a company
+func invalidatePeopleSearchIndex(ctx context.Context, cache contract.CacheManager, log logger.Logger, companyID int64) {
+	err := cache.Delete(ctx, peopleSearchIndexCacheKey(companyID))
+	if err != nil {
+		log.Errorw(ctx, "error invalidating people search index", logger.Err(err), logger.Int64("company_id", companyID))
+	}
+}
+
+// SearchPeople returns the company people that match the search, most relevant first
+func (s *personApp) SearchPeople(ctx context.Context, search string) ([]entity.Person, error) {
+	results, err := s.SearchPeopleRanked(ctx, search, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	people := make([]entity.Person, 0, len(results))
+	for _, result := range results {
+		people = append(people, result.Person)
+	}
+
+	return people, nil
+}
+
+// SearchPeopleRanked does an accent and case insensitive search, tolerant to typos, across name, email,
+// position, department and attributes. Results are sorted by relevance. A limit <= 0 returns all matches
+func (s *personApp) SearchPeopleRanked(ctx context.Context, search string, limit int) ([]entity.PersonSearchResult, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.getAuthorizedCompany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query := text.Normalize(search)
+	if query == "" {
+		return []entity.PersonSearchResult{}, nil
+	}
+
+	index, err := s.getPeopleSearchIndex(ctx, company.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting people search index", logger.Err(err))
+		return nil, err
+	}
+
+	results := []entity.PersonSearchResult{}
+	for _, doc := range index {
+		result, ok := scorePersonSearchDocument(query, doc)
+		if ok {
+			results = append(results, result)
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return strings.ToLower(results[i].Person.Name) < strings.ToLower(results[j].Person.Name)
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	s.log.Infow(ctx, "people search completed successfully",
+		logger.Int("people_count", len(results)),
+		logger.String("search_term", search),
+		logger.Int64("company_id", company.ID),
+	)
+
+	return results, nil
+}
+
+// getPeopleSearchIndex returns the cached search documents of a company, building them when the cache is empty
+func (s *personApp) getPeopleSearchIndex(ctx context.Context, companyID int64) ([]entity.PersonSearchDocument, error) {
+	cacheKey := peopleSearchIndexCacheKey(companyID)
+
+	var index []entity.PersonSearchDocument
+	err := s.cache.GetStruct(ctx, cacheKey, &index)
+	if err == nil {
+		return index, nil
+	}
+
+	people, err := s.dm.Person().GetPersonsByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := s.dm.Person().GetAttributesByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	attributesByPerson := make(map[int64][]string)
+	for _, attr := range attributes {
+		attributesByPerson[attr.PersonID] = append(attributesByPerson[attr.PersonID], text.Normalize(attr.AttributeValue))
+	}
+
+	index = make([]entity.PersonSearchDocument, 0, len(people))
+	for _, person := range people {
+		index = append(index, entity.PersonSearchDocument{
+			Person:     person,
+			Name:       text.Normalize(person.Name),
+			Email:      text.Normalize(person.Email),
+			Position:   text.Normalize(person.Position),
+			Department: text.Normalize(person.Department),
+			Attributes: attributesByPerson[person.ID],
+		})
+	}
+
+	err = s.cache.SetStructWithExpiration(ctx, cacheKey, index, peopleSearchIndexTTL)
+	if err != nil {
+		// the search still works without the cache, it's just slower
+		s.log.Errorw(ctx, "error caching people search index", logger.Err(err))
+	}
+
+	return index, nil
+}
+
+func scorePersonSearchDocument(query string, doc entity.PersonSearchDocument) (entity.PersonSearchResult, bool) {
+	result := entity.PersonSearchResult{Person: doc.Person}
+
+	fields := []struct {
+		name   string
+		values []string
+	}{
+		{name: domain.PersonSearchFieldName, values: []string{doc.Name}},
+		{name: domain.PersonSearchFieldEmail, values: []string{doc.Email}},
+		{name: domain.PersonSearchFieldPosition, values: []string{doc.Position}},
+		{name: domain.PersonSearchFieldDepartment, values: []string{doc.Department}},
+		{name: domain.PersonSearchFieldAttributes, values: doc.Attributes},
+	}
+
+	for _, field := range fields {
+		for _, value := range field.values {
+			score := text.MatchScore(query, value) * peopleSearchFieldWeights[field.name]
+			if score > result.Score {
+				result.Score = score
+				result.MatchedField = field.name
+			}
+		}
+	}
+
+	return result, result.Score > 0
+}
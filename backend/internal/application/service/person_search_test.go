@@ -0,0 +1,59 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/text"
+	"github.com/stretchr/testify/require"
+)
+
+func newSearchDocument(name, email, position, department string, attributes ...string) entity.PersonSearchDocument {
+	doc := entity.PersonSearchDocument{
+		Person:     entity.Person{Name: name, Email: email, Position: position, Department: department},
+		Name:       text.Normalize(name),
+		Email:      text.Normalize(email),
+		Position:   text.Normalize(position),
+		Department: text.Normalize(department),
+	}
+	for _, attr := range attributes {
+		doc.Attributes = append(doc.Attributes, text.Normalize(attr))
+	}
+	return doc
+}
+
+func Test_scorePersonSearchDocument(t *testing.T) {
+	joao := newSearchDocument("João Silva", "joao@empresa.com", "Tech Lead", "Engenharia", "corrida, leitura")
+	maria := newSearchDocument("Maria Souza", "maria@empresa.com", "Designer", "Produto")
+
+	t.Run("Should match accent insensitive on name", func(t *testing.T) {
+		result, ok := scorePersonSearchDocument(text.Normalize("joao"), joao)
+		require.True(t, ok)
+		require.Equal(t, domain.PersonSearchFieldName, result.MatchedField)
+	})
+
+	t.Run("Should tolerate typos", func(t *testing.T) {
+		_, ok := scorePersonSearchDocument(text.Normalize("mraia"), maria)
+		require.True(t, ok)
+	})
+
+	t.Run("Should match on attributes", func(t *testing.T) {
+		result, ok := scorePersonSearchDocument(text.Normalize("corrida"), joao)
+		require.True(t, ok)
+		require.Equal(t, domain.PersonSearchFieldAttributes, result.MatchedField)
+	})
+
+	t.Run("Should rank name matches above other fields", func(t *testing.T) {
+		byName, ok := scorePersonSearchDocument(text.Normalize("design"), newSearchDocument("Designer Santos", "", "", ""))
+		require.True(t, ok)
+		byPosition, ok := scorePersonSearchDocument(text.Normalize("design"), maria)
+		require.True(t, ok)
+		require.Greater(t, byName.Score, byPosition.Score)
+	})
+
+	t.Run("Should not match unrelated people", func(t *testing.T) {
+		_, ok := scorePersonSearchDocument(text.Normalize("carlos"), maria)
+		require.False(t, ok)
+	})
+}
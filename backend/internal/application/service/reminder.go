@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/date"
+	"github.com/twinj/uuid"
+)
+
+type reminderApp struct {
+	dm        contract.DataManager
+	log       logger.Logger
+	userApp   contract.UserApp
+	personApp *personApp
+}
+
+func newReminderApp(infra domain.Infrastructure, userApp contract.UserApp, personApp *personApp) contract.ReminderApp {
+	return &reminderApp{
+		dm:        infra.DataManager(),
+		log:       infra.Logger(),
+		userApp:   userApp,
+		personApp: personApp,
+	}
+}
+
+func (s *reminderApp) GetUpcomingReminders(ctx context.Context, days int) ([]entity.PersonReminder, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	preferences, err := s.userApp.GetUserPreferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetCompanyReminders(ctx, company.ID, preferences.Location(), days)
+}
+
+// GetCompanyReminders does not depend on the logged user, so it can also be used by background jobs
+// that send the reminders as notifications
+func (s *reminderApp) GetCompanyReminders(ctx context.Context, companyID int64, loc *time.Location, days int) ([]entity.PersonReminder, error) {
+	people, err := s.dm.Person().GetPersonsByCompany(ctx, companyID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting company people", logger.Err(err))
+		return nil, err
+	}
+
+	reminders := buildPersonReminders(people, date.Today(time.Now(), loc), getRemindersDays(days))
+
+	s.log.Infow(ctx, "upcoming reminders computed",
+		logger.Int64("company_id", companyID),
+		logger.String("timezone", loc.String()),
+		logger.Int("reminders_count", len(reminders)),
+	)
+
+	return reminders, nil
+}
+
+// getRemindersDays returns the reminders window, using the default when it is not set and limiting it to the max
+func getRemindersDays(days int) int {
+	if days <= 0 {
+		return domain.RemindersDefaultDays
+	}
+	if days > domain.RemindersMaxDays {
+		return domain.RemindersMaxDays
+	}
+	return days
+}
+
+// buildPersonReminders returns the birthdays and work anniversaries from today until today plus days (both inclusive),
+// sorted by date. The day the person started is not an anniversary
+func buildPersonReminders(people []entity.Person, today time.Time, days int) []entity.PersonReminder {
+	reminders := []entity.PersonReminder{}
+
+	add := func(person entity.Person, reminderType string, since time.Time) {
+		next := date.NextAnniversary(since, today)
+		daysUntil := date.DaysBetween(today, next)
+		if daysUntil > days {
+			return
+		}
+
+		years := date.YearsBetween(since, next)
+		if years < 1 {
+			return
+		}
+
+		reminders = append(reminders, entity.PersonReminder{
+			Type:       reminderType,
+			PersonID:   person.ID,
+			PersonUUID: person.UUID,
+			PersonName: person.Name,
+			Date:       next,
+			DaysUntil:  daysUntil,
+			Years:      years,
+		})
+	}
+
+	for _, person := range people {
+		if person.Birthday != nil {
+			add(person, domain.ReminderTypeBirthday, *person.Birthday)
+		}
+		if person.StartDate != nil {
+			add(person, domain.ReminderTypeWorkAnniversary, *person.StartDate)
+		}
+	}
+
+	sort.SliceStable(reminders, func(i, j int) bool {
+		if reminders[i].DaysUntil != reminders[j].DaysUntil {
+			return reminders[i].DaysUntil < reminders[j].DaysUntil
+		}
+		return reminders[i].PersonName < reminders[j].PersonName
+	})
+
+	return reminders
+}
+
+// reminderActivity returns the feed event of a reminder of today. Its UUID is the same for the person, type and date,
+// so a reminder is recorded only once
+func reminderActivity(companyID int64, reminder entity.PersonReminder) entity.Activity {
+	name := "reminder:" + reminder.PersonUUID + ":" + reminder.Type + ":" + reminder.Date.Format(time.DateOnly)
+	return entity.Activity{
+		UUID:      uuid.NewV5(uuid.NameSpaceURL, name).String(),
+		CompanyID: companyID,
+		PersonID:  reminder.PersonID,
+		Type:      domain.ActivityTypeReminder,
+		Detail:    reminder.Type,
+	}
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func datePointer(year int, month time.Month, day int) *time.Time {
+	value := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return &value
+}
+
+func Test_buildPersonReminders(t *testing.T) {
+	today := time.Date(2025, time.February, 24, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Should return birthdays and anniversaries inside the window sorted by date", func(t *testing.T) {
+		people := []entity.Person{
+			{ID: 1, UUID: "uuid-1", Name: "Bruno", Birthday: datePointer(1990, time.March, 2)},
+			{ID: 2, UUID: "uuid-2", Name: "Ana", StartDate: datePointer(2022, time.February, 26)},
+			{ID: 3, UUID: "uuid-3", Name: "Carla", Birthday: datePointer(1985, time.February, 24), StartDate: datePointer(2020, time.June, 1)},
+			{ID: 4, UUID: "uuid-4", Name: "Diego", Birthday: datePointer(1993, time.March, 4)},
+		}
+
+		reminders := buildPersonReminders(people, today, 7)
+
+		require.Equal(t, []entity.PersonReminder{
+			{Type: domain.ReminderTypeBirthday, PersonID: 3, PersonUUID: "uuid-3", PersonName: "Carla", Date: *datePointer(2025, time.February, 24), DaysUntil: 0, Years: 40},
+			{Type: domain.ReminderTypeWorkAnniversary, PersonID: 2, PersonUUID: "uuid-2", PersonName: "Ana", Date: *datePointer(2025, time.February, 26), DaysUntil: 2, Years: 3},
+			{Type: domain.ReminderTypeBirthday, PersonID: 1, PersonUUID: "uuid-1", PersonName: "Bruno", Date: *datePointer(2025, time.March, 2), DaysUntil: 6, Years: 35},
+		}, reminders)
+	})
+
+	t.Run("Should remind February 29 birthdays on February 28 in non leap years", func(t *testing.T) {
+		people := []entity.Person{{ID: 1, Name: "Leap", Birthday: datePointer(2000, time.February, 29)}}
+
+		reminders := buildPersonReminders(people, today, 7)
+
+		require.Len(t, reminders, 1)
+		require.Equal(t, *datePointer(2025, time.February, 28), reminders[0].Date)
+		require.Equal(t, 4, reminders[0].DaysUntil)
+		require.Equal(t, 25, reminders[0].Years)
+	})
+
+	t.Run("Should cross the year boundary", func(t *testing.T) {
+		people := []entity.Person{{ID: 1, Name: "Ana", StartDate: datePointer(2021, time.January, 2)}}
+
+		reminders := buildPersonReminders(people, time.Date(2025, time.December, 29, 0, 0, 0, 0, time.UTC), 7)
+
+		require.Len(t, reminders, 1)
+		require.Equal(t, *datePointer(2026, time.January, 2), reminders[0].Date)
+		require.Equal(t, 5, reminders[0].Years)
+	})
+
+	t.Run("Should not remind the start day or future start dates", func(t *testing.T) {
+		people := []entity.Person{
+			{ID: 1, Name: "New", StartDate: datePointer(2025, time.February, 24)},
+			{ID: 2, Name: "Future", StartDate: datePointer(2025, time.February, 27)},
+		}
+
+		require.Empty(t, buildPersonReminders(people, today, 7))
+	})
+}
+
+func Test_reminderActivity(t *testing.T) {
+	reminder := entity.PersonReminder{
+		Type:       domain.ReminderTypeBirthday,
+		PersonID:   7,
+		PersonUUID: "person-uuid",
+		Date:       time.Date(2025, time.March, 4, 0, 0, 0, 0, time.UTC),
+	}
+
+	activity := reminderActivity(1, reminder)
+	require.Equal(t, int64(1), activity.CompanyID)
+	require.Equal(t, int64(7), activity.PersonID)
+	require.Nil(t, activity.UserID)
+	require.Equal(t, domain.ActivityTypeReminder, activity.Type)
+	require.Equal(t, domain.ReminderTypeBirthday, activity.Detail)
+	require.Equal(t, activity.UUID, reminderActivity(1, reminder).UUID)
+
+	anniversary := reminder
+	anniversary.Type = domain.ReminderTypeWorkAnniversary
+	require.NotEqual(t, activity.UUID, reminderActivity(1, anniversary).UUID)
+
+	nextYear := reminder
+	nextYear.Date = reminder.Date.AddDate(1, 0, 0)
+	require.NotEqual(t, activity.UUID, reminderActivity(1, nextYear).UUID)
+}
+
+func Test_getRemindersDays(t *testing.T) {
+	require.Equal(t, domain.RemindersDefaultDays, getRemindersDays(0))
+	require.Equal(t, 30, getRemindersDays(30))
+	require.Equal(t, domain.RemindersMaxDays, getRemindersDays(1000))
+}
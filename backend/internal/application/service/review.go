@@ -0,0 +1,848 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/date"
+	"github.com/twinj/uuid"
+)
+
+type reviewApp struct {
+	dm        contract.DataManager
+	log       logger.Logger
+	authApp   contract.AuthApp
+	userApp   contract.UserApp
+	personApp *personApp
+}
+
+func newReviewApp(infra domain.Infrastructure, authApp contract.AuthApp, userApp contract.UserApp, personApp *personApp) contract.ReviewApp {
+	return &reviewApp{
+		dm:        infra.DataManager(),
+		log:       infra.Logger(),
+		authApp:   authApp,
+		userApp:   userApp,
+		personApp: personApp,
+	}
+}
+
+// loadTemplateDetails sets the competencies and the rating scale of the template
+func loadTemplateDetails(ctx context.Context, dm contract.DataManager, template *entity.ReviewTemplate) (err error) {
+	template.Competencies, err = dm.Review().GetCompetenciesByTemplate(ctx, template.ID)
+	if err != nil {
+		return err
+	}
+
+	template.RatingLevels, err = dm.Review().GetRatingLevelsByTemplate(ctx, template.ID)
+	return err
+}
+
+// getAuthorizedTemplate loads a template by UUID with its competencies and rating scale
+// and checks that the logged user owns the template's company
+func (s *reviewApp) getAuthorizedTemplate(ctx context.Context, templateUUID string) (entity.ReviewTemplate, error) {
+	template, err := s.dm.Review().GetTemplateByUUID(ctx, templateUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return template, resterrors.NewNotFoundError("review template not found")
+		}
+		s.log.Errorw(ctx, "error getting review template by UUID", logger.Err(err))
+		return template, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return template, err
+	}
+
+	_, err = s.personApp.validateUserCompanyAccess(ctx, userID, template.CompanyID)
+	if err != nil {
+		return template, err
+	}
+
+	err = loadTemplateDetails(ctx, s.dm, &template)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting review template details", logger.Err(err))
+		return template, err
+	}
+
+	return template, nil
+}
+
+// getAuthorizedCycle loads a cycle by UUID and checks that the logged user owns the cycle's company
+func (s *reviewApp) getAuthorizedCycle(ctx context.Context, cycleUUID string) (entity.ReviewCycle, error) {
+	cycle, err := s.dm.Review().GetCycleByUUID(ctx, cycleUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return cycle, resterrors.NewNotFoundError("review cycle not found")
+		}
+		s.log.Errorw(ctx, "error getting review cycle by UUID", logger.Err(err))
+		return cycle, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return cycle, err
+	}
+
+	_, err = s.personApp.validateUserCompanyAccess(ctx, userID, cycle.CompanyID)
+	if err != nil {
+		return cycle, err
+	}
+
+	return cycle, nil
+}
+
+// getAuthorizedReview loads a review by UUID with its template and ratings
+// and checks that the logged user owns the review's company
+func (s *reviewApp) getAuthorizedReview(ctx context.Context, reviewUUID string) (entity.Review, error) {
+	review, err := s.dm.Review().GetReviewByUUID(ctx, reviewUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return review, resterrors.NewNotFoundError("review not found")
+		}
+		s.log.Errorw(ctx, "error getting review by UUID", logger.Err(err))
+		return review, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return review, err
+	}
+
+	_, err = s.personApp.validateUserCompanyAccess(ctx, userID, review.CompanyID)
+	if err != nil {
+		return review, err
+	}
+
+	template, err := s.dm.Review().GetTemplateByID(ctx, review.TemplateID)
+	if err == nil {
+		err = loadTemplateDetails(ctx, s.dm, &template)
+	}
+	if err != nil {
+		s.log.Errorw(ctx, "error getting review template", logger.Err(err))
+		return review, err
+	}
+	review.Template = &template
+
+	ratings, err := s.dm.Review().GetRatingsByReview(ctx, review.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting review ratings", logger.Err(err))
+		return review, err
+	}
+	review.Ratings = mergeReviewRatings(template.Competencies, ratings)
+
+	return review, nil
+}
+
+// normalizeReviewTemplate trims the texts and validates that the template has competencies and a
+// rating scale with unique values, sorting the scale by value
+func normalizeReviewTemplate(template *entity.ReviewTemplate) error {
+	template.Name = strings.TrimSpace(template.Name)
+	if template.Name == "" {
+		return resterrors.NewBadRequestError("name is required")
+	}
+	template.Description = strings.TrimSpace(template.Description)
+
+	if len(template.Competencies) == 0 {
+		return resterrors.NewBadRequestError("at least one competency is required")
+	}
+	for i := range template.Competencies {
+		competency := &template.Competencies[i]
+		competency.Name = strings.TrimSpace(competency.Name)
+		if competency.Name == "" {
+			return resterrors.NewBadRequestError("competency name is required")
+		}
+		competency.Description = strings.TrimSpace(competency.Description)
+	}
+
+	if len(template.RatingLevels) < 2 || len(template.RatingLevels) > domain.ReviewTemplateMaxRatingLevels {
+		return resterrors.NewBadRequestError(fmt.Sprintf("the rating scale must have from 2 to %d levels", domain.ReviewTemplateMaxRatingLevels))
+	}
+	values := make(map[int]bool, len(template.RatingLevels))
+	for i := range template.RatingLevels {
+		level := &template.RatingLevels[i]
+		level.Label = strings.TrimSpace(level.Label)
+		if level.Label == "" {
+			return resterrors.NewBadRequestError("rating level label is required")
+		}
+		level.Description = strings.TrimSpace(level.Description)
+
+		if values[level.Value] {
+			return resterrors.NewBadRequestError(fmt.Sprintf("rating level value %d is repeated", level.Value))
+		}
+		values[level.Value] = true
+	}
+
+	sort.SliceStable(template.RatingLevels, func(i, j int) bool {
+		return template.RatingLevels[i].Value < template.RatingLevels[j].Value
+	})
+
+	return nil
+}
+
+// normalizeReviewCycle trims the name and validates the period, dropping the time of the dates
+func normalizeReviewCycle(cycle *entity.ReviewCycle) error {
+	cycle.Name = strings.TrimSpace(cycle.Name)
+	if cycle.Name == "" {
+		return resterrors.NewBadRequestError("name is required")
+	}
+
+	if cycle.PeriodStart.IsZero() || cycle.PeriodEnd.IsZero() {
+		return resterrors.NewBadRequestError("period_start and period_end are required")
+	}
+	cycle.PeriodStart = date.Day(cycle.PeriodStart)
+	cycle.PeriodEnd = date.Day(cycle.PeriodEnd)
+	if cycle.PeriodEnd.Before(cycle.PeriodStart) {
+		return resterrors.NewBadRequestError("period_end must not be before period_start")
+	}
+
+	if cycle.DueDate != nil {
+		dueDate := date.Day(*cycle.DueDate)
+		if dueDate.Before(cycle.PeriodStart) {
+			return resterrors.NewBadRequestError("due_date must not be before period_start")
+		}
+		cycle.DueDate = &dueDate
+	}
+
+	return nil
+}
+
+// reviewPeriodRange returns the instants of the review period in the given location,
+// from the start of the first day to the start of the day after the last one
+func reviewPeriodRange(periodStart, periodEnd time.Time, loc *time.Location) (from, to time.Time) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	from = time.Date(periodStart.Year(), periodStart.Month(), periodStart.Day(), 0, 0, 0, 0, loc)
+	to = time.Date(periodEnd.Year(), periodEnd.Month(), periodEnd.Day()+1, 0, 0, 0, 0, loc)
+	return from, to
+}
+
+// reviewHighlight shortens the content of a 1:1 note to a highlight, cutting at the last word that fits
+func reviewHighlight(content string, maxLength int) string {
+	content = strings.Join(strings.Fields(content), " ")
+
+	runes := []rune(content)
+	if len(runes) <= maxLength {
+		return content
+	}
+
+	highlight := string(runes[:maxLength])
+	if i := strings.LastIndex(highlight, " "); i > 0 {
+		highlight = highlight[:i]
+	}
+
+	return strings.TrimRight(highlight, " ,.;:") + "…"
+}
+
+// buildReviewEvidence compiles the timeline entries of the review period: the feedback counted by
+// type and category, the mentions received and the highlights of the 1:1s
+func buildReviewEvidence(entries []entity.UnifiedTimelineEntry) entity.ReviewEvidence {
+	evidence := entity.ReviewEvidence{
+		FeedbackByType:     map[string]int{},
+		FeedbackByCategory: map[string]int{},
+		Feedback:           []entity.UnifiedTimelineEntry{},
+		Mentions:           []entity.UnifiedTimelineEntry{},
+		OneOnOneHighlights: []entity.UnifiedTimelineEntry{},
+	}
+
+	for _, entry := range entries {
+		switch entry.Type {
+		case domain.NoteTypeFeedback:
+			evidence.FeedbackCount++
+			if entry.FeedbackType != nil && *entry.FeedbackType != "" {
+				evidence.FeedbackByType[*entry.FeedbackType]++
+			}
+			if entry.FeedbackCategory != nil && *entry.FeedbackCategory != "" {
+				evidence.FeedbackByCategory[*entry.FeedbackCategory]++
+			}
+			evidence.Feedback = append(evidence.Feedback, entry)
+
+		case "mention":
+			evidence.Mentions = append(evidence.Mentions, entry)
+
+		case domain.NoteTypeOneOnOne:
+			evidence.OneOnOneCount++
+			if strings.TrimSpace(entry.Content) == "" {
+				continue
+			}
+			entry.Content = reviewHighlight(entry.Content, domain.ReviewHighlightMaxLength)
+			evidence.OneOnOneHighlights = append(evidence.OneOnOneHighlights, entry)
+
+		case domain.NoteTypeObservation:
+			evidence.ObservationCount++
+		}
+	}
+
+	return evidence
+}
+
+// mergeReviewRatings returns one rating per competency of the template, in the template order,
+// keeping the competencies not rated yet without rating
+func mergeReviewRatings(competencies []entity.ReviewCompetency, ratings []entity.ReviewRating) []entity.ReviewRating {
+	byCompetency := make(map[int64]entity.ReviewRating, len(ratings))
+	for _, rating := range ratings {
+		byCompetency[rating.CompetencyID] = rating
+	}
+
+	merged := make([]entity.ReviewRating, len(competencies))
+	for i, competency := range competencies {
+		rating, ok := byCompetency[competency.ID]
+		if !ok {
+			rating = entity.ReviewRating{CompetencyID: competency.ID}
+		}
+		rating.CompetencyUUID = competency.UUID
+		rating.CompetencyName = competency.Name
+		merged[i] = rating
+	}
+
+	return merged
+}
+
+// validateReviewRatings checks that the ratings are of competencies of the template and use values
+// of its rating scale, setting the competency of each rating
+func validateReviewRatings(template entity.ReviewTemplate, review *entity.Review) error {
+	if review.OverallRating != nil && !template.HasRatingLevel(*review.OverallRating) {
+		return resterrors.NewBadRequestError("overall_rating must be a value of the rating scale")
+	}
+
+	for i := range review.Ratings {
+		rating := &review.Ratings[i]
+
+		found := false
+		for _, competency := range template.Competencies {
+			if competency.UUID == rating.CompetencyUUID {
+				rating.CompetencyID = competency.ID
+				rating.CompetencyName = competency.Name
+				found = true
+				break
+			}
+		}
+		if !found {
+			return resterrors.NewNotFoundError("competency not found")
+		}
+
+		if rating.Rating != nil && !template.HasRatingLevel(*rating.Rating) {
+			return resterrors.NewBadRequestError(fmt.Sprintf("rating of %s must be a value of the rating scale", rating.CompetencyName))
+		}
+		rating.Comment = strings.TrimSpace(rating.Comment)
+	}
+
+	return nil
+}
+
+// transitionReview moves the review to the status, validating the workflow
+// draft -> submitted -> shared, where a submitted review can also be reopened as draft.
+// A review can only be submitted when all its competencies and the overall rating are rated
+func transitionReview(review *entity.Review, status string, now time.Time) error {
+	switch status {
+	case domain.ReviewStatusSubmitted:
+		if !review.IsDraft() {
+			return resterrors.NewBadRequestError("only draft reviews can be submitted")
+		}
+		for _, rating := range review.Ratings {
+			if rating.Rating == nil {
+				return resterrors.NewBadRequestError(fmt.Sprintf("competency %s is not rated", rating.CompetencyName))
+			}
+		}
+		if review.OverallRating == nil {
+			return resterrors.NewBadRequestError("overall_rating is required to submit the review")
+		}
+		review.SubmittedAt = &now
+
+	case domain.ReviewStatusShared:
+		if review.Status != domain.ReviewStatusSubmitted {
+			return resterrors.NewBadRequestError("only submitted reviews can be shared")
+		}
+		review.SharedAt = &now
+
+	case domain.ReviewStatusDraft:
+		if review.Status != domain.ReviewStatusSubmitted {
+			return resterrors.NewBadRequestError("only submitted reviews can be reopened")
+		}
+		review.SubmittedAt = nil
+
+	default:
+		return resterrors.NewBadRequestError("invalid review status")
+	}
+
+	review.Status = status
+	return nil
+}
+
+func (s *reviewApp) CreateReviewTemplate(ctx context.Context, template entity.ReviewTemplate) (entity.ReviewTemplate, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return template, err
+	}
+
+	if err := normalizeReviewTemplate(&template); err != nil {
+		return template, err
+	}
+
+	template.UUID = uuid.NewV4().String()
+	template.CompanyID = company.ID
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		template.ID, err = tx.Review().CreateTemplate(ctx, template)
+		if err != nil {
+			return err
+		}
+
+		for _, competency := range template.Competencies {
+			competency.UUID = uuid.NewV4().String()
+			competency.TemplateID = template.ID
+
+			_, err = tx.Review().CreateCompetency(ctx, competency)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, level := range template.RatingLevels {
+			level.TemplateID = template.ID
+
+			_, err = tx.Review().CreateRatingLevel(ctx, level)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error creating review template", logger.Err(err))
+		return template, err
+	}
+
+	s.log.Infow(ctx, "review template created successfully",
+		logger.String("template_uuid", template.UUID),
+	)
+
+	return s.getAuthorizedTemplate(ctx, template.UUID)
+}
+
+func (s *reviewApp) GetReviewTemplates(ctx context.Context) ([]entity.ReviewTemplate, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates, err := s.dm.Review().GetTemplatesByCompany(ctx, company.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting review templates", logger.Err(err))
+		return nil, err
+	}
+
+	for i := range templates {
+		err = loadTemplateDetails(ctx, s.dm, &templates[i])
+		if err != nil {
+			s.log.Errorw(ctx, "error getting review template details", logger.Err(err))
+			return nil, err
+		}
+	}
+
+	return templates, nil
+}
+
+func (s *reviewApp) GetReviewTemplate(ctx context.Context, templateUUID string) (entity.ReviewTemplate, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	return s.getAuthorizedTemplate(ctx, templateUUID)
+}
+
+func (s *reviewApp) DeleteReviewTemplate(ctx context.Context, templateUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	template, err := s.getAuthorizedTemplate(ctx, templateUUID)
+	if err != nil {
+		return err
+	}
+
+	cycles, err := s.dm.Review().GetCyclesByCompany(ctx, template.CompanyID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting review cycles", logger.Err(err))
+		return err
+	}
+	for _, cycle := range cycles {
+		if cycle.TemplateID == template.ID {
+			return resterrors.NewBadRequestError("review template is used by review cycles")
+		}
+	}
+
+	err = s.dm.Review().DeleteTemplate(ctx, template.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error deleting review template", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *reviewApp) CreateReviewCycle(ctx context.Context, cycle entity.ReviewCycle) (entity.ReviewCycle, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return cycle, err
+	}
+
+	if err := normalizeReviewCycle(&cycle); err != nil {
+		return cycle, err
+	}
+
+	template, err := s.getAuthorizedTemplate(ctx, cycle.TemplateUUID)
+	if err != nil {
+		return cycle, err
+	}
+	if template.CompanyID != company.ID {
+		return cycle, resterrors.NewNotFoundError("review template not found")
+	}
+
+	cycle.UUID = uuid.NewV4().String()
+	cycle.CompanyID = company.ID
+	cycle.TemplateID = template.ID
+	cycle.UserID, err = s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return cycle, err
+	}
+
+	_, err = s.dm.Review().CreateCycle(ctx, cycle)
+	if err != nil {
+		s.log.Errorw(ctx, "error creating review cycle", logger.Err(err))
+		return cycle, err
+	}
+
+	s.log.Infow(ctx, "review cycle created successfully",
+		logger.String("cycle_uuid", cycle.UUID),
+	)
+
+	return s.getAuthorizedCycle(ctx, cycle.UUID)
+}
+
+func (s *reviewApp) GetReviewCycles(ctx context.Context) ([]entity.ReviewCycle, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cycles, err := s.dm.Review().GetCyclesByCompany(ctx, company.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting review cycles", logger.Err(err))
+		return nil, err
+	}
+
+	return cycles, nil
+}
+
+func (s *reviewApp) GetReviewCycle(ctx context.Context, cycleUUID string) (entity.ReviewCycle, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	return s.getAuthorizedCycle(ctx, cycleUUID)
+}
+
+func (s *reviewApp) DeleteReviewCycle(ctx context.Context, cycleUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	cycle, err := s.getAuthorizedCycle(ctx, cycleUUID)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.Review().DeleteCycle(ctx, cycle.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error deleting review cycle", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *reviewApp) CreateCycleReviews(ctx context.Context, cycleUUID string, personUUIDs []string) ([]entity.Review, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	cycle, err := s.getAuthorizedCycle(ctx, cycleUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	var people []entity.Person
+	if len(personUUIDs) == 0 {
+		people, err = s.dm.Person().GetPersonsByCompany(ctx, cycle.CompanyID)
+		if err != nil {
+			s.log.Errorw(ctx, "error getting company people", logger.Err(err))
+			return nil, err
+		}
+	}
+	for _, personUUID := range personUUIDs {
+		person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+		if err != nil {
+			return nil, err
+		}
+		if person.CompanyID != cycle.CompanyID {
+			return nil, resterrors.NewBadRequestError("person does not belong to the company of the review cycle")
+		}
+		people = append(people, person)
+	}
+
+	existing, err := s.dm.Review().GetReviewsByCycle(ctx, cycle.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting cycle reviews", logger.Err(err))
+		return nil, err
+	}
+	reviewed := make(map[int64]bool, len(existing))
+	for _, review := range existing {
+		reviewed[review.PersonID] = true
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created := 0
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		for _, person := range people {
+			if reviewed[person.ID] {
+				continue
+			}
+			reviewed[person.ID] = true
+
+			_, err := tx.Review().CreateReview(ctx, entity.Review{
+				UUID:      uuid.NewV4().String(),
+				CycleID:   cycle.ID,
+				CompanyID: cycle.CompanyID,
+				PersonID:  person.ID,
+				UserID:    userID,
+				Status:    domain.ReviewStatusDraft,
+			})
+			if err != nil {
+				return err
+			}
+			created++
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error creating cycle reviews", logger.Err(err))
+		return nil, err
+	}
+
+	s.log.Infow(ctx, "cycle reviews created successfully",
+		logger.String("cycle_uuid", cycleUUID),
+		logger.Int("created", created),
+	)
+
+	reviews, err := s.dm.Review().GetReviewsByCycle(ctx, cycle.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting cycle reviews", logger.Err(err))
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+func (s *reviewApp) GetCycleReviews(ctx context.Context, cycleUUID string) ([]entity.Review, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	cycle, err := s.getAuthorizedCycle(ctx, cycleUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := s.dm.Review().GetReviewsByCycle(ctx, cycle.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting cycle reviews", logger.Err(err))
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+func (s *reviewApp) GetPersonReviews(ctx context.Context, personUUID string) ([]entity.Review, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	person, err := s.personApp.getAuthorizedPerson(ctx, personUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	reviews, err := s.dm.Review().GetReviewsByPerson(ctx, person.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting person reviews", logger.Err(err))
+		return nil, err
+	}
+
+	return reviews, nil
+}
+
+func (s *reviewApp) GetReview(ctx context.Context, reviewUUID string) (entity.Review, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	review, err := s.getAuthorizedReview(ctx, reviewUUID)
+	if err != nil {
+		return review, err
+	}
+
+	preferences, err := s.userApp.GetUserPreferences(ctx)
+	if err != nil {
+		return review, err
+	}
+
+	from, to := reviewPeriodRange(review.PeriodStart, review.PeriodEnd, preferences.Location())
+	filters := entity.TimelineFilters{From: &from, To: &to}
+
+	entries, _, err := s.dm.Note().GetPersonTimeline(ctx, review.PersonID, filters, domain.ReviewEvidenceMaxEntries, 0)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting person timeline", logger.Err(err))
+		return review, err
+	}
+
+	evidence := buildReviewEvidence(entries)
+	review.Evidence = &evidence
+
+	return review, nil
+}
+
+func (s *reviewApp) UpdateReview(ctx context.Context, reviewUUID string, review entity.Review) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	existing, err := s.getAuthorizedReview(ctx, reviewUUID)
+	if err != nil {
+		return err
+	}
+
+	if !existing.IsDraft() {
+		return resterrors.NewBadRequestError("only draft reviews can be edited")
+	}
+
+	if err := validateReviewRatings(*existing.Template, &review); err != nil {
+		return err
+	}
+
+	existing.OverallRating = review.OverallRating
+	existing.Summary = strings.TrimSpace(review.Summary)
+	existing.Strengths = strings.TrimSpace(review.Strengths)
+	existing.Improvements = strings.TrimSpace(review.Improvements)
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		err := tx.Review().UpdateReview(ctx, existing.ID, existing)
+		if err != nil {
+			return err
+		}
+
+		for _, rating := range review.Ratings {
+			rating.ReviewID = existing.ID
+
+			err = tx.Review().SaveRating(ctx, rating)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error updating review", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *reviewApp) DeleteReview(ctx context.Context, reviewUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	review, err := s.getAuthorizedReview(ctx, reviewUUID)
+	if err != nil {
+		return err
+	}
+
+	if !review.IsDraft() {
+		return resterrors.NewBadRequestError("only draft reviews can be deleted")
+	}
+
+	err = s.dm.Review().DeleteReview(ctx, review.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error deleting review", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// changeReviewStatus moves the review to the status following the review workflow
+func (s *reviewApp) changeReviewStatus(ctx context.Context, reviewUUID, status string) error {
+	review, err := s.getAuthorizedReview(ctx, reviewUUID)
+	if err != nil {
+		return err
+	}
+
+	if err := transitionReview(&review, status, time.Now()); err != nil {
+		return err
+	}
+
+	err = s.dm.Review().UpdateReview(ctx, review.ID, review)
+	if err != nil {
+		s.log.Errorw(ctx, "error updating review status", logger.Err(err))
+		return err
+	}
+
+	s.log.Infow(ctx, "review status changed successfully",
+		logger.String("review_uuid", reviewUUID),
+		logger.String("status", status),
+	)
+
+	return nil
+}
+
+func (s *reviewApp) SubmitReview(ctx context.Context, reviewUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	return s.changeReviewStatus(ctx, reviewUUID, domain.ReviewStatusSubmitted)
+}
+
+func (s *reviewApp) ShareReview(ctx context.Context, reviewUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	return s.changeReviewStatus(ctx, reviewUUID, domain.ReviewStatusShared)
+}
+
+func (s *reviewApp) ReopenReview(ctx context.Context, reviewUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	return s.changeReviewStatus(ctx, reviewUUID, domain.ReviewStatusDraft)
+}
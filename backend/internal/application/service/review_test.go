@@ -0,0 +1,242 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_normalizeReviewTemplate(t *testing.T) {
+	newTemplate := func() entity.ReviewTemplate {
+		return entity.ReviewTemplate{
+			Name:         "  Engineering review  ",
+			Competencies: []entity.ReviewCompetency{{Name: " Delivery "}, {Name: "Collaboration"}},
+			RatingLevels: []entity.ReviewRatingLevel{
+				{Value: 3, Label: "Exceeds expectations"},
+				{Value: 1, Label: "Below expectations"},
+				{Value: 2, Label: " Meets expectations "},
+			},
+		}
+	}
+
+	t.Run("Should trim the texts and sort the rating scale", func(t *testing.T) {
+		template := newTemplate()
+
+		err := normalizeReviewTemplate(&template)
+		require.NoError(t, err)
+		require.Equal(t, "Engineering review", template.Name)
+		require.Equal(t, "Delivery", template.Competencies[0].Name)
+		require.Equal(t, 1, template.RatingLevels[0].Value)
+		require.Equal(t, "Meets expectations", template.RatingLevels[1].Label)
+		require.Equal(t, 3, template.RatingLevels[2].Value)
+	})
+
+	t.Run("Should return error when there are no competencies", func(t *testing.T) {
+		template := newTemplate()
+		template.Competencies = nil
+		require.Error(t, normalizeReviewTemplate(&template))
+	})
+
+	t.Run("Should return error when the rating scale has a single level", func(t *testing.T) {
+		template := newTemplate()
+		template.RatingLevels = template.RatingLevels[:1]
+		require.Error(t, normalizeReviewTemplate(&template))
+	})
+
+	t.Run("Should return error when a rating value is repeated", func(t *testing.T) {
+		template := newTemplate()
+		template.RatingLevels[1].Value = 3
+		require.Error(t, normalizeReviewTemplate(&template))
+	})
+}
+
+func Test_normalizeReviewCycle(t *testing.T) {
+	t.Run("Should drop the time of the dates", func(t *testing.T) {
+		dueDate := time.Date(2025, time.July, 15, 18, 0, 0, 0, time.UTC)
+		cycle := entity.ReviewCycle{
+			Name:        "H1 2025",
+			PeriodStart: time.Date(2025, time.January, 1, 10, 0, 0, 0, time.UTC),
+			PeriodEnd:   time.Date(2025, time.June, 30, 10, 0, 0, 0, time.UTC),
+			DueDate:     &dueDate,
+		}
+
+		err := normalizeReviewCycle(&cycle)
+		require.NoError(t, err)
+		require.Equal(t, *datePointer(2025, time.January, 1), cycle.PeriodStart)
+		require.Equal(t, *datePointer(2025, time.June, 30), cycle.PeriodEnd)
+		require.Equal(t, *datePointer(2025, time.July, 15), *cycle.DueDate)
+	})
+
+	t.Run("Should return error when the period ends before it starts", func(t *testing.T) {
+		cycle := entity.ReviewCycle{
+			Name:        "H1 2025",
+			PeriodStart: time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC),
+			PeriodEnd:   time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+		}
+		require.Error(t, normalizeReviewCycle(&cycle))
+	})
+
+	t.Run("Should return error when the period is missing", func(t *testing.T) {
+		require.Error(t, normalizeReviewCycle(&entity.ReviewCycle{Name: "H1 2025"}))
+	})
+}
+
+func Test_reviewPeriodRange(t *testing.T) {
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	from, to := reviewPeriodRange(*datePointer(2025, time.January, 1), *datePointer(2025, time.June, 30), loc)
+	require.Equal(t, time.Date(2025, time.January, 1, 3, 0, 0, 0, time.UTC), from.UTC())
+	require.Equal(t, time.Date(2025, time.July, 1, 3, 0, 0, 0, time.UTC), to.UTC())
+}
+
+func Test_reviewHighlight(t *testing.T) {
+	require.Equal(t, "Talked about the promotion", reviewHighlight("Talked about\n the promotion", 50))
+	require.Equal(t, "Talked about the…", reviewHighlight("Talked about the promotion plan", 20))
+	require.Len(t, []rune(reviewHighlight(strings.Repeat("a", 300), 280)), 281)
+}
+
+func Test_buildReviewEvidence(t *testing.T) {
+	positive, constructive := domain.FeedbackTypePositive, domain.FeedbackTypeConstructive
+	skill, behavior := domain.FeedbackCategorySkill, domain.FeedbackCategoryBehavior
+	mentionedBy := "Maria"
+
+	evidence := buildReviewEvidence([]entity.UnifiedTimelineEntry{
+		{UUID: "note-1", Type: domain.NoteTypeFeedback, FeedbackType: &positive, FeedbackCategory: &skill},
+		{UUID: "note-2", Type: domain.NoteTypeFeedback, FeedbackType: &constructive, FeedbackCategory: &behavior},
+		{UUID: "note-3", Type: domain.NoteTypeFeedback, FeedbackType: &positive, FeedbackCategory: &skill},
+		{UUID: "note-4", Type: "mention", MentionedByPersonName: &mentionedBy},
+		{UUID: "note-5", Type: domain.NoteTypeOneOnOne, Content: strings.Repeat("word ", 100)},
+		{UUID: "note-6", Type: domain.NoteTypeOneOnOne},
+		{UUID: "note-7", Type: domain.NoteTypeObservation},
+	})
+
+	require.Equal(t, 3, evidence.FeedbackCount)
+	require.Equal(t, map[string]int{positive: 2, constructive: 1}, evidence.FeedbackByType)
+	require.Equal(t, map[string]int{skill: 2, behavior: 1}, evidence.FeedbackByCategory)
+	require.Len(t, evidence.Feedback, 3)
+	require.Len(t, evidence.Mentions, 1)
+	require.Equal(t, "note-4", evidence.Mentions[0].UUID)
+	require.Equal(t, 2, evidence.OneOnOneCount)
+	require.Len(t, evidence.OneOnOneHighlights, 1)
+	require.LessOrEqual(t, len([]rune(evidence.OneOnOneHighlights[0].Content)), domain.ReviewHighlightMaxLength+1)
+	require.Equal(t, 1, evidence.ObservationCount)
+}
+
+func Test_mergeReviewRatings(t *testing.T) {
+	competencies := []entity.ReviewCompetency{
+		{ID: 1, UUID: "competency-1", Name: "Delivery"},
+		{ID: 2, UUID: "competency-2", Name: "Collaboration"},
+	}
+
+	ratings := mergeReviewRatings(competencies, []entity.ReviewRating{
+		{ID: 10, CompetencyID: 2, Rating: intPointer(3), Comment: "Great partner"},
+	})
+
+	require.Len(t, ratings, 2)
+	require.Equal(t, "competency-1", ratings[0].CompetencyUUID)
+	require.Nil(t, ratings[0].Rating)
+	require.Equal(t, "Collaboration", ratings[1].CompetencyName)
+	require.Equal(t, 3, *ratings[1].Rating)
+	require.Equal(t, "Great partner", ratings[1].Comment)
+}
+
+func Test_validateReviewRatings(t *testing.T) {
+	template := entity.ReviewTemplate{
+		Competencies: []entity.ReviewCompetency{{ID: 1, UUID: "competency-1", Name: "Delivery"}},
+		RatingLevels: []entity.ReviewRatingLevel{{Value: 1}, {Value: 2}, {Value: 3}},
+	}
+
+	t.Run("Should set the competency of the ratings", func(t *testing.T) {
+		review := entity.Review{
+			OverallRating: intPointer(2),
+			Ratings:       []entity.ReviewRating{{CompetencyUUID: "competency-1", Rating: intPointer(3), Comment: " Ships fast "}},
+		}
+
+		err := validateReviewRatings(template, &review)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), review.Ratings[0].CompetencyID)
+		require.Equal(t, "Ships fast", review.Ratings[0].Comment)
+	})
+
+	t.Run("Should return error when the rating is not in the scale", func(t *testing.T) {
+		review := entity.Review{Ratings: []entity.ReviewRating{{CompetencyUUID: "competency-1", Rating: intPointer(5)}}}
+		require.Error(t, validateReviewRatings(template, &review))
+	})
+
+	t.Run("Should return error when the overall rating is not in the scale", func(t *testing.T) {
+		review := entity.Review{OverallRating: intPointer(0)}
+		require.Error(t, validateReviewRatings(template, &review))
+	})
+
+	t.Run("Should return error when the competency is from another template", func(t *testing.T) {
+		review := entity.Review{Ratings: []entity.ReviewRating{{CompetencyUUID: "other"}}}
+		require.Error(t, validateReviewRatings(template, &review))
+	})
+}
+
+func Test_transitionReview(t *testing.T) {
+	now := time.Date(2025, time.July, 10, 12, 0, 0, 0, time.UTC)
+
+	newReview := func(status string) entity.Review {
+		return entity.Review{
+			Status:        status,
+			OverallRating: intPointer(3),
+			Ratings:       []entity.ReviewRating{{CompetencyName: "Delivery", Rating: intPointer(3)}},
+		}
+	}
+
+	t.Run("Should submit a complete draft", func(t *testing.T) {
+		review := newReview(domain.ReviewStatusDraft)
+
+		err := transitionReview(&review, domain.ReviewStatusSubmitted, now)
+		require.NoError(t, err)
+		require.Equal(t, domain.ReviewStatusSubmitted, review.Status)
+		require.Equal(t, now, *review.SubmittedAt)
+	})
+
+	t.Run("Should return error when submitting a draft with competencies not rated", func(t *testing.T) {
+		review := newReview(domain.ReviewStatusDraft)
+		review.Ratings[0].Rating = nil
+		require.Error(t, transitionReview(&review, domain.ReviewStatusSubmitted, now))
+	})
+
+	t.Run("Should return error when submitting a draft without overall rating", func(t *testing.T) {
+		review := newReview(domain.ReviewStatusDraft)
+		review.OverallRating = nil
+		require.Error(t, transitionReview(&review, domain.ReviewStatusSubmitted, now))
+	})
+
+	t.Run("Should share a submitted review", func(t *testing.T) {
+		review := newReview(domain.ReviewStatusSubmitted)
+
+		err := transitionReview(&review, domain.ReviewStatusShared, now)
+		require.NoError(t, err)
+		require.Equal(t, domain.ReviewStatusShared, review.Status)
+		require.Equal(t, now, *review.SharedAt)
+	})
+
+	t.Run("Should return error when sharing a draft", func(t *testing.T) {
+		review := newReview(domain.ReviewStatusDraft)
+		require.Error(t, transitionReview(&review, domain.ReviewStatusShared, now))
+	})
+
+	t.Run("Should reopen a submitted review", func(t *testing.T) {
+		review := newReview(domain.ReviewStatusSubmitted)
+		review.SubmittedAt = &now
+
+		err := transitionReview(&review, domain.ReviewStatusDraft, now)
+		require.NoError(t, err)
+		require.Equal(t, domain.ReviewStatusDraft, review.Status)
+		require.Nil(t, review.SubmittedAt)
+	})
+
+	t.Run("Should return error when reopening a shared review", func(t *testing.T) {
+		review := newReview(domain.ReviewStatusShared)
+		require.Error(t, transitionReview(&review, domain.ReviewStatusDraft, now))
+	})
+}
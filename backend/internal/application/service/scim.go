@@ -0,0 +1,861 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/twinj/uuid"
+)
+
+const secretTokenBytes = 32
+
+type scimApp struct {
+	cache   contract.CacheManager
+	dm      contract.DataManager
+	log     logger.Logger
+	authApp contract.AuthApp
+}
+
+func newSCIMApp(infra domain.Infrastructure, authApp contract.AuthApp) contract.SCIMApp {
+	return &scimApp{
+		cache:   infra.CacheManager(),
+		dm:      infra.DataManager(),
+		log:     infra.Logger(),
+		authApp: authApp,
+	}
+}
+
+// hashSecretToken returns the SHA-256 of a token given to a client, only the hash is stored
+func hashSecretToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+// generateSecretToken returns a random token to be given to a client, like the SCIM bearer token or the calendar feed token
+func generateSecretToken() (string, error) {
+	randomBytes := make([]byte, secretTokenBytes)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(randomBytes), nil
+}
+
+// getCompany returns the company of the SCIM request, the token was already validated by the middleware
+func (s *scimApp) getCompany(ctx context.Context) (entity.Company, error) {
+	companyUUID, err := s.authApp.GetCompanyFromContext(ctx)
+	if err != nil {
+		return entity.Company{}, err
+	}
+
+	company, err := s.dm.Company().GetCompanyByUUID(ctx, companyUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return company, resterrors.NewNotFoundError("company not found")
+		}
+		s.log.Errorw(ctx, "error getting company by UUID", logger.Err(err))
+		return company, err
+	}
+
+	return company, nil
+}
+
+// getOwnedCompany returns the company of the request when it belongs to the logged user
+func (s *scimApp) getOwnedCompany(ctx context.Context) (entity.Company, error) {
+	company, err := s.getCompany(ctx)
+	if err != nil {
+		return company, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return company, err
+	}
+
+	if company.UserOwnerID != userID {
+		s.log.Errorw(ctx, "user trying to access company they don't own",
+			logger.Int64("company_owner_id", company.UserOwnerID),
+			logger.Int64("logged_user_id", userID),
+		)
+		return company, resterrors.NewUnauthorizedError("you don't have permission to access this company")
+	}
+
+	return company, nil
+}
+
+func (s *scimApp) GenerateSCIMToken(ctx context.Context) (string, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.getOwnedCompany(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := generateSecretToken()
+	if err != nil {
+		s.log.Errorw(ctx, "error generating SCIM token", logger.Err(err))
+		return "", err
+	}
+
+	err = s.dm.SCIM().SaveSCIMToken(ctx, company.ID, hashSecretToken(token), company.UserOwnerID)
+	if err != nil {
+		s.log.Errorw(ctx, "error saving SCIM token", logger.Err(err))
+		return "", err
+	}
+
+	s.log.Infow(ctx, "SCIM token generated", logger.Int64("company_id", company.ID))
+
+	return token, nil
+}
+
+func (s *scimApp) RevokeSCIMToken(ctx context.Context) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.getOwnedCompany(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.SCIM().DeleteSCIMToken(ctx, company.ID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return resterrors.NewNotFoundError("SCIM token not found")
+		}
+		s.log.Errorw(ctx, "error deleting SCIM token", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *scimApp) ValidateSCIMToken(ctx context.Context, companyUUID, token string) error {
+	if token == "" {
+		return resterrors.NewUnauthorizedError("SCIM token is required")
+	}
+
+	company, err := s.dm.Company().GetCompanyByUUID(ctx, companyUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return resterrors.NewUnauthorizedError("invalid SCIM token")
+		}
+		s.log.Errorw(ctx, "error getting company by UUID", logger.Err(err))
+		return err
+	}
+
+	tokenHash, err := s.dm.SCIM().GetSCIMTokenHash(ctx, company.ID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return resterrors.NewUnauthorizedError("invalid SCIM token")
+		}
+		s.log.Errorw(ctx, "error getting SCIM token", logger.Err(err))
+		return err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(tokenHash), []byte(hashSecretToken(token))) != 1 {
+		s.log.Warnw(ctx, "invalid SCIM token", logger.String("company_uuid", companyUUID))
+		return resterrors.NewUnauthorizedError("invalid SCIM token")
+	}
+
+	return nil
+}
+
+// getSCIMPagination converts the 1-based SCIM start index and count to take and skip
+func getSCIMPagination(params entity.SCIMListParams) (take, skip int64) {
+	take = params.Count
+	if take < 0 {
+		take = domain.SCIMDefaultCount
+	}
+	if take > domain.SCIMMaxCount {
+		take = domain.SCIMMaxCount
+	}
+
+	skip = params.StartIndex - 1
+	if skip < 0 {
+		skip = 0
+	}
+
+	return take, skip
+}
+
+func (s *scimApp) GetUsers(ctx context.Context, params entity.SCIMListParams) ([]entity.SCIMUser, int64, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.getCompany(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	take, skip := getSCIMPagination(params)
+	users, totalRecords, err := s.dm.SCIM().GetSCIMUsers(ctx, company.ID, params.Filter, take, skip)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting SCIM users", logger.Err(err))
+		return nil, 0, err
+	}
+
+	return users, totalRecords, nil
+}
+
+func (s *scimApp) getUser(ctx context.Context, dm contract.DataManager, companyID int64, userID string) (entity.SCIMUser, error) {
+	user, err := dm.SCIM().GetSCIMUserByPersonUUID(ctx, companyID, userID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return user, resterrors.NewNotFoundError("user not found")
+		}
+		s.log.Errorw(ctx, "error getting SCIM user", logger.Err(err))
+		return user, err
+	}
+
+	return user, nil
+}
+
+func (s *scimApp) GetUser(ctx context.Context, userID string) (entity.SCIMUser, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.getCompany(ctx)
+	if err != nil {
+		return entity.SCIMUser{}, err
+	}
+
+	return s.getUser(ctx, s.dm, company.ID, userID)
+}
+
+// getSCIMUserExternalKey returns the key that identifies the user in the HR system. Some providers
+// don't send the externalId, in that case the userName is used, as it is unique too
+func getSCIMUserExternalKey(user entity.SCIMUser) string {
+	if user.ExternalID != "" {
+		return user.ExternalID
+	}
+	return user.UserName
+}
+
+func validateSCIMUser(user entity.SCIMUser) error {
+	if strings.TrimSpace(user.UserName) == "" {
+		return resterrors.NewBadRequestError("userName is required")
+	}
+	if strings.TrimSpace(user.Name) == "" {
+		return resterrors.NewBadRequestError("name is required")
+	}
+	return nil
+}
+
+// CreateUser provisions a user. It's idempotent: a user already linked by its external id is updated,
+// and a person with the same email that is not linked yet is linked instead of duplicated
+func (s *scimApp) CreateUser(ctx context.Context, user entity.SCIMUser) (entity.SCIMUser, bool, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	err := validateSCIMUser(user)
+	if err != nil {
+		return user, false, err
+	}
+
+	company, err := s.getCompany(ctx)
+	if err != nil {
+		return user, false, err
+	}
+
+	externalKey := getSCIMUserExternalKey(user)
+	created := false
+	var personID int64
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		personSync, err := tx.SCIM().GetPersonSyncByExternalID(ctx, company.ID, externalKey)
+		if err != nil && !mysqlutils.SQLNotFound(err.Error()) {
+			s.log.Errorw(ctx, "error getting person sync by external id", logger.Err(err))
+			return err
+		}
+
+		if err == nil {
+			personID = personSync.PersonID
+			return s.syncUser(ctx, tx, company, personSync, user)
+		}
+
+		personSync = entity.PersonSync{CompanyID: company.ID}
+		if user.Email != "" {
+			personSync.PersonID, err = tx.SCIM().GetUnsyncedPersonIDByEmail(ctx, company.ID, user.Email)
+			if err != nil && !mysqlutils.SQLNotFound(err.Error()) {
+				s.log.Errorw(ctx, "error getting person by email", logger.Err(err))
+				return err
+			}
+		}
+
+		if personSync.PersonID == 0 {
+			personSync.PersonID, err = tx.Person().CreatePerson(ctx, entity.Person{
+				UUID:      uuid.NewV4().String(),
+				CompanyID: company.ID,
+				Name:      user.Name,
+				CreatedBy: company.UserOwnerID,
+				Active:    true,
+			})
+			if err != nil {
+				s.log.Errorw(ctx, "error creating person", logger.Err(err))
+				return err
+			}
+			// the snapshot matches the created person, so every field receives the HR value
+			personSync.Snapshot = &entity.PersonSyncSnapshot{Name: user.Name}
+			created = true
+		}
+
+		personID = personSync.PersonID
+		return s.syncUser(ctx, tx, company, personSync, user)
+	})
+	if err != nil {
+		return user, false, err
+	}
+
+	invalidatePeopleSearchIndex(ctx, s.cache, s.log, company.ID)
+
+	syncedUser, err := s.dm.SCIM().GetSCIMUserByPersonID(ctx, personID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting SCIM user", logger.Err(err))
+		return user, created, err
+	}
+
+	s.log.Infow(ctx, "SCIM user synced",
+		logger.Int64("person_id", personID),
+		logger.String("external_id", externalKey),
+		logger.Bool("created", created),
+	)
+
+	return syncedUser, created, nil
+}
+
+func (s *scimApp) ReplaceUser(ctx context.Context, userID string, user entity.SCIMUser) (entity.SCIMUser, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	err := validateSCIMUser(user)
+	if err != nil {
+		return user, err
+	}
+
+	company, err := s.getCompany(ctx)
+	if err != nil {
+		return user, err
+	}
+
+	var personID int64
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		current, err := s.getUser(ctx, tx, company.ID, userID)
+		if err != nil {
+			return err
+		}
+		personID = current.PersonID
+
+		personSync, err := tx.SCIM().GetPersonSyncByPersonID(ctx, current.PersonID)
+		if err != nil {
+			if !mysqlutils.SQLNotFound(err.Error()) {
+				s.log.Errorw(ctx, "error getting person sync", logger.Err(err))
+				return err
+			}
+			personSync = entity.PersonSync{PersonID: current.PersonID, CompanyID: company.ID}
+		}
+
+		return s.syncUser(ctx, tx, company, personSync, user)
+	})
+	if err != nil {
+		return user, err
+	}
+
+	invalidatePeopleSearchIndex(ctx, s.cache, s.log, company.ID)
+
+	syncedUser, err := s.dm.SCIM().GetSCIMUserByPersonID(ctx, personID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting SCIM user", logger.Err(err))
+		return user, err
+	}
+
+	return syncedUser, nil
+}
+
+// syncUser applies the values received from the HR system to the person, preserving the fields edited
+// manually since the last sync, and saves the new snapshot. A new link is created when personSync.ID is 0
+func (s *scimApp) syncUser(ctx context.Context, tx contract.DataManager, company entity.Company, personSync entity.PersonSync, user entity.SCIMUser) error {
+	current, err := tx.SCIM().GetSCIMUserByPersonID(ctx, personSync.PersonID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting SCIM user", logger.Err(err))
+		return err
+	}
+
+	personSync.ExternalID = getSCIMUserExternalKey(user)
+	personSync.UserName = user.UserName
+
+	if !user.Active {
+		if current.Active {
+			err = tx.Person().DeletePerson(ctx, personSync.PersonID)
+			if err != nil {
+				s.log.Errorw(ctx, "error deactivating person", logger.Err(err))
+				return err
+			}
+		}
+		// the fields of an inactive person are not updated, so the snapshot is kept as well
+		return s.savePersonSync(ctx, tx, personSync)
+	}
+
+	if !current.Active {
+		err = tx.Person().ReactivatePerson(ctx, personSync.PersonID)
+		if err != nil {
+			s.log.Errorw(ctx, "error reactivating person", logger.Err(err))
+			return err
+		}
+	}
+
+	person, err := tx.Person().GetPersonByID(ctx, personSync.PersonID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting person by ID", logger.Err(err))
+		return err
+	}
+
+	managerID, pendingManagerRef, err := s.resolveManager(ctx, tx, company.ID, person, user.ManagerRef)
+	if err != nil {
+		return err
+	}
+
+	incoming := entity.PersonSyncSnapshot{
+		Name:       user.Name,
+		Email:      user.Email,
+		Phone:      user.Phone,
+		Position:   user.Position,
+		Department: user.Department,
+		ManagerID:  managerID,
+	}
+	mergeSyncedPerson(&person, personSync.Snapshot, incoming)
+
+	err = tx.Person().UpdatePerson(ctx, person.ID, person)
+	if err != nil {
+		s.log.Errorw(ctx, "error updating person", logger.Err(err))
+		return err
+	}
+
+	personSync.Snapshot = &incoming
+	personSync.PendingManagerRef = pendingManagerRef
+	err = s.savePersonSync(ctx, tx, personSync)
+	if err != nil {
+		return err
+	}
+
+	return s.resolvePendingManagers(ctx, tx, company.ID, person.ID, []string{person.UUID, personSync.ExternalID})
+}
+
+func (s *scimApp) savePersonSync(ctx context.Context, tx contract.DataManager, personSync entity.PersonSync) (err error) {
+	if personSync.ID == 0 {
+		_, err = tx.SCIM().CreatePersonSync(ctx, personSync)
+	} else {
+		err = tx.SCIM().UpdatePersonSync(ctx, personSync)
+	}
+	if err != nil {
+		s.log.Errorw(ctx, "error saving person sync", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
+
+// resolveManager finds the manager by SCIM id or external id. When the manager was not provisioned
+// yet, the reference is returned as pending and the manager is linked once it arrives
+func (s *scimApp) resolveManager(ctx context.Context, tx contract.DataManager, companyID int64, person entity.Person, managerRef string) (managerID *int64, pendingManagerRef *string, err error) {
+	if managerRef == "" || managerRef == person.UUID {
+		return nil, nil, nil
+	}
+
+	manager, err := tx.SCIM().GetSCIMUserByPersonUUID(ctx, companyID, managerRef)
+	if err == nil {
+		return &manager.PersonID, nil, nil
+	}
+	if !mysqlutils.SQLNotFound(err.Error()) {
+		s.log.Errorw(ctx, "error getting manager by UUID", logger.Err(err))
+		return nil, nil, err
+	}
+
+	managerSync, err := tx.SCIM().GetPersonSyncByExternalID(ctx, companyID, managerRef)
+	if err == nil {
+		if managerSync.PersonID == person.ID {
+			return nil, nil, nil
+		}
+		return &managerSync.PersonID, nil, nil
+	}
+	if !mysqlutils.SQLNotFound(err.Error()) {
+		s.log.Errorw(ctx, "error getting manager by external id", logger.Err(err))
+		return nil, nil, err
+	}
+
+	return nil, &managerRef, nil
+}
+
+// resolvePendingManagers links the people that were waiting for this manager to be provisioned
+func (s *scimApp) resolvePendingManagers(ctx context.Context, tx contract.DataManager, companyID, managerID int64, managerRefs []string) error {
+	syncs, err := tx.SCIM().GetPendingManagerSyncs(ctx, companyID, managerRefs)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting pending manager syncs", logger.Err(err))
+		return err
+	}
+
+	for _, personSync := range syncs {
+		person, err := tx.Person().GetPersonByID(ctx, personSync.PersonID)
+		if err != nil && !mysqlutils.SQLNotFound(err.Error()) {
+			s.log.Errorw(ctx, "error getting person by ID", logger.Err(err))
+			return err
+		}
+
+		if err == nil && person.ID != managerID {
+			var lastManagerID *int64
+			if personSync.Snapshot != nil {
+				lastManagerID = personSync.Snapshot.ManagerID
+			}
+
+			merged := mergeSyncedValue(int64Value(person.ManagerID), int64Value(lastManagerID), managerID, personSync.Snapshot != nil)
+			if merged == managerID {
+				err = tx.Person().UpdatePersonManager(ctx, person.ID, &managerID)
+				if err != nil {
+					s.log.Errorw(ctx, "error updating person manager", logger.Err(err))
+					return err
+				}
+			}
+		}
+
+		if personSync.Snapshot != nil {
+			personSync.Snapshot.ManagerID = &managerID
+		}
+		personSync.PendingManagerRef = nil
+		err = s.savePersonSync(ctx, tx, personSync)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// mergeSyncedValue returns the value the field must have after a sync. A field is only overwritten when
+// it still has the value of the last sync, otherwise it was edited manually. Without a previous sync the
+// field is only filled when it's empty
+func mergeSyncedValue[T comparable](current, lastSynced, incoming T, hasSnapshot bool) T {
+	var zero T
+	if !hasSnapshot {
+		if current == zero {
+			return incoming
+		}
+		return current
+	}
+
+	if current == lastSynced {
+		return incoming
+	}
+
+	return current
+}
+
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
+
+// mergeSyncedPerson applies the incoming HR values to the person fields that were not edited manually
+func mergeSyncedPerson(person *entity.Person, last *entity.PersonSyncSnapshot, incoming entity.PersonSyncSnapshot) {
+	hasSnapshot := last != nil
+	if last == nil {
+		last = &entity.PersonSyncSnapshot{}
+	}
+
+	person.Name = mergeSyncedValue(person.Name, last.Name, incoming.Name, hasSnapshot)
+	person.Email = mergeSyncedValue(person.Email, last.Email, incoming.Email, hasSnapshot)
+	person.Phone = mergeSyncedValue(person.Phone, last.Phone, incoming.Phone, hasSnapshot)
+	person.Position = mergeSyncedValue(person.Position, last.Position, incoming.Position, hasSnapshot)
+	person.Department = mergeSyncedValue(person.Department, last.Department, incoming.Department, hasSnapshot)
+
+	managerID := mergeSyncedValue(int64Value(person.ManagerID), int64Value(last.ManagerID), int64Value(incoming.ManagerID), hasSnapshot)
+	person.ManagerID = nil
+	if managerID != 0 {
+		person.ManagerID = &managerID
+	}
+}
+
+// DeactivateUser deactivates the person, its notes and history are kept
+func (s *scimApp) DeactivateUser(ctx context.Context, userID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.getCompany(ctx)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.getUser(ctx, s.dm, company.ID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !user.Active {
+		return nil
+	}
+
+	err = s.dm.Person().DeletePerson(ctx, user.PersonID)
+	if err != nil {
+		s.log.Errorw(ctx, "error deactivating person", logger.Err(err))
+		return err
+	}
+
+	invalidatePeopleSearchIndex(ctx, s.cache, s.log, company.ID)
+
+	s.log.Infow(ctx, "SCIM user deactivated", logger.Int64("person_id", user.PersonID))
+
+	return nil
+}
+
+func (s *scimApp) loadGroupMembers(ctx context.Context, group *entity.SCIMGroup) error {
+	members, err := s.dm.SCIM().GetSCIMGroupMembers(ctx, group.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting SCIM group members", logger.Err(err))
+		return err
+	}
+	group.Members = members
+
+	return nil
+}
+
+func (s *scimApp) GetGroups(ctx context.Context, params entity.SCIMListParams) ([]entity.SCIMGroup, int64, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.getCompany(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	take, skip := getSCIMPagination(params)
+	groups, totalRecords, err := s.dm.SCIM().GetSCIMGroups(ctx, company.ID, params.Filter, take, skip)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting SCIM groups", logger.Err(err))
+		return nil, 0, err
+	}
+
+	for i := range groups {
+		err = s.loadGroupMembers(ctx, &groups[i])
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	return groups, totalRecords, nil
+}
+
+func (s *scimApp) getGroup(ctx context.Context, companyID int64, groupID string) (entity.SCIMGroup, error) {
+	group, err := s.dm.SCIM().GetSCIMGroupByUUID(ctx, companyID, groupID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return group, resterrors.NewNotFoundError("group not found")
+		}
+		s.log.Errorw(ctx, "error getting SCIM group", logger.Err(err))
+		return group, err
+	}
+
+	return group, nil
+}
+
+func (s *scimApp) GetGroup(ctx context.Context, groupID string) (entity.SCIMGroup, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.getCompany(ctx)
+	if err != nil {
+		return entity.SCIMGroup{}, err
+	}
+
+	group, err := s.getGroup(ctx, company.ID, groupID)
+	if err != nil {
+		return group, err
+	}
+
+	err = s.loadGroupMembers(ctx, &group)
+	if err != nil {
+		return group, err
+	}
+
+	return group, nil
+}
+
+// CreateGroup provisions a group, a group already linked by its external id is replaced
+func (s *scimApp) CreateGroup(ctx context.Context, group entity.SCIMGroup) (entity.SCIMGroup, bool, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	if strings.TrimSpace(group.DisplayName) == "" {
+		return group, false, resterrors.NewBadRequestError("displayName is required")
+	}
+
+	company, err := s.getCompany(ctx)
+	if err != nil {
+		return group, false, err
+	}
+
+	if group.ExternalID != "" {
+		existing, err := s.dm.SCIM().GetSCIMGroupByExternalID(ctx, company.ID, group.ExternalID)
+		if err == nil {
+			syncedGroup, err := s.replaceGroup(ctx, company, existing, group)
+			return syncedGroup, false, err
+		}
+		if !mysqlutils.SQLNotFound(err.Error()) {
+			s.log.Errorw(ctx, "error getting SCIM group by external id", logger.Err(err))
+			return group, false, err
+		}
+	}
+
+	group.UUID = uuid.NewV4().String()
+	group.CompanyID = company.ID
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		memberIDs, err := s.getGroupMemberIDs(ctx, tx, company.ID, group.Members)
+		if err != nil {
+			return err
+		}
+
+		group.ID, err = tx.SCIM().CreateSCIMGroup(ctx, group)
+		if err != nil {
+			s.log.Errorw(ctx, "error creating SCIM group", logger.Err(err))
+			return err
+		}
+
+		err = tx.SCIM().ReplaceSCIMGroupMembers(ctx, group.ID, memberIDs)
+		if err != nil {
+			s.log.Errorw(ctx, "error saving SCIM group members", logger.Err(err))
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return group, false, err
+	}
+
+	created, err := s.getGroup(ctx, company.ID, group.UUID)
+	if err != nil {
+		return group, true, err
+	}
+
+	err = s.loadGroupMembers(ctx, &created)
+	if err != nil {
+		return created, true, err
+	}
+
+	return created, true, nil
+}
+
+func (s *scimApp) ReplaceGroup(ctx context.Context, groupID string, group entity.SCIMGroup) (entity.SCIMGroup, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	if strings.TrimSpace(group.DisplayName) == "" {
+		return group, resterrors.NewBadRequestError("displayName is required")
+	}
+
+	company, err := s.getCompany(ctx)
+	if err != nil {
+		return group, err
+	}
+
+	existing, err := s.getGroup(ctx, company.ID, groupID)
+	if err != nil {
+		return group, err
+	}
+
+	return s.replaceGroup(ctx, company, existing, group)
+}
+
+func (s *scimApp) replaceGroup(ctx context.Context, company entity.Company, existing, group entity.SCIMGroup) (entity.SCIMGroup, error) {
+	err := s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		memberIDs, err := s.getGroupMemberIDs(ctx, tx, company.ID, group.Members)
+		if err != nil {
+			return err
+		}
+
+		err = tx.SCIM().UpdateSCIMGroup(ctx, existing.ID, group)
+		if err != nil {
+			s.log.Errorw(ctx, "error updating SCIM group", logger.Err(err))
+			return err
+		}
+
+		err = tx.SCIM().ReplaceSCIMGroupMembers(ctx, existing.ID, memberIDs)
+		if err != nil {
+			s.log.Errorw(ctx, "error saving SCIM group members", logger.Err(err))
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return group, err
+	}
+
+	updated, err := s.getGroup(ctx, company.ID, existing.UUID)
+	if err != nil {
+		return group, err
+	}
+
+	err = s.loadGroupMembers(ctx, &updated)
+	if err != nil {
+		return updated, err
+	}
+
+	return updated, nil
+}
+
+// getGroupMemberIDs converts the members SCIM ids to person IDs
+func (s *scimApp) getGroupMemberIDs(ctx context.Context, tx contract.DataManager, companyID int64, members []entity.SCIMGroupMember) ([]int64, error) {
+	memberIDs := make([]int64, 0, len(members))
+	for _, member := range members {
+		user, err := tx.SCIM().GetSCIMUserByPersonUUID(ctx, companyID, member.PersonUUID)
+		if err != nil {
+			if mysqlutils.SQLNotFound(err.Error()) {
+				return nil, resterrors.NewBadRequestError(fmt.Sprintf("group member %s not found", member.PersonUUID))
+			}
+			s.log.Errorw(ctx, "error getting SCIM group member", logger.Err(err))
+			return nil, err
+		}
+		memberIDs = append(memberIDs, user.PersonID)
+	}
+
+	return memberIDs, nil
+}
+
+func (s *scimApp) DeleteGroup(ctx context.Context, groupID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.getCompany(ctx)
+	if err != nil {
+		return err
+	}
+
+	group, err := s.getGroup(ctx, company.ID, groupID)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.SCIM().DeleteSCIMGroup(ctx, group.ID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return resterrors.NewNotFoundError("group not found")
+		}
+		s.log.Errorw(ctx, "error deleting SCIM group", logger.Err(err))
+		return err
+	}
+
+	return nil
+}
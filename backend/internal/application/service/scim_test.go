@@ -0,0 +1,91 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func int64Pointer(value int64) *int64 {
+	return &value
+}
+
+func Test_mergeSyncedPerson(t *testing.T) {
+	last := &entity.PersonSyncSnapshot{
+		Name:       "Maria Silva",
+		Email:      "maria@acme.com",
+		Position:   "Developer",
+		Department: "Engineering",
+		ManagerID:  int64Pointer(10),
+	}
+	incoming := entity.PersonSyncSnapshot{
+		Name:       "Maria Silva Souza",
+		Email:      "maria@acme.com",
+		Position:   "Senior Developer",
+		Department: "Platform",
+		ManagerID:  int64Pointer(20),
+	}
+
+	t.Run("Should apply the HR values on fields not edited manually", func(t *testing.T) {
+		person := entity.Person{Name: "Maria Silva", Email: "maria@acme.com", Position: "Developer", Department: "Engineering", ManagerID: int64Pointer(10)}
+
+		mergeSyncedPerson(&person, last, incoming)
+
+		require.Equal(t, "Maria Silva Souza", person.Name)
+		require.Equal(t, "Senior Developer", person.Position)
+		require.Equal(t, "Platform", person.Department)
+		require.Equal(t, int64(20), *person.ManagerID)
+	})
+
+	t.Run("Should preserve the fields edited manually since the last sync", func(t *testing.T) {
+		person := entity.Person{Name: "Maria S.", Email: "maria@acme.com", Position: "Tech Lead", Department: "Engineering", ManagerID: int64Pointer(30)}
+
+		mergeSyncedPerson(&person, last, incoming)
+
+		require.Equal(t, "Maria S.", person.Name)
+		require.Equal(t, "Tech Lead", person.Position)
+		require.Equal(t, "Platform", person.Department)
+		require.Equal(t, int64(30), *person.ManagerID)
+	})
+
+	t.Run("Should only fill empty fields of a person linked without snapshot", func(t *testing.T) {
+		person := entity.Person{Name: "Maria", Email: "maria@acme.com"}
+
+		mergeSyncedPerson(&person, nil, incoming)
+
+		require.Equal(t, "Maria", person.Name)
+		require.Equal(t, "Senior Developer", person.Position)
+		require.Equal(t, "Platform", person.Department)
+		require.Equal(t, int64(20), *person.ManagerID)
+	})
+
+	t.Run("Should remove the manager when the HR system removes it", func(t *testing.T) {
+		person := entity.Person{Name: "Maria Silva", ManagerID: int64Pointer(10)}
+		withoutManager := incoming
+		withoutManager.ManagerID = nil
+
+		mergeSyncedPerson(&person, last, withoutManager)
+
+		require.Nil(t, person.ManagerID)
+	})
+}
+
+func Test_getSCIMPagination(t *testing.T) {
+	take, skip := getSCIMPagination(entity.SCIMListParams{StartIndex: 1, Count: 10})
+	require.Equal(t, int64(10), take)
+	require.Equal(t, int64(0), skip)
+
+	take, skip = getSCIMPagination(entity.SCIMListParams{StartIndex: 21, Count: 1000})
+	require.Equal(t, int64(200), take)
+	require.Equal(t, int64(20), skip)
+
+	take, skip = getSCIMPagination(entity.SCIMListParams{StartIndex: 0, Count: 0})
+	require.Equal(t, int64(0), take)
+	require.Equal(t, int64(0), skip)
+}
+
+func Test_getSCIMUserExternalKey(t *testing.T) {
+	require.Equal(t, "00u1", getSCIMUserExternalKey(entity.SCIMUser{ExternalID: "00u1", UserName: "maria@acme.com"}))
+	require.Equal(t, "maria@acme.com", getSCIMUserExternalKey(entity.SCIMUser{UserName: "maria@acme.com"}))
+}
@@ -9,16 +9,32 @@ import (
 )
 
 type Apps struct {
-	User      contract.UserApp
-	Auth      contract.AuthApp
-	Company   contract.CompanyApp
-	Person    contract.PersonApp
-	Dashboard contract.DashboardApp
-	AI        contract.AIApp
+	User         contract.UserApp
+	Auth         contract.AuthApp
+	Company      contract.CompanyApp
+	Person       contract.PersonApp
+	Dashboard    contract.DashboardApp
+	AI           contract.AIApp
+	SCIM         contract.SCIMApp
+	Reminder     contract.ReminderApp
+	Meeting      contract.MeetingApp
+	ActionItem   contract.ActionItemApp
+	Cadence      contract.CadenceApp
+	Calendar     contract.CalendarApp
+	Goal         contract.GoalApp
+	Review       contract.ReviewApp
+	Feedback     contract.FeedbackRequestApp
+	Competency   contract.CompetencyApp
+	NoteTemplate contract.NoteTemplateApp
+	Tag          contract.TagApp
+	Attachment   contract.NoteAttachmentApp
+	Activity     contract.ActivityApp
+	Outbox       contract.OutboxApp
+	Job          contract.JobApp
 }
 
 // New to get instance of all services
-func New(infra domain.Infrastructure, aiProvider contract.AIProvider, accessTokenDuration time.Duration) (*Apps, error) {
+func New(infra domain.Infrastructure, aiProvider contract.AIProvider, accessTokenDuration time.Duration, adminToken string) (*Apps, error) {
 	if err := validateInfrastructure(infra); err != nil {
 		return nil, err
 	}
@@ -26,6 +42,10 @@ func New(infra domain.Infrastructure, aiProvider contract.AIProvider, accessToke
 	userApp := newUserApp(infra)
 	authApp := newAuthApp(infra, userApp, accessTokenDuration)
 	personApp := newPersonApp(infra, authApp)
+	reminderApp := newReminderApp(infra, userApp, personApp)
+	actionItemApp := newActionItemApp(infra, authApp, userApp, personApp)
+	cadenceApp := newCadenceApp(infra, userApp, personApp)
+	meetingApp := newMeetingApp(infra, authApp, personApp)
 
 	// Initialize AI service if AI Provider is provided
 	var aiApp contract.AIApp
@@ -35,13 +55,31 @@ func New(infra domain.Infrastructure, aiProvider contract.AIProvider, accessToke
 		personApp.SetAIApp(aiApp)
 	}
 
+	jobApp := newJobApp(infra, aiApp, personApp, adminToken)
+
 	return &Apps{
-		User:      userApp,
-		Auth:      authApp,
-		Company:   newCompanyApp(infra, authApp),
-		Person:    personApp,
-		Dashboard: newDashboardService(infra, authApp, personApp),
-		AI:        aiApp,
+		User:         userApp,
+		Auth:         authApp,
+		Company:      newCompanyApp(infra, authApp),
+		Person:       personApp,
+		Dashboard:    newDashboardService(infra, authApp, personApp, reminderApp, actionItemApp, cadenceApp),
+		AI:           aiApp,
+		SCIM:         newSCIMApp(infra, authApp),
+		Reminder:     reminderApp,
+		Meeting:      meetingApp,
+		ActionItem:   actionItemApp,
+		Cadence:      cadenceApp,
+		Calendar:     newCalendarApp(infra, authApp, userApp, personApp, meetingApp, reminderApp, cadenceApp),
+		Goal:         newGoalApp(infra, authApp, userApp, personApp),
+		Review:       newReviewApp(infra, authApp, userApp, personApp),
+		Feedback:     newFeedbackRequestApp(infra, authApp, personApp),
+		Competency:   newCompetencyApp(infra, authApp, userApp, personApp),
+		NoteTemplate: newNoteTemplateApp(infra, authApp, userApp, personApp),
+		Tag:          newTagApp(infra, authApp, personApp),
+		Attachment:   newNoteAttachmentApp(infra, personApp),
+		Activity:     newActivityApp(infra, authApp, personApp),
+		Outbox:       newOutboxApp(infra, jobApp),
+		Job:          jobApp,
 	}, nil
 }
 
@@ -67,5 +105,17 @@ func validateInfrastructure(infra domain.Infrastructure) error {
 		return errors.New("validator is required")
 	}
 
+	if infra.JobQueue() == nil {
+		return errors.New("job queue is required")
+	}
+
+	if infra.NoteSearchEngine() == nil {
+		return errors.New("note search engine is required")
+	}
+
+	if infra.BlobStorage() == nil {
+		return errors.New("blob storage is required")
+	}
+
 	return nil
 }
@@ -18,13 +18,16 @@ type allMocks struct {
 	mockAuthRepo *mocks.MockAuthRepo
 	mockUserRepo *mocks.MockUserRepo
 
-	mockCacheManager *mocks.MockCacheManager
-	mockCrypto       *mocks.MockCrypto
-	mockValidator    validator.Validator
-	mockLogger       logger.Logger
+	mockCacheManager     *mocks.MockCacheManager
+	mockCrypto           *mocks.MockCrypto
+	mockValidator        validator.Validator
+	mockJobQueue         *mocks.MockJobQueue
+	mockNoteSearchEngine *mocks.MockNoteSearchEngine
+	mockBlobStorage      *mocks.MockBlobStorage
+	mockLogger           logger.Logger
 
 	mockUserSvc *mocks.MockUserApp
-	
+
 	mockAIProvider *mocks.MockAIProvider
 
 	mockDomain *mocks.MockInfrastructure
@@ -51,6 +54,9 @@ func newServiceTestMock(t *testing.T) (m allMocks, ctrl *gomock.Controller) {
 
 	userSvc := mocks.NewMockUserApp(ctrl)
 	aiProvider := mocks.NewMockAIProvider(ctrl)
+	jobQueue := mocks.NewMockJobQueue(ctrl)
+	noteSearchEngine := mocks.NewMockNoteSearchEngine(ctrl)
+	blobStorage := mocks.NewMockBlobStorage(ctrl)
 
 	domainMock := mocks.NewMockInfrastructure(ctrl)
 	domainMock.EXPECT().DataManager().Return(dm).AnyTimes()
@@ -58,22 +64,28 @@ func newServiceTestMock(t *testing.T) (m allMocks, ctrl *gomock.Controller) {
 	domainMock.EXPECT().CacheManager().Return(cm).AnyTimes()
 	domainMock.EXPECT().Crypto().Return(crypto).AnyTimes()
 	domainMock.EXPECT().Validator().Return(v).AnyTimes()
+	domainMock.EXPECT().JobQueue().Return(jobQueue).AnyTimes()
+	domainMock.EXPECT().NoteSearchEngine().Return(noteSearchEngine).AnyTimes()
+	domainMock.EXPECT().BlobStorage().Return(blobStorage).AnyTimes()
 
 	m = allMocks{
-		mockDataManager:  dm,
-		mockUserRepo:     userRepo,
-		mockCacheManager: cm,
-		mockAuthRepo:     authRepo,
-		mockCrypto:       crypto,
-		mockUserSvc:      userSvc,
-		mockAIProvider:   aiProvider,
-		mockDomain:       domainMock,
-		mockValidator:    v,
-		mockLogger:       log,
+		mockDataManager:      dm,
+		mockUserRepo:         userRepo,
+		mockCacheManager:     cm,
+		mockAuthRepo:         authRepo,
+		mockCrypto:           crypto,
+		mockUserSvc:          userSvc,
+		mockAIProvider:       aiProvider,
+		mockDomain:           domainMock,
+		mockValidator:        v,
+		mockJobQueue:         jobQueue,
+		mockNoteSearchEngine: noteSearchEngine,
+		mockBlobStorage:      blobStorage,
+		mockLogger:           log,
 	}
 
 	// validate func New
-	s, err := New(domainMock, aiProvider, time.Minute)
+	s, err := New(domainMock, aiProvider, time.Minute, "")
 	require.NoError(t, err)
 	require.NotNil(t, s)
 
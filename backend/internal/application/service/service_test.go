@@ -13,7 +13,7 @@ func TestNew(t *testing.T) {
 		m, ctrl := newServiceTestMock(t)
 		defer ctrl.Finish()
 
-		apps, err := New(m.mockDomain, m.mockAIProvider, time.Hour)
+		apps, err := New(m.mockDomain, m.mockAIProvider, time.Hour, "")
 		assert.NoError(t, err)
 		assert.NotNil(t, apps)
 	})
@@ -24,7 +24,7 @@ func TestNew(t *testing.T) {
 		m.mockDomain.EXPECT().Logger().Return(nil)
 		defer ctrl.Finish()
 
-		apps, err := New(m.mockDomain, m.mockAIProvider, time.Hour)
+		apps, err := New(m.mockDomain, m.mockAIProvider, time.Hour, "")
 		assert.Error(t, err)
 		assert.Nil(t, apps)
 	})
@@ -49,6 +49,9 @@ func TestValidateInfrastructure(t *testing.T) {
 				m.mockDomain.EXPECT().CacheManager().Return(m.mockCacheManager)
 				m.mockDomain.EXPECT().Crypto().Return(m.mockCrypto)
 				m.mockDomain.EXPECT().Validator().Return(m.mockValidator)
+				m.mockDomain.EXPECT().JobQueue().Return(m.mockJobQueue)
+				m.mockDomain.EXPECT().NoteSearchEngine().Return(m.mockNoteSearchEngine)
+				m.mockDomain.EXPECT().BlobStorage().Return(m.mockBlobStorage)
 			},
 			wantErr: "",
 		},
@@ -97,6 +100,45 @@ func TestValidateInfrastructure(t *testing.T) {
 			},
 			wantErr: "validator is required",
 		},
+		{
+			name: "Missing job queue",
+			setup: func(m allMocks) {
+				m.mockDomain.EXPECT().Logger().Return(m.mockLogger)
+				m.mockDomain.EXPECT().DataManager().Return(m.mockDataManager)
+				m.mockDomain.EXPECT().CacheManager().Return(m.mockCacheManager)
+				m.mockDomain.EXPECT().Crypto().Return(m.mockCrypto)
+				m.mockDomain.EXPECT().Validator().Return(m.mockValidator)
+				m.mockDomain.EXPECT().JobQueue().Return(nil)
+			},
+			wantErr: "job queue is required",
+		},
+		{
+			name: "Missing note search engine",
+			setup: func(m allMocks) {
+				m.mockDomain.EXPECT().Logger().Return(m.mockLogger)
+				m.mockDomain.EXPECT().DataManager().Return(m.mockDataManager)
+				m.mockDomain.EXPECT().CacheManager().Return(m.mockCacheManager)
+				m.mockDomain.EXPECT().Crypto().Return(m.mockCrypto)
+				m.mockDomain.EXPECT().Validator().Return(m.mockValidator)
+				m.mockDomain.EXPECT().JobQueue().Return(m.mockJobQueue)
+				m.mockDomain.EXPECT().NoteSearchEngine().Return(nil)
+			},
+			wantErr: "note search engine is required",
+		},
+		{
+			name: "Missing blob storage",
+			setup: func(m allMocks) {
+				m.mockDomain.EXPECT().Logger().Return(m.mockLogger)
+				m.mockDomain.EXPECT().DataManager().Return(m.mockDataManager)
+				m.mockDomain.EXPECT().CacheManager().Return(m.mockCacheManager)
+				m.mockDomain.EXPECT().Crypto().Return(m.mockCrypto)
+				m.mockDomain.EXPECT().Validator().Return(m.mockValidator)
+				m.mockDomain.EXPECT().JobQueue().Return(m.mockJobQueue)
+				m.mockDomain.EXPECT().NoteSearchEngine().Return(m.mockNoteSearchEngine)
+				m.mockDomain.EXPECT().BlobStorage().Return(nil)
+			},
+			wantErr: "blob storage is required",
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,343 @@
+package service
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/twinj/uuid"
+)
+
+const maxTagDescriptionLength = 255
+
+type tagApp struct {
+	dm        contract.DataManager
+	log       logger.Logger
+	authApp   contract.AuthApp
+	personApp *personApp
+}
+
+func newTagApp(infra domain.Infrastructure, authApp contract.AuthApp, personApp *personApp) contract.TagApp {
+	return &tagApp{
+		dm:        infra.DataManager(),
+		log:       infra.Logger(),
+		authApp:   authApp,
+		personApp: personApp,
+	}
+}
+
+// normalizeTagNames returns the names as they are stored, without repetitions, or a bad request error for an invalid name
+func normalizeTagNames(names []string) ([]string, error) {
+	normalized := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		tagName, ok := entity.NormalizeTagName(name)
+		if !ok {
+			return nil, resterrors.NewBadRequestError("invalid tag: " + name)
+		}
+
+		if seen[tagName] {
+			continue
+		}
+		seen[tagName] = true
+		normalized = append(normalized, tagName)
+	}
+	return normalized, nil
+}
+
+// getAuthorizedTag loads a tag by UUID and checks that it belongs to the company
+func (s *tagApp) getAuthorizedTag(ctx context.Context, tagUUID string, companyID int64) (entity.Tag, error) {
+	tag, err := s.dm.Tag().GetTagByUUID(ctx, tagUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return tag, resterrors.NewNotFoundError("tag not found")
+		}
+		s.log.Errorw(ctx, "error getting tag by UUID", logger.Err(err))
+		return tag, err
+	}
+
+	if tag.CompanyID != companyID {
+		return tag, resterrors.NewNotFoundError("tag not found")
+	}
+
+	return tag, nil
+}
+
+func (s *tagApp) CreateTag(ctx context.Context, tag entity.Tag) (entity.Tag, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return tag, err
+	}
+
+	name, ok := entity.NormalizeTagName(tag.Name)
+	if !ok {
+		return tag, resterrors.NewBadRequestError("invalid tag name: use letters, digits, - and _, with at least one letter")
+	}
+	if utf8.RuneCountInString(tag.Description) > maxTagDescriptionLength {
+		return tag, resterrors.NewBadRequestError("tag description is too long")
+	}
+
+	existing, err := s.dm.Tag().GetTagByName(ctx, company.ID, name)
+	if err != nil && !mysqlutils.SQLNotFound(err.Error()) {
+		s.log.Errorw(ctx, "error getting tag by name", logger.Err(err))
+		return tag, err
+	}
+
+	// a tag already used by the notes becomes curated, keeping its notes
+	if err == nil {
+		if existing.Curated {
+			return tag, resterrors.NewConflictError("tag already exists")
+		}
+
+		existing.Curated = true
+		existing.Description = tag.Description
+		err = s.dm.Tag().UpdateTag(ctx, existing.ID, existing)
+		if err != nil {
+			s.log.Errorw(ctx, "error curating tag", logger.Err(err))
+			return tag, err
+		}
+
+		s.log.Infow(ctx, "tag curated successfully", logger.String("tag_uuid", existing.UUID))
+		return existing, nil
+	}
+
+	tag = entity.Tag{
+		UUID:        uuid.NewV4().String(),
+		CompanyID:   company.ID,
+		Name:        name,
+		Description: tag.Description,
+		Curated:     true,
+		CreatedAt:   time.Now(),
+	}
+
+	tag.ID, err = s.dm.Tag().CreateTag(ctx, tag)
+	if err != nil {
+		s.log.Errorw(ctx, "error creating tag", logger.Err(err))
+		return tag, err
+	}
+
+	s.log.Infow(ctx, "tag created successfully", logger.String("tag_uuid", tag.UUID))
+
+	return tag, nil
+}
+
+func (s *tagApp) GetCompanyTags(ctx context.Context) ([]entity.Tag, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := s.dm.Tag().GetTagsByCompany(ctx, company.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting company tags", logger.Err(err))
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (s *tagApp) DeleteTag(ctx context.Context, tagUUID string) error {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return err
+	}
+
+	tag, err := s.getAuthorizedTag(ctx, tagUUID, company.ID)
+	if err != nil {
+		return err
+	}
+
+	err = s.dm.Tag().DeleteTag(ctx, tag.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error deleting tag", logger.Err(err))
+		return err
+	}
+
+	s.log.Infow(ctx, "tag deleted successfully", logger.String("tag_uuid", tagUUID))
+
+	return nil
+}
+
+func (s *tagApp) SetNoteTags(ctx context.Context, noteUUID string, names []string) ([]entity.Tag, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	note, err := s.dm.Note().GetNoteByUUID(ctx, noteUUID)
+	if err != nil {
+		if mysqlutils.SQLNotFound(err.Error()) {
+			return nil, resterrors.NewNotFoundError("note not found")
+		}
+		s.log.Errorw(ctx, "error getting note by UUID", logger.Err(err))
+		return nil, err
+	}
+
+	userID, err := s.authApp.GetLoggedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.personApp.validateUserCompanyAccess(ctx, userID, note.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	names, err = normalizeTagNames(names)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.dm.WithTransaction(ctx, func(tx contract.DataManager) error {
+		err := tx.Tag().DeleteNoteTags(ctx, note.ID, domain.NoteTagSourceManual)
+		if err != nil {
+			return err
+		}
+
+		return addNoteTags(ctx, tx, note, names, domain.NoteTagSourceManual)
+	})
+	if err != nil {
+		s.log.Errorw(ctx, "error setting note tags", logger.Err(err))
+		return nil, err
+	}
+
+	tags, err := s.dm.Tag().GetNoteTags(ctx, note.ID)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting note tags", logger.Err(err))
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+func (s *tagApp) GetTagAnalytics(ctx context.Context, filters entity.TagAnalyticsFilters) (entity.TagAnalytics, error) {
+	s.log.Info(ctx, "Process Started")
+	defer s.log.Info(ctx, "Process Finished")
+
+	var analytics entity.TagAnalytics
+
+	company, err := s.personApp.getAuthorizedCompany(ctx)
+	if err != nil {
+		return analytics, err
+	}
+
+	filters, err = normalizeTagAnalyticsFilters(filters, time.Now())
+	if err != nil {
+		return analytics, err
+	}
+
+	analytics.ByPerson, err = s.dm.Tag().GetTagCountsByPerson(ctx, company.ID, filters)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting tag counts by person", logger.Err(err))
+		return analytics, err
+	}
+
+	analytics.OverTime, err = s.dm.Tag().GetTagCountsOverTime(ctx, company.ID, filters)
+	if err != nil {
+		s.log.Errorw(ctx, "error getting tag counts over time", logger.Err(err))
+		return analytics, err
+	}
+
+	return analytics, nil
+}
+
+// normalizeTagAnalyticsFilters validates the filters, defaulting to monthly counts of the last months
+func normalizeTagAnalyticsFilters(filters entity.TagAnalyticsFilters, now time.Time) (entity.TagAnalyticsFilters, error) {
+	var err error
+	filters.TagNames, err = normalizeTagNames(filters.TagNames)
+	if err != nil {
+		return filters, err
+	}
+
+	switch filters.Interval {
+	case "":
+		filters.Interval = domain.TagAnalyticsIntervalMonth
+	case domain.TagAnalyticsIntervalWeek, domain.TagAnalyticsIntervalMonth:
+	default:
+		return filters, resterrors.NewBadRequestError("interval must be week or month")
+	}
+
+	if filters.From != nil && filters.To != nil && !filters.From.Before(*filters.To) {
+		return filters, resterrors.NewBadRequestError("from must be before to")
+	}
+
+	if filters.From == nil && filters.To == nil {
+		from := now.AddDate(0, -domain.TagAnalyticsDefaultMonths, 0)
+		filters.From = &from
+	}
+
+	return filters, nil
+}
+
+// addNoteTags adds the tags to the note, creating the tags the company does not have yet
+func addNoteTags(ctx context.Context, tx contract.DataManager, note entity.Note, names []string, source string) error {
+	for _, name := range names {
+		tagID, err := tx.Tag().GetOrCreateTag(ctx, entity.Tag{
+			UUID:      uuid.NewV4().String(),
+			CompanyID: note.CompanyID,
+			Name:      name,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = tx.Tag().AddNoteTag(ctx, note.ID, tagID, source)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rebuildNoteTags replaces the tags of the note written as #tags with the ones of its current content, returning
+// how many tags it has. The tags set manually are kept. It runs in the transaction that writes the note
+func (s *personApp) rebuildNoteTags(ctx context.Context, tx contract.DataManager, note entity.Note) (int, error) {
+	err := tx.Tag().DeleteNoteTags(ctx, note.ID, domain.NoteTagSourceContent)
+	if err != nil {
+		return 0, err
+	}
+
+	names := entity.ParseTags(note.Content)
+	err = addNoteTags(ctx, tx, note, names, domain.NoteTagSourceContent)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(names), nil
+}
+
+// fillTimelineTags sets the tag names of each timeline entry
+func (s *personApp) fillTimelineTags(ctx context.Context, timeline []entity.UnifiedTimelineEntry) error {
+	if len(timeline) == 0 {
+		return nil
+	}
+
+	noteUUIDs := make([]string, len(timeline))
+	for i, entry := range timeline {
+		noteUUIDs[i] = entry.UUID
+	}
+
+	names, err := s.dm.Tag().GetTagNamesByNotes(ctx, noteUUIDs)
+	if err != nil {
+		return err
+	}
+
+	for i := range timeline {
+		timeline[i].Tags = names[timeline[i].UUID]
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func Test_normalizeTagNames(t *testing.T) {
+	names, err := normalizeTagNames([]string{"#Promotion", "promotion", "burnout-risk"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"promotion", "burnout-risk"}, names)
+
+	_, err = normalizeTagNames([]string{"project x"})
+	require.Error(t, err)
+	require.Equal(t, http.StatusBadRequest, err.(resterrors.RestErr).StatusCode())
+}
+
+func Test_normalizeTagAnalyticsFilters(t *testing.T) {
+	now := time.Date(2025, time.June, 15, 10, 0, 0, 0, time.UTC)
+
+	t.Run("Should default to monthly counts of the last months", func(t *testing.T) {
+		filters, err := normalizeTagAnalyticsFilters(entity.TagAnalyticsFilters{TagNames: []string{"#Hiring"}}, now)
+		require.NoError(t, err)
+		require.Equal(t, []string{"hiring"}, filters.TagNames)
+		require.Equal(t, domain.TagAnalyticsIntervalMonth, filters.Interval)
+		require.Equal(t, now.AddDate(0, -domain.TagAnalyticsDefaultMonths, 0), *filters.From)
+		require.Nil(t, filters.To)
+	})
+
+	t.Run("Should keep the date range", func(t *testing.T) {
+		to := now.AddDate(0, -1, 0)
+		filters, err := normalizeTagAnalyticsFilters(entity.TagAnalyticsFilters{To: &to, Interval: domain.TagAnalyticsIntervalWeek}, now)
+		require.NoError(t, err)
+		require.Nil(t, filters.From)
+		require.Equal(t, to, *filters.To)
+		require.Equal(t, domain.TagAnalyticsIntervalWeek, filters.Interval)
+	})
+
+	t.Run("Should validate the interval and the date range", func(t *testing.T) {
+		_, err := normalizeTagAnalyticsFilters(entity.TagAnalyticsFilters{Interval: "day"}, now)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, err.(resterrors.RestErr).StatusCode())
+
+		_, err = normalizeTagAnalyticsFilters(entity.TagAnalyticsFilters{From: &now, To: &now}, now)
+		require.Error(t, err)
+		require.Equal(t, http.StatusBadRequest, err.(resterrors.RestErr).StatusCode())
+	})
+}
+
+func TestPersonApp_rebuildNoteTags(t *testing.T) {
+	ctx := context.Background()
+	m, ctrl := newServiceTestMock(t)
+	defer ctrl.Finish()
+
+	tagRepo := mocks.NewMockTagRepo(ctrl)
+	m.mockDataManager.EXPECT().Tag().Return(tagRepo).AnyTimes()
+
+	note := entity.Note{ID: 7, CompanyID: 10, Content: "Talked about the #Promotion and #promotion again, then #hiring"}
+
+	gomock.InOrder(
+		tagRepo.EXPECT().DeleteNoteTags(ctx, int64(7), domain.NoteTagSourceContent).Return(nil),
+		tagRepo.EXPECT().GetOrCreateTag(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, tag entity.Tag) (int64, error) {
+			require.Equal(t, int64(10), tag.CompanyID)
+			require.Equal(t, "promotion", tag.Name)
+			require.NotEmpty(t, tag.UUID)
+			return 1, nil
+		}),
+		tagRepo.EXPECT().AddNoteTag(ctx, int64(7), int64(1), domain.NoteTagSourceContent).Return(nil),
+		tagRepo.EXPECT().GetOrCreateTag(ctx, gomock.Any()).Return(int64(2), nil),
+		tagRepo.EXPECT().AddNoteTag(ctx, int64(7), int64(2), domain.NoteTagSourceContent).Return(nil),
+	)
+
+	s := newPersonApp(m.mockDomain, nil)
+	count, err := s.rebuildNoteTags(ctx, m.mockDataManager, note)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
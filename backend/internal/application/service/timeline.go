@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/go_utils/mysqlutils"
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+var (
+	timelineDirections = []string{
+		domain.TimelineDirectionAll,
+		domain.TimelineDirectionAboutPerson,
+		domain.TimelineDirectionFromPerson,
+		domain.TimelineDirectionBilateral,
+	}
+	timelineSorts   = []string{domain.TimelineSortNewest, domain.TimelineSortOldest}
+	timelinePeriods = map[string][3]int{ // years, months and days before now
+		"7d":  {0, 0, 7},
+		"30d": {0, 0, 30},
+		"3m":  {0, 3, 0},
+		"6m":  {0, 6, 0},
+		"1y":  {1, 0, 0},
+	}
+	feedbackCategories = []string{
+		domain.FeedbackCategoryPerformance,
+		domain.FeedbackCategoryBehavior,
+		domain.FeedbackCategorySkill,
+		domain.FeedbackCategoryCollaboration,
+	}
+)
+
+// normalizeTimelineFilters validates the filters, resolves the author to its user id and turns the period preset
+// into the start of the date range. An explicit from or to takes precedence over the period
+func (s *personApp) normalizeTimelineFilters(ctx context.Context, filters entity.TimelineFilters, now time.Time) (entity.TimelineFilters, error) {
+	if filters.Direction != "" && !slices.Contains(timelineDirections, filters.Direction) {
+		return filters, resterrors.NewBadRequestError("invalid direction: " + filters.Direction)
+	}
+
+	if filters.Sort != "" && !slices.Contains(timelineSorts, filters.Sort) {
+		return filters, resterrors.NewBadRequestError("invalid sort: " + filters.Sort)
+	}
+
+	for _, category := range filters.FeedbackCategories {
+		if !slices.Contains(feedbackCategories, category) {
+			return filters, resterrors.NewBadRequestError("invalid feedback category: " + category)
+		}
+	}
+
+	if filters.Period != "" && filters.Period != domain.TimelinePeriodAll {
+		offset, ok := timelinePeriods[filters.Period]
+		if !ok {
+			return filters, resterrors.NewBadRequestError("invalid period: " + filters.Period)
+		}
+		if filters.From == nil && filters.To == nil {
+			from := now.AddDate(-offset[0], -offset[1], -offset[2])
+			filters.From = &from
+		}
+	}
+
+	if filters.From != nil && filters.To != nil && !filters.From.Before(*filters.To) {
+		return filters, resterrors.NewBadRequestError("from must be before to")
+	}
+
+	if filters.AuthorUUID != "" {
+		authorID, err := s.dm.User().GetUserIDByUUID(ctx, filters.AuthorUUID)
+		if err != nil {
+			if mysqlutils.SQLNotFound(err.Error()) {
+				return filters, resterrors.NewNotFoundError("author not found")
+			}
+			s.log.Errorw(ctx, "error getting author by UUID", logger.Err(err))
+			return filters, err
+		}
+		filters.AuthorUserID = &authorID
+	}
+
+	return filters, nil
+}
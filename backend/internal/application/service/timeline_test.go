@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPersonApp_normalizeTimelineFilters(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, time.June, 15, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Should turn the period into the start of the date range", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		s := newPersonApp(m.mockDomain, nil)
+		filters, err := s.normalizeTimelineFilters(ctx, entity.TimelineFilters{Period: "3m"}, now)
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2025, time.March, 15, 12, 0, 0, 0, time.UTC), *filters.From)
+		require.Nil(t, filters.To)
+
+		filters, err = s.normalizeTimelineFilters(ctx, entity.TimelineFilters{Period: domain.TimelinePeriodAll}, now)
+		require.NoError(t, err)
+		require.Nil(t, filters.From)
+	})
+
+	t.Run("Should keep the explicit date range over the period", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		to := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+		s := newPersonApp(m.mockDomain, nil)
+		filters, err := s.normalizeTimelineFilters(ctx, entity.TimelineFilters{Period: "7d", To: &to}, now)
+		require.NoError(t, err)
+		require.Nil(t, filters.From)
+		require.Equal(t, to, *filters.To)
+	})
+
+	t.Run("Should resolve the author", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		m.mockUserRepo.EXPECT().GetUserIDByUUID(gomock.Any(), "author-uuid").Return(int64(7), nil)
+
+		s := newPersonApp(m.mockDomain, nil)
+		filters, err := s.normalizeTimelineFilters(ctx, entity.TimelineFilters{AuthorUUID: "author-uuid"}, now)
+		require.NoError(t, err)
+		require.Equal(t, int64(7), *filters.AuthorUserID)
+	})
+
+	t.Run("Should return not found when the author does not exist", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		m.mockUserRepo.EXPECT().GetUserIDByUUID(gomock.Any(), "author-uuid").Return(int64(0), sql.ErrNoRows)
+
+		s := newPersonApp(m.mockDomain, nil)
+		_, err := s.normalizeTimelineFilters(ctx, entity.TimelineFilters{AuthorUUID: "author-uuid"}, now)
+		require.Error(t, err)
+		require.Equal(t, http.StatusNotFound, err.(resterrors.RestErr).StatusCode())
+	})
+
+	t.Run("Should validate the filters", func(t *testing.T) {
+		m, ctrl := newServiceTestMock(t)
+		defer ctrl.Finish()
+
+		s := newPersonApp(m.mockDomain, nil)
+		from := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+		for _, filters := range []entity.TimelineFilters{
+			{Direction: "sideways"},
+			{Sort: "random"},
+			{Period: "2w"},
+			{FeedbackCategories: []string{domain.FeedbackCategorySkill, "luck"}},
+			{From: &from, To: &from},
+		} {
+			_, err := s.normalizeTimelineFilters(ctx, filters, now)
+			require.Error(t, err)
+			require.Equal(t, http.StatusBadRequest, err.(resterrors.RestErr).StatusCode())
+		}
+	})
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/diegoclair/go_utils/logger"
 	"github.com/diegoclair/go_utils/mysqlutils"
@@ -271,6 +272,19 @@ func (s *userApp) UpdateUserPreferences(ctx context.Context, preferences entity.
 
 	preferences.UserID = userID
 
+	// keep the current timezone when the client does not send it
+	if preferences.Timezone == "" {
+		current, err := s.GetUserPreferences(ctx)
+		if err != nil {
+			return preferences, err
+		}
+		preferences.Timezone = current.Timezone
+	}
+
+	if _, err := time.LoadLocation(preferences.Timezone); err != nil {
+		return preferences, resterrors.NewBadRequestError("invalid timezone")
+	}
+
 	err = s.dm.User().UpdateUserPreferences(ctx, userID, preferences)
 	if err != nil {
 		s.log.Errorw(ctx, "error updating user preferences", logger.Err(err))
@@ -280,6 +294,7 @@ func (s *userApp) UpdateUserPreferences(ctx context.Context, preferences entity.
 	s.log.Infow(ctx, "user preferences updated successfully",
 		logger.Int64("user_id", userID),
 		logger.String("theme", preferences.Theme),
+		logger.String("timezone", preferences.Timezone),
 	)
 
 	return s.dm.User().GetUserPreferences(ctx, userID)
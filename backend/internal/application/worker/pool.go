@@ -0,0 +1,187 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/cron"
+)
+
+// JobRunner is the application side of the pool, it knows the queues, the schedules and how to run each job
+type JobRunner interface {
+	GetQueues() []entity.JobQueueConfig
+	GetSchedules() []entity.JobSchedule
+	EnqueueScheduledJob(ctx context.Context, schedule entity.JobSchedule, at time.Time) error
+	DequeueJobs(ctx context.Context, queue string, limit int) ([]entity.Job, error)
+	RunJob(ctx context.Context, job entity.Job) error
+}
+
+// Pool runs the jobs of each queue with at most the concurrency of the queue, and enqueues the scheduled jobs
+type Pool struct {
+	runner       JobRunner
+	log          logger.Logger
+	pollInterval time.Duration
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	loops    sync.WaitGroup // polling and scheduling loops
+	jobs     sync.WaitGroup // running jobs
+	cancel   context.CancelFunc
+}
+
+func NewPool(runner JobRunner, log logger.Logger, pollInterval time.Duration) *Pool {
+	return &Pool{
+		runner:       runner,
+		log:          log,
+		pollInterval: pollInterval,
+		stop:         make(chan struct{}),
+	}
+}
+
+// Start starts polling the queues and enqueueing the scheduled jobs, in background
+func (p *Pool) Start(ctx context.Context) {
+	// The jobs are not tied to the caller context, so a stop drains the running jobs
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	p.cancel = cancel
+
+	for _, queue := range p.runner.GetQueues() {
+		p.loops.Add(1)
+		go p.poll(runCtx, queue)
+	}
+
+	schedules := p.parseSchedules(ctx)
+	if len(schedules) > 0 {
+		p.loops.Add(1)
+		go p.schedule(runCtx, schedules)
+	}
+
+	p.log.Infow(ctx, "job pool started", logger.Int("queues", len(p.runner.GetQueues())), logger.Int("schedules", len(schedules)))
+}
+
+// poll claims jobs of the queue while it has free slots, running each one in its own goroutine
+func (p *Pool) poll(ctx context.Context, queue entity.JobQueueConfig) {
+	defer p.loops.Done()
+
+	slots := make(chan struct{}, max(queue.Concurrency, 1))
+	freed := make(chan struct{}, 1)
+
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if free := cap(slots) - len(slots); free > 0 {
+			jobs, err := p.runner.DequeueJobs(ctx, queue.Name, free)
+			if err != nil {
+				p.log.Errorw(ctx, "error polling job queue", logger.String("queue", queue.Name), logger.Err(err))
+			}
+
+			for _, job := range jobs {
+				slots <- struct{}{}
+				p.jobs.Add(1)
+
+				go func(job entity.Job) {
+					defer p.jobs.Done()
+					defer func() {
+						<-slots
+						select {
+						case freed <- struct{}{}:
+						default:
+						}
+					}()
+
+					err := p.runner.RunJob(ctx, job)
+					if err != nil {
+						p.log.Errorw(ctx, "error running job", logger.String("job_uuid", job.UUID), logger.Err(err))
+					}
+				}(job)
+			}
+		}
+
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+		case <-freed: // a slot was freed, poll again without waiting for the ticker
+		}
+	}
+}
+
+type parsedSchedule struct {
+	entity.JobSchedule
+	cron cron.Schedule
+}
+
+func (p *Pool) parseSchedules(ctx context.Context) []parsedSchedule {
+	var schedules []parsedSchedule
+	for _, schedule := range p.runner.GetSchedules() {
+		parsed, err := cron.Parse(schedule.Cron)
+		if err != nil {
+			p.log.Errorw(ctx, "invalid job schedule, skipping it", logger.String("schedule", schedule.Name), logger.Err(err))
+			continue
+		}
+		schedules = append(schedules, parsedSchedule{JobSchedule: schedule, cron: parsed})
+	}
+	return schedules
+}
+
+// schedule enqueues the job of each schedule when it is due
+func (p *Pool) schedule(ctx context.Context, schedules []parsedSchedule) {
+	defer p.loops.Done()
+
+	next := make([]time.Time, len(schedules))
+	for i, schedule := range schedules {
+		next[i] = schedule.cron.Next(time.Now())
+	}
+
+	ticker := time.NewTicker(p.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+		for i, schedule := range schedules {
+			if next[i].IsZero() || now.Before(next[i]) {
+				continue
+			}
+
+			err := p.runner.EnqueueScheduledJob(ctx, schedule.JobSchedule, next[i])
+			if err != nil {
+				p.log.Errorw(ctx, "error enqueueing scheduled job", logger.String("schedule", schedule.Name), logger.Err(err))
+				continue // tried again on the next tick
+			}
+			next[i] = schedule.cron.Next(now)
+		}
+	}
+}
+
+// Stop stops claiming jobs and waits for the running jobs to finish. When ctx ends first, the running jobs
+// are cancelled and the error of ctx is returned, they run again once their lease expires
+func (p *Pool) Stop(ctx context.Context) error {
+	p.stopOnce.Do(func() { close(p.stop) })
+
+	done := make(chan struct{})
+	go func() {
+		p.loops.Wait()
+		p.jobs.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		p.log.Info(ctx, "job pool drained")
+		return nil
+	case <-ctx.Done():
+		if p.cancel != nil {
+			p.cancel()
+		}
+		return ctx.Err()
+	}
+}
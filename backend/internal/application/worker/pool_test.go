@@ -0,0 +1,133 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRunner serves the pending jobs of a single queue and runs them with the run func
+type fakeRunner struct {
+	mu      sync.Mutex
+	queue   entity.JobQueueConfig
+	pending []entity.Job
+	run     func(ctx context.Context, job entity.Job) error
+}
+
+func (r *fakeRunner) GetQueues() []entity.JobQueueConfig {
+	return []entity.JobQueueConfig{r.queue}
+}
+
+func (r *fakeRunner) GetSchedules() []entity.JobSchedule {
+	return nil
+}
+
+func (r *fakeRunner) EnqueueScheduledJob(ctx context.Context, schedule entity.JobSchedule, at time.Time) error {
+	return nil
+}
+
+func (r *fakeRunner) DequeueJobs(ctx context.Context, queue string, limit int) ([]entity.Job, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	limit = min(limit, len(r.pending))
+	jobs := r.pending[:limit]
+	r.pending = r.pending[limit:]
+	return jobs, nil
+}
+
+func (r *fakeRunner) RunJob(ctx context.Context, job entity.Job) error {
+	return r.run(ctx, job)
+}
+
+func newFakeJobs(count int) []entity.Job {
+	jobs := make([]entity.Job, count)
+	for i := range jobs {
+		jobs[i] = entity.Job{UUID: fmt.Sprintf("job-%d", i), Queue: "test"}
+	}
+	return jobs
+}
+
+func TestPool(t *testing.T) {
+	ctx := context.Background()
+	log := logger.NewNoop()
+
+	t.Run("Should run all the jobs without exceeding the queue concurrency", func(t *testing.T) {
+		var running, maxRunning, done atomic.Int32
+		runner := &fakeRunner{
+			queue:   entity.JobQueueConfig{Name: "test", Concurrency: 2},
+			pending: newFakeJobs(6),
+			run: func(ctx context.Context, job entity.Job) error {
+				current := running.Add(1)
+				for {
+					seen := maxRunning.Load()
+					if current <= seen || maxRunning.CompareAndSwap(seen, current) {
+						break
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+				running.Add(-1)
+				done.Add(1)
+				return nil
+			},
+		}
+
+		p := NewPool(runner, log, time.Hour)
+		p.Start(ctx)
+
+		require.Eventually(t, func() bool { return done.Load() == 6 }, time.Second, 5*time.Millisecond)
+		require.NoError(t, p.Stop(ctx))
+		require.Equal(t, int32(2), maxRunning.Load())
+	})
+
+	t.Run("Should wait for the running jobs when stopping", func(t *testing.T) {
+		started := make(chan struct{})
+		var finished atomic.Bool
+		runner := &fakeRunner{
+			queue:   entity.JobQueueConfig{Name: "test", Concurrency: 1},
+			pending: newFakeJobs(1),
+			run: func(ctx context.Context, job entity.Job) error {
+				close(started)
+				time.Sleep(30 * time.Millisecond)
+				finished.Store(true)
+				return nil
+			},
+		}
+
+		p := NewPool(runner, log, time.Hour)
+		p.Start(ctx)
+		<-started
+
+		require.NoError(t, p.Stop(ctx))
+		require.True(t, finished.Load())
+	})
+
+	t.Run("Should cancel the running jobs when the drain times out", func(t *testing.T) {
+		started := make(chan struct{})
+		runner := &fakeRunner{
+			queue:   entity.JobQueueConfig{Name: "test", Concurrency: 1},
+			pending: newFakeJobs(1),
+			run: func(ctx context.Context, job entity.Job) error {
+				close(started)
+				<-ctx.Done()
+				return ctx.Err()
+			},
+		}
+
+		p := NewPool(runner, log, time.Hour)
+		p.Start(ctx)
+		<-started
+
+		stopCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+		require.ErrorIs(t, p.Stop(stopCtx), context.DeadlineExceeded)
+	})
+
+}
@@ -0,0 +1,80 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+)
+
+// Task is the work done by a worker on each run
+type Task func(ctx context.Context) error
+
+// Worker runs a task periodically in background until it is stopped
+type Worker struct {
+	name     string
+	interval time.Duration
+	task     Task
+	log      logger.Logger
+
+	stop     chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+	cancel   context.CancelFunc
+}
+
+func New(name string, interval time.Duration, log logger.Logger, task Task) *Worker {
+	return &Worker{
+		name:     name,
+		interval: interval,
+		task:     task,
+		log:      log,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+// Start runs the task right away and then on every interval, in background
+func (w *Worker) Start(ctx context.Context) {
+	// The runs are not tied to the caller context, so a stop waits for the current run to finish
+	runCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	w.cancel = cancel
+
+	go func() {
+		defer close(w.done)
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			err := w.task(runCtx)
+			if err != nil {
+				w.log.Errorw(runCtx, "worker run failed", logger.String("worker", w.name), logger.Err(err))
+			}
+
+			select {
+			case <-w.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	w.log.Infow(ctx, "worker started", logger.String("worker", w.name))
+}
+
+// Stop waits for the current run to finish and stops the worker. When ctx ends first,
+// the current run is cancelled and the error of ctx is returned
+func (w *Worker) Stop(ctx context.Context) error {
+	w.stopOnce.Do(func() { close(w.stop) })
+
+	select {
+	case <-w.done:
+		w.log.Infow(ctx, "worker stopped", logger.String("worker", w.name))
+		return nil
+	case <-ctx.Done():
+		w.cancel()
+		return ctx.Err()
+	}
+}
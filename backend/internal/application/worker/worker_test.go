@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/logger"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorker(t *testing.T) {
+	ctx := context.Background()
+	log := logger.NewNoop()
+
+	t.Run("Should run the task on every interval until stopped", func(t *testing.T) {
+		var runs atomic.Int32
+		w := New("test", 10*time.Millisecond, log, func(ctx context.Context) error {
+			runs.Add(1)
+			return errors.New("failed runs do not stop the worker")
+		})
+
+		w.Start(ctx)
+		require.Eventually(t, func() bool { return runs.Load() >= 3 }, time.Second, 5*time.Millisecond)
+
+		require.NoError(t, w.Stop(ctx))
+		stoppedRuns := runs.Load()
+		time.Sleep(30 * time.Millisecond)
+		require.Equal(t, stoppedRuns, runs.Load())
+	})
+
+	t.Run("Should wait for the current run when stopping", func(t *testing.T) {
+		started := make(chan struct{})
+		var finished atomic.Bool
+		w := New("test", time.Hour, log, func(ctx context.Context) error {
+			close(started)
+			time.Sleep(30 * time.Millisecond)
+			finished.Store(true)
+			return nil
+		})
+
+		w.Start(ctx)
+		<-started
+
+		require.NoError(t, w.Stop(ctx))
+		require.True(t, finished.Load())
+	})
+
+	t.Run("Should cancel the current run when the stop times out", func(t *testing.T) {
+		started := make(chan struct{})
+		w := New("test", time.Hour, log, func(ctx context.Context) error {
+			close(started)
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		w.Start(ctx)
+		<-started
+
+		stopCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+
+		require.ErrorIs(t, w.Stop(stopCtx), context.DeadlineExceeded)
+	})
+}
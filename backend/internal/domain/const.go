@@ -1,5 +1,7 @@
 package domain
 
+import "github.com/diegoclair/leaderpro/internal/domain/entity"
+
 // Note types constants
 const (
 	NoteTypeOneOnOne    = "one_on_one"
@@ -27,3 +29,336 @@ const (
 	AIPromptTypeLeadershipCoach    = "leadership_coach"
 	AIPromptTypeAttributeExtraction = "attribute_extraction"
 )
+
+// Person attribute sources constants
+const (
+	AttributeSourceManual      = entity.AttributeSourceManual
+	AttributeSourceAIExtracted = entity.AttributeSourceAIExtracted
+	AttributeSourceImported    = entity.AttributeSourceImported
+)
+
+// People search fields constants
+const (
+	PersonSearchFieldName       = "name"
+	PersonSearchFieldEmail      = "email"
+	PersonSearchFieldPosition   = "position"
+	PersonSearchFieldDepartment = "department"
+	PersonSearchFieldAttributes = "attributes"
+)
+
+// Person import fields constants, used in the column mapping
+const (
+	PersonImportFieldName         = "name"
+	PersonImportFieldEmail        = "email"
+	PersonImportFieldPosition     = "position"
+	PersonImportFieldDepartment   = "department"
+	PersonImportFieldPhone        = "phone"
+	PersonImportFieldBirthday     = "birthday"
+	PersonImportFieldStartDate    = "start_date"
+	PersonImportFieldIsManager    = "is_manager"
+	PersonImportFieldManagerEmail = "manager_email"
+	PersonImportFieldGender       = "gender"
+	PersonImportFieldNotes        = "notes"
+	PersonImportFieldCity         = "city"
+	PersonImportFieldState        = "state"
+	PersonImportFieldCountry      = "country"
+)
+
+// Person import status constants
+const (
+	PersonImportStatusPending   = "pending" // valid file waiting for the job that creates its people
+	PersonImportStatusCompleted = "completed"
+	PersonImportStatusFailed    = "failed"
+
+	PersonImportRowStatusValid   = "valid"
+	PersonImportRowStatusCreated = "created"
+	PersonImportRowStatusError   = "error"
+	PersonImportRowStatusSkipped = "skipped"
+)
+
+// SCIM filter attributes constants, only equality filters on these attributes are supported
+const (
+	SCIMFilterUserName    = "userName"
+	SCIMFilterExternalID  = "externalId"
+	SCIMFilterEmail       = "emails.value"
+	SCIMFilterDisplayName = "displayName"
+)
+
+// SCIM pagination constants
+const (
+	SCIMDefaultCount = 100
+	SCIMMaxCount     = 200
+)
+
+// Reminder types constants
+const (
+	ReminderTypeBirthday        = "birthday"
+	ReminderTypeWorkAnniversary = "work_anniversary"
+)
+
+// Reminders window constants, in days
+const (
+	RemindersDefaultDays   = 7
+	RemindersMaxDays       = 90
+	RemindersDashboardDays = 7
+)
+
+// DefaultTimezone is used when the user did not set a timezone in the preferences
+const DefaultTimezone = "UTC"
+
+// Meeting status constants
+const (
+	MeetingStatusScheduled = "scheduled"
+	MeetingStatusHeld      = "held"
+	MeetingStatusCanceled  = "canceled"
+)
+
+// Meeting origin constants
+const (
+	MeetingOriginScheduled = "scheduled"
+	MeetingOriginNote      = "note"
+)
+
+// Agenda item authors constants
+const (
+	AgendaItemAddedByManager = "manager"
+	AgendaItemAddedByReport  = "report"
+)
+
+// Action item owner constants
+const (
+	ActionItemOwnerManager = "manager"
+	ActionItemOwnerReport  = "report"
+)
+
+// Action item status constants
+const (
+	ActionItemStatusOpen     = "open"
+	ActionItemStatusDone     = "done"
+	ActionItemStatusCanceled = "canceled"
+)
+
+// 1:1 cadence constants
+const (
+	OneOnOneCadenceWeekly   = "weekly"
+	OneOnOneCadenceBiweekly = "biweekly"
+	OneOnOneCadenceMonthly  = "monthly"
+	OneOnOneCadenceCustom   = "custom"
+)
+
+// 1:1 cadence limits
+const (
+	OneOnOneCadenceMaxCustomDays = 365
+	OneOnOnesDueDefaultDays      = 7
+	OneOnOnesDueMaxDays          = 90
+	OneOnOnesDueDashboardDays    = 7
+)
+
+// Calendar integration constants
+const (
+	CalendarFeedUpcomingDays   = 90 // reminders and 1:1s due published in the feed
+	CalendarFeedPastDays       = 30 // scheduled meetings kept in the feed after their date
+	CalendarImportMaxEvents    = 1000
+	MeetingDefaultDurationMins = 30 // duration of the feed events of meetings without duration
+)
+
+// Calendar import result of each event
+const (
+	CalendarImportStatusCreated   = "created"
+	CalendarImportStatusLinked    = "linked"
+	CalendarImportStatusUpdated   = "updated"
+	CalendarImportStatusCanceled  = "canceled"
+	CalendarImportStatusUnchanged = "unchanged"
+	CalendarImportStatusSkipped   = "skipped"
+)
+
+// Goal type constants
+const (
+	GoalTypeCareer      = "career"
+	GoalTypePerformance = "performance"
+	GoalTypeLearning    = "learning"
+)
+
+// Goal status constants
+const (
+	GoalStatusActive    = "active"
+	GoalStatusAchieved  = "achieved"
+	GoalStatusAbandoned = "abandoned"
+)
+
+// Goal check-in confidence constants
+const (
+	GoalConfidenceOnTrack  = "on_track"
+	GoalConfidenceAtRisk   = "at_risk"
+	GoalConfidenceOffTrack = "off_track"
+)
+
+// Goal timeline entry types constants
+const (
+	GoalTimelineGoalCreated   = "goal_created"
+	GoalTimelineCheckIn       = "check_in"
+	GoalTimelineGoalAchieved  = "goal_achieved"
+	GoalTimelineGoalAbandoned = "goal_abandoned"
+)
+
+// Review status constants, a review goes from draft to submitted and then shared with the person
+const (
+	ReviewStatusDraft     = "draft"
+	ReviewStatusSubmitted = "submitted"
+	ReviewStatusShared    = "shared"
+)
+
+// Review evidence constants
+const (
+	ReviewEvidenceMaxEntries      = 500 // timeline entries of the period used as evidence
+	ReviewHighlightMaxLength      = 280 // characters of the 1:1 notes kept as highlights
+	ReviewTemplateMaxRatingLevels = 10
+)
+
+// Feedback request question types constants
+const (
+	FeedbackQuestionTypeText   = "text"
+	FeedbackQuestionTypeRating = "rating"
+)
+
+// Feedback request constants
+const (
+	FeedbackRequestDefaultExpirationDays = 14
+	FeedbackRequestMaxExpirationDays     = 90
+	FeedbackRequestMaxRespondents        = 50
+	FeedbackRequestMaxQuestions          = 20
+	FeedbackRatingMin                    = 1
+	FeedbackRatingMax                    = 5
+	FeedbackAnonymousRespondentName      = "Anonymous" // author of the notes of anonymous requests
+)
+
+// Competency framework constants
+const (
+	CompetencyFrameworkMaxLevels       = 15
+	CompetencyFrameworkMaxCompetencies = 50
+)
+
+// Note template constants
+const (
+	NoteTemplateScopeBuiltIn = "built_in" // available to every company, read only
+	NoteTemplateScopeCompany = "company"  // shared by the company
+	NoteTemplateScopeUser    = "user"     // personal template of the user
+
+	NoteTemplateMaxSections = 20
+
+	// Variables replaced when a template is instantiated for a person
+	NoteTemplateVariablePersonName      = "{{person_name}}"
+	NoteTemplateVariablePersonFirstName = "{{person_first_name}}"
+	NoteTemplateVariableManagerName     = "{{manager_name}}"
+	NoteTemplateVariableCompanyName     = "{{company_name}}"
+	NoteTemplateVariableDate            = "{{date}}"
+)
+
+// Note revision constants
+const (
+	NoteUndeleteRetentionDays = 30 // days a deleted note can still be restored
+)
+
+// Outbox constants
+const (
+	OutboxStatusPending    = "pending"
+	OutboxStatusProcessing = "processing"
+	OutboxStatusDone       = "done"
+	OutboxStatusFailed     = "failed" // no attempts left, kept for inspection
+
+	OutboxEventNoteAttributesExtraction = "note.attributes_extraction"
+	OutboxEventPeopleImport             = "people.import"
+
+	OutboxMaxAttempts        = 5
+	OutboxBatchSize          = 20
+	OutboxPollIntervalSecs   = 5
+	OutboxLeaseSecs          = 120 // a processing event whose worker died is retried after the lease
+	OutboxRetryBaseDelaySecs = 30  // doubled after each failed attempt
+	OutboxRetryMaxDelaySecs  = 3600
+)
+
+// Job queue constants
+const (
+	JobStatusPending = "pending"
+	JobStatusRunning = "running"
+	JobStatusDead    = "dead" // no attempts left, kept until an admin requeues it
+
+	JobLeaseExpiredError = "lease expired" // last error of a job dead-lettered when its last attempt never finished
+
+	JobQueueBackendMySQL = "mysql"
+	JobQueueBackendRedis = "redis"
+
+	JobQueueDefault = "default"
+	JobQueueAI      = "ai" // jobs calling the AI provider, with a lower concurrency
+
+	JobTypeNoteAttributesExtraction = "note.attributes_extraction"
+	JobTypeConversationCleanup      = "ai.conversation_cleanup"
+	JobTypeAttachmentCleanup        = "note.attachment_cleanup"
+	JobTypePeopleImport             = "people.import"
+	JobTypeReminders                = "person.reminders"
+
+	JobMaxAttempts           = 5
+	JobLeaseSecs             = 300 // a running job whose worker died runs again after the lease
+	JobPollIntervalSecs      = 2
+	JobRetryBaseDelaySecs    = 30 // doubled after each failed attempt
+	JobRetryMaxDelaySecs     = 3600
+	JobDefaultConcurrency    = 4
+	JobAIConcurrency         = 2
+	JobDeadListLimit         = 100
+	ConversationCleanupBatch = 1000
+	ReminderCompanyBatch     = 100
+)
+
+// Note search constants
+const (
+	NoteSearchEngineMySQL = "mysql"
+	NoteSearchSnippetSize = 200 // characters of the content shown around the first match
+)
+
+// Note tag constants
+const (
+	NoteTagSourceContent = "content" // #tag written in the content of the note
+	NoteTagSourceManual  = "manual"  // tag set on the note without writing it in the content
+
+	TagAnalyticsIntervalWeek  = "week"
+	TagAnalyticsIntervalMonth = "month"
+	TagAnalyticsDefaultMonths = 6 // period of the analytics when no date range is given
+)
+
+// Note attachment constants
+const (
+	BlobStorageBackendLocal = "local"
+	BlobStorageBackendS3    = "s3"
+
+	NoteAttachmentMaxSizeBytes      = 10 << 20 // 10MB
+	NoteAttachmentMaxPerNote        = 10
+	NoteAttachmentMaxFileNameLength = 255
+	NoteAttachmentDownloadTTLMins   = 10 // how long a download URL works
+	NoteAttachmentCleanupBatch      = 100
+)
+
+// Person timeline constants
+const (
+	TimelineDirectionAll         = "all"
+	TimelineDirectionAboutPerson = "about-person" // feedback received: notes about the person and mentions of them in other notes
+	TimelineDirectionFromPerson  = "from-person"  // feedback given: notes about the person where they talked about other people
+	TimelineDirectionBilateral   = "bilateral"    // entries between the person and other people, in any direction
+
+	TimelinePeriodAll = "all"
+
+	TimelineSortNewest = "newest"
+	TimelineSortOldest = "oldest"
+)
+
+// Activity feed constants
+const (
+	ActivityTypeNoteCreated        = "note_created"        // 1:1 or observation about the person, detail is the note type
+	ActivityTypeFeedbackCreated    = "feedback_created"    // feedback about the person, detail is the feedback type
+	ActivityTypePersonMentioned    = "person_mentioned"    // the person was mentioned in a note about someone else, detail is the name of the note person
+	ActivityTypeGoalUpdated        = "goal_updated"        // detail is the goal event: goal_created, check_in, goal_achieved or goal_abandoned
+	ActivityTypeProfileUpdated     = "profile_updated"     // detail is the comma separated changed fields
+	ActivityTypeAttributeExtracted = "attribute_extracted" // attributes extracted by the AI from a note, detail is the comma separated keys
+	ActivityTypeReminder           = "reminder"            // birthday or work anniversary of the person today, detail is the reminder type
+
+	ActivityDetailMaxLength = 255
+)
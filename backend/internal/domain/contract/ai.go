@@ -12,7 +12,7 @@ type AIProvider interface {
 	Chat(ctx context.Context, req entity.ChatRequest, systemPrompt string, contextPrompt string) (entity.ChatResponse, error)
 	
 	// ExtractAttributes extracts attributes from text using AI and returns usage info
-	ExtractAttributes(ctx context.Context, req entity.ExtractionRequest, extractionPrompt string) (map[string]string, entity.AIUsage, error)
+	ExtractAttributes(ctx context.Context, req entity.ExtractionRequest, extractionPrompt string) (map[string]entity.ExtractedAttribute, entity.AIUsage, error)
 	
 	// GetProviderName returns the provider name
 	GetProviderName() string
@@ -0,0 +1,29 @@
+package contract
+
+import (
+	"context"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+// JobQueue stores the background jobs until a worker runs them, backed by MySQL or Redis.
+//   - The methods that change a job return domain.ErrJobNotFound when it is not in the expected state
+type JobQueue interface {
+	// Enqueue adds a pending job, doing nothing when a job with the same UUID is already in the queue
+	Enqueue(ctx context.Context, job entity.Job) (err error)
+	// Dequeue claims up to limit due jobs of the queue, counting the attempt and leasing them until leaseUntil.
+	// Running jobs whose lease expired are due again
+	Dequeue(ctx context.Context, queue string, now, leaseUntil time.Time, limit int) (jobs []entity.Job, err error)
+	// Complete removes a job that ran successfully
+	Complete(ctx context.Context, job entity.Job) (err error)
+	// Retry puts back a job that failed, due at runAt
+	Retry(ctx context.Context, job entity.Job, lastError string, runAt time.Time) (err error)
+	// DeadLetter keeps a job that failed with no attempts left out of the queue, until it is requeued
+	DeadLetter(ctx context.Context, job entity.Job, lastError string, now time.Time) (err error)
+	// Requeue puts a dead job back in its queue with its attempts reset
+	Requeue(ctx context.Context, jobUUID string, now time.Time) (err error)
+
+	GetStats(ctx context.Context, queue string, now time.Time) (stats entity.JobQueueStats, err error)
+	GetDeadJobs(ctx context.Context, queue string, limit int) (jobs []entity.Job, err error)
+}
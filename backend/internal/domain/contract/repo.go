@@ -15,8 +15,19 @@ type DataManager interface {
 	Company() CompanyRepo
 	Person() PersonRepo
 	Note() NoteRepo
+	Meeting() MeetingRepo
+	ActionItem() ActionItemRepo
+	Goal() GoalRepo
+	Review() ReviewRepo
+	FeedbackRequest() FeedbackRequestRepo
+	Competency() CompetencyRepo
+	NoteTemplate() NoteTemplateRepo
+	Tag() TagRepo
+	Activity() ActivityRepo
+	Outbox() OutboxRepo
 	Auth() AuthRepo
 	AI() AIRepo
+	SCIM() SCIMRepo
 }
 
 type AuthRepo interface {
@@ -37,6 +48,11 @@ type UserRepo interface {
 	GetUserPreferences(ctx context.Context, userID int64) (preferences entity.UserPreferences, err error)
 	CreateUserPreferences(ctx context.Context, preferences entity.UserPreferences) (createdID int64, err error)
 	UpdateUserPreferences(ctx context.Context, userID int64, preferences entity.UserPreferences) (err error)
+
+	// Calendar feed
+	SaveCalendarFeedToken(ctx context.Context, userID int64, tokenHash string) (err error)
+	GetUserIDByCalendarFeedToken(ctx context.Context, tokenHash string) (userID int64, err error)
+	DeleteCalendarFeedToken(ctx context.Context, userID int64) (err error)
 }
 
 type CompanyRepo interface {
@@ -44,6 +60,8 @@ type CompanyRepo interface {
 	GetCompanyByID(ctx context.Context, companyID int64) (company entity.Company, err error)
 	GetCompanyByUUID(ctx context.Context, companyUUID string) (company entity.Company, err error)
 	GetCompaniesByUser(ctx context.Context, userID int64) (companies []entity.Company, err error)
+	// GetCompaniesAfterID returns the active companies with an id greater than afterID, ordered by id, to go through all of them in batches
+	GetCompaniesAfterID(ctx context.Context, afterID int64, limit int) (companies []entity.Company, err error)
 	UpdateCompany(ctx context.Context, companyID int64, company entity.Company) (err error)
 	DeleteCompany(ctx context.Context, companyID int64) (err error)
 }
@@ -56,12 +74,34 @@ type PersonRepo interface {
 	GetPeopleCountByCompany(ctx context.Context, companyID int64) (count int64, err error)
 	UpdatePerson(ctx context.Context, personID int64, person entity.Person) (err error)
 	DeletePerson(ctx context.Context, personID int64) (err error)
+	ReactivatePerson(ctx context.Context, personID int64) (err error)
 	SearchPeople(ctx context.Context, companyID int64, search string) (people []entity.Person, err error)
+	UpdatePersonManager(ctx context.Context, personID int64, managerID *int64) (err error)
+	UpdatePersonCadence(ctx context.Context, personID int64, cadence *string, cadenceDays *int) (err error)
+	CreatePersonAddress(ctx context.Context, address entity.Address) (createdID int64, err error)
+
+	// Person Import
+	CreatePersonImport(ctx context.Context, personImport entity.PersonImport) (createdID int64, err error)
+	GetPersonImportByUUID(ctx context.Context, importUUID string) (personImport entity.PersonImport, err error)
+	// UpdatePersonImportResult sets the result of a processed import and removes its file
+	UpdatePersonImportResult(ctx context.Context, personImport entity.PersonImport) (err error)
 
 	// Person Attributes (AI-related)
 	CreatePersonAttribute(ctx context.Context, attr entity.PersonAttribute) (entity.PersonAttribute, error)
+	GetPersonAttributes(ctx context.Context, personID int64) (attributes []entity.PersonAttribute, err error)
+	GetPersonAttribute(ctx context.Context, personID int64, attributeKey string) (attr entity.PersonAttribute, err error)
+	GetAttributesByCompany(ctx context.Context, companyID int64) (attributes []entity.PersonAttribute, err error)
 	GetPersonAttributesMap(ctx context.Context, personID int64) (map[string]string, error)
-	BulkUpsertPersonAttributes(ctx context.Context, personID int64, attributes map[string]string, source string, sourceNoteID *int64) error
+	UpsertPersonAttribute(ctx context.Context, attr entity.PersonAttribute) (err error)
+	DeletePersonAttribute(ctx context.Context, personID int64, attributeKey string) (err error)
+	BulkUpsertPersonAttributes(ctx context.Context, personID int64, attributes map[string]entity.ExtractedAttribute, source string, sourceNoteID *int64) error
+
+	// Person Attribute History
+	AddPersonAttributeHistory(ctx context.Context, attr entity.PersonAttribute, changedAt time.Time) (err error)
+	ClosePersonAttributeHistory(ctx context.Context, personID int64, attributeKey string, closedAt time.Time) (err error)
+	GetPersonAttributeHistory(ctx context.Context, personID int64, attributeKey string) (history []entity.PersonAttributeHistory, err error)
+	GetPersonAttributesAsOf(ctx context.Context, personID int64, asOf time.Time) (history []entity.PersonAttributeHistory, err error)
+	GetPersonAttributeChanges(ctx context.Context, personID int64, since time.Time, limit int64) (history []entity.PersonAttributeHistory, err error)
 }
 
 type NoteRepo interface {
@@ -78,14 +118,281 @@ type NoteRepo interface {
 	GetMentionsByPerson(ctx context.Context, mentionedPersonID int64, take, skip int64) (mentions []entity.NoteMention, totalRecords int64, err error)
 	GetPersonTimeline(ctx context.Context, personID int64, filters entity.TimelineFilters, take, skip int64) (timeline []entity.UnifiedTimelineEntry, totalRecords int64, err error)
 	GetPersonMentions(ctx context.Context, mentionedPersonID int64, take, skip int64) (mentions []entity.MentionEntry, totalRecords int64, err error)
+	GetPersonTimelinePage(ctx context.Context, personID int64, filters entity.TimelineFilters, page entity.CursorPage) (timeline []entity.UnifiedTimelineEntry, info entity.CursorPageInfo, err error)
+	GetPersonMentionsPage(ctx context.Context, mentionedPersonID int64, page entity.CursorPage) (mentions []entity.MentionEntry, info entity.CursorPageInfo, err error)
 	DeleteMentionsByNote(ctx context.Context, noteID int64) (err error)
 
-	// Dashboard stats methods (based on one-on-one notes)
+	// Note revision methods
+	// CreateNoteRevision adds a revision to the note with the next revision number
+	CreateNoteRevision(ctx context.Context, revision entity.NoteRevision) (createdID int64, err error)
+	// GetNoteRevisions returns the revisions of the note, newest first
+	GetNoteRevisions(ctx context.Context, noteID int64) (revisions []entity.NoteRevision, err error)
+	GetNoteRevisionByNumber(ctx context.Context, noteID int64, number int) (revision entity.NoteRevision, err error)
+	// GetDeletedNoteByUUID returns a soft deleted note with its deletion date
+	GetDeletedNoteByUUID(ctx context.Context, noteUUID string) (note entity.Note, err error)
+	UndeleteNote(ctx context.Context, noteID int64) (err error)
+
+	// Note attachment methods
+	CreateNoteAttachment(ctx context.Context, attachment entity.NoteAttachment) (createdID int64, err error)
+	GetNoteAttachmentByUUID(ctx context.Context, attachmentUUID string) (attachment entity.NoteAttachment, err error)
+	// GetNoteAttachments returns the attachments of the note, oldest first
+	GetNoteAttachments(ctx context.Context, noteID int64) (attachments []entity.NoteAttachment, err error)
+	CountNoteAttachments(ctx context.Context, noteID int64) (count int64, err error)
+	// DeleteNoteAttachment marks the attachment as deleted, its content is removed later by the cleanup
+	DeleteNoteAttachment(ctx context.Context, attachmentID int64) (err error)
+	// GetExpiredNoteAttachments returns up to limit attachments deleted by the users or of notes deleted before noteDeletedBefore,
+	// whose content can be removed
+	GetExpiredNoteAttachments(ctx context.Context, noteDeletedBefore time.Time, limit int) (attachments []entity.NoteAttachment, err error)
+	// PurgeNoteAttachment removes the attachment from the database, after its content was removed
+	PurgeNoteAttachment(ctx context.Context, attachmentID int64) (err error)
+}
+
+type MeetingRepo interface {
+	CreateMeeting(ctx context.Context, meeting entity.Meeting) (createdID int64, err error)
+	GetMeetingByUUID(ctx context.Context, meetingUUID string) (meeting entity.Meeting, err error)
+	GetMeetingsByPerson(ctx context.Context, personID int64, status string, take, skip int64) (meetings []entity.Meeting, totalRecords int64, err error)
+	UpdateMeeting(ctx context.Context, meetingID int64, meeting entity.Meeting) (err error)
+	DeleteMeetingsFromNote(ctx context.Context, noteID int64) (err error)
+	GetNextScheduledMeeting(ctx context.Context, personID int64) (meeting entity.Meeting, err error)
+	// GetNextScheduledDatesByCompany returns the earliest scheduled meeting date of each person of the company
+	GetNextScheduledDatesByCompany(ctx context.Context, companyID int64) (dates map[int64]time.Time, err error)
+	GetMeetingsByICalUID(ctx context.Context, userID int64, icalUID string) (meetings []entity.Meeting, err error)
+	GetScheduledMeetingsByUser(ctx context.Context, userID int64, from time.Time) (meetings []entity.Meeting, err error)
+
+	// Agenda items
+	CreateAgendaItem(ctx context.Context, item entity.MeetingAgendaItem) (createdID int64, err error)
+	GetAgendaItemsByMeeting(ctx context.Context, meetingID int64) (items []entity.MeetingAgendaItem, err error)
+	GetAgendaItemByUUID(ctx context.Context, meetingID int64, itemUUID string) (item entity.MeetingAgendaItem, err error)
+	UpdateAgendaItem(ctx context.Context, itemID int64, item entity.MeetingAgendaItem) (err error)
+	DeleteAgendaItem(ctx context.Context, itemID int64) (err error)
+
+	// Dashboard stats methods (based on held meetings)
 	GetOneOnOnesCountThisMonth(ctx context.Context, companyID int64) (count int64, err error)
 	GetAverageFrequencyDays(ctx context.Context, companyID int64) (avgDays float64, err error)
 	GetLastMeetingDate(ctx context.Context, companyID int64) (lastDate *time.Time, err error)
 }
 
+type ActionItemRepo interface {
+	CreateActionItem(ctx context.Context, item entity.ActionItem) (createdID int64, err error)
+	GetActionItemByUUID(ctx context.Context, itemUUID string) (item entity.ActionItem, err error)
+	GetActionItemsByPerson(ctx context.Context, personID int64, status string, take, skip int64) (items []entity.ActionItem, totalRecords int64, err error)
+	GetOpenActionItemsByPerson(ctx context.Context, personID int64) (items []entity.ActionItem, err error)
+	// GetActionItemsToCarryOver returns the open items of the person that are not in the agenda of a scheduled meeting yet
+	GetActionItemsToCarryOver(ctx context.Context, personID int64) (items []entity.ActionItem, err error)
+	// GetOverdueActionItemsByCompany returns the open items of active people with due date before today (YYYY-MM-DD)
+	GetOverdueActionItemsByCompany(ctx context.Context, companyID int64, today time.Time) (items []entity.ActionItem, err error)
+	UpdateActionItem(ctx context.Context, itemID int64, item entity.ActionItem) (err error)
+	DeleteActionItem(ctx context.Context, itemID int64) (err error)
+}
+
+type GoalRepo interface {
+	CreateGoal(ctx context.Context, goal entity.Goal) (createdID int64, err error)
+	GetGoalByUUID(ctx context.Context, goalUUID string) (goal entity.Goal, err error)
+	// GetGoalsByPerson returns the goals of the person, filtered by status when it is not empty
+	GetGoalsByPerson(ctx context.Context, personID int64, status string) (goals []entity.Goal, err error)
+	UpdateGoal(ctx context.Context, goalID int64, goal entity.Goal) (err error)
+	DeleteGoal(ctx context.Context, goalID int64) (err error)
+
+	// Key results
+	CreateKeyResult(ctx context.Context, keyResult entity.GoalKeyResult) (createdID int64, err error)
+	GetKeyResultsByGoal(ctx context.Context, goalID int64) (keyResults []entity.GoalKeyResult, err error)
+	GetKeyResultsByPerson(ctx context.Context, personID int64) (keyResults []entity.GoalKeyResult, err error)
+	UpdateKeyResult(ctx context.Context, keyResultID int64, keyResult entity.GoalKeyResult) (err error)
+	DeleteKeyResult(ctx context.Context, keyResultID int64) (err error)
+
+	// Check-ins
+	CreateCheckIn(ctx context.Context, checkIn entity.GoalCheckIn) (createdID int64, err error)
+	GetCheckInsByGoal(ctx context.Context, goalID int64) (checkIns []entity.GoalCheckIn, err error)
+	GetCheckInsByPerson(ctx context.Context, personID int64) (checkIns []entity.GoalCheckIn, err error)
+	DeleteCheckIn(ctx context.Context, checkInID int64) (err error)
+}
+
+type ReviewRepo interface {
+	// Templates
+	CreateTemplate(ctx context.Context, template entity.ReviewTemplate) (createdID int64, err error)
+	GetTemplateByUUID(ctx context.Context, templateUUID string) (template entity.ReviewTemplate, err error)
+	GetTemplateByID(ctx context.Context, templateID int64) (template entity.ReviewTemplate, err error)
+	GetTemplatesByCompany(ctx context.Context, companyID int64) (templates []entity.ReviewTemplate, err error)
+	DeleteTemplate(ctx context.Context, templateID int64) (err error)
+	CreateCompetency(ctx context.Context, competency entity.ReviewCompetency) (createdID int64, err error)
+	GetCompetenciesByTemplate(ctx context.Context, templateID int64) (competencies []entity.ReviewCompetency, err error)
+	CreateRatingLevel(ctx context.Context, level entity.ReviewRatingLevel) (createdID int64, err error)
+	GetRatingLevelsByTemplate(ctx context.Context, templateID int64) (levels []entity.ReviewRatingLevel, err error)
+
+	// Cycles
+	CreateCycle(ctx context.Context, cycle entity.ReviewCycle) (createdID int64, err error)
+	GetCycleByUUID(ctx context.Context, cycleUUID string) (cycle entity.ReviewCycle, err error)
+	// GetCyclesByCompany returns the cycles of the company, most recent period first
+	GetCyclesByCompany(ctx context.Context, companyID int64) (cycles []entity.ReviewCycle, err error)
+	DeleteCycle(ctx context.Context, cycleID int64) (err error)
+
+	// Reviews
+	CreateReview(ctx context.Context, review entity.Review) (createdID int64, err error)
+	GetReviewByUUID(ctx context.Context, reviewUUID string) (review entity.Review, err error)
+	GetReviewsByCycle(ctx context.Context, cycleID int64) (reviews []entity.Review, err error)
+	// GetReviewsByPerson returns the reviews of the person, most recent period first
+	GetReviewsByPerson(ctx context.Context, personID int64) (reviews []entity.Review, err error)
+	UpdateReview(ctx context.Context, reviewID int64, review entity.Review) (err error)
+	DeleteReview(ctx context.Context, reviewID int64) (err error)
+
+	// Ratings
+	// SaveRating creates or replaces the rating of the competency in the review
+	SaveRating(ctx context.Context, rating entity.ReviewRating) (err error)
+	GetRatingsByReview(ctx context.Context, reviewID int64) (ratings []entity.ReviewRating, err error)
+}
+
+type FeedbackRequestRepo interface {
+	// Requests
+	CreateRequest(ctx context.Context, request entity.FeedbackRequest) (createdID int64, err error)
+	GetRequestByUUID(ctx context.Context, requestUUID string) (request entity.FeedbackRequest, err error)
+	GetRequestByID(ctx context.Context, requestID int64) (request entity.FeedbackRequest, err error)
+	// GetRequestsByPerson returns the requests about the person, most recent first
+	GetRequestsByPerson(ctx context.Context, personID int64) (requests []entity.FeedbackRequest, err error)
+	DeleteRequest(ctx context.Context, requestID int64) (err error)
+
+	// Questions
+	CreateQuestion(ctx context.Context, question entity.FeedbackQuestion) (createdID int64, err error)
+	GetQuestionsByRequest(ctx context.Context, requestID int64) (questions []entity.FeedbackQuestion, err error)
+
+	// Respondents
+	CreateRespondent(ctx context.Context, respondent entity.FeedbackRespondent) (createdID int64, err error)
+	GetRespondentsByRequest(ctx context.Context, requestID int64) (respondents []entity.FeedbackRespondent, err error)
+	GetRespondentByTokenHash(ctx context.Context, tokenHash string) (respondent entity.FeedbackRespondent, err error)
+	// MarkRespondentResponded registers the response of a respondent that did not respond yet,
+	// returning sql.ErrNoRows when the respondent already responded
+	MarkRespondentResponded(ctx context.Context, respondentID int64, noteID *int64, respondedAt time.Time) (err error)
+}
+
+type CompetencyRepo interface {
+	// Frameworks
+	CreateFramework(ctx context.Context, framework entity.CompetencyFramework) (createdID int64, err error)
+	GetFrameworkByUUID(ctx context.Context, frameworkUUID string) (framework entity.CompetencyFramework, err error)
+	GetFrameworkByID(ctx context.Context, frameworkID int64) (framework entity.CompetencyFramework, err error)
+	GetFrameworksByCompany(ctx context.Context, companyID int64) (frameworks []entity.CompetencyFramework, err error)
+	DeleteFramework(ctx context.Context, frameworkID int64) (err error)
+	CreateLevel(ctx context.Context, level entity.CompetencyLevel) (createdID int64, err error)
+	GetLevelsByFramework(ctx context.Context, frameworkID int64) (levels []entity.CompetencyLevel, err error)
+	CreateCompetency(ctx context.Context, competency entity.Competency) (createdID int64, err error)
+	GetCompetencyByUUID(ctx context.Context, competencyUUID string) (competency entity.Competency, err error)
+	GetCompetenciesByFramework(ctx context.Context, frameworkID int64) (competencies []entity.Competency, err error)
+	CreateDescriptor(ctx context.Context, descriptor entity.CompetencyDescriptor) (createdID int64, err error)
+	// GetDescriptorsByFramework returns the descriptors of all competencies of the framework, ordered by the level position
+	GetDescriptorsByFramework(ctx context.Context, frameworkID int64) (descriptors []entity.CompetencyDescriptor, err error)
+
+	// Assessments
+	CreateAssessment(ctx context.Context, assessment entity.CompetencyAssessment) (createdID int64, err error)
+	GetAssessmentByUUID(ctx context.Context, assessmentUUID string) (assessment entity.CompetencyAssessment, err error)
+	// GetAssessmentsByPerson returns the assessments of the person, most recent first, of all frameworks when frameworkID is 0
+	GetAssessmentsByPerson(ctx context.Context, personID, frameworkID int64) (assessments []entity.CompetencyAssessment, err error)
+	CountAssessmentsByFramework(ctx context.Context, frameworkID int64) (count int64, err error)
+	DeleteAssessment(ctx context.Context, assessmentID int64) (err error)
+	CreateRating(ctx context.Context, rating entity.CompetencyRating) (createdID int64, err error)
+	// GetRatingsByAssessment returns the ratings of the assessment in the order of the competencies
+	GetRatingsByAssessment(ctx context.Context, assessmentID int64) (ratings []entity.CompetencyRating, err error)
+
+	// Feedback notes tagged with competencies
+	DeleteNoteCompetencies(ctx context.Context, noteID int64) (err error)
+	AddNoteCompetency(ctx context.Context, noteID, competencyID int64) (err error)
+	GetNoteCompetencies(ctx context.Context, noteID int64) (competencies []entity.Competency, err error)
+	// GetEvidenceCounts returns, per competency of the framework, how many notes about the person are tagged with it
+	GetEvidenceCounts(ctx context.Context, personID, frameworkID int64) (counts []entity.CompetencyEvidenceCount, err error)
+	// GetCompetencyEvidence returns the notes about the person tagged with the competency, most recent first
+	GetCompetencyEvidence(ctx context.Context, personID, competencyID int64) (evidence []entity.UnifiedTimelineEntry, err error)
+}
+
+type TagRepo interface {
+	CreateTag(ctx context.Context, tag entity.Tag) (createdID int64, err error)
+	// GetOrCreateTag returns the id of the tag of the company with the name, creating a not curated tag when there is none
+	GetOrCreateTag(ctx context.Context, tag entity.Tag) (tagID int64, err error)
+	GetTagByUUID(ctx context.Context, tagUUID string) (tag entity.Tag, err error)
+	GetTagByName(ctx context.Context, companyID int64, name string) (tag entity.Tag, err error)
+	// GetTagsByCompany returns the tags of the company by name, with how many notes use each one
+	GetTagsByCompany(ctx context.Context, companyID int64) (tags []entity.Tag, err error)
+	UpdateTag(ctx context.Context, tagID int64, tag entity.Tag) (err error)
+	DeleteTag(ctx context.Context, tagID int64) (err error)
+
+	// Tags of the notes, the source tells if the tag was written in the content or set manually
+	DeleteNoteTags(ctx context.Context, noteID int64, source string) (err error)
+	AddNoteTag(ctx context.Context, noteID, tagID int64, source string) (err error)
+	// GetNoteTags returns the tags of the note by name, from any source
+	GetNoteTags(ctx context.Context, noteID int64) (tags []entity.Tag, err error)
+	// GetTagNamesByNotes returns the tag names of each note by note UUID, sorted by name
+	GetTagNamesByNotes(ctx context.Context, noteUUIDs []string) (names map[string][]string, err error)
+
+	// Analytics over the not deleted notes of the company
+	GetTagCountsByPerson(ctx context.Context, companyID int64, filters entity.TagAnalyticsFilters) (counts []entity.TagPersonCount, err error)
+	GetTagCountsOverTime(ctx context.Context, companyID int64, filters entity.TagAnalyticsFilters) (counts []entity.TagPeriodCount, err error)
+}
+
+type ActivityRepo interface {
+	CreateActivity(ctx context.Context, activity entity.Activity) (createdID int64, err error)
+	GetActivityByUUID(ctx context.Context, activityUUID string) (activity entity.Activity, err error)
+	// GetActivities returns the activities of the query after the cursor of the page, newest first
+	GetActivities(ctx context.Context, query entity.ActivityQuery, page entity.CursorPage) (activities []entity.Activity, info entity.CursorPageInfo, err error)
+	// GetLatestActivityID returns the id of the newest activity of the company, 0 when there is none
+	GetLatestActivityID(ctx context.Context, companyID int64) (activityID int64, err error)
+	CountActivitiesAfter(ctx context.Context, companyID, activityID int64) (count int64, err error)
+
+	// Last seen marker of the feed of each user, 0 when the user never saw the feed
+	GetLastSeenActivityID(ctx context.Context, userID, companyID int64) (activityID int64, err error)
+	// SetLastSeenActivityID moves the marker forward, it never goes back to an older activity
+	SetLastSeenActivityID(ctx context.Context, userID, companyID, activityID int64) (err error)
+}
+
+type SCIMRepo interface {
+	// SCIM Token
+	SaveSCIMToken(ctx context.Context, companyID int64, tokenHash string, createdBy int64) (err error)
+	GetSCIMTokenHash(ctx context.Context, companyID int64) (tokenHash string, err error)
+	DeleteSCIMToken(ctx context.Context, companyID int64) (err error)
+
+	// Users
+	GetSCIMUsers(ctx context.Context, companyID int64, filter *entity.SCIMFilter, take, skip int64) (users []entity.SCIMUser, totalRecords int64, err error)
+	GetSCIMUserByPersonUUID(ctx context.Context, companyID int64, personUUID string) (user entity.SCIMUser, err error)
+	GetSCIMUserByPersonID(ctx context.Context, personID int64) (user entity.SCIMUser, err error)
+	GetUnsyncedPersonIDByEmail(ctx context.Context, companyID int64, email string) (personID int64, err error)
+
+	// Person Sync
+	CreatePersonSync(ctx context.Context, personSync entity.PersonSync) (createdID int64, err error)
+	UpdatePersonSync(ctx context.Context, personSync entity.PersonSync) (err error)
+	GetPersonSyncByExternalID(ctx context.Context, companyID int64, externalID string) (personSync entity.PersonSync, err error)
+	GetPersonSyncByPersonID(ctx context.Context, personID int64) (personSync entity.PersonSync, err error)
+	GetPendingManagerSyncs(ctx context.Context, companyID int64, managerRefs []string) (syncs []entity.PersonSync, err error)
+
+	// Groups
+	CreateSCIMGroup(ctx context.Context, group entity.SCIMGroup) (createdID int64, err error)
+	GetSCIMGroupByUUID(ctx context.Context, companyID int64, groupUUID string) (group entity.SCIMGroup, err error)
+	GetSCIMGroupByExternalID(ctx context.Context, companyID int64, externalID string) (group entity.SCIMGroup, err error)
+	GetSCIMGroups(ctx context.Context, companyID int64, filter *entity.SCIMFilter, take, skip int64) (groups []entity.SCIMGroup, totalRecords int64, err error)
+	UpdateSCIMGroup(ctx context.Context, groupID int64, group entity.SCIMGroup) (err error)
+	DeleteSCIMGroup(ctx context.Context, groupID int64) (err error)
+	ReplaceSCIMGroupMembers(ctx context.Context, groupID int64, personIDs []int64) (err error)
+	GetSCIMGroupMembers(ctx context.Context, groupID int64) (members []entity.SCIMGroupMember, err error)
+}
+
+type NoteTemplateRepo interface {
+	CreateTemplate(ctx context.Context, template entity.NoteTemplate) (createdID int64, err error)
+	GetTemplateByUUID(ctx context.Context, templateUUID string) (template entity.NoteTemplate, err error)
+	// GetAvailableTemplates returns the built-in templates, the templates of the company and the personal templates
+	// of the user, with how many notes of the company were written with each one
+	GetAvailableTemplates(ctx context.Context, companyID, userID int64) (templates []entity.NoteTemplate, err error)
+	DeleteTemplate(ctx context.Context, templateID int64) (err error)
+
+	CreateSection(ctx context.Context, section entity.NoteTemplateSection) (createdID int64, err error)
+	GetSectionsByTemplate(ctx context.Context, templateID int64) (sections []entity.NoteTemplateSection, err error)
+}
+
+type OutboxRepo interface {
+	CreateEvent(ctx context.Context, event entity.OutboxEvent) (createdID int64, err error)
+	// GetDueEvents returns the pending events whose next attempt is due and the processing events whose lease expired, oldest first
+	GetDueEvents(ctx context.Context, now time.Time, limit int) (events []entity.OutboxEvent, err error)
+	// ClaimEvent marks a due event as processing until leaseUntil, counting the attempt. It returns sql.ErrNoRows
+	// when the event was claimed by another worker since it was read
+	ClaimEvent(ctx context.Context, event entity.OutboxEvent, now, leaseUntil time.Time) (err error)
+	MarkEventDone(ctx context.Context, eventID int64) (err error)
+	// MarkEventFailed records the error of the attempt, retrying the event at nextAttemptAt, or failing it for good when nextAttemptAt is nil
+	MarkEventFailed(ctx context.Context, eventID int64, lastError string, nextAttemptAt *time.Time) (err error)
+}
+
 type AIRepo interface {
 	// ========== AI Prompts ==========
 	GetActivePromptByType(ctx context.Context, promptType string) (entity.AIPrompt, error)
@@ -97,4 +404,6 @@ type AIRepo interface {
 
 	// ========== AI Conversations ==========
 	CreateConversation(ctx context.Context, conversation entity.AIConversation) (entity.AIConversation, error)
+	// DeleteExpiredConversations deletes up to limit conversations expired before now, returning how many were deleted
+	DeleteExpiredConversations(ctx context.Context, now time.Time, limit int) (deleted int64, err error)
 }
@@ -0,0 +1,14 @@
+package contract
+
+import (
+	"context"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+// NoteSearchEngine finds the notes of a company by their content, feedback category, the name of the person
+// and the names of the mentioned people, most relevant first. The deleted notes are never returned.
+// MySQL FULLTEXT indexes are the default engine, kept up to date by the database itself
+type NoteSearchEngine interface {
+	SearchNotes(ctx context.Context, query entity.NoteSearchQuery) (hits []entity.NoteSearchHit, totalRecords int64, err error)
+}
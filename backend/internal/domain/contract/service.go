@@ -2,6 +2,7 @@ package contract
 
 import (
 	"context"
+	"time"
 
 	"github.com/diegoclair/leaderpro/internal/application/dto"
 	"github.com/diegoclair/leaderpro/internal/domain/entity"
@@ -50,13 +51,232 @@ type PersonApp interface {
 	UpdatePerson(ctx context.Context, personUUID string, person entity.Person) (err error)
 	DeletePerson(ctx context.Context, personUUID string) (err error)
 	SearchPeople(ctx context.Context, search string) (people []entity.Person, err error)
+	SearchPeopleRanked(ctx context.Context, search string, limit int) (results []entity.PersonSearchResult, err error)
+	ImportPeople(ctx context.Context, input entity.PersonImportInput) (result entity.PersonImportResult, err error)
+	GetPersonImport(ctx context.Context, importUUID string) (personImport entity.PersonImport, err error)
+
+	// Person attribute methods
+	GetPersonAttributes(ctx context.Context, personUUID string) (attributes []entity.PersonAttribute, err error)
+	GetPersonAttributesAsOf(ctx context.Context, personUUID string, asOf time.Time) (attributes []entity.PersonAttribute, err error)
+	GetPersonAttributeHistory(ctx context.Context, personUUID, attributeKey string) (history []entity.PersonAttributeHistory, err error)
+	UpdatePersonAttribute(ctx context.Context, personUUID, attributeKey, value string) (attribute entity.PersonAttribute, err error)
+	DeletePersonAttribute(ctx context.Context, personUUID, attributeKey string) (err error)
 	
 	// Note management methods
 	CreateNote(ctx context.Context, note entity.Note, personUUID string) (createdNote entity.Note, err error)
 	GetPersonTimeline(ctx context.Context, personUUID string, filters entity.TimelineFilters, take, skip int64) (timeline []entity.UnifiedTimelineEntry, totalRecords int64, err error)
 	GetPersonMentions(ctx context.Context, personUUID string, take, skip int64) (mentions []entity.MentionEntry, totalRecords int64, err error)
+	GetPersonTimelinePage(ctx context.Context, personUUID string, filters entity.TimelineFilters, page entity.CursorPage) (timeline []entity.UnifiedTimelineEntry, info entity.CursorPageInfo, err error)
+	GetPersonMentionsPage(ctx context.Context, personUUID string, page entity.CursorPage) (mentions []entity.MentionEntry, info entity.CursorPageInfo, err error)
 	UpdateNote(ctx context.Context, noteUUID string, note entity.Note) (err error)
 	DeleteNote(ctx context.Context, noteUUID string) (err error)
+	// GetNoteRevisions returns every revision of the note, newest first. The latest one is the current content
+	GetNoteRevisions(ctx context.Context, noteUUID string) (revisions []entity.NoteRevision, err error)
+	// GetNoteRevisionDiff compares two revisions of the note, to = 0 compares with the latest revision
+	GetNoteRevisionDiff(ctx context.Context, noteUUID string, from, to int) (diff entity.NoteRevisionDiff, err error)
+	// RestoreNoteRevision writes the content of an older revision as a new revision of the note and rebuilds its mentions
+	RestoreNoteRevision(ctx context.Context, noteUUID string, number int) (note entity.Note, err error)
+	// UndeleteNote restores a note deleted less than domain.NoteUndeleteRetentionDays ago
+	UndeleteNote(ctx context.Context, noteUUID string) (note entity.Note, err error)
+	// SearchNotes searches the text of every note of the company, most relevant first, with a highlighted snippet of each note
+	SearchNotes(ctx context.Context, search string, filters entity.NoteSearchFilters, take, skip int64) (results []entity.NoteSearchResult, totalRecords int64, err error)
+}
+
+type MeetingApp interface {
+	ScheduleMeeting(ctx context.Context, personUUID string, meeting entity.Meeting) (createdMeeting entity.Meeting, err error)
+	GetMeeting(ctx context.Context, meetingUUID string) (meeting entity.Meeting, err error)
+	GetPersonMeetings(ctx context.Context, personUUID, status string, take, skip int64) (meetings []entity.Meeting, totalRecords int64, err error)
+	UpdateMeeting(ctx context.Context, meetingUUID string, meeting entity.Meeting) (err error)
+	CancelMeeting(ctx context.Context, meetingUUID string) (err error)
+	CompleteMeeting(ctx context.Context, meetingUUID string, completion entity.MeetingCompletion) (meeting entity.Meeting, err error)
+
+	// Agenda items
+	AddAgendaItem(ctx context.Context, meetingUUID string, item entity.MeetingAgendaItem) (createdItem entity.MeetingAgendaItem, err error)
+	UpdateAgendaItem(ctx context.Context, meetingUUID, itemUUID string, item entity.MeetingAgendaItem) (err error)
+	DeleteAgendaItem(ctx context.Context, meetingUUID, itemUUID string) (err error)
+}
+
+type ActionItemApp interface {
+	// CreateActionItem creates an item for the person, optionally linked to the note or meeting where it was agreed
+	CreateActionItem(ctx context.Context, personUUID string, item entity.ActionItem) (createdItem entity.ActionItem, err error)
+	GetPersonActionItems(ctx context.Context, personUUID, status string, take, skip int64) (items []entity.ActionItem, totalRecords int64, err error)
+	UpdateActionItem(ctx context.Context, itemUUID string, item entity.ActionItem) (err error)
+	DeleteActionItem(ctx context.Context, itemUUID string) (err error)
+	// GetOverdueActionItems returns the overdue items of the company in the context, using the logged user timezone
+	GetOverdueActionItems(ctx context.Context) (items []entity.ActionItem, err error)
+}
+
+type GoalApp interface {
+	// CreateGoal creates a goal for the person together with its key results
+	CreateGoal(ctx context.Context, personUUID string, goal entity.Goal) (createdGoal entity.Goal, err error)
+	// GetGoal returns the goal with its key results and check-ins
+	GetGoal(ctx context.Context, goalUUID string) (goal entity.Goal, err error)
+	// GetPersonGoals returns the goals of the person with their key results, filtered by status when it is not empty
+	GetPersonGoals(ctx context.Context, personUUID, status string) (goals []entity.Goal, err error)
+	// GetPersonGoalTimeline returns the goals created and closed and the check-ins of the person, most recent first
+	GetPersonGoalTimeline(ctx context.Context, personUUID string) (timeline []entity.GoalTimelineEntry, err error)
+	UpdateGoal(ctx context.Context, goalUUID string, goal entity.Goal) (err error)
+	DeleteGoal(ctx context.Context, goalUUID string) (err error)
+
+	// Key results
+	AddKeyResult(ctx context.Context, goalUUID string, keyResult entity.GoalKeyResult) (createdKeyResult entity.GoalKeyResult, err error)
+	UpdateKeyResult(ctx context.Context, goalUUID, keyResultUUID string, keyResult entity.GoalKeyResult) (err error)
+	DeleteKeyResult(ctx context.Context, goalUUID, keyResultUUID string) (err error)
+
+	// Check-ins
+	// CheckIn registers a progress update of an active goal, applying the key result values reported in it
+	CheckIn(ctx context.Context, goalUUID string, checkIn entity.GoalCheckIn) (createdCheckIn entity.GoalCheckIn, err error)
+	DeleteCheckIn(ctx context.Context, goalUUID, checkInUUID string) (err error)
+}
+
+type ReviewApp interface {
+	// Templates
+	// CreateReviewTemplate creates a template with its competencies and rating scale in the company of the context
+	CreateReviewTemplate(ctx context.Context, template entity.ReviewTemplate) (createdTemplate entity.ReviewTemplate, err error)
+	GetReviewTemplates(ctx context.Context) (templates []entity.ReviewTemplate, err error)
+	GetReviewTemplate(ctx context.Context, templateUUID string) (template entity.ReviewTemplate, err error)
+	// DeleteReviewTemplate deletes a template that is not used by any cycle
+	DeleteReviewTemplate(ctx context.Context, templateUUID string) (err error)
+
+	// Cycles
+	CreateReviewCycle(ctx context.Context, cycle entity.ReviewCycle) (createdCycle entity.ReviewCycle, err error)
+	GetReviewCycles(ctx context.Context) (cycles []entity.ReviewCycle, err error)
+	GetReviewCycle(ctx context.Context, cycleUUID string) (cycle entity.ReviewCycle, err error)
+	DeleteReviewCycle(ctx context.Context, cycleUUID string) (err error)
+
+	// Reviews
+	// CreateCycleReviews creates a draft review for each person, or for all active people of the company
+	// when no person is given. People that already have a review in the cycle are skipped
+	CreateCycleReviews(ctx context.Context, cycleUUID string, personUUIDs []string) (reviews []entity.Review, err error)
+	GetCycleReviews(ctx context.Context, cycleUUID string) (reviews []entity.Review, err error)
+	GetPersonReviews(ctx context.Context, personUUID string) (reviews []entity.Review, err error)
+	// GetReview returns the review document with its template, ratings and the evidence compiled from the timeline of the period
+	GetReview(ctx context.Context, reviewUUID string) (review entity.Review, err error)
+	// UpdateReview updates the texts and ratings of a draft review
+	UpdateReview(ctx context.Context, reviewUUID string, review entity.Review) (err error)
+	DeleteReview(ctx context.Context, reviewUUID string) (err error)
+	// SubmitReview moves a complete draft review to submitted
+	SubmitReview(ctx context.Context, reviewUUID string) (err error)
+	// ShareReview shares a submitted review with the person
+	ShareReview(ctx context.Context, reviewUUID string) (err error)
+	// ReopenReview moves a submitted review back to draft
+	ReopenReview(ctx context.Context, reviewUUID string) (err error)
+}
+
+type FeedbackRequestApp interface {
+	// CreateFeedbackRequest asks the respondents for feedback about the person, using the default questionnaire when
+	// none is given. The respondents are returned with the token of their link, which is not stored and can't be read again
+	CreateFeedbackRequest(ctx context.Context, personUUID string, request entity.FeedbackRequest, expiresInDays int) (createdRequest entity.FeedbackRequest, err error)
+	GetPersonFeedbackRequests(ctx context.Context, personUUID string) (requests []entity.FeedbackRequest, err error)
+	GetFeedbackRequest(ctx context.Context, requestUUID string) (request entity.FeedbackRequest, err error)
+	// DeleteFeedbackRequest deletes the request and invalidates its links, the feedback already received is kept
+	DeleteFeedbackRequest(ctx context.Context, requestUUID string) (err error)
+
+	// GetFeedbackForm returns the questionnaire of the link token, without checking the logged user
+	GetFeedbackForm(ctx context.Context, token string) (form entity.FeedbackForm, err error)
+	// SubmitFeedbackResponse stores the answers of the link token as a feedback note about the person,
+	// attributed to the respondent or anonymous. Each link can be answered once
+	SubmitFeedbackResponse(ctx context.Context, token string, response entity.FeedbackResponse) (err error)
+}
+
+type CompetencyApp interface {
+	// Frameworks
+	// CreateCompetencyFramework creates a framework in the company of the context with its levels, competencies
+	// and the descriptor of each competency at each level
+	CreateCompetencyFramework(ctx context.Context, framework entity.CompetencyFramework) (createdFramework entity.CompetencyFramework, err error)
+	GetCompetencyFrameworks(ctx context.Context) (frameworks []entity.CompetencyFramework, err error)
+	// GetCompetencyFramework returns the framework matrix: levels, competencies and descriptors
+	GetCompetencyFramework(ctx context.Context, frameworkUUID string) (framework entity.CompetencyFramework, err error)
+	// DeleteCompetencyFramework deletes a framework that has no assessments
+	DeleteCompetencyFramework(ctx context.Context, frameworkUUID string) (err error)
+
+	// Assessments
+	// CreateCompetencyAssessment assesses the person against a framework, rating the level demonstrated in each competency
+	CreateCompetencyAssessment(ctx context.Context, personUUID string, assessment entity.CompetencyAssessment) (createdAssessment entity.CompetencyAssessment, err error)
+	// GetPersonCompetencyAssessments returns the assessments of the person, newest first, optionally of a single framework
+	GetPersonCompetencyAssessments(ctx context.Context, personUUID, frameworkUUID string) (assessments []entity.CompetencyAssessment, err error)
+	GetCompetencyAssessment(ctx context.Context, assessmentUUID string) (assessment entity.CompetencyAssessment, err error)
+	DeleteCompetencyAssessment(ctx context.Context, assessmentUUID string) (err error)
+	// GetPersonCompetencyGaps compares the latest assessment of the person in the framework with the target level,
+	// which defaults to the expected level of that assessment
+	GetPersonCompetencyGaps(ctx context.Context, personUUID, frameworkUUID, targetLevelUUID string) (analysis entity.CompetencyGapAnalysis, err error)
+
+	// Evidence
+	// SetNoteCompetencies replaces the competencies a feedback note is evidence of
+	SetNoteCompetencies(ctx context.Context, noteUUID string, competencyUUIDs []string) (competencies []entity.Competency, err error)
+	// GetCompetencyEvidence returns the feedback notes about the person tagged with the competency, newest first
+	GetCompetencyEvidence(ctx context.Context, personUUID, competencyUUID string) (evidence []entity.UnifiedTimelineEntry, err error)
+}
+
+type TagApp interface {
+	// CreateTag creates a curated tag in the company of the context. A tag already used by the notes becomes curated
+	CreateTag(ctx context.Context, tag entity.Tag) (createdTag entity.Tag, err error)
+	// GetCompanyTags returns the tags of the company of the context with how many notes use each one
+	GetCompanyTags(ctx context.Context) (tags []entity.Tag, err error)
+	// DeleteTag deletes the tag and removes it from the notes
+	DeleteTag(ctx context.Context, tagUUID string) (err error)
+	// SetNoteTags replaces the tags set manually on the note, the #tags written in its content are kept
+	SetNoteTags(ctx context.Context, noteUUID string, names []string) (tags []entity.Tag, err error)
+	// GetTagAnalytics counts the notes of the company of the context by tag, per person and over time
+	GetTagAnalytics(ctx context.Context, filters entity.TagAnalyticsFilters) (analytics entity.TagAnalytics, err error)
+}
+
+type ActivityApp interface {
+	// GetActivityFeed returns the events of the company of the context, newest first, flagging the ones the logged user has not seen
+	GetActivityFeed(ctx context.Context, filters entity.ActivityFeedFilters, page entity.CursorPage) (feed entity.ActivityFeed, err error)
+	// MarkActivitySeen marks the activity and the older ones as seen by the logged user, or all of them when activityUUID is empty
+	MarkActivitySeen(ctx context.Context, activityUUID string) (err error)
+}
+
+type NoteAttachmentApp interface {
+	// UploadAttachment stores the file and attaches it to the note, checking its size and type
+	UploadAttachment(ctx context.Context, noteUUID string, upload entity.NoteAttachmentUpload) (attachment entity.NoteAttachment, err error)
+	GetNoteAttachments(ctx context.Context, noteUUID string) (attachments []entity.NoteAttachment, err error)
+	// CreateDownloadURL returns a token to download the attachment without the authorization header, valid for a few minutes
+	CreateDownloadURL(ctx context.Context, noteUUID, attachmentUUID string) (downloadURL entity.NoteAttachmentDownloadURL, err error)
+	// DownloadAttachment returns the attachment of a download token, the caller must close its content
+	DownloadAttachment(ctx context.Context, token string) (download entity.NoteAttachmentDownload, err error)
+	DeleteAttachment(ctx context.Context, noteUUID, attachmentUUID string) (err error)
+}
+
+type NoteTemplateApp interface {
+	// CreateNoteTemplate creates a template in the company of the context, personal templates are visible only to the logged user
+	CreateNoteTemplate(ctx context.Context, template entity.NoteTemplate, personal bool) (createdTemplate entity.NoteTemplate, err error)
+	// GetNoteTemplates returns the built-in, company and personal templates with how many notes used each one,
+	// optionally only the templates of a note type
+	GetNoteTemplates(ctx context.Context, noteType string) (templates []entity.NoteTemplate, err error)
+	GetNoteTemplate(ctx context.Context, templateUUID string) (template entity.NoteTemplate, err error)
+	// DeleteNoteTemplate deletes a company or personal template, the built-in templates can not be deleted
+	DeleteNoteTemplate(ctx context.Context, templateUUID string) (err error)
+	// InstantiateNoteTemplate renders the template for a note about the person, replacing the variables of its sections
+	InstantiateNoteTemplate(ctx context.Context, templateUUID, personUUID string) (instance entity.NoteTemplateInstance, err error)
+}
+
+type CadenceApp interface {
+	// UpdatePersonCadence sets how often the manager wants a 1:1 with the person, an empty cadence removes it
+	UpdatePersonCadence(ctx context.Context, personUUID string, cadence entity.OneOnOneCadence) (err error)
+	// GetOneOnOnesDue returns the overdue and upcoming 1:1s of the company in the context, using the logged user timezone
+	GetOneOnOnesDue(ctx context.Context, days int) (due []entity.OneOnOneDue, err error)
+	// GetCompanyOneOnOnesDue returns the overdue and upcoming 1:1s of a company without checking the logged user, to feed notifications
+	GetCompanyOneOnOnesDue(ctx context.Context, companyID int64, loc *time.Location, days int) (due []entity.OneOnOneDue, err error)
+}
+
+type CalendarApp interface {
+	// GenerateFeedToken creates the secret token of the logged user ICS feed URL, replacing the previous one
+	GenerateFeedToken(ctx context.Context) (token string, err error)
+	RevokeFeedToken(ctx context.Context) (err error)
+	// GetFeed returns the ICS feed of the user that owns the token, with the scheduled 1:1s and the upcoming reminders
+	GetFeed(ctx context.Context, token string) (feed []byte, err error)
+	// ImportMeetings creates, links or updates the 1:1s of the company in the context from the events of an ICS file,
+	// matching the attendee emails with the people emails
+	ImportMeetings(ctx context.Context, input entity.CalendarImportInput) (result entity.CalendarImportResult, err error)
+}
+
+type ReminderApp interface {
+	// GetUpcomingReminders returns the reminders of the company in the context, using the logged user timezone
+	GetUpcomingReminders(ctx context.Context, days int) (reminders []entity.PersonReminder, err error)
+	// GetCompanyReminders returns the reminders of a company without checking the logged user, to feed notifications
+	GetCompanyReminders(ctx context.Context, companyID int64, loc *time.Location, days int) (reminders []entity.PersonReminder, err error)
 }
 
 type DashboardApp interface {
@@ -79,3 +299,49 @@ type AIApp interface {
 	// GetUsageReport returns AI usage report
 	GetUsageReport(ctx context.Context, period string) (entity.AIUsageReport, error)
 }
+
+type SCIMApp interface {
+	// GenerateSCIMToken creates the bearer token used by the HR system, replacing the previous one
+	GenerateSCIMToken(ctx context.Context) (token string, err error)
+	RevokeSCIMToken(ctx context.Context) (err error)
+	// ValidateSCIMToken checks the bearer token sent by the HR system to the company SCIM endpoints
+	ValidateSCIMToken(ctx context.Context, companyUUID, token string) (err error)
+
+	// Users are created, updated and deactivated idempotently by their external id
+	GetUsers(ctx context.Context, params entity.SCIMListParams) (users []entity.SCIMUser, totalRecords int64, err error)
+	GetUser(ctx context.Context, userID string) (user entity.SCIMUser, err error)
+	CreateUser(ctx context.Context, user entity.SCIMUser) (syncedUser entity.SCIMUser, created bool, err error)
+	ReplaceUser(ctx context.Context, userID string, user entity.SCIMUser) (syncedUser entity.SCIMUser, err error)
+	DeactivateUser(ctx context.Context, userID string) (err error)
+
+	GetGroups(ctx context.Context, params entity.SCIMListParams) (groups []entity.SCIMGroup, totalRecords int64, err error)
+	GetGroup(ctx context.Context, groupID string) (group entity.SCIMGroup, err error)
+	CreateGroup(ctx context.Context, group entity.SCIMGroup) (syncedGroup entity.SCIMGroup, created bool, err error)
+	ReplaceGroup(ctx context.Context, groupID string, group entity.SCIMGroup) (syncedGroup entity.SCIMGroup, err error)
+	DeleteGroup(ctx context.Context, groupID string) (err error)
+}
+
+type OutboxApp interface {
+	// ProcessOutbox runs the side effects of a batch of due outbox events, retrying the failed ones later.
+	// It runs in background without a logged user
+	ProcessOutbox(ctx context.Context) (processed int, err error)
+}
+
+// JobApp runs the background jobs of the job queue, called by the workers and by the admin endpoints
+type JobApp interface {
+	GetQueues() []entity.JobQueueConfig
+	GetSchedules() []entity.JobSchedule
+	// EnqueueJob adds a job to the queue, doing nothing when a job with the same UUID is already there
+	EnqueueJob(ctx context.Context, job entity.Job) (err error)
+	// EnqueueScheduledJob enqueues the job of a schedule for the time it is due, once even when several instances call it
+	EnqueueScheduledJob(ctx context.Context, schedule entity.JobSchedule, at time.Time) (err error)
+	DequeueJobs(ctx context.Context, queue string, limit int) (jobs []entity.Job, err error)
+	// RunJob runs a claimed job, completing it, retrying it with backoff or dead-lettering it when it has no attempts left
+	RunJob(ctx context.Context, job entity.Job) (err error)
+
+	// ValidateAdminToken checks the token sent to the admin endpoints
+	ValidateAdminToken(ctx context.Context, token string) (err error)
+	GetJobQueueState(ctx context.Context) (state entity.JobQueueState, err error)
+	GetDeadJobs(ctx context.Context, queue string) (jobs []entity.Job, err error)
+	RequeueDeadJob(ctx context.Context, jobUUID string) (err error)
+}
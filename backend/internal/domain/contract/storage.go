@@ -0,0 +1,18 @@
+package contract
+
+import (
+	"context"
+	"io"
+)
+
+// BlobStorage keeps the files uploaded by the users, like the note attachments, by key.
+// The local filesystem is the default backend, S3 compatible services can be used in production.
+//   - Get returns domain.ErrBlobNotFound when the key does not exist
+type BlobStorage interface {
+	// Put stores the content of the reader with size bytes under the key, replacing the previous content
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) (err error)
+	// Get returns the content stored under the key, the caller must close it
+	Get(ctx context.Context, key string) (content io.ReadCloser, err error)
+	// Delete removes the content of the key, doing nothing when it does not exist
+	Delete(ctx context.Context, key string) (err error)
+}
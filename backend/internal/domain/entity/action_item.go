@@ -0,0 +1,40 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/util/date"
+)
+
+// ActionItem is a follow-up committed in a 1:1, owned by the manager or by the report
+type ActionItem struct {
+	ID                int64
+	UUID              string
+	CompanyID         int64
+	PersonID          int64
+	PersonUUID        string
+	PersonName        string
+	UserID            int64
+	Owner             string // manager, report
+	Description       string
+	DueDate           *time.Time
+	Status            string // open, done, canceled
+	SourceNoteID      *int64
+	SourceNoteUUID    *string
+	SourceMeetingID   *int64
+	SourceMeetingUUID *string
+	CompletedAt       *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// IsOpen returns true while the item was neither done nor canceled
+func (a *ActionItem) IsOpen() bool {
+	return a.Status == "open"
+}
+
+// IsOverdue returns true when the item is open and its due date is before today.
+// today is a calendar day as returned by date.Today
+func (a *ActionItem) IsOverdue(today time.Time) bool {
+	return a.IsOpen() && a.DueDate != nil && date.Day(*a.DueDate).Before(today)
+}
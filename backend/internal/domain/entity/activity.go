@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+// Activity is an event of the company feed about one of its people
+type Activity struct {
+	ID            int64
+	UUID          string
+	CompanyID     int64
+	PersonID      int64
+	UserID        *int64 // nil when the event was caused by the AI
+	Type          string
+	Detail        string
+	ReferenceUUID *string // note or goal of the event
+	CreatedAt     time.Time
+
+	// Loaded with the feed
+	PersonUUID string
+	PersonName string
+	UserName   string
+	Unread     bool
+}
+
+// ActivityFeedFilters are the filters of the feed sent by the client
+type ActivityFeedFilters struct {
+	PersonUUID string
+	Team       string   // department of the people
+	Types      []string // activity types
+}
+
+// ActivityQuery is the feed query of a company with the filters resolved to ids
+type ActivityQuery struct {
+	CompanyID int64
+	PersonID  *int64
+	Team      string
+	Types     []string
+}
+
+// ActivityFeed is a page of the feed, the activities newer than the last one seen by the user are unread
+type ActivityFeed struct {
+	Activities  []Activity
+	Page        CursorPageInfo
+	UnreadCount int64 // unread activities of the whole company, regardless of the filters
+}
@@ -4,6 +4,13 @@ import (
 	"time"
 )
 
+// Person attribute sources, also exported by the domain package
+const (
+	AttributeSourceManual      = "manual"
+	AttributeSourceAIExtracted = "ai_extracted"
+	AttributeSourceImported    = "imported"
+)
+
 // PersonAttribute represents a flexible person attribute
 type PersonAttribute struct {
 	ID                  int64     `db:"id" json:"id"`
@@ -11,9 +18,62 @@ type PersonAttribute struct {
 	AttributeKey        string    `db:"attribute_key" json:"attribute_key"`
 	AttributeValue      string    `db:"attribute_value" json:"attribute_value"`
 	Source              string    `db:"source" json:"source"` // 'manual', 'ai_extracted', 'imported'
+	Confidence          *float64  `db:"confidence" json:"confidence,omitempty"` // 0.0 - 1.0, manual values are always 1.0
 	ExtractedFromNoteID *int64    `db:"extracted_from_note_id" json:"extracted_from_note_id,omitempty"`
 	CreatedAt           time.Time `db:"created_at" json:"created_at"`
 	UpdatedAt           time.Time `db:"updated_at" json:"updated_at"`
+
+	// Loaded with joins, not persisted
+	ExtractedFromNoteUUID *string `json:"extracted_from_note_uuid,omitempty"`
+}
+
+// IsManual returns true if the attribute was set by the manager and must not be overwritten by AI
+func (a *PersonAttribute) IsManual() bool {
+	return a.Source == AttributeSourceManual
+}
+
+// PersonAttributeHistory represents one version of a person attribute and the period it was valid.
+// ValidTo is nil for the current version
+type PersonAttributeHistory struct {
+	ID                  int64      `db:"id" json:"id"`
+	PersonID            int64      `db:"person_id" json:"person_id"`
+	AttributeKey        string     `db:"attribute_key" json:"attribute_key"`
+	AttributeValue      string     `db:"attribute_value" json:"attribute_value"`
+	Source              string     `db:"source" json:"source"`
+	Confidence          *float64   `db:"confidence" json:"confidence,omitempty"`
+	ExtractedFromNoteID *int64     `db:"extracted_from_note_id" json:"extracted_from_note_id,omitempty"`
+	ValidFrom           time.Time  `db:"valid_from" json:"valid_from"`
+	ValidTo             *time.Time `db:"valid_to" json:"valid_to,omitempty"`
+	CreatedAt           time.Time  `db:"created_at" json:"created_at"`
+
+	// Loaded with joins, not persisted
+	ExtractedFromNoteUUID *string `json:"extracted_from_note_uuid,omitempty"`
+}
+
+// IsCurrent returns true if this is the version currently in use
+func (h *PersonAttributeHistory) IsCurrent() bool {
+	return h.ValidTo == nil
+}
+
+// ToAttribute converts the version to the attribute it represented while it was valid
+func (h *PersonAttributeHistory) ToAttribute() PersonAttribute {
+	return PersonAttribute{
+		PersonID:              h.PersonID,
+		AttributeKey:          h.AttributeKey,
+		AttributeValue:        h.AttributeValue,
+		Source:                h.Source,
+		Confidence:            h.Confidence,
+		ExtractedFromNoteID:   h.ExtractedFromNoteID,
+		ExtractedFromNoteUUID: h.ExtractedFromNoteUUID,
+		CreatedAt:             h.ValidFrom,
+		UpdatedAt:             h.ValidFrom,
+	}
+}
+
+// ExtractedAttribute represents a single value returned by the AI extraction with its confidence
+type ExtractedAttribute struct {
+	Value      string   `json:"value"`
+	Confidence *float64 `json:"confidence,omitempty"`
 }
 
 // AIPrompt represents a versioned AI prompt
@@ -93,10 +153,13 @@ type AttributesResponse struct {
 
 // PersonAIContext represents a person's context for AI
 type PersonAIContext struct {
-	Person      Person                    `json:"person"`
-	Attributes  map[string]string         `json:"attributes"`
-	RecentNotes []Note                    `json:"recent_notes"`
-	LastMeeting *PersonLastMeeting        `json:"last_meeting,omitempty"`
+	Person           Person                   `json:"person"`
+	Attributes       map[string]string        `json:"attributes"`
+	AttributeChanges []PersonAttributeHistory `json:"attribute_changes,omitempty"` // Previous values replaced recently
+	RecentNotes      []Note                   `json:"recent_notes"`
+	LastMeeting      *PersonLastMeeting       `json:"last_meeting,omitempty"`
+	OpenActionItems  []ActionItem             `json:"open_action_items,omitempty"` // Follow-ups still pending from previous 1:1s
+	ActiveGoals      []Goal                   `json:"active_goals,omitempty"`      // Goals in progress with their key results and check-ins
 }
 
 // PersonLastMeeting represents information from the last meeting
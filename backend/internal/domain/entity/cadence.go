@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"time"
+)
+
+// OneOnOneCadence is how often the manager wants to have a 1:1 with a person
+type OneOnOneCadence struct {
+	Cadence string // weekly, biweekly, monthly, custom - empty removes the cadence
+	Days    *int   // interval of the custom cadence
+}
+
+// OneOnOneDue is an overdue or upcoming 1:1 computed from the person's cadence
+type OneOnOneDue struct {
+	PersonID         int64
+	PersonUUID       string
+	PersonName       string
+	Cadence          string
+	CadenceDays      *int
+	LastOneOnOneDate *time.Time
+	DueDate          time.Time  // day the 1:1 is due, in the user timezone
+	DaysUntil        int        // negative when overdue, 0 means today
+	Overdue          bool       // the due date has passed
+	ScheduledAt      *time.Time // next scheduled meeting with the person, if any
+}
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"time"
+)
+
+// CalendarImportInput is the uploaded ICS file
+type CalendarImportInput struct {
+	FileName string
+	Data     []byte
+	DryRun   bool
+}
+
+// CalendarImportEvent is the result of one event of the imported file
+type CalendarImportEvent struct {
+	UID         string
+	Summary     string
+	Start       *time.Time // occurrence used for the meeting, the next one for recurring events
+	Status      string     // created, linked, updated, canceled, unchanged, skipped
+	Reason      string     // why the event was skipped
+	PersonUUID  string
+	PersonName  string
+	MeetingUUID string
+}
+
+// CalendarImportResult is the result of each event of an imported ICS file
+type CalendarImportResult struct {
+	DryRun    bool
+	Created   int
+	Linked    int
+	Updated   int
+	Canceled  int
+	Unchanged int
+	Skipped   int
+	Events    []CalendarImportEvent
+}
@@ -0,0 +1,139 @@
+package entity
+
+import "time"
+
+// CompetencyFramework is a career ladder of a company: the levels of the roles, the competencies
+// and, for each competency, what is expected at each level
+type CompetencyFramework struct {
+	ID           int64
+	UUID         string
+	CompanyID    int64
+	Name         string
+	Description  string
+	Levels       []CompetencyLevel // ordered by position, from the least to the most senior
+	Competencies []Competency      // ordered by position
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// LevelByUUID returns the level of the framework with the UUID
+func (f *CompetencyFramework) LevelByUUID(levelUUID string) (CompetencyLevel, bool) {
+	for _, level := range f.Levels {
+		if level.UUID == levelUUID {
+			return level, true
+		}
+	}
+	return CompetencyLevel{}, false
+}
+
+// LevelByID returns the level of the framework with the id
+func (f *CompetencyFramework) LevelByID(levelID int64) (CompetencyLevel, bool) {
+	for _, level := range f.Levels {
+		if level.ID == levelID {
+			return level, true
+		}
+	}
+	return CompetencyLevel{}, false
+}
+
+// CompetencyByUUID returns the competency of the framework with the UUID
+func (f *CompetencyFramework) CompetencyByUUID(competencyUUID string) (Competency, bool) {
+	for _, competency := range f.Competencies {
+		if competency.UUID == competencyUUID {
+			return competency, true
+		}
+	}
+	return Competency{}, false
+}
+
+// CompetencyLevel is a level of the ladder, usually a role/position like Senior Engineer
+type CompetencyLevel struct {
+	ID          int64
+	UUID        string
+	FrameworkID int64
+	Name        string
+	Description string
+	Position    int // higher is more senior
+}
+
+// Competency is a skill evaluated by the framework
+type Competency struct {
+	ID            int64
+	UUID          string
+	FrameworkID   int64
+	FrameworkUUID string
+	CompanyID     int64
+	Name          string
+	Description   string
+	Position      int
+	Descriptors   []CompetencyDescriptor // ordered by the position of the level
+}
+
+// CompetencyDescriptor describes what is expected of a competency at a level
+type CompetencyDescriptor struct {
+	ID            int64
+	CompetencyID  int64
+	LevelID       int64
+	LevelUUID     string
+	LevelPosition int
+	Description   string
+}
+
+// CompetencyAssessment is an assessment of a person against a framework at a date
+type CompetencyAssessment struct {
+	ID                int64
+	UUID              string
+	CompanyID         int64
+	PersonID          int64
+	PersonUUID        string
+	FrameworkID       int64
+	FrameworkUUID     string
+	FrameworkName     string
+	ExpectedLevelID   int64 // level of the role of the person when assessed
+	ExpectedLevelUUID string
+	ExpectedLevelName string
+	UserID            int64
+	Notes             string
+	AssessedAt        time.Time // date only
+	Ratings           []CompetencyRating
+	CreatedAt         time.Time
+}
+
+// CompetencyRating is the level a person demonstrates in a competency of an assessment
+type CompetencyRating struct {
+	ID             int64
+	AssessmentID   int64
+	CompetencyID   int64
+	CompetencyUUID string
+	CompetencyName string
+	LevelID        int64
+	LevelUUID      string
+	LevelName      string
+	LevelPosition  int
+	Comment        string
+}
+
+// CompetencyGapAnalysis compares the latest assessment of a person with a target level of the framework
+type CompetencyGapAnalysis struct {
+	Framework   CompetencyFramework
+	Assessment  *CompetencyAssessment // latest assessment, nil when the person was never assessed
+	TargetLevel CompetencyLevel       // expected level of the latest assessment, unless another one was asked
+	Gaps        []CompetencyGap       // one per competency of the framework
+}
+
+// CompetencyGap is the distance between the assessed and the target level of a competency
+type CompetencyGap struct {
+	Competency     Competency
+	AssessedLevel  *CompetencyLevel // nil when the competency was not assessed
+	Gap            *int             // assessed minus target level positions, negative when below the target
+	Descriptor     string           // what is expected of the competency at the target level
+	EvidenceCount  int              // feedback notes tagged with the competency
+	LastEvidenceAt *time.Time
+}
+
+// CompetencyEvidenceCount is how many feedback notes about a person are tagged with a competency
+type CompetencyEvidenceCount struct {
+	CompetencyID   int64
+	Count          int
+	LastEvidenceAt time.Time
+}
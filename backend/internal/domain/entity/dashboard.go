@@ -14,6 +14,9 @@ type DashboardStats struct {
 
 // Dashboard represents the complete dashboard data
 type Dashboard struct {
-	People []Person       `json:"people"`
-	Stats  DashboardStats `json:"stats"`
+	People             []Person         `json:"people"`
+	Stats              DashboardStats   `json:"stats"`
+	UpcomingReminders  []PersonReminder `json:"upcoming_reminders"`
+	OverdueActionItems []ActionItem     `json:"overdue_action_items"`
+	OneOnOnesDue       []OneOnOneDue    `json:"one_on_ones_due"`
 }
\ No newline at end of file
@@ -0,0 +1,79 @@
+package entity
+
+import "time"
+
+// FeedbackRequest asks peers or external people about a person, through links that need no account
+type FeedbackRequest struct {
+	ID            int64
+	UUID          string
+	CompanyID     int64
+	CompanyName   string
+	PersonID      int64
+	PersonUUID    string
+	PersonName    string
+	UserID        int64 // manager that requested the feedback
+	RequesterName string
+	Message       string // shown to the respondents in the form
+	Anonymous     bool   // responses are not attributed to the respondents
+	ExpiresAt     time.Time
+	CreatedAt     time.Time
+	Questions     []FeedbackQuestion // ordered by position
+	Respondents   []FeedbackRespondent
+}
+
+// IsExpired returns true when the links of the request can no longer be answered
+func (r *FeedbackRequest) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
+
+// FeedbackQuestion is a question of the questionnaire of a request, answered with a text or a rating
+type FeedbackQuestion struct {
+	ID        int64
+	UUID      string
+	RequestID int64
+	Text      string
+	Type      string // "text" or "rating"
+	Required  bool
+	Position  int
+}
+
+// FeedbackRespondent is someone asked to answer a request, a peer of the company or an external email
+type FeedbackRespondent struct {
+	ID          int64
+	UUID        string
+	RequestID   int64
+	PersonID    *int64 // nil for external emails
+	PersonUUID  string
+	Name        string
+	Email       string
+	Token       string // only set when the respondent is created, just the hash is stored
+	TokenHash   string
+	RespondedAt *time.Time
+	NoteID      *int64 // feedback note of the response, nil when anonymous
+	CreatedAt   time.Time
+}
+
+// HasResponded returns true when the respondent already answered the request
+func (r *FeedbackRespondent) HasResponded() bool {
+	return r.RespondedAt != nil
+}
+
+// FeedbackAnswer is the answer to a question, Text for text questions and Rating for rating questions
+type FeedbackAnswer struct {
+	QuestionUUID string
+	Text         string
+	Rating       *int
+}
+
+// FeedbackResponse is what a respondent submits through the link, stored as a feedback note
+type FeedbackResponse struct {
+	FeedbackType     string
+	FeedbackCategory string
+	Answers          []FeedbackAnswer
+}
+
+// FeedbackForm is what a respondent sees when opening the link
+type FeedbackForm struct {
+	Request    FeedbackRequest
+	Respondent FeedbackRespondent
+}
@@ -0,0 +1,108 @@
+package entity
+
+import (
+	"math"
+	"time"
+)
+
+// Goal is an objective of a person (OKR), measured by its key results and followed with dated check-ins
+type Goal struct {
+	ID          int64
+	UUID        string
+	CompanyID   int64
+	PersonID    int64
+	PersonUUID  string
+	PersonName  string
+	UserID      int64
+	Title       string
+	Description string
+	Type        string // career, performance, learning
+	Status      string // active, achieved, abandoned
+	Progress    int    // percentage, derived from the key results when the goal has any
+	TargetDate  *time.Time
+	CompletedAt *time.Time
+	KeyResults  []GoalKeyResult
+	CheckIns    []GoalCheckIn // most recent first
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// IsActive returns true while the goal was neither achieved nor abandoned
+func (g *Goal) IsActive() bool {
+	return g.Status == "active"
+}
+
+// KeyResultsProgress returns the average progress of the key results and false when the goal has none
+func (g *Goal) KeyResultsProgress() (int, bool) {
+	if len(g.KeyResults) == 0 {
+		return 0, false
+	}
+
+	total := 0
+	for _, keyResult := range g.KeyResults {
+		total += keyResult.Progress()
+	}
+
+	return int(math.Round(float64(total) / float64(len(g.KeyResults)))), true
+}
+
+// GoalKeyResult is a measurable result of a goal, going from the start value to the target value
+type GoalKeyResult struct {
+	ID           int64
+	UUID         string
+	GoalID       int64
+	Title        string
+	StartValue   float64
+	TargetValue  float64
+	CurrentValue float64
+	Unit         string
+	Position     int
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Progress returns how far the current value is from the start to the target value, from 0 to 100.
+// Targets below the start value are supported, e.g. reducing the number of incidents
+func (k *GoalKeyResult) Progress() int {
+	if k.TargetValue == k.StartValue {
+		if k.CurrentValue == k.TargetValue {
+			return 100
+		}
+		return 0
+	}
+
+	progress := (k.CurrentValue - k.StartValue) / (k.TargetValue - k.StartValue) * 100
+	return int(math.Round(math.Max(0, math.Min(100, progress))))
+}
+
+// GoalCheckIn is a dated progress update of a goal, optionally linked to the note where it was discussed
+type GoalCheckIn struct {
+	ID              int64
+	UUID            string
+	GoalID          int64
+	UserID          int64
+	Date            time.Time
+	Progress        int    // goal progress after the check-in
+	Confidence      string // on_track, at_risk, off_track
+	Comment         string
+	NoteID          *int64
+	NoteUUID        *string
+	KeyResultValues []GoalKeyResultValue // key result values reported in the check-in, applied to the key results
+	CreatedAt       time.Time
+}
+
+// GoalKeyResultValue is the current value of a key result reported in a check-in
+type GoalKeyResultValue struct {
+	KeyResultUUID string
+	CurrentValue  float64
+}
+
+// GoalTimelineEntry is an event of the goals of a person: a goal created, a check-in or a goal closed
+type GoalTimelineEntry struct {
+	Type      string // goal_created, check_in, goal_achieved, goal_abandoned
+	Date      time.Time
+	GoalUUID  string
+	GoalTitle string
+	GoalType  string
+	CheckIn   *GoalCheckIn
+}
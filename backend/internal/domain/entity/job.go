@@ -0,0 +1,55 @@
+package entity
+
+import "time"
+
+// Job is a unit of background work stored in the job queue until a worker runs it
+type Job struct {
+	UUID        string // also the idempotency key, a job is enqueued only once
+	Queue       string
+	Type        string
+	Payload     string // JSON
+	Status      string
+	Attempts    int
+	MaxAttempts int
+	RunAt       time.Time // when a pending job is due, or when the lease of a running job expires
+	LastError   *string
+	CreatedAt   time.Time
+	FailedAt    *time.Time // when the job was dead-lettered
+}
+
+// JobQueueConfig is a queue of jobs and how many of its jobs run at the same time in each instance
+type JobQueueConfig struct {
+	Name        string
+	Concurrency int
+}
+
+// JobSchedule enqueues a job of the type on every time that matches the cron expression
+type JobSchedule struct {
+	Name    string
+	Cron    string
+	Queue   string
+	JobType string
+}
+
+// JobQueueStats is the state of a queue
+type JobQueueStats struct {
+	Queue           string
+	Pending         int64 // due jobs waiting for a worker
+	Scheduled       int64 // pending jobs not due yet, like retries waiting for their backoff
+	Running         int64
+	Dead            int64
+	OldestPendingAt *time.Time
+	Concurrency     int
+}
+
+// JobScheduleState is a schedule with the next time it enqueues its job
+type JobScheduleState struct {
+	JobSchedule
+	NextRunAt time.Time
+}
+
+// JobQueueState is the state of the job queue shown to the admins
+type JobQueueState struct {
+	Queues    []JobQueueStats
+	Schedules []JobScheduleState
+}
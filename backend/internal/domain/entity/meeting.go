@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"time"
+)
+
+// Meeting is a 1:1 between the manager and a person
+type Meeting struct {
+	ID              int64
+	UUID            string
+	CompanyID       int64
+	PersonID        int64
+	PersonUUID      string
+	PersonName      string
+	UserID          int64
+	Status          string // scheduled, held, canceled
+	Origin          string // scheduled, note
+	ScheduledAt     time.Time
+	HeldAt          *time.Time
+	DurationMinutes *int
+	NoteID          *int64 // resulting one_on_one note
+	NoteUUID        *string
+	ICalUID         *string // UID of the calendar event the meeting was imported from
+	AgendaItems     []MeetingAgendaItem
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// IsScheduled returns true while the meeting did not happen yet and was not canceled
+func (m *Meeting) IsScheduled() bool {
+	return m.Status == "scheduled"
+}
+
+// MeetingAgendaItem is a talking point of a meeting, added by the manager or by the report
+type MeetingAgendaItem struct {
+	ID             int64
+	UUID           string
+	MeetingID      int64
+	ActionItemID   *int64 // set when the item was carried over from an open action item
+	ActionItemUUID *string
+	AddedBy        string // manager, report
+	Content        string
+	Notes          string
+	Discussed      bool
+	Position       int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// MeetingCompletion holds what happened in a meeting when it is marked as held
+type MeetingCompletion struct {
+	HeldAt          time.Time
+	DurationMinutes *int
+	Summary         string // content of the resulting one_on_one note
+}
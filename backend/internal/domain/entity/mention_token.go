@@ -0,0 +1,169 @@
+package entity
+
+import "strings"
+
+const (
+	mentionTokenOpen  = "{{person:"
+	mentionTokenClose = "}}"
+	mentionEscape     = '\\'
+)
+
+// MentionToken is a {{person:uuid|name}} token found in the content of a note.
+// The indexes are character (rune) offsets in the content, EndIndex is exclusive
+type MentionToken struct {
+	PersonUUID string
+	PersonName string // name written in the token, unescaped
+	StartIndex int
+	EndIndex   int
+}
+
+// ParseMentions returns every well formed mention token of the content, in order.
+// Malformed tokens are kept as text, a backslash before "{{" writes the token literally
+// and a backslash inside the name escapes the next character, e.g. "{{person:uuid|A \}} B}}"
+func ParseMentions(content string) []MentionToken {
+	runes := []rune(content)
+	open := []rune(mentionTokenOpen)
+
+	var tokens []MentionToken
+	for i := 0; i < len(runes); i++ {
+		if runes[i] == mentionEscape {
+			i++ // the next character is literal
+			continue
+		}
+		if !hasRunePrefix(runes[i:], open) {
+			continue
+		}
+
+		token, end, ok := parseMentionToken(runes, i, i+len(open))
+		if !ok {
+			continue
+		}
+
+		tokens = append(tokens, token)
+		i = end - 1
+	}
+
+	return tokens
+}
+
+// parseMentionToken parses the uuid and the name of the token starting at start, whose uuid starts at pos
+func parseMentionToken(runes []rune, start, pos int) (token MentionToken, end int, ok bool) {
+	uuidStart := pos
+	for pos < len(runes) && isMentionUUIDRune(runes[pos]) {
+		pos++
+	}
+	if pos == uuidStart || pos >= len(runes) || runes[pos] != '|' {
+		return token, 0, false
+	}
+	token.PersonUUID = string(runes[uuidStart:pos])
+	pos++
+
+	var name strings.Builder
+	for pos < len(runes) {
+		switch {
+		case runes[pos] == '\n', runes[pos] == '{' && pos+1 < len(runes) && runes[pos+1] == '{':
+			// a line break or another token before the closing means this token is not closed
+			return token, 0, false
+		case runes[pos] == mentionEscape && pos+1 < len(runes):
+			name.WriteRune(runes[pos+1])
+			pos += 2
+		case runes[pos] == '}' && pos+1 < len(runes) && runes[pos+1] == '}':
+			token.PersonName = strings.TrimSpace(name.String())
+			token.StartIndex = start
+			token.EndIndex = pos + len(mentionTokenClose)
+			return token, token.EndIndex, true
+		default:
+			name.WriteRune(runes[pos])
+			pos++
+		}
+	}
+
+	return token, 0, false
+}
+
+func isMentionUUIDRune(r rune) bool {
+	return r == '-' || (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+func hasRunePrefix(runes, prefix []rune) bool {
+	if len(runes) < len(prefix) {
+		return false
+	}
+	for i, r := range prefix {
+		if runes[i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+// MentionUUIDs returns the UUIDs of the mentioned people without repetitions, in the order they first appear
+func MentionUUIDs(tokens []MentionToken) []string {
+	seen := make(map[string]bool, len(tokens))
+	uuids := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if seen[token.PersonUUID] {
+			continue
+		}
+		seen[token.PersonUUID] = true
+		uuids = append(uuids, token.PersonUUID)
+	}
+	return uuids
+}
+
+// EscapeMentionName escapes the characters that would end the name of a mention token
+func EscapeMentionName(name string) string {
+	return strings.NewReplacer(`\`, `\\`, `|`, `\|`, `{`, `\{`, `}`, `\}`).Replace(name)
+}
+
+// EscapeNoteText escapes the text so it has no mention token or #tag, for the parts of a note written by someone
+// that is not a user of the company, like the answers of a feedback respondent
+func EscapeNoteText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, `{`, `\{`, `#`, `\#`).Replace(text)
+}
+
+// RenderMentions rewrites the name of each mention token with the current name of the person,
+// so the content shows the new name after a rename. Tokens of unknown people are kept as they are
+func RenderMentions(content string, names map[string]string) string {
+	tokens := ParseMentions(content)
+	if len(tokens) == 0 {
+		return content
+	}
+
+	runes := []rune(content)
+	var result strings.Builder
+	last := 0
+	for _, token := range tokens {
+		name, ok := names[token.PersonUUID]
+		if !ok || name == token.PersonName {
+			continue
+		}
+
+		result.WriteString(string(runes[last:token.StartIndex]))
+		result.WriteString(mentionTokenOpen + token.PersonUUID + "|" + EscapeMentionName(name) + mentionTokenClose)
+		last = token.EndIndex
+	}
+	result.WriteString(string(runes[last:]))
+
+	return result.String()
+}
+
+// MentionsAsText replaces each mention token of the content with the name written in it, for plain text views
+func MentionsAsText(content string) string {
+	tokens := ParseMentions(content)
+	if len(tokens) == 0 {
+		return content
+	}
+
+	runes := []rune(content)
+	var result strings.Builder
+	last := 0
+	for _, token := range tokens {
+		result.WriteString(string(runes[last:token.StartIndex]))
+		result.WriteString(token.PersonName)
+		last = token.EndIndex
+	}
+	result.WriteString(string(runes[last:]))
+
+	return result.String()
+}
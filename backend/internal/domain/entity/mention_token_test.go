@@ -0,0 +1,106 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []MentionToken
+	}{
+		{
+			name:    "single mention with its positions",
+			content: "Ask {{person:abc-1|Maria}} about it",
+			want:    []MentionToken{{PersonUUID: "abc-1", PersonName: "Maria", StartIndex: 4, EndIndex: 26}},
+		},
+		{
+			name:    "positions are character offsets",
+			content: "Conversa com {{person:abc-1|João}}",
+			want:    []MentionToken{{PersonUUID: "abc-1", PersonName: "João", StartIndex: 13, EndIndex: 34}},
+		},
+		{
+			name:    "repeated mentions keep every position",
+			content: "{{person:abc-1|Maria}} and {{person:abc-1|Maria}}",
+			want: []MentionToken{
+				{PersonUUID: "abc-1", PersonName: "Maria", StartIndex: 0, EndIndex: 22},
+				{PersonUUID: "abc-1", PersonName: "Maria", StartIndex: 27, EndIndex: 49},
+			},
+		},
+		{
+			name:    "escaped characters in the name",
+			content: `{{person:abc-1|A \| B \}\} C}}`,
+			want:    []MentionToken{{PersonUUID: "abc-1", PersonName: "A | B }} C", StartIndex: 0, EndIndex: 30}},
+		},
+		{
+			name:    "escaped token is literal",
+			content: `\{{person:abc-1|Maria}}`,
+		},
+		{
+			name:    "token without closing is ignored",
+			content: "{{person:abc-1|Maria and {{person:def-2|Ana}}",
+			want:    []MentionToken{{PersonUUID: "def-2", PersonName: "Ana", StartIndex: 25, EndIndex: 45}},
+		},
+		{
+			name:    "token without uuid is ignored",
+			content: "{{person:|Maria}} {{person:abc 1|Ana}}",
+		},
+		{
+			name:    "token can not span lines",
+			content: "{{person:abc-1|Maria\n}}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ParseMentions(tt.content))
+		})
+	}
+}
+
+func TestNote_ExtractMentionUUIDs(t *testing.T) {
+	note := Note{Content: "{{person:abc-1|Maria}}, {{person:def-2|Ana}} and {{person:abc-1|Maria}} {{person:broken"}
+	require.Equal(t, []string{"abc-1", "def-2"}, note.ExtractMentionUUIDs())
+	require.True(t, note.HasMentions())
+}
+
+func TestRenderMentions(t *testing.T) {
+	content := "{{person:abc-1|Maria}} helped {{person:def-2|Ana}} and {{person:ghi-3|Pedro}}"
+
+	rendered := RenderMentions(content, map[string]string{
+		"abc-1": "Maria Silva",
+		"def-2": "Ana",
+		"ghi-3": "Pedro | Tech}",
+	})
+	require.Equal(t, `{{person:abc-1|Maria Silva}} helped {{person:def-2|Ana}} and {{person:ghi-3|Pedro \| Tech\}}}`, rendered)
+
+	tokens := ParseMentions(rendered)
+	require.Len(t, tokens, 3)
+	require.Equal(t, "Maria Silva", tokens[0].PersonName)
+	require.Equal(t, "Pedro | Tech}", tokens[2].PersonName)
+
+	require.Equal(t, content, RenderMentions(content, nil))
+}
+
+func TestMentionsAsText(t *testing.T) {
+	content := `{{person:abc-1|Maria}} helped {{person:def-2|Pedro \| Tech}} with the migration`
+	require.Equal(t, "Maria helped Pedro | Tech with the migration", MentionsAsText(content))
+	require.Equal(t, "no mentions", MentionsAsText("no mentions"))
+}
+
+func TestEscapeNoteText(t *testing.T) {
+	for _, text := range []string{
+		"Great work on #promotion, {{person:abc-1|Maria}} agrees",
+		`\{{person:abc-1|Maria}} and \#burnout`,
+		"{{{person:abc-1|Maria}}} #a#b",
+	} {
+		escaped := EscapeNoteText(text)
+		require.Empty(t, ParseMentions(escaped), text)
+		require.Empty(t, ParseTags(escaped), text)
+	}
+
+	require.Equal(t, `C\# and \{\{x}} \\`, EscapeNoteText(`C# and {{x}} \`))
+}
@@ -14,8 +14,12 @@ type Note struct {
 	Content          string  // Conteúdo com tokens {{person:uuid|nome}}
 	FeedbackType     *string // "positive", "constructive", "neutral" - apenas para type="feedback"
 	FeedbackCategory *string // "performance", "behavior", "skill", "collaboration" - apenas para type="feedback"
+	RespondentName   *string // Quem respondeu uma solicitação de feedback - nil quando escrita pelo manager
+	TemplateID       *int64  // Template usado para escrever a anotação
+	TemplateUUID     *string
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
+	DeletedAt        *time.Time // Preenchido apenas para anotações excluídas
 }
 
 // IsOneOnOne returns true if the note is a 1:1 meeting record
@@ -38,45 +42,7 @@ func (n *Note) HasMentions() bool {
 	return len(n.ExtractMentionUUIDs()) > 0
 }
 
-// ExtractMentionUUIDs extracts all person UUIDs from mention tokens in content
+// ExtractMentionUUIDs extracts the UUIDs of the people mentioned in the content, without repetitions
 func (n *Note) ExtractMentionUUIDs() []string {
-	// Regex to match {{person:uuid|name}} pattern
-	// This is a simple implementation - can be enhanced with proper regex
-	var uuids []string
-	content := n.Content
-
-	for {
-		start := findSubstring(content, "{{person:")
-		if start == -1 {
-			break
-		}
-
-		uuidStart := start + 9 // len("{{person:")
-		pipePos := findSubstring(content[uuidStart:], "|")
-		if pipePos == -1 {
-			break
-		}
-
-		uuid := content[uuidStart : uuidStart+pipePos]
-		uuids = append(uuids, uuid)
-
-		// Move past this token
-		endPos := findSubstring(content[start:], "}}")
-		if endPos == -1 {
-			break
-		}
-		content = content[start+endPos+2:]
-	}
-
-	return uuids
-}
-
-// Helper function to find substring position
-func findSubstring(str, substr string) int {
-	for i := 0; i <= len(str)-len(substr); i++ {
-		if str[i:i+len(substr)] == substr {
-			return i
-		}
-	}
-	return -1
+	return MentionUUIDs(ParseMentions(n.Content))
 }
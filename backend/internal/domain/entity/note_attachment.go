@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"io"
+	"time"
+)
+
+// NoteAttachment is a file attached to a note, its content is kept in the blob storage under StorageKey
+type NoteAttachment struct {
+	ID          int64
+	UUID        string
+	NoteID      int64
+	CompanyID   int64
+	UserID      int64
+	UserName    string
+	FileName    string
+	ContentType string
+	SizeBytes   int64
+	StorageKey  string
+	CreatedAt   time.Time
+	DeletedAt   *time.Time
+}
+
+// NoteAttachmentUpload is a file sent by the user to be attached to a note
+type NoteAttachmentUpload struct {
+	FileName string
+	Size     int64
+	Content  io.Reader
+}
+
+// NoteAttachmentDownloadURL is a temporary token to download an attachment without the authorization header,
+// so the file can be opened by the browser, like in an img tag
+type NoteAttachmentDownloadURL struct {
+	Token     string
+	ExpiresAt time.Time
+}
+
+// NoteAttachmentDownload is the content of an attachment, the caller must close it
+type NoteAttachmentDownload struct {
+	Attachment NoteAttachment
+	Content    io.ReadCloser
+}
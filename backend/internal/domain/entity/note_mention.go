@@ -41,6 +41,7 @@ type TimelineEntry struct {
 
 // MentionEntry represents notes where a person was mentioned (feedbacks received)
 type MentionEntry struct {
+	NoteID           int64     `json:"-"` // position of the entry in the cursor pagination
 	UUID             string    `json:"uuid"`
 	Type             string    `json:"type"`             // "one_on_one", "feedback", "observation"
 	Content          string    `json:"content"`
@@ -49,17 +50,12 @@ type MentionEntry struct {
 	CreatedAt        time.Time `json:"created_at"`
 	PersonID         string    `json:"person_id"`   // UUID da pessoa sobre quem a nota foi feita
 	PersonName       string    `json:"person_name"` // Nome da pessoa sobre quem a nota foi feita
-	Mentions         []struct {
-		ID          string `json:"id"`
-		PersonID    string `json:"person_id"`
-		PersonName  string `json:"person_name"`
-		StartIndex  int    `json:"start_index"`
-		EndIndex    int    `json:"end_index"`
-	} `json:"mentions,omitempty"`
+	Mentions         []MentionToken `json:"mentions,omitempty"` // Tokens de menção do conteúdo com suas posições
 }
 
 // UnifiedTimelineEntry represents a unified timeline entry combining both direct notes and mentions
 type UnifiedTimelineEntry struct {
+	NoteID      int64     `json:"-"` // position of the entry in the cursor pagination
 	UUID        string    `json:"uuid"`
 	Type        string    `json:"type"`        // "one_on_one", "feedback", "observation", "mention"
 	Content     string    `json:"content"`
@@ -73,6 +69,9 @@ type UnifiedTimelineEntry struct {
 	// For mentions only - who mentioned this person
 	MentionedByPersonUUID *string `json:"mentioned_by_person_uuid,omitempty"`
 	MentionedByPersonName *string `json:"mentioned_by_person_name,omitempty"`
+
+	Mentions []MentionToken `json:"mentions,omitempty"` // Tokens de menção do conteúdo com suas posições
+	Tags     []string       `json:"tags,omitempty"`     // Nomes das tags da nota, sem o #
 }
 
 // TimelineFilters represents filters for the unified timeline endpoint
@@ -81,5 +80,12 @@ type TimelineFilters struct {
 	Types          []string `json:"types,omitempty"`          // ["feedback", "one_on_one", "observation", "mention"]
 	FeedbackTypes  []string `json:"feedback_types,omitempty"` // ["positive", "constructive", "neutral"]
 	Direction      string   `json:"direction,omitempty"`      // "all", "about-person", "from-person", "bilateral"
-	Period         string   `json:"period,omitempty"`         // "7d", "30d", "3m", "6m", "1y", "all"
+	Period         string   `json:"period,omitempty"`         // "7d", "30d", "3m", "6m", "1y", "all", turned into From by the service
+	From           *time.Time `json:"from,omitempty"`         // inclusive
+	To             *time.Time `json:"to,omitempty"`           // exclusive
+	Tags           []string   `json:"tags,omitempty"`         // notes with any of the tags, names without the #
+	AuthorUUID         string   `json:"author_uuid,omitempty"`         // user who wrote the notes
+	AuthorUserID       *int64   `json:"-"`                             // resolved from AuthorUUID
+	FeedbackCategories []string `json:"feedback_categories,omitempty"` // ["performance", "behavior", "skill", "collaboration"]
+	Sort               string   `json:"sort,omitempty"`                // "newest" (default), "oldest"
 }
\ No newline at end of file
@@ -0,0 +1,48 @@
+package entity
+
+import "time"
+
+// NoteRevision is an immutable snapshot of a note, created when the note is written, edited or restored
+type NoteRevision struct {
+	ID               int64
+	UUID             string
+	NoteID           int64
+	Number           int
+	UserID           int64
+	UserName         string
+	Type             string
+	Content          string
+	FeedbackType     *string
+	FeedbackCategory *string
+	RestoredFrom     *int // number of the revision this one restored
+	CreatedAt        time.Time
+}
+
+// SameContent reports whether both revisions have the same content and type fields
+func (r NoteRevision) SameContent(other NoteRevision) bool {
+	return r.Type == other.Type &&
+		r.Content == other.Content &&
+		equalStringPtr(r.FeedbackType, other.FeedbackType) &&
+		equalStringPtr(r.FeedbackCategory, other.FeedbackCategory)
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// NoteDiffLine is a line of the content of a revision compared with another one
+type NoteDiffLine struct {
+	Op   string // "equal", "added" or "removed"
+	Text string
+}
+
+// NoteRevisionDiff compares two revisions of a note
+type NoteRevisionDiff struct {
+	From          NoteRevision
+	To            NoteRevision
+	ChangedFields []string // type, feedback_type and feedback_category when they differ
+	Lines         []NoteDiffLine
+}
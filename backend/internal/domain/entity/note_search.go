@@ -0,0 +1,53 @@
+package entity
+
+import "time"
+
+// NoteSearchFilters narrows a note search, the empty fields don't filter
+type NoteSearchFilters struct {
+	PersonUUID string   // notes about the person or mentioning them
+	AuthorUUID string   // user who wrote the notes
+	Types      []string // "one_on_one", "feedback", "observation"
+	From       *time.Time
+	To         *time.Time // exclusive
+}
+
+// NoteSearchQuery is a search over the notes of a company, with the filters resolved to ids
+type NoteSearchQuery struct {
+	CompanyID    int64
+	Text         string
+	PersonID     *int64
+	AuthorUserID *int64
+	Types        []string
+	From         *time.Time
+	To           *time.Time
+	Take         int64
+	Skip         int64
+}
+
+// NoteSearchHit is a note found by the search engine with its relevance, higher is more relevant
+type NoteSearchHit struct {
+	NoteUUID         string
+	Type             string
+	Content          string
+	FeedbackType     *string
+	FeedbackCategory *string
+	PersonUUID       string
+	PersonName       string
+	AuthorUUID       string
+	AuthorName       string
+	CreatedAt        time.Time
+	Score            float64
+}
+
+// NoteSnippetPart is a piece of the snippet of a search result, Highlighted when it matches the search
+type NoteSnippetPart struct {
+	Text        string
+	Highlighted bool
+}
+
+// NoteSearchResult is a note found by the search with an excerpt of its content around the matches
+type NoteSearchResult struct {
+	NoteSearchHit
+	Snippet  []NoteSnippetPart
+	Mentions []MentionToken
+}
@@ -0,0 +1,46 @@
+package entity
+
+import "time"
+
+// NoteTemplate is a structure of sections to write a note, built-in, shared by the company or personal
+type NoteTemplate struct {
+	ID          int64
+	UUID        string
+	CompanyID   *int64 // nil for the built-in templates
+	UserID      *int64 // set for the personal templates of a user
+	NoteType    string
+	Name        string
+	Description string
+	Sections    []NoteTemplateSection // ordered by position
+	UsageCount  int64                 // notes of the company written with the template
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// IsAvailableTo returns true if the user can use the template in the company
+func (t *NoteTemplate) IsAvailableTo(companyID, userID int64) bool {
+	if t.CompanyID == nil {
+		return true
+	}
+	if *t.CompanyID != companyID {
+		return false
+	}
+	return t.UserID == nil || *t.UserID == userID
+}
+
+// NoteTemplateSection is a section of a template, rendered as a heading followed by its content
+type NoteTemplateSection struct {
+	ID          int64
+	TemplateID  int64
+	Title       string
+	Placeholder string // hint shown while the section is empty
+	Content     string // initial text, may use the template variables
+	Position    int
+}
+
+// NoteTemplateInstance is a template rendered for a person, ready to be edited and saved as a note
+type NoteTemplateInstance struct {
+	Template NoteTemplate
+	Content  string                // sections rendered as markdown headings, to be used as the note content
+	Sections []NoteTemplateSection // sections with the variables replaced
+}
@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+// OutboxEvent is a side effect written in the same transaction as the data that caused it,
+// processed later by the outbox worker
+type OutboxEvent struct {
+	ID            int64
+	UUID          string
+	EventType     string
+	Payload       string // JSON
+	Status        string
+	Attempts      int
+	MaxAttempts   int
+	NextAttemptAt time.Time
+	LastError     *string
+	CreatedAt     time.Time
+	ProcessedAt   *time.Time
+}
+
+// NoteAttributesExtractionPayload is the payload of the events that extract the attributes of a person from a note
+type NoteAttributesExtractionPayload struct {
+	NoteID   int64  `json:"note_id"`
+	UserUUID string `json:"user_uuid,omitempty"` // author of the note, the AI usage is tracked for them
+}
+
+// PersonImportPayload is the payload of the events and jobs that create the people of a pending import
+type PersonImportPayload struct {
+	ImportUUID string            `json:"import_uuid"`
+	Mapping    map[string]string `json:"mapping,omitempty"` // person field => column header, as uploaded
+}
@@ -0,0 +1,63 @@
+package entity
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidPageCursor is returned when a cursor was not created by PageCursor.Encode
+var ErrInvalidPageCursor = errors.New("invalid cursor")
+
+// PageCursor is the position of an item in a list ordered by creation time, the id breaking the ties.
+// Unlike an offset it does not move when items are added before it
+type PageCursor struct {
+	CreatedAt time.Time
+	ID        int64
+}
+
+// Encode returns the cursor as an opaque string, the clients only send it back to get the next page
+func (c PageCursor) Encode() string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + ":" + strconv.FormatInt(c.ID, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodePageCursor parses a cursor returned by PageCursor.Encode
+func DecodePageCursor(cursor string) (PageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return PageCursor{}, ErrInvalidPageCursor
+	}
+
+	createdAt, id, found := strings.Cut(string(raw), ":")
+	if !found {
+		return PageCursor{}, ErrInvalidPageCursor
+	}
+
+	nanos, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return PageCursor{}, ErrInvalidPageCursor
+	}
+
+	itemID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || itemID < 1 {
+		return PageCursor{}, ErrInvalidPageCursor
+	}
+
+	return PageCursor{CreatedAt: time.Unix(0, nanos).UTC(), ID: itemID}, nil
+}
+
+// CursorPage asks for the items after the cursor, or for the first ones when there is no cursor
+type CursorPage struct {
+	After     *PageCursor
+	Limit     int64
+	WithCount bool // also count all the items of the list, which costs a query over all of them
+}
+
+// CursorPageInfo describes the page returned for a CursorPage
+type CursorPageInfo struct {
+	Next         *PageCursor // nil on the last page
+	TotalRecords *int64      // only when the count was asked
+}
@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageCursor(t *testing.T) {
+	t.Run("Should decode the encoded cursor", func(t *testing.T) {
+		cursor := PageCursor{CreatedAt: time.Date(2025, time.March, 5, 10, 30, 15, 0, time.UTC), ID: 42}
+
+		decoded, err := DecodePageCursor(cursor.Encode())
+		require.NoError(t, err)
+		require.Equal(t, cursor, decoded)
+	})
+
+	t.Run("Should refuse cursors it did not encode", func(t *testing.T) {
+		for _, cursor := range []string{"", "not base64!", "MTIz", "YWJjOjE", "MTIzOjA", "MTIzOmFiYw"} {
+			_, err := DecodePageCursor(cursor)
+			require.ErrorIs(t, err, ErrInvalidPageCursor, cursor)
+		}
+	})
+}
@@ -2,6 +2,8 @@ package entity
 
 import (
 	"time"
+
+	"github.com/diegoclair/leaderpro/util/date"
 )
 
 type Person struct {
@@ -26,7 +28,9 @@ type Person struct {
 	Personality string
 	
 	// One-on-One information
-	LastOneOnOneDate *time.Time `json:"last_one_on_one_date"`
+	LastOneOnOneDate    *time.Time `json:"last_one_on_one_date"`
+	OneOnOneCadence     *string    // "weekly", "biweekly", "monthly", "custom" - nil when there is no recurring 1:1
+	OneOnOneCadenceDays *int       // interval of the "custom" cadence
 	
 	// Address information (loaded separately)
 	PrimaryAddress *Address `json:"primary_address,omitempty"`
@@ -44,23 +48,54 @@ func (p *Person) GetAge() *int {
 		return nil
 	}
 	
-	now := time.Now()
-	age := now.Year() - p.Birthday.Year()
-	
-	// Adjust if birthday hasn't occurred this year
-	if now.YearDay() < p.Birthday.YearDay() {
-		age--
-	}
-	
+	age := date.YearsBetween(*p.Birthday, time.Now())
 	return &age
 }
 
-// GetTenure returns how long the person has been with the company in months
+// GetTenure returns how long the person has been with the company in complete calendar months
 func (p *Person) GetTenure() *int {
 	if p.StartDate == nil {
 		return nil
 	}
 	
-	months := int(time.Since(*p.StartDate).Hours() / 24 / 30)
+	months := date.MonthsBetween(*p.StartDate, time.Now())
 	return &months
-}
\ No newline at end of file
+}
+
+// NextOneOnOneDate returns the day the next 1:1 is due by the person's cadence, counted from the day of
+// the last held 1:1 in the given location. Without any 1:1 yet, it is due since the day the person was added.
+// Returns nil when the person has no valid cadence
+func (p *Person) NextOneOnOneDate(loc *time.Location) *time.Time {
+	if p.OneOnOneCadence == nil {
+		return nil
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	if p.LastOneOnOneDate == nil {
+		due := date.Day(p.CreatedAt.In(loc))
+		return &due
+	}
+
+	last := date.Day(p.LastOneOnOneDate.In(loc))
+
+	var due time.Time
+	switch *p.OneOnOneCadence {
+	case "weekly":
+		due = last.AddDate(0, 0, 7)
+	case "biweekly":
+		due = last.AddDate(0, 0, 14)
+	case "monthly":
+		due = date.AddMonths(last, 1)
+	case "custom":
+		if p.OneOnOneCadenceDays == nil || *p.OneOnOneCadenceDays <= 0 {
+			return nil
+		}
+		due = last.AddDate(0, 0, *p.OneOnOneCadenceDays)
+	default:
+		return nil
+	}
+
+	return &due
+}
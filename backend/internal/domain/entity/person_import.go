@@ -0,0 +1,54 @@
+package entity
+
+import (
+	"time"
+)
+
+// PersonImport is the record of a bulk import of people from a CSV/XLSX file, including its result report
+type PersonImport struct {
+	ID           int64
+	UUID         string
+	CompanyID    int64
+	UserID       int64
+	FileName     string
+	DryRun       bool
+	Status       string // "pending", "completed", "failed"
+	TotalRows    int
+	ImportedRows int
+	ErrorRows    int
+	Report       []byte // CSV with the result of each row
+	FileData     []byte // uploaded file, kept while the import is pending
+	CreatedAt    time.Time
+}
+
+// PersonImportInput is the uploaded file and how its columns map to person fields
+type PersonImportInput struct {
+	FileName string
+	Data     []byte
+	Mapping  map[string]string // person field => column header. Empty means automatic mapping by header name
+	DryRun   bool
+}
+
+// PersonImportRow is one line of the imported file, already converted to a person
+type PersonImportRow struct {
+	Line         int // line number in the file, the header is line 1
+	Status       string
+	Person       Person
+	Address      *Address
+	ManagerEmail string
+	Errors       []string
+}
+
+func (r *PersonImportRow) AddError(message string) {
+	r.Errors = append(r.Errors, message)
+}
+
+func (r *PersonImportRow) IsValid() bool {
+	return len(r.Errors) == 0
+}
+
+// PersonImportResult is the import record and the result of each row
+type PersonImportResult struct {
+	Import PersonImport
+	Rows   []PersonImportRow
+}
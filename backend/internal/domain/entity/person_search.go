@@ -0,0 +1,19 @@
+package entity
+
+// PersonSearchDocument is a person with the normalized (lowercase, no accents) values used by the search.
+// The documents of a company are cached together as the search index
+type PersonSearchDocument struct {
+	Person     Person   `json:"person"`
+	Name       string   `json:"name"`
+	Email      string   `json:"email"`
+	Position   string   `json:"position"`
+	Department string   `json:"department"`
+	Attributes []string `json:"attributes"`
+}
+
+// PersonSearchResult is a person found by the search with its relevance
+type PersonSearchResult struct {
+	Person       Person  `json:"person"`
+	Score        float64 `json:"score"`         // 0.0 - 1.0
+	MatchedField string  `json:"matched_field"` // name, email, position, department or attributes
+}
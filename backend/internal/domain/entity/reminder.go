@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"time"
+)
+
+// PersonReminder is an upcoming birthday or work anniversary of a person
+type PersonReminder struct {
+	Type       string // birthday, work_anniversary
+	PersonID   int64
+	PersonUUID string
+	PersonName string
+	Date       time.Time // day of the celebration, in the user timezone
+	DaysUntil  int       // 0 means today
+	Years      int       // age on the birthday or years of company on the anniversary
+}
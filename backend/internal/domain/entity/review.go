@@ -0,0 +1,120 @@
+package entity
+
+import "time"
+
+// ReviewTemplate defines what the reviews of a cycle evaluate: the competencies, rated on the rating scale
+type ReviewTemplate struct {
+	ID           int64
+	UUID         string
+	CompanyID    int64
+	Name         string
+	Description  string
+	Competencies []ReviewCompetency  // ordered by position
+	RatingLevels []ReviewRatingLevel // ordered by value, higher is better
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// HasRatingLevel returns true when the value is one of the levels of the rating scale
+func (t *ReviewTemplate) HasRatingLevel(value int) bool {
+	for _, level := range t.RatingLevels {
+		if level.Value == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ReviewCompetency is a competency evaluated in the reviews of a template
+type ReviewCompetency struct {
+	ID          int64
+	UUID        string
+	TemplateID  int64
+	Name        string
+	Description string
+	Position    int
+}
+
+// ReviewRatingLevel is a level of the rating scale of a template, e.g. 3 - Meets expectations
+type ReviewRatingLevel struct {
+	ID          int64
+	TemplateID  int64
+	Value       int
+	Label       string
+	Description string
+}
+
+// ReviewCycle is a review period of a company, where each person gets a review based on the template
+type ReviewCycle struct {
+	ID           int64
+	UUID         string
+	CompanyID    int64
+	TemplateID   int64
+	TemplateUUID string
+	TemplateName string
+	UserID       int64
+	Name         string
+	PeriodStart  time.Time
+	PeriodEnd    time.Time // inclusive
+	DueDate      *time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Review is the review document of a person in a cycle.
+// It goes from draft to submitted and then shared with the person
+type Review struct {
+	ID            int64
+	UUID          string
+	CycleID       int64
+	CycleUUID     string
+	CycleName     string
+	TemplateID    int64
+	PeriodStart   time.Time
+	PeriodEnd     time.Time // inclusive
+	CompanyID     int64
+	PersonID      int64
+	PersonUUID    string
+	PersonName    string
+	UserID        int64
+	Status        string // draft, submitted, shared
+	OverallRating *int
+	Summary       string
+	Strengths     string
+	Improvements  string
+	SubmittedAt   *time.Time
+	SharedAt      *time.Time
+	Ratings       []ReviewRating  // one per competency of the template, in the template order
+	Template      *ReviewTemplate // loaded with the review document
+	Evidence      *ReviewEvidence // compiled from the timeline of the period, not persisted
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// IsDraft returns true while the review can still be edited
+func (r *Review) IsDraft() bool {
+	return r.Status == "draft"
+}
+
+// ReviewRating is the rating of a competency in a review
+type ReviewRating struct {
+	ID             int64
+	ReviewID       int64
+	CompetencyID   int64
+	CompetencyUUID string
+	CompetencyName string
+	Rating         *int // nil while the competency was not rated
+	Comment        string
+}
+
+// ReviewEvidence is what happened with the person in the review period, compiled from the timeline
+type ReviewEvidence struct {
+	FeedbackCount      int
+	FeedbackByType     map[string]int // positive, constructive, neutral
+	FeedbackByCategory map[string]int // performance, behavior, skill, collaboration
+	Feedback           []UnifiedTimelineEntry
+	Mentions           []UnifiedTimelineEntry // notes about other people mentioning the person
+	OneOnOneCount      int
+	OneOnOneHighlights []UnifiedTimelineEntry // content shortened to a highlight
+	ObservationCount   int
+}
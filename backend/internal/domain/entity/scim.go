@@ -0,0 +1,79 @@
+package entity
+
+import (
+	"time"
+)
+
+// SCIMUser is a person as seen by the company HR system through the SCIM /Users endpoint
+type SCIMUser struct {
+	PersonID   int64
+	ID         string // person UUID, used as the SCIM id
+	ExternalID string
+	UserName   string
+	Name       string
+	Email      string
+	Phone      string
+	Position   string
+	Department string
+	ManagerRef string // SCIM id (person UUID) or external id of the manager, as sent by the HR system
+	ManagerID  *int64
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// PersonSync links a person to its record in the HR system
+type PersonSync struct {
+	ID                int64
+	PersonID          int64
+	CompanyID         int64
+	ExternalID        string
+	UserName          string
+	Snapshot          *PersonSyncSnapshot // nil when the person existed before being linked
+	PendingManagerRef *string
+	LastSyncedAt      time.Time
+	CreatedAt         time.Time
+}
+
+// PersonSyncSnapshot holds the values received on the last sync. A person field that differs from
+// its snapshot value was edited manually and is not overwritten by the next syncs
+type PersonSyncSnapshot struct {
+	Name       string `json:"name"`
+	Email      string `json:"email"`
+	Phone      string `json:"phone"`
+	Position   string `json:"position"`
+	Department string `json:"department"`
+	ManagerID  *int64 `json:"manager_id,omitempty"`
+}
+
+// SCIMGroup is a group provisioned by the HR system through the SCIM /Groups endpoint
+type SCIMGroup struct {
+	ID          int64
+	UUID        string
+	CompanyID   int64
+	ExternalID  string
+	DisplayName string
+	Members     []SCIMGroupMember
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// SCIMGroupMember is a person that belongs to a SCIM group
+type SCIMGroupMember struct {
+	PersonID   int64
+	PersonUUID string
+	Name       string
+}
+
+// SCIMFilter is a SCIM filter with a single equality expression, e.g. userName eq "john@company.com"
+type SCIMFilter struct {
+	Attribute string
+	Value     string
+}
+
+// SCIMListParams are the filter and pagination of a SCIM list request. StartIndex is 1-based
+type SCIMListParams struct {
+	Filter     *SCIMFilter
+	StartIndex int64
+	Count      int64
+}
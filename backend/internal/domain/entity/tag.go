@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	tagPrefix = '#'
+
+	// MaxTagNameLength is the maximum number of characters of a tag name, without the #
+	MaxTagNameLength = 50
+)
+
+// Tag is a topic of the notes of a company, like #promotion or #burnout-risk. Curated tags are created by the
+// company to be reused, the others are created the first time a note uses them
+type Tag struct {
+	ID          int64
+	UUID        string
+	CompanyID   int64
+	Name        string // normalized, without the #
+	Description string
+	Curated     bool
+	NotesCount  int64 // notes using the tag, filled only by the listing of the company tags
+	CreatedAt   time.Time
+}
+
+// TagAnalyticsFilters filters the notes counted by the tag analytics
+type TagAnalyticsFilters struct {
+	TagNames []string   // all tags when empty
+	From     *time.Time // inclusive
+	To       *time.Time // exclusive
+	Interval string     // "week" or "month", the size of the periods over time
+}
+
+// TagPersonCount is how many notes about a person use a tag
+type TagPersonCount struct {
+	TagName    string
+	PersonUUID string
+	PersonName string
+	Count      int64
+}
+
+// TagPeriodCount is how many notes of a period use a tag. The period starts on a Monday for weeks
+// and on the first day for months
+type TagPeriodCount struct {
+	TagName     string
+	PeriodStart time.Time
+	Count       int64
+}
+
+// TagAnalytics counts the notes of the company by tag, per person and over time
+type TagAnalytics struct {
+	ByPerson []TagPersonCount // most used first
+	OverTime []TagPeriodCount // ordered by period
+}
+
+// NormalizeTagName returns the tag name as it is stored: lowercase and without the #. It returns false
+// when the name is not a valid tag: letters, digits, "-" and "_", with at least one letter
+func NormalizeTagName(name string) (string, bool) {
+	name = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(name), string(tagPrefix)))
+	name = strings.TrimRight(name, "-_")
+
+	if name == "" || len([]rune(name)) > MaxTagNameLength {
+		return "", false
+	}
+
+	hasLetter := false
+	for _, r := range name {
+		if !isTagRune(r) {
+			return "", false
+		}
+		if unicode.IsLetter(r) {
+			hasLetter = true
+		}
+	}
+
+	return name, hasLetter
+}
+
+// ParseTags returns the normalized names of the #tags of the content without repetitions, in the order they first appear.
+// A # only starts a tag at the beginning of a word, so "C#" and "a#b" are not tags, a backslash before it writes the #
+// literally and the names of the mention tokens are ignored. Numbers like "#42" are not tags
+func ParseTags(content string) []string {
+	runes := []rune(content)
+
+	mentions := ParseMentions(content)
+	next := 0
+
+	seen := make(map[string]bool)
+	var names []string
+	for i := 0; i < len(runes); i++ {
+		for next < len(mentions) && mentions[next].EndIndex <= i {
+			next++
+		}
+		if next < len(mentions) && mentions[next].StartIndex <= i {
+			i = mentions[next].EndIndex - 1
+			continue
+		}
+
+		if runes[i] != tagPrefix || (i > 0 && !isTagBoundary(runes[i-1])) {
+			continue
+		}
+
+		end := i + 1
+		for end < len(runes) && isTagRune(runes[end]) {
+			end++
+		}
+
+		name, ok := NormalizeTagName(string(runes[i+1 : end]))
+		i = end - 1
+		if !ok || seen[name] {
+			continue
+		}
+
+		seen[name] = true
+		names = append(names, name)
+	}
+
+	return names
+}
+
+func isTagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_'
+}
+
+// isTagBoundary returns true when a # after the rune starts a tag
+func isTagBoundary(r rune) bool {
+	return !isTagRune(r) && r != tagPrefix && r != mentionEscape && r != '&'
+}
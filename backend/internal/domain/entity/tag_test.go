@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "tags are lowercase and without the #",
+			content: "Talked about the #Promotion and the #burnout-risk.",
+			want:    []string{"promotion", "burnout-risk"},
+		},
+		{
+			name:    "repeated tags appear once",
+			content: "#project-x kickoff, #project-x retro and #PROJECT-X demo",
+			want:    []string{"project-x"},
+		},
+		{
+			name:    "tag at the start of a line and after punctuation",
+			content: "#growth\n(#mentoring)",
+			want:    []string{"growth", "mentoring"},
+		},
+		{
+			name:    "accented letters",
+			content: "Falamos sobre #promoção",
+			want:    []string{"promoção"},
+		},
+		{
+			name:    "trailing separators are not part of the tag",
+			content: "It is #done_ and #ready-",
+			want:    []string{"done", "ready"},
+		},
+		{
+			name:    "# inside a word is not a tag",
+			content: "Writes C# and a#b, see issue&#35",
+			want:    nil,
+		},
+		{
+			name:    "numbers are not tags",
+			content: "Fixed #42 and #2024",
+			want:    nil,
+		},
+		{
+			name:    "escaped # is literal",
+			content: `Not a tag: \#promotion`,
+			want:    nil,
+		},
+		{
+			name:    "mention names are ignored",
+			content: "{{person:abc-1|#Maria}} on #hiring",
+			want:    []string{"hiring"},
+		},
+		{
+			name:    "too long names are not tags",
+			content: "#" + strings.Repeat("a", MaxTagNameLength+1) + " #ok",
+			want:    []string{"ok"},
+		},
+		{
+			name:    "no tags",
+			content: "Just a note",
+			want:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ParseTags(tt.content))
+		})
+	}
+}
+
+func TestNormalizeTagName(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		want   string
+		wantOk bool
+	}{
+		{name: "with the #", input: " #Burnout-Risk ", want: "burnout-risk", wantOk: true},
+		{name: "without the #", input: "project_x", want: "project_x", wantOk: true},
+		{name: "only digits", input: "#2024", wantOk: false},
+		{name: "spaces inside", input: "project x", wantOk: false},
+		{name: "empty", input: "#", wantOk: false},
+		{name: "too long", input: strings.Repeat("a", MaxTagNameLength+1), wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := NormalizeTagName(tt.input)
+			require.Equal(t, tt.wantOk, ok)
+			if tt.wantOk {
+				require.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
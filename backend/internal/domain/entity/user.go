@@ -48,6 +48,9 @@ type UserPreferences struct {
 	// Appearance
 	Theme string // system, light, dark
 	
+	// Locale
+	Timezone string // IANA name, e.g. America/Sao_Paulo
+	
 	// Metadata
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -58,4 +61,19 @@ func (p *UserPreferences) SetDefaults() {
 	if p.Theme == "" {
 		p.Theme = "light"
 	}
+	if p.Timezone == "" {
+		p.Timezone = "UTC"
+	}
+}
+
+// Location returns the time location of the user timezone, falling back to UTC when it is not valid
+func (p *UserPreferences) Location() *time.Location {
+	if p.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
 }
@@ -0,0 +1,10 @@
+package domain
+
+import "errors"
+
+// ErrJobNotFound is returned by the job queue when the job does not exist in the expected state,
+// e.g. when a worker lost the lease of a running job to another worker
+var ErrJobNotFound = errors.New("job not found")
+
+// ErrBlobNotFound is returned by the blob storage when there is no content under the key
+var ErrBlobNotFound = errors.New("blob not found")
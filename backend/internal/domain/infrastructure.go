@@ -12,14 +12,20 @@ type Infrastructure interface {
 	Logger() logger.Logger
 	Crypto() contract.Crypto
 	Validator() validator.Validator
+	JobQueue() contract.JobQueue
+	NoteSearchEngine() contract.NoteSearchEngine
+	BlobStorage() contract.BlobStorage
 }
 
 type infrastructureServices struct {
-	cacheManager contract.CacheManager
-	dataManager  contract.DataManager
-	logger       logger.Logger
-	crypto       contract.Crypto
-	validator    validator.Validator
+	cacheManager     contract.CacheManager
+	dataManager      contract.DataManager
+	logger           logger.Logger
+	crypto           contract.Crypto
+	validator        validator.Validator
+	jobQueue         contract.JobQueue
+	noteSearchEngine contract.NoteSearchEngine
+	blobStorage      contract.BlobStorage
 }
 
 type InfraOption func(*infrastructureServices)
@@ -54,6 +60,24 @@ func WithValidator(validator validator.Validator) InfraOption {
 	}
 }
 
+func WithJobQueue(jobQueue contract.JobQueue) InfraOption {
+	return func(i *infrastructureServices) {
+		i.jobQueue = jobQueue
+	}
+}
+
+func WithNoteSearchEngine(noteSearchEngine contract.NoteSearchEngine) InfraOption {
+	return func(i *infrastructureServices) {
+		i.noteSearchEngine = noteSearchEngine
+	}
+}
+
+func WithBlobStorage(blobStorage contract.BlobStorage) InfraOption {
+	return func(i *infrastructureServices) {
+		i.blobStorage = blobStorage
+	}
+}
+
 func NewInfrastructureServices(options ...InfraOption) Infrastructure {
 	infra := &infrastructureServices{}
 	for _, option := range options {
@@ -81,3 +105,15 @@ func (i *infrastructureServices) Crypto() contract.Crypto {
 func (i *infrastructureServices) Validator() validator.Validator {
 	return i.validator
 }
+
+func (i *infrastructureServices) JobQueue() contract.JobQueue {
+	return i.jobQueue
+}
+
+func (i *infrastructureServices) NoteSearchEngine() contract.NoteSearchEngine {
+	return i.noteSearchEngine
+}
+
+func (i *infrastructureServices) BlobStorage() contract.BlobStorage {
+	return i.blobStorage
+}
@@ -0,0 +1,132 @@
+package actionitemroute
+
+import (
+	"sync"
+
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	actionItemService contract.ActionItemApp
+}
+
+func NewHandler(actionItemService contract.ActionItemApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			actionItemService: actionItemService,
+		}
+	})
+
+	return instance
+}
+
+func (s *Handler) handleCreateActionItem(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.ActionItemRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	item, err := s.actionItemService.CreateActionItem(ctx, personUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.ActionItemResponse{}
+	response.FillFromEntity(item)
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleGetPersonActionItems(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	take, skip := routeutils.GetPagingParams(c, "", "")
+
+	items, totalRecords, err := s.actionItemService.GetPersonActionItems(ctx, personUUID, c.QueryParam("status"), take, skip)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.ActionItemResponse, len(items))
+	for i, item := range items {
+		response[i].FillFromEntity(item)
+	}
+
+	return routeutils.ResponseAPIOk(c, viewmodel.BuildPaginatedResponse(response, skip, take, totalRecords))
+}
+
+func (s *Handler) handleGetOverdueActionItems(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	items, err := s.actionItemService.GetOverdueActionItems(ctx)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.ActionItemResponse, len(items))
+	for i, item := range items {
+		response[i].FillFromEntity(item)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleUpdateActionItem(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	itemUUID, err := routeutils.GetRequiredStringPathParam(c, "action_item_uuid", "Invalid action_item_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.UpdateActionItemRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	err = s.actionItemService.UpdateActionItem(ctx, itemUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleDeleteActionItem(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	itemUUID, err := routeutils.GetRequiredStringPathParam(c, "action_item_uuid", "Invalid action_item_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.actionItemService.DeleteActionItem(ctx, itemUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
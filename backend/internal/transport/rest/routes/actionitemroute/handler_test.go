@@ -0,0 +1,221 @@
+package actionitemroute_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/actionitemroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	companyUUID    = "company-uuid-123"
+	personUUID     = "person-uuid-123"
+	actionItemUUID = "action-item-uuid-123"
+	meetingUUID    = "meeting-uuid-123"
+)
+
+type actionItemTest struct {
+	name          string
+	body          any
+	buildMocks    func(m test.AppMocks)
+	checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+}
+
+func runActionItemTests(t *testing.T, method, url string, tests []actionItemTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actionitemroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			var body []byte
+			if tt.body != nil {
+				var err error
+				body, err = json.Marshal(tt.body)
+				require.NoError(t, err)
+			}
+
+			req, err := http.NewRequest(method, url, bytes.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			test.AddAuthorization(context.Background(), t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(m)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestHandler_handleCreateActionItem(t *testing.T) {
+	dueDate := time.Date(2025, time.March, 14, 0, 0, 0, 0, time.UTC)
+	source := meetingUUID
+
+	tests := []actionItemTest{
+		{
+			name: "Should create the action item from a meeting",
+			body: viewmodel.ActionItemRequest{
+				Description: "Share the promotion criteria",
+				Owner:       domain.ActionItemOwnerManager,
+				DueDate:     &dueDate,
+				MeetingUUID: &source,
+			},
+			buildMocks: func(m test.AppMocks) {
+				m.ActionItemAppMock.EXPECT().CreateActionItem(gomock.Any(), personUUID, entity.ActionItem{
+					Description:       "Share the promotion criteria",
+					Owner:             domain.ActionItemOwnerManager,
+					DueDate:           &dueDate,
+					SourceMeetingUUID: &source,
+				}).Return(entity.ActionItem{
+					UUID:              actionItemUUID,
+					PersonUUID:        personUUID,
+					Owner:             domain.ActionItemOwnerManager,
+					Description:       "Share the promotion criteria",
+					DueDate:           &dueDate,
+					Status:            domain.ActionItemStatusOpen,
+					SourceMeetingUUID: &source,
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.ActionItemResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, actionItemUUID, response.UUID)
+				require.Equal(t, "2025-03-14", *response.DueDate)
+				require.Equal(t, meetingUUID, *response.MeetingUUID)
+				require.Equal(t, domain.ActionItemStatusOpen, response.Status)
+			},
+		},
+		{
+			name: "Should return error when the meeting is from another person",
+			body: viewmodel.ActionItemRequest{Description: "Share the promotion criteria", MeetingUUID: &source},
+			buildMocks: func(m test.AppMocks) {
+				m.ActionItemAppMock.EXPECT().CreateActionItem(gomock.Any(), personUUID, gomock.Any()).
+					Return(entity.ActionItem{}, resterrors.NewBadRequestError("meeting does not belong to this person")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	runActionItemTests(t, http.MethodPost, "/companies/"+companyUUID+"/people/"+personUUID+"/action-items", tests)
+}
+
+func TestHandler_handleGetPersonActionItems(t *testing.T) {
+	tests := []actionItemTest{
+		{
+			name: "Should return the open action items",
+			buildMocks: func(m test.AppMocks) {
+				m.ActionItemAppMock.EXPECT().GetPersonActionItems(gomock.Any(), personUUID, domain.ActionItemStatusOpen, int64(10), int64(0)).
+					Return([]entity.ActionItem{{UUID: actionItemUUID, Status: domain.ActionItemStatusOpen}}, int64(1), nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.PaginatedResponse[[]viewmodel.ActionItemResponse]
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response.List, 1)
+				require.Equal(t, actionItemUUID, response.List[0].UUID)
+				require.Nil(t, response.List[0].DueDate)
+			},
+		},
+	}
+
+	runActionItemTests(t, http.MethodGet, "/companies/"+companyUUID+"/people/"+personUUID+"/action-items?status=open&page=1&quantity=10", tests)
+}
+
+func TestHandler_handleGetOverdueActionItems(t *testing.T) {
+	dueDate := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []actionItemTest{
+		{
+			name: "Should return the overdue action items",
+			buildMocks: func(m test.AppMocks) {
+				m.ActionItemAppMock.EXPECT().GetOverdueActionItems(gomock.Any()).
+					Return([]entity.ActionItem{{UUID: actionItemUUID, PersonName: "Ana", DueDate: &dueDate, Status: domain.ActionItemStatusOpen}}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response []viewmodel.ActionItemResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response, 1)
+				require.Equal(t, "Ana", response[0].PersonName)
+				require.Equal(t, "2025-03-01", *response[0].DueDate)
+			},
+		},
+	}
+
+	runActionItemTests(t, http.MethodGet, "/companies/"+companyUUID+"/action-items/overdue", tests)
+}
+
+func TestHandler_handleUpdateActionItem(t *testing.T) {
+	tests := []actionItemTest{
+		{
+			name: "Should mark the action item as done",
+			body: viewmodel.UpdateActionItemRequest{Description: "Share the promotion criteria", Status: domain.ActionItemStatusDone},
+			buildMocks: func(m test.AppMocks) {
+				m.ActionItemAppMock.EXPECT().UpdateActionItem(gomock.Any(), actionItemUUID, entity.ActionItem{
+					Description: "Share the promotion criteria",
+					Status:      domain.ActionItemStatusDone,
+				}).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Should return error when the action item is not found",
+			body: viewmodel.UpdateActionItemRequest{Description: "Share the promotion criteria"},
+			buildMocks: func(m test.AppMocks) {
+				m.ActionItemAppMock.EXPECT().UpdateActionItem(gomock.Any(), actionItemUUID, gomock.Any()).
+					Return(resterrors.NewNotFoundError("action item not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	runActionItemTests(t, http.MethodPut, "/companies/"+companyUUID+"/action-items/"+actionItemUUID, tests)
+}
+
+func TestHandler_handleDeleteActionItem(t *testing.T) {
+	tests := []actionItemTest{
+		{
+			name: "Should delete the action item",
+			buildMocks: func(m test.AppMocks) {
+				m.ActionItemAppMock.EXPECT().DeleteActionItem(gomock.Any(), actionItemUUID).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+	}
+
+	runActionItemTests(t, http.MethodDelete, "/companies/"+companyUUID+"/action-items/"+actionItemUUID, tests)
+}
@@ -0,0 +1,92 @@
+package actionitemroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const GroupRouteName = "companies/:company_uuid"
+
+const (
+	PersonActionItemsRoute  = "/people/:person_uuid/action-items"
+	OverdueActionItemsRoute = "/action-items/overdue"
+	ActionItemByUUIDRoute   = "/action-items/:action_item_uuid"
+)
+
+type ActionItemRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *ActionItemRouter {
+	return &ActionItemRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *ActionItemRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.CompanyGroup.Group(GroupRouteName)
+
+	router.POST(PersonActionItemsRoute, r.ctrl.handleCreateActionItem).
+		Summary("Create action item").
+		Description("Create a follow-up for a person, optionally linked to the note or meeting where it was agreed. The item is added to the agenda of the next scheduled 1:1").
+		Read(viewmodel.ActionItemRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.ActionItemResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(PersonActionItemsRoute, r.ctrl.handleGetPersonActionItems).
+		Summary("Get person action items").
+		Description("Get the action items of a person, ordered by due date").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.PaginatedResponse[[]viewmodel.ActionItemResponse]{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		QueryParam("status", "filter by status: open, done or canceled", goswag.StringType, false).
+		QueryParam("page", "page number", goswag.IntType, false).
+		QueryParam("quantity", "quantity of items per page", goswag.IntType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(OverdueActionItemsRoute, r.ctrl.handleGetOverdueActionItems).
+		Summary("Get overdue action items").
+		Description("Get the open action items of the company with due date before today, in the user timezone").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.ActionItemResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.PUT(ActionItemByUUIDRoute, r.ctrl.handleUpdateActionItem).
+		Summary("Update action item").
+		Description("Update an action item. Setting the status to done registers the completion date").
+		Read(viewmodel.UpdateActionItemRequest{}).
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("action_item_uuid", "action item uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(ActionItemByUUIDRoute, r.ctrl.handleDeleteActionItem).
+		Summary("Delete action item").
+		Description("Delete an action item").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("action_item_uuid", "action item uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+}
@@ -0,0 +1,71 @@
+package activityroute
+
+import (
+	"sync"
+
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	activityService contract.ActivityApp
+}
+
+func NewHandler(activityService contract.ActivityApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			activityService: activityService,
+		}
+	})
+
+	return instance
+}
+
+func (s *Handler) handleGetActivityFeed(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	page, err := routeutils.GetCursorPageParams(c)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	feed, err := s.activityService.GetActivityFeed(ctx, entity.ActivityFeedFilters{
+		PersonUUID: c.QueryParam("person_uuid"),
+		Team:       c.QueryParam("team"),
+		Types:      routeutils.GetStringArrayQueryParam(c, "types", ","),
+	}, page)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.ActivityFeedResponse{}
+	response.FillFromEntity(feed, page)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleMarkActivitySeen(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	input := viewmodel.ActivitySeenRequest{}
+	err := c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	err = s.activityService.MarkActivitySeen(ctx, input.ActivityUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
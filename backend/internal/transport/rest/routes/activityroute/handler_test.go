@@ -0,0 +1,178 @@
+package activityroute_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/activityroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	companyUUID  = "company-uuid-123"
+	activityUUID = "activity-uuid-123"
+	personUUID   = "person-uuid-123"
+)
+
+type activityTest struct {
+	name          string
+	url           string
+	body          any
+	buildMocks    func(m test.AppMocks)
+	checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+}
+
+func runActivityTests(t *testing.T, method string, tests []activityTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			activityroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			var body []byte
+			if tt.body != nil {
+				var err error
+				body, err = json.Marshal(tt.body)
+				require.NoError(t, err)
+			}
+
+			req, err := http.NewRequest(method, "/companies/"+companyUUID+tt.url, bytes.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			test.AddAuthorization(context.Background(), t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(m)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestHandler_handleGetActivityFeed(t *testing.T) {
+	next := entity.PageCursor{CreatedAt: time.Date(2025, time.May, 5, 9, 0, 0, 0, time.UTC), ID: 40}
+
+	runActivityTests(t, http.MethodGet, []activityTest{
+		{
+			name: "Should return the feed with the unread activities",
+			url:  "/activity?person_uuid=" + personUUID + "&team=Sales&types=note_created,goal_updated&quantity=2",
+			buildMocks: func(m test.AppMocks) {
+				filters := entity.ActivityFeedFilters{
+					PersonUUID: personUUID,
+					Team:       "Sales",
+					Types:      []string{domain.ActivityTypeNoteCreated, domain.ActivityTypeGoalUpdated},
+				}
+				m.ActivityAppMock.EXPECT().GetActivityFeed(gomock.Any(), filters, entity.CursorPage{Limit: 2}).
+					Return(entity.ActivityFeed{
+						Activities: []entity.Activity{
+							{UUID: activityUUID, Type: domain.ActivityTypeNoteCreated, PersonUUID: personUUID, UserName: "Manager", Unread: true},
+							{UUID: "older-uuid", Type: domain.ActivityTypeGoalUpdated, PersonUUID: personUUID},
+						},
+						Page:        entity.CursorPageInfo{Next: &next},
+						UnreadCount: 5,
+					}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.ActivityFeedResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, int64(5), response.UnreadCount)
+				require.Len(t, response.List, 2)
+				require.True(t, response.List[0].Unread)
+				require.Equal(t, "Manager", response.List[0].AuthorName)
+				require.False(t, response.List[1].Unread)
+				require.True(t, response.CursorPagination.HasMore)
+				require.Equal(t, next.Encode(), response.CursorPagination.NextCursor)
+			},
+		},
+		{
+			name: "Should ask for the page after the cursor",
+			url:  "/activity?cursor=" + next.Encode() + "&with_count=true",
+			buildMocks: func(m test.AppMocks) {
+				page := entity.CursorPage{After: &next, Limit: 10, WithCount: true}
+				m.ActivityAppMock.EXPECT().GetActivityFeed(gomock.Any(), entity.ActivityFeedFilters{Types: []string{}}, page).
+					Return(entity.ActivityFeed{}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name: "Should return error with an invalid cursor",
+			url:  "/activity?cursor=invalid",
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "Should return error with an invalid type",
+			url:  "/activity?types=unknown",
+			buildMocks: func(m test.AppMocks) {
+				m.ActivityAppMock.EXPECT().GetActivityFeed(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(entity.ActivityFeed{}, resterrors.NewBadRequestError("invalid activity type: unknown")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	})
+}
+
+func TestHandler_handleMarkActivitySeen(t *testing.T) {
+	runActivityTests(t, http.MethodPut, []activityTest{
+		{
+			name: "Should mark the activity as seen",
+			url:  "/activity/seen",
+			body: viewmodel.ActivitySeenRequest{ActivityUUID: activityUUID},
+			buildMocks: func(m test.AppMocks) {
+				m.ActivityAppMock.EXPECT().MarkActivitySeen(gomock.Any(), activityUUID).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Should mark all the activities as seen without body",
+			url:  "/activity/seen",
+			buildMocks: func(m test.AppMocks) {
+				m.ActivityAppMock.EXPECT().MarkActivitySeen(gomock.Any(), "").Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Should return not found for an activity of another company",
+			url:  "/activity/seen",
+			body: viewmodel.ActivitySeenRequest{ActivityUUID: activityUUID},
+			buildMocks: func(m test.AppMocks) {
+				m.ActivityAppMock.EXPECT().MarkActivitySeen(gomock.Any(), activityUUID).
+					Return(resterrors.NewNotFoundError("activity not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	})
+}
@@ -0,0 +1,58 @@
+package activityroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const GroupRouteName = "companies/:company_uuid"
+
+const (
+	ActivityRoute     = "/activity"
+	ActivitySeenRoute = "/activity/seen"
+)
+
+type ActivityRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *ActivityRouter {
+	return &ActivityRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *ActivityRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.CompanyGroup.Group(GroupRouteName)
+
+	router.GET(ActivityRoute, r.ctrl.handleGetActivityFeed).
+		Summary("Get activity feed").
+		Description("Get what happened with the people of the company, newest first: notes, feedback, mentions, goal updates, profile changes and attributes extracted by the AI. The activities the logged user has not seen are unread. Send the next_cursor of a page as cursor to get the next one").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.ActivityFeedResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		QueryParam("person_uuid", "only the activities of this person", goswag.StringType, false).
+		QueryParam("team", "only the activities of the people of this department", goswag.StringType, false).
+		QueryParam("types", "comma separated activity types: note_created, feedback_created, person_mentioned, goal_updated, profile_updated, attribute_extracted and reminder", goswag.StringType, false).
+		QueryParam("cursor", "next_cursor of the previous page, empty for the first page", goswag.StringType, false).
+		QueryParam("quantity", "activities per page", goswag.StringType, false).
+		QueryParam("with_count", "also count all the activities of the filters", goswag.BoolType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.PUT(ActivitySeenRoute, r.ctrl.handleMarkActivitySeen).
+		Summary("Mark activity as seen").
+		Description("Mark the activity and the older ones as seen by the logged user, or all of them when no activity is sent. The seen marker never moves back").
+		Read(viewmodel.ActivitySeenRequest{}).
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+}
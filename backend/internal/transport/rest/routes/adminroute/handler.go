@@ -0,0 +1,81 @@
+package adminroute
+
+import (
+	"sync"
+
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	jobService contract.JobApp
+}
+
+func NewHandler(jobService contract.JobApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			jobService: jobService,
+		}
+	})
+
+	return instance
+}
+
+func (s *Handler) handleGetJobQueueState(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	state, err := s.jobService.GetJobQueueState(ctx)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.JobQueueStateResponse{}
+	response.FillFromEntity(state)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetDeadJobs(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	queue, err := routeutils.GetRequiredStringPathParam(c, "queue", "invalid queue")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	jobs, err := s.jobService.GetDeadJobs(ctx, queue)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.DeadJobResponse, len(jobs))
+	for i, job := range jobs {
+		response[i].FillFromEntity(job)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleRequeueDeadJob(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	jobUUID, err := routeutils.GetRequiredStringPathParam(c, "job_uuid", "invalid job_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.jobService.RequeueDeadJob(ctx, jobUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
@@ -0,0 +1,219 @@
+package adminroute_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/adminroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const adminToken = "admin-token"
+
+func TestHandler_handleGetJobQueueState(t *testing.T) {
+	nextRunAt := time.Date(2025, time.March, 4, 2, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		buildMocks    func(m test.AppMocks)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Should return the state of the queues",
+			buildMocks: func(m test.AppMocks) {
+				m.JobAppMock.EXPECT().ValidateAdminToken(gomock.Any(), adminToken).Return(nil).Times(1)
+				m.JobAppMock.EXPECT().GetJobQueueState(gomock.Any()).Return(entity.JobQueueState{
+					Queues: []entity.JobQueueStats{
+						{Queue: domain.JobQueueAI, Concurrency: 2, Pending: 3, Scheduled: 1, Running: 2, Dead: 1},
+					},
+					Schedules: []entity.JobScheduleState{
+						{
+							JobSchedule: entity.JobSchedule{Name: "ai-conversation-cleanup", Cron: "0 2 * * *", Queue: domain.JobQueueDefault, JobType: domain.JobTypeConversationCleanup},
+							NextRunAt:   nextRunAt,
+						},
+					},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.JobQueueStateResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, viewmodel.JobQueueStateResponse{
+					Queues: []viewmodel.JobQueueStatsResponse{
+						{Queue: domain.JobQueueAI, Concurrency: 2, Pending: 3, Scheduled: 1, Running: 2, Dead: 1},
+					},
+					Schedules: []viewmodel.JobScheduleResponse{
+						{Name: "ai-conversation-cleanup", Cron: "0 2 * * *", Queue: domain.JobQueueDefault, JobType: domain.JobTypeConversationCleanup, NextRunAt: nextRunAt},
+					},
+				}, response)
+			},
+		},
+		{
+			name: "Should return unauthorized when the admin token is invalid",
+			buildMocks: func(m test.AppMocks) {
+				m.JobAppMock.EXPECT().ValidateAdminToken(gomock.Any(), adminToken).
+					Return(resterrors.NewUnauthorizedError("invalid admin token")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Should return error when the service fails",
+			buildMocks: func(m test.AppMocks) {
+				m.JobAppMock.EXPECT().ValidateAdminToken(gomock.Any(), adminToken).Return(nil).Times(1)
+				m.JobAppMock.EXPECT().GetJobQueueState(gomock.Any()).Return(entity.JobQueueState{}, fmt.Errorf("error to get stats")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			req, err := http.NewRequest(http.MethodGet, "/admin/jobs", nil)
+			require.NoError(t, err)
+			req.Header.Set(infra.AdminTokenHeader, adminToken)
+
+			tt.buildMocks(m)
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestHandler_handleGetDeadJobs(t *testing.T) {
+	lastError := "provider unavailable"
+	failedAt := time.Date(2025, time.March, 3, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		buildMocks    func(m test.AppMocks)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Should return the dead jobs of the queue",
+			buildMocks: func(m test.AppMocks) {
+				m.JobAppMock.EXPECT().GetDeadJobs(gomock.Any(), domain.JobQueueAI).Return([]entity.Job{
+					{
+						UUID:      "job-uuid-1",
+						Queue:     domain.JobQueueAI,
+						Type:      domain.JobTypeNoteAttributesExtraction,
+						Payload:   `{"note_id":1}`,
+						Status:    domain.JobStatusDead,
+						Attempts:  5,
+						LastError: &lastError,
+						CreatedAt: failedAt.Add(-time.Hour),
+						FailedAt:  &failedAt,
+					},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response []viewmodel.DeadJobResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response, 1)
+				require.Equal(t, "job-uuid-1", response[0].UUID)
+				require.Equal(t, lastError, response[0].LastError)
+				require.Equal(t, 5, response[0].Attempts)
+			},
+		},
+		{
+			name: "Should return not found when the queue doesn't exist",
+			buildMocks: func(m test.AppMocks) {
+				m.JobAppMock.EXPECT().GetDeadJobs(gomock.Any(), domain.JobQueueAI).
+					Return(nil, resterrors.NewNotFoundError("queue not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			req, err := http.NewRequest(http.MethodGet, "/admin/jobs/queues/"+domain.JobQueueAI+"/dead", nil)
+			require.NoError(t, err)
+			req.Header.Set(infra.AdminTokenHeader, adminToken)
+
+			m.JobAppMock.EXPECT().ValidateAdminToken(gomock.Any(), adminToken).Return(nil).Times(1)
+			tt.buildMocks(m)
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestHandler_handleRequeueDeadJob(t *testing.T) {
+	tests := []struct {
+		name          string
+		buildMocks    func(m test.AppMocks)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Should requeue the dead job",
+			buildMocks: func(m test.AppMocks) {
+				m.JobAppMock.EXPECT().RequeueDeadJob(gomock.Any(), "job-uuid-1").Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Should return not found when the job is not dead",
+			buildMocks: func(m test.AppMocks) {
+				m.JobAppMock.EXPECT().RequeueDeadJob(gomock.Any(), "job-uuid-1").
+					Return(resterrors.NewNotFoundError("dead job not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adminroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			req, err := http.NewRequest(http.MethodPost, "/admin/jobs/job-uuid-1/requeue", nil)
+			require.NoError(t, err)
+			req.Header.Set(infra.AdminTokenHeader, adminToken)
+
+			m.JobAppMock.EXPECT().ValidateAdminToken(gomock.Any(), adminToken).Return(nil).Times(1)
+			tt.buildMocks(m)
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
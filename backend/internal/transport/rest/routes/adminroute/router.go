@@ -0,0 +1,63 @@
+package adminroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const GroupRouteName = "admin"
+
+const (
+	JobsRoute       = "/jobs"
+	DeadJobsRoute   = "/jobs/queues/:queue/dead"
+	RequeueJobRoute = "/jobs/:job_uuid/requeue"
+)
+
+type AdminRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *AdminRouter {
+	return &AdminRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *AdminRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.AdminGroup.Group(GroupRouteName)
+
+	router.GET(JobsRoute, r.ctrl.handleGetJobQueueState).
+		Summary("Get job queue state").
+		Description("Get the number of pending, scheduled, running and dead jobs of each queue and the next run of the cron schedules").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.JobQueueStateResponse{},
+			},
+		}).
+		HeaderParam(infra.AdminTokenHeader, infra.AdminTokenHeaderDescription, goswag.StringType, true)
+
+	router.GET(DeadJobsRoute, r.ctrl.handleGetDeadJobs).
+		Summary("Get dead jobs").
+		Description("Get the most recent jobs of the queue that failed all their attempts").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.DeadJobResponse{},
+			},
+		}).
+		PathParam("queue", "queue name", goswag.StringType, true).
+		HeaderParam(infra.AdminTokenHeader, infra.AdminTokenHeaderDescription, goswag.StringType, true)
+
+	router.POST(RequeueJobRoute, r.ctrl.handleRequeueDeadJob).
+		Summary("Requeue dead job").
+		Description("Move a dead job back to its queue to run now, with its attempts reset").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("job_uuid", "job uuid", goswag.StringType, true).
+		HeaderParam(infra.AdminTokenHeader, infra.AdminTokenHeaderDescription, goswag.StringType, true)
+}
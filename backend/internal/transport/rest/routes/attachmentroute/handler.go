@@ -0,0 +1,150 @@
+package attachmentroute
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	attachmentService contract.NoteAttachmentApp
+}
+
+func NewHandler(attachmentService contract.NoteAttachmentApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			attachmentService: attachmentService,
+		}
+	})
+
+	return instance
+}
+
+func (s *Handler) handleUploadAttachment(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	noteUUID, err := routeutils.GetRequiredStringPathParam(c, "note_uuid", "Invalid note_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return routeutils.HandleError(c, resterrors.NewBadRequestError("file is required"))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return routeutils.HandleError(c, resterrors.NewBadRequestError("invalid file"))
+	}
+	defer file.Close()
+
+	attachment, err := s.attachmentService.UploadAttachment(ctx, noteUUID, entity.NoteAttachmentUpload{
+		FileName: fileHeader.Filename,
+		Size:     fileHeader.Size,
+		Content:  file,
+	})
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.NoteAttachmentResponse{}
+	response.FillFromEntity(attachment)
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleGetAttachments(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	noteUUID, err := routeutils.GetRequiredStringPathParam(c, "note_uuid", "Invalid note_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	attachments, err := s.attachmentService.GetNoteAttachments(ctx, noteUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.NoteAttachmentResponse, len(attachments))
+	for i, attachment := range attachments {
+		response[i].FillFromEntity(attachment)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleCreateDownloadURL(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	noteUUID, err := routeutils.GetRequiredStringPathParam(c, "note_uuid", "Invalid note_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	attachmentUUID, err := routeutils.GetRequiredStringPathParam(c, "attachment_uuid", "Invalid attachment_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	downloadURL, err := s.attachmentService.CreateDownloadURL(ctx, noteUUID, attachmentUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.NoteAttachmentDownloadURLResponse{
+		URL:       c.Scheme() + "://" + c.Request().Host + "/" + DownloadGroupRouteName + "/download/" + downloadURL.Token,
+		ExpiresAt: downloadURL.ExpiresAt,
+	}
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleDownloadAttachment(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	download, err := s.attachmentService.DownloadAttachment(ctx, c.Param("token"))
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+	defer download.Content.Close()
+
+	attachment := download.Attachment
+	inline := strings.HasPrefix(attachment.ContentType, "image/") || attachment.ContentType == "application/pdf"
+
+	return routeutils.ResponseStream(c, attachment.FileName, attachment.ContentType, attachment.SizeBytes, inline, download.Content)
+}
+
+func (s *Handler) handleDeleteAttachment(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	noteUUID, err := routeutils.GetRequiredStringPathParam(c, "note_uuid", "Invalid note_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	attachmentUUID, err := routeutils.GetRequiredStringPathParam(c, "attachment_uuid", "Invalid attachment_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.attachmentService.DeleteAttachment(ctx, noteUUID, attachmentUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
@@ -0,0 +1,279 @@
+package attachmentroute_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/attachmentroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	companyUUID    = "company-uuid-123"
+	noteUUID       = "note-uuid-123"
+	attachmentUUID = "attachment-uuid-123"
+)
+
+type attachmentTest struct {
+	name          string
+	url           string
+	body          io.Reader
+	contentType   string
+	buildMocks    func(m test.AppMocks)
+	checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+}
+
+func runAttachmentTests(t *testing.T, method string, tests []attachmentTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachmentroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			body := tt.body
+			if body == nil {
+				body = http.NoBody
+			}
+
+			req, err := http.NewRequest(method, "/companies/"+companyUUID+"/notes/"+noteUUID+"/attachments"+tt.url, body)
+			require.NoError(t, err)
+			if tt.contentType != "" {
+				req.Header.Set(echo.HeaderContentType, tt.contentType)
+			}
+
+			test.AddAuthorization(context.Background(), t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(m)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func multipartFile(t *testing.T, fileName, content string) (io.Reader, string) {
+	t.Helper()
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", fileName)
+	require.NoError(t, err)
+	_, err = part.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	return body, writer.FormDataContentType()
+}
+
+func TestHandler_handleUploadAttachment(t *testing.T) {
+	body, contentType := multipartFile(t, "review.png", "\x89PNG\r\n\x1a\nimage")
+	badBody, badContentType := multipartFile(t, "diagram.svg", "<svg></svg>")
+
+	runAttachmentTests(t, http.MethodPost, []attachmentTest{
+		{
+			name:        "Should upload the file",
+			body:        body,
+			contentType: contentType,
+			buildMocks: func(m test.AppMocks) {
+				m.AttachmentAppMock.EXPECT().UploadAttachment(gomock.Any(), noteUUID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, upload entity.NoteAttachmentUpload) (entity.NoteAttachment, error) {
+						require.Equal(t, "review.png", upload.FileName)
+						require.Equal(t, int64(13), upload.Size)
+
+						data, err := io.ReadAll(upload.Content)
+						require.NoError(t, err)
+						require.Equal(t, "\x89PNG\r\n\x1a\nimage", string(data))
+
+						return entity.NoteAttachment{UUID: attachmentUUID, FileName: upload.FileName, ContentType: "image/png", SizeBytes: upload.Size, UserName: "Maria"}, nil
+					}).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.NoteAttachmentResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, attachmentUUID, response.UUID)
+				require.Equal(t, "image/png", response.ContentType)
+				require.Equal(t, "Maria", response.UploadedBy)
+			},
+		},
+		{
+			name:        "Should return bad request when the file type is not allowed",
+			body:        badBody,
+			contentType: badContentType,
+			buildMocks: func(m test.AppMocks) {
+				m.AttachmentAppMock.EXPECT().UploadAttachment(gomock.Any(), noteUUID, gomock.Any()).
+					Return(entity.NoteAttachment{}, resterrors.NewBadRequestError("file type not allowed")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:        "Should return bad request when there is no file",
+			body:        strings.NewReader(`{}`),
+			contentType: echo.MIMEApplicationJSON,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	})
+}
+
+func TestHandler_handleGetAttachments(t *testing.T) {
+	runAttachmentTests(t, http.MethodGet, []attachmentTest{
+		{
+			name: "Should return the attachments of the note",
+			buildMocks: func(m test.AppMocks) {
+				m.AttachmentAppMock.EXPECT().GetNoteAttachments(gomock.Any(), noteUUID).Return([]entity.NoteAttachment{
+					{UUID: attachmentUUID, FileName: "review.png", ContentType: "image/png", SizeBytes: 2048},
+					{UUID: "attachment-uuid-2", FileName: "plan.pdf", ContentType: "application/pdf", SizeBytes: 4096},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response []viewmodel.NoteAttachmentResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response, 2)
+				require.Equal(t, int64(4096), response[1].SizeBytes)
+			},
+		},
+	})
+}
+
+func TestHandler_handleCreateDownloadURL(t *testing.T) {
+	expiresAt := time.Date(2025, time.March, 4, 10, 10, 0, 0, time.UTC)
+
+	runAttachmentTests(t, http.MethodPost, []attachmentTest{
+		{
+			name: "Should return the download URL",
+			url:  "/" + attachmentUUID + "/download-url",
+			buildMocks: func(m test.AppMocks) {
+				m.AttachmentAppMock.EXPECT().CreateDownloadURL(gomock.Any(), noteUUID, attachmentUUID).
+					Return(entity.NoteAttachmentDownloadURL{Token: "secret-token", ExpiresAt: expiresAt}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.NoteAttachmentDownloadURLResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.True(t, strings.HasSuffix(response.URL, "/attachments/download/secret-token"), response.URL)
+				require.True(t, expiresAt.Equal(response.ExpiresAt))
+			},
+		},
+		{
+			name: "Should return not found when the attachment does not exist",
+			url:  "/" + attachmentUUID + "/download-url",
+			buildMocks: func(m test.AppMocks) {
+				m.AttachmentAppMock.EXPECT().CreateDownloadURL(gomock.Any(), noteUUID, attachmentUUID).
+					Return(entity.NoteAttachmentDownloadURL{}, resterrors.NewNotFoundError("attachment not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	})
+}
+
+func TestHandler_handleDeleteAttachment(t *testing.T) {
+	runAttachmentTests(t, http.MethodDelete, []attachmentTest{
+		{
+			name: "Should delete the attachment",
+			url:  "/" + attachmentUUID,
+			buildMocks: func(m test.AppMocks) {
+				m.AttachmentAppMock.EXPECT().DeleteAttachment(gomock.Any(), noteUUID, attachmentUUID).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+	})
+}
+
+func TestHandler_handleDownloadAttachment(t *testing.T) {
+	tests := []struct {
+		name          string
+		buildMocks    func(m test.AppMocks)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Should show an image in the browser",
+			buildMocks: func(m test.AppMocks) {
+				m.AttachmentAppMock.EXPECT().DownloadAttachment(gomock.Any(), "secret-token").Return(entity.NoteAttachmentDownload{
+					Attachment: entity.NoteAttachment{FileName: "review.png", ContentType: "image/png", SizeBytes: 5},
+					Content:    io.NopCloser(strings.NewReader("image")),
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "image/png", recorder.Header().Get(echo.HeaderContentType))
+				require.Equal(t, `inline; filename=review.png`, recorder.Header().Get(echo.HeaderContentDisposition))
+				require.Equal(t, "nosniff", recorder.Header().Get(echo.HeaderXContentTypeOptions))
+				require.Equal(t, "image", recorder.Body.String())
+			},
+		},
+		{
+			name: "Should download the other files",
+			buildMocks: func(m test.AppMocks) {
+				m.AttachmentAppMock.EXPECT().DownloadAttachment(gomock.Any(), "secret-token").Return(entity.NoteAttachmentDownload{
+					Attachment: entity.NoteAttachment{FileName: "1:1 notes.csv", ContentType: "text/csv; charset=utf-8", SizeBytes: 9},
+					Content:    io.NopCloser(strings.NewReader("name,role")),
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, `attachment; filename="1:1 notes.csv"`, recorder.Header().Get(echo.HeaderContentDisposition))
+			},
+		},
+		{
+			name: "Should return not found when the token expired",
+			buildMocks: func(m test.AppMocks) {
+				m.AttachmentAppMock.EXPECT().DownloadAttachment(gomock.Any(), "secret-token").
+					Return(entity.NoteAttachmentDownload{}, resterrors.NewNotFoundError("download link is invalid or expired")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attachmentroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			// the token of the URL is the authorization
+			req, err := http.NewRequest(http.MethodGet, "/attachments/download/secret-token", nil)
+			require.NoError(t, err)
+
+			tt.buildMocks(m)
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
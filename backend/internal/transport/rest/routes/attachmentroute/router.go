@@ -0,0 +1,93 @@
+package attachmentroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const (
+	GroupRouteName         = "companies/:company_uuid/notes/:note_uuid/attachments"
+	DownloadGroupRouteName = "attachments"
+)
+
+const (
+	RootRoute             = ""
+	AttachmentByUUIDRoute = "/:attachment_uuid"
+	DownloadURLRoute      = "/:attachment_uuid/download-url"
+	DownloadRoute         = "/download/:token"
+)
+
+type AttachmentRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *AttachmentRouter {
+	return &AttachmentRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *AttachmentRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.CompanyGroup.Group(GroupRouteName)
+	downloadRouter := g.AppGroup.Group(DownloadGroupRouteName)
+
+	router.POST(RootRoute, r.ctrl.handleUploadAttachment).
+		Summary("Upload note attachment").
+		Description("Attach a file to the note (multipart field \"file\"). Images, PDF, text, CSV and Office documents up to 10MB are allowed, with up to 10 files per note. The content must match the extension of the file").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.NoteAttachmentResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("note_uuid", "note uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(RootRoute, r.ctrl.handleGetAttachments).
+		Summary("Get note attachments").
+		Description("Get the files attached to the note, oldest first").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.NoteAttachmentResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("note_uuid", "note uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(DownloadURLRoute, r.ctrl.handleCreateDownloadURL).
+		Summary("Create attachment download URL").
+		Description("Create a URL to download the attachment without the authorization header, so it can be opened by the browser or used in an img tag. The URL expires in 10 minutes").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.NoteAttachmentDownloadURLResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("note_uuid", "note uuid", goswag.StringType, true).
+		PathParam("attachment_uuid", "attachment uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(AttachmentByUUIDRoute, r.ctrl.handleDeleteAttachment).
+		Summary("Delete note attachment").
+		Description("Remove the file from the note and from the storage").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("note_uuid", "note uuid", goswag.StringType, true).
+		PathParam("attachment_uuid", "attachment uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	downloadRouter.GET(DownloadRoute, r.ctrl.handleDownloadAttachment).
+		Summary("Download note attachment").
+		Description("Download the content of the attachment, authenticated by the token of the download URL. Images and PDF files are shown by the browser, the other files are downloaded").
+		Returns([]models.ReturnType{{StatusCode: http.StatusOK}}).
+		PathParam("token", "download token", goswag.StringType, true)
+}
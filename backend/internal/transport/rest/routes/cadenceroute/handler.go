@@ -0,0 +1,79 @@
+package cadenceroute
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	cadenceService contract.CadenceApp
+}
+
+func NewHandler(cadenceService contract.CadenceApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			cadenceService: cadenceService,
+		}
+	})
+
+	return instance
+}
+
+func (s *Handler) handleUpdatePersonCadence(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.CadenceRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	err = s.cadenceService.UpdatePersonCadence(ctx, personUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleGetOneOnOnesDue(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	days := 0
+	if rawDays := c.QueryParam("days"); rawDays != "" {
+		var err error
+		days, err = strconv.Atoi(rawDays)
+		if err != nil || days < 1 {
+			return routeutils.HandleError(c, resterrors.NewBadRequestError("days must be a positive number"))
+		}
+	}
+
+	due, err := s.cadenceService.GetOneOnOnesDue(ctx, days)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.OneOnOneDueResponse, len(due))
+	for i, item := range due {
+		response[i].FillFromEntity(item)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
@@ -0,0 +1,157 @@
+package cadenceroute_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/cadenceroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	companyUUID = "company-uuid-123"
+	personUUID  = "person-uuid-123"
+)
+
+type cadenceTest struct {
+	name          string
+	url           string
+	body          any
+	buildMocks    func(m test.AppMocks)
+	checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+}
+
+func runCadenceTests(t *testing.T, method string, tests []cadenceTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cadenceroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			var body []byte
+			if tt.body != nil {
+				var err error
+				body, err = json.Marshal(tt.body)
+				require.NoError(t, err)
+			}
+
+			req, err := http.NewRequest(method, tt.url, bytes.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			test.AddAuthorization(context.Background(), t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(m)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestHandler_handleUpdatePersonCadence(t *testing.T) {
+	url := "/companies/" + companyUUID + "/people/" + personUUID + "/cadence"
+	days := 21
+
+	runCadenceTests(t, http.MethodPut, []cadenceTest{
+		{
+			name: "Should update the cadence",
+			url:  url,
+			body: viewmodel.CadenceRequest{Cadence: domain.OneOnOneCadenceCustom, Days: &days},
+			buildMocks: func(m test.AppMocks) {
+				m.CadenceAppMock.EXPECT().UpdatePersonCadence(gomock.Any(), personUUID, entity.OneOnOneCadence{
+					Cadence: domain.OneOnOneCadenceCustom,
+					Days:    &days,
+				}).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Should return error when the cadence is invalid",
+			url:  url,
+			body: viewmodel.CadenceRequest{Cadence: "daily"},
+			buildMocks: func(m test.AppMocks) {
+				m.CadenceAppMock.EXPECT().UpdatePersonCadence(gomock.Any(), personUUID, entity.OneOnOneCadence{Cadence: "daily"}).
+					Return(resterrors.NewBadRequestError("cadence must be weekly, biweekly, monthly or custom")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	})
+}
+
+func TestHandler_handleGetOneOnOnesDue(t *testing.T) {
+	url := "/companies/" + companyUUID + "/one-on-ones/due"
+	lastOneOnOne := time.Date(2025, time.February, 17, 10, 0, 0, 0, time.UTC)
+
+	runCadenceTests(t, http.MethodGet, []cadenceTest{
+		{
+			name: "Should return the 1:1s due",
+			url:  url + "?days=14",
+			buildMocks: func(m test.AppMocks) {
+				m.CadenceAppMock.EXPECT().GetOneOnOnesDue(gomock.Any(), 14).Return([]entity.OneOnOneDue{
+					{
+						PersonUUID:       personUUID,
+						PersonName:       "Carlos",
+						Cadence:          domain.OneOnOneCadenceWeekly,
+						LastOneOnOneDate: &lastOneOnOne,
+						DueDate:          time.Date(2025, time.February, 24, 0, 0, 0, 0, time.UTC),
+						DaysUntil:        -14,
+						Overdue:          true,
+					},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response []viewmodel.OneOnOneDueResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response, 1)
+				require.Equal(t, "Carlos", response[0].PersonName)
+				require.Equal(t, "2025-02-24", response[0].DueDate)
+				require.Equal(t, -14, response[0].DaysUntil)
+				require.True(t, response[0].Overdue)
+			},
+		},
+		{
+			name: "Should use the default window when days is not sent",
+			url:  url,
+			buildMocks: func(m test.AppMocks) {
+				m.CadenceAppMock.EXPECT().GetOneOnOnesDue(gomock.Any(), 0).Return([]entity.OneOnOneDue{}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.JSONEq(t, "[]", recorder.Body.String())
+			},
+		},
+		{
+			name: "Should return bad request when days is invalid",
+			url:  url + "?days=0",
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package cadenceroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const GroupRouteName = "companies/:company_uuid"
+
+const (
+	PersonCadenceRoute = "/people/:person_uuid/cadence"
+	OneOnOnesDueRoute  = "/one-on-ones/due"
+)
+
+type CadenceRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *CadenceRouter {
+	return &CadenceRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *CadenceRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.CompanyGroup.Group(GroupRouteName)
+
+	router.PUT(PersonCadenceRoute, r.ctrl.handleUpdatePersonCadence).
+		Summary("Update person 1:1 cadence").
+		Description("Set how often the manager wants a 1:1 with the person: weekly, biweekly, monthly or custom (every \"days\" days). An empty cadence removes it").
+		Read(viewmodel.CadenceRequest{}).
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(OneOnOnesDueRoute, r.ctrl.handleGetOneOnOnesDue).
+		Summary("Get 1:1s due").
+		Description("Get the overdue 1:1s and the ones due in the next days, by the cadence of each person, computed in the user timezone. The most overdue come first").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.OneOnOneDueResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		QueryParam("days", "upcoming window in days (default 7, max 90)", goswag.IntType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+}
@@ -0,0 +1,121 @@
+package calendarroute
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+const (
+	maxImportFileSize = 5 << 20 // 5MB
+	feedFileExtension = ".ics"
+	feedContentType   = "text/calendar; charset=utf-8"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	calendarService contract.CalendarApp
+}
+
+func NewHandler(calendarService contract.CalendarApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			calendarService: calendarService,
+		}
+	})
+
+	return instance
+}
+
+func (s *Handler) handleGenerateFeedToken(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	token, err := s.calendarService.GenerateFeedToken(ctx)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.CalendarFeedResponse{
+		Token: token,
+		URL:   c.Scheme() + "://" + c.Request().Host + "/" + GroupRouteName + "/feed/" + token + feedFileExtension,
+	}
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleRevokeFeedToken(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	err := s.calendarService.RevokeFeedToken(ctx)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleGetFeed(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	token := strings.TrimSuffix(c.Param("token"), feedFileExtension)
+
+	feed, err := s.calendarService.GetFeed(ctx, token)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseFile(c, "leaderpro"+feedFileExtension, feedContentType, feed)
+}
+
+func (s *Handler) handleImportMeetings(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return routeutils.HandleError(c, resterrors.NewBadRequestError("file is required"))
+	}
+
+	if fileHeader.Size > maxImportFileSize {
+		return routeutils.HandleError(c, resterrors.NewBadRequestError("file is too large, the limit is 5MB"))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return routeutils.HandleError(c, resterrors.NewBadRequestError("invalid file"))
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxImportFileSize))
+	if err != nil {
+		return routeutils.HandleError(c, resterrors.NewBadRequestError("invalid file"))
+	}
+
+	input := entity.CalendarImportInput{
+		FileName: fileHeader.Filename,
+		Data:     data,
+	}
+	input.DryRun, _ = strconv.ParseBool(c.FormValue("dry_run"))
+
+	result, err := s.calendarService.ImportMeetings(ctx, input)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.CalendarImportResponse{}
+	response.FillFromEntity(result)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
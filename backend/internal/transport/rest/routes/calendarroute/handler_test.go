@@ -0,0 +1,192 @@
+package calendarroute_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/calendarroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const companyUUID = "company-uuid-123"
+
+const icsFile = "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:1on1@google.com\r\nDTSTART:20250310T140000Z\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+
+func TestHandler_handleGenerateFeedToken(t *testing.T) {
+	t.Run("Should return the secret feed URL", func(t *testing.T) {
+		calendarroute.Once = sync.Once{}
+		m, server, ctrl := test.GetServerTest(t)
+		defer ctrl.Finish()
+
+		req, err := http.NewRequest(http.MethodPost, "/calendar/feed", nil)
+		require.NoError(t, err)
+		req.Host = "api.leaderpro.com"
+
+		test.AddAuthorization(context.Background(), t, req, m)
+		m.CalendarAppMock.EXPECT().GenerateFeedToken(gomock.Any()).Return("feed-token", nil).Times(1)
+
+		recorder := httptest.NewRecorder()
+		server.Echo().ServeHTTP(recorder, req)
+
+		require.Equal(t, http.StatusCreated, recorder.Code)
+
+		var response viewmodel.CalendarFeedResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		require.Equal(t, "feed-token", response.Token)
+		require.Equal(t, "http://api.leaderpro.com/calendar/feed/feed-token.ics", response.URL)
+	})
+}
+
+func TestHandler_handleRevokeFeedToken(t *testing.T) {
+	t.Run("Should revoke the feed URL", func(t *testing.T) {
+		calendarroute.Once = sync.Once{}
+		m, server, ctrl := test.GetServerTest(t)
+		defer ctrl.Finish()
+
+		req, err := http.NewRequest(http.MethodDelete, "/calendar/feed", nil)
+		require.NoError(t, err)
+
+		test.AddAuthorization(context.Background(), t, req, m)
+		m.CalendarAppMock.EXPECT().RevokeFeedToken(gomock.Any()).Return(nil).Times(1)
+
+		recorder := httptest.NewRecorder()
+		server.Echo().ServeHTTP(recorder, req)
+
+		require.Equal(t, http.StatusNoContent, recorder.Code)
+	})
+}
+
+func TestHandler_handleGetFeed(t *testing.T) {
+	tests := []struct {
+		name          string
+		url           string
+		buildMocks    func(m test.AppMocks)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Should return the feed without a session",
+			url:  "/calendar/feed/feed-token.ics",
+			buildMocks: func(m test.AppMocks) {
+				m.CalendarAppMock.EXPECT().GetFeed(gomock.Any(), "feed-token").Return([]byte(icsFile), nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/calendar; charset=utf-8", recorder.Header().Get(echo.HeaderContentType))
+				require.Equal(t, icsFile, recorder.Body.String())
+			},
+		},
+		{
+			name: "Should return not found for an unknown token",
+			url:  "/calendar/feed/unknown",
+			buildMocks: func(m test.AppMocks) {
+				m.CalendarAppMock.EXPECT().GetFeed(gomock.Any(), "unknown").Return(nil, resterrors.NewNotFoundError("calendar feed not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calendarroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			require.NoError(t, err)
+
+			tt.buildMocks(m)
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestHandler_handleImportMeetings(t *testing.T) {
+	url := "/companies/" + companyUUID + "/calendar/import"
+
+	newRequest := func(t *testing.T, withFile bool) *http.Request {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		if withFile {
+			part, err := writer.CreateFormFile("file", "calendar.ics")
+			require.NoError(t, err)
+			_, err = part.Write([]byte(icsFile))
+			require.NoError(t, err)
+		}
+		require.NoError(t, writer.WriteField("dry_run", "true"))
+		require.NoError(t, writer.Close())
+
+		req, err := http.NewRequest(http.MethodPost, url, body)
+		require.NoError(t, err)
+		req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+		return req
+	}
+
+	t.Run("Should import the calendar file", func(t *testing.T) {
+		calendarroute.Once = sync.Once{}
+		m, server, ctrl := test.GetServerTest(t)
+		defer ctrl.Finish()
+
+		req := newRequest(t, true)
+		test.AddAuthorization(context.Background(), t, req, m)
+		m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+
+		start := time.Date(2025, time.March, 10, 14, 0, 0, 0, time.UTC)
+		m.CalendarAppMock.EXPECT().ImportMeetings(gomock.Any(), entity.CalendarImportInput{
+			FileName: "calendar.ics",
+			Data:     []byte(icsFile),
+			DryRun:   true,
+		}).Return(entity.CalendarImportResult{
+			DryRun:  true,
+			Created: 1,
+			Events: []entity.CalendarImportEvent{
+				{UID: "1on1@google.com", Start: &start, Status: domain.CalendarImportStatusCreated, PersonUUID: "person-uuid", PersonName: "Ana"},
+			},
+		}, nil).Times(1)
+
+		recorder := httptest.NewRecorder()
+		server.Echo().ServeHTTP(recorder, req)
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		var response viewmodel.CalendarImportResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		require.True(t, response.DryRun)
+		require.Equal(t, 1, response.Created)
+		require.Len(t, response.Events, 1)
+		require.Equal(t, "Ana", response.Events[0].PersonName)
+	})
+
+	t.Run("Should return bad request without the file", func(t *testing.T) {
+		calendarroute.Once = sync.Once{}
+		m, server, ctrl := test.GetServerTest(t)
+		defer ctrl.Finish()
+
+		req := newRequest(t, false)
+		test.AddAuthorization(context.Background(), t, req, m)
+		m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+
+		recorder := httptest.NewRecorder()
+		server.Echo().ServeHTTP(recorder, req)
+
+		require.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
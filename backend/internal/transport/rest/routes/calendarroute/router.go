@@ -0,0 +1,74 @@
+package calendarroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const (
+	GroupRouteName       = "calendar"
+	ImportGroupRouteName = "companies/:company_uuid/calendar"
+)
+
+const (
+	FeedTokenRoute = "/feed"
+	FeedRoute      = "/feed/:token"
+	ImportRoute    = "/import"
+)
+
+type CalendarRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *CalendarRouter {
+	return &CalendarRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *CalendarRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.AppGroup.Group(GroupRouteName)
+	privateRouter := g.PrivateGroup.Group(GroupRouteName)
+	companyRouter := g.CompanyGroup.Group(ImportGroupRouteName)
+
+	privateRouter.POST(FeedTokenRoute, r.ctrl.handleGenerateFeedToken).
+		Summary("Generate calendar feed URL").
+		Description("Generate the secret ICS feed URL of the logged user, to subscribe in calendar apps. The feed has the scheduled 1:1s, birthdays, work anniversaries, overdue follow-ups and 1:1s due of all the user companies. The previous URL stops working and the new one is only shown once").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.CalendarFeedResponse{},
+			},
+		}).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	privateRouter.DELETE(FeedTokenRoute, r.ctrl.handleRevokeFeedToken).
+		Summary("Revoke calendar feed URL").
+		Description("Revoke the secret ICS feed URL of the logged user").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(FeedRoute, r.ctrl.handleGetFeed).
+		Summary("Get calendar feed").
+		Description("ICS feed called by calendar apps, authenticated by the secret token in the URL. The token can end with .ics").
+		Returns([]models.ReturnType{{StatusCode: http.StatusOK}}).
+		PathParam("token", "secret feed token", goswag.StringType, true)
+
+	companyRouter.POST(ImportRoute, r.ctrl.handleImportMeetings).
+		Summary("Import 1:1s from calendar").
+		Description("Import the 1:1s from an ICS file (multipart field \"file\"). Each event with exactly one person of the company among the attendees, matched by email, creates a scheduled meeting for its next occurrence, or links the scheduled meeting of that day. Importing the file again updates the meetings by the event UID, and canceled events cancel them. With dry_run=true nothing is changed").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.CalendarImportResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		QueryParam("dry_run", "only report what would be done, without changing meetings", goswag.BoolType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+}
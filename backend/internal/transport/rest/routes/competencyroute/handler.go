@@ -0,0 +1,259 @@
+package competencyroute
+
+import (
+	"sync"
+
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	competencyService contract.CompetencyApp
+}
+
+func NewHandler(competencyService contract.CompetencyApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			competencyService: competencyService,
+		}
+	})
+
+	return instance
+}
+
+func (s *Handler) handleCreateFramework(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	input := viewmodel.CompetencyFrameworkRequest{}
+	err := c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	framework, err := s.competencyService.CreateCompetencyFramework(ctx, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.CompetencyFrameworkResponse{}
+	response.FillFromEntity(framework)
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleGetFrameworks(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	frameworks, err := s.competencyService.GetCompetencyFrameworks(ctx)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.CompetencyFrameworkResponse, len(frameworks))
+	for i, framework := range frameworks {
+		response[i].FillFromEntity(framework)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetFramework(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	frameworkUUID, err := routeutils.GetRequiredStringPathParam(c, "framework_uuid", "Invalid framework_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	framework, err := s.competencyService.GetCompetencyFramework(ctx, frameworkUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.CompetencyFrameworkResponse{}
+	response.FillFromEntity(framework)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleDeleteFramework(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	frameworkUUID, err := routeutils.GetRequiredStringPathParam(c, "framework_uuid", "Invalid framework_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.competencyService.DeleteCompetencyFramework(ctx, frameworkUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleCreateAssessment(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.CompetencyAssessmentRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	assessment, err := s.competencyService.CreateCompetencyAssessment(ctx, personUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.CompetencyAssessmentResponse{}
+	response.FillFromEntity(assessment)
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleGetPersonAssessments(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	assessments, err := s.competencyService.GetPersonCompetencyAssessments(ctx, personUUID, c.QueryParam("framework_uuid"))
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.CompetencyAssessmentResponse, len(assessments))
+	for i, assessment := range assessments {
+		response[i].FillFromEntity(assessment)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetAssessment(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	assessmentUUID, err := routeutils.GetRequiredStringPathParam(c, "assessment_uuid", "Invalid assessment_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	assessment, err := s.competencyService.GetCompetencyAssessment(ctx, assessmentUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.CompetencyAssessmentResponse{}
+	response.FillFromEntity(assessment)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleDeleteAssessment(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	assessmentUUID, err := routeutils.GetRequiredStringPathParam(c, "assessment_uuid", "Invalid assessment_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.competencyService.DeleteCompetencyAssessment(ctx, assessmentUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleGetPersonGaps(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	frameworkUUID, err := routeutils.GetRequiredStringQueryParam(c, "framework_uuid", "Invalid framework_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	analysis, err := s.competencyService.GetPersonCompetencyGaps(ctx, personUUID, frameworkUUID, c.QueryParam("target_level_uuid"))
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.CompetencyGapAnalysisResponse{}
+	response.FillFromEntity(analysis)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetCompetencyEvidence(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	competencyUUID, err := routeutils.GetRequiredStringPathParam(c, "competency_uuid", "Invalid competency_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	evidence, err := s.competencyService.GetCompetencyEvidence(ctx, personUUID, competencyUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.UnifiedTimelineResponse, len(evidence))
+	for i, entry := range evidence {
+		response[i].FillFromUnifiedTimelineEntry(entry)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleSetNoteCompetencies(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	noteUUID, err := routeutils.GetRequiredStringPathParam(c, "note_uuid", "Invalid note_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.NoteCompetenciesRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	competencies, err := s.competencyService.SetNoteCompetencies(ctx, noteUUID, input.CompetencyUUIDs)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.CompetencyResponse, len(competencies))
+	for i, competency := range competencies {
+		response[i].FillFromEntity(competency)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
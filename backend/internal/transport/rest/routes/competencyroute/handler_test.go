@@ -0,0 +1,344 @@
+package competencyroute_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/competencyroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	companyUUID    = "company-uuid-123"
+	personUUID     = "person-uuid-123"
+	frameworkUUID  = "framework-uuid-123"
+	assessmentUUID = "assessment-uuid-123"
+	competencyUUID = "competency-uuid-123"
+	noteUUID       = "note-uuid-123"
+)
+
+type competencyTest struct {
+	name          string
+	body          any
+	buildMocks    func(m test.AppMocks)
+	checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+}
+
+func runCompetencyTests(t *testing.T, method, url string, tests []competencyTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			competencyroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			var body []byte
+			if tt.body != nil {
+				var err error
+				body, err = json.Marshal(tt.body)
+				require.NoError(t, err)
+			}
+
+			req, err := http.NewRequest(method, url, bytes.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			test.AddAuthorization(context.Background(), t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(m)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestHandler_handleCreateFramework(t *testing.T) {
+	tests := []competencyTest{
+		{
+			name: "Should create the framework with its matrix",
+			body: viewmodel.CompetencyFrameworkRequest{
+				Name:   "Engineering",
+				Levels: []viewmodel.CompetencyLevelRequest{{Name: "Engineer"}, {Name: "Senior Engineer"}},
+				Competencies: []viewmodel.CompetencyRequest{
+					{
+						Name:        "Code quality",
+						Descriptors: []viewmodel.CompetencyDescriptorRequest{{Level: 2, Description: "Sets the quality bar"}},
+					},
+				},
+			},
+			buildMocks: func(m test.AppMocks) {
+				m.CompetencyAppMock.EXPECT().CreateCompetencyFramework(gomock.Any(), entity.CompetencyFramework{
+					Name:   "Engineering",
+					Levels: []entity.CompetencyLevel{{Name: "Engineer"}, {Name: "Senior Engineer"}},
+					Competencies: []entity.Competency{
+						{
+							Name:        "Code quality",
+							Descriptors: []entity.CompetencyDescriptor{{LevelPosition: 2, Description: "Sets the quality bar"}},
+						},
+					},
+				}).Return(entity.CompetencyFramework{
+					UUID: frameworkUUID,
+					Name: "Engineering",
+					Levels: []entity.CompetencyLevel{
+						{UUID: "level-1", Name: "Engineer", Position: 1},
+						{UUID: "level-2", Name: "Senior Engineer", Position: 2},
+					},
+					Competencies: []entity.Competency{
+						{
+							UUID:        competencyUUID,
+							Name:        "Code quality",
+							Position:    1,
+							Descriptors: []entity.CompetencyDescriptor{{LevelUUID: "level-2", Description: "Sets the quality bar"}},
+						},
+					},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.CompetencyFrameworkResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, frameworkUUID, response.UUID)
+				require.Len(t, response.Levels, 2)
+				require.Len(t, response.Competencies, 1)
+				require.Equal(t, frameworkUUID, response.Competencies[0].FrameworkUUID)
+				require.Equal(t, "level-2", response.Competencies[0].Descriptors[0].LevelUUID)
+			},
+		},
+		{
+			name: "Should return error when the framework is invalid",
+			body: viewmodel.CompetencyFrameworkRequest{Name: "Engineering"},
+			buildMocks: func(m test.AppMocks) {
+				m.CompetencyAppMock.EXPECT().CreateCompetencyFramework(gomock.Any(), gomock.Any()).
+					Return(entity.CompetencyFramework{}, resterrors.NewBadRequestError("the framework must have from 1 to 15 levels")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	runCompetencyTests(t, http.MethodPost, "/companies/"+companyUUID+"/competency-frameworks", tests)
+}
+
+func TestHandler_handleDeleteFramework(t *testing.T) {
+	tests := []competencyTest{
+		{
+			name: "Should delete the framework",
+			buildMocks: func(m test.AppMocks) {
+				m.CompetencyAppMock.EXPECT().DeleteCompetencyFramework(gomock.Any(), frameworkUUID).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Should return error when the framework has assessments",
+			buildMocks: func(m test.AppMocks) {
+				m.CompetencyAppMock.EXPECT().DeleteCompetencyFramework(gomock.Any(), frameworkUUID).
+					Return(resterrors.NewBadRequestError("competency framework is used by assessments")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	runCompetencyTests(t, http.MethodDelete, "/companies/"+companyUUID+"/competency-frameworks/"+frameworkUUID, tests)
+}
+
+func TestHandler_handleCreateAssessment(t *testing.T) {
+	assessedAt := time.Date(2025, time.July, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []competencyTest{
+		{
+			name: "Should assess the person",
+			body: viewmodel.CompetencyAssessmentRequest{
+				FrameworkUUID:     frameworkUUID,
+				ExpectedLevelUUID: "level-2",
+				AssessedAt:        &assessedAt,
+				Ratings:           []viewmodel.CompetencyRatingRequest{{CompetencyUUID: competencyUUID, LevelUUID: "level-1"}},
+			},
+			buildMocks: func(m test.AppMocks) {
+				m.CompetencyAppMock.EXPECT().CreateCompetencyAssessment(gomock.Any(), personUUID, entity.CompetencyAssessment{
+					FrameworkUUID:     frameworkUUID,
+					ExpectedLevelUUID: "level-2",
+					AssessedAt:        assessedAt,
+					Ratings:           []entity.CompetencyRating{{CompetencyUUID: competencyUUID, LevelUUID: "level-1"}},
+				}).Return(entity.CompetencyAssessment{
+					UUID:              assessmentUUID,
+					PersonUUID:        personUUID,
+					FrameworkUUID:     frameworkUUID,
+					ExpectedLevelUUID: "level-2",
+					AssessedAt:        assessedAt,
+					Ratings:           []entity.CompetencyRating{{CompetencyUUID: competencyUUID, LevelUUID: "level-1", LevelPosition: 1}},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.CompetencyAssessmentResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, assessmentUUID, response.UUID)
+				require.Equal(t, "2025-07-10", response.AssessedAt)
+				require.Len(t, response.Ratings, 1)
+				require.Equal(t, 1, response.Ratings[0].LevelPosition)
+			},
+		},
+	}
+
+	runCompetencyTests(t, http.MethodPost, "/companies/"+companyUUID+"/people/"+personUUID+"/competency-assessments", tests)
+}
+
+func TestHandler_handleGetPersonAssessments(t *testing.T) {
+	tests := []competencyTest{
+		{
+			name: "Should return the assessments of the framework",
+			buildMocks: func(m test.AppMocks) {
+				m.CompetencyAppMock.EXPECT().GetPersonCompetencyAssessments(gomock.Any(), personUUID, frameworkUUID).
+					Return([]entity.CompetencyAssessment{{UUID: assessmentUUID}}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response []viewmodel.CompetencyAssessmentResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response, 1)
+			},
+		},
+	}
+
+	runCompetencyTests(t, http.MethodGet, "/companies/"+companyUUID+"/people/"+personUUID+"/competency-assessments?framework_uuid="+frameworkUUID, tests)
+}
+
+func TestHandler_handleGetPersonGaps(t *testing.T) {
+	gap := -1
+	lastEvidenceAt := time.Date(2025, time.July, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []competencyTest{
+		{
+			name: "Should return the gaps to the target level",
+			buildMocks: func(m test.AppMocks) {
+				m.CompetencyAppMock.EXPECT().GetPersonCompetencyGaps(gomock.Any(), personUUID, frameworkUUID, "level-3").
+					Return(entity.CompetencyGapAnalysis{
+						Framework:   entity.CompetencyFramework{UUID: frameworkUUID, Name: "Engineering"},
+						TargetLevel: entity.CompetencyLevel{UUID: "level-3", Name: "Staff Engineer", Position: 3},
+						Gaps: []entity.CompetencyGap{
+							{
+								Competency:     entity.Competency{UUID: competencyUUID, Name: "Code quality"},
+								AssessedLevel:  &entity.CompetencyLevel{UUID: "level-2", Name: "Senior Engineer", Position: 2},
+								Gap:            &gap,
+								Descriptor:     "Sets the quality bar of the organization",
+								EvidenceCount:  3,
+								LastEvidenceAt: &lastEvidenceAt,
+							},
+							{Competency: entity.Competency{UUID: "competency-2", Name: "Mentoring"}},
+						},
+					}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.CompetencyGapAnalysisResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, "level-3", response.TargetLevel.UUID)
+				require.Nil(t, response.Assessment)
+				require.Len(t, response.Gaps, 2)
+				require.Equal(t, -1, *response.Gaps[0].Gap)
+				require.Equal(t, "level-2", response.Gaps[0].AssessedLevel.UUID)
+				require.Equal(t, 3, response.Gaps[0].EvidenceCount)
+				require.Nil(t, response.Gaps[1].Gap)
+				require.Nil(t, response.Gaps[1].AssessedLevel)
+			},
+		},
+	}
+
+	runCompetencyTests(t, http.MethodGet, "/companies/"+companyUUID+"/people/"+personUUID+"/competency-gaps?framework_uuid="+frameworkUUID+"&target_level_uuid=level-3", tests)
+}
+
+func TestHandler_handleGetPersonGapsWithoutFramework(t *testing.T) {
+	tests := []competencyTest{
+		{
+			name: "Should return error when the framework is missing",
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+	}
+
+	runCompetencyTests(t, http.MethodGet, "/companies/"+companyUUID+"/people/"+personUUID+"/competency-gaps", tests)
+}
+
+func TestHandler_handleSetNoteCompetencies(t *testing.T) {
+	tests := []competencyTest{
+		{
+			name: "Should tag the note",
+			body: viewmodel.NoteCompetenciesRequest{CompetencyUUIDs: []string{competencyUUID}},
+			buildMocks: func(m test.AppMocks) {
+				m.CompetencyAppMock.EXPECT().SetNoteCompetencies(gomock.Any(), noteUUID, []string{competencyUUID}).
+					Return([]entity.Competency{{UUID: competencyUUID, FrameworkUUID: frameworkUUID, Name: "Code quality"}}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response []viewmodel.CompetencyResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response, 1)
+				require.Equal(t, frameworkUUID, response[0].FrameworkUUID)
+			},
+		},
+		{
+			name: "Should return error when the note is not a feedback",
+			body: viewmodel.NoteCompetenciesRequest{CompetencyUUIDs: []string{competencyUUID}},
+			buildMocks: func(m test.AppMocks) {
+				m.CompetencyAppMock.EXPECT().SetNoteCompetencies(gomock.Any(), noteUUID, gomock.Any()).
+					Return(nil, resterrors.NewBadRequestError("only feedback notes can be tagged with competencies")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	runCompetencyTests(t, http.MethodPut, "/companies/"+companyUUID+"/notes/"+noteUUID+"/competencies", tests)
+}
+
+func TestHandler_handleGetCompetencyEvidence(t *testing.T) {
+	tests := []competencyTest{
+		{
+			name: "Should return the tagged feedback notes",
+			buildMocks: func(m test.AppMocks) {
+				m.CompetencyAppMock.EXPECT().GetCompetencyEvidence(gomock.Any(), personUUID, competencyUUID).
+					Return([]entity.UnifiedTimelineEntry{{UUID: noteUUID, Type: "feedback", Content: "Great code reviews"}}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response []viewmodel.UnifiedTimelineResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response, 1)
+				require.Equal(t, noteUUID, response[0].UUID)
+			},
+		},
+	}
+
+	runCompetencyTests(t, http.MethodGet, "/companies/"+companyUUID+"/people/"+personUUID+"/competencies/"+competencyUUID+"/evidence", tests)
+}
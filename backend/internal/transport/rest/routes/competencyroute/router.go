@@ -0,0 +1,175 @@
+package competencyroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const GroupRouteName = "companies/:company_uuid"
+
+const (
+	FrameworksRoute               = "/competency-frameworks"
+	FrameworkByUUIDRoute          = "/competency-frameworks/:framework_uuid"
+	PersonAssessmentsRoute        = "/people/:person_uuid/competency-assessments"
+	AssessmentByUUIDRoute         = "/competency-assessments/:assessment_uuid"
+	PersonCompetencyGapsRoute     = "/people/:person_uuid/competency-gaps"
+	PersonCompetencyEvidenceRoute = "/people/:person_uuid/competencies/:competency_uuid/evidence"
+	NoteCompetenciesRoute         = "/notes/:note_uuid/competencies"
+)
+
+type CompetencyRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *CompetencyRouter {
+	return &CompetencyRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *CompetencyRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.CompanyGroup.Group(GroupRouteName)
+
+	router.POST(FrameworksRoute, r.ctrl.handleCreateFramework).
+		Summary("Create competency framework").
+		Description("Create a career ladder of the company: the levels of the roles, from the least to the most senior, the competencies and what is expected of each competency at each level. Descriptors reference the levels by their 1-based position in the list of levels").
+		Read(viewmodel.CompetencyFrameworkRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.CompetencyFrameworkResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(FrameworksRoute, r.ctrl.handleGetFrameworks).
+		Summary("Get competency frameworks").
+		Description("Get the competency frameworks of the company with their matrix").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.CompetencyFrameworkResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(FrameworkByUUIDRoute, r.ctrl.handleGetFramework).
+		Summary("Get competency framework").
+		Description("Get the matrix of a competency framework: levels, competencies and the descriptor of each competency at each level").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.CompetencyFrameworkResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("framework_uuid", "competency framework uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(FrameworkByUUIDRoute, r.ctrl.handleDeleteFramework).
+		Summary("Delete competency framework").
+		Description("Delete a competency framework that has no assessments").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("framework_uuid", "competency framework uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(PersonAssessmentsRoute, r.ctrl.handleCreateAssessment).
+		Summary("Assess person competencies").
+		Description("Assess a person against a competency framework, rating the level demonstrated in each competency. The expected level is the level of the role of the person").
+		Read(viewmodel.CompetencyAssessmentRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.CompetencyAssessmentResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(PersonAssessmentsRoute, r.ctrl.handleGetPersonAssessments).
+		Summary("Get person competency assessments").
+		Description("Get the competency assessments of a person, newest first, to follow the progression over time").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.CompetencyAssessmentResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		QueryParam("framework_uuid", "only the assessments of the framework", goswag.StringType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(AssessmentByUUIDRoute, r.ctrl.handleGetAssessment).
+		Summary("Get competency assessment").
+		Description("Get a competency assessment with its ratings").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.CompetencyAssessmentResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("assessment_uuid", "competency assessment uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(AssessmentByUUIDRoute, r.ctrl.handleDeleteAssessment).
+		Summary("Delete competency assessment").
+		Description("Delete a competency assessment").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("assessment_uuid", "competency assessment uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(PersonCompetencyGapsRoute, r.ctrl.handleGetPersonGaps).
+		Summary("Get person competency gaps").
+		Description("Compare the latest assessment of a person in a framework with a target level, by default the expected level of that assessment, to show where the person is below or above what is expected. Each competency shows how many feedback notes are evidence of it").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.CompetencyGapAnalysisResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		QueryParam("framework_uuid", "competency framework uuid", goswag.StringType, true).
+		QueryParam("target_level_uuid", "level to compare with, e.g. the next level for a promotion", goswag.StringType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(PersonCompetencyEvidenceRoute, r.ctrl.handleGetCompetencyEvidence).
+		Summary("Get competency evidence").
+		Description("Get the feedback notes about a person tagged with a competency, newest first").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.UnifiedTimelineResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		PathParam("competency_uuid", "competency uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.PUT(NoteCompetenciesRoute, r.ctrl.handleSetNoteCompetencies).
+		Summary("Tag note with competencies").
+		Description("Replace the competencies a feedback note is evidence of. An empty list removes the tags").
+		Read(viewmodel.NoteCompetenciesRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.CompetencyResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("note_uuid", "note uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+}
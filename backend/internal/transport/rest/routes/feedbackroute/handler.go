@@ -0,0 +1,150 @@
+package feedbackroute
+
+import (
+	"sync"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	feedbackService contract.FeedbackRequestApp
+}
+
+func NewHandler(feedbackService contract.FeedbackRequestApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			feedbackService: feedbackService,
+		}
+	})
+
+	return instance
+}
+
+// formURL returns a function that builds the link of the feedback form of a token
+func formURL(c echo.Context) func(token string) string {
+	return func(token string) string {
+		return c.Scheme() + "://" + c.Request().Host + "/" + PublicGroupRouteName + "/" + token
+	}
+}
+
+func (s *Handler) handleCreateFeedbackRequest(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.FeedbackRequestRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	request, err := s.feedbackService.CreateFeedbackRequest(ctx, personUUID, input.ToEntity(), input.ExpiresInDays)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.FeedbackRequestResponse{}
+	response.FillFromEntity(request, formURL(c))
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleGetPersonFeedbackRequests(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	requests, err := s.feedbackService.GetPersonFeedbackRequests(ctx, personUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.FeedbackRequestResponse, len(requests))
+	for i, request := range requests {
+		response[i].FillFromEntity(request, formURL(c))
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetFeedbackRequest(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	requestUUID, err := routeutils.GetRequiredStringPathParam(c, "request_uuid", "Invalid request_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	request, err := s.feedbackService.GetFeedbackRequest(ctx, requestUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.FeedbackRequestResponse{}
+	response.FillFromEntity(request, formURL(c))
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleDeleteFeedbackRequest(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	requestUUID, err := routeutils.GetRequiredStringPathParam(c, "request_uuid", "Invalid request_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.feedbackService.DeleteFeedbackRequest(ctx, requestUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleGetFeedbackForm(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	form, err := s.feedbackService.GetFeedbackForm(ctx, c.Param("token"))
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.FeedbackFormResponse{}
+	response.FillFromEntity(form, domain.FeedbackRatingMin, domain.FeedbackRatingMax)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleSubmitFeedbackResponse(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	input := viewmodel.FeedbackResponseRequest{}
+	err := c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	err = s.feedbackService.SubmitFeedbackResponse(ctx, c.Param("token"), input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
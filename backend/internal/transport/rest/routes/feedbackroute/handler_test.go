@@ -0,0 +1,278 @@
+package feedbackroute_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/feedbackroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	companyUUID = "company-uuid-123"
+	personUUID  = "person-uuid-123"
+	requestUUID = "request-uuid-123"
+	token       = "feedback-token"
+)
+
+type feedbackTest struct {
+	name          string
+	body          any
+	buildMocks    func(m test.AppMocks)
+	checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+}
+
+// runFeedbackTests runs the tests of a company route, or of a public route without a session
+func runFeedbackTests(t *testing.T, method, url string, public bool, tests []feedbackTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feedbackroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			var body []byte
+			if tt.body != nil {
+				var err error
+				body, err = json.Marshal(tt.body)
+				require.NoError(t, err)
+			}
+
+			req, err := http.NewRequest(method, url, bytes.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.Host = "api.leaderpro.com"
+
+			if !public {
+				test.AddAuthorization(context.Background(), t, req, m)
+				m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+			}
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(m)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestHandler_handleCreateFeedbackRequest(t *testing.T) {
+	expiresAt := time.Date(2025, time.July, 24, 12, 0, 0, 0, time.UTC)
+
+	tests := []feedbackTest{
+		{
+			name: "Should create the request and return the links of the respondents",
+			body: viewmodel.FeedbackRequestRequest{
+				Anonymous:     true,
+				ExpiresInDays: 7,
+				Respondents: []viewmodel.FeedbackRespondentRequest{
+					{PersonUUID: "peer-uuid"},
+					{Name: "Ana", Email: "ana@client.com"},
+				},
+			},
+			buildMocks: func(m test.AppMocks) {
+				m.FeedbackAppMock.EXPECT().CreateFeedbackRequest(gomock.Any(), personUUID, entity.FeedbackRequest{
+					Anonymous: true,
+					Respondents: []entity.FeedbackRespondent{
+						{PersonUUID: "peer-uuid"},
+						{Name: "Ana", Email: "ana@client.com"},
+					},
+				}, 7).Return(entity.FeedbackRequest{
+					UUID:      requestUUID,
+					Anonymous: true,
+					ExpiresAt: expiresAt,
+					Questions: []entity.FeedbackQuestion{{UUID: "question-uuid", Text: "What should they keep doing?", Type: "text", Position: 1}},
+					Respondents: []entity.FeedbackRespondent{
+						{UUID: "respondent-1", Name: "Maria", Token: token},
+						{UUID: "respondent-2", Name: "Ana", Email: "ana@client.com", Token: "other-token"},
+					},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.FeedbackRequestResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, requestUUID, response.UUID)
+				require.True(t, response.Anonymous)
+				require.Len(t, response.Questions, 1)
+				require.Len(t, response.Respondents, 2)
+				require.Equal(t, token, response.Respondents[0].Token)
+				require.Equal(t, "http://api.leaderpro.com/feedback/"+token, response.Respondents[0].URL)
+				require.Zero(t, response.ResponseCount)
+			},
+		},
+		{
+			name: "Should return error when the respondents are invalid",
+			body: viewmodel.FeedbackRequestRequest{},
+			buildMocks: func(m test.AppMocks) {
+				m.FeedbackAppMock.EXPECT().CreateFeedbackRequest(gomock.Any(), personUUID, gomock.Any(), 0).
+					Return(entity.FeedbackRequest{}, resterrors.NewBadRequestError("at least one respondent is required")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	runFeedbackTests(t, http.MethodPost, "/companies/"+companyUUID+"/people/"+personUUID+"/feedback-requests", false, tests)
+}
+
+func TestHandler_handleGetPersonFeedbackRequests(t *testing.T) {
+	respondedAt := time.Date(2025, time.July, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []feedbackTest{
+		{
+			name: "Should return the requests without the links",
+			buildMocks: func(m test.AppMocks) {
+				m.FeedbackAppMock.EXPECT().GetPersonFeedbackRequests(gomock.Any(), personUUID).Return([]entity.FeedbackRequest{
+					{
+						UUID: requestUUID,
+						Respondents: []entity.FeedbackRespondent{
+							{UUID: "respondent-1", Name: "Maria", RespondedAt: &respondedAt},
+							{UUID: "respondent-2", Name: "Ana"},
+						},
+					},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response []viewmodel.FeedbackRequestResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response, 1)
+				require.Equal(t, 1, response[0].ResponseCount)
+				require.Empty(t, response[0].Respondents[0].URL)
+				require.Equal(t, respondedAt, *response[0].Respondents[0].RespondedAt)
+			},
+		},
+	}
+
+	runFeedbackTests(t, http.MethodGet, "/companies/"+companyUUID+"/people/"+personUUID+"/feedback-requests", false, tests)
+}
+
+func TestHandler_handleDeleteFeedbackRequest(t *testing.T) {
+	tests := []feedbackTest{
+		{
+			name: "Should delete the request",
+			buildMocks: func(m test.AppMocks) {
+				m.FeedbackAppMock.EXPECT().DeleteFeedbackRequest(gomock.Any(), requestUUID).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Should return not found",
+			buildMocks: func(m test.AppMocks) {
+				m.FeedbackAppMock.EXPECT().DeleteFeedbackRequest(gomock.Any(), requestUUID).
+					Return(resterrors.NewNotFoundError("feedback request not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	runFeedbackTests(t, http.MethodDelete, "/companies/"+companyUUID+"/feedback-requests/"+requestUUID, false, tests)
+}
+
+func TestHandler_handleGetFeedbackForm(t *testing.T) {
+	tests := []feedbackTest{
+		{
+			name: "Should return the form without a session",
+			buildMocks: func(m test.AppMocks) {
+				m.FeedbackAppMock.EXPECT().GetFeedbackForm(gomock.Any(), token).Return(entity.FeedbackForm{
+					Request: entity.FeedbackRequest{
+						CompanyName:   "Acme",
+						PersonName:    "John",
+						RequesterName: "Diego",
+						Questions:     []entity.FeedbackQuestion{{UUID: "question-uuid", Text: "How was working with John?", Type: "rating", Position: 1}},
+					},
+					Respondent: entity.FeedbackRespondent{Name: "Maria"},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.FeedbackFormResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, "John", response.PersonName)
+				require.Equal(t, "Maria", response.RespondentName)
+				require.Equal(t, 1, response.RatingMin)
+				require.Equal(t, 5, response.RatingMax)
+				require.Len(t, response.Questions, 1)
+			},
+		},
+		{
+			name: "Should return conflict when the link was already answered",
+			buildMocks: func(m test.AppMocks) {
+				m.FeedbackAppMock.EXPECT().GetFeedbackForm(gomock.Any(), token).
+					Return(entity.FeedbackForm{}, resterrors.NewConflictError("feedback was already sent")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	}
+
+	runFeedbackTests(t, http.MethodGet, "/feedback/"+token, true, tests)
+}
+
+func TestHandler_handleSubmitFeedbackResponse(t *testing.T) {
+	rating := 4
+
+	tests := []feedbackTest{
+		{
+			name: "Should send the answers without a session",
+			body: viewmodel.FeedbackResponseRequest{
+				FeedbackType: "positive",
+				Answers: []viewmodel.FeedbackAnswerRequest{
+					{QuestionUUID: "question-1", Text: "Great partner"},
+					{QuestionUUID: "question-2", Rating: &rating},
+				},
+			},
+			buildMocks: func(m test.AppMocks) {
+				m.FeedbackAppMock.EXPECT().SubmitFeedbackResponse(gomock.Any(), token, entity.FeedbackResponse{
+					FeedbackType: "positive",
+					Answers: []entity.FeedbackAnswer{
+						{QuestionUUID: "question-1", Text: "Great partner"},
+						{QuestionUUID: "question-2", Rating: &rating},
+					},
+				}).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Should return error when the link has expired",
+			body: viewmodel.FeedbackResponseRequest{Answers: []viewmodel.FeedbackAnswerRequest{{QuestionUUID: "question-1", Text: "Great partner"}}},
+			buildMocks: func(m test.AppMocks) {
+				m.FeedbackAppMock.EXPECT().SubmitFeedbackResponse(gomock.Any(), token, gomock.Any()).
+					Return(resterrors.NewBadRequestError("feedback request has expired")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	runFeedbackTests(t, http.MethodPost, "/feedback/"+token, true, tests)
+}
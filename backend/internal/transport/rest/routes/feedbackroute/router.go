@@ -0,0 +1,103 @@
+package feedbackroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const (
+	GroupRouteName       = "companies/:company_uuid"
+	PublicGroupRouteName = "feedback"
+)
+
+const (
+	PersonFeedbackRequestsRoute = "/people/:person_uuid/feedback-requests"
+	FeedbackRequestByUUIDRoute  = "/feedback-requests/:request_uuid"
+	FeedbackFormRoute           = "/:token"
+)
+
+type FeedbackRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *FeedbackRouter {
+	return &FeedbackRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *FeedbackRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.CompanyGroup.Group(GroupRouteName)
+	publicRouter := g.AppGroup.Group(PublicGroupRouteName)
+
+	router.POST(PersonFeedbackRequestsRoute, r.ctrl.handleCreateFeedbackRequest).
+		Summary("Request feedback about a person").
+		Description("Request feedback about a person from people of the company or external emails. Each respondent gets a link that expires and needs no account, answered once. Without questions the default questionnaire is used. The links are only returned here, to be sent to the respondents. The responses are stored as feedback notes of the person, attributed to the respondent or to \"Anonymous\" when the request is anonymous").
+		Read(viewmodel.FeedbackRequestRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.FeedbackRequestResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(PersonFeedbackRequestsRoute, r.ctrl.handleGetPersonFeedbackRequests).
+		Summary("Get person feedback requests").
+		Description("Get the feedback requests about a person with who already responded, most recent first").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.FeedbackRequestResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(FeedbackRequestByUUIDRoute, r.ctrl.handleGetFeedbackRequest).
+		Summary("Get feedback request").
+		Description("Get a feedback request with its questionnaire and respondents").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.FeedbackRequestResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("request_uuid", "feedback request uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(FeedbackRequestByUUIDRoute, r.ctrl.handleDeleteFeedbackRequest).
+		Summary("Delete feedback request").
+		Description("Delete a feedback request, its links stop working. The feedback already received is kept in the person timeline").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("request_uuid", "feedback request uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	publicRouter.GET(FeedbackFormRoute, r.ctrl.handleGetFeedbackForm).
+		Summary("Get feedback form").
+		Description("Get the questionnaire of a feedback request link, authenticated by the token in the URL. Returns 409 when the link was already answered and 400 when it has expired").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.FeedbackFormResponse{},
+			},
+		}).
+		PathParam("token", "feedback link token", goswag.StringType, true)
+
+	publicRouter.POST(FeedbackFormRoute, r.ctrl.handleSubmitFeedbackResponse).
+		Summary("Send feedback").
+		Description("Send the answers of a feedback request link, authenticated by the token in the URL. Each link can be answered once").
+		Read(viewmodel.FeedbackResponseRequest{}).
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("token", "feedback link token", goswag.StringType, true)
+}
@@ -0,0 +1,273 @@
+package goalroute
+
+import (
+	"sync"
+
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	goalService contract.GoalApp
+}
+
+func NewHandler(goalService contract.GoalApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			goalService: goalService,
+		}
+	})
+
+	return instance
+}
+
+func (s *Handler) handleCreateGoal(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.GoalRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	goal, err := s.goalService.CreateGoal(ctx, personUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.GoalResponse{}
+	response.FillFromEntity(goal)
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleGetPersonGoals(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	goals, err := s.goalService.GetPersonGoals(ctx, personUUID, c.QueryParam("status"))
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.GoalResponse, len(goals))
+	for i, goal := range goals {
+		response[i].FillFromEntity(goal)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetPersonGoalTimeline(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	timeline, err := s.goalService.GetPersonGoalTimeline(ctx, personUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.GoalTimelineEntryResponse, len(timeline))
+	for i, entry := range timeline {
+		response[i].FillFromEntity(entry)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetGoal(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	goalUUID, err := routeutils.GetRequiredStringPathParam(c, "goal_uuid", "Invalid goal_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	goal, err := s.goalService.GetGoal(ctx, goalUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.GoalResponse{}
+	response.FillFromEntity(goal)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleUpdateGoal(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	goalUUID, err := routeutils.GetRequiredStringPathParam(c, "goal_uuid", "Invalid goal_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.UpdateGoalRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	err = s.goalService.UpdateGoal(ctx, goalUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleDeleteGoal(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	goalUUID, err := routeutils.GetRequiredStringPathParam(c, "goal_uuid", "Invalid goal_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.goalService.DeleteGoal(ctx, goalUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleAddKeyResult(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	goalUUID, err := routeutils.GetRequiredStringPathParam(c, "goal_uuid", "Invalid goal_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.KeyResultRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	keyResult, err := s.goalService.AddKeyResult(ctx, goalUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.KeyResultResponse{}
+	response.FillFromEntity(keyResult)
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleUpdateKeyResult(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	goalUUID, err := routeutils.GetRequiredStringPathParam(c, "goal_uuid", "Invalid goal_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	keyResultUUID, err := routeutils.GetRequiredStringPathParam(c, "key_result_uuid", "Invalid key_result_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.KeyResultRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	err = s.goalService.UpdateKeyResult(ctx, goalUUID, keyResultUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleDeleteKeyResult(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	goalUUID, err := routeutils.GetRequiredStringPathParam(c, "goal_uuid", "Invalid goal_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	keyResultUUID, err := routeutils.GetRequiredStringPathParam(c, "key_result_uuid", "Invalid key_result_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.goalService.DeleteKeyResult(ctx, goalUUID, keyResultUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleCheckIn(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	goalUUID, err := routeutils.GetRequiredStringPathParam(c, "goal_uuid", "Invalid goal_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.GoalCheckInRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	checkIn, err := s.goalService.CheckIn(ctx, goalUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.GoalCheckInResponse{}
+	response.FillFromEntity(checkIn)
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleDeleteCheckIn(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	goalUUID, err := routeutils.GetRequiredStringPathParam(c, "goal_uuid", "Invalid goal_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	checkInUUID, err := routeutils.GetRequiredStringPathParam(c, "check_in_uuid", "Invalid check_in_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.goalService.DeleteCheckIn(ctx, goalUUID, checkInUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
@@ -0,0 +1,260 @@
+package goalroute_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/goalroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	companyUUID = "company-uuid-123"
+	personUUID  = "person-uuid-123"
+	goalUUID    = "goal-uuid-123"
+	noteUUID    = "note-uuid-123"
+)
+
+type goalTest struct {
+	name          string
+	body          any
+	buildMocks    func(m test.AppMocks)
+	checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+}
+
+func runGoalTests(t *testing.T, method, url string, tests []goalTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			goalroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			var body []byte
+			if tt.body != nil {
+				var err error
+				body, err = json.Marshal(tt.body)
+				require.NoError(t, err)
+			}
+
+			req, err := http.NewRequest(method, url, bytes.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			test.AddAuthorization(context.Background(), t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(m)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestHandler_handleCreateGoal(t *testing.T) {
+	targetDate := time.Date(2025, time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []goalTest{
+		{
+			name: "Should create the goal with its key results",
+			body: viewmodel.GoalRequest{
+				Title:      "Become tech lead",
+				Type:       domain.GoalTypeCareer,
+				TargetDate: &targetDate,
+				KeyResults: []viewmodel.KeyResultRequest{{Title: "Lead projects", TargetValue: 2, Unit: "projects"}},
+			},
+			buildMocks: func(m test.AppMocks) {
+				m.GoalAppMock.EXPECT().CreateGoal(gomock.Any(), personUUID, entity.Goal{
+					Title:      "Become tech lead",
+					Type:       domain.GoalTypeCareer,
+					TargetDate: &targetDate,
+					KeyResults: []entity.GoalKeyResult{{Title: "Lead projects", TargetValue: 2, Unit: "projects"}},
+				}).Return(entity.Goal{
+					UUID:       goalUUID,
+					PersonUUID: personUUID,
+					Title:      "Become tech lead",
+					Type:       domain.GoalTypeCareer,
+					Status:     domain.GoalStatusActive,
+					TargetDate: &targetDate,
+					KeyResults: []entity.GoalKeyResult{{UUID: "kr-uuid", Title: "Lead projects", TargetValue: 2, CurrentValue: 1, Unit: "projects"}},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.GoalResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, goalUUID, response.UUID)
+				require.Equal(t, "2025-12-31", *response.TargetDate)
+				require.Len(t, response.KeyResults, 1)
+				require.Equal(t, 50, response.KeyResults[0].Progress)
+				require.Empty(t, response.CheckIns)
+			},
+		},
+		{
+			name: "Should return error when the person is not found",
+			body: viewmodel.GoalRequest{Title: "Learn Go", Type: domain.GoalTypeLearning},
+			buildMocks: func(m test.AppMocks) {
+				m.GoalAppMock.EXPECT().CreateGoal(gomock.Any(), personUUID, gomock.Any()).
+					Return(entity.Goal{}, resterrors.NewNotFoundError("person not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	runGoalTests(t, http.MethodPost, "/companies/"+companyUUID+"/people/"+personUUID+"/goals", tests)
+}
+
+func TestHandler_handleGetPersonGoalTimeline(t *testing.T) {
+	checkInDate := time.Date(2025, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []goalTest{
+		{
+			name: "Should return the goals timeline of the person",
+			buildMocks: func(m test.AppMocks) {
+				m.GoalAppMock.EXPECT().GetPersonGoalTimeline(gomock.Any(), personUUID).Return([]entity.GoalTimelineEntry{
+					{
+						Type:      domain.GoalTimelineCheckIn,
+						Date:      checkInDate,
+						GoalUUID:  goalUUID,
+						GoalTitle: "Become tech lead",
+						GoalType:  domain.GoalTypeCareer,
+						CheckIn:   &entity.GoalCheckIn{UUID: "check-in-uuid", Date: checkInDate, Progress: 40, Confidence: domain.GoalConfidenceAtRisk},
+					},
+					{Type: domain.GoalTimelineGoalCreated, GoalUUID: goalUUID, GoalTitle: "Become tech lead"},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response []viewmodel.GoalTimelineEntryResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response, 2)
+				require.Equal(t, "2025-03-05", response[0].CheckIn.Date)
+				require.Equal(t, 40, response[0].CheckIn.Progress)
+				require.Nil(t, response[1].CheckIn)
+			},
+		},
+	}
+
+	runGoalTests(t, http.MethodGet, "/companies/"+companyUUID+"/people/"+personUUID+"/goals/timeline", tests)
+}
+
+func TestHandler_handleCheckIn(t *testing.T) {
+	note := noteUUID
+
+	tests := []goalTest{
+		{
+			name: "Should register the check-in with the key result values",
+			body: viewmodel.GoalCheckInRequest{
+				Confidence: domain.GoalConfidenceOnTrack,
+				Comment:    "Leading the billing project",
+				NoteUUID:   &note,
+				KeyResults: []viewmodel.CheckInKeyResultRequest{{UUID: "kr-uuid", CurrentValue: 1}},
+			},
+			buildMocks: func(m test.AppMocks) {
+				m.GoalAppMock.EXPECT().CheckIn(gomock.Any(), goalUUID, entity.GoalCheckIn{
+					Confidence:      domain.GoalConfidenceOnTrack,
+					Comment:         "Leading the billing project",
+					NoteUUID:        &note,
+					KeyResultValues: []entity.GoalKeyResultValue{{KeyResultUUID: "kr-uuid", CurrentValue: 1}},
+				}).Return(entity.GoalCheckIn{
+					UUID:       "check-in-uuid",
+					Date:       time.Date(2025, time.March, 10, 0, 0, 0, 0, time.UTC),
+					Progress:   50,
+					Confidence: domain.GoalConfidenceOnTrack,
+					Comment:    "Leading the billing project",
+					NoteUUID:   &note,
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.GoalCheckInResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, "2025-03-10", response.Date)
+				require.Equal(t, 50, response.Progress)
+				require.Equal(t, noteUUID, *response.NoteUUID)
+			},
+		},
+		{
+			name: "Should return error when the goal is closed",
+			body: viewmodel.GoalCheckInRequest{Progress: 80},
+			buildMocks: func(m test.AppMocks) {
+				m.GoalAppMock.EXPECT().CheckIn(gomock.Any(), goalUUID, gomock.Any()).
+					Return(entity.GoalCheckIn{}, resterrors.NewBadRequestError("check-ins can only be added to active goals")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	runGoalTests(t, http.MethodPost, "/companies/"+companyUUID+"/goals/"+goalUUID+"/check-ins", tests)
+}
+
+func TestHandler_handleUpdateGoal(t *testing.T) {
+	tests := []goalTest{
+		{
+			name: "Should update the goal",
+			body: viewmodel.UpdateGoalRequest{Title: "Become tech lead", Status: domain.GoalStatusAchieved},
+			buildMocks: func(m test.AppMocks) {
+				m.GoalAppMock.EXPECT().UpdateGoal(gomock.Any(), goalUUID, entity.Goal{
+					Title:  "Become tech lead",
+					Status: domain.GoalStatusAchieved,
+				}).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+	}
+
+	runGoalTests(t, http.MethodPut, "/companies/"+companyUUID+"/goals/"+goalUUID, tests)
+}
+
+func TestHandler_handleDeleteKeyResult(t *testing.T) {
+	tests := []goalTest{
+		{
+			name: "Should delete the key result",
+			buildMocks: func(m test.AppMocks) {
+				m.GoalAppMock.EXPECT().DeleteKeyResult(gomock.Any(), goalUUID, "kr-uuid").Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Should return not found for a key result of another goal",
+			buildMocks: func(m test.AppMocks) {
+				m.GoalAppMock.EXPECT().DeleteKeyResult(gomock.Any(), goalUUID, "kr-uuid").
+					Return(resterrors.NewNotFoundError("key result not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	runGoalTests(t, http.MethodDelete, "/companies/"+companyUUID+"/goals/"+goalUUID+"/key-results/kr-uuid", tests)
+}
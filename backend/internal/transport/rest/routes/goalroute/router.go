@@ -0,0 +1,165 @@
+package goalroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const GroupRouteName = "companies/:company_uuid"
+
+const (
+	PersonGoalsRoute        = "/people/:person_uuid/goals"
+	PersonGoalTimelineRoute = "/people/:person_uuid/goals/timeline"
+	GoalByUUIDRoute         = "/goals/:goal_uuid"
+	GoalKeyResultsRoute     = "/goals/:goal_uuid/key-results"
+	GoalKeyResultRoute      = "/goals/:goal_uuid/key-results/:key_result_uuid"
+	GoalCheckInsRoute       = "/goals/:goal_uuid/check-ins"
+	GoalCheckInRoute        = "/goals/:goal_uuid/check-ins/:check_in_uuid"
+)
+
+type GoalRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *GoalRouter {
+	return &GoalRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *GoalRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.CompanyGroup.Group(GroupRouteName)
+
+	router.POST(PersonGoalsRoute, r.ctrl.handleCreateGoal).
+		Summary("Create goal").
+		Description("Create a career, performance or learning goal for a person, optionally with its key results").
+		Read(viewmodel.GoalRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.GoalResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(PersonGoalsRoute, r.ctrl.handleGetPersonGoals).
+		Summary("Get person goals").
+		Description("Get the goals of a person with their key results and check-ins, active goals first").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.GoalResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		QueryParam("status", "filter by status: active, achieved or abandoned", goswag.StringType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(PersonGoalTimelineRoute, r.ctrl.handleGetPersonGoalTimeline).
+		Summary("Get person goals timeline").
+		Description("Get when the goals of a person were created and closed and their check-ins, most recent first").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.GoalTimelineEntryResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(GoalByUUIDRoute, r.ctrl.handleGetGoal).
+		Summary("Get goal").
+		Description("Get a goal with its key results and check-ins").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.GoalResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("goal_uuid", "goal uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.PUT(GoalByUUIDRoute, r.ctrl.handleUpdateGoal).
+		Summary("Update goal").
+		Description("Update a goal. Setting the status to achieved or abandoned registers the completion date").
+		Read(viewmodel.UpdateGoalRequest{}).
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("goal_uuid", "goal uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(GoalByUUIDRoute, r.ctrl.handleDeleteGoal).
+		Summary("Delete goal").
+		Description("Delete a goal with its key results and check-ins").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("goal_uuid", "goal uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(GoalKeyResultsRoute, r.ctrl.handleAddKeyResult).
+		Summary("Add key result").
+		Description("Add a key result to a goal. The goal progress becomes the average of its key results").
+		Read(viewmodel.KeyResultRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.KeyResultResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("goal_uuid", "goal uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.PUT(GoalKeyResultRoute, r.ctrl.handleUpdateKeyResult).
+		Summary("Update key result").
+		Description("Update a key result of a goal").
+		Read(viewmodel.KeyResultRequest{}).
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("goal_uuid", "goal uuid", goswag.StringType, true).
+		PathParam("key_result_uuid", "key result uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(GoalKeyResultRoute, r.ctrl.handleDeleteKeyResult).
+		Summary("Delete key result").
+		Description("Delete a key result of a goal").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("goal_uuid", "goal uuid", goswag.StringType, true).
+		PathParam("key_result_uuid", "key result uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(GoalCheckInsRoute, r.ctrl.handleCheckIn).
+		Summary("Check in on a goal").
+		Description("Register a dated progress update of an active goal, optionally linked to the note where it was discussed. "+
+			"The key result values reported are applied to the key results").
+		Read(viewmodel.GoalCheckInRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.GoalCheckInResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("goal_uuid", "goal uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(GoalCheckInRoute, r.ctrl.handleDeleteCheckIn).
+		Summary("Delete goal check-in").
+		Description("Delete a check-in of a goal").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("goal_uuid", "goal uuid", goswag.StringType, true).
+		PathParam("check_in_uuid", "check-in uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+}
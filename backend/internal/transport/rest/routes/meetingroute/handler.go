@@ -0,0 +1,233 @@
+package meetingroute
+
+import (
+	"sync"
+
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	meetingService contract.MeetingApp
+}
+
+func NewHandler(meetingService contract.MeetingApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			meetingService: meetingService,
+		}
+	})
+
+	return instance
+}
+
+func (s *Handler) handleScheduleMeeting(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.MeetingRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	meeting, err := s.meetingService.ScheduleMeeting(ctx, personUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.MeetingResponse{}
+	response.FillFromEntity(meeting)
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleGetPersonMeetings(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	take, skip := routeutils.GetPagingParams(c, "", "")
+
+	meetings, totalRecords, err := s.meetingService.GetPersonMeetings(ctx, personUUID, c.QueryParam("status"), take, skip)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.MeetingResponse, len(meetings))
+	for i, meeting := range meetings {
+		response[i].FillFromEntity(meeting)
+	}
+
+	return routeutils.ResponseAPIOk(c, viewmodel.BuildPaginatedResponse(response, skip, take, totalRecords))
+}
+
+func (s *Handler) handleGetMeeting(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	meetingUUID, err := routeutils.GetRequiredStringPathParam(c, "meeting_uuid", "Invalid meeting_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	meeting, err := s.meetingService.GetMeeting(ctx, meetingUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.MeetingResponse{}
+	response.FillFromEntity(meeting)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleUpdateMeeting(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	meetingUUID, err := routeutils.GetRequiredStringPathParam(c, "meeting_uuid", "Invalid meeting_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.MeetingRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	err = s.meetingService.UpdateMeeting(ctx, meetingUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleCancelMeeting(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	meetingUUID, err := routeutils.GetRequiredStringPathParam(c, "meeting_uuid", "Invalid meeting_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.meetingService.CancelMeeting(ctx, meetingUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleCompleteMeeting(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	meetingUUID, err := routeutils.GetRequiredStringPathParam(c, "meeting_uuid", "Invalid meeting_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.CompleteMeetingRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	meeting, err := s.meetingService.CompleteMeeting(ctx, meetingUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.MeetingResponse{}
+	response.FillFromEntity(meeting)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleAddAgendaItem(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	meetingUUID, err := routeutils.GetRequiredStringPathParam(c, "meeting_uuid", "Invalid meeting_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.AgendaItemRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	item, err := s.meetingService.AddAgendaItem(ctx, meetingUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.AgendaItemResponse{}
+	response.FillFromEntity(item)
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleUpdateAgendaItem(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	meetingUUID, err := routeutils.GetRequiredStringPathParam(c, "meeting_uuid", "Invalid meeting_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	itemUUID, err := routeutils.GetRequiredStringPathParam(c, "item_uuid", "Invalid item_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.AgendaItemRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	err = s.meetingService.UpdateAgendaItem(ctx, meetingUUID, itemUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleDeleteAgendaItem(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	meetingUUID, err := routeutils.GetRequiredStringPathParam(c, "meeting_uuid", "Invalid meeting_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	itemUUID, err := routeutils.GetRequiredStringPathParam(c, "item_uuid", "Invalid item_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.meetingService.DeleteAgendaItem(ctx, meetingUUID, itemUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
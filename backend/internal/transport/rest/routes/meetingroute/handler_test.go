@@ -0,0 +1,248 @@
+package meetingroute_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/meetingroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	companyUUID = "company-uuid-123"
+	personUUID  = "person-uuid-123"
+	meetingUUID = "meeting-uuid-123"
+	itemUUID    = "item-uuid-123"
+)
+
+type meetingTest struct {
+	name          string
+	body          any
+	buildMocks    func(m test.AppMocks)
+	checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+}
+
+func runMeetingTests(t *testing.T, method, url string, tests []meetingTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			meetingroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			var body []byte
+			if tt.body != nil {
+				var err error
+				body, err = json.Marshal(tt.body)
+				require.NoError(t, err)
+			}
+
+			req, err := http.NewRequest(method, url, bytes.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			test.AddAuthorization(context.Background(), t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(m)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestHandler_handleScheduleMeeting(t *testing.T) {
+	scheduledAt := time.Date(2025, time.March, 10, 14, 0, 0, 0, time.UTC)
+	duration := 30
+
+	tests := []meetingTest{
+		{
+			name: "Should schedule the meeting",
+			body: viewmodel.MeetingRequest{ScheduledAt: scheduledAt, DurationMinutes: &duration},
+			buildMocks: func(m test.AppMocks) {
+				m.MeetingAppMock.EXPECT().ScheduleMeeting(gomock.Any(), personUUID, entity.Meeting{
+					ScheduledAt:     scheduledAt,
+					DurationMinutes: &duration,
+				}).Return(entity.Meeting{
+					UUID:        meetingUUID,
+					PersonUUID:  personUUID,
+					Status:      domain.MeetingStatusScheduled,
+					Origin:      domain.MeetingOriginScheduled,
+					ScheduledAt: scheduledAt,
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.MeetingResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, meetingUUID, response.UUID)
+				require.Equal(t, domain.MeetingStatusScheduled, response.Status)
+			},
+		},
+		{
+			name: "Should return error when the person is not found",
+			body: viewmodel.MeetingRequest{ScheduledAt: scheduledAt},
+			buildMocks: func(m test.AppMocks) {
+				m.MeetingAppMock.EXPECT().ScheduleMeeting(gomock.Any(), personUUID, gomock.Any()).
+					Return(entity.Meeting{}, resterrors.NewNotFoundError("person not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	runMeetingTests(t, http.MethodPost, "/companies/"+companyUUID+"/people/"+personUUID+"/meetings", tests)
+}
+
+func TestHandler_handleGetPersonMeetings(t *testing.T) {
+	tests := []meetingTest{
+		{
+			name: "Should return the meetings filtered by status",
+			buildMocks: func(m test.AppMocks) {
+				m.MeetingAppMock.EXPECT().GetPersonMeetings(gomock.Any(), personUUID, domain.MeetingStatusHeld, int64(10), int64(0)).
+					Return([]entity.Meeting{{UUID: meetingUUID, Status: domain.MeetingStatusHeld}}, int64(1), nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.PaginatedResponse[[]viewmodel.MeetingResponse]
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response.List, 1)
+				require.Equal(t, meetingUUID, response.List[0].UUID)
+			},
+		},
+	}
+
+	runMeetingTests(t, http.MethodGet, "/companies/"+companyUUID+"/people/"+personUUID+"/meetings?status=held&page=1&quantity=10", tests)
+}
+
+func TestHandler_handleCompleteMeeting(t *testing.T) {
+	heldAt := time.Date(2025, time.March, 10, 14, 5, 0, 0, time.UTC)
+	noteUUID := "note-uuid-123"
+
+	tests := []meetingTest{
+		{
+			name: "Should complete the meeting with the resulting note",
+			body: viewmodel.CompleteMeetingRequest{HeldAt: &heldAt, Summary: "Talked about the promotion"},
+			buildMocks: func(m test.AppMocks) {
+				m.MeetingAppMock.EXPECT().CompleteMeeting(gomock.Any(), meetingUUID, entity.MeetingCompletion{
+					HeldAt:  heldAt,
+					Summary: "Talked about the promotion",
+				}).Return(entity.Meeting{
+					UUID:     meetingUUID,
+					Status:   domain.MeetingStatusHeld,
+					HeldAt:   &heldAt,
+					NoteUUID: &noteUUID,
+					AgendaItems: []entity.MeetingAgendaItem{
+						{UUID: itemUUID, AddedBy: domain.AgendaItemAddedByReport, Content: "Promotion", Notes: "Next cycle", Discussed: true, Position: 1},
+					},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.MeetingResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, domain.MeetingStatusHeld, response.Status)
+				require.Equal(t, noteUUID, *response.NoteUUID)
+				require.Len(t, response.AgendaItems, 1)
+				require.Equal(t, "Next cycle", response.AgendaItems[0].Notes)
+			},
+		},
+		{
+			name: "Should return error when the meeting is not scheduled",
+			body: viewmodel.CompleteMeetingRequest{},
+			buildMocks: func(m test.AppMocks) {
+				m.MeetingAppMock.EXPECT().CompleteMeeting(gomock.Any(), meetingUUID, entity.MeetingCompletion{}).
+					Return(entity.Meeting{}, resterrors.NewBadRequestError("only scheduled meetings can be completed")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	runMeetingTests(t, http.MethodPost, "/companies/"+companyUUID+"/meetings/"+meetingUUID+"/complete", tests)
+}
+
+func TestHandler_handleAddAgendaItem(t *testing.T) {
+	tests := []meetingTest{
+		{
+			name: "Should add the agenda item",
+			body: viewmodel.AgendaItemRequest{Content: "Career plan", AddedBy: domain.AgendaItemAddedByReport},
+			buildMocks: func(m test.AppMocks) {
+				m.MeetingAppMock.EXPECT().AddAgendaItem(gomock.Any(), meetingUUID, entity.MeetingAgendaItem{
+					Content: "Career plan",
+					AddedBy: domain.AgendaItemAddedByReport,
+				}).Return(entity.MeetingAgendaItem{UUID: itemUUID, Content: "Career plan", AddedBy: domain.AgendaItemAddedByReport, Position: 1}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.AgendaItemResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, itemUUID, response.UUID)
+				require.Equal(t, 1, response.Position)
+			},
+		},
+	}
+
+	runMeetingTests(t, http.MethodPost, "/companies/"+companyUUID+"/meetings/"+meetingUUID+"/agenda", tests)
+}
+
+func TestHandler_handleUpdateAgendaItem(t *testing.T) {
+	tests := []meetingTest{
+		{
+			name: "Should update the agenda item notes",
+			body: viewmodel.AgendaItemRequest{Content: "Career plan", Notes: "Wants to lead the platform team", Discussed: true},
+			buildMocks: func(m test.AppMocks) {
+				m.MeetingAppMock.EXPECT().UpdateAgendaItem(gomock.Any(), meetingUUID, itemUUID, entity.MeetingAgendaItem{
+					Content:   "Career plan",
+					Notes:     "Wants to lead the platform team",
+					Discussed: true,
+				}).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+	}
+
+	runMeetingTests(t, http.MethodPut, "/companies/"+companyUUID+"/meetings/"+meetingUUID+"/agenda/"+itemUUID, tests)
+}
+
+func TestHandler_handleCancelMeeting(t *testing.T) {
+	tests := []meetingTest{
+		{
+			name: "Should cancel the meeting",
+			buildMocks: func(m test.AppMocks) {
+				m.MeetingAppMock.EXPECT().CancelMeeting(gomock.Any(), meetingUUID).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+	}
+
+	runMeetingTests(t, http.MethodPost, "/companies/"+companyUUID+"/meetings/"+meetingUUID+"/cancel", tests)
+}
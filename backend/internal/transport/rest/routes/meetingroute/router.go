@@ -0,0 +1,143 @@
+package meetingroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const GroupRouteName = "companies/:company_uuid"
+
+const (
+	PersonMeetingsRoute    = "/people/:person_uuid/meetings"
+	MeetingByUUIDRoute     = "/meetings/:meeting_uuid"
+	MeetingCancelRoute     = "/meetings/:meeting_uuid/cancel"
+	MeetingCompleteRoute   = "/meetings/:meeting_uuid/complete"
+	MeetingAgendaRoute     = "/meetings/:meeting_uuid/agenda"
+	MeetingAgendaItemRoute = "/meetings/:meeting_uuid/agenda/:item_uuid"
+)
+
+type MeetingRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *MeetingRouter {
+	return &MeetingRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *MeetingRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.CompanyGroup.Group(GroupRouteName)
+
+	router.POST(PersonMeetingsRoute, r.ctrl.handleScheduleMeeting).
+		Summary("Schedule a 1:1 meeting").
+		Description("Schedule a 1:1 meeting with a person").
+		Read(viewmodel.MeetingRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.MeetingResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(PersonMeetingsRoute, r.ctrl.handleGetPersonMeetings).
+		Summary("Get person meetings").
+		Description("Get the 1:1 meetings with a person, most recent first").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.PaginatedResponse[[]viewmodel.MeetingResponse]{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		QueryParam("status", "filter by status: scheduled, held or canceled", goswag.StringType, false).
+		QueryParam("page", "page number", goswag.IntType, false).
+		QueryParam("quantity", "quantity of items per page", goswag.IntType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(MeetingByUUIDRoute, r.ctrl.handleGetMeeting).
+		Summary("Get meeting").
+		Description("Get a meeting with its agenda items").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.MeetingResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("meeting_uuid", "meeting uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.PUT(MeetingByUUIDRoute, r.ctrl.handleUpdateMeeting).
+		Summary("Reschedule meeting").
+		Description("Change the date and the expected duration of a scheduled meeting").
+		Read(viewmodel.MeetingRequest{}).
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("meeting_uuid", "meeting uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(MeetingCancelRoute, r.ctrl.handleCancelMeeting).
+		Summary("Cancel meeting").
+		Description("Cancel a scheduled meeting").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("meeting_uuid", "meeting uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(MeetingCompleteRoute, r.ctrl.handleCompleteMeeting).
+		Summary("Complete meeting").
+		Description("Mark a scheduled meeting as held. When a summary is sent, it is saved as the resulting one_on_one note").
+		Read(viewmodel.CompleteMeetingRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.MeetingResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("meeting_uuid", "meeting uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(MeetingAgendaRoute, r.ctrl.handleAddAgendaItem).
+		Summary("Add agenda item").
+		Description("Add a talking point to the agenda of a scheduled meeting").
+		Read(viewmodel.AgendaItemRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.AgendaItemResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("meeting_uuid", "meeting uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.PUT(MeetingAgendaItemRoute, r.ctrl.handleUpdateAgendaItem).
+		Summary("Update agenda item").
+		Description("Update the notes and the discussed flag of an agenda item. The content can only be changed before the meeting").
+		Read(viewmodel.AgendaItemRequest{}).
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("meeting_uuid", "meeting uuid", goswag.StringType, true).
+		PathParam("item_uuid", "agenda item uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(MeetingAgendaItemRoute, r.ctrl.handleDeleteAgendaItem).
+		Summary("Delete agenda item").
+		Description("Remove a talking point from the agenda of a scheduled meeting").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("meeting_uuid", "meeting uuid", goswag.StringType, true).
+		PathParam("item_uuid", "agenda item uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+}
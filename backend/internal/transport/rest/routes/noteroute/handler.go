@@ -0,0 +1,66 @@
+package noteroute
+
+import (
+	"sync"
+
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	personService contract.PersonApp
+}
+
+func NewHandler(personService contract.PersonApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			personService: personService,
+		}
+	})
+
+	return instance
+}
+
+func (s *Handler) handleSearchNotes(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	from, err := routeutils.GetTimeQueryParam(c, "from")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	to, err := routeutils.GetTimeQueryParam(c, "to")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	filters := entity.NoteSearchFilters{
+		PersonUUID: c.QueryParam("person_uuid"),
+		AuthorUUID: c.QueryParam("author_uuid"),
+		Types:      routeutils.GetStringArrayQueryParam(c, "types", ","),
+		From:       from,
+		To:         to,
+	}
+	take, skip := routeutils.GetPagingParams(c, "", "")
+
+	results, totalRecords, err := s.personService.SearchNotes(ctx, c.QueryParam("q"), filters, take, skip)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.NoteSearchResultResponse, len(results))
+	for i, result := range results {
+		response[i].FillFromEntity(result)
+	}
+
+	return routeutils.ResponseAPIOk(c, viewmodel.BuildPaginatedResponse(response, skip, take, totalRecords))
+}
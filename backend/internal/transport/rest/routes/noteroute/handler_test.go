@@ -0,0 +1,120 @@
+package noteroute_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/noteroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const companyUUID = "company-uuid-123"
+
+func TestHandler_handleSearchNotes(t *testing.T) {
+	createdAt := time.Date(2025, time.March, 3, 10, 0, 0, 0, time.UTC)
+	from := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		query         string
+		buildMocks    func(m test.AppMocks)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "Should return the notes found with their snippets",
+			query: "?q=migration&person_uuid=person-uuid-1&types=one_on_one,observation&from=2025-01-01&quantity=10",
+			buildMocks: func(m test.AppMocks) {
+				filters := entity.NoteSearchFilters{
+					PersonUUID: "person-uuid-1",
+					Types:      []string{domain.NoteTypeOneOnOne, domain.NoteTypeObservation},
+					From:       &from,
+				}
+				m.PersonAppMock.EXPECT().SearchNotes(gomock.Any(), "migration", filters, int64(10), int64(0)).Return([]entity.NoteSearchResult{
+					{
+						NoteSearchHit: entity.NoteSearchHit{
+							NoteUUID:   "note-uuid-1",
+							Type:       domain.NoteTypeOneOnOne,
+							Content:    "Talked about the migration project",
+							PersonUUID: "person-uuid-1",
+							PersonName: "Maria Silva",
+							AuthorUUID: "user-uuid-1",
+							AuthorName: "Diego",
+							CreatedAt:  createdAt,
+							Score:      1.5,
+						},
+						Snippet: []entity.NoteSnippetPart{
+							{Text: "Talked about the "},
+							{Text: "migration", Highlighted: true},
+							{Text: " project"},
+						},
+					},
+				}, int64(1), nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.PaginatedResponse[[]viewmodel.NoteSearchResultResponse]
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response.List, 1)
+				require.Equal(t, "note-uuid-1", response.List[0].UUID)
+				require.Equal(t, "Maria Silva", response.List[0].PersonName)
+				require.Equal(t, []viewmodel.NoteSnippetPartResponse{
+					{Text: "Talked about the "},
+					{Text: "migration", Highlighted: true},
+					{Text: " project"},
+				}, response.List[0].Snippet)
+			},
+		},
+		{
+			name:  "Should return unprocessable entity when a date is invalid",
+			query: "?q=migration&from=yesterday",
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name:  "Should return error when the service fails",
+			query: "?q=migration",
+			buildMocks: func(m test.AppMocks) {
+				m.PersonAppMock.EXPECT().SearchNotes(gomock.Any(), "migration", gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, int64(0), fmt.Errorf("error to search notes")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			noteroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			req, err := http.NewRequest(http.MethodGet, "/companies/"+companyUUID+"/notes/search"+tt.query, nil)
+			require.NoError(t, err)
+
+			test.AddAuthorization(context.Background(), t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(m)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
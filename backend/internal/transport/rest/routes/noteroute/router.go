@@ -0,0 +1,51 @@
+package noteroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const GroupRouteName = "companies/:company_uuid/notes"
+
+const (
+	SearchRoute = "/search"
+)
+
+type NoteRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *NoteRouter {
+	return &NoteRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *NoteRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.CompanyGroup.Group(GroupRouteName)
+
+	router.GET(SearchRoute, r.ctrl.handleSearchNotes).
+		Summary("Search notes").
+		Description("Search the notes of every person of the company by content, feedback category, person name and mentioned people, most relevant first. Words match by prefix, ignoring case and accents, and each result has a snippet with the matching words highlighted").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.PaginatedResponse[[]viewmodel.NoteSearchResultResponse]{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		QueryParam("q", "search text", goswag.StringType, true).
+		QueryParam("person_uuid", "only notes about the person or mentioning them", goswag.StringType, false).
+		QueryParam("author_uuid", "only notes written by the user", goswag.StringType, false).
+		QueryParam("types", "comma separated note types: one_on_one, feedback, observation", goswag.StringType, false).
+		QueryParam("from", "notes created from this date (YYYY-MM-DD or RFC3339)", goswag.StringType, false).
+		QueryParam("to", "notes created before this date (YYYY-MM-DD or RFC3339)", goswag.StringType, false).
+		QueryParam("page", "page number", goswag.NumberType, false).
+		QueryParam("quantity", "items per page", goswag.NumberType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+}
@@ -0,0 +1,126 @@
+package notetemplateroute
+
+import (
+	"sync"
+
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	noteTemplateService contract.NoteTemplateApp
+}
+
+func NewHandler(noteTemplateService contract.NoteTemplateApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			noteTemplateService: noteTemplateService,
+		}
+	})
+
+	return instance
+}
+
+func (s *Handler) handleCreateTemplate(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	input := viewmodel.NoteTemplateRequest{}
+	err := c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	template, err := s.noteTemplateService.CreateNoteTemplate(ctx, input.ToEntity(), input.IsPersonal())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.NoteTemplateResponse{}
+	response.FillFromEntity(template)
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleGetTemplates(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	templates, err := s.noteTemplateService.GetNoteTemplates(ctx, c.QueryParam("note_type"))
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.NoteTemplateResponse, len(templates))
+	for i, template := range templates {
+		response[i].FillFromEntity(template)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetTemplate(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	templateUUID, err := routeutils.GetRequiredStringPathParam(c, "template_uuid", "Invalid template_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	template, err := s.noteTemplateService.GetNoteTemplate(ctx, templateUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.NoteTemplateResponse{}
+	response.FillFromEntity(template)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleDeleteTemplate(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	templateUUID, err := routeutils.GetRequiredStringPathParam(c, "template_uuid", "Invalid template_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.noteTemplateService.DeleteNoteTemplate(ctx, templateUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleInstantiateTemplate(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	templateUUID, err := routeutils.GetRequiredStringPathParam(c, "template_uuid", "Invalid template_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.InstantiateNoteTemplateRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	instance, err := s.noteTemplateService.InstantiateNoteTemplate(ctx, templateUUID, input.PersonUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.NoteTemplateInstanceResponse{}
+	response.FillFromEntity(instance)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
@@ -0,0 +1,214 @@
+package notetemplateroute_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/notetemplateroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	companyUUID  = "company-uuid-123"
+	personUUID   = "person-uuid-123"
+	templateUUID = "template-uuid-123"
+)
+
+type noteTemplateTest struct {
+	name          string
+	body          any
+	buildMocks    func(m test.AppMocks)
+	checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+}
+
+func runNoteTemplateTests(t *testing.T, method, url string, tests []noteTemplateTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notetemplateroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			var body []byte
+			if tt.body != nil {
+				var err error
+				body, err = json.Marshal(tt.body)
+				require.NoError(t, err)
+			}
+
+			req, err := http.NewRequest(method, url, bytes.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			test.AddAuthorization(context.Background(), t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(m)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestHandler_handleCreateTemplate(t *testing.T) {
+	companyID, userID := int64(1), int64(2)
+
+	tests := []noteTemplateTest{
+		{
+			name: "Should create a personal template",
+			body: viewmodel.NoteTemplateRequest{
+				Name:     "Weekly 1:1",
+				NoteType: domain.NoteTypeOneOnOne,
+				Scope:    domain.NoteTemplateScopeUser,
+				Sections: []viewmodel.NoteTemplateSectionRequest{{Title: "Wins", Placeholder: "What went well?"}},
+			},
+			buildMocks: func(m test.AppMocks) {
+				m.NoteTemplateAppMock.EXPECT().CreateNoteTemplate(gomock.Any(), entity.NoteTemplate{
+					Name:     "Weekly 1:1",
+					NoteType: domain.NoteTypeOneOnOne,
+					Sections: []entity.NoteTemplateSection{{Title: "Wins", Placeholder: "What went well?"}},
+				}, true).Return(entity.NoteTemplate{
+					UUID:      templateUUID,
+					CompanyID: &companyID,
+					UserID:    &userID,
+					NoteType:  domain.NoteTypeOneOnOne,
+					Name:      "Weekly 1:1",
+					Sections:  []entity.NoteTemplateSection{{Title: "Wins", Placeholder: "What went well?", Position: 1}},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.NoteTemplateResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, templateUUID, response.UUID)
+				require.Equal(t, domain.NoteTemplateScopeUser, response.Scope)
+				require.Len(t, response.Sections, 1)
+				require.Equal(t, 1, response.Sections[0].Position)
+			},
+		},
+		{
+			name: "Should return error when the template is invalid",
+			body: viewmodel.NoteTemplateRequest{Name: "Weekly 1:1", NoteType: "meeting"},
+			buildMocks: func(m test.AppMocks) {
+				m.NoteTemplateAppMock.EXPECT().CreateNoteTemplate(gomock.Any(), gomock.Any(), false).
+					Return(entity.NoteTemplate{}, resterrors.NewBadRequestError("invalid note type: meeting")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	runNoteTemplateTests(t, http.MethodPost, "/companies/"+companyUUID+"/note-templates", tests)
+}
+
+func TestHandler_handleGetTemplates(t *testing.T) {
+	companyID := int64(1)
+
+	tests := []noteTemplateTest{
+		{
+			name: "Should return the templates of the note type with their usage",
+			buildMocks: func(m test.AppMocks) {
+				m.NoteTemplateAppMock.EXPECT().GetNoteTemplates(gomock.Any(), domain.NoteTypeFeedback).Return([]entity.NoteTemplate{
+					{UUID: templateUUID, CompanyID: &companyID, NoteType: domain.NoteTypeFeedback, Name: "Quarterly feedback", UsageCount: 4},
+					{UUID: "built-in-uuid", NoteType: domain.NoteTypeFeedback, Name: "SBI feedback"},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response []viewmodel.NoteTemplateResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response, 2)
+				require.Equal(t, domain.NoteTemplateScopeCompany, response[0].Scope)
+				require.Equal(t, int64(4), response[0].UsageCount)
+				require.Equal(t, domain.NoteTemplateScopeBuiltIn, response[1].Scope)
+			},
+		},
+	}
+
+	runNoteTemplateTests(t, http.MethodGet, "/companies/"+companyUUID+"/note-templates?note_type=feedback", tests)
+}
+
+func TestHandler_handleDeleteTemplate(t *testing.T) {
+	tests := []noteTemplateTest{
+		{
+			name: "Should delete the template",
+			buildMocks: func(m test.AppMocks) {
+				m.NoteTemplateAppMock.EXPECT().DeleteNoteTemplate(gomock.Any(), templateUUID).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Should return error when the template is built-in",
+			buildMocks: func(m test.AppMocks) {
+				m.NoteTemplateAppMock.EXPECT().DeleteNoteTemplate(gomock.Any(), templateUUID).
+					Return(resterrors.NewBadRequestError("built-in note templates can not be deleted")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	runNoteTemplateTests(t, http.MethodDelete, "/companies/"+companyUUID+"/note-templates/"+templateUUID, tests)
+}
+
+func TestHandler_handleInstantiateTemplate(t *testing.T) {
+	tests := []noteTemplateTest{
+		{
+			name: "Should render the template for the person",
+			body: viewmodel.InstantiateNoteTemplateRequest{PersonUUID: personUUID},
+			buildMocks: func(m test.AppMocks) {
+				m.NoteTemplateAppMock.EXPECT().InstantiateNoteTemplate(gomock.Any(), templateUUID, personUUID).Return(entity.NoteTemplateInstance{
+					Template: entity.NoteTemplate{UUID: templateUUID, NoteType: domain.NoteTypeOneOnOne},
+					Content:  "## Getting to know Maria",
+					Sections: []entity.NoteTemplateSection{{Title: "Getting to know Maria", Position: 1}},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.NoteTemplateInstanceResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, templateUUID, response.TemplateUUID)
+				require.Equal(t, domain.NoteTypeOneOnOne, response.NoteType)
+				require.Equal(t, "## Getting to know Maria", response.Content)
+				require.Len(t, response.Sections, 1)
+			},
+		},
+		{
+			name: "Should return error when the template is not found",
+			body: viewmodel.InstantiateNoteTemplateRequest{PersonUUID: personUUID},
+			buildMocks: func(m test.AppMocks) {
+				m.NoteTemplateAppMock.EXPECT().InstantiateNoteTemplate(gomock.Any(), templateUUID, personUUID).
+					Return(entity.NoteTemplateInstance{}, resterrors.NewNotFoundError("note template not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	runNoteTemplateTests(t, http.MethodPost, "/companies/"+companyUUID+"/note-templates/"+templateUUID+"/instantiate", tests)
+}
@@ -0,0 +1,94 @@
+package notetemplateroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const GroupRouteName = "companies/:company_uuid"
+
+const (
+	TemplatesRoute           = "/note-templates"
+	TemplateByUUIDRoute      = "/note-templates/:template_uuid"
+	InstantiateTemplateRoute = "/note-templates/:template_uuid/instantiate"
+)
+
+type NoteTemplateRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *NoteTemplateRouter {
+	return &NoteTemplateRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *NoteTemplateRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.CompanyGroup.Group(GroupRouteName)
+
+	router.POST(TemplatesRoute, r.ctrl.handleCreateTemplate).
+		Summary("Create note template").
+		Description("Create a note template with its sections. Company templates are shared in the company, user templates are visible only to their author. The placeholder and the content of the sections may use the variables {{person_name}}, {{person_first_name}}, {{manager_name}}, {{company_name}} and {{date}}").
+		Read(viewmodel.NoteTemplateRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.NoteTemplateResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(TemplatesRoute, r.ctrl.handleGetTemplates).
+		Summary("Get note templates").
+		Description("Get the built-in, company and personal note templates with how many notes of the company were written with each one").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.NoteTemplateResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		QueryParam("note_type", "only the templates of the note type: one_on_one, feedback or observation", goswag.StringType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(TemplateByUUIDRoute, r.ctrl.handleGetTemplate).
+		Summary("Get note template").
+		Description("Get a note template with its sections").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.NoteTemplateResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("template_uuid", "note template uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(TemplateByUUIDRoute, r.ctrl.handleDeleteTemplate).
+		Summary("Delete note template").
+		Description("Delete a company or personal note template. The notes written with it are kept. Built-in templates can not be deleted").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("template_uuid", "note template uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(InstantiateTemplateRoute, r.ctrl.handleInstantiateTemplate).
+		Summary("Instantiate note template").
+		Description("Render a note template for a person, replacing the variables of its sections. The content is ready to be the content of the note, which should be created with the template_uuid to register the template usage").
+		Read(viewmodel.InstantiateNoteTemplateRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.NoteTemplateInstanceResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("template_uuid", "note template uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+}
@@ -1,8 +1,14 @@
 package personroute
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
 	"sync"
 
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
 	"github.com/diegoclair/leaderpro/internal/domain/contract"
 	"github.com/diegoclair/leaderpro/internal/domain/entity"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
@@ -11,6 +17,12 @@ import (
 	echo "github.com/labstack/echo/v4"
 )
 
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+	maxImportFileSize  = 5 << 20 // 5MB
+)
+
 var (
 	instance *Handler
 	Once     sync.Once
@@ -79,6 +91,122 @@ func (s *Handler) handleGetCompanyPeople(c echo.Context) error {
 	return routeutils.ResponseAPIOk(c, response)
 }
 
+func (s *Handler) handleSearchPeople(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	limit = min(limit, maxSearchLimit)
+
+	results, err := s.personService.SearchPeopleRanked(ctx, c.QueryParam("q"), limit)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := []viewmodel.PersonSearchResponse{}
+	for _, result := range results {
+		item := viewmodel.PersonSearchResponse{}
+		item.FillFromEntity(result)
+		response = append(response, item)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleImportPeople(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return routeutils.HandleError(c, resterrors.NewBadRequestError("file is required"))
+	}
+
+	if fileHeader.Size > maxImportFileSize {
+		return routeutils.HandleError(c, resterrors.NewBadRequestError("file is too large, the limit is 5MB"))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return routeutils.HandleError(c, resterrors.NewBadRequestError("invalid file"))
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxImportFileSize))
+	if err != nil {
+		return routeutils.HandleError(c, resterrors.NewBadRequestError("invalid file"))
+	}
+
+	input := entity.PersonImportInput{
+		FileName: fileHeader.Filename,
+		Data:     data,
+	}
+
+	if mapping := c.FormValue("mapping"); mapping != "" {
+		err = json.Unmarshal([]byte(mapping), &input.Mapping)
+		if err != nil {
+			return routeutils.ResponseInvalidRequestBody(c, err)
+		}
+	}
+
+	input.DryRun, _ = strconv.ParseBool(c.FormValue("dry_run"))
+
+	result, err := s.personService.ImportPeople(ctx, input)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.PersonImportResponse{}
+	response.FillFromEntity(result)
+
+	if result.Import.Status == domain.PersonImportStatusPending {
+		return routeutils.ResponseAccepted(c, response)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetPeopleImport(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	importUUID, err := routeutils.GetRequiredStringPathParam(c, "import_uuid", "Invalid import_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	personImport, err := s.personService.GetPersonImport(ctx, importUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.PersonImportResponse{}
+	response.FillFromEntity(entity.PersonImportResult{Import: personImport})
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetPeopleImportReport(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	importUUID, err := routeutils.GetRequiredStringPathParam(c, "import_uuid", "Invalid import_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	personImport, err := s.personService.GetPersonImport(ctx, importUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	if personImport.Status == domain.PersonImportStatusPending {
+		return routeutils.HandleError(c, resterrors.NewConflictError("the import is still being processed"))
+	}
+
+	fileName := fmt.Sprintf("import-report-%s.csv", personImport.UUID)
+	return routeutils.ResponseFile(c, fileName, "text/csv; charset=utf-8", personImport.Report)
+}
+
 func (s *Handler) handleGetPersonByUUID(c echo.Context) error {
 	ctx := routeutils.GetContext(c)
 
@@ -171,19 +299,50 @@ func (s *Handler) handleGetPersonTimeline(c echo.Context) error {
 		return routeutils.HandleError(c, err)
 	}
 
+	from, err := routeutils.GetTimeQueryParam(c, "from")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	to, err := routeutils.GetTimeQueryParam(c, "to")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
 	// Parse filters from query parameters using routeutils helpers
 	types := routeutils.GetStringArrayQueryParam(c, "types", ",")
 	feedbackTypes := routeutils.GetStringArrayQueryParam(c, "feedback_types", ",")
 
 	filtersReq := viewmodel.TimelineFiltersRequest{
-		SearchQuery:   c.QueryParam("search_query"),
-		Types:         types,
-		FeedbackTypes: feedbackTypes,
-		Direction:     c.QueryParam("direction"),
-		Period:        c.QueryParam("period"),
+		SearchQuery:        c.QueryParam("search_query"),
+		Types:              types,
+		FeedbackTypes:      feedbackTypes,
+		Direction:          c.QueryParam("direction"),
+		Period:             c.QueryParam("period"),
+		Tags:               routeutils.GetStringArrayQueryParam(c, "tags", ","),
+		From:               from,
+		To:                 to,
+		AuthorUUID:         c.QueryParam("author_uuid"),
+		FeedbackCategories: routeutils.GetStringArrayQueryParam(c, "feedback_categories", ","),
+		Sort:               c.QueryParam("sort"),
 	}
 
 	filters := filtersReq.ToEntity()
+
+	if routeutils.IsCursorPaging(c) {
+		page, err := routeutils.GetCursorPageParams(c)
+		if err != nil {
+			return routeutils.HandleError(c, err)
+		}
+
+		timeline, info, err := s.personService.GetPersonTimelinePage(ctx, personUUID, filters, page)
+		if err != nil {
+			return routeutils.HandleError(c, err)
+		}
+
+		return routeutils.ResponseAPIOk(c, viewmodel.BuildCursorPaginatedResponse(newTimelineResponses(timeline), page, info))
+	}
+
 	take, skip := routeutils.GetPagingParams(c, "", "")
 
 	timeline, totalRecords, err := s.personService.GetPersonTimeline(ctx, personUUID, filters, take, skip)
@@ -191,16 +350,19 @@ func (s *Handler) handleGetPersonTimeline(c echo.Context) error {
 		return routeutils.HandleError(c, err)
 	}
 
+	paginatedResponse := viewmodel.BuildPaginatedResponse(newTimelineResponses(timeline), skip, take, totalRecords)
+
+	return routeutils.ResponseAPIOk(c, paginatedResponse)
+}
+
+func newTimelineResponses(timeline []entity.UnifiedTimelineEntry) []viewmodel.UnifiedTimelineResponse {
 	response := []viewmodel.UnifiedTimelineResponse{}
 	for _, entry := range timeline {
 		item := viewmodel.UnifiedTimelineResponse{}
 		item.FillFromUnifiedTimelineEntry(entry)
 		response = append(response, item)
 	}
-
-	paginatedResponse := viewmodel.BuildPaginatedResponse(response, skip, take, totalRecords)
-
-	return routeutils.ResponseAPIOk(c, paginatedResponse)
+	return response
 }
 
 func (s *Handler) handleGetPersonMentions(c echo.Context) error {
@@ -211,6 +373,20 @@ func (s *Handler) handleGetPersonMentions(c echo.Context) error {
 		return routeutils.HandleError(c, err)
 	}
 
+	if routeutils.IsCursorPaging(c) {
+		page, err := routeutils.GetCursorPageParams(c)
+		if err != nil {
+			return routeutils.HandleError(c, err)
+		}
+
+		mentions, info, err := s.personService.GetPersonMentionsPage(ctx, personUUID, page)
+		if err != nil {
+			return routeutils.HandleError(c, err)
+		}
+
+		return routeutils.ResponseAPIOk(c, viewmodel.BuildCursorPaginatedResponse(newMentionResponses(mentions), page, info))
+	}
+
 	take, skip := routeutils.GetPagingParams(c, "", "")
 
 	mentions, totalRecords, err := s.personService.GetPersonMentions(ctx, personUUID, take, skip)
@@ -218,16 +394,19 @@ func (s *Handler) handleGetPersonMentions(c echo.Context) error {
 		return routeutils.HandleError(c, err)
 	}
 
+	paginatedResponse := viewmodel.BuildPaginatedResponse(newMentionResponses(mentions), skip, take, totalRecords)
+
+	return routeutils.ResponseAPIOk(c, paginatedResponse)
+}
+
+func newMentionResponses(mentions []entity.MentionEntry) []viewmodel.MentionResponse {
 	response := []viewmodel.MentionResponse{}
 	for _, mention := range mentions {
 		item := viewmodel.MentionResponse{}
 		item.FillFromMentionEntry(mention)
 		response = append(response, item)
 	}
-
-	paginatedResponse := viewmodel.BuildPaginatedResponse(response, skip, take, totalRecords)
-
-	return routeutils.ResponseAPIOk(c, paginatedResponse)
+	return response
 }
 
 func (s *Handler) handleUpdateNote(c echo.Context) error {
@@ -280,3 +459,211 @@ func (s *Handler) handleDeleteNote(c echo.Context) error {
 
 	return routeutils.ResponseNoContent(c)
 }
+
+func (s *Handler) handleGetNoteRevisions(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	noteUUID, err := routeutils.GetRequiredStringPathParam(c, "note_uuid", "Invalid note_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	revisions, err := s.personService.GetNoteRevisions(ctx, noteUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.NoteRevisionResponse, len(revisions))
+	for i, revision := range revisions {
+		response[i].FillFromEntity(revision)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetNoteRevisionDiff(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	noteUUID, err := routeutils.GetRequiredStringPathParam(c, "note_uuid", "Invalid note_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	from, err := strconv.Atoi(c.QueryParam("from"))
+	if err != nil || from < 1 {
+		return routeutils.HandleError(c, resterrors.NewUnprocessableEntity("from must be a revision number"))
+	}
+
+	to := 0
+	if rawTo := c.QueryParam("to"); rawTo != "" {
+		to, err = strconv.Atoi(rawTo)
+		if err != nil || to < 1 {
+			return routeutils.HandleError(c, resterrors.NewBadRequestError("to must be a revision number"))
+		}
+	}
+
+	diff, err := s.personService.GetNoteRevisionDiff(ctx, noteUUID, from, to)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.NoteRevisionDiffResponse{}
+	response.FillFromEntity(diff)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleRestoreNoteRevision(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	noteUUID, err := routeutils.GetRequiredStringPathParam(c, "note_uuid", "Invalid note_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	number, err := routeutils.GetRequiredInt64PathParam(c, "revision_number", "Invalid revision_number")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	note, err := s.personService.RestoreNoteRevision(ctx, noteUUID, int(number))
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.NoteResponse{}
+	response.FillFromEntity(note)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleUndeleteNote(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	noteUUID, err := routeutils.GetRequiredStringPathParam(c, "note_uuid", "Invalid note_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	note, err := s.personService.UndeleteNote(ctx, noteUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.NoteResponse{}
+	response.FillFromEntity(note)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetPersonAttributes(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	asOf, err := routeutils.GetTimeQueryParam(c, "as_of")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	var attributes []entity.PersonAttribute
+	if asOf != nil {
+		attributes, err = s.personService.GetPersonAttributesAsOf(ctx, personUUID, *asOf)
+	} else {
+		attributes, err = s.personService.GetPersonAttributes(ctx, personUUID)
+	}
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := []viewmodel.PersonAttributeResponse{}
+	for _, attr := range attributes {
+		item := viewmodel.PersonAttributeResponse{}
+		item.FillFromEntity(attr)
+		response = append(response, item)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleUpdatePersonAttribute(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	attributeKey, err := routeutils.GetRequiredStringPathParam(c, "attribute_key", "Invalid attribute_key")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.PersonAttributeRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	attr, err := s.personService.UpdatePersonAttribute(ctx, personUUID, attributeKey, input.Value)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.PersonAttributeResponse{}
+	response.FillFromEntity(attr)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleDeletePersonAttribute(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	attributeKey, err := routeutils.GetRequiredStringPathParam(c, "attribute_key", "Invalid attribute_key")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.personService.DeletePersonAttribute(ctx, personUUID, attributeKey)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleGetPersonAttributeHistory(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	attributeKey, err := routeutils.GetRequiredStringPathParam(c, "attribute_key", "Invalid attribute_key")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	history, err := s.personService.GetPersonAttributeHistory(ctx, personUUID, attributeKey)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := []viewmodel.PersonAttributeHistoryResponse{}
+	for _, version := range history {
+		item := viewmodel.PersonAttributeHistoryResponse{}
+		item.FillFromEntity(version)
+		response = append(response, item)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
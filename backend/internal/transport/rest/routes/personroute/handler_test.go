@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/diegoclair/leaderpro/internal/domain/entity"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/personroute"
@@ -437,3 +438,472 @@ func TestHandler_handleDeletePerson(t *testing.T) {
 		})
 	}
 }
+
+func TestHandler_handleUpdatePersonAttribute(t *testing.T) {
+	type args struct {
+		companyUUID  string
+		personUUID   string
+		attributeKey string
+		body         any
+	}
+
+	confidence := 1.0
+
+	tests := []struct {
+		name          string
+		args          args
+		buildMocks    func(ctx context.Context, m test.AppMocks, args args)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Should complete request with no error",
+			args: args{
+				companyUUID:  "company-uuid-123",
+				personUUID:   "person-uuid-456",
+				attributeKey: "hobbies",
+				body:         viewmodel.PersonAttributeRequest{Value: "running"},
+			},
+			buildMocks: func(ctx context.Context, m test.AppMocks, args args) {
+				m.PersonAppMock.EXPECT().UpdatePersonAttribute(ctx, args.personUUID, args.attributeKey, "running").Return(entity.PersonAttribute{
+					AttributeKey:   "hobbies",
+					AttributeValue: "running",
+					Source:         "manual",
+					Confidence:     &confidence,
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.PersonAttributeResponse
+				err := json.Unmarshal(recorder.Body.Bytes(), &response)
+				require.NoError(t, err)
+				require.Equal(t, "hobbies", response.Key)
+				require.Equal(t, "running", response.Value)
+				require.Equal(t, "manual", response.Source)
+				require.NotNil(t, response.Confidence)
+				require.Equal(t, 1.0, *response.Confidence)
+			},
+		},
+		{
+			name: "Should return error with invalid body",
+			args: args{
+				companyUUID:  "company-uuid-123",
+				personUUID:   "person-uuid-456",
+				attributeKey: "hobbies",
+				body:         "invalid body",
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "Should return error when update fails",
+			args: args{
+				companyUUID:  "company-uuid-123",
+				personUUID:   "person-uuid-456",
+				attributeKey: "hobbies",
+				body:         viewmodel.PersonAttributeRequest{Value: "running"},
+			},
+			buildMocks: func(ctx context.Context, m test.AppMocks, args args) {
+				m.PersonAppMock.EXPECT().UpdatePersonAttribute(ctx, args.personUUID, args.attributeKey, "running").Return(entity.PersonAttribute{}, fmt.Errorf("error to update attribute")).Times(1)
+			},
+			checkResponse: func(t *testing.T, resp *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, resp.Code)
+				require.Contains(t, resp.Body.String(), "error to update attribute")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			personroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			body, err := json.Marshal(tt.args.body)
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/companies/%s/people/%s/attributes/%s", tt.args.companyUUID, tt.args.personUUID, tt.args.attributeKey)
+
+			req, err := http.NewRequest(http.MethodPut, url, bytes.NewBuffer(body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", echo.MIMEApplicationJSON)
+
+			ctx := test.GetTestContext(t, req, recorder, true)
+
+			test.AddAuthorization(ctx, t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), tt.args.companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(ctx, m, tt.args)
+			}
+
+			server.Echo().ServeHTTP(recorder, req)
+			if tt.checkResponse != nil {
+				tt.checkResponse(t, recorder)
+			}
+		})
+	}
+}
+
+func TestHandler_handleDeletePersonAttribute(t *testing.T) {
+	type args struct {
+		companyUUID  string
+		personUUID   string
+		attributeKey string
+	}
+
+	tests := []struct {
+		name          string
+		args          args
+		buildMocks    func(ctx context.Context, m test.AppMocks, args args)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Should complete request with no error",
+			args: args{
+				companyUUID:  "company-uuid-123",
+				personUUID:   "person-uuid-456",
+				attributeKey: "hobbies",
+			},
+			buildMocks: func(ctx context.Context, m test.AppMocks, args args) {
+				m.PersonAppMock.EXPECT().DeletePersonAttribute(ctx, args.personUUID, args.attributeKey).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Should return error when delete fails",
+			args: args{
+				companyUUID:  "company-uuid-123",
+				personUUID:   "person-uuid-456",
+				attributeKey: "hobbies",
+			},
+			buildMocks: func(ctx context.Context, m test.AppMocks, args args) {
+				m.PersonAppMock.EXPECT().DeletePersonAttribute(ctx, args.personUUID, args.attributeKey).Return(fmt.Errorf("error to delete attribute")).Times(1)
+			},
+			checkResponse: func(t *testing.T, resp *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, resp.Code)
+				require.Contains(t, resp.Body.String(), "error to delete attribute")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			personroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/companies/%s/people/%s/attributes/%s", tt.args.companyUUID, tt.args.personUUID, tt.args.attributeKey)
+
+			req, err := http.NewRequest(http.MethodDelete, url, nil)
+			require.NoError(t, err)
+
+			ctx := test.GetTestContext(t, req, recorder, true)
+
+			test.AddAuthorization(ctx, t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), tt.args.companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(ctx, m, tt.args)
+			}
+
+			server.Echo().ServeHTTP(recorder, req)
+			if tt.checkResponse != nil {
+				tt.checkResponse(t, recorder)
+			}
+		})
+	}
+}
+
+func TestHandler_handleGetNoteRevisionDiff(t *testing.T) {
+	type args struct {
+		companyUUID string
+		personUUID  string
+		noteUUID    string
+		query       string
+	}
+
+	tests := []struct {
+		name          string
+		args          args
+		buildMocks    func(ctx context.Context, m test.AppMocks, args args)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Should compare the revision with the latest one",
+			args: args{
+				companyUUID: "company-uuid-123",
+				personUUID:  "person-uuid-456",
+				noteUUID:    "note-uuid-789",
+				query:       "from=1",
+			},
+			buildMocks: func(ctx context.Context, m test.AppMocks, args args) {
+				m.PersonAppMock.EXPECT().GetNoteRevisionDiff(gomock.Any(), args.noteUUID, 1, 0).Return(entity.NoteRevisionDiff{
+					From:          entity.NoteRevision{Number: 1, Content: "Shipped v1"},
+					To:            entity.NoteRevision{Number: 2, Content: "Shipped v2"},
+					ChangedFields: []string{},
+					Lines: []entity.NoteDiffLine{
+						{Op: "removed", Text: "Shipped v1"},
+						{Op: "added", Text: "Shipped v2"},
+					},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.NoteRevisionDiffResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, 1, response.From.Number)
+				require.Equal(t, 2, response.To.Number)
+				require.Len(t, response.Lines, 2)
+				require.Equal(t, "added", response.Lines[1].Op)
+			},
+		},
+		{
+			name: "Should return error when from is missing",
+			args: args{
+				companyUUID: "company-uuid-123",
+				personUUID:  "person-uuid-456",
+				noteUUID:    "note-uuid-789",
+				query:       "to=2",
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			personroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/companies/%s/people/%s/notes/%s/revisions/diff?%s", tt.args.companyUUID, tt.args.personUUID, tt.args.noteUUID, tt.args.query)
+
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			ctx := test.GetTestContext(t, req, recorder, true)
+
+			test.AddAuthorization(ctx, t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), tt.args.companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(ctx, m, tt.args)
+			}
+
+			server.Echo().ServeHTTP(recorder, req)
+			if tt.checkResponse != nil {
+				tt.checkResponse(t, recorder)
+			}
+		})
+	}
+}
+
+func TestHandler_handleRestoreNoteRevision(t *testing.T) {
+	type args struct {
+		companyUUID    string
+		personUUID     string
+		noteUUID       string
+		revisionNumber string
+	}
+
+	tests := []struct {
+		name          string
+		args          args
+		buildMocks    func(ctx context.Context, m test.AppMocks, args args)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Should restore the revision",
+			args: args{
+				companyUUID:    "company-uuid-123",
+				personUUID:     "person-uuid-456",
+				noteUUID:       "note-uuid-789",
+				revisionNumber: "2",
+			},
+			buildMocks: func(ctx context.Context, m test.AppMocks, args args) {
+				m.PersonAppMock.EXPECT().RestoreNoteRevision(gomock.Any(), args.noteUUID, 2).Return(entity.Note{
+					UUID:    args.noteUUID,
+					Type:    "observation",
+					Content: "Shipped v1",
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.NoteResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, "note-uuid-789", response.UUID)
+				require.Equal(t, "Shipped v1", response.Content)
+			},
+		},
+		{
+			name: "Should return error when the revision number is invalid",
+			args: args{
+				companyUUID:    "company-uuid-123",
+				personUUID:     "person-uuid-456",
+				noteUUID:       "note-uuid-789",
+				revisionNumber: "abc",
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			personroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/companies/%s/people/%s/notes/%s/revisions/%s/restore", tt.args.companyUUID, tt.args.personUUID, tt.args.noteUUID, tt.args.revisionNumber)
+
+			req, err := http.NewRequest(http.MethodPost, url, nil)
+			require.NoError(t, err)
+
+			ctx := test.GetTestContext(t, req, recorder, true)
+
+			test.AddAuthorization(ctx, t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), tt.args.companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(ctx, m, tt.args)
+			}
+
+			server.Echo().ServeHTTP(recorder, req)
+			if tt.checkResponse != nil {
+				tt.checkResponse(t, recorder)
+			}
+		})
+	}
+}
+
+func TestHandler_handleGetPersonTimeline(t *testing.T) {
+	type args struct {
+		companyUUID string
+		personUUID  string
+		query       string
+	}
+
+	next := entity.PageCursor{CreatedAt: time.Date(2025, time.March, 5, 10, 0, 0, 0, time.UTC), ID: 7}
+	totalRecords := int64(2)
+
+	tests := []struct {
+		name          string
+		args          args
+		buildMocks    func(ctx context.Context, m test.AppMocks, args args)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name: "Should page by page number with the filters",
+			args: args{
+				companyUUID: "company-uuid-123",
+				personUUID:  "person-uuid-456",
+				query:       "page=2&quantity=5&direction=from-person&feedback_categories=skill,behavior&sort=oldest&from=2025-01-01",
+			},
+			buildMocks: func(ctx context.Context, m test.AppMocks, args args) {
+				m.PersonAppMock.EXPECT().GetPersonTimeline(gomock.Any(), args.personUUID, gomock.Any(), int64(5), int64(5)).
+					DoAndReturn(func(ctx context.Context, personUUID string, filters entity.TimelineFilters, take, skip int64) ([]entity.UnifiedTimelineEntry, int64, error) {
+						require.Equal(t, "from-person", filters.Direction)
+						require.Equal(t, []string{"skill", "behavior"}, filters.FeedbackCategories)
+						require.Equal(t, "oldest", filters.Sort)
+						require.Equal(t, time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), *filters.From)
+						return []entity.UnifiedTimelineEntry{{UUID: "note-uuid-1", Type: "feedback"}}, 6, nil
+					}).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.PaginatedResponse[[]viewmodel.UnifiedTimelineResponse]
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Nil(t, response.CursorPagination)
+				require.Equal(t, int64(2), response.Pagination.CurrentPage)
+				require.Equal(t, int64(6), response.Pagination.TotalRecords)
+				require.Len(t, response.List, 1)
+			},
+		},
+		{
+			name: "Should page by cursor when the cursor is sent",
+			args: args{
+				companyUUID: "company-uuid-123",
+				personUUID:  "person-uuid-456",
+				query:       "cursor=&quantity=1&with_count=true",
+			},
+			buildMocks: func(ctx context.Context, m test.AppMocks, args args) {
+				m.PersonAppMock.EXPECT().GetPersonTimelinePage(gomock.Any(), args.personUUID, gomock.Any(), entity.CursorPage{Limit: 1, WithCount: true}).
+					Return([]entity.UnifiedTimelineEntry{{UUID: "note-uuid-1", Type: "observation"}}, entity.CursorPageInfo{Next: &next, TotalRecords: &totalRecords}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.PaginatedResponse[[]viewmodel.UnifiedTimelineResponse]
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Nil(t, response.Pagination)
+				require.True(t, response.CursorPagination.HasMore)
+				require.Equal(t, next.Encode(), response.CursorPagination.NextCursor)
+				require.Equal(t, int64(2), *response.CursorPagination.TotalRecords)
+				require.Len(t, response.List, 1)
+			},
+		},
+		{
+			name: "Should return error when the cursor is invalid",
+			args: args{
+				companyUUID: "company-uuid-123",
+				personUUID:  "person-uuid-456",
+				query:       "cursor=invalid",
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+		{
+			name: "Should return error when the date range is invalid",
+			args: args{
+				companyUUID: "company-uuid-123",
+				personUUID:  "person-uuid-456",
+				query:       "to=31/12/2025",
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			personroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			recorder := httptest.NewRecorder()
+			url := fmt.Sprintf("/companies/%s/people/%s/timeline?%s", tt.args.companyUUID, tt.args.personUUID, tt.args.query)
+
+			req, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			ctx := test.GetTestContext(t, req, recorder, true)
+
+			test.AddAuthorization(ctx, t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), tt.args.companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(ctx, m, tt.args)
+			}
+
+			server.Echo().ServeHTTP(recorder, req)
+			if tt.checkResponse != nil {
+				tt.checkResponse(t, recorder)
+			}
+		})
+	}
+}
@@ -5,20 +5,31 @@ import (
 
 	"github.com/diegoclair/goswag"
 	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
-	"github.com/diegoclair/leaderpro/infra"
 )
 
 const GroupRouteName = "companies/:company_uuid/people"
 
 const (
-	RootRoute            = ""
-	PersonByUUIDRoute    = "/:person_uuid"
-	PersonNotesRoute     = "/:person_uuid/notes"
-	PersonNoteByUUIDRoute = "/:person_uuid/notes/:note_uuid"
-	PersonTimelineRoute  = "/:person_uuid/timeline"
-	PersonMentionsRoute  = "/:person_uuid/mentions"
+	RootRoute                   = ""
+	PersonByUUIDRoute           = "/:person_uuid"
+	PeopleSearchRoute           = "/search"
+	PeopleImportRoute           = "/import"
+	PeopleImportByUUIDRoute     = "/imports/:import_uuid"
+	PeopleImportReportRoute     = "/imports/:import_uuid/report"
+	PersonNotesRoute            = "/:person_uuid/notes"
+	PersonNoteByUUIDRoute       = "/:person_uuid/notes/:note_uuid"
+	NoteRevisionsRoute          = "/:person_uuid/notes/:note_uuid/revisions"
+	NoteRevisionDiffRoute       = "/:person_uuid/notes/:note_uuid/revisions/diff"
+	NoteRevisionRestoreRoute    = "/:person_uuid/notes/:note_uuid/revisions/:revision_number/restore"
+	NoteUndeleteRoute           = "/:person_uuid/notes/:note_uuid/undelete"
+	PersonTimelineRoute         = "/:person_uuid/timeline"
+	PersonMentionsRoute         = "/:person_uuid/mentions"
+	PersonAttributesRoute       = "/:person_uuid/attributes"
+	PersonAttributeByKeyRoute   = "/:person_uuid/attributes/:attribute_key"
+	PersonAttributeHistoryRoute = "/:person_uuid/attributes/:attribute_key/history"
 )
 
 type PersonRouter struct {
@@ -55,6 +66,58 @@ func (r *PersonRouter) RegisterRoutes(g *routeutils.EchoGroups) {
 		QueryParam("search", "search term to filter people", goswag.StringType, false).
 		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
 
+	router.POST(PeopleImportRoute, r.ctrl.handleImportPeople).
+		Summary("Import people").
+		Description("Import people in bulk from a CSV or XLSX file (multipart field \"file\"). The optional \"mapping\" field is a JSON object of person field to column header, when empty the columns are mapped by their header names. Fields: name, email, position, department, phone, birthday, start_date, is_manager, manager_email, gender, notes, city, state, country. Nothing is created when any row is invalid, and with dry_run=true the file is only validated. A valid file returns 202 with the pending import, its people are created in background").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusAccepted,
+				Body:       viewmodel.PersonImportResponse{},
+			},
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.PersonImportResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		QueryParam("dry_run", "only validate the file, without creating people", goswag.BoolType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(PeopleImportByUUIDRoute, r.ctrl.handleGetPeopleImport).
+		Summary("Get people import").
+		Description("Get the status and the totals of an import, pending until its people are created").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.PersonImportResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("import_uuid", "import uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(PeopleImportReportRoute, r.ctrl.handleGetPeopleImportReport).
+		Summary("Download people import report").
+		Description("Download the CSV report with the result of each row of an import, available once the import is processed").
+		Returns([]models.ReturnType{{StatusCode: http.StatusOK}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("import_uuid", "import uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(PeopleSearchRoute, r.ctrl.handleSearchPeople).
+		Summary("Search people").
+		Description("Accent and case insensitive search, tolerant to typos, across name, email, position, department and attributes. Results are ranked by relevance. Used by the @mention autocomplete").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.PersonSearchResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		QueryParam("q", "search term", goswag.StringType, true).
+		QueryParam("limit", "max number of results (default 10, max 50)", goswag.NumberType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
 	router.GET(PersonByUUIDRoute, r.ctrl.handleGetPersonByUUID).
 		Summary("Get person by UUID").
 		Description("Get person details by UUID").
@@ -96,7 +159,7 @@ func (r *PersonRouter) RegisterRoutes(g *routeutils.EchoGroups) {
 
 	router.GET(PersonTimelineRoute, r.ctrl.handleGetPersonTimeline).
 		Summary("Get person timeline").
-		Description("Get timeline of direct notes for a person (1:1s and observations, excluding feedbacks/mentions)").
+		Description("Get the timeline of a person: the notes about them and the mentions of them in other notes").
 		Returns([]models.ReturnType{
 			{
 				StatusCode: http.StatusOK,
@@ -105,8 +168,21 @@ func (r *PersonRouter) RegisterRoutes(g *routeutils.EchoGroups) {
 		}).
 		PathParam("company_uuid", "company uuid", goswag.StringType, true).
 		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		QueryParam("search_query", "text searched in the content, author, feedback type and category", goswag.StringType, false).
+		QueryParam("types", "comma separated types: one_on_one, feedback, observation, mention", goswag.StringType, false).
+		QueryParam("feedback_types", "comma separated feedback types: positive, constructive, neutral", goswag.StringType, false).
+		QueryParam("feedback_categories", "comma separated feedback categories: performance, behavior, skill, collaboration", goswag.StringType, false).
+		QueryParam("direction", "all, about-person (feedback received), from-person (feedback given about others) or bilateral", goswag.StringType, false).
+		QueryParam("author_uuid", "only notes written by the user", goswag.StringType, false).
+		QueryParam("period", "7d, 30d, 3m, 6m, 1y or all, ignored when from or to is given", goswag.StringType, false).
+		QueryParam("from", "notes created from this date (YYYY-MM-DD or RFC3339)", goswag.StringType, false).
+		QueryParam("to", "notes created before this date (YYYY-MM-DD or RFC3339)", goswag.StringType, false).
+		QueryParam("sort", "newest (default) or oldest", goswag.StringType, false).
+		QueryParam("tags", "comma separated tags, only notes with any of them", goswag.StringType, false).
 		QueryParam("page", "page number", goswag.NumberType, false).
 		QueryParam("quantity", "items per page", goswag.NumberType, false).
+		QueryParam("cursor", "next_cursor of the previous page, empty for the first page; pages by cursor instead of page", goswag.StringType, false).
+		QueryParam("with_count", "also return the total records when paging by cursor", goswag.BoolType, false).
 		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
 
 	router.GET(PersonMentionsRoute, r.ctrl.handleGetPersonMentions).
@@ -122,6 +198,8 @@ func (r *PersonRouter) RegisterRoutes(g *routeutils.EchoGroups) {
 		PathParam("person_uuid", "person uuid", goswag.StringType, true).
 		QueryParam("page", "page number", goswag.NumberType, false).
 		QueryParam("quantity", "items per page", goswag.NumberType, false).
+		QueryParam("cursor", "next_cursor of the previous page, empty for the first page; pages by cursor instead of page", goswag.StringType, false).
+		QueryParam("with_count", "also return the total records when paging by cursor", goswag.BoolType, false).
 		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
 
 	router.PUT(PersonNoteByUUIDRoute, r.ctrl.handleUpdateNote).
@@ -142,4 +220,115 @@ func (r *PersonRouter) RegisterRoutes(g *routeutils.EchoGroups) {
 		PathParam("person_uuid", "person uuid", goswag.StringType, true).
 		PathParam("note_uuid", "note uuid", goswag.StringType, true).
 		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
-}
\ No newline at end of file
+
+	router.GET(NoteRevisionsRoute, r.ctrl.handleGetNoteRevisions).
+		Summary("Get note revisions").
+		Description("Get every revision of a note, newest first. Each edit or restore of the note creates a revision and the latest one is the current content").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.NoteRevisionResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		PathParam("note_uuid", "note uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(NoteRevisionDiffRoute, r.ctrl.handleGetNoteRevisionDiff).
+		Summary("Diff note revisions").
+		Description("Compare two revisions of a note line by line, listing the other fields that changed").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.NoteRevisionDiffResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		PathParam("note_uuid", "note uuid", goswag.StringType, true).
+		QueryParam("from", "number of the older revision", goswag.NumberType, true).
+		QueryParam("to", "number of the newer revision, defaults to the latest one", goswag.NumberType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(NoteRevisionRestoreRoute, r.ctrl.handleRestoreNoteRevision).
+		Summary("Restore note revision").
+		Description("Restore the content of an older revision of a note. The restore creates a new revision, so the history is kept, and the mentions of the note are rebuilt").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.NoteResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		PathParam("note_uuid", "note uuid", goswag.StringType, true).
+		PathParam("revision_number", "number of the revision to restore", goswag.NumberType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(NoteUndeleteRoute, r.ctrl.handleUndeleteNote).
+		Summary("Undelete a note").
+		Description("Restore a deleted note, up to 30 days after it was deleted, with its mentions").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.NoteResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		PathParam("note_uuid", "note uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(PersonAttributesRoute, r.ctrl.handleGetPersonAttributes).
+		Summary("Get person attributes").
+		Description("Get the attributes of a person with their source, originating note and confidence. Use as_of to get the values valid at a given date").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.PersonAttributeResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		QueryParam("as_of", "date (YYYY-MM-DD or RFC3339) to get the attribute values at", goswag.StringType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.PUT(PersonAttributeByKeyRoute, r.ctrl.handleUpdatePersonAttribute).
+		Summary("Set a person attribute").
+		Description("Manually create or update a person attribute. Manual values are never overwritten by the AI extraction").
+		Read(viewmodel.PersonAttributeRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.PersonAttributeResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		PathParam("attribute_key", "attribute key", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(PersonAttributeByKeyRoute, r.ctrl.handleDeletePersonAttribute).
+		Summary("Delete a person attribute").
+		Description("Delete a person attribute by key").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		PathParam("attribute_key", "attribute key", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(PersonAttributeHistoryRoute, r.ctrl.handleGetPersonAttributeHistory).
+		Summary("Get person attribute history").
+		Description("Get every value an attribute had, with its source, originating note and validity period, newest first").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.PersonAttributeHistoryResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		PathParam("attribute_key", "attribute key", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+}
@@ -0,0 +1,57 @@
+package reminderroute
+
+import (
+	"strconv"
+	"sync"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	reminderService contract.ReminderApp
+}
+
+func NewHandler(reminderService contract.ReminderApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			reminderService: reminderService,
+		}
+	})
+
+	return instance
+}
+
+func (s *Handler) handleGetUpcomingReminders(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	days := 0
+	if rawDays := c.QueryParam("days"); rawDays != "" {
+		var err error
+		days, err = strconv.Atoi(rawDays)
+		if err != nil || days < 1 {
+			return routeutils.HandleError(c, resterrors.NewBadRequestError("days must be a positive number"))
+		}
+	}
+
+	reminders, err := s.reminderService.GetUpcomingReminders(ctx, days)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.PersonReminderResponse, len(reminders))
+	for i, reminder := range reminders {
+		response[i].FillFromEntity(reminder)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
@@ -0,0 +1,113 @@
+package reminderroute_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/reminderroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const companyUUID = "company-uuid-123"
+
+func TestHandler_handleGetUpcomingReminders(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		buildMocks    func(m test.AppMocks)
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "Should return the upcoming reminders",
+			query: "?days=14",
+			buildMocks: func(m test.AppMocks) {
+				m.ReminderAppMock.EXPECT().GetUpcomingReminders(gomock.Any(), 14).Return([]entity.PersonReminder{
+					{
+						Type:       domain.ReminderTypeWorkAnniversary,
+						PersonUUID: "person-uuid-1",
+						PersonName: "Maria Silva",
+						Date:       time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC),
+						DaysUntil:  5,
+						Years:      3,
+					},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response []viewmodel.PersonReminderResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, []viewmodel.PersonReminderResponse{
+					{
+						Type:       domain.ReminderTypeWorkAnniversary,
+						PersonUUID: "person-uuid-1",
+						PersonName: "Maria Silva",
+						Date:       "2025-03-03",
+						DaysUntil:  5,
+						Years:      3,
+					},
+				}, response)
+			},
+		},
+		{
+			name: "Should use the default window when days is not sent",
+			buildMocks: func(m test.AppMocks) {
+				m.ReminderAppMock.EXPECT().GetUpcomingReminders(gomock.Any(), 0).Return([]entity.PersonReminder{}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.JSONEq(t, "[]", recorder.Body.String())
+			},
+		},
+		{
+			name:  "Should return bad request when days is invalid",
+			query: "?days=abc",
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "Should return error when the service fails",
+			query: "?days=7",
+			buildMocks: func(m test.AppMocks) {
+				m.ReminderAppMock.EXPECT().GetUpcomingReminders(gomock.Any(), 7).Return(nil, fmt.Errorf("error to get reminders")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reminderroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			req, err := http.NewRequest(http.MethodGet, "/companies/"+companyUUID+"/reminders"+tt.query, nil)
+			require.NoError(t, err)
+
+			test.AddAuthorization(context.Background(), t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(m)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package reminderroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const GroupRouteName = "companies/:company_uuid/reminders"
+
+const (
+	RootRoute = ""
+)
+
+type ReminderRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *ReminderRouter {
+	return &ReminderRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *ReminderRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.CompanyGroup.Group(GroupRouteName)
+
+	router.GET(RootRoute, r.ctrl.handleGetUpcomingReminders).
+		Summary("Get upcoming reminders").
+		Description("Get the upcoming birthdays and work anniversaries of the company people, computed in the logged user timezone").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.PersonReminderResponse{},
+			},
+		}).
+		PathParam("company_uuid", "Company UUID", goswag.StringType, true).
+		QueryParam("days", "Number of days ahead to look for reminders (default 7, max 90)", goswag.IntType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+}
@@ -0,0 +1,340 @@
+package reviewroute
+
+import (
+	"sync"
+
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	reviewService contract.ReviewApp
+}
+
+func NewHandler(reviewService contract.ReviewApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			reviewService: reviewService,
+		}
+	})
+
+	return instance
+}
+
+func reviewsResponse(reviews []entity.Review) []viewmodel.ReviewResponse {
+	response := make([]viewmodel.ReviewResponse, len(reviews))
+	for i, review := range reviews {
+		response[i].FillFromEntity(review)
+	}
+	return response
+}
+
+func (s *Handler) handleCreateReviewTemplate(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	input := viewmodel.ReviewTemplateRequest{}
+	err := c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	template, err := s.reviewService.CreateReviewTemplate(ctx, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.ReviewTemplateResponse{}
+	response.FillFromEntity(template)
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleGetReviewTemplates(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	templates, err := s.reviewService.GetReviewTemplates(ctx)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.ReviewTemplateResponse, len(templates))
+	for i, template := range templates {
+		response[i].FillFromEntity(template)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetReviewTemplate(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	templateUUID, err := routeutils.GetRequiredStringPathParam(c, "template_uuid", "Invalid template_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	template, err := s.reviewService.GetReviewTemplate(ctx, templateUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.ReviewTemplateResponse{}
+	response.FillFromEntity(template)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleDeleteReviewTemplate(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	templateUUID, err := routeutils.GetRequiredStringPathParam(c, "template_uuid", "Invalid template_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.reviewService.DeleteReviewTemplate(ctx, templateUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleCreateReviewCycle(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	input := viewmodel.ReviewCycleRequest{}
+	err := c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	cycle, err := s.reviewService.CreateReviewCycle(ctx, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.ReviewCycleResponse{}
+	response.FillFromEntity(cycle)
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleGetReviewCycles(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	cycles, err := s.reviewService.GetReviewCycles(ctx)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.ReviewCycleResponse, len(cycles))
+	for i, cycle := range cycles {
+		response[i].FillFromEntity(cycle)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetReviewCycle(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	cycleUUID, err := routeutils.GetRequiredStringPathParam(c, "cycle_uuid", "Invalid cycle_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	cycle, err := s.reviewService.GetReviewCycle(ctx, cycleUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.ReviewCycleResponse{}
+	response.FillFromEntity(cycle)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleDeleteReviewCycle(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	cycleUUID, err := routeutils.GetRequiredStringPathParam(c, "cycle_uuid", "Invalid cycle_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.reviewService.DeleteReviewCycle(ctx, cycleUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleCreateCycleReviews(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	cycleUUID, err := routeutils.GetRequiredStringPathParam(c, "cycle_uuid", "Invalid cycle_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.CreateCycleReviewsRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	reviews, err := s.reviewService.CreateCycleReviews(ctx, cycleUUID, input.PersonUUIDs)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseCreated(c, reviewsResponse(reviews))
+}
+
+func (s *Handler) handleGetCycleReviews(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	cycleUUID, err := routeutils.GetRequiredStringPathParam(c, "cycle_uuid", "Invalid cycle_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	reviews, err := s.reviewService.GetCycleReviews(ctx, cycleUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseAPIOk(c, reviewsResponse(reviews))
+}
+
+func (s *Handler) handleGetPersonReviews(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	personUUID, err := routeutils.GetRequiredStringPathParam(c, "person_uuid", "Invalid person_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	reviews, err := s.reviewService.GetPersonReviews(ctx, personUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseAPIOk(c, reviewsResponse(reviews))
+}
+
+func (s *Handler) handleGetReview(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	reviewUUID, err := routeutils.GetRequiredStringPathParam(c, "review_uuid", "Invalid review_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	review, err := s.reviewService.GetReview(ctx, reviewUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.ReviewResponse{}
+	response.FillFromEntity(review)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleUpdateReview(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	reviewUUID, err := routeutils.GetRequiredStringPathParam(c, "review_uuid", "Invalid review_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.UpdateReviewRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	err = s.reviewService.UpdateReview(ctx, reviewUUID, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleDeleteReview(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	reviewUUID, err := routeutils.GetRequiredStringPathParam(c, "review_uuid", "Invalid review_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.reviewService.DeleteReview(ctx, reviewUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleSubmitReview(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	reviewUUID, err := routeutils.GetRequiredStringPathParam(c, "review_uuid", "Invalid review_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.reviewService.SubmitReview(ctx, reviewUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleShareReview(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	reviewUUID, err := routeutils.GetRequiredStringPathParam(c, "review_uuid", "Invalid review_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.reviewService.ShareReview(ctx, reviewUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleReopenReview(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	reviewUUID, err := routeutils.GetRequiredStringPathParam(c, "review_uuid", "Invalid review_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.reviewService.ReopenReview(ctx, reviewUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
@@ -0,0 +1,344 @@
+package reviewroute_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/reviewroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	companyUUID  = "company-uuid-123"
+	personUUID   = "person-uuid-123"
+	templateUUID = "template-uuid-123"
+	cycleUUID    = "cycle-uuid-123"
+	reviewUUID   = "review-uuid-123"
+)
+
+type reviewTest struct {
+	name          string
+	body          any
+	buildMocks    func(m test.AppMocks)
+	checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+}
+
+func runReviewTests(t *testing.T, method, url string, tests []reviewTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reviewroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			var body []byte
+			if tt.body != nil {
+				var err error
+				body, err = json.Marshal(tt.body)
+				require.NoError(t, err)
+			}
+
+			req, err := http.NewRequest(method, url, bytes.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			test.AddAuthorization(context.Background(), t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(m)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func intPointer(value int) *int {
+	return &value
+}
+
+func TestHandler_handleCreateReviewTemplate(t *testing.T) {
+	tests := []reviewTest{
+		{
+			name: "Should create the template with its competencies and rating scale",
+			body: viewmodel.ReviewTemplateRequest{
+				Name:         "Engineering review",
+				Competencies: []viewmodel.ReviewCompetencyRequest{{Name: "Delivery"}},
+				RatingLevels: []viewmodel.ReviewRatingLevelRequest{{Value: 1, Label: "Below"}, {Value: 2, Label: "Meets"}},
+			},
+			buildMocks: func(m test.AppMocks) {
+				m.ReviewAppMock.EXPECT().CreateReviewTemplate(gomock.Any(), entity.ReviewTemplate{
+					Name:         "Engineering review",
+					Competencies: []entity.ReviewCompetency{{Name: "Delivery"}},
+					RatingLevels: []entity.ReviewRatingLevel{{Value: 1, Label: "Below"}, {Value: 2, Label: "Meets"}},
+				}).Return(entity.ReviewTemplate{
+					UUID:         templateUUID,
+					Name:         "Engineering review",
+					Competencies: []entity.ReviewCompetency{{UUID: "competency-uuid", Name: "Delivery", Position: 1}},
+					RatingLevels: []entity.ReviewRatingLevel{{Value: 1, Label: "Below"}, {Value: 2, Label: "Meets"}},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.ReviewTemplateResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, templateUUID, response.UUID)
+				require.Len(t, response.Competencies, 1)
+				require.Equal(t, 1, response.Competencies[0].Position)
+				require.Len(t, response.RatingLevels, 2)
+			},
+		},
+		{
+			name: "Should return error when the rating scale is invalid",
+			body: viewmodel.ReviewTemplateRequest{
+				Name:         "Engineering review",
+				Competencies: []viewmodel.ReviewCompetencyRequest{{Name: "Delivery"}},
+				RatingLevels: []viewmodel.ReviewRatingLevelRequest{{Value: 1, Label: "Below"}, {Value: 1, Label: "Meets"}},
+			},
+			buildMocks: func(m test.AppMocks) {
+				m.ReviewAppMock.EXPECT().CreateReviewTemplate(gomock.Any(), gomock.Any()).
+					Return(entity.ReviewTemplate{}, resterrors.NewBadRequestError("rating level value 1 is repeated")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	runReviewTests(t, http.MethodPost, "/companies/"+companyUUID+"/review-templates", tests)
+}
+
+func TestHandler_handleCreateReviewCycle(t *testing.T) {
+	periodStart := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	periodEnd := time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []reviewTest{
+		{
+			name: "Should create the cycle",
+			body: viewmodel.ReviewCycleRequest{Name: "H1 2025", TemplateUUID: templateUUID, PeriodStart: periodStart, PeriodEnd: periodEnd},
+			buildMocks: func(m test.AppMocks) {
+				m.ReviewAppMock.EXPECT().CreateReviewCycle(gomock.Any(), entity.ReviewCycle{
+					Name:         "H1 2025",
+					TemplateUUID: templateUUID,
+					PeriodStart:  periodStart,
+					PeriodEnd:    periodEnd,
+				}).Return(entity.ReviewCycle{
+					UUID:         cycleUUID,
+					Name:         "H1 2025",
+					TemplateUUID: templateUUID,
+					TemplateName: "Engineering review",
+					PeriodStart:  periodStart,
+					PeriodEnd:    periodEnd,
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.ReviewCycleResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, cycleUUID, response.UUID)
+				require.Equal(t, "2025-01-01", response.PeriodStart)
+				require.Equal(t, "2025-06-30", response.PeriodEnd)
+				require.Nil(t, response.DueDate)
+			},
+		},
+		{
+			name: "Should return error when the template is not found",
+			body: viewmodel.ReviewCycleRequest{Name: "H1 2025", TemplateUUID: "other", PeriodStart: periodStart, PeriodEnd: periodEnd},
+			buildMocks: func(m test.AppMocks) {
+				m.ReviewAppMock.EXPECT().CreateReviewCycle(gomock.Any(), gomock.Any()).
+					Return(entity.ReviewCycle{}, resterrors.NewNotFoundError("review template not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	runReviewTests(t, http.MethodPost, "/companies/"+companyUUID+"/review-cycles", tests)
+}
+
+func TestHandler_handleCreateCycleReviews(t *testing.T) {
+	tests := []reviewTest{
+		{
+			name: "Should create the reviews of the people",
+			body: viewmodel.CreateCycleReviewsRequest{PersonUUIDs: []string{personUUID}},
+			buildMocks: func(m test.AppMocks) {
+				m.ReviewAppMock.EXPECT().CreateCycleReviews(gomock.Any(), cycleUUID, []string{personUUID}).Return([]entity.Review{
+					{UUID: reviewUUID, CycleUUID: cycleUUID, PersonUUID: personUUID, Status: domain.ReviewStatusDraft},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response []viewmodel.ReviewResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response, 1)
+				require.Equal(t, domain.ReviewStatusDraft, response[0].Status)
+				require.Nil(t, response[0].Evidence)
+			},
+		},
+		{
+			name: "Should create the reviews of all people when none is given",
+			body: viewmodel.CreateCycleReviewsRequest{},
+			buildMocks: func(m test.AppMocks) {
+				m.ReviewAppMock.EXPECT().CreateCycleReviews(gomock.Any(), cycleUUID, nil).Return([]entity.Review{}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+			},
+		},
+	}
+
+	runReviewTests(t, http.MethodPost, "/companies/"+companyUUID+"/review-cycles/"+cycleUUID+"/reviews", tests)
+}
+
+func TestHandler_handleGetReview(t *testing.T) {
+	positive, skill := domain.FeedbackTypePositive, domain.FeedbackCategorySkill
+
+	tests := []reviewTest{
+		{
+			name: "Should return the review document with its evidence",
+			buildMocks: func(m test.AppMocks) {
+				m.ReviewAppMock.EXPECT().GetReview(gomock.Any(), reviewUUID).Return(entity.Review{
+					UUID:        reviewUUID,
+					PersonUUID:  personUUID,
+					Status:      domain.ReviewStatusDraft,
+					PeriodStart: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC),
+					PeriodEnd:   time.Date(2025, time.June, 30, 0, 0, 0, 0, time.UTC),
+					Ratings:     []entity.ReviewRating{{CompetencyUUID: "competency-uuid", CompetencyName: "Delivery"}},
+					Template:    &entity.ReviewTemplate{UUID: templateUUID, Name: "Engineering review"},
+					Evidence: &entity.ReviewEvidence{
+						FeedbackCount:      1,
+						FeedbackByType:     map[string]int{positive: 1},
+						FeedbackByCategory: map[string]int{skill: 1},
+						Feedback:           []entity.UnifiedTimelineEntry{{UUID: "note-uuid", Type: domain.NoteTypeFeedback, FeedbackType: &positive}},
+						OneOnOneCount:      2,
+					},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.ReviewResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, "2025-06-30", response.PeriodEnd)
+				require.Len(t, response.Ratings, 1)
+				require.Nil(t, response.Ratings[0].Rating)
+				require.Equal(t, templateUUID, response.Template.UUID)
+				require.Equal(t, 1, response.Evidence.FeedbackByType[positive])
+				require.Len(t, response.Evidence.Feedback, 1)
+				require.Empty(t, response.Evidence.Mentions)
+				require.Equal(t, 2, response.Evidence.OneOnOneCount)
+			},
+		},
+		{
+			name: "Should return not found for an unknown review",
+			buildMocks: func(m test.AppMocks) {
+				m.ReviewAppMock.EXPECT().GetReview(gomock.Any(), reviewUUID).
+					Return(entity.Review{}, resterrors.NewNotFoundError("review not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	}
+
+	runReviewTests(t, http.MethodGet, "/companies/"+companyUUID+"/reviews/"+reviewUUID, tests)
+}
+
+func TestHandler_handleUpdateReview(t *testing.T) {
+	tests := []reviewTest{
+		{
+			name: "Should update the texts and the ratings",
+			body: viewmodel.UpdateReviewRequest{
+				OverallRating: intPointer(3),
+				Summary:       "Great half",
+				Ratings:       []viewmodel.ReviewRatingRequest{{CompetencyUUID: "competency-uuid", Rating: intPointer(2), Comment: "Ships fast"}},
+			},
+			buildMocks: func(m test.AppMocks) {
+				m.ReviewAppMock.EXPECT().UpdateReview(gomock.Any(), reviewUUID, entity.Review{
+					OverallRating: intPointer(3),
+					Summary:       "Great half",
+					Ratings:       []entity.ReviewRating{{CompetencyUUID: "competency-uuid", Rating: intPointer(2), Comment: "Ships fast"}},
+				}).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Should return error when the review is not a draft",
+			body: viewmodel.UpdateReviewRequest{Summary: "Great half"},
+			buildMocks: func(m test.AppMocks) {
+				m.ReviewAppMock.EXPECT().UpdateReview(gomock.Any(), reviewUUID, gomock.Any()).
+					Return(resterrors.NewBadRequestError("only draft reviews can be edited")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	runReviewTests(t, http.MethodPut, "/companies/"+companyUUID+"/reviews/"+reviewUUID, tests)
+}
+
+func TestHandler_handleSubmitReview(t *testing.T) {
+	tests := []reviewTest{
+		{
+			name: "Should submit the review",
+			buildMocks: func(m test.AppMocks) {
+				m.ReviewAppMock.EXPECT().SubmitReview(gomock.Any(), reviewUUID).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Should return error when a competency is not rated",
+			buildMocks: func(m test.AppMocks) {
+				m.ReviewAppMock.EXPECT().SubmitReview(gomock.Any(), reviewUUID).
+					Return(resterrors.NewBadRequestError("competency Delivery is not rated")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	runReviewTests(t, http.MethodPost, "/companies/"+companyUUID+"/reviews/"+reviewUUID+"/submit", tests)
+}
+
+func TestHandler_handleShareReview(t *testing.T) {
+	tests := []reviewTest{
+		{
+			name: "Should share the review",
+			buildMocks: func(m test.AppMocks) {
+				m.ReviewAppMock.EXPECT().ShareReview(gomock.Any(), reviewUUID).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+	}
+
+	runReviewTests(t, http.MethodPost, "/companies/"+companyUUID+"/reviews/"+reviewUUID+"/share", tests)
+}
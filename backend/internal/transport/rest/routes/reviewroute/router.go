@@ -0,0 +1,228 @@
+package reviewroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const GroupRouteName = "companies/:company_uuid"
+
+const (
+	ReviewTemplatesRoute      = "/review-templates"
+	ReviewTemplateByUUIDRoute = "/review-templates/:template_uuid"
+	ReviewCyclesRoute         = "/review-cycles"
+	ReviewCycleByUUIDRoute    = "/review-cycles/:cycle_uuid"
+	CycleReviewsRoute         = "/review-cycles/:cycle_uuid/reviews"
+	PersonReviewsRoute        = "/people/:person_uuid/reviews"
+	ReviewByUUIDRoute         = "/reviews/:review_uuid"
+	SubmitReviewRoute         = "/reviews/:review_uuid/submit"
+	ShareReviewRoute          = "/reviews/:review_uuid/share"
+	ReopenReviewRoute         = "/reviews/:review_uuid/reopen"
+)
+
+type ReviewRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *ReviewRouter {
+	return &ReviewRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *ReviewRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.CompanyGroup.Group(GroupRouteName)
+
+	router.POST(ReviewTemplatesRoute, r.ctrl.handleCreateReviewTemplate).
+		Summary("Create review template").
+		Description("Create a review template with the competencies to evaluate and the rating scale").
+		Read(viewmodel.ReviewTemplateRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.ReviewTemplateResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(ReviewTemplatesRoute, r.ctrl.handleGetReviewTemplates).
+		Summary("Get review templates").
+		Description("Get the review templates of the company").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.ReviewTemplateResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(ReviewTemplateByUUIDRoute, r.ctrl.handleGetReviewTemplate).
+		Summary("Get review template").
+		Description("Get a review template with its competencies and rating scale").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.ReviewTemplateResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("template_uuid", "template uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(ReviewTemplateByUUIDRoute, r.ctrl.handleDeleteReviewTemplate).
+		Summary("Delete review template").
+		Description("Delete a review template that is not used by any review cycle").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("template_uuid", "template uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(ReviewCyclesRoute, r.ctrl.handleCreateReviewCycle).
+		Summary("Create review cycle").
+		Description("Create a review cycle for a period, evaluated with a review template").
+		Read(viewmodel.ReviewCycleRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.ReviewCycleResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(ReviewCyclesRoute, r.ctrl.handleGetReviewCycles).
+		Summary("Get review cycles").
+		Description("Get the review cycles of the company, most recent period first").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.ReviewCycleResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(ReviewCycleByUUIDRoute, r.ctrl.handleGetReviewCycle).
+		Summary("Get review cycle").
+		Description("Get a review cycle").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.ReviewCycleResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("cycle_uuid", "cycle uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(ReviewCycleByUUIDRoute, r.ctrl.handleDeleteReviewCycle).
+		Summary("Delete review cycle").
+		Description("Delete a review cycle with its reviews").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("cycle_uuid", "cycle uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(CycleReviewsRoute, r.ctrl.handleCreateCycleReviews).
+		Summary("Create cycle reviews").
+		Description("Create a draft review for each person, or for all active people of the company when no person is given. "+
+			"People that already have a review in the cycle are skipped").
+		Read(viewmodel.CreateCycleReviewsRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       []viewmodel.ReviewResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("cycle_uuid", "cycle uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(CycleReviewsRoute, r.ctrl.handleGetCycleReviews).
+		Summary("Get cycle reviews").
+		Description("Get the reviews of a review cycle").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.ReviewResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("cycle_uuid", "cycle uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(PersonReviewsRoute, r.ctrl.handleGetPersonReviews).
+		Summary("Get person reviews").
+		Description("Get the reviews of a person, most recent period first").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.ReviewResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("person_uuid", "person uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(ReviewByUUIDRoute, r.ctrl.handleGetReview).
+		Summary("Get review").
+		Description("Get the review document with its template, ratings and the evidence compiled from the timeline of the period: "+
+			"feedback by type and category, mentions received and 1:1 highlights").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.ReviewResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("review_uuid", "review uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.PUT(ReviewByUUIDRoute, r.ctrl.handleUpdateReview).
+		Summary("Update review").
+		Description("Update the texts and the ratings of a draft review").
+		Read(viewmodel.UpdateReviewRequest{}).
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("review_uuid", "review uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(ReviewByUUIDRoute, r.ctrl.handleDeleteReview).
+		Summary("Delete review").
+		Description("Delete a draft review").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("review_uuid", "review uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(SubmitReviewRoute, r.ctrl.handleSubmitReview).
+		Summary("Submit review").
+		Description("Submit a draft review. All competencies and the overall rating must be rated").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("review_uuid", "review uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(ShareReviewRoute, r.ctrl.handleShareReview).
+		Summary("Share review").
+		Description("Share a submitted review with the person").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("review_uuid", "review uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.POST(ReopenReviewRoute, r.ctrl.handleReopenReview).
+		Summary("Reopen review").
+		Description("Move a submitted review back to draft").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("review_uuid", "review uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+}
@@ -0,0 +1,397 @@
+package scimroute
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+const scimContentType = "application/scim+json; charset=UTF-8"
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	scimService contract.SCIMApp
+}
+
+func NewHandler(scimService contract.SCIMApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			scimService: scimService,
+		}
+	})
+
+	return instance
+}
+
+// bindSCIM decodes the request body, SCIM clients send application/scim+json that echo doesn't bind
+func bindSCIM(c echo.Context, input any) error {
+	return json.NewDecoder(c.Request().Body).Decode(input)
+}
+
+func responseSCIM(c echo.Context, status int, data any) error {
+	c.Response().Header().Set(echo.HeaderContentType, scimContentType)
+	return c.JSON(status, data)
+}
+
+// responseSCIMError returns the error in the SCIM error format (RFC 7644, section 3.12)
+func responseSCIMError(c echo.Context, err error) error {
+	status := http.StatusServiceUnavailable
+	detail := routeutils.ErrorMessageServiceUnavailable
+
+	if restErr, ok := resterrors.FromError(err).(resterrors.RestErr); ok {
+		status = restErr.StatusCode()
+		detail = restErr.Message()
+	}
+
+	scimType := ""
+	switch status {
+	case http.StatusBadRequest:
+		scimType = "invalidValue"
+	case http.StatusConflict:
+		scimType = "uniqueness"
+	}
+
+	return responseSCIM(c, status, viewmodel.NewSCIMError(status, scimType, detail))
+}
+
+func responseSCIMInvalidRequest(c echo.Context, scimType string, err error) error {
+	return responseSCIM(c, http.StatusBadRequest, viewmodel.NewSCIMError(http.StatusBadRequest, scimType, err.Error()))
+}
+
+// getSCIMListParams reads the filter and the 1-based pagination of a SCIM list request
+func getSCIMListParams(c echo.Context) (params entity.SCIMListParams, err error) {
+	params.StartIndex = 1
+	params.Count = domain.SCIMDefaultCount
+
+	if value := c.QueryParam("startIndex"); value != "" {
+		params.StartIndex, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return params, err
+		}
+	}
+	if params.StartIndex < 1 {
+		params.StartIndex = 1
+	}
+
+	if value := c.QueryParam("count"); value != "" {
+		params.Count, err = strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return params, err
+		}
+	}
+
+	params.Filter, err = viewmodel.ParseSCIMFilter(c.QueryParam("filter"))
+	if err != nil {
+		return params, err
+	}
+
+	return params, nil
+}
+
+func (s *Handler) handleGenerateToken(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	token, err := s.scimService.GenerateSCIMToken(ctx)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseCreated(c, viewmodel.SCIMTokenResponse{Token: token})
+}
+
+func (s *Handler) handleRevokeToken(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	err := s.scimService.RevokeSCIMToken(ctx)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleGetServiceProviderConfig(c echo.Context) error {
+	config := viewmodel.SCIMServiceProviderConfig{
+		Schemas: []string{viewmodel.SCIMSchemaServiceConfig},
+		Patch:   viewmodel.SCIMSupported{Supported: true},
+		Filter: viewmodel.SCIMFilterSupport{
+			Supported:  true,
+			MaxResults: domain.SCIMMaxCount,
+		},
+		AuthenticationSchemes: []viewmodel.SCIMAuthenticationScheme{
+			{
+				Type:        "oauthbearertoken",
+				Name:        "OAuth Bearer Token",
+				Description: "Authentication with the SCIM token generated for the company",
+			},
+		},
+	}
+
+	return responseSCIM(c, http.StatusOK, config)
+}
+
+func (s *Handler) handleGetUsers(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	params, err := getSCIMListParams(c)
+	if err != nil {
+		return responseSCIMInvalidRequest(c, "invalidFilter", err)
+	}
+
+	users, totalRecords, err := s.scimService.GetUsers(ctx, params)
+	if err != nil {
+		return responseSCIMError(c, err)
+	}
+
+	resources := make([]viewmodel.SCIMUser, 0, len(users))
+	for _, user := range users {
+		item := viewmodel.SCIMUser{}
+		item.FillFromEntity(user)
+		resources = append(resources, item)
+	}
+
+	return responseSCIM(c, http.StatusOK, viewmodel.BuildSCIMListResponse(resources, params.StartIndex, totalRecords))
+}
+
+func (s *Handler) handleGetUser(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	user, err := s.scimService.GetUser(ctx, c.Param("user_id"))
+	if err != nil {
+		return responseSCIMError(c, err)
+	}
+
+	response := viewmodel.SCIMUser{}
+	response.FillFromEntity(user)
+
+	return responseSCIM(c, http.StatusOK, response)
+}
+
+func (s *Handler) handleCreateUser(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	input := viewmodel.SCIMUser{}
+	err := bindSCIM(c, &input)
+	if err != nil {
+		return responseSCIMInvalidRequest(c, "invalidSyntax", err)
+	}
+
+	user, created, err := s.scimService.CreateUser(ctx, input.ToEntity())
+	if err != nil {
+		return responseSCIMError(c, err)
+	}
+
+	response := viewmodel.SCIMUser{}
+	response.FillFromEntity(user)
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+
+	return responseSCIM(c, status, response)
+}
+
+func (s *Handler) handleReplaceUser(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	input := viewmodel.SCIMUser{}
+	err := bindSCIM(c, &input)
+	if err != nil {
+		return responseSCIMInvalidRequest(c, "invalidSyntax", err)
+	}
+
+	user, err := s.scimService.ReplaceUser(ctx, c.Param("user_id"), input.ToEntity())
+	if err != nil {
+		return responseSCIMError(c, err)
+	}
+
+	response := viewmodel.SCIMUser{}
+	response.FillFromEntity(user)
+
+	return responseSCIM(c, http.StatusOK, response)
+}
+
+func (s *Handler) handlePatchUser(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+	userID := c.Param("user_id")
+
+	input := viewmodel.SCIMPatchRequest{}
+	err := bindSCIM(c, &input)
+	if err != nil {
+		return responseSCIMInvalidRequest(c, "invalidSyntax", err)
+	}
+
+	current, err := s.scimService.GetUser(ctx, userID)
+	if err != nil {
+		return responseSCIMError(c, err)
+	}
+
+	patched := viewmodel.SCIMUser{}
+	patched.FillFromEntity(current)
+	err = patched.ApplyPatch(input.Operations)
+	if err != nil {
+		return responseSCIMInvalidRequest(c, "invalidValue", err)
+	}
+
+	user, err := s.scimService.ReplaceUser(ctx, userID, patched.ToEntity())
+	if err != nil {
+		return responseSCIMError(c, err)
+	}
+
+	response := viewmodel.SCIMUser{}
+	response.FillFromEntity(user)
+
+	return responseSCIM(c, http.StatusOK, response)
+}
+
+func (s *Handler) handleDeleteUser(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	err := s.scimService.DeactivateUser(ctx, c.Param("user_id"))
+	if err != nil {
+		return responseSCIMError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleGetGroups(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	params, err := getSCIMListParams(c)
+	if err != nil {
+		return responseSCIMInvalidRequest(c, "invalidFilter", err)
+	}
+
+	groups, totalRecords, err := s.scimService.GetGroups(ctx, params)
+	if err != nil {
+		return responseSCIMError(c, err)
+	}
+
+	resources := make([]viewmodel.SCIMGroup, 0, len(groups))
+	for _, group := range groups {
+		item := viewmodel.SCIMGroup{}
+		item.FillFromEntity(group)
+		resources = append(resources, item)
+	}
+
+	return responseSCIM(c, http.StatusOK, viewmodel.BuildSCIMListResponse(resources, params.StartIndex, totalRecords))
+}
+
+func (s *Handler) handleGetGroup(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	group, err := s.scimService.GetGroup(ctx, c.Param("group_id"))
+	if err != nil {
+		return responseSCIMError(c, err)
+	}
+
+	response := viewmodel.SCIMGroup{}
+	response.FillFromEntity(group)
+
+	return responseSCIM(c, http.StatusOK, response)
+}
+
+func (s *Handler) handleCreateGroup(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	input := viewmodel.SCIMGroup{}
+	err := bindSCIM(c, &input)
+	if err != nil {
+		return responseSCIMInvalidRequest(c, "invalidSyntax", err)
+	}
+
+	group, created, err := s.scimService.CreateGroup(ctx, input.ToEntity())
+	if err != nil {
+		return responseSCIMError(c, err)
+	}
+
+	response := viewmodel.SCIMGroup{}
+	response.FillFromEntity(group)
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+
+	return responseSCIM(c, status, response)
+}
+
+func (s *Handler) handleReplaceGroup(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	input := viewmodel.SCIMGroup{}
+	err := bindSCIM(c, &input)
+	if err != nil {
+		return responseSCIMInvalidRequest(c, "invalidSyntax", err)
+	}
+
+	group, err := s.scimService.ReplaceGroup(ctx, c.Param("group_id"), input.ToEntity())
+	if err != nil {
+		return responseSCIMError(c, err)
+	}
+
+	response := viewmodel.SCIMGroup{}
+	response.FillFromEntity(group)
+
+	return responseSCIM(c, http.StatusOK, response)
+}
+
+func (s *Handler) handlePatchGroup(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+	groupID := c.Param("group_id")
+
+	input := viewmodel.SCIMPatchRequest{}
+	err := bindSCIM(c, &input)
+	if err != nil {
+		return responseSCIMInvalidRequest(c, "invalidSyntax", err)
+	}
+
+	current, err := s.scimService.GetGroup(ctx, groupID)
+	if err != nil {
+		return responseSCIMError(c, err)
+	}
+
+	patched := viewmodel.SCIMGroup{}
+	patched.FillFromEntity(current)
+	err = patched.ApplyPatch(input.Operations)
+	if err != nil {
+		return responseSCIMInvalidRequest(c, "invalidValue", err)
+	}
+
+	group, err := s.scimService.ReplaceGroup(ctx, groupID, patched.ToEntity())
+	if err != nil {
+		return responseSCIMError(c, err)
+	}
+
+	response := viewmodel.SCIMGroup{}
+	response.FillFromEntity(group)
+
+	return responseSCIM(c, http.StatusOK, response)
+}
+
+func (s *Handler) handleDeleteGroup(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	err := s.scimService.DeleteGroup(ctx, c.Param("group_id"))
+	if err != nil {
+		return responseSCIMError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
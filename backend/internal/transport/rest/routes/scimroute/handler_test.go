@@ -0,0 +1,490 @@
+package scimroute_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/scimroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	companyUUID  = "company-uuid-123"
+	scimToken    = "scim-token"
+	mariaUUID    = "0d3a3b1e-5f7c-4e2a-9b8d-6c5a4f3e2d1c"
+	joaoUUID     = "5e6f7a8b-1c2d-4e3f-8a9b-0c1d2e3f4a5b"
+	managerUUID  = "9a8b7c6d-aaaa-bbbb-cccc-111122223333"
+	groupUUID    = "7b6a5c4d-3e2f-4a1b-9c8d-7e6f5a4b3c2d"
+	usersURL     = "/scim/v2/companies/" + companyUUID + "/Users"
+	groupsURL    = "/scim/v2/companies/" + companyUUID + "/Groups"
+	scimJSONType = "application/scim+json"
+)
+
+// loadFixture reads a request recorded from a SCIM client
+func loadFixture(t *testing.T, name string) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return data
+}
+
+func authorizeSCIM(req *http.Request, m test.AppMocks) {
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+scimToken)
+	m.SCIMAppMock.EXPECT().ValidateSCIMToken(gomock.Any(), companyUUID, scimToken).Return(nil).Times(1)
+}
+
+type scimEndpointTest struct {
+	name          string
+	body          []byte
+	url           string
+	setupAuth     func(req *http.Request, m test.AppMocks)
+	buildMocks    func(m test.AppMocks)
+	checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+}
+
+func runSCIMTests(t *testing.T, method, url string, tests []scimEndpointTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scimroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			requestURL := url
+			if tt.url != "" {
+				requestURL = tt.url
+			}
+
+			req, err := http.NewRequest(method, requestURL, bytes.NewReader(tt.body))
+			require.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, scimJSONType)
+
+			if tt.setupAuth != nil {
+				tt.setupAuth(req, m)
+			}
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(m)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func mariaSCIMUser() entity.SCIMUser {
+	return entity.SCIMUser{
+		PersonID:   1,
+		ID:         mariaUUID,
+		ExternalID: "00u1a2b3c4d5e6f7g8h9",
+		UserName:   "maria.silva@acme.com",
+		Name:       "Maria Silva",
+		Email:      "maria.silva@acme.com",
+		Active:     true,
+	}
+}
+
+func joaoSCIMUser() entity.SCIMUser {
+	return entity.SCIMUser{
+		PersonID:   2,
+		ID:         joaoUUID,
+		ExternalID: "4f1c2a6e-0b5d-4d1c-9a8e-3f2b1c0d9e8a",
+		UserName:   "joao.souza@acme.onmicrosoft.com",
+		Name:       "João Souza",
+		Email:      "joao.souza@acme.com",
+		Phone:      "+55 11 98888-7777",
+		Position:   "Engenheiro de Software",
+		Department: "Engenharia",
+		ManagerRef: mariaUUID,
+		Active:     true,
+	}
+}
+
+func TestHandler_handleCreateUser(t *testing.T) {
+	tests := []scimEndpointTest{
+		{
+			name:      "Should create the user sent by Okta",
+			body:      loadFixture(t, "okta_create_user.json"),
+			setupAuth: authorizeSCIM,
+			buildMocks: func(m test.AppMocks) {
+				m.SCIMAppMock.EXPECT().CreateUser(gomock.Any(), entity.SCIMUser{
+					ExternalID: "00u1a2b3c4d5e6f7g8h9",
+					UserName:   "maria.silva@acme.com",
+					Name:       "Maria Silva",
+					Email:      "maria.silva@acme.com",
+					Active:     true,
+				}).Return(mariaSCIMUser(), true, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+				require.Contains(t, recorder.Header().Get(echo.HeaderContentType), scimJSONType)
+
+				var response viewmodel.SCIMUser
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, mariaUUID, response.ID)
+				require.Equal(t, "00u1a2b3c4d5e6f7g8h9", response.ExternalID)
+				require.NotNil(t, response.Active)
+				require.True(t, *response.Active)
+				require.Equal(t, "User", response.Meta.ResourceType)
+			},
+		},
+		{
+			name:      "Should update the user already provisioned by Azure AD",
+			body:      loadFixture(t, "azure_create_user.json"),
+			setupAuth: authorizeSCIM,
+			buildMocks: func(m test.AppMocks) {
+				m.SCIMAppMock.EXPECT().CreateUser(gomock.Any(), entity.SCIMUser{
+					ExternalID: "4f1c2a6e-0b5d-4d1c-9a8e-3f2b1c0d9e8a",
+					UserName:   "joao.souza@acme.onmicrosoft.com",
+					Name:       "João Souza",
+					Email:      "joao.souza@acme.com",
+					Phone:      "+55 11 98888-7777",
+					Position:   "Engenheiro de Software",
+					Department: "Engenharia",
+					ManagerRef: "00u1a2b3c4d5e6f7g8h9",
+					Active:     true,
+				}).Return(joaoSCIMUser(), false, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.SCIMUser
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, joaoUUID, response.ID)
+				require.Equal(t, "Engenharia", response.Enterprise.Department)
+				require.Equal(t, mariaUUID, response.Enterprise.Manager.Value)
+			},
+		},
+		{
+			name: "Should return error when the bearer token is missing",
+			body: loadFixture(t, "okta_create_user.json"),
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name: "Should return error when the bearer token is invalid",
+			body: loadFixture(t, "okta_create_user.json"),
+			setupAuth: func(req *http.Request, m test.AppMocks) {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer invalid")
+				m.SCIMAppMock.EXPECT().ValidateSCIMToken(gomock.Any(), companyUUID, "invalid").
+					Return(resterrors.NewUnauthorizedError("invalid SCIM token")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+		{
+			name:      "Should return a SCIM error when the body is invalid",
+			body:      []byte(`{"userName": `),
+			setupAuth: authorizeSCIM,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				var response viewmodel.SCIMError
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, []string{viewmodel.SCIMSchemaError}, response.Schemas)
+				require.Equal(t, "400", response.Status)
+				require.Equal(t, "invalidSyntax", response.ScimType)
+			},
+		},
+		{
+			name:      "Should return a SCIM error when the service fails",
+			body:      loadFixture(t, "okta_create_user.json"),
+			setupAuth: authorizeSCIM,
+			buildMocks: func(m test.AppMocks) {
+				m.SCIMAppMock.EXPECT().CreateUser(gomock.Any(), gomock.Any()).
+					Return(entity.SCIMUser{}, false, resterrors.NewBadRequestError("userName is required")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				var response viewmodel.SCIMError
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, "invalidValue", response.ScimType)
+				require.Equal(t, "userName is required", response.Detail)
+			},
+		},
+	}
+
+	runSCIMTests(t, http.MethodPost, usersURL, tests)
+}
+
+func TestHandler_handlePatchUser(t *testing.T) {
+	patchedJoao := joaoSCIMUser()
+	patchedJoao.PersonID = 0
+	patchedJoao.ID = ""
+	patchedJoao.Position = "Tech Lead"
+	patchedJoao.Department = "Plataforma"
+	patchedJoao.ManagerRef = managerUUID
+	patchedJoao.Email = "joao.souza@acme.com.br"
+
+	deactivatedJoao := joaoSCIMUser()
+	deactivatedJoao.PersonID = 0
+	deactivatedJoao.ID = ""
+	deactivatedJoao.Active = false
+
+	tests := []scimEndpointTest{
+		{
+			name:      "Should apply the attributes changed in Azure AD",
+			body:      loadFixture(t, "azure_patch_user.json"),
+			setupAuth: authorizeSCIM,
+			buildMocks: func(m test.AppMocks) {
+				m.SCIMAppMock.EXPECT().GetUser(gomock.Any(), joaoUUID).Return(joaoSCIMUser(), nil).Times(1)
+				m.SCIMAppMock.EXPECT().ReplaceUser(gomock.Any(), joaoUUID, patchedJoao).Return(joaoSCIMUser(), nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:      "Should deactivate the user when Azure AD sends active as a string",
+			body:      loadFixture(t, "azure_patch_user_deactivate.json"),
+			setupAuth: authorizeSCIM,
+			buildMocks: func(m test.AppMocks) {
+				m.SCIMAppMock.EXPECT().GetUser(gomock.Any(), joaoUUID).Return(joaoSCIMUser(), nil).Times(1)
+				m.SCIMAppMock.EXPECT().ReplaceUser(gomock.Any(), joaoUUID, deactivatedJoao).Return(deactivatedJoao, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.SCIMUser
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.NotNil(t, response.Active)
+				require.False(t, *response.Active)
+			},
+		},
+		{
+			name:      "Should deactivate the user when Okta sends a patch without path",
+			body:      loadFixture(t, "okta_patch_user_deactivate.json"),
+			setupAuth: authorizeSCIM,
+			buildMocks: func(m test.AppMocks) {
+				m.SCIMAppMock.EXPECT().GetUser(gomock.Any(), joaoUUID).Return(joaoSCIMUser(), nil).Times(1)
+				m.SCIMAppMock.EXPECT().ReplaceUser(gomock.Any(), joaoUUID, deactivatedJoao).Return(deactivatedJoao, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+		{
+			name:      "Should return not found when the user doesn't exist",
+			body:      loadFixture(t, "okta_patch_user_deactivate.json"),
+			setupAuth: authorizeSCIM,
+			buildMocks: func(m test.AppMocks) {
+				m.SCIMAppMock.EXPECT().GetUser(gomock.Any(), joaoUUID).
+					Return(entity.SCIMUser{}, resterrors.NewNotFoundError("user not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+
+				var response viewmodel.SCIMError
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, "404", response.Status)
+			},
+		},
+		{
+			name:      "Should return error when the operation is not supported",
+			body:      []byte(`{"Operations": [{"op": "move", "path": "title", "value": "x"}]}`),
+			setupAuth: authorizeSCIM,
+			buildMocks: func(m test.AppMocks) {
+				m.SCIMAppMock.EXPECT().GetUser(gomock.Any(), joaoUUID).Return(joaoSCIMUser(), nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+	}
+
+	runSCIMTests(t, http.MethodPatch, usersURL+"/"+joaoUUID, tests)
+}
+
+func TestHandler_handleGetUsers(t *testing.T) {
+	tests := []scimEndpointTest{
+		{
+			name:      "Should filter the users by userName",
+			url:       usersURL + `?filter=userName%20eq%20%22maria.silva%40acme.com%22&startIndex=1&count=10`,
+			setupAuth: authorizeSCIM,
+			buildMocks: func(m test.AppMocks) {
+				params := entity.SCIMListParams{
+					Filter:     &entity.SCIMFilter{Attribute: "userName", Value: "maria.silva@acme.com"},
+					StartIndex: 1,
+					Count:      10,
+				}
+				m.SCIMAppMock.EXPECT().GetUsers(gomock.Any(), params).Return([]entity.SCIMUser{mariaSCIMUser()}, int64(1), nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.SCIMListResponse[viewmodel.SCIMUser]
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, []string{viewmodel.SCIMSchemaListResponse}, response.Schemas)
+				require.Equal(t, int64(1), response.TotalResults)
+				require.Equal(t, 1, response.ItemsPerPage)
+				require.Len(t, response.Resources, 1)
+				require.Equal(t, mariaUUID, response.Resources[0].ID)
+			},
+		},
+		{
+			name:      "Should return an empty list when no user matches",
+			url:       usersURL + `?filter=externalId%20eq%20%22unknown%22`,
+			setupAuth: authorizeSCIM,
+			buildMocks: func(m test.AppMocks) {
+				params := entity.SCIMListParams{
+					Filter:     &entity.SCIMFilter{Attribute: "externalId", Value: "unknown"},
+					StartIndex: 1,
+					Count:      100,
+				}
+				m.SCIMAppMock.EXPECT().GetUsers(gomock.Any(), params).Return(nil, int64(0), nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Contains(t, recorder.Body.String(), `"Resources":[]`)
+			},
+		},
+		{
+			name:      "Should return error when the filter is not supported",
+			url:       usersURL + `?filter=userName%20sw%20%22maria%22`,
+			setupAuth: authorizeSCIM,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+
+				var response viewmodel.SCIMError
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, "invalidFilter", response.ScimType)
+			},
+		},
+	}
+
+	runSCIMTests(t, http.MethodGet, usersURL, tests)
+}
+
+func TestHandler_handleDeleteUser(t *testing.T) {
+	tests := []scimEndpointTest{
+		{
+			name:      "Should deactivate the user",
+			setupAuth: authorizeSCIM,
+			buildMocks: func(m test.AppMocks) {
+				m.SCIMAppMock.EXPECT().DeactivateUser(gomock.Any(), mariaUUID).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name:      "Should return error when deactivate fails",
+			setupAuth: authorizeSCIM,
+			buildMocks: func(m test.AppMocks) {
+				m.SCIMAppMock.EXPECT().DeactivateUser(gomock.Any(), mariaUUID).Return(fmt.Errorf("some error")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+			},
+		},
+	}
+
+	runSCIMTests(t, http.MethodDelete, usersURL+"/"+mariaUUID, tests)
+}
+
+func engineeringGroup() entity.SCIMGroup {
+	return entity.SCIMGroup{
+		ID:          1,
+		UUID:        groupUUID,
+		ExternalID:  "00g1emaKYZTWRYYRRTSK",
+		DisplayName: "Engenharia",
+		Members: []entity.SCIMGroupMember{
+			{PersonID: 1, PersonUUID: mariaUUID, Name: "Maria Silva"},
+		},
+	}
+}
+
+func TestHandler_handleCreateGroup(t *testing.T) {
+	tests := []scimEndpointTest{
+		{
+			name:      "Should create the group sent by Okta",
+			body:      loadFixture(t, "okta_create_group.json"),
+			setupAuth: authorizeSCIM,
+			buildMocks: func(m test.AppMocks) {
+				m.SCIMAppMock.EXPECT().CreateGroup(gomock.Any(), entity.SCIMGroup{
+					ExternalID:  "00g1emaKYZTWRYYRRTSK",
+					DisplayName: "Engenharia",
+					Members:     []entity.SCIMGroupMember{{PersonUUID: mariaUUID}},
+				}).Return(engineeringGroup(), true, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.SCIMGroup
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, groupUUID, response.ID)
+				require.Equal(t, []viewmodel.SCIMMultiValue{{Value: mariaUUID, Display: "Maria Silva"}}, response.Members)
+			},
+		},
+	}
+
+	runSCIMTests(t, http.MethodPost, groupsURL, tests)
+}
+
+func TestHandler_handlePatchGroup(t *testing.T) {
+	tests := []scimEndpointTest{
+		{
+			name:      "Should add and remove the members sent by Azure AD",
+			body:      loadFixture(t, "azure_patch_group_members.json"),
+			setupAuth: authorizeSCIM,
+			buildMocks: func(m test.AppMocks) {
+				m.SCIMAppMock.EXPECT().GetGroup(gomock.Any(), groupUUID).Return(engineeringGroup(), nil).Times(1)
+				m.SCIMAppMock.EXPECT().ReplaceGroup(gomock.Any(), groupUUID, entity.SCIMGroup{
+					ExternalID:  "00g1emaKYZTWRYYRRTSK",
+					DisplayName: "Engenharia de Plataforma",
+					Members:     []entity.SCIMGroupMember{{PersonUUID: managerUUID}},
+				}).Return(engineeringGroup(), nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
+	}
+
+	runSCIMTests(t, http.MethodPatch, groupsURL+"/"+groupUUID, tests)
+}
+
+func TestHandler_handleGenerateToken(t *testing.T) {
+	t.Run("Should generate the SCIM token for the company owner", func(t *testing.T) {
+		scimroute.Once = sync.Once{}
+		m, server, ctrl := test.GetServerTest(t)
+		defer ctrl.Finish()
+
+		req, err := http.NewRequest(http.MethodPost, "/companies/"+companyUUID+"/scim/token", nil)
+		require.NoError(t, err)
+
+		test.AddAuthorization(context.Background(), t, req, m)
+		m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+		m.SCIMAppMock.EXPECT().GenerateSCIMToken(gomock.Any()).Return("generated-token", nil).Times(1)
+
+		recorder := httptest.NewRecorder()
+		server.Echo().ServeHTTP(recorder, req)
+
+		require.Equal(t, http.StatusCreated, recorder.Code)
+
+		var response viewmodel.SCIMTokenResponse
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		require.Equal(t, "generated-token", response.Token)
+	})
+}
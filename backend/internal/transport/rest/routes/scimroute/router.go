@@ -0,0 +1,229 @@
+package scimroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	echo "github.com/labstack/echo/v4"
+)
+
+const (
+	GroupRouteName      = "scim/v2/companies/:company_uuid"
+	TokenGroupRouteName = "companies/:company_uuid/scim"
+)
+
+const (
+	TokenRoute                 = "/token"
+	ServiceProviderConfigRoute = "/ServiceProviderConfig"
+	UsersRoute                 = "/Users"
+	UserByIDRoute              = "/Users/:user_id"
+	GroupsRoute                = "/Groups"
+	GroupByIDRoute             = "/Groups/:group_id"
+)
+
+type SCIMRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *SCIMRouter {
+	return &SCIMRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *SCIMRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	tokenRouter := g.CompanyGroup.Group(TokenGroupRouteName)
+
+	tokenRouter.POST(TokenRoute, r.ctrl.handleGenerateToken).
+		Summary("Generate SCIM token").
+		Description("Generate the bearer token used by the HR system to provision people through the SCIM endpoints. The previous token stops working and the new one is only shown once").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.SCIMTokenResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	tokenRouter.DELETE(TokenRoute, r.ctrl.handleRevokeToken).
+		Summary("Revoke SCIM token").
+		Description("Revoke the SCIM token, disabling the provisioning from the HR system").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router := g.SCIMGroup.Group(GroupRouteName)
+
+	router.GET(ServiceProviderConfigRoute, r.ctrl.handleGetServiceProviderConfig).
+		Summary("SCIM service provider config").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.SCIMServiceProviderConfig{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(echo.HeaderAuthorization, "Bearer SCIM token", goswag.StringType, true)
+
+	router.GET(UsersRoute, r.ctrl.handleGetUsers).
+		Summary("List SCIM users").
+		Description("List the company people as SCIM users, including the deactivated ones. Supports the filters userName, externalId and emails.value with the eq operator").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.SCIMListResponse[viewmodel.SCIMUser]{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		QueryParam("filter", "SCIM filter, e.g. userName eq \"john@company.com\"", goswag.StringType, false).
+		QueryParam("startIndex", "1-based index of the first result", goswag.IntType, false).
+		QueryParam("count", "maximum number of results", goswag.IntType, false).
+		HeaderParam(echo.HeaderAuthorization, "Bearer SCIM token", goswag.StringType, true)
+
+	router.GET(UserByIDRoute, r.ctrl.handleGetUser).
+		Summary("Get SCIM user").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.SCIMUser{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("user_id", "SCIM user id (person uuid)", goswag.StringType, true).
+		HeaderParam(echo.HeaderAuthorization, "Bearer SCIM token", goswag.StringType, true)
+
+	router.POST(UsersRoute, r.ctrl.handleCreateUser).
+		Summary("Create SCIM user").
+		Description("Provision a person from the HR system. It's idempotent by externalId (or userName when externalId is not sent): an existing user is updated and 200 is returned. Fields edited manually since the last sync are preserved").
+		Read(viewmodel.SCIMUser{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.SCIMUser{},
+			},
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.SCIMUser{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(echo.HeaderAuthorization, "Bearer SCIM token", goswag.StringType, true)
+
+	router.PUT(UserByIDRoute, r.ctrl.handleReplaceUser).
+		Summary("Replace SCIM user").
+		Description("Update a person with the values of the HR system. Fields edited manually since the last sync are preserved, active=false deactivates the person").
+		Read(viewmodel.SCIMUser{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.SCIMUser{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("user_id", "SCIM user id (person uuid)", goswag.StringType, true).
+		HeaderParam(echo.HeaderAuthorization, "Bearer SCIM token", goswag.StringType, true)
+
+	router.PATCH(UserByIDRoute, r.ctrl.handlePatchUser).
+		Summary("Patch SCIM user").
+		Read(viewmodel.SCIMPatchRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.SCIMUser{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("user_id", "SCIM user id (person uuid)", goswag.StringType, true).
+		HeaderParam(echo.HeaderAuthorization, "Bearer SCIM token", goswag.StringType, true)
+
+	router.DELETE(UserByIDRoute, r.ctrl.handleDeleteUser).
+		Summary("Delete SCIM user").
+		Description("Deactivate the person, the notes and history are kept").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("user_id", "SCIM user id (person uuid)", goswag.StringType, true).
+		HeaderParam(echo.HeaderAuthorization, "Bearer SCIM token", goswag.StringType, true)
+
+	router.GET(GroupsRoute, r.ctrl.handleGetGroups).
+		Summary("List SCIM groups").
+		Description("Supports the filters displayName and externalId with the eq operator").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.SCIMListResponse[viewmodel.SCIMGroup]{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		QueryParam("filter", "SCIM filter, e.g. displayName eq \"Engineering\"", goswag.StringType, false).
+		QueryParam("startIndex", "1-based index of the first result", goswag.IntType, false).
+		QueryParam("count", "maximum number of results", goswag.IntType, false).
+		HeaderParam(echo.HeaderAuthorization, "Bearer SCIM token", goswag.StringType, true)
+
+	router.GET(GroupByIDRoute, r.ctrl.handleGetGroup).
+		Summary("Get SCIM group").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.SCIMGroup{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("group_id", "SCIM group id", goswag.StringType, true).
+		HeaderParam(echo.HeaderAuthorization, "Bearer SCIM token", goswag.StringType, true)
+
+	router.POST(GroupsRoute, r.ctrl.handleCreateGroup).
+		Summary("Create SCIM group").
+		Description("Provision a group and its members. A group with the same externalId is replaced and 200 is returned").
+		Read(viewmodel.SCIMGroup{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.SCIMGroup{},
+			},
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.SCIMGroup{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(echo.HeaderAuthorization, "Bearer SCIM token", goswag.StringType, true)
+
+	router.PUT(GroupByIDRoute, r.ctrl.handleReplaceGroup).
+		Summary("Replace SCIM group").
+		Read(viewmodel.SCIMGroup{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.SCIMGroup{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("group_id", "SCIM group id", goswag.StringType, true).
+		HeaderParam(echo.HeaderAuthorization, "Bearer SCIM token", goswag.StringType, true)
+
+	router.PATCH(GroupByIDRoute, r.ctrl.handlePatchGroup).
+		Summary("Patch SCIM group").
+		Description("Rename the group or add and remove members").
+		Read(viewmodel.SCIMPatchRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.SCIMGroup{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("group_id", "SCIM group id", goswag.StringType, true).
+		HeaderParam(echo.HeaderAuthorization, "Bearer SCIM token", goswag.StringType, true)
+
+	router.DELETE(GroupByIDRoute, r.ctrl.handleDeleteGroup).
+		Summary("Delete SCIM group").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("group_id", "SCIM group id", goswag.StringType, true).
+		HeaderParam(echo.HeaderAuthorization, "Bearer SCIM token", goswag.StringType, true)
+}
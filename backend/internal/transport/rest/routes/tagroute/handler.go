@@ -0,0 +1,139 @@
+package tagroute
+
+import (
+	"sync"
+
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+
+	echo "github.com/labstack/echo/v4"
+)
+
+var (
+	instance *Handler
+	Once     sync.Once
+)
+
+type Handler struct {
+	tagService contract.TagApp
+}
+
+func NewHandler(tagService contract.TagApp) *Handler {
+	Once.Do(func() {
+		instance = &Handler{
+			tagService: tagService,
+		}
+	})
+
+	return instance
+}
+
+func (s *Handler) handleCreateTag(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	input := viewmodel.TagRequest{}
+	err := c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	tag, err := s.tagService.CreateTag(ctx, input.ToEntity())
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.TagResponse{}
+	response.FillFromEntity(tag)
+
+	return routeutils.ResponseCreated(c, response)
+}
+
+func (s *Handler) handleGetTags(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	tags, err := s.tagService.GetCompanyTags(ctx)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.TagResponse, len(tags))
+	for i, tag := range tags {
+		response[i].FillFromEntity(tag)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleGetTagAnalytics(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	from, err := routeutils.GetTimeQueryParam(c, "from")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	to, err := routeutils.GetTimeQueryParam(c, "to")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	analytics, err := s.tagService.GetTagAnalytics(ctx, entity.TagAnalyticsFilters{
+		TagNames: routeutils.GetStringArrayQueryParam(c, "tags", ","),
+		From:     from,
+		To:       to,
+		Interval: c.QueryParam("interval"),
+	})
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := viewmodel.TagAnalyticsResponse{}
+	response.FillFromEntity(analytics)
+
+	return routeutils.ResponseAPIOk(c, response)
+}
+
+func (s *Handler) handleDeleteTag(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	tagUUID, err := routeutils.GetRequiredStringPathParam(c, "tag_uuid", "Invalid tag_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	err = s.tagService.DeleteTag(ctx, tagUUID)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	return routeutils.ResponseNoContent(c)
+}
+
+func (s *Handler) handleSetNoteTags(c echo.Context) error {
+	ctx := routeutils.GetContext(c)
+
+	noteUUID, err := routeutils.GetRequiredStringPathParam(c, "note_uuid", "Invalid note_uuid")
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	input := viewmodel.NoteTagsRequest{}
+	err = c.Bind(&input)
+	if err != nil {
+		return routeutils.ResponseInvalidRequestBody(c, err)
+	}
+
+	tags, err := s.tagService.SetNoteTags(ctx, noteUUID, input.Tags)
+	if err != nil {
+		return routeutils.HandleError(c, err)
+	}
+
+	response := make([]viewmodel.TagResponse, len(tags))
+	for i, tag := range tags {
+		response[i].FillFromEntity(tag)
+	}
+
+	return routeutils.ResponseAPIOk(c, response)
+}
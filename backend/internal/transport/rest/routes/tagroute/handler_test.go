@@ -0,0 +1,230 @@
+package tagroute_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/tagroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/test"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const (
+	companyUUID = "company-uuid-123"
+	tagUUID     = "tag-uuid-123"
+	noteUUID    = "note-uuid-123"
+)
+
+type tagTest struct {
+	name          string
+	url           string
+	body          any
+	buildMocks    func(m test.AppMocks)
+	checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+}
+
+func runTagTests(t *testing.T, method string, tests []tagTest) {
+	t.Helper()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tagroute.Once = sync.Once{}
+			m, server, ctrl := test.GetServerTest(t)
+			defer ctrl.Finish()
+
+			var body []byte
+			if tt.body != nil {
+				var err error
+				body, err = json.Marshal(tt.body)
+				require.NoError(t, err)
+			}
+
+			req, err := http.NewRequest(method, "/companies/"+companyUUID+tt.url, bytes.NewReader(body))
+			require.NoError(t, err)
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+			test.AddAuthorization(context.Background(), t, req, m)
+			m.CompanyAppMock.EXPECT().ValidateCompanyOwnership(gomock.Any(), companyUUID, gomock.Any()).Return(nil).Times(1)
+
+			if tt.buildMocks != nil {
+				tt.buildMocks(m)
+			}
+
+			recorder := httptest.NewRecorder()
+			server.Echo().ServeHTTP(recorder, req)
+			tt.checkResponse(t, recorder)
+		})
+	}
+}
+
+func TestHandler_handleCreateTag(t *testing.T) {
+	runTagTests(t, http.MethodPost, []tagTest{
+		{
+			name: "Should create the curated tag",
+			url:  "/tags",
+			body: viewmodel.TagRequest{Name: "#Promotion", Description: "Promotion cases"},
+			buildMocks: func(m test.AppMocks) {
+				m.TagAppMock.EXPECT().CreateTag(gomock.Any(), entity.Tag{Name: "#Promotion", Description: "Promotion cases"}).
+					Return(entity.Tag{UUID: tagUUID, Name: "promotion", Description: "Promotion cases", Curated: true}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusCreated, recorder.Code)
+
+				var response viewmodel.TagResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, tagUUID, response.UUID)
+				require.Equal(t, "promotion", response.Name)
+				require.True(t, response.Curated)
+			},
+		},
+		{
+			name: "Should return conflict when the curated tag exists",
+			url:  "/tags",
+			body: viewmodel.TagRequest{Name: "promotion"},
+			buildMocks: func(m test.AppMocks) {
+				m.TagAppMock.EXPECT().CreateTag(gomock.Any(), gomock.Any()).
+					Return(entity.Tag{}, resterrors.NewConflictError("tag already exists")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusConflict, recorder.Code)
+			},
+		},
+	})
+}
+
+func TestHandler_handleGetTags(t *testing.T) {
+	runTagTests(t, http.MethodGet, []tagTest{
+		{
+			name: "Should return the tags with their notes count",
+			url:  "/tags",
+			buildMocks: func(m test.AppMocks) {
+				m.TagAppMock.EXPECT().GetCompanyTags(gomock.Any()).Return([]entity.Tag{
+					{UUID: tagUUID, Name: "promotion", Curated: true, NotesCount: 3},
+					{UUID: "tag-uuid-2", Name: "project-x", NotesCount: 1},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response []viewmodel.TagResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response, 2)
+				require.Equal(t, int64(3), response[0].NotesCount)
+				require.False(t, response[1].Curated)
+			},
+		},
+	})
+}
+
+func TestHandler_handleGetTagAnalytics(t *testing.T) {
+	from := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	periodStart := time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC)
+
+	runTagTests(t, http.MethodGet, []tagTest{
+		{
+			name: "Should return the counts per person and over time",
+			url:  "/tags/analytics?tags=promotion,burnout-risk&interval=week&from=2025-01-01",
+			buildMocks: func(m test.AppMocks) {
+				m.TagAppMock.EXPECT().GetTagAnalytics(gomock.Any(), entity.TagAnalyticsFilters{
+					TagNames: []string{"promotion", "burnout-risk"},
+					From:     &from,
+					Interval: domain.TagAnalyticsIntervalWeek,
+				}).Return(entity.TagAnalytics{
+					ByPerson: []entity.TagPersonCount{{TagName: "promotion", PersonUUID: "person-uuid-1", PersonName: "Maria", Count: 2}},
+					OverTime: []entity.TagPeriodCount{{TagName: "promotion", PeriodStart: periodStart, Count: 2}},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response viewmodel.TagAnalyticsResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Equal(t, []viewmodel.TagPersonCountResponse{
+					{Tag: "promotion", PersonUUID: "person-uuid-1", PersonName: "Maria", Count: 2},
+				}, response.ByPerson)
+				require.Equal(t, []viewmodel.TagPeriodCountResponse{
+					{Tag: "promotion", PeriodStart: "2025-03-03", Count: 2},
+				}, response.OverTime)
+			},
+		},
+		{
+			name: "Should return unprocessable entity when a date is invalid",
+			url:  "/tags/analytics?from=yesterday",
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+			},
+		},
+	})
+}
+
+func TestHandler_handleDeleteTag(t *testing.T) {
+	runTagTests(t, http.MethodDelete, []tagTest{
+		{
+			name: "Should delete the tag",
+			url:  "/tags/" + tagUUID,
+			buildMocks: func(m test.AppMocks) {
+				m.TagAppMock.EXPECT().DeleteTag(gomock.Any(), tagUUID).Return(nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNoContent, recorder.Code)
+			},
+		},
+		{
+			name: "Should return not found when the tag does not exist",
+			url:  "/tags/" + tagUUID,
+			buildMocks: func(m test.AppMocks) {
+				m.TagAppMock.EXPECT().DeleteTag(gomock.Any(), tagUUID).Return(resterrors.NewNotFoundError("tag not found")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusNotFound, recorder.Code)
+			},
+		},
+	})
+}
+
+func TestHandler_handleSetNoteTags(t *testing.T) {
+	runTagTests(t, http.MethodPut, []tagTest{
+		{
+			name: "Should replace the tags of the note",
+			url:  "/notes/" + noteUUID + "/tags",
+			body: viewmodel.NoteTagsRequest{Tags: []string{"promotion"}},
+			buildMocks: func(m test.AppMocks) {
+				m.TagAppMock.EXPECT().SetNoteTags(gomock.Any(), noteUUID, []string{"promotion"}).Return([]entity.Tag{
+					{UUID: tagUUID, Name: "promotion"},
+					{UUID: "tag-uuid-2", Name: "project-x"},
+				}, nil).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+
+				var response []viewmodel.TagResponse
+				require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+				require.Len(t, response, 2)
+			},
+		},
+		{
+			name: "Should return error when the service fails",
+			url:  "/notes/" + noteUUID + "/tags",
+			body: viewmodel.NoteTagsRequest{Tags: []string{"project x"}},
+			buildMocks: func(m test.AppMocks) {
+				m.TagAppMock.EXPECT().SetNoteTags(gomock.Any(), noteUUID, gomock.Any()).Return(nil, fmt.Errorf("error to set tags")).Times(1)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusServiceUnavailable, recorder.Code)
+			},
+		},
+	})
+}
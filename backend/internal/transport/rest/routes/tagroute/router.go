@@ -0,0 +1,97 @@
+package tagroute
+
+import (
+	"net/http"
+
+	"github.com/diegoclair/goswag"
+	"github.com/diegoclair/goswag/models"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/viewmodel"
+)
+
+const GroupRouteName = "companies/:company_uuid"
+
+const (
+	TagsRoute         = "/tags"
+	TagByUUIDRoute    = "/tags/:tag_uuid"
+	TagAnalyticsRoute = "/tags/analytics"
+	NoteTagsRoute     = "/notes/:note_uuid/tags"
+)
+
+type TagRouter struct {
+	ctrl *Handler
+}
+
+func NewRouter(ctrl *Handler) *TagRouter {
+	return &TagRouter{
+		ctrl: ctrl,
+	}
+}
+
+func (r *TagRouter) RegisterRoutes(g *routeutils.EchoGroups) {
+	router := g.CompanyGroup.Group(GroupRouteName)
+
+	router.POST(TagsRoute, r.ctrl.handleCreateTag).
+		Summary("Create tag").
+		Description("Create a curated tag of the company, like #promotion or #burnout-risk. Names are lowercase letters, digits, - and _. A tag already used by the notes becomes curated").
+		Read(viewmodel.TagRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusCreated,
+				Body:       viewmodel.TagResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(TagsRoute, r.ctrl.handleGetTags).
+		Summary("Get tags").
+		Description("Get the curated and the free-form tags of the company with how many notes use each one").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.TagResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.GET(TagAnalyticsRoute, r.ctrl.handleGetTagAnalytics).
+		Summary("Get tag analytics").
+		Description("Count the notes of the company by tag, per person and per week or month. Without a date range the last 6 months are counted").
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       viewmodel.TagAnalyticsResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		QueryParam("tags", "comma separated tags, all tags when empty", goswag.StringType, false).
+		QueryParam("interval", "week or month (default)", goswag.StringType, false).
+		QueryParam("from", "notes created from this date (YYYY-MM-DD or RFC3339)", goswag.StringType, false).
+		QueryParam("to", "notes created before this date (YYYY-MM-DD or RFC3339)", goswag.StringType, false).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.DELETE(TagByUUIDRoute, r.ctrl.handleDeleteTag).
+		Summary("Delete tag").
+		Description("Delete a tag of the company, removing it from the notes").
+		Returns([]models.ReturnType{{StatusCode: http.StatusNoContent}}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("tag_uuid", "tag uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+
+	router.PUT(NoteTagsRoute, r.ctrl.handleSetNoteTags).
+		Summary("Tag note").
+		Description("Replace the tags set on a note without writing them in its content. The #tags written in the content are kept. Returns all the tags of the note").
+		Read(viewmodel.NoteTagsRequest{}).
+		Returns([]models.ReturnType{
+			{
+				StatusCode: http.StatusOK,
+				Body:       []viewmodel.TagResponse{},
+			},
+		}).
+		PathParam("company_uuid", "company uuid", goswag.StringType, true).
+		PathParam("note_uuid", "note uuid", goswag.StringType, true).
+		HeaderParam(infra.TokenKey.String(), infra.TokenKeyDescription, goswag.StringType, true)
+}
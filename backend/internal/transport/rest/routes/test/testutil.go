@@ -14,10 +14,26 @@ import (
 	"github.com/diegoclair/leaderpro/infra/configmock"
 	"github.com/diegoclair/leaderpro/infra/contract"
 	infraMocks "github.com/diegoclair/leaderpro/infra/mocks"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/actionitemroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/activityroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/adminroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/attachmentroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/authroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/cadenceroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/calendarroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/companyroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/competencyroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/feedbackroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/goalroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/meetingroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/noteroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/notetemplateroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/personroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/reminderroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/reviewroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/scimroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/shared"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/tagroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/userroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
 	servermiddleware "github.com/diegoclair/leaderpro/internal/transport/rest/serverMiddleware"
@@ -29,12 +45,27 @@ import (
 )
 
 type AppMocks struct {
-	UserAppMock    *mocks.MockUserApp
-	AuthAppMock    *mocks.MockAuthApp
-	PersonAppMock  *mocks.MockPersonApp
-	CompanyAppMock *mocks.MockCompanyApp
-	AuthTokenMock  *infraMocks.MockAuthToken
-	CacheMock      *mocks.MockCacheManager
+	UserAppMock         *mocks.MockUserApp
+	AuthAppMock         *mocks.MockAuthApp
+	PersonAppMock       *mocks.MockPersonApp
+	CompanyAppMock      *mocks.MockCompanyApp
+	SCIMAppMock         *mocks.MockSCIMApp
+	ReminderAppMock     *mocks.MockReminderApp
+	MeetingAppMock      *mocks.MockMeetingApp
+	ActionItemAppMock   *mocks.MockActionItemApp
+	CadenceAppMock      *mocks.MockCadenceApp
+	CalendarAppMock     *mocks.MockCalendarApp
+	GoalAppMock         *mocks.MockGoalApp
+	ReviewAppMock       *mocks.MockReviewApp
+	FeedbackAppMock     *mocks.MockFeedbackRequestApp
+	CompetencyAppMock   *mocks.MockCompetencyApp
+	NoteTemplateAppMock *mocks.MockNoteTemplateApp
+	TagAppMock          *mocks.MockTagApp
+	AttachmentAppMock   *mocks.MockNoteAttachmentApp
+	ActivityAppMock     *mocks.MockActivityApp
+	JobAppMock          *mocks.MockJobApp
+	AuthTokenMock       *infraMocks.MockAuthToken
+	CacheMock           *mocks.MockCacheManager
 }
 
 func GetServerTest(t *testing.T) (m AppMocks, server goswag.Echo, ctrl *gomock.Controller) {
@@ -42,12 +73,27 @@ func GetServerTest(t *testing.T) (m AppMocks, server goswag.Echo, ctrl *gomock.C
 
 	ctrl = gomock.NewController(t)
 	m = AppMocks{
-		UserAppMock:    mocks.NewMockUserApp(ctrl),
-		AuthAppMock:    mocks.NewMockAuthApp(ctrl),
-		PersonAppMock:  mocks.NewMockPersonApp(ctrl),
-		CompanyAppMock: mocks.NewMockCompanyApp(ctrl),
-		AuthTokenMock:  infraMocks.NewMockAuthToken(ctrl),
-		CacheMock:      mocks.NewMockCacheManager(ctrl),
+		UserAppMock:         mocks.NewMockUserApp(ctrl),
+		AuthAppMock:         mocks.NewMockAuthApp(ctrl),
+		PersonAppMock:       mocks.NewMockPersonApp(ctrl),
+		CompanyAppMock:      mocks.NewMockCompanyApp(ctrl),
+		SCIMAppMock:         mocks.NewMockSCIMApp(ctrl),
+		ReminderAppMock:     mocks.NewMockReminderApp(ctrl),
+		MeetingAppMock:      mocks.NewMockMeetingApp(ctrl),
+		ActionItemAppMock:   mocks.NewMockActionItemApp(ctrl),
+		CadenceAppMock:      mocks.NewMockCadenceApp(ctrl),
+		CalendarAppMock:     mocks.NewMockCalendarApp(ctrl),
+		GoalAppMock:         mocks.NewMockGoalApp(ctrl),
+		ReviewAppMock:       mocks.NewMockReviewApp(ctrl),
+		FeedbackAppMock:     mocks.NewMockFeedbackRequestApp(ctrl),
+		CompetencyAppMock:   mocks.NewMockCompetencyApp(ctrl),
+		NoteTemplateAppMock: mocks.NewMockNoteTemplateApp(ctrl),
+		TagAppMock:          mocks.NewMockTagApp(ctrl),
+		AttachmentAppMock:   mocks.NewMockNoteAttachmentApp(ctrl),
+		ActivityAppMock:     mocks.NewMockActivityApp(ctrl),
+		JobAppMock:          mocks.NewMockJobApp(ctrl),
+		AuthTokenMock:       infraMocks.NewMockAuthToken(ctrl),
+		CacheMock:           mocks.NewMockCacheManager(ctrl),
 	}
 
 	cfg := configmock.New()
@@ -64,11 +110,21 @@ func GetServerTest(t *testing.T) (m AppMocks, server goswag.Echo, ctrl *gomock.C
 	companyGroup := privateGroup.Group("",
 		servermiddleware.CompanyOwnershipMiddleware(m.CompanyAppMock),
 	)
-	
+
+	scimGroup := appGroup.Group("",
+		servermiddleware.SCIMAuthMiddleware(m.SCIMAppMock),
+	)
+
+	adminGroup := appGroup.Group("",
+		servermiddleware.AdminAuthMiddleware(m.JobAppMock),
+	)
+
 	g := &routeutils.EchoGroups{
 		AppGroup:     appGroup,
 		PrivateGroup: privateGroup,
 		CompanyGroup: companyGroup,
+		SCIMGroup:    scimGroup,
+		AdminGroup:   adminGroup,
 	}
 	authHelper := shared.NewAuthHelper(m.AuthAppMock, m.UserAppMock, m.AuthTokenMock)
 
@@ -80,11 +136,59 @@ func GetServerTest(t *testing.T) (m AppMocks, server goswag.Echo, ctrl *gomock.C
 	personRoute := personroute.NewRouter(personHandler)
 	companyHandler := companyroute.NewHandler(m.CompanyAppMock)
 	companyRoute := companyroute.NewRouter(companyHandler)
+	scimHandler := scimroute.NewHandler(m.SCIMAppMock)
+	scimRoute := scimroute.NewRouter(scimHandler)
+	reminderHandler := reminderroute.NewHandler(m.ReminderAppMock)
+	reminderRoute := reminderroute.NewRouter(reminderHandler)
+	meetingHandler := meetingroute.NewHandler(m.MeetingAppMock)
+	meetingRoute := meetingroute.NewRouter(meetingHandler)
+	noteHandler := noteroute.NewHandler(m.PersonAppMock)
+	noteRoute := noteroute.NewRouter(noteHandler)
+	actionItemHandler := actionitemroute.NewHandler(m.ActionItemAppMock)
+	actionItemRoute := actionitemroute.NewRouter(actionItemHandler)
+	cadenceHandler := cadenceroute.NewHandler(m.CadenceAppMock)
+	cadenceRoute := cadenceroute.NewRouter(cadenceHandler)
+	calendarHandler := calendarroute.NewHandler(m.CalendarAppMock)
+	calendarRoute := calendarroute.NewRouter(calendarHandler)
+	goalHandler := goalroute.NewHandler(m.GoalAppMock)
+	goalRoute := goalroute.NewRouter(goalHandler)
+	reviewHandler := reviewroute.NewHandler(m.ReviewAppMock)
+	reviewRoute := reviewroute.NewRouter(reviewHandler)
+	feedbackHandler := feedbackroute.NewHandler(m.FeedbackAppMock)
+	feedbackRoute := feedbackroute.NewRouter(feedbackHandler)
+	competencyHandler := competencyroute.NewHandler(m.CompetencyAppMock)
+	competencyRoute := competencyroute.NewRouter(competencyHandler)
+	noteTemplateHandler := notetemplateroute.NewHandler(m.NoteTemplateAppMock)
+	noteTemplateRoute := notetemplateroute.NewRouter(noteTemplateHandler)
+	tagHandler := tagroute.NewHandler(m.TagAppMock)
+	tagRoute := tagroute.NewRouter(tagHandler)
+	attachmentHandler := attachmentroute.NewHandler(m.AttachmentAppMock)
+	attachmentRoute := attachmentroute.NewRouter(attachmentHandler)
+	activityHandler := activityroute.NewHandler(m.ActivityAppMock)
+	activityRoute := activityroute.NewRouter(activityHandler)
+	adminHandler := adminroute.NewHandler(m.JobAppMock)
+	adminRoute := adminroute.NewRouter(adminHandler)
 
 	userRoute.RegisterRoutes(g)
 	authRoute.RegisterRoutes(g)
 	personRoute.RegisterRoutes(g)
 	companyRoute.RegisterRoutes(g)
+	scimRoute.RegisterRoutes(g)
+	reminderRoute.RegisterRoutes(g)
+	meetingRoute.RegisterRoutes(g)
+	noteRoute.RegisterRoutes(g)
+	actionItemRoute.RegisterRoutes(g)
+	cadenceRoute.RegisterRoutes(g)
+	calendarRoute.RegisterRoutes(g)
+	goalRoute.RegisterRoutes(g)
+	reviewRoute.RegisterRoutes(g)
+	feedbackRoute.RegisterRoutes(g)
+	competencyRoute.RegisterRoutes(g)
+	noteTemplateRoute.RegisterRoutes(g)
+	tagRoute.RegisterRoutes(g)
+	attachmentRoute.RegisterRoutes(g)
+	activityRoute.RegisterRoutes(g)
+	adminRoute.RegisterRoutes(g)
 	return
 }
 
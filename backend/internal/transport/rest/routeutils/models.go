@@ -10,4 +10,8 @@ type EchoGroups struct {
 	PrivateGroup models.EchoGroup
 	// CompanyGroup is the group for routes that need authentication + company ownership validation
 	CompanyGroup models.EchoGroup
+	// SCIMGroup is the group for the SCIM endpoints called by the company HR system, authenticated by the company SCIM token
+	SCIMGroup models.EchoGroup
+	// AdminGroup is the group for the operation endpoints, authenticated by the admin token of the config
+	AdminGroup models.EchoGroup
 }
@@ -4,9 +4,11 @@ import (
 	"context"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/diegoclair/go_utils/resterrors"
 	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
 	echo "github.com/labstack/echo/v4"
 )
 
@@ -75,6 +77,33 @@ func GetPagingParams(c echo.Context, pageParameter, quantityParameter string) (t
 	return GetTakeSkipFromPageQuantity(page, quantity)
 }
 
+// IsCursorPaging tells whether the client asked for the cursor pagination, sending the cursor parameter.
+// The first page is asked with an empty cursor
+func IsCursorPaging(c echo.Context) bool {
+	return c.QueryParams().Has("cursor")
+}
+
+// GetCursorPageParams returns the page of the cursor pagination asked with the cursor, quantity and with_count parameters,
+// and an error when the cursor is not one returned by a previous page
+func GetCursorPageParams(c echo.Context) (page entity.CursorPage, err error) {
+	quantity, _ := strconv.ParseInt(c.QueryParam("quantity"), 10, 64)
+	page.Limit, _ = GetTakeSkipFromPageQuantity(1, quantity)
+	page.WithCount = GetBoolQueryParam(c, "with_count")
+
+	cursor := strings.TrimSpace(c.QueryParam("cursor"))
+	if cursor == "" {
+		return page, nil
+	}
+
+	after, err := entity.DecodePageCursor(cursor)
+	if err != nil {
+		return page, resterrors.NewUnprocessableEntity("Invalid cursor")
+	}
+	page.After = &after
+
+	return page, nil
+}
+
 func GetTakeSkipFromPageQuantity(page, quantity int64) (take, skip int64) {
 	if page < 1 {
 		page = 1
@@ -151,3 +180,28 @@ func GetBoolQueryParam(c echo.Context, paramName string) bool {
 	result, _ := BoolConverter(param)
 	return result
 }
+
+// TimeConverter accepts a full RFC3339 timestamp or a plain date (2006-01-02, UTC)
+func TimeConverter(value string) (time.Time, error) {
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return parsed, nil
+	}
+
+	return time.Parse(time.DateOnly, value)
+}
+
+// GetTimeQueryParam returns nil when the parameter is not present and an error when it's present but invalid
+func GetTimeQueryParam(c echo.Context, paramName string) (*time.Time, error) {
+	param := strings.TrimSpace(c.QueryParam(paramName))
+	if param == "" {
+		return nil, nil
+	}
+
+	result, err := TimeConverter(param)
+	if err != nil {
+		return nil, resterrors.NewUnprocessableEntity("Invalid " + paramName + ", expected YYYY-MM-DD or RFC3339")
+	}
+
+	return &result, nil
+}
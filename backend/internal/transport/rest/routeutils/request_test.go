@@ -6,8 +6,10 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
 	echo "github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
@@ -957,3 +959,110 @@ func TestGetTakeSkipFromPageQuantity(t *testing.T) {
 		})
 	}
 }
+
+func TestGetTimeQueryParam(t *testing.T) {
+	tests := []struct {
+		name       string
+		paramValue string
+		wantResult *time.Time
+		wantErr    bool
+	}{
+		{
+			name:       "Valid date",
+			paramValue: "2025-03-10",
+			wantResult: func() *time.Time { v := time.Date(2025, 3, 10, 0, 0, 0, 0, time.UTC); return &v }(),
+		},
+		{
+			name:       "Valid RFC3339",
+			paramValue: "2025-03-10T15:04:05Z",
+			wantResult: func() *time.Time { v := time.Date(2025, 3, 10, 15, 4, 5, 0, time.UTC); return &v }(),
+		},
+		{
+			name:       "Invalid value returns error",
+			paramValue: "10/03/2025",
+			wantErr:    true,
+		},
+		{
+			name:       "Empty parameter returns nil",
+			paramValue: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queryParams := map[string]string{}
+			if tt.paramValue != "" {
+				queryParams["test"] = tt.paramValue
+			}
+
+			c := setupEchoContext(queryParams)
+
+			got, err := routeutils.GetTimeQueryParam(c, "test")
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			if tt.wantResult == nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.True(t, tt.wantResult.Equal(*got))
+		})
+	}
+}
+
+func TestGetCursorPageParams(t *testing.T) {
+	cursor := entity.PageCursor{CreatedAt: time.Date(2025, 3, 10, 15, 4, 5, 0, time.UTC), ID: 42}
+
+	tests := []struct {
+		name        string
+		queryParams map[string]string
+		wantCursor  bool
+		wantPage    entity.CursorPage
+		wantErr     bool
+	}{
+		{
+			name:        "Paging by page without cursor parameter",
+			queryParams: map[string]string{"page": "2"},
+			wantCursor:  false,
+			wantPage:    entity.CursorPage{Limit: 10},
+		},
+		{
+			name:        "Empty cursor asks for the first page",
+			queryParams: map[string]string{"cursor": "", "quantity": "25", "with_count": "true"},
+			wantCursor:  true,
+			wantPage:    entity.CursorPage{Limit: 25, WithCount: true},
+		},
+		{
+			name:        "Cursor of a previous page",
+			queryParams: map[string]string{"cursor": cursor.Encode()},
+			wantCursor:  true,
+			wantPage:    entity.CursorPage{After: &cursor, Limit: 10},
+		},
+		{
+			name:        "Invalid cursor returns error",
+			queryParams: map[string]string{"cursor": "not-a-cursor"},
+			wantCursor:  true,
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := setupEchoContext(tt.queryParams)
+
+			assert.Equal(t, tt.wantCursor, routeutils.IsCursorPaging(c))
+
+			got, err := routeutils.GetCursorPageParams(c)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantPage, got)
+		})
+	}
+}
@@ -1,7 +1,11 @@
 package routeutils
 
 import (
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"strconv"
 
 	"github.com/diegoclair/go_utils/resterrors"
 	echo "github.com/labstack/echo/v4"
@@ -22,10 +26,38 @@ func ResponseCreated(c echo.Context, data ...interface{}) error {
 	return c.NoContent(http.StatusCreated)
 }
 
+// ResponseAccepted returns a 202 Accepted response, for requests processed in background
+func ResponseAccepted(c echo.Context, data interface{}) error {
+	return c.JSON(http.StatusAccepted, data)
+}
+
 func ResponseAPIOk(c echo.Context, data interface{}) error {
 	return c.JSON(http.StatusOK, data)
 }
 
+// ResponseFile returns the data as a file download
+func ResponseFile(c echo.Context, fileName, contentType string, data []byte) error {
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", fileName))
+	return c.Blob(http.StatusOK, contentType, data)
+}
+
+// ResponseStream streams the content as a file, shown by the browser when inline is true, otherwise downloaded
+func ResponseStream(c echo.Context, fileName, contentType string, size int64, inline bool, content io.Reader) error {
+	disposition := "attachment"
+	if inline {
+		disposition = "inline"
+	}
+
+	header := c.Response().Header()
+	header.Set(echo.HeaderContentDisposition, mime.FormatMediaType(disposition, map[string]string{"filename": fileName}))
+	header.Set(echo.HeaderXContentTypeOptions, "nosniff")
+	if size > 0 {
+		header.Set(echo.HeaderContentLength, strconv.FormatInt(size, 10))
+	}
+
+	return c.Stream(http.StatusOK, contentType, content)
+}
+
 func ResponseUnauthorizedError(c echo.Context, errMsg string) error {
 	err := resterrors.NewUnauthorizedError(errMsg)
 	return c.JSON(err.StatusCode(), err)
@@ -12,14 +12,30 @@ import (
 	"github.com/diegoclair/leaderpro/internal/application/service"
 	"github.com/diegoclair/leaderpro/internal/domain"
 	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/actionitemroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/activityroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/adminroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/airoute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/attachmentroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/authroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/cadenceroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/calendarroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/companyroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/competencyroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/dashboardroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/feedbackroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/goalroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/meetingroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/noteroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/notetemplateroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/personroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/pingroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/reminderroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/reviewroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/scimroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/shared"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/swaggerroute"
+	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/tagroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routes/userroute"
 	"github.com/diegoclair/leaderpro/internal/transport/rest/routeutils"
 	servermiddleware "github.com/diegoclair/leaderpro/internal/transport/rest/serverMiddleware"
@@ -66,33 +82,81 @@ func NewRestServer(services *service.Apps, authToken infraContract.AuthToken, in
 	authHelper := shared.NewAuthHelper(services.Auth, services.User, authToken)
 
 	pingHandler := pingroute.NewHandler()
+	actionItemHandler := actionitemroute.NewHandler(services.ActionItem)
+	activityHandler := activityroute.NewHandler(services.Activity)
+	attachmentHandler := attachmentroute.NewHandler(services.Attachment)
 	authHandler := authroute.NewHandler(services.Auth, authToken, authHelper, infra.Logger())
 	aiHandler := airoute.NewHandler(services.AI)
+	cadenceHandler := cadenceroute.NewHandler(services.Cadence)
+	calendarHandler := calendarroute.NewHandler(services.Calendar)
 	companyHandler := companyroute.NewHandler(services.Company)
+	competencyHandler := competencyroute.NewHandler(services.Competency)
 	dashboardHandler := dashboardroute.NewHandler(services.Dashboard)
+	feedbackHandler := feedbackroute.NewHandler(services.Feedback)
+	goalHandler := goalroute.NewHandler(services.Goal)
 	personHandler := personroute.NewHandler(services.Person)
+	reminderHandler := reminderroute.NewHandler(services.Reminder)
+	reviewHandler := reviewroute.NewHandler(services.Review)
+	meetingHandler := meetingroute.NewHandler(services.Meeting)
+	noteHandler := noteroute.NewHandler(services.Person)
+	noteTemplateHandler := notetemplateroute.NewHandler(services.NoteTemplate)
+	scimHandler := scimroute.NewHandler(services.SCIM)
+	tagHandler := tagroute.NewHandler(services.Tag)
+	adminHandler := adminroute.NewHandler(services.Job)
 	userHandler := userroute.NewHandler(services.User, authHelper)
 
 	pingRoute := pingroute.NewRouter(pingHandler)
+	actionItemRoute := actionitemroute.NewRouter(actionItemHandler)
+	activityRoute := activityroute.NewRouter(activityHandler)
+	attachmentRoute := attachmentroute.NewRouter(attachmentHandler)
 	authRoute := authroute.NewRouter(authHandler)
 	aiRoute := airoute.NewRouter(aiHandler)
+	cadenceRoute := cadenceroute.NewRouter(cadenceHandler)
+	calendarRoute := calendarroute.NewRouter(calendarHandler)
 	companyRoute := companyroute.NewRouter(companyHandler)
+	competencyRoute := competencyroute.NewRouter(competencyHandler)
 	dashboardRoute := dashboardroute.NewRouter(dashboardHandler)
+	feedbackRoute := feedbackroute.NewRouter(feedbackHandler)
+	goalRoute := goalroute.NewRouter(goalHandler)
 	personRoute := personroute.NewRouter(personHandler)
+	reminderRoute := reminderroute.NewRouter(reminderHandler)
+	reviewRoute := reviewroute.NewRouter(reviewHandler)
+	meetingRoute := meetingroute.NewRouter(meetingHandler)
+	noteRoute := noteroute.NewRouter(noteHandler)
+	noteTemplateRoute := notetemplateroute.NewRouter(noteTemplateHandler)
+	scimRoute := scimroute.NewRouter(scimHandler)
+	tagRoute := tagroute.NewRouter(tagHandler)
+	adminRoute := adminroute.NewRouter(adminHandler)
 	userRoute := userroute.NewRouter(userHandler)
 
 	swaggerRoute := swaggerroute.NewRouter(router.Echo())
 
 	server := &Server{Router: router, cache: infra.CacheManager()}
+	server.addRouters(actionItemRoute)
+	server.addRouters(activityRoute)
+	server.addRouters(adminRoute)
+	server.addRouters(attachmentRoute)
 	server.addRouters(authRoute)
 	server.addRouters(aiRoute)
+	server.addRouters(cadenceRoute)
+	server.addRouters(calendarRoute)
 	server.addRouters(companyRoute)
+	server.addRouters(competencyRoute)
 	server.addRouters(dashboardRoute)
+	server.addRouters(feedbackRoute)
+	server.addRouters(goalRoute)
+	server.addRouters(meetingRoute)
+	server.addRouters(noteRoute)
+	server.addRouters(noteTemplateRoute)
 	server.addRouters(personRoute)
 	server.addRouters(pingRoute)
+	server.addRouters(reminderRoute)
+	server.addRouters(reviewRoute)
+	server.addRouters(scimRoute)
+	server.addRouters(tagRoute)
 	server.addRouters(swaggerRoute)
 	server.addRouters(userRoute)
-	server.registerAppRouters(authToken, services.Company)
+	server.registerAppRouters(authToken, services.Company, services.SCIM, services.Job)
 
 	server.setupPrometheus(appName)
 
@@ -103,7 +167,7 @@ func (r *Server) addRouters(router routeutils.IRoute) {
 	r.routes = append(r.routes, router)
 }
 
-func (r *Server) registerAppRouters(authToken infraContract.AuthToken, companyService contract.CompanyApp) {
+func (r *Server) registerAppRouters(authToken infraContract.AuthToken, companyService contract.CompanyApp, scimService contract.SCIMApp, jobService contract.JobApp) {
 	g := &routeutils.EchoGroups{}
 	g.AppGroup = r.Router.Group("/")
 	g.PrivateGroup = g.AppGroup.Group("",
@@ -112,6 +176,12 @@ func (r *Server) registerAppRouters(authToken infraContract.AuthToken, companySe
 	g.CompanyGroup = g.PrivateGroup.Group("",
 		servermiddleware.CompanyOwnershipMiddleware(companyService),
 	)
+	g.SCIMGroup = g.AppGroup.Group("",
+		servermiddleware.SCIMAuthMiddleware(scimService),
+	)
+	g.AdminGroup = g.AppGroup.Group("",
+		servermiddleware.AdminAuthMiddleware(jobService),
+	)
 
 	for _, appRouter := range r.routes {
 		appRouter.RegisterRoutes(g)
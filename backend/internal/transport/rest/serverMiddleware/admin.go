@@ -0,0 +1,23 @@
+package servermiddleware
+
+import (
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	echo "github.com/labstack/echo/v4"
+)
+
+// AdminAuthMiddleware validates the admin token sent to the operation endpoints
+func AdminAuthMiddleware(jobService contract.JobApp) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			token := ctx.Request().Header.Get(infra.AdminTokenHeader)
+
+			err := jobService.ValidateAdminToken(ctx.Request().Context(), token)
+			if err != nil {
+				return err
+			}
+
+			return next(ctx)
+		}
+	}
+}
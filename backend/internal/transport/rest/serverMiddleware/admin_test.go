@@ -0,0 +1,53 @@
+package servermiddleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/mocks"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAdminAuthMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockJobService := mocks.NewMockJobApp(ctrl)
+	middleware := AdminAuthMiddleware(mockJobService)
+
+	newContext := func(token string) echo.Context {
+		req := httptest.NewRequest(http.MethodGet, "/admin/jobs", nil)
+		if token != "" {
+			req.Header.Set(infra.AdminTokenHeader, token)
+		}
+		return echo.New().NewContext(req, httptest.NewRecorder())
+	}
+
+	t.Run("Should complete the middleware without errors when the token is valid", func(t *testing.T) {
+		mockJobService.EXPECT().ValidateAdminToken(gomock.Any(), "admin-token").Return(nil)
+
+		called := false
+		err := middleware(func(c echo.Context) error {
+			called = true
+			return nil
+		})(newContext("admin-token"))
+
+		assert.Nil(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("Should return error when the token is invalid", func(t *testing.T) {
+		mockJobService.EXPECT().ValidateAdminToken(gomock.Any(), "").
+			Return(resterrors.NewUnauthorizedError("admin token is required"))
+
+		err := middleware(func(c echo.Context) error {
+			return nil
+		})(newContext(""))
+
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusUnauthorized, err.(resterrors.RestErr).StatusCode())
+	})
+}
@@ -0,0 +1,39 @@
+package servermiddleware
+
+import (
+	"strings"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/internal/domain/contract"
+	echo "github.com/labstack/echo/v4"
+)
+
+const bearerPrefix = "bearer "
+
+// SCIMAuthMiddleware validates the bearer token sent by the HR system to the SCIM endpoints of the company_uuid in the route
+func SCIMAuthMiddleware(scimService contract.SCIMApp) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			companyUUID := ctx.Param("company_uuid")
+			if companyUUID == "" {
+				return resterrors.NewBadRequestError("company_uuid is required")
+			}
+
+			authorization := ctx.Request().Header.Get(echo.HeaderAuthorization)
+			if !strings.HasPrefix(strings.ToLower(authorization), bearerPrefix) {
+				return resterrors.NewUnauthorizedError("bearer token is required")
+			}
+			token := strings.TrimSpace(authorization[len(bearerPrefix):])
+
+			err := scimService.ValidateSCIMToken(ctx.Request().Context(), companyUUID, token)
+			if err != nil {
+				return err
+			}
+
+			ctx.Set(infra.CompanyUUIDKey.String(), companyUUID)
+
+			return next(ctx)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package servermiddleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/diegoclair/go_utils/resterrors"
+	"github.com/diegoclair/leaderpro/infra"
+	"github.com/diegoclair/leaderpro/mocks"
+	echo "github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSCIMAuthMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockSCIMService := mocks.NewMockSCIMApp(ctrl)
+	middleware := SCIMAuthMiddleware(mockSCIMService)
+
+	newContext := func(authorization string) echo.Context {
+		req := httptest.NewRequest(http.MethodGet, "/scim/v2/companies/company-uuid-123/Users", nil)
+		if authorization != "" {
+			req.Header.Set(echo.HeaderAuthorization, authorization)
+		}
+		c := echo.New().NewContext(req, httptest.NewRecorder())
+		c.SetPath("/scim/v2/companies/:company_uuid/Users")
+		c.SetParamNames("company_uuid")
+		c.SetParamValues("company-uuid-123")
+		return c
+	}
+
+	t.Run("Should complete the middleware without errors when the token is valid", func(t *testing.T) {
+		c := newContext("Bearer scim-token")
+
+		mockSCIMService.EXPECT().ValidateSCIMToken(gomock.Any(), "company-uuid-123", "scim-token").Return(nil)
+
+		err := middleware(func(c echo.Context) error {
+			return nil
+		})(c)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "company-uuid-123", c.Get(infra.CompanyUUIDKey.String()))
+	})
+
+	t.Run("Should return error when the bearer token is missing", func(t *testing.T) {
+		c := newContext("Basic dXNlcjpwYXNz")
+
+		err := middleware(func(c echo.Context) error {
+			return nil
+		})(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusUnauthorized, err.(resterrors.RestErr).StatusCode())
+	})
+
+	t.Run("Should return error when the token is invalid", func(t *testing.T) {
+		c := newContext("bearer wrong-token")
+
+		mockSCIMService.EXPECT().ValidateSCIMToken(gomock.Any(), "company-uuid-123", "wrong-token").
+			Return(resterrors.NewUnauthorizedError("invalid SCIM token"))
+
+		err := middleware(func(c echo.Context) error {
+			return nil
+		})(c)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, http.StatusUnauthorized, err.(resterrors.RestErr).StatusCode())
+		assert.Nil(t, c.Get(infra.CompanyUUIDKey.String()))
+	})
+}
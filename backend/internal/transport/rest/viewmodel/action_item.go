@@ -0,0 +1,75 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type ActionItemRequest struct {
+	Description string     `json:"description" validate:"required"`
+	Owner       string     `json:"owner,omitempty" validate:"omitempty,oneof=manager report"` // defaults to manager
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	NoteUUID    *string    `json:"note_uuid,omitempty"`    // note where the item was agreed
+	MeetingUUID *string    `json:"meeting_uuid,omitempty"` // meeting where the item was agreed
+}
+
+func (r *ActionItemRequest) ToEntity() entity.ActionItem {
+	return entity.ActionItem{
+		Description:       r.Description,
+		Owner:             r.Owner,
+		DueDate:           r.DueDate,
+		SourceNoteUUID:    r.NoteUUID,
+		SourceMeetingUUID: r.MeetingUUID,
+	}
+}
+
+type UpdateActionItemRequest struct {
+	Description string     `json:"description" validate:"required"`
+	Owner       string     `json:"owner,omitempty" validate:"omitempty,oneof=manager report"`
+	DueDate     *time.Time `json:"due_date,omitempty"`
+	Status      string     `json:"status,omitempty" validate:"omitempty,oneof=open done canceled"`
+}
+
+func (r *UpdateActionItemRequest) ToEntity() entity.ActionItem {
+	return entity.ActionItem{
+		Description: r.Description,
+		Owner:       r.Owner,
+		DueDate:     r.DueDate,
+		Status:      r.Status,
+	}
+}
+
+type ActionItemResponse struct {
+	UUID        string     `json:"uuid"`
+	PersonUUID  string     `json:"person_uuid"`
+	PersonName  string     `json:"person_name"`
+	Owner       string     `json:"owner"`
+	Description string     `json:"description"`
+	DueDate     *string    `json:"due_date,omitempty"` // YYYY-MM-DD
+	Status      string     `json:"status"`
+	NoteUUID    *string    `json:"note_uuid,omitempty"`
+	MeetingUUID *string    `json:"meeting_uuid,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+func (r *ActionItemResponse) FillFromEntity(item entity.ActionItem) {
+	r.UUID = item.UUID
+	r.PersonUUID = item.PersonUUID
+	r.PersonName = item.PersonName
+	r.Owner = item.Owner
+	r.Description = item.Description
+	r.Status = item.Status
+	r.NoteUUID = item.SourceNoteUUID
+	r.MeetingUUID = item.SourceMeetingUUID
+	r.CompletedAt = item.CompletedAt
+	r.CreatedAt = item.CreatedAt
+	r.UpdatedAt = item.UpdatedAt
+
+	if item.DueDate != nil {
+		dueDate := item.DueDate.Format("2006-01-02")
+		r.DueDate = &dueDate
+	}
+}
@@ -0,0 +1,51 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type ActivitySeenRequest struct {
+	ActivityUUID string `json:"activity_uuid"` // empty marks all the activities as seen
+}
+
+type ActivityResponse struct {
+	UUID          string    `json:"uuid"`
+	Type          string    `json:"type"`
+	Detail        string    `json:"detail,omitempty"`
+	PersonUUID    string    `json:"person_uuid"`
+	PersonName    string    `json:"person_name"`
+	AuthorName    string    `json:"author_name,omitempty"` // empty when extracted by the AI
+	ReferenceUUID *string   `json:"reference_uuid,omitempty"`
+	Unread        bool      `json:"unread"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+func (r *ActivityResponse) FillFromEntity(activity entity.Activity) {
+	r.UUID = activity.UUID
+	r.Type = activity.Type
+	r.Detail = activity.Detail
+	r.PersonUUID = activity.PersonUUID
+	r.PersonName = activity.PersonName
+	r.AuthorName = activity.UserName
+	r.ReferenceUUID = activity.ReferenceUUID
+	r.Unread = activity.Unread
+	r.CreatedAt = activity.CreatedAt
+}
+
+// ActivityFeedResponse is a page of the feed with how many activities of the company the user has not seen
+type ActivityFeedResponse struct {
+	PaginatedResponse[[]ActivityResponse]
+	UnreadCount int64 `json:"unread_count"`
+}
+
+func (r *ActivityFeedResponse) FillFromEntity(feed entity.ActivityFeed, page entity.CursorPage) {
+	activities := make([]ActivityResponse, len(feed.Activities))
+	for i, activity := range feed.Activities {
+		activities[i].FillFromEntity(activity)
+	}
+
+	r.PaginatedResponse = BuildCursorPaginatedResponse(activities, page, feed.Page)
+	r.UnreadCount = feed.UnreadCount
+}
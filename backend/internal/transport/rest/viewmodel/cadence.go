@@ -0,0 +1,45 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type CadenceRequest struct {
+	Cadence string `json:"cadence" validate:"omitempty,oneof=weekly biweekly monthly custom"` // empty removes the cadence
+	Days    *int   `json:"days,omitempty" validate:"omitempty,min=1,max=365"`                 // required for the custom cadence
+}
+
+func (r *CadenceRequest) ToEntity() entity.OneOnOneCadence {
+	return entity.OneOnOneCadence{
+		Cadence: r.Cadence,
+		Days:    r.Days,
+	}
+}
+
+// OneOnOneDueResponse is an overdue or upcoming 1:1 by the person's cadence
+type OneOnOneDueResponse struct {
+	PersonUUID       string     `json:"person_uuid"`
+	PersonName       string     `json:"person_name"`
+	Cadence          string     `json:"cadence"`
+	CadenceDays      *int       `json:"cadence_days,omitempty"`
+	LastOneOnOneDate *time.Time `json:"last_one_on_one_date,omitempty"`
+	DueDate          string     `json:"due_date"` // YYYY-MM-DD, in the user timezone
+	DaysUntil        int        `json:"days_until"`
+	Overdue          bool       `json:"overdue"`
+	ScheduledAt      *time.Time `json:"scheduled_at,omitempty"`
+}
+
+// FillFromEntity fills the 1:1 due response from entity
+func (r *OneOnOneDueResponse) FillFromEntity(due entity.OneOnOneDue) {
+	r.PersonUUID = due.PersonUUID
+	r.PersonName = due.PersonName
+	r.Cadence = due.Cadence
+	r.CadenceDays = due.CadenceDays
+	r.LastOneOnOneDate = due.LastOneOnOneDate
+	r.DueDate = due.DueDate.Format("2006-01-02")
+	r.DaysUntil = due.DaysUntil
+	r.Overdue = due.Overdue
+	r.ScheduledAt = due.ScheduledAt
+}
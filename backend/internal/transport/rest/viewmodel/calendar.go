@@ -0,0 +1,59 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+// CalendarFeedResponse is the secret feed token and URL, they are only returned when generated
+type CalendarFeedResponse struct {
+	Token string `json:"token"`
+	URL   string `json:"url"`
+}
+
+type CalendarImportResponse struct {
+	DryRun    bool                          `json:"dry_run"`
+	Created   int                           `json:"created"`
+	Linked    int                           `json:"linked"`
+	Updated   int                           `json:"updated"`
+	Canceled  int                           `json:"canceled"`
+	Unchanged int                           `json:"unchanged"`
+	Skipped   int                           `json:"skipped"`
+	Events    []CalendarImportEventResponse `json:"events"`
+}
+
+type CalendarImportEventResponse struct {
+	UID         string     `json:"uid"`
+	Summary     string     `json:"summary"`
+	Start       *time.Time `json:"start,omitempty"`
+	Status      string     `json:"status"`
+	Reason      string     `json:"reason,omitempty"`
+	PersonUUID  string     `json:"person_uuid,omitempty"`
+	PersonName  string     `json:"person_name,omitempty"`
+	MeetingUUID string     `json:"meeting_uuid,omitempty"`
+}
+
+func (r *CalendarImportResponse) FillFromEntity(result entity.CalendarImportResult) {
+	r.DryRun = result.DryRun
+	r.Created = result.Created
+	r.Linked = result.Linked
+	r.Updated = result.Updated
+	r.Canceled = result.Canceled
+	r.Unchanged = result.Unchanged
+	r.Skipped = result.Skipped
+
+	r.Events = make([]CalendarImportEventResponse, 0, len(result.Events))
+	for _, event := range result.Events {
+		r.Events = append(r.Events, CalendarImportEventResponse{
+			UID:         event.UID,
+			Summary:     event.Summary,
+			Start:       event.Start,
+			Status:      event.Status,
+			Reason:      event.Reason,
+			PersonUUID:  event.PersonUUID,
+			PersonName:  event.PersonName,
+			MeetingUUID: event.MeetingUUID,
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type CompetencyLevelRequest struct {
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description,omitempty"`
+}
+
+type CompetencyDescriptorRequest struct {
+	Level       int    `json:"level" validate:"required"` // 1-based position of the level in the levels of the framework
+	Description string `json:"description" validate:"required"`
+}
+
+type CompetencyRequest struct {
+	Name        string                        `json:"name" validate:"required"`
+	Description string                        `json:"description,omitempty"`
+	Descriptors []CompetencyDescriptorRequest `json:"descriptors,omitempty"`
+}
+
+type CompetencyFrameworkRequest struct {
+	Name         string                   `json:"name" validate:"required"`
+	Description  string                   `json:"description,omitempty"`
+	Levels       []CompetencyLevelRequest `json:"levels" validate:"required,min=1"` // from the least to the most senior
+	Competencies []CompetencyRequest      `json:"competencies" validate:"required,min=1"`
+}
+
+func (r *CompetencyFrameworkRequest) ToEntity() entity.CompetencyFramework {
+	framework := entity.CompetencyFramework{
+		Name:        r.Name,
+		Description: r.Description,
+	}
+	for _, level := range r.Levels {
+		framework.Levels = append(framework.Levels, entity.CompetencyLevel{
+			Name:        level.Name,
+			Description: level.Description,
+		})
+	}
+	for _, competency := range r.Competencies {
+		item := entity.Competency{
+			Name:        competency.Name,
+			Description: competency.Description,
+		}
+		for _, descriptor := range competency.Descriptors {
+			item.Descriptors = append(item.Descriptors, entity.CompetencyDescriptor{
+				LevelPosition: descriptor.Level,
+				Description:   descriptor.Description,
+			})
+		}
+		framework.Competencies = append(framework.Competencies, item)
+	}
+	return framework
+}
+
+type CompetencyRatingRequest struct {
+	CompetencyUUID string `json:"competency_uuid" validate:"required"`
+	LevelUUID      string `json:"level_uuid" validate:"required"` // level the person demonstrates in the competency
+	Comment        string `json:"comment,omitempty"`
+}
+
+type CompetencyAssessmentRequest struct {
+	FrameworkUUID     string                    `json:"framework_uuid" validate:"required"`
+	ExpectedLevelUUID string                    `json:"expected_level_uuid" validate:"required"` // level of the role of the person
+	Notes             string                    `json:"notes,omitempty"`
+	AssessedAt        *time.Time                `json:"assessed_at,omitempty"` // defaults to today
+	Ratings           []CompetencyRatingRequest `json:"ratings" validate:"required,min=1"`
+}
+
+func (r *CompetencyAssessmentRequest) ToEntity() entity.CompetencyAssessment {
+	assessment := entity.CompetencyAssessment{
+		FrameworkUUID:     r.FrameworkUUID,
+		ExpectedLevelUUID: r.ExpectedLevelUUID,
+		Notes:             r.Notes,
+	}
+	if r.AssessedAt != nil {
+		assessment.AssessedAt = *r.AssessedAt
+	}
+	for _, rating := range r.Ratings {
+		assessment.Ratings = append(assessment.Ratings, entity.CompetencyRating{
+			CompetencyUUID: rating.CompetencyUUID,
+			LevelUUID:      rating.LevelUUID,
+			Comment:        rating.Comment,
+		})
+	}
+	return assessment
+}
+
+type NoteCompetenciesRequest struct {
+	CompetencyUUIDs []string `json:"competency_uuids"` // empty removes all the competencies of the note
+}
+
+type CompetencyLevelResponse struct {
+	UUID        string `json:"uuid"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Position    int    `json:"position"`
+}
+
+func (r *CompetencyLevelResponse) FillFromEntity(level entity.CompetencyLevel) {
+	r.UUID = level.UUID
+	r.Name = level.Name
+	r.Description = level.Description
+	r.Position = level.Position
+}
+
+type CompetencyDescriptorResponse struct {
+	LevelUUID   string `json:"level_uuid"`
+	Description string `json:"description"`
+}
+
+type CompetencyResponse struct {
+	UUID          string                         `json:"uuid"`
+	FrameworkUUID string                         `json:"framework_uuid"`
+	Name          string                         `json:"name"`
+	Description   string                         `json:"description,omitempty"`
+	Position      int                            `json:"position"`
+	Descriptors   []CompetencyDescriptorResponse `json:"descriptors,omitempty"` // ordered by level
+}
+
+func (r *CompetencyResponse) FillFromEntity(competency entity.Competency) {
+	r.UUID = competency.UUID
+	r.FrameworkUUID = competency.FrameworkUUID
+	r.Name = competency.Name
+	r.Description = competency.Description
+	r.Position = competency.Position
+
+	for _, descriptor := range competency.Descriptors {
+		r.Descriptors = append(r.Descriptors, CompetencyDescriptorResponse{
+			LevelUUID:   descriptor.LevelUUID,
+			Description: descriptor.Description,
+		})
+	}
+}
+
+type CompetencyFrameworkResponse struct {
+	UUID         string                    `json:"uuid"`
+	Name         string                    `json:"name"`
+	Description  string                    `json:"description,omitempty"`
+	Levels       []CompetencyLevelResponse `json:"levels"` // from the least to the most senior
+	Competencies []CompetencyResponse      `json:"competencies"`
+	CreatedAt    time.Time                 `json:"created_at"`
+	UpdatedAt    time.Time                 `json:"updated_at"`
+}
+
+func (r *CompetencyFrameworkResponse) FillFromEntity(framework entity.CompetencyFramework) {
+	r.UUID = framework.UUID
+	r.Name = framework.Name
+	r.Description = framework.Description
+	r.CreatedAt = framework.CreatedAt
+	r.UpdatedAt = framework.UpdatedAt
+
+	r.Levels = make([]CompetencyLevelResponse, len(framework.Levels))
+	for i, level := range framework.Levels {
+		r.Levels[i].FillFromEntity(level)
+	}
+
+	r.Competencies = make([]CompetencyResponse, len(framework.Competencies))
+	for i, competency := range framework.Competencies {
+		r.Competencies[i].FillFromEntity(competency)
+		r.Competencies[i].FrameworkUUID = framework.UUID
+	}
+}
+
+type CompetencyRatingResponse struct {
+	CompetencyUUID string `json:"competency_uuid"`
+	CompetencyName string `json:"competency_name"`
+	LevelUUID      string `json:"level_uuid"`
+	LevelName      string `json:"level_name"`
+	LevelPosition  int    `json:"level_position"`
+	Comment        string `json:"comment,omitempty"`
+}
+
+type CompetencyAssessmentResponse struct {
+	UUID              string                     `json:"uuid"`
+	PersonUUID        string                     `json:"person_uuid"`
+	FrameworkUUID     string                     `json:"framework_uuid"`
+	FrameworkName     string                     `json:"framework_name"`
+	ExpectedLevelUUID string                     `json:"expected_level_uuid"`
+	ExpectedLevelName string                     `json:"expected_level_name"`
+	Notes             string                     `json:"notes,omitempty"`
+	AssessedAt        string                     `json:"assessed_at"` // YYYY-MM-DD
+	Ratings           []CompetencyRatingResponse `json:"ratings"`
+	CreatedAt         time.Time                  `json:"created_at"`
+}
+
+func (r *CompetencyAssessmentResponse) FillFromEntity(assessment entity.CompetencyAssessment) {
+	r.UUID = assessment.UUID
+	r.PersonUUID = assessment.PersonUUID
+	r.FrameworkUUID = assessment.FrameworkUUID
+	r.FrameworkName = assessment.FrameworkName
+	r.ExpectedLevelUUID = assessment.ExpectedLevelUUID
+	r.ExpectedLevelName = assessment.ExpectedLevelName
+	r.Notes = assessment.Notes
+	r.AssessedAt = assessment.AssessedAt.Format("2006-01-02")
+	r.CreatedAt = assessment.CreatedAt
+
+	r.Ratings = make([]CompetencyRatingResponse, len(assessment.Ratings))
+	for i, rating := range assessment.Ratings {
+		r.Ratings[i] = CompetencyRatingResponse{
+			CompetencyUUID: rating.CompetencyUUID,
+			CompetencyName: rating.CompetencyName,
+			LevelUUID:      rating.LevelUUID,
+			LevelName:      rating.LevelName,
+			LevelPosition:  rating.LevelPosition,
+			Comment:        rating.Comment,
+		}
+	}
+}
+
+type CompetencyGapResponse struct {
+	CompetencyUUID string                   `json:"competency_uuid"`
+	CompetencyName string                   `json:"competency_name"`
+	AssessedLevel  *CompetencyLevelResponse `json:"assessed_level,omitempty"` // null when the competency was not assessed
+	Gap            *int                     `json:"gap,omitempty"`            // assessed minus target level, negative when below the target
+	Expected       string                   `json:"expected,omitempty"`       // descriptor of the competency at the target level
+	EvidenceCount  int                      `json:"evidence_count"`
+	LastEvidenceAt *time.Time               `json:"last_evidence_at,omitempty"`
+}
+
+type CompetencyGapAnalysisResponse struct {
+	FrameworkUUID string                        `json:"framework_uuid"`
+	FrameworkName string                        `json:"framework_name"`
+	TargetLevel   CompetencyLevelResponse       `json:"target_level"`
+	Assessment    *CompetencyAssessmentResponse `json:"assessment,omitempty"` // latest assessment, null when never assessed
+	Gaps          []CompetencyGapResponse       `json:"gaps"`
+}
+
+func (r *CompetencyGapAnalysisResponse) FillFromEntity(analysis entity.CompetencyGapAnalysis) {
+	r.FrameworkUUID = analysis.Framework.UUID
+	r.FrameworkName = analysis.Framework.Name
+	r.TargetLevel.FillFromEntity(analysis.TargetLevel)
+
+	if analysis.Assessment != nil {
+		r.Assessment = &CompetencyAssessmentResponse{}
+		r.Assessment.FillFromEntity(*analysis.Assessment)
+	}
+
+	r.Gaps = make([]CompetencyGapResponse, len(analysis.Gaps))
+	for i, gap := range analysis.Gaps {
+		r.Gaps[i] = CompetencyGapResponse{
+			CompetencyUUID: gap.Competency.UUID,
+			CompetencyName: gap.Competency.Name,
+			Gap:            gap.Gap,
+			Expected:       gap.Descriptor,
+			EvidenceCount:  gap.EvidenceCount,
+			LastEvidenceAt: gap.LastEvidenceAt,
+		}
+		if gap.AssessedLevel != nil {
+			r.Gaps[i].AssessedLevel = &CompetencyLevelResponse{}
+			r.Gaps[i].AssessedLevel.FillFromEntity(*gap.AssessedLevel)
+		}
+	}
+}
@@ -16,8 +16,11 @@ type DashboardStatsResponse struct {
 
 // DashboardResponse represents the complete dashboard data
 type DashboardResponse struct {
-	People []PersonResponse        `json:"people"`
-	Stats  DashboardStatsResponse  `json:"stats"`
+	People             []PersonResponse         `json:"people"`
+	Stats              DashboardStatsResponse   `json:"stats"`
+	UpcomingReminders  []PersonReminderResponse `json:"upcoming_reminders"`
+	OverdueActionItems []ActionItemResponse     `json:"overdue_action_items"`
+	OneOnOnesDue       []OneOnOneDueResponse    `json:"one_on_ones_due"`
 }
 
 // FillFromEntity fills the dashboard response from entity
@@ -35,4 +38,22 @@ func (r *DashboardResponse) FillFromEntity(dashboard entity.Dashboard) {
 		AverageFrequency:   dashboard.Stats.AverageFrequency,
 		LastMeetingDate:    dashboard.Stats.LastMeetingDate,
 	}
+
+	// Fill upcoming birthdays and work anniversaries
+	r.UpcomingReminders = make([]PersonReminderResponse, len(dashboard.UpcomingReminders))
+	for i, reminder := range dashboard.UpcomingReminders {
+		r.UpcomingReminders[i].FillFromEntity(reminder)
+	}
+
+	// Fill overdue action items
+	r.OverdueActionItems = make([]ActionItemResponse, len(dashboard.OverdueActionItems))
+	for i, item := range dashboard.OverdueActionItems {
+		r.OverdueActionItems[i].FillFromEntity(item)
+	}
+
+	// Fill overdue and upcoming 1:1s
+	r.OneOnOnesDue = make([]OneOnOneDueResponse, len(dashboard.OneOnOnesDue))
+	for i, due := range dashboard.OneOnOnesDue {
+		r.OneOnOnesDue[i].FillFromEntity(due)
+	}
 }
\ No newline at end of file
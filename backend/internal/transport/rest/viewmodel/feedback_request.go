@@ -0,0 +1,185 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type FeedbackQuestionRequest struct {
+	Text     string `json:"text" validate:"required"`
+	Type     string `json:"type,omitempty" validate:"omitempty,oneof=text rating"` // text by default, ratings go from 1 to 5
+	Required bool   `json:"required,omitempty"`
+}
+
+// FeedbackRespondentRequest is a person of the company, by person_uuid, or an external email
+type FeedbackRespondentRequest struct {
+	PersonUUID string `json:"person_uuid,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Email      string `json:"email,omitempty" validate:"omitempty,email"`
+}
+
+type FeedbackRequestRequest struct {
+	Message       string                      `json:"message,omitempty"` // shown to the respondents in the form
+	Anonymous     bool                        `json:"anonymous,omitempty"`
+	ExpiresInDays int                         `json:"expires_in_days,omitempty"` // 14 by default, at most 90
+	Questions     []FeedbackQuestionRequest   `json:"questions,omitempty"`       // default questionnaire when empty
+	Respondents   []FeedbackRespondentRequest `json:"respondents" validate:"required,min=1"`
+}
+
+func (r *FeedbackRequestRequest) ToEntity() entity.FeedbackRequest {
+	request := entity.FeedbackRequest{
+		Message:   r.Message,
+		Anonymous: r.Anonymous,
+	}
+	for _, question := range r.Questions {
+		request.Questions = append(request.Questions, entity.FeedbackQuestion{
+			Text:     question.Text,
+			Type:     question.Type,
+			Required: question.Required,
+		})
+	}
+	for _, respondent := range r.Respondents {
+		request.Respondents = append(request.Respondents, entity.FeedbackRespondent{
+			PersonUUID: respondent.PersonUUID,
+			Name:       respondent.Name,
+			Email:      respondent.Email,
+		})
+	}
+	return request
+}
+
+type FeedbackQuestionResponse struct {
+	UUID     string `json:"uuid"`
+	Text     string `json:"text"`
+	Type     string `json:"type"`
+	Required bool   `json:"required"`
+	Position int    `json:"position"`
+}
+
+func feedbackQuestionResponses(questions []entity.FeedbackQuestion) []FeedbackQuestionResponse {
+	responses := make([]FeedbackQuestionResponse, len(questions))
+	for i, question := range questions {
+		responses[i] = FeedbackQuestionResponse{
+			UUID:     question.UUID,
+			Text:     question.Text,
+			Type:     question.Type,
+			Required: question.Required,
+			Position: question.Position,
+		}
+	}
+	return responses
+}
+
+type FeedbackRespondentResponse struct {
+	UUID        string     `json:"uuid"`
+	PersonUUID  string     `json:"person_uuid,omitempty"`
+	Name        string     `json:"name"`
+	Email       string     `json:"email,omitempty"`
+	Token       string     `json:"token,omitempty"`        // only returned when the request is created
+	URL         string     `json:"url,omitempty"`          // link of the form, only returned when the request is created
+	RespondedAt *time.Time `json:"responded_at,omitempty"` // never returned for anonymous requests
+}
+
+type FeedbackRequestResponse struct {
+	UUID          string                       `json:"uuid"`
+	PersonUUID    string                       `json:"person_uuid"`
+	PersonName    string                       `json:"person_name"`
+	RequesterName string                       `json:"requester_name"`
+	Message       string                       `json:"message,omitempty"`
+	Anonymous     bool                         `json:"anonymous"`
+	ExpiresAt     time.Time                    `json:"expires_at"`
+	CreatedAt     time.Time                    `json:"created_at"`
+	Questions     []FeedbackQuestionResponse   `json:"questions"`
+	Respondents   []FeedbackRespondentResponse `json:"respondents"`
+	ResponseCount int                          `json:"response_count"`
+}
+
+// FillFromEntity fills the response, formURL builds the link of the form from the token of the respondent
+func (r *FeedbackRequestResponse) FillFromEntity(request entity.FeedbackRequest, formURL func(token string) string) {
+	r.UUID = request.UUID
+	r.PersonUUID = request.PersonUUID
+	r.PersonName = request.PersonName
+	r.RequesterName = request.RequesterName
+	r.Message = request.Message
+	r.Anonymous = request.Anonymous
+	r.ExpiresAt = request.ExpiresAt
+	r.CreatedAt = request.CreatedAt
+	r.Questions = feedbackQuestionResponses(request.Questions)
+
+	r.Respondents = make([]FeedbackRespondentResponse, len(request.Respondents))
+	for i, respondent := range request.Respondents {
+		r.Respondents[i] = FeedbackRespondentResponse{
+			UUID:        respondent.UUID,
+			PersonUUID:  respondent.PersonUUID,
+			Name:        respondent.Name,
+			Email:       respondent.Email,
+			Token:       respondent.Token,
+			RespondedAt: respondent.RespondedAt,
+		}
+		// when each respondent answered would match the created_at of the anonymous notes, only the count is returned
+		if request.Anonymous {
+			r.Respondents[i].RespondedAt = nil
+		}
+		if respondent.Token != "" {
+			r.Respondents[i].URL = formURL(respondent.Token)
+		}
+		if respondent.HasResponded() {
+			r.ResponseCount++
+		}
+	}
+}
+
+// FeedbackFormResponse is what the respondent sees when opening the link, without any data of the company people
+type FeedbackFormResponse struct {
+	CompanyName    string                     `json:"company_name"`
+	PersonName     string                     `json:"person_name"`
+	RequesterName  string                     `json:"requester_name"`
+	RespondentName string                     `json:"respondent_name"`
+	Message        string                     `json:"message,omitempty"`
+	Anonymous      bool                       `json:"anonymous"`
+	ExpiresAt      time.Time                  `json:"expires_at"`
+	RatingMin      int                        `json:"rating_min"`
+	RatingMax      int                        `json:"rating_max"`
+	Questions      []FeedbackQuestionResponse `json:"questions"`
+}
+
+func (r *FeedbackFormResponse) FillFromEntity(form entity.FeedbackForm, ratingMin, ratingMax int) {
+	r.CompanyName = form.Request.CompanyName
+	r.PersonName = form.Request.PersonName
+	r.RequesterName = form.Request.RequesterName
+	r.RespondentName = form.Respondent.Name
+	r.Message = form.Request.Message
+	r.Anonymous = form.Request.Anonymous
+	r.ExpiresAt = form.Request.ExpiresAt
+	r.RatingMin = ratingMin
+	r.RatingMax = ratingMax
+	r.Questions = feedbackQuestionResponses(form.Request.Questions)
+}
+
+type FeedbackAnswerRequest struct {
+	QuestionUUID string `json:"question_uuid" validate:"required"`
+	Text         string `json:"text,omitempty"`   // for text questions
+	Rating       *int   `json:"rating,omitempty"` // for rating questions
+}
+
+type FeedbackResponseRequest struct {
+	FeedbackType     string                  `json:"feedback_type,omitempty" validate:"omitempty,oneof=positive constructive neutral"` // neutral by default
+	FeedbackCategory string                  `json:"feedback_category,omitempty" validate:"omitempty,oneof=performance behavior skill collaboration"`
+	Answers          []FeedbackAnswerRequest `json:"answers" validate:"required,min=1"`
+}
+
+func (r *FeedbackResponseRequest) ToEntity() entity.FeedbackResponse {
+	response := entity.FeedbackResponse{
+		FeedbackType:     r.FeedbackType,
+		FeedbackCategory: r.FeedbackCategory,
+	}
+	for _, answer := range r.Answers {
+		response.Answers = append(response.Answers, entity.FeedbackAnswer{
+			QuestionUUID: answer.QuestionUUID,
+			Text:         answer.Text,
+			Rating:       answer.Rating,
+		})
+	}
+	return response
+}
@@ -0,0 +1,43 @@
+package viewmodel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedbackRequestResponse_FillFromEntity(t *testing.T) {
+	respondedAt := time.Date(2025, time.May, 5, 9, 30, 0, 0, time.UTC)
+	request := entity.FeedbackRequest{
+		UUID: "request-uuid",
+		Respondents: []entity.FeedbackRespondent{
+			{UUID: "answered-uuid", Name: "Ana", RespondedAt: &respondedAt},
+			{UUID: "pending-uuid", Name: "Bruno"},
+		},
+	}
+	formURL := func(token string) string { return "https://app/feedback/" + token }
+
+	t.Run("Should return when each respondent answered", func(t *testing.T) {
+		var response FeedbackRequestResponse
+		response.FillFromEntity(request, formURL)
+
+		require.Equal(t, 1, response.ResponseCount)
+		require.Equal(t, &respondedAt, response.Respondents[0].RespondedAt)
+		require.Nil(t, response.Respondents[1].RespondedAt)
+	})
+
+	t.Run("Should return only the response count of anonymous requests", func(t *testing.T) {
+		anonymous := request
+		anonymous.Anonymous = true
+
+		var response FeedbackRequestResponse
+		response.FillFromEntity(anonymous, formURL)
+
+		require.Equal(t, 1, response.ResponseCount)
+		for _, respondent := range response.Respondents {
+			require.Nil(t, respondent.RespondedAt, respondent.UUID)
+		}
+	})
+}
@@ -0,0 +1,207 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type KeyResultRequest struct {
+	Title        string  `json:"title" validate:"required"`
+	StartValue   float64 `json:"start_value"`
+	TargetValue  float64 `json:"target_value"`
+	CurrentValue float64 `json:"current_value"`
+	Unit         string  `json:"unit,omitempty"` // e.g. %, projects, talks
+}
+
+func (r *KeyResultRequest) ToEntity() entity.GoalKeyResult {
+	return entity.GoalKeyResult{
+		Title:        r.Title,
+		StartValue:   r.StartValue,
+		TargetValue:  r.TargetValue,
+		CurrentValue: r.CurrentValue,
+		Unit:         r.Unit,
+	}
+}
+
+type GoalRequest struct {
+	Title       string             `json:"title" validate:"required"`
+	Description string             `json:"description,omitempty"`
+	Type        string             `json:"type" validate:"required,oneof=career performance learning"`
+	TargetDate  *time.Time         `json:"target_date,omitempty"`
+	KeyResults  []KeyResultRequest `json:"key_results,omitempty"`
+}
+
+func (r *GoalRequest) ToEntity() entity.Goal {
+	goal := entity.Goal{
+		Title:       r.Title,
+		Description: r.Description,
+		Type:        r.Type,
+		TargetDate:  r.TargetDate,
+	}
+	for _, keyResult := range r.KeyResults {
+		goal.KeyResults = append(goal.KeyResults, keyResult.ToEntity())
+	}
+	return goal
+}
+
+type UpdateGoalRequest struct {
+	Title       string     `json:"title" validate:"required"`
+	Description string     `json:"description,omitempty"`
+	Type        string     `json:"type,omitempty" validate:"omitempty,oneof=career performance learning"`
+	Status      string     `json:"status,omitempty" validate:"omitempty,oneof=active achieved abandoned"`
+	TargetDate  *time.Time `json:"target_date,omitempty"`
+}
+
+func (r *UpdateGoalRequest) ToEntity() entity.Goal {
+	return entity.Goal{
+		Title:       r.Title,
+		Description: r.Description,
+		Type:        r.Type,
+		Status:      r.Status,
+		TargetDate:  r.TargetDate,
+	}
+}
+
+type CheckInKeyResultRequest struct {
+	UUID         string  `json:"uuid" validate:"required"`
+	CurrentValue float64 `json:"current_value"`
+}
+
+type GoalCheckInRequest struct {
+	Date       *time.Time                `json:"date,omitempty"`     // defaults to today
+	Progress   int                       `json:"progress,omitempty"` // only used by goals without key results, from 0 to 100
+	Confidence string                    `json:"confidence,omitempty" validate:"omitempty,oneof=on_track at_risk off_track"`
+	Comment    string                    `json:"comment,omitempty"`
+	NoteUUID   *string                   `json:"note_uuid,omitempty"` // note where the progress was discussed
+	KeyResults []CheckInKeyResultRequest `json:"key_results,omitempty"`
+}
+
+func (r *GoalCheckInRequest) ToEntity() entity.GoalCheckIn {
+	checkIn := entity.GoalCheckIn{
+		Progress:   r.Progress,
+		Confidence: r.Confidence,
+		Comment:    r.Comment,
+		NoteUUID:   r.NoteUUID,
+	}
+	if r.Date != nil {
+		checkIn.Date = *r.Date
+	}
+	for _, keyResult := range r.KeyResults {
+		checkIn.KeyResultValues = append(checkIn.KeyResultValues, entity.GoalKeyResultValue{
+			KeyResultUUID: keyResult.UUID,
+			CurrentValue:  keyResult.CurrentValue,
+		})
+	}
+	return checkIn
+}
+
+type KeyResultResponse struct {
+	UUID         string  `json:"uuid"`
+	Title        string  `json:"title"`
+	StartValue   float64 `json:"start_value"`
+	TargetValue  float64 `json:"target_value"`
+	CurrentValue float64 `json:"current_value"`
+	Unit         string  `json:"unit,omitempty"`
+	Progress     int     `json:"progress"`
+	Position     int     `json:"position"`
+}
+
+func (r *KeyResultResponse) FillFromEntity(keyResult entity.GoalKeyResult) {
+	r.UUID = keyResult.UUID
+	r.Title = keyResult.Title
+	r.StartValue = keyResult.StartValue
+	r.TargetValue = keyResult.TargetValue
+	r.CurrentValue = keyResult.CurrentValue
+	r.Unit = keyResult.Unit
+	r.Progress = keyResult.Progress()
+	r.Position = keyResult.Position
+}
+
+type GoalCheckInResponse struct {
+	UUID       string    `json:"uuid"`
+	Date       string    `json:"date"` // YYYY-MM-DD
+	Progress   int       `json:"progress"`
+	Confidence string    `json:"confidence"`
+	Comment    string    `json:"comment,omitempty"`
+	NoteUUID   *string   `json:"note_uuid,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (r *GoalCheckInResponse) FillFromEntity(checkIn entity.GoalCheckIn) {
+	r.UUID = checkIn.UUID
+	r.Date = checkIn.Date.Format("2006-01-02")
+	r.Progress = checkIn.Progress
+	r.Confidence = checkIn.Confidence
+	r.Comment = checkIn.Comment
+	r.NoteUUID = checkIn.NoteUUID
+	r.CreatedAt = checkIn.CreatedAt
+}
+
+type GoalResponse struct {
+	UUID        string                `json:"uuid"`
+	PersonUUID  string                `json:"person_uuid"`
+	PersonName  string                `json:"person_name"`
+	Title       string                `json:"title"`
+	Description string                `json:"description,omitempty"`
+	Type        string                `json:"type"`
+	Status      string                `json:"status"`
+	Progress    int                   `json:"progress"`
+	TargetDate  *string               `json:"target_date,omitempty"` // YYYY-MM-DD
+	CompletedAt *time.Time            `json:"completed_at,omitempty"`
+	KeyResults  []KeyResultResponse   `json:"key_results"`
+	CheckIns    []GoalCheckInResponse `json:"check_ins"` // most recent first
+	CreatedAt   time.Time             `json:"created_at"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+}
+
+func (r *GoalResponse) FillFromEntity(goal entity.Goal) {
+	r.UUID = goal.UUID
+	r.PersonUUID = goal.PersonUUID
+	r.PersonName = goal.PersonName
+	r.Title = goal.Title
+	r.Description = goal.Description
+	r.Type = goal.Type
+	r.Status = goal.Status
+	r.Progress = goal.Progress
+	r.CompletedAt = goal.CompletedAt
+	r.CreatedAt = goal.CreatedAt
+	r.UpdatedAt = goal.UpdatedAt
+
+	if goal.TargetDate != nil {
+		targetDate := goal.TargetDate.Format("2006-01-02")
+		r.TargetDate = &targetDate
+	}
+
+	r.KeyResults = make([]KeyResultResponse, len(goal.KeyResults))
+	for i, keyResult := range goal.KeyResults {
+		r.KeyResults[i].FillFromEntity(keyResult)
+	}
+
+	r.CheckIns = make([]GoalCheckInResponse, len(goal.CheckIns))
+	for i, checkIn := range goal.CheckIns {
+		r.CheckIns[i].FillFromEntity(checkIn)
+	}
+}
+
+type GoalTimelineEntryResponse struct {
+	Type      string               `json:"type"` // goal_created, check_in, goal_achieved, goal_abandoned
+	Date      time.Time            `json:"date"`
+	GoalUUID  string               `json:"goal_uuid"`
+	GoalTitle string               `json:"goal_title"`
+	GoalType  string               `json:"goal_type"`
+	CheckIn   *GoalCheckInResponse `json:"check_in,omitempty"`
+}
+
+func (r *GoalTimelineEntryResponse) FillFromEntity(entry entity.GoalTimelineEntry) {
+	r.Type = entry.Type
+	r.Date = entry.Date
+	r.GoalUUID = entry.GoalUUID
+	r.GoalTitle = entry.GoalTitle
+	r.GoalType = entry.GoalType
+
+	if entry.CheckIn != nil {
+		r.CheckIn = &GoalCheckInResponse{}
+		r.CheckIn.FillFromEntity(*entry.CheckIn)
+	}
+}
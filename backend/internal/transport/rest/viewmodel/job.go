@@ -0,0 +1,82 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type JobQueueStatsResponse struct {
+	Queue           string     `json:"queue"`
+	Concurrency     int        `json:"concurrency"` // jobs of the queue running at the same time in each instance
+	Pending         int64      `json:"pending"`     // due jobs waiting for a worker
+	Scheduled       int64      `json:"scheduled"`   // pending jobs not due yet, like retries waiting for their backoff
+	Running         int64      `json:"running"`
+	Dead            int64      `json:"dead"`
+	OldestPendingAt *time.Time `json:"oldest_pending_at,omitempty"`
+}
+
+func (r *JobQueueStatsResponse) FillFromEntity(stats entity.JobQueueStats) {
+	r.Queue = stats.Queue
+	r.Concurrency = stats.Concurrency
+	r.Pending = stats.Pending
+	r.Scheduled = stats.Scheduled
+	r.Running = stats.Running
+	r.Dead = stats.Dead
+	r.OldestPendingAt = stats.OldestPendingAt
+}
+
+type JobScheduleResponse struct {
+	Name      string    `json:"name"`
+	Cron      string    `json:"cron"`
+	Queue     string    `json:"queue"`
+	JobType   string    `json:"job_type"`
+	NextRunAt time.Time `json:"next_run_at"`
+}
+
+type JobQueueStateResponse struct {
+	Queues    []JobQueueStatsResponse `json:"queues"`
+	Schedules []JobScheduleResponse   `json:"schedules"`
+}
+
+func (r *JobQueueStateResponse) FillFromEntity(state entity.JobQueueState) {
+	r.Queues = make([]JobQueueStatsResponse, len(state.Queues))
+	for i, stats := range state.Queues {
+		r.Queues[i].FillFromEntity(stats)
+	}
+
+	r.Schedules = make([]JobScheduleResponse, len(state.Schedules))
+	for i, schedule := range state.Schedules {
+		r.Schedules[i] = JobScheduleResponse{
+			Name:      schedule.Name,
+			Cron:      schedule.Cron,
+			Queue:     schedule.Queue,
+			JobType:   schedule.JobType,
+			NextRunAt: schedule.NextRunAt,
+		}
+	}
+}
+
+type DeadJobResponse struct {
+	UUID      string     `json:"uuid"`
+	Queue     string     `json:"queue"`
+	Type      string     `json:"type"`
+	Payload   string     `json:"payload"`
+	Attempts  int        `json:"attempts"`
+	LastError string     `json:"last_error,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	FailedAt  *time.Time `json:"failed_at,omitempty"`
+}
+
+func (r *DeadJobResponse) FillFromEntity(job entity.Job) {
+	r.UUID = job.UUID
+	r.Queue = job.Queue
+	r.Type = job.Type
+	r.Payload = job.Payload
+	r.Attempts = job.Attempts
+	if job.LastError != nil {
+		r.LastError = *job.LastError
+	}
+	r.CreatedAt = job.CreatedAt
+	r.FailedAt = job.FailedAt
+}
@@ -0,0 +1,112 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type MeetingRequest struct {
+	ScheduledAt     time.Time `json:"scheduled_at" validate:"required"`
+	DurationMinutes *int      `json:"duration_minutes,omitempty" validate:"omitempty,min=1"`
+}
+
+func (r *MeetingRequest) ToEntity() entity.Meeting {
+	return entity.Meeting{
+		ScheduledAt:     r.ScheduledAt,
+		DurationMinutes: r.DurationMinutes,
+	}
+}
+
+type CompleteMeetingRequest struct {
+	HeldAt          *time.Time `json:"held_at,omitempty"` // defaults to now
+	DurationMinutes *int       `json:"duration_minutes,omitempty" validate:"omitempty,min=1"`
+	Summary         string     `json:"summary,omitempty"` // content of the resulting one_on_one note
+}
+
+func (r *CompleteMeetingRequest) ToEntity() entity.MeetingCompletion {
+	completion := entity.MeetingCompletion{
+		DurationMinutes: r.DurationMinutes,
+		Summary:         r.Summary,
+	}
+	if r.HeldAt != nil {
+		completion.HeldAt = *r.HeldAt
+	}
+	return completion
+}
+
+type AgendaItemRequest struct {
+	Content   string `json:"content" validate:"required"`
+	AddedBy   string `json:"added_by,omitempty" validate:"omitempty,oneof=manager report"` // defaults to manager
+	Notes     string `json:"notes,omitempty"`
+	Discussed bool   `json:"discussed"`
+}
+
+func (r *AgendaItemRequest) ToEntity() entity.MeetingAgendaItem {
+	return entity.MeetingAgendaItem{
+		Content:   r.Content,
+		AddedBy:   r.AddedBy,
+		Notes:     r.Notes,
+		Discussed: r.Discussed,
+	}
+}
+
+type AgendaItemResponse struct {
+	UUID           string    `json:"uuid"`
+	ActionItemUUID *string   `json:"action_item_uuid,omitempty"` // set when carried over from an open action item
+	AddedBy        string    `json:"added_by"`
+	Content        string    `json:"content"`
+	Notes          string    `json:"notes"`
+	Discussed      bool      `json:"discussed"`
+	Position       int       `json:"position"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+func (r *AgendaItemResponse) FillFromEntity(item entity.MeetingAgendaItem) {
+	r.UUID = item.UUID
+	r.ActionItemUUID = item.ActionItemUUID
+	r.AddedBy = item.AddedBy
+	r.Content = item.Content
+	r.Notes = item.Notes
+	r.Discussed = item.Discussed
+	r.Position = item.Position
+	r.CreatedAt = item.CreatedAt
+	r.UpdatedAt = item.UpdatedAt
+}
+
+type MeetingResponse struct {
+	UUID            string               `json:"uuid"`
+	PersonUUID      string               `json:"person_uuid"`
+	PersonName      string               `json:"person_name"`
+	Status          string               `json:"status"`
+	Origin          string               `json:"origin"`
+	ScheduledAt     time.Time            `json:"scheduled_at"`
+	HeldAt          *time.Time           `json:"held_at,omitempty"`
+	DurationMinutes *int                 `json:"duration_minutes,omitempty"`
+	NoteUUID        *string              `json:"note_uuid,omitempty"`
+	AgendaItems     []AgendaItemResponse `json:"agenda_items,omitempty"`
+	CreatedAt       time.Time            `json:"created_at"`
+	UpdatedAt       time.Time            `json:"updated_at"`
+}
+
+func (r *MeetingResponse) FillFromEntity(meeting entity.Meeting) {
+	r.UUID = meeting.UUID
+	r.PersonUUID = meeting.PersonUUID
+	r.PersonName = meeting.PersonName
+	r.Status = meeting.Status
+	r.Origin = meeting.Origin
+	r.ScheduledAt = meeting.ScheduledAt
+	r.HeldAt = meeting.HeldAt
+	r.DurationMinutes = meeting.DurationMinutes
+	r.NoteUUID = meeting.NoteUUID
+	r.CreatedAt = meeting.CreatedAt
+	r.UpdatedAt = meeting.UpdatedAt
+
+	if meeting.AgendaItems != nil {
+		r.AgendaItems = make([]AgendaItemResponse, len(meeting.AgendaItems))
+		for i, item := range meeting.AgendaItems {
+			r.AgendaItems[i].FillFromEntity(item)
+		}
+	}
+}
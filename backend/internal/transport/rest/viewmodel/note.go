@@ -12,6 +12,7 @@ type CreateNoteRequest struct {
 	FeedbackType     *string           `json:"feedback_type,omitempty" validate:"omitempty,oneof=positive constructive neutral"`
 	FeedbackCategory *string           `json:"feedback_category,omitempty" validate:"omitempty,oneof=performance behavior skill collaboration"`
 	MentionedPeople  []MentionedPerson `json:"mentioned_people,omitempty"`
+	TemplateUUID     *string           `json:"template_uuid,omitempty"`
 }
 
 type MentionedPerson struct {
@@ -33,6 +34,7 @@ type NoteResponse struct {
 	Content          string    `json:"content"`
 	FeedbackType     *string   `json:"feedback_type,omitempty"`
 	FeedbackCategory *string   `json:"feedback_category,omitempty"`
+	TemplateUUID     *string   `json:"template_uuid,omitempty"`
 	CreatedAt        time.Time `json:"created_at"`
 	UpdatedAt        time.Time `json:"updated_at"`
 }
@@ -57,13 +59,32 @@ type MentionResponse struct {
 	CreatedAt        time.Time `json:"created_at"`
 	PersonID         string    `json:"person_id"`   // UUID da pessoa sobre quem a nota foi feita
 	PersonName       string    `json:"person_name"` // Nome da pessoa sobre quem a nota foi feita
-	Mentions         []struct {
-		ID          string `json:"id"`
-		PersonID    string `json:"person_id"`
-		PersonName  string `json:"person_name"`
-		StartIndex  int    `json:"start_index"`
-		EndIndex    int    `json:"end_index"`
-	} `json:"mentions,omitempty"`
+	Mentions         []MentionTokenResponse `json:"mentions,omitempty"`
+}
+
+// MentionTokenResponse is a {{person:uuid|name}} token of the content, indexes are character offsets and end_index is exclusive
+type MentionTokenResponse struct {
+	PersonID   string `json:"person_id"` // UUID da pessoa mencionada
+	PersonName string `json:"person_name"`
+	StartIndex int    `json:"start_index"`
+	EndIndex   int    `json:"end_index"`
+}
+
+func newMentionTokenResponses(tokens []entity.MentionToken) []MentionTokenResponse {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	response := make([]MentionTokenResponse, len(tokens))
+	for i, token := range tokens {
+		response[i] = MentionTokenResponse{
+			PersonID:   token.PersonUUID,
+			PersonName: token.PersonName,
+			StartIndex: token.StartIndex,
+			EndIndex:   token.EndIndex,
+		}
+	}
+	return response
 }
 
 func (r *CreateNoteRequest) ToEntity() entity.Note {
@@ -72,6 +93,7 @@ func (r *CreateNoteRequest) ToEntity() entity.Note {
 		Content:          r.Content,
 		FeedbackType:     r.FeedbackType,
 		FeedbackCategory: r.FeedbackCategory,
+		TemplateUUID:     r.TemplateUUID,
 	}
 }
 
@@ -90,6 +112,7 @@ func (r *NoteResponse) FillFromEntity(note entity.Note) {
 	r.Content = note.Content
 	r.FeedbackType = note.FeedbackType
 	r.FeedbackCategory = note.FeedbackCategory
+	r.TemplateUUID = note.TemplateUUID
 	r.CreatedAt = note.CreatedAt
 	r.UpdatedAt = note.UpdatedAt
 }
@@ -115,22 +138,7 @@ func (r *MentionResponse) FillFromMentionEntry(entry entity.MentionEntry) {
 	r.PersonID = entry.PersonID
 	r.PersonName = entry.PersonName
 	
-	// Convert mentions
-	r.Mentions = make([]struct {
-		ID          string `json:"id"`
-		PersonID    string `json:"person_id"`
-		PersonName  string `json:"person_name"`
-		StartIndex  int    `json:"start_index"`
-		EndIndex    int    `json:"end_index"`
-	}, len(entry.Mentions))
-	
-	for i, mention := range entry.Mentions {
-		r.Mentions[i].ID = mention.ID
-		r.Mentions[i].PersonID = mention.PersonID
-		r.Mentions[i].PersonName = mention.PersonName
-		r.Mentions[i].StartIndex = mention.StartIndex
-		r.Mentions[i].EndIndex = mention.EndIndex
-	}
+	r.Mentions = newMentionTokenResponses(entry.Mentions)
 }
 
 // UnifiedTimelineResponse represents the unified timeline response combining timeline and mentions
@@ -146,6 +154,9 @@ type UnifiedTimelineResponse struct {
 	// For mentions only - who mentioned this person
 	MentionedByPersonUUID *string `json:"mentioned_by_person_uuid,omitempty"`
 	MentionedByPersonName *string `json:"mentioned_by_person_name,omitempty"`
+
+	Mentions []MentionTokenResponse `json:"mentions,omitempty"`
+	Tags     []string               `json:"tags,omitempty"`
 }
 
 // TimelineFiltersRequest represents the request filters for timeline endpoint
@@ -155,6 +166,12 @@ type TimelineFiltersRequest struct {
 	FeedbackTypes []string `json:"feedback_types,omitempty" form:"feedback_types"`
 	Direction     string   `json:"direction,omitempty" form:"direction"`
 	Period        string   `json:"period,omitempty" form:"period"`
+	Tags          []string `json:"tags,omitempty" form:"tags"`
+	From               *time.Time `json:"from,omitempty" form:"from"`
+	To                 *time.Time `json:"to,omitempty" form:"to"`
+	AuthorUUID         string     `json:"author_uuid,omitempty" form:"author_uuid"`
+	FeedbackCategories []string   `json:"feedback_categories,omitempty" form:"feedback_categories"`
+	Sort               string     `json:"sort,omitempty" form:"sort"`
 }
 
 func (r *UnifiedTimelineResponse) FillFromUnifiedTimelineEntry(entry entity.UnifiedTimelineEntry) {
@@ -167,6 +184,8 @@ func (r *UnifiedTimelineResponse) FillFromUnifiedTimelineEntry(entry entity.Unif
 	r.FeedbackCategory = entry.FeedbackCategory
 	r.MentionedByPersonUUID = entry.MentionedByPersonUUID
 	r.MentionedByPersonName = entry.MentionedByPersonName
+	r.Mentions = newMentionTokenResponses(entry.Mentions)
+	r.Tags = entry.Tags
 }
 
 func (r *TimelineFiltersRequest) ToEntity() entity.TimelineFilters {
@@ -176,5 +195,11 @@ func (r *TimelineFiltersRequest) ToEntity() entity.TimelineFilters {
 		FeedbackTypes: r.FeedbackTypes,
 		Direction:     r.Direction,
 		Period:        r.Period,
+		Tags:          r.Tags,
+		From:               r.From,
+		To:                 r.To,
+		AuthorUUID:         r.AuthorUUID,
+		FeedbackCategories: r.FeedbackCategories,
+		Sort:               r.Sort,
 	}
 }
\ No newline at end of file
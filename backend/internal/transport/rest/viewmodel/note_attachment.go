@@ -0,0 +1,30 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type NoteAttachmentResponse struct {
+	UUID        string    `json:"uuid"`
+	FileName    string    `json:"file_name"`
+	ContentType string    `json:"content_type"`
+	SizeBytes   int64     `json:"size_bytes"`
+	UploadedBy  string    `json:"uploaded_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (r *NoteAttachmentResponse) FillFromEntity(attachment entity.NoteAttachment) {
+	r.UUID = attachment.UUID
+	r.FileName = attachment.FileName
+	r.ContentType = attachment.ContentType
+	r.SizeBytes = attachment.SizeBytes
+	r.UploadedBy = attachment.UserName
+	r.CreatedAt = attachment.CreatedAt
+}
+
+type NoteAttachmentDownloadURLResponse struct {
+	URL       string    `json:"url"` // works without the authorization header until it expires, e.g. in an img tag
+	ExpiresAt time.Time `json:"expires_at"`
+}
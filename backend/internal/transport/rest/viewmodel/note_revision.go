@@ -0,0 +1,54 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type NoteRevisionResponse struct {
+	UUID             string    `json:"uuid"`
+	Number           int       `json:"number"` // the highest number is the current content of the note
+	Type             string    `json:"type"`
+	Content          string    `json:"content"`
+	FeedbackType     *string   `json:"feedback_type,omitempty"`
+	FeedbackCategory *string   `json:"feedback_category,omitempty"`
+	AuthorName       string    `json:"author_name"`
+	RestoredFrom     *int      `json:"restored_from,omitempty"` // number of the revision restored by this one
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+func (r *NoteRevisionResponse) FillFromEntity(revision entity.NoteRevision) {
+	r.UUID = revision.UUID
+	r.Number = revision.Number
+	r.Type = revision.Type
+	r.Content = revision.Content
+	r.FeedbackType = revision.FeedbackType
+	r.FeedbackCategory = revision.FeedbackCategory
+	r.AuthorName = revision.UserName
+	r.RestoredFrom = revision.RestoredFrom
+	r.CreatedAt = revision.CreatedAt
+}
+
+type NoteDiffLineResponse struct {
+	Op   string `json:"op"` // "equal", "added" or "removed"
+	Text string `json:"text"`
+}
+
+type NoteRevisionDiffResponse struct {
+	From          NoteRevisionResponse   `json:"from"`
+	To            NoteRevisionResponse   `json:"to"`
+	ChangedFields []string               `json:"changed_fields"` // type, feedback_type and feedback_category when they differ
+	Lines         []NoteDiffLineResponse `json:"lines"`
+}
+
+func (r *NoteRevisionDiffResponse) FillFromEntity(diff entity.NoteRevisionDiff) {
+	r.From.FillFromEntity(diff.From)
+	r.To.FillFromEntity(diff.To)
+	r.ChangedFields = diff.ChangedFields
+
+	r.Lines = make([]NoteDiffLineResponse, len(diff.Lines))
+	for i, line := range diff.Lines {
+		r.Lines[i] = NoteDiffLineResponse{Op: line.Op, Text: line.Text}
+	}
+}
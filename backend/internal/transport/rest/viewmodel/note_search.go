@@ -0,0 +1,49 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+// NoteSnippetPartResponse is a piece of the snippet, the highlighted parts are the words matching the search
+type NoteSnippetPartResponse struct {
+	Text        string `json:"text"`
+	Highlighted bool   `json:"highlighted"`
+}
+
+type NoteSearchResultResponse struct {
+	UUID             string                    `json:"uuid"`
+	Type             string                    `json:"type"`
+	Content          string                    `json:"content"`
+	Snippet          []NoteSnippetPartResponse `json:"snippet"`
+	PersonUUID       string                    `json:"person_uuid"`
+	PersonName       string                    `json:"person_name"`
+	AuthorUUID       string                    `json:"author_uuid"`
+	AuthorName       string                    `json:"author_name"`
+	FeedbackType     *string                   `json:"feedback_type,omitempty"`
+	FeedbackCategory *string                   `json:"feedback_category,omitempty"`
+	CreatedAt        time.Time                 `json:"created_at"`
+	Score            float64                   `json:"score"`
+	Mentions         []MentionTokenResponse    `json:"mentions,omitempty"`
+}
+
+func (r *NoteSearchResultResponse) FillFromEntity(result entity.NoteSearchResult) {
+	r.UUID = result.NoteUUID
+	r.Type = result.Type
+	r.Content = result.Content
+	r.PersonUUID = result.PersonUUID
+	r.PersonName = result.PersonName
+	r.AuthorUUID = result.AuthorUUID
+	r.AuthorName = result.AuthorName
+	r.FeedbackType = result.FeedbackType
+	r.FeedbackCategory = result.FeedbackCategory
+	r.CreatedAt = result.CreatedAt
+	r.Score = result.Score
+	r.Mentions = newMentionTokenResponses(result.Mentions)
+
+	r.Snippet = make([]NoteSnippetPartResponse, len(result.Snippet))
+	for i, part := range result.Snippet {
+		r.Snippet[i] = NoteSnippetPartResponse{Text: part.Text, Highlighted: part.Highlighted}
+	}
+}
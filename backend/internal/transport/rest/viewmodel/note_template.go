@@ -0,0 +1,114 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain"
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type NoteTemplateSectionRequest struct {
+	Title       string `json:"title" validate:"required"`
+	Placeholder string `json:"placeholder,omitempty"` // hint shown while the section is empty
+	Content     string `json:"content,omitempty"`     // initial text, may use variables like {{person_name}}
+}
+
+type NoteTemplateRequest struct {
+	Name        string                       `json:"name" validate:"required"`
+	Description string                       `json:"description,omitempty"`
+	NoteType    string                       `json:"note_type" validate:"required,oneof=one_on_one feedback observation"`
+	Scope       string                       `json:"scope,omitempty" validate:"omitempty,oneof=company user"` // defaults to company, user templates are visible only to their author
+	Sections    []NoteTemplateSectionRequest `json:"sections" validate:"required,min=1"`
+}
+
+func (r *NoteTemplateRequest) ToEntity() entity.NoteTemplate {
+	template := entity.NoteTemplate{
+		Name:        r.Name,
+		Description: r.Description,
+		NoteType:    r.NoteType,
+	}
+	for _, section := range r.Sections {
+		template.Sections = append(template.Sections, entity.NoteTemplateSection{
+			Title:       section.Title,
+			Placeholder: section.Placeholder,
+			Content:     section.Content,
+		})
+	}
+	return template
+}
+
+func (r *NoteTemplateRequest) IsPersonal() bool {
+	return r.Scope == domain.NoteTemplateScopeUser
+}
+
+type InstantiateNoteTemplateRequest struct {
+	PersonUUID string `json:"person_uuid" validate:"required"`
+}
+
+type NoteTemplateSectionResponse struct {
+	Title       string `json:"title"`
+	Placeholder string `json:"placeholder,omitempty"`
+	Content     string `json:"content,omitempty"`
+	Position    int    `json:"position"`
+}
+
+func (r *NoteTemplateSectionResponse) FillFromEntity(section entity.NoteTemplateSection) {
+	r.Title = section.Title
+	r.Placeholder = section.Placeholder
+	r.Content = section.Content
+	r.Position = section.Position
+}
+
+type NoteTemplateResponse struct {
+	UUID        string                        `json:"uuid"`
+	Scope       string                        `json:"scope"` // "built_in", "company" or "user"
+	NoteType    string                        `json:"note_type"`
+	Name        string                        `json:"name"`
+	Description string                        `json:"description,omitempty"`
+	Sections    []NoteTemplateSectionResponse `json:"sections"`
+	UsageCount  int64                         `json:"usage_count"` // notes of the company written with the template
+	CreatedAt   time.Time                     `json:"created_at"`
+	UpdatedAt   time.Time                     `json:"updated_at"`
+}
+
+func (r *NoteTemplateResponse) FillFromEntity(template entity.NoteTemplate) {
+	r.UUID = template.UUID
+	r.NoteType = template.NoteType
+	r.Name = template.Name
+	r.Description = template.Description
+	r.UsageCount = template.UsageCount
+	r.CreatedAt = template.CreatedAt
+	r.UpdatedAt = template.UpdatedAt
+
+	switch {
+	case template.CompanyID == nil:
+		r.Scope = domain.NoteTemplateScopeBuiltIn
+	case template.UserID != nil:
+		r.Scope = domain.NoteTemplateScopeUser
+	default:
+		r.Scope = domain.NoteTemplateScopeCompany
+	}
+
+	r.Sections = make([]NoteTemplateSectionResponse, len(template.Sections))
+	for i, section := range template.Sections {
+		r.Sections[i].FillFromEntity(section)
+	}
+}
+
+type NoteTemplateInstanceResponse struct {
+	TemplateUUID string                        `json:"template_uuid"` // send it as template_uuid when creating the note
+	NoteType     string                        `json:"note_type"`
+	Content      string                        `json:"content"` // the sections as markdown, ready to be the content of the note
+	Sections     []NoteTemplateSectionResponse `json:"sections"`
+}
+
+func (r *NoteTemplateInstanceResponse) FillFromEntity(instance entity.NoteTemplateInstance) {
+	r.TemplateUUID = instance.Template.UUID
+	r.NoteType = instance.Template.NoteType
+	r.Content = instance.Content
+
+	r.Sections = make([]NoteTemplateSectionResponse, len(instance.Sections))
+	for i, section := range instance.Sections {
+		r.Sections[i].FillFromEntity(section)
+	}
+}
@@ -1,6 +1,10 @@
 package viewmodel
 
-import "math"
+import (
+	"math"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
 
 type ReturnPagination struct {
 	TotalRecords   int64 `json:"total_records"`
@@ -9,9 +13,20 @@ type ReturnPagination struct {
 	CurrentPage    int64 `json:"current_page"`
 }
 
+// ReturnCursorPagination is the pagination of the lists paged by cursor. The next page is asked
+// sending NextCursor as the cursor parameter, there is no next page when it is empty
+type ReturnCursorPagination struct {
+	NextCursor     string `json:"next_cursor,omitempty"`
+	HasMore        bool   `json:"has_more"`
+	RecordsPerPage int64  `json:"records_per_page"`
+	TotalRecords   *int64 `json:"total_records,omitempty"` // only when asked with with_count
+}
+
+// PaginatedResponse has the pagination of the style used by the request, by page or by cursor
 type PaginatedResponse[T any] struct {
-	Pagination ReturnPagination `json:"pagination,omitempty"`
-	List       T                `json:"data,omitempty"`
+	Pagination       *ReturnPagination       `json:"pagination,omitempty"`
+	CursorPagination *ReturnCursorPagination `json:"cursor_pagination,omitempty"`
+	List             T                       `json:"data,omitempty"`
 }
 
 // BuildPaginatedResponse is a function that builds a paginated result based on the given parameters.
@@ -21,7 +36,7 @@ type PaginatedResponse[T any] struct {
 func BuildPaginatedResponse[T any](list T, skip int64, take int64, totalRecords int64) PaginatedResponse[T] {
 	return PaginatedResponse[T]{
 		List: list,
-		Pagination: ReturnPagination{
+		Pagination: &ReturnPagination{
 			CurrentPage:    (skip / take) + 1,
 			RecordsPerPage: take,
 			TotalRecords:   totalRecords,
@@ -29,3 +44,20 @@ func BuildPaginatedResponse[T any](list T, skip int64, take int64, totalRecords
 		},
 	}
 }
+
+// BuildCursorPaginatedResponse builds the response of a page of the cursor pagination, encoding the cursor of the next page
+func BuildCursorPaginatedResponse[T any](list T, page entity.CursorPage, info entity.CursorPageInfo) PaginatedResponse[T] {
+	pagination := &ReturnCursorPagination{
+		HasMore:        info.Next != nil,
+		RecordsPerPage: page.Limit,
+		TotalRecords:   info.TotalRecords,
+	}
+	if info.Next != nil {
+		pagination.NextCursor = info.Next.Encode()
+	}
+
+	return PaginatedResponse[T]{
+		List:             list,
+		CursorPagination: pagination,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/diegoclair/leaderpro/internal/domain/entity"
+	"github.com/diegoclair/leaderpro/util/number"
 )
 
 type PersonRequest struct {
@@ -36,25 +37,28 @@ func (p PersonRequest) ToEntity() entity.Person {
 }
 
 type PersonResponse struct {
-	UUID               string     `json:"uuid"`
-	Name               string     `json:"name"`
-	Email              string     `json:"email,omitempty"`
-	Position           string     `json:"position,omitempty"`
-	Department         string     `json:"department,omitempty"`
-	Phone              string     `json:"phone,omitempty"`
-	Birthday           *time.Time `json:"birthday,omitempty"`
-	StartDate          *time.Time `json:"start_date,omitempty"`
-	IsManager          bool       `json:"is_manager"`
-	ManagerUUID        string     `json:"manager_uuid,omitempty"`
-	Notes              string     `json:"notes,omitempty"`
-	HasKids            bool       `json:"has_kids"`
-	Gender             *string    `json:"gender,omitempty"`
-	Interests          string     `json:"interests,omitempty"`
-	Personality        string     `json:"personality,omitempty"`
-	LastOneOnOneDate   *time.Time `json:"last_one_on_one_date,omitempty"`
-	CreatedAt          time.Time  `json:"created_at"`
-	Age                *int       `json:"age,omitempty"`
-	Tenure             *int       `json:"tenure,omitempty"`
+	UUID                string     `json:"uuid"`
+	Name                string     `json:"name"`
+	Email               string     `json:"email,omitempty"`
+	Position            string     `json:"position,omitempty"`
+	Department          string     `json:"department,omitempty"`
+	Phone               string     `json:"phone,omitempty"`
+	Birthday            *time.Time `json:"birthday,omitempty"`
+	StartDate           *time.Time `json:"start_date,omitempty"`
+	IsManager           bool       `json:"is_manager"`
+	ManagerUUID         string     `json:"manager_uuid,omitempty"`
+	Notes               string     `json:"notes,omitempty"`
+	HasKids             bool       `json:"has_kids"`
+	Gender              *string    `json:"gender,omitempty"`
+	Interests           string     `json:"interests,omitempty"`
+	Personality         string     `json:"personality,omitempty"`
+	LastOneOnOneDate    *time.Time `json:"last_one_on_one_date,omitempty"`
+	OneOnOneCadence     *string    `json:"one_on_one_cadence,omitempty"`
+	OneOnOneCadenceDays *int       `json:"one_on_one_cadence_days,omitempty"`
+	NextOneOnOneDate    *string    `json:"next_one_on_one_date,omitempty"` // YYYY-MM-DD, in UTC
+	CreatedAt           time.Time  `json:"created_at"`
+	Age                 *int       `json:"age,omitempty"`
+	Tenure              *int       `json:"tenure,omitempty"`
 }
 
 func (p *PersonResponse) FillFromEntity(person entity.Person) {
@@ -73,6 +77,12 @@ func (p *PersonResponse) FillFromEntity(person entity.Person) {
 	p.Interests = person.Interests
 	p.Personality = person.Personality
 	p.LastOneOnOneDate = person.LastOneOnOneDate
+	p.OneOnOneCadence = person.OneOnOneCadence
+	p.OneOnOneCadenceDays = person.OneOnOneCadenceDays
+	if next := person.NextOneOnOneDate(time.UTC); next != nil {
+		nextDate := next.Format("2006-01-02")
+		p.NextOneOnOneDate = &nextDate
+	}
 	p.CreatedAt = person.CreatedAt
 	p.Age = person.GetAge()
 	p.Tenure = person.GetTenure()
@@ -80,4 +90,24 @@ func (p *PersonResponse) FillFromEntity(person entity.Person) {
 	// Manager UUID will be resolved in a future enhancement if needed
 	// For now, we keep it empty since we don't have a direct relationship
 	p.ManagerUUID = ""
-}
\ No newline at end of file
+}
+// PersonSearchResponse is a lightweight person used by the ranked search and the @mention autocomplete
+type PersonSearchResponse struct {
+	UUID         string  `json:"uuid"`
+	Name         string  `json:"name"`
+	Email        string  `json:"email,omitempty"`
+	Position     string  `json:"position,omitempty"`
+	Department   string  `json:"department,omitempty"`
+	Score        float64 `json:"score"`
+	MatchedField string  `json:"matched_field"`
+}
+
+func (p *PersonSearchResponse) FillFromEntity(result entity.PersonSearchResult) {
+	p.UUID = result.Person.UUID
+	p.Name = result.Person.Name
+	p.Email = result.Person.Email
+	p.Position = result.Person.Position
+	p.Department = result.Person.Department
+	p.Score = number.RoundFloat(result.Score, 3)
+	p.MatchedField = result.MatchedField
+}
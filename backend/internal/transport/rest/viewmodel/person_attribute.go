@@ -0,0 +1,53 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type PersonAttributeRequest struct {
+	Value string `json:"value" validate:"required,max=1000"`
+}
+
+type PersonAttributeResponse struct {
+	Key                   string    `json:"key"`
+	Value                 string    `json:"value"`
+	Source                string    `json:"source"`
+	Confidence            *float64  `json:"confidence,omitempty"`
+	ExtractedFromNoteUUID *string   `json:"extracted_from_note_uuid,omitempty"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+func (p *PersonAttributeResponse) FillFromEntity(attr entity.PersonAttribute) {
+	p.Key = attr.AttributeKey
+	p.Value = attr.AttributeValue
+	p.Source = attr.Source
+	p.Confidence = attr.Confidence
+	p.ExtractedFromNoteUUID = attr.ExtractedFromNoteUUID
+	p.CreatedAt = attr.CreatedAt
+	p.UpdatedAt = attr.UpdatedAt
+}
+
+type PersonAttributeHistoryResponse struct {
+	Key                   string     `json:"key"`
+	Value                 string     `json:"value"`
+	Source                string     `json:"source"`
+	Confidence            *float64   `json:"confidence,omitempty"`
+	ExtractedFromNoteUUID *string    `json:"extracted_from_note_uuid,omitempty"`
+	ValidFrom             time.Time  `json:"valid_from"`
+	ValidTo               *time.Time `json:"valid_to,omitempty"`
+	IsCurrent             bool       `json:"is_current"`
+}
+
+func (p *PersonAttributeHistoryResponse) FillFromEntity(history entity.PersonAttributeHistory) {
+	p.Key = history.AttributeKey
+	p.Value = history.AttributeValue
+	p.Source = history.Source
+	p.Confidence = history.Confidence
+	p.ExtractedFromNoteUUID = history.ExtractedFromNoteUUID
+	p.ValidFrom = history.ValidFrom
+	p.ValidTo = history.ValidTo
+	p.IsCurrent = history.IsCurrent()
+}
@@ -0,0 +1,51 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type PersonImportResponse struct {
+	UUID         string                    `json:"uuid"`
+	FileName     string                    `json:"file_name"`
+	DryRun       bool                      `json:"dry_run"`
+	Status       string                    `json:"status"`
+	TotalRows    int                       `json:"total_rows"`
+	ImportedRows int                       `json:"imported_rows"`
+	ErrorRows    int                       `json:"error_rows"`
+	Rows         []PersonImportRowResponse `json:"rows"`
+	CreatedAt    time.Time                 `json:"created_at"`
+}
+
+type PersonImportRowResponse struct {
+	Line       int      `json:"line"`
+	Status     string   `json:"status"`
+	Name       string   `json:"name"`
+	Email      string   `json:"email,omitempty"`
+	PersonUUID string   `json:"person_uuid,omitempty"`
+	Errors     []string `json:"errors,omitempty"`
+}
+
+func (p *PersonImportResponse) FillFromEntity(result entity.PersonImportResult) {
+	p.UUID = result.Import.UUID
+	p.FileName = result.Import.FileName
+	p.DryRun = result.Import.DryRun
+	p.Status = result.Import.Status
+	p.TotalRows = result.Import.TotalRows
+	p.ImportedRows = result.Import.ImportedRows
+	p.ErrorRows = result.Import.ErrorRows
+	p.CreatedAt = result.Import.CreatedAt
+
+	p.Rows = make([]PersonImportRowResponse, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		p.Rows = append(p.Rows, PersonImportRowResponse{
+			Line:       row.Line,
+			Status:     row.Status,
+			Name:       row.Person.Name,
+			Email:      row.Person.Email,
+			PersonUUID: row.Person.UUID,
+			Errors:     row.Errors,
+		})
+	}
+}
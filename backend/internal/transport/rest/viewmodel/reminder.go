@@ -0,0 +1,25 @@
+package viewmodel
+
+import (
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+// PersonReminderResponse is an upcoming birthday or work anniversary
+type PersonReminderResponse struct {
+	Type       string `json:"type"`
+	PersonUUID string `json:"person_uuid"`
+	PersonName string `json:"person_name"`
+	Date       string `json:"date"` // YYYY-MM-DD, in the user timezone
+	DaysUntil  int    `json:"days_until"`
+	Years      int    `json:"years"`
+}
+
+// FillFromEntity fills the reminder response from entity
+func (r *PersonReminderResponse) FillFromEntity(reminder entity.PersonReminder) {
+	r.Type = reminder.Type
+	r.PersonUUID = reminder.PersonUUID
+	r.PersonName = reminder.PersonName
+	r.Date = reminder.Date.Format("2006-01-02")
+	r.DaysUntil = reminder.DaysUntil
+	r.Years = reminder.Years
+}
@@ -0,0 +1,274 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type ReviewCompetencyRequest struct {
+	Name        string `json:"name" validate:"required"`
+	Description string `json:"description,omitempty"`
+}
+
+type ReviewRatingLevelRequest struct {
+	Value       int    `json:"value"` // higher is better
+	Label       string `json:"label" validate:"required"`
+	Description string `json:"description,omitempty"`
+}
+
+type ReviewTemplateRequest struct {
+	Name         string                     `json:"name" validate:"required"`
+	Description  string                     `json:"description,omitempty"`
+	Competencies []ReviewCompetencyRequest  `json:"competencies" validate:"required,min=1"`
+	RatingLevels []ReviewRatingLevelRequest `json:"rating_levels" validate:"required,min=2"`
+}
+
+func (r *ReviewTemplateRequest) ToEntity() entity.ReviewTemplate {
+	template := entity.ReviewTemplate{
+		Name:        r.Name,
+		Description: r.Description,
+	}
+	for _, competency := range r.Competencies {
+		template.Competencies = append(template.Competencies, entity.ReviewCompetency{
+			Name:        competency.Name,
+			Description: competency.Description,
+		})
+	}
+	for _, level := range r.RatingLevels {
+		template.RatingLevels = append(template.RatingLevels, entity.ReviewRatingLevel{
+			Value:       level.Value,
+			Label:       level.Label,
+			Description: level.Description,
+		})
+	}
+	return template
+}
+
+type ReviewCycleRequest struct {
+	Name         string     `json:"name" validate:"required"`
+	TemplateUUID string     `json:"template_uuid" validate:"required"`
+	PeriodStart  time.Time  `json:"period_start" validate:"required"`
+	PeriodEnd    time.Time  `json:"period_end" validate:"required"` // inclusive
+	DueDate      *time.Time `json:"due_date,omitempty"`
+}
+
+func (r *ReviewCycleRequest) ToEntity() entity.ReviewCycle {
+	return entity.ReviewCycle{
+		Name:         r.Name,
+		TemplateUUID: r.TemplateUUID,
+		PeriodStart:  r.PeriodStart,
+		PeriodEnd:    r.PeriodEnd,
+		DueDate:      r.DueDate,
+	}
+}
+
+type CreateCycleReviewsRequest struct {
+	PersonUUIDs []string `json:"person_uuids,omitempty"` // all active people of the company when empty
+}
+
+type ReviewRatingRequest struct {
+	CompetencyUUID string `json:"competency_uuid" validate:"required"`
+	Rating         *int   `json:"rating,omitempty"` // value of the rating scale of the template
+	Comment        string `json:"comment,omitempty"`
+}
+
+type UpdateReviewRequest struct {
+	OverallRating *int                  `json:"overall_rating,omitempty"`
+	Summary       string                `json:"summary,omitempty"`
+	Strengths     string                `json:"strengths,omitempty"`
+	Improvements  string                `json:"improvements,omitempty"`
+	Ratings       []ReviewRatingRequest `json:"ratings,omitempty"`
+}
+
+func (r *UpdateReviewRequest) ToEntity() entity.Review {
+	review := entity.Review{
+		OverallRating: r.OverallRating,
+		Summary:       r.Summary,
+		Strengths:     r.Strengths,
+		Improvements:  r.Improvements,
+	}
+	for _, rating := range r.Ratings {
+		review.Ratings = append(review.Ratings, entity.ReviewRating{
+			CompetencyUUID: rating.CompetencyUUID,
+			Rating:         rating.Rating,
+			Comment:        rating.Comment,
+		})
+	}
+	return review
+}
+
+type ReviewCompetencyResponse struct {
+	UUID        string `json:"uuid"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Position    int    `json:"position"`
+}
+
+type ReviewRatingLevelResponse struct {
+	Value       int    `json:"value"`
+	Label       string `json:"label"`
+	Description string `json:"description,omitempty"`
+}
+
+type ReviewTemplateResponse struct {
+	UUID         string                      `json:"uuid"`
+	Name         string                      `json:"name"`
+	Description  string                      `json:"description,omitempty"`
+	Competencies []ReviewCompetencyResponse  `json:"competencies"`
+	RatingLevels []ReviewRatingLevelResponse `json:"rating_levels"` // ordered by value, higher is better
+	CreatedAt    time.Time                   `json:"created_at"`
+	UpdatedAt    time.Time                   `json:"updated_at"`
+}
+
+func (r *ReviewTemplateResponse) FillFromEntity(template entity.ReviewTemplate) {
+	r.UUID = template.UUID
+	r.Name = template.Name
+	r.Description = template.Description
+	r.CreatedAt = template.CreatedAt
+	r.UpdatedAt = template.UpdatedAt
+
+	r.Competencies = make([]ReviewCompetencyResponse, len(template.Competencies))
+	for i, competency := range template.Competencies {
+		r.Competencies[i] = ReviewCompetencyResponse{
+			UUID:        competency.UUID,
+			Name:        competency.Name,
+			Description: competency.Description,
+			Position:    competency.Position,
+		}
+	}
+
+	r.RatingLevels = make([]ReviewRatingLevelResponse, len(template.RatingLevels))
+	for i, level := range template.RatingLevels {
+		r.RatingLevels[i] = ReviewRatingLevelResponse{
+			Value:       level.Value,
+			Label:       level.Label,
+			Description: level.Description,
+		}
+	}
+}
+
+type ReviewCycleResponse struct {
+	UUID         string    `json:"uuid"`
+	Name         string    `json:"name"`
+	TemplateUUID string    `json:"template_uuid"`
+	TemplateName string    `json:"template_name"`
+	PeriodStart  string    `json:"period_start"` // YYYY-MM-DD
+	PeriodEnd    string    `json:"period_end"`   // YYYY-MM-DD, inclusive
+	DueDate      *string   `json:"due_date,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+func (r *ReviewCycleResponse) FillFromEntity(cycle entity.ReviewCycle) {
+	r.UUID = cycle.UUID
+	r.Name = cycle.Name
+	r.TemplateUUID = cycle.TemplateUUID
+	r.TemplateName = cycle.TemplateName
+	r.PeriodStart = cycle.PeriodStart.Format("2006-01-02")
+	r.PeriodEnd = cycle.PeriodEnd.Format("2006-01-02")
+	r.CreatedAt = cycle.CreatedAt
+	r.UpdatedAt = cycle.UpdatedAt
+
+	if cycle.DueDate != nil {
+		dueDate := cycle.DueDate.Format("2006-01-02")
+		r.DueDate = &dueDate
+	}
+}
+
+type ReviewRatingResponse struct {
+	CompetencyUUID string `json:"competency_uuid"`
+	CompetencyName string `json:"competency_name"`
+	Rating         *int   `json:"rating"` // null while not rated
+	Comment        string `json:"comment,omitempty"`
+}
+
+type ReviewEvidenceResponse struct {
+	FeedbackCount      int                       `json:"feedback_count"`
+	FeedbackByType     map[string]int            `json:"feedback_by_type"`
+	FeedbackByCategory map[string]int            `json:"feedback_by_category"`
+	Feedback           []UnifiedTimelineResponse `json:"feedback"`
+	Mentions           []UnifiedTimelineResponse `json:"mentions"`
+	OneOnOneCount      int                       `json:"one_on_one_count"`
+	OneOnOneHighlights []UnifiedTimelineResponse `json:"one_on_one_highlights"`
+	ObservationCount   int                       `json:"observation_count"`
+}
+
+func timelineResponses(entries []entity.UnifiedTimelineEntry) []UnifiedTimelineResponse {
+	responses := make([]UnifiedTimelineResponse, len(entries))
+	for i, entry := range entries {
+		responses[i].FillFromUnifiedTimelineEntry(entry)
+	}
+	return responses
+}
+
+func (r *ReviewEvidenceResponse) FillFromEntity(evidence entity.ReviewEvidence) {
+	r.FeedbackCount = evidence.FeedbackCount
+	r.FeedbackByType = evidence.FeedbackByType
+	r.FeedbackByCategory = evidence.FeedbackByCategory
+	r.Feedback = timelineResponses(evidence.Feedback)
+	r.Mentions = timelineResponses(evidence.Mentions)
+	r.OneOnOneCount = evidence.OneOnOneCount
+	r.OneOnOneHighlights = timelineResponses(evidence.OneOnOneHighlights)
+	r.ObservationCount = evidence.ObservationCount
+}
+
+type ReviewResponse struct {
+	UUID          string                  `json:"uuid"`
+	CycleUUID     string                  `json:"cycle_uuid"`
+	CycleName     string                  `json:"cycle_name"`
+	PeriodStart   string                  `json:"period_start"` // YYYY-MM-DD
+	PeriodEnd     string                  `json:"period_end"`   // YYYY-MM-DD, inclusive
+	PersonUUID    string                  `json:"person_uuid"`
+	PersonName    string                  `json:"person_name"`
+	Status        string                  `json:"status"` // draft, submitted, shared
+	OverallRating *int                    `json:"overall_rating,omitempty"`
+	Summary       string                  `json:"summary,omitempty"`
+	Strengths     string                  `json:"strengths,omitempty"`
+	Improvements  string                  `json:"improvements,omitempty"`
+	SubmittedAt   *time.Time              `json:"submitted_at,omitempty"`
+	SharedAt      *time.Time              `json:"shared_at,omitempty"`
+	Ratings       []ReviewRatingResponse  `json:"ratings,omitempty"`  // only in the review document
+	Template      *ReviewTemplateResponse `json:"template,omitempty"` // only in the review document
+	Evidence      *ReviewEvidenceResponse `json:"evidence,omitempty"` // only in the review document
+	CreatedAt     time.Time               `json:"created_at"`
+	UpdatedAt     time.Time               `json:"updated_at"`
+}
+
+func (r *ReviewResponse) FillFromEntity(review entity.Review) {
+	r.UUID = review.UUID
+	r.CycleUUID = review.CycleUUID
+	r.CycleName = review.CycleName
+	r.PeriodStart = review.PeriodStart.Format("2006-01-02")
+	r.PeriodEnd = review.PeriodEnd.Format("2006-01-02")
+	r.PersonUUID = review.PersonUUID
+	r.PersonName = review.PersonName
+	r.Status = review.Status
+	r.OverallRating = review.OverallRating
+	r.Summary = review.Summary
+	r.Strengths = review.Strengths
+	r.Improvements = review.Improvements
+	r.SubmittedAt = review.SubmittedAt
+	r.SharedAt = review.SharedAt
+	r.CreatedAt = review.CreatedAt
+	r.UpdatedAt = review.UpdatedAt
+
+	for _, rating := range review.Ratings {
+		r.Ratings = append(r.Ratings, ReviewRatingResponse{
+			CompetencyUUID: rating.CompetencyUUID,
+			CompetencyName: rating.CompetencyName,
+			Rating:         rating.Rating,
+			Comment:        rating.Comment,
+		})
+	}
+
+	if review.Template != nil {
+		r.Template = &ReviewTemplateResponse{}
+		r.Template.FillFromEntity(*review.Template)
+	}
+
+	if review.Evidence != nil {
+		r.Evidence = &ReviewEvidenceResponse{}
+		r.Evidence.FillFromEntity(*review.Evidence)
+	}
+}
@@ -0,0 +1,576 @@
+package viewmodel
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+// SCIM 2.0 schemas (RFC 7643 and RFC 7644)
+const (
+	SCIMSchemaUser           = "urn:ietf:params:scim:schemas:core:2.0:User"
+	SCIMSchemaEnterpriseUser = "urn:ietf:params:scim:schemas:extension:enterprise:2.0:User"
+	SCIMSchemaGroup          = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	SCIMSchemaListResponse   = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	SCIMSchemaPatchOp        = "urn:ietf:params:scim:api:messages:2.0:PatchOp"
+	SCIMSchemaError          = "urn:ietf:params:scim:api:messages:2.0:Error"
+	SCIMSchemaServiceConfig  = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+)
+
+type SCIMName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+// SCIMMultiValue is a multi-valued attribute item, like an email, a phone number or a group member
+type SCIMMultiValue struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type SCIMManager struct {
+	Value       string `json:"value,omitempty"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// UnmarshalJSON accepts the manager as an object or, as some providers send it, as a plain string
+func (m *SCIMManager) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err == nil {
+		m.Value = value
+		return nil
+	}
+
+	type manager SCIMManager
+	return json.Unmarshal(data, (*manager)(m))
+}
+
+type SCIMEnterpriseUser struct {
+	EmployeeNumber string       `json:"employeeNumber,omitempty"`
+	Department     string       `json:"department,omitempty"`
+	Manager        *SCIMManager `json:"manager,omitempty"`
+}
+
+type SCIMMeta struct {
+	ResourceType string    `json:"resourceType"`
+	Created      time.Time `json:"created"`
+	LastModified time.Time `json:"lastModified"`
+}
+
+type SCIMUser struct {
+	Schemas      []string            `json:"schemas"`
+	ID           string              `json:"id,omitempty"`
+	ExternalID   string              `json:"externalId,omitempty"`
+	UserName     string              `json:"userName"`
+	Name         *SCIMName           `json:"name,omitempty"`
+	DisplayName  string              `json:"displayName,omitempty"`
+	Title        string              `json:"title,omitempty"`
+	Emails       []SCIMMultiValue    `json:"emails,omitempty"`
+	PhoneNumbers []SCIMMultiValue    `json:"phoneNumbers,omitempty"`
+	Active       *bool               `json:"active,omitempty"`
+	Enterprise   *SCIMEnterpriseUser `json:"urn:ietf:params:scim:schemas:extension:enterprise:2.0:User,omitempty"`
+	Meta         *SCIMMeta           `json:"meta,omitempty"`
+}
+
+// primaryValue returns the primary item of a multi-valued attribute, or the first one
+func primaryValue(values []SCIMMultiValue) string {
+	for _, value := range values {
+		if value.Primary {
+			return value.Value
+		}
+	}
+	if len(values) > 0 {
+		return values[0].Value
+	}
+	return ""
+}
+
+func (u *SCIMUser) ToEntity() entity.SCIMUser {
+	user := entity.SCIMUser{
+		ExternalID: strings.TrimSpace(u.ExternalID),
+		UserName:   strings.TrimSpace(u.UserName),
+		Email:      strings.TrimSpace(primaryValue(u.Emails)),
+		Phone:      strings.TrimSpace(primaryValue(u.PhoneNumbers)),
+		Position:   strings.TrimSpace(u.Title),
+		Active:     u.Active == nil || *u.Active,
+	}
+
+	switch {
+	case strings.TrimSpace(u.DisplayName) != "":
+		user.Name = u.DisplayName
+	case u.Name != nil && strings.TrimSpace(u.Name.Formatted) != "":
+		user.Name = u.Name.Formatted
+	case u.Name != nil:
+		user.Name = u.Name.GivenName + " " + u.Name.FamilyName
+	}
+	user.Name = strings.TrimSpace(user.Name)
+	if user.Name == "" {
+		user.Name = user.UserName
+	}
+
+	if user.Email == "" && strings.Contains(user.UserName, "@") {
+		user.Email = user.UserName
+	}
+
+	if u.Enterprise != nil {
+		user.Department = strings.TrimSpace(u.Enterprise.Department)
+		if u.Enterprise.Manager != nil {
+			user.ManagerRef = strings.TrimSpace(u.Enterprise.Manager.Value)
+		}
+	}
+
+	return user
+}
+
+func (u *SCIMUser) FillFromEntity(user entity.SCIMUser) {
+	active := user.Active
+
+	u.Schemas = []string{SCIMSchemaUser, SCIMSchemaEnterpriseUser}
+	u.ID = user.ID
+	u.ExternalID = user.ExternalID
+	u.UserName = user.UserName
+	u.Name = &SCIMName{Formatted: user.Name}
+	u.DisplayName = user.Name
+	u.Title = user.Position
+	u.Active = &active
+	u.Emails = nil
+	if user.Email != "" {
+		u.Emails = []SCIMMultiValue{{Value: user.Email, Type: "work", Primary: true}}
+	}
+	u.PhoneNumbers = nil
+	if user.Phone != "" {
+		u.PhoneNumbers = []SCIMMultiValue{{Value: user.Phone, Type: "work", Primary: true}}
+	}
+	u.Enterprise = &SCIMEnterpriseUser{Department: user.Department}
+	if user.ManagerRef != "" {
+		u.Enterprise.Manager = &SCIMManager{Value: user.ManagerRef}
+	}
+	u.Meta = &SCIMMeta{
+		ResourceType: "User",
+		Created:      user.CreatedAt,
+		LastModified: user.UpdatedAt,
+	}
+}
+
+type SCIMGroup struct {
+	Schemas     []string         `json:"schemas"`
+	ID          string           `json:"id,omitempty"`
+	ExternalID  string           `json:"externalId,omitempty"`
+	DisplayName string           `json:"displayName"`
+	Members     []SCIMMultiValue `json:"members"`
+	Meta        *SCIMMeta        `json:"meta,omitempty"`
+}
+
+func (g *SCIMGroup) ToEntity() entity.SCIMGroup {
+	group := entity.SCIMGroup{
+		ExternalID:  strings.TrimSpace(g.ExternalID),
+		DisplayName: strings.TrimSpace(g.DisplayName),
+	}
+
+	for _, member := range g.Members {
+		group.Members = append(group.Members, entity.SCIMGroupMember{PersonUUID: member.Value})
+	}
+
+	return group
+}
+
+func (g *SCIMGroup) FillFromEntity(group entity.SCIMGroup) {
+	g.Schemas = []string{SCIMSchemaGroup}
+	g.ID = group.UUID
+	g.ExternalID = group.ExternalID
+	g.DisplayName = group.DisplayName
+	g.Members = make([]SCIMMultiValue, 0, len(group.Members))
+	for _, member := range group.Members {
+		g.Members = append(g.Members, SCIMMultiValue{Value: member.PersonUUID, Display: member.Name})
+	}
+	g.Meta = &SCIMMeta{
+		ResourceType: "Group",
+		Created:      group.CreatedAt,
+		LastModified: group.UpdatedAt,
+	}
+}
+
+type SCIMListResponse[T any] struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int64    `json:"totalResults"`
+	StartIndex   int64    `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []T      `json:"Resources"`
+}
+
+func BuildSCIMListResponse[T any](resources []T, startIndex, totalResults int64) SCIMListResponse[T] {
+	if resources == nil {
+		resources = []T{}
+	}
+
+	return SCIMListResponse[T]{
+		Schemas:      []string{SCIMSchemaListResponse},
+		TotalResults: totalResults,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(resources),
+		Resources:    resources,
+	}
+}
+
+type SCIMError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail,omitempty"`
+}
+
+func NewSCIMError(status int, scimType, detail string) SCIMError {
+	return SCIMError{
+		Schemas:  []string{SCIMSchemaError},
+		Status:   strconv.Itoa(status),
+		ScimType: scimType,
+		Detail:   detail,
+	}
+}
+
+type SCIMSupported struct {
+	Supported bool `json:"supported"`
+}
+
+type SCIMFilterSupport struct {
+	Supported  bool `json:"supported"`
+	MaxResults int  `json:"maxResults"`
+}
+
+type SCIMBulkSupport struct {
+	Supported      bool `json:"supported"`
+	MaxOperations  int  `json:"maxOperations"`
+	MaxPayloadSize int  `json:"maxPayloadSize"`
+}
+
+type SCIMAuthenticationScheme struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type SCIMServiceProviderConfig struct {
+	Schemas               []string                   `json:"schemas"`
+	Patch                 SCIMSupported              `json:"patch"`
+	Bulk                  SCIMBulkSupport            `json:"bulk"`
+	Filter                SCIMFilterSupport          `json:"filter"`
+	ChangePassword        SCIMSupported              `json:"changePassword"`
+	Sort                  SCIMSupported              `json:"sort"`
+	ETag                  SCIMSupported              `json:"etag"`
+	AuthenticationSchemes []SCIMAuthenticationScheme `json:"authenticationSchemes"`
+}
+
+type SCIMPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+type SCIMPatchRequest struct {
+	Schemas    []string             `json:"schemas"`
+	Operations []SCIMPatchOperation `json:"Operations"`
+}
+
+type SCIMTokenResponse struct {
+	Token string `json:"token"`
+}
+
+var (
+	scimFilterRegex       = regexp.MustCompile(`^\s*([\w.:]+)\s+eq\s+"((?:[^"\\]|\\.)*)"\s*$`)
+	scimMemberFilterRegex = regexp.MustCompile(`(?i)^members\[\s*value\s+eq\s+"([^"]*)"\s*\]$`)
+)
+
+// ParseSCIMFilter parses a SCIM filter. Only a single equality expression is supported,
+// which is what the provisioning clients use to look up a resource before creating it
+func ParseSCIMFilter(filter string) (*entity.SCIMFilter, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+
+	matches := scimFilterRegex.FindStringSubmatch(filter)
+	if matches == nil {
+		return nil, fmt.Errorf("unsupported filter: %s", filter)
+	}
+
+	value, err := strconv.Unquote(`"` + matches[2] + `"`)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter value: %s", matches[2])
+	}
+
+	return &entity.SCIMFilter{Attribute: matches[1], Value: value}, nil
+}
+
+// parseSCIMString reads a JSON string value, null means an empty value
+func parseSCIMString(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+
+	var value string
+	err := json.Unmarshal(raw, &value)
+	return value, err
+}
+
+// parseSCIMBool reads a boolean that some providers send as a string, e.g. "False"
+func parseSCIMBool(raw json.RawMessage) (bool, error) {
+	var value bool
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return value, nil
+	}
+
+	text, err := parseSCIMString(raw)
+	if err != nil {
+		return false, err
+	}
+
+	return strconv.ParseBool(strings.ToLower(text))
+}
+
+// parseSCIMMultiValue reads a multi-valued attribute sent as a list, a single item or a plain value
+func parseSCIMMultiValue(raw json.RawMessage) ([]SCIMMultiValue, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var values []SCIMMultiValue
+	if err := json.Unmarshal(raw, &values); err == nil {
+		return values, nil
+	}
+
+	var value SCIMMultiValue
+	if err := json.Unmarshal(raw, &value); err == nil {
+		return []SCIMMultiValue{value}, nil
+	}
+
+	text, err := parseSCIMString(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return []SCIMMultiValue{{Value: text, Primary: true}}, nil
+}
+
+// ApplyPatch applies the PATCH operations to the user. Unknown attributes are ignored
+func (u *SCIMUser) ApplyPatch(operations []SCIMPatchOperation) error {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		if op != "add" && op != "replace" && op != "remove" {
+			return fmt.Errorf("unsupported patch operation: %s", operation.Op)
+		}
+
+		value := operation.Value
+		if op == "remove" {
+			value = nil
+		}
+
+		if operation.Path != "" {
+			if err := u.setAttribute(operation.Path, value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var attributes map[string]json.RawMessage
+		if err := json.Unmarshal(value, &attributes); err != nil {
+			return fmt.Errorf("patch value without path must be an object: %w", err)
+		}
+		for path, attributeValue := range attributes {
+			if err := u.setAttribute(path, attributeValue); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (u *SCIMUser) enterprise() *SCIMEnterpriseUser {
+	if u.Enterprise == nil {
+		u.Enterprise = &SCIMEnterpriseUser{}
+	}
+	return u.Enterprise
+}
+
+func (u *SCIMUser) name() *SCIMName {
+	if u.Name == nil {
+		u.Name = &SCIMName{}
+	}
+	return u.Name
+}
+
+func (u *SCIMUser) setAttribute(path string, raw json.RawMessage) (err error) {
+	attribute := strings.ToLower(path)
+	enterprisePrefix := strings.ToLower(SCIMSchemaEnterpriseUser)
+
+	switch {
+	case attribute == "active":
+		active := false
+		if len(raw) > 0 {
+			active, err = parseSCIMBool(raw)
+		}
+		u.Active = &active
+	case attribute == "username":
+		u.UserName, err = parseSCIMString(raw)
+	case attribute == "externalid":
+		u.ExternalID, err = parseSCIMString(raw)
+	case attribute == "displayname":
+		u.DisplayName, err = parseSCIMString(raw)
+	case attribute == "title":
+		u.Title, err = parseSCIMString(raw)
+	case attribute == "name":
+		u.Name = nil
+		if len(raw) > 0 && string(raw) != "null" {
+			err = json.Unmarshal(raw, u.name())
+		}
+	case attribute == "name.formatted":
+		u.name().Formatted, err = parseSCIMString(raw)
+		// the display name has precedence over the formatted name, so it's cleared when the name is replaced
+		u.DisplayName = ""
+	case attribute == "name.givenname":
+		u.name().GivenName, err = parseSCIMString(raw)
+		u.name().Formatted = ""
+		u.DisplayName = ""
+	case attribute == "name.familyname":
+		u.name().FamilyName, err = parseSCIMString(raw)
+		u.name().Formatted = ""
+		u.DisplayName = ""
+	case strings.HasPrefix(attribute, "emails"):
+		u.Emails, err = parseSCIMMultiValue(raw)
+	case strings.HasPrefix(attribute, "phonenumbers"):
+		u.PhoneNumbers, err = parseSCIMMultiValue(raw)
+	case attribute == enterprisePrefix:
+		u.Enterprise = nil
+		if len(raw) > 0 && string(raw) != "null" {
+			err = json.Unmarshal(raw, u.enterprise())
+		}
+	case attribute == enterprisePrefix+":department":
+		u.enterprise().Department, err = parseSCIMString(raw)
+	case attribute == enterprisePrefix+":manager", attribute == enterprisePrefix+":manager.value":
+		u.enterprise().Manager = nil
+		if len(raw) > 0 && string(raw) != "null" {
+			u.enterprise().Manager = &SCIMManager{}
+			err = json.Unmarshal(raw, u.enterprise().Manager)
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid value for %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// ApplyPatch applies the PATCH operations to the group, including adding and removing members
+func (g *SCIMGroup) ApplyPatch(operations []SCIMPatchOperation) error {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		if op != "add" && op != "replace" && op != "remove" {
+			return fmt.Errorf("unsupported patch operation: %s", operation.Op)
+		}
+
+		if operation.Path != "" {
+			if err := g.patchAttribute(op, operation.Path, operation.Value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var attributes map[string]json.RawMessage
+		if err := json.Unmarshal(operation.Value, &attributes); err != nil {
+			return fmt.Errorf("patch value without path must be an object: %w", err)
+		}
+		for path, value := range attributes {
+			if err := g.patchAttribute(op, path, value); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (g *SCIMGroup) patchAttribute(op, path string, raw json.RawMessage) (err error) {
+	if matches := scimMemberFilterRegex.FindStringSubmatch(path); matches != nil {
+		if op == "remove" {
+			g.removeMembers([]SCIMMultiValue{{Value: matches[1]}})
+		}
+		return nil
+	}
+
+	switch strings.ToLower(path) {
+	case "displayname":
+		if op != "remove" {
+			g.DisplayName, err = parseSCIMString(raw)
+		}
+	case "externalid":
+		g.ExternalID = ""
+		if op != "remove" {
+			g.ExternalID, err = parseSCIMString(raw)
+		}
+	case "members":
+		var members []SCIMMultiValue
+		members, err = parseSCIMMultiValue(raw)
+		if err != nil {
+			break
+		}
+
+		switch op {
+		case "add":
+			g.addMembers(members)
+		case "replace":
+			g.Members = members
+		case "remove":
+			if members == nil {
+				g.Members = nil
+			} else {
+				g.removeMembers(members)
+			}
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid value for %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func (g *SCIMGroup) addMembers(members []SCIMMultiValue) {
+	for _, member := range members {
+		exists := false
+		for _, current := range g.Members {
+			if current.Value == member.Value {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			g.Members = append(g.Members, member)
+		}
+	}
+}
+
+func (g *SCIMGroup) removeMembers(members []SCIMMultiValue) {
+	remaining := g.Members[:0]
+	for _, current := range g.Members {
+		removed := false
+		for _, member := range members {
+			if current.Value == member.Value {
+				removed = true
+				break
+			}
+		}
+		if !removed {
+			remaining = append(remaining, current)
+		}
+	}
+	g.Members = remaining
+}
@@ -0,0 +1,80 @@
+package viewmodel
+
+import (
+	"time"
+
+	"github.com/diegoclair/leaderpro/internal/domain/entity"
+)
+
+type TagRequest struct {
+	Name        string `json:"name" validate:"required"` // with or without the #
+	Description string `json:"description"`
+}
+
+func (r *TagRequest) ToEntity() entity.Tag {
+	return entity.Tag{
+		Name:        r.Name,
+		Description: r.Description,
+	}
+}
+
+type NoteTagsRequest struct {
+	Tags []string `json:"tags"` // empty removes the tags set on the note, the #tags of its content are kept
+}
+
+type TagResponse struct {
+	UUID        string    `json:"uuid"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Curated     bool      `json:"curated"`
+	NotesCount  int64     `json:"notes_count"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+func (r *TagResponse) FillFromEntity(tag entity.Tag) {
+	r.UUID = tag.UUID
+	r.Name = tag.Name
+	r.Description = tag.Description
+	r.Curated = tag.Curated
+	r.NotesCount = tag.NotesCount
+	r.CreatedAt = tag.CreatedAt
+}
+
+type TagPersonCountResponse struct {
+	Tag        string `json:"tag"`
+	PersonUUID string `json:"person_uuid"`
+	PersonName string `json:"person_name"`
+	Count      int64  `json:"count"`
+}
+
+type TagPeriodCountResponse struct {
+	Tag         string `json:"tag"`
+	PeriodStart string `json:"period_start"` // YYYY-MM-DD
+	Count       int64  `json:"count"`
+}
+
+type TagAnalyticsResponse struct {
+	ByPerson []TagPersonCountResponse `json:"by_person"`
+	OverTime []TagPeriodCountResponse `json:"over_time"`
+}
+
+func (r *TagAnalyticsResponse) FillFromEntity(analytics entity.TagAnalytics) {
+	r.ByPerson = make([]TagPersonCountResponse, len(analytics.ByPerson))
+	for i, count := range analytics.ByPerson {
+		r.ByPerson[i] = TagPersonCountResponse{
+			Tag:        count.TagName,
+			PersonUUID: count.PersonUUID,
+			PersonName: count.PersonName,
+			Count:      count.Count,
+		}
+	}
+
+	r.OverTime = make([]TagPeriodCountResponse, len(analytics.OverTime))
+	for i, count := range analytics.OverTime {
+		r.OverTime[i] = TagPeriodCountResponse{
+			Tag:         count.TagName,
+			PeriodStart: count.PeriodStart.Format("2006-01-02"),
+			Count:       count.Count,
+		}
+	}
+}
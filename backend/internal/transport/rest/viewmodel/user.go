@@ -70,21 +70,25 @@ func FromEntityUser(user entity.User) User {
 }
 
 type UpdateUserPreferences struct {
-	Theme string `json:"theme" validate:"required,oneof=light dark"`
+	Theme    string `json:"theme" validate:"required,oneof=light dark"`
+	Timezone string `json:"timezone,omitempty" validate:"omitempty,timezone"`
 }
 
 func (u *UpdateUserPreferences) ToEntity() entity.UserPreferences {
 	return entity.UserPreferences{
-		Theme: u.Theme,
+		Theme:    u.Theme,
+		Timezone: u.Timezone,
 	}
 }
 
 type UserPreferences struct {
-	Theme string `json:"theme"`
+	Theme    string `json:"theme"`
+	Timezone string `json:"timezone"`
 }
 
 func FromEntityUserPreferences(preferences entity.UserPreferences) UserPreferences {
 	return UserPreferences{
-		Theme: preferences.Theme,
+		Theme:    preferences.Theme,
+		Timezone: preferences.Timezone,
 	}
 }
@@ -0,0 +1,41 @@
+-- ================================================
+-- Migration 000008: Person attribute provenance
+-- ================================================
+
+-- Confidence score for AI extracted attributes (manual values are always 1.00)
+ALTER TABLE `person_attributes`
+ADD COLUMN `confidence` DECIMAL(3,2) NULL AFTER `source`;
+
+UPDATE `person_attributes` SET `confidence` = 1.00 WHERE `source` = 'manual';
+
+-- Extraction prompt v2 returns a confidence score for each attribute
+UPDATE `ai_prompts` SET `is_active` = FALSE WHERE `type` = 'attribute_extraction';
+
+INSERT INTO `ai_prompts` (`type`, `version`, `prompt`, `model`, `temperature`, `max_tokens`, `is_active`, `created_by`) VALUES
+(
+    'attribute_extraction',
+    2,
+    'Analise as notas fornecidas e extraia APENAS informações que você tem certeza sobre a pessoa mencionada.
+
+Retorne um JSON onde cada chave contém um objeto com o valor extraído e a sua confiança (de 0.0 a 1.0). Use apenas estas chaves permitidas:
+- has_children: "true" ou "false"
+- children_names: "Nome1, Nome2"
+- hobbies: "hobby1, hobby2"
+- communication_style: "direct", "diplomatic", "informal"
+- preferred_meeting_time: "morning", "afternoon", "evening"
+- feedback_preference: "written", "verbal", "immediate"
+- personality_traits: "trait1, trait2"
+- technical_interests: "area1, area2"
+- career_goals: "objetivo mencionado"
+- work_challenges: "desafio mencionado"
+
+Exemplo de resposta:
+{"has_children": {"value": "true", "confidence": 0.95}, "hobbies": {"value": "corrida, leitura", "confidence": 0.8}}
+
+Se a confiança for menor que 0.6, NÃO inclua no JSON. Prefira não extrair do que extrair informação incorreta.',
+    'gpt-4o-mini',
+    0.3,
+    1000,
+    TRUE,
+    1
+);
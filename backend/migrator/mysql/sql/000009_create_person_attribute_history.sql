@@ -0,0 +1,30 @@
+-- ================================================
+-- Migration 000009: Person attribute history
+-- ================================================
+
+-- Every value an attribute had, with the period it was valid.
+-- The current value is the row with valid_to NULL (same as person_attributes)
+CREATE TABLE IF NOT EXISTS `person_attribute_history` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT,
+    `person_id` INT NOT NULL,
+    `attribute_key` VARCHAR(100) NOT NULL,
+    `attribute_value` TEXT NOT NULL,
+    `source` ENUM('manual', 'ai_extracted', 'imported') NOT NULL DEFAULT 'manual',
+    `confidence` DECIMAL(3,2) NULL,
+    `extracted_from_note_id` INT NULL,
+    `valid_from` DATETIME NOT NULL,
+    `valid_to` DATETIME NULL,
+    `created_at` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (`id`),
+    INDEX `idx_person_key_valid` (`person_id`, `attribute_key`, `valid_from`),
+    INDEX `idx_person_valid_to` (`person_id`, `valid_to`),
+
+    CONSTRAINT `fk_person_attribute_history_person` FOREIGN KEY (`person_id`) REFERENCES `tab_person` (`person_id`) ON DELETE CASCADE,
+    CONSTRAINT `fk_person_attribute_history_note` FOREIGN KEY (`extracted_from_note_id`) REFERENCES `tab_note` (`note_id`) ON DELETE SET NULL
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+-- Current values become the first version of each attribute
+INSERT INTO `person_attribute_history` (`person_id`, `attribute_key`, `attribute_value`, `source`, `confidence`, `extracted_from_note_id`, `valid_from`)
+SELECT `person_id`, `attribute_key`, `attribute_value`, `source`, `confidence`, `extracted_from_note_id`, `updated_at`
+FROM `person_attributes`;
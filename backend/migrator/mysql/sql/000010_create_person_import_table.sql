@@ -0,0 +1,34 @@
+-- ================================================
+-- Migration 000010: Bulk people import
+-- ================================================
+
+CREATE TABLE IF NOT EXISTS tab_person_import (
+    import_id INT NOT NULL AUTO_INCREMENT,
+    import_uuid CHAR(36) NOT NULL,
+    company_id INT NOT NULL,
+    user_id INT NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    dry_run TINYINT(1) NOT NULL DEFAULT 0,
+    status ENUM('completed', 'failed') NOT NULL,
+    total_rows INT NOT NULL DEFAULT 0,
+    imported_rows INT NOT NULL DEFAULT 0,
+    error_rows INT NOT NULL DEFAULT 0,
+    report MEDIUMBLOB NULL COMMENT 'CSV report with the result of each row',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (import_id),
+    UNIQUE INDEX import_uuid_UNIQUE (import_uuid ASC) VISIBLE,
+    INDEX idx_person_import_company (company_id ASC, created_at DESC) VISIBLE,
+
+    CONSTRAINT fk_person_import_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_person_import_user
+        FOREIGN KEY (user_id)
+        REFERENCES tab_user (user_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
@@ -0,0 +1,98 @@
+-- ================================================
+-- Migration 000011: SCIM provisioning from the HR system
+-- ================================================
+
+-- Bearer token used by the HR system to call the SCIM endpoints of a company (only the SHA-256 hash is stored)
+CREATE TABLE IF NOT EXISTS tab_company_scim_token (
+    company_id INT NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    created_by INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (company_id),
+    UNIQUE INDEX token_hash_UNIQUE (token_hash ASC) VISIBLE,
+
+    CONSTRAINT fk_scim_token_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_scim_token_user
+        FOREIGN KEY (created_by)
+        REFERENCES tab_user (user_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+-- Link between a person and the HR system record. The snapshot keeps the values received on the
+-- last sync, so fields edited manually afterwards are detected and preserved
+CREATE TABLE IF NOT EXISTS tab_person_sync (
+    person_sync_id INT NOT NULL AUTO_INCREMENT,
+    person_id INT NOT NULL,
+    company_id INT NOT NULL,
+    external_id VARCHAR(255) NOT NULL,
+    user_name VARCHAR(255) NOT NULL,
+    snapshot JSON NULL,
+    pending_manager_ref VARCHAR(255) NULL COMMENT 'manager reference not resolved yet, linked when the manager is provisioned',
+    last_synced_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (person_sync_id),
+    UNIQUE INDEX person_sync_person_UNIQUE (person_id ASC) VISIBLE,
+    UNIQUE INDEX person_sync_external_UNIQUE (company_id ASC, external_id ASC) VISIBLE,
+    INDEX idx_person_sync_pending_manager (company_id ASC, pending_manager_ref ASC) VISIBLE,
+
+    CONSTRAINT fk_person_sync_person
+        FOREIGN KEY (person_id)
+        REFERENCES tab_person (person_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_person_sync_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_scim_group (
+    group_id INT NOT NULL AUTO_INCREMENT,
+    group_uuid CHAR(36) NOT NULL,
+    company_id INT NOT NULL,
+    external_id VARCHAR(255) NULL,
+    display_name VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (group_id),
+    UNIQUE INDEX group_uuid_UNIQUE (group_uuid ASC) VISIBLE,
+    UNIQUE INDEX scim_group_external_UNIQUE (company_id ASC, external_id ASC) VISIBLE,
+    INDEX idx_scim_group_name (company_id ASC, display_name ASC) VISIBLE,
+
+    CONSTRAINT fk_scim_group_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_scim_group_member (
+    group_id INT NOT NULL,
+    person_id INT NOT NULL,
+
+    PRIMARY KEY (group_id, person_id),
+    INDEX idx_scim_group_member_person (person_id ASC) VISIBLE,
+
+    CONSTRAINT fk_scim_group_member_group
+        FOREIGN KEY (group_id)
+        REFERENCES tab_scim_group (group_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_scim_group_member_person
+        FOREIGN KEY (person_id)
+        REFERENCES tab_person (person_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
@@ -0,0 +1,6 @@
+-- ================================================
+-- Migration 000012: User timezone, used to compute birthday and work anniversary reminders
+-- ================================================
+
+ALTER TABLE user_preferences
+    ADD COLUMN timezone VARCHAR(64) NOT NULL DEFAULT 'UTC' AFTER theme;
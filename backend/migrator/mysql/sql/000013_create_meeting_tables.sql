@@ -0,0 +1,80 @@
+-- ================================================
+-- Migration 000013: Structured 1:1 meetings with agenda items
+-- ================================================
+
+CREATE TABLE IF NOT EXISTS tab_meeting (
+    meeting_id INT NOT NULL AUTO_INCREMENT,
+    meeting_uuid CHAR(36) NOT NULL,
+    company_id INT NOT NULL,
+    person_id INT NOT NULL,
+    user_id INT NOT NULL,
+    status ENUM('scheduled', 'held', 'canceled') NOT NULL DEFAULT 'scheduled',
+    origin ENUM('scheduled', 'note') NOT NULL DEFAULT 'scheduled' COMMENT 'note: registered from a one_on_one note written without a scheduled meeting',
+    scheduled_at TIMESTAMP NOT NULL,
+    held_at TIMESTAMP NULL,
+    duration_minutes INT NULL,
+    note_id INT NULL COMMENT 'resulting one_on_one note',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (meeting_id),
+    UNIQUE INDEX meeting_uuid_UNIQUE (meeting_uuid ASC) VISIBLE,
+    INDEX idx_meeting_person_status (person_id ASC, status ASC, held_at ASC) VISIBLE,
+    INDEX idx_meeting_company_status (company_id ASC, status ASC, held_at ASC) VISIBLE,
+    INDEX idx_meeting_note (note_id ASC) VISIBLE,
+
+    CONSTRAINT fk_meeting_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_meeting_person
+        FOREIGN KEY (person_id)
+        REFERENCES tab_person (person_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_meeting_user
+        FOREIGN KEY (user_id)
+        REFERENCES tab_user (user_id)
+        ON DELETE NO ACTION
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_meeting_note
+        FOREIGN KEY (note_id)
+        REFERENCES tab_note (note_id)
+        ON DELETE SET NULL
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_meeting_agenda_item (
+    agenda_item_id INT NOT NULL AUTO_INCREMENT,
+    agenda_item_uuid CHAR(36) NOT NULL,
+    meeting_id INT NOT NULL,
+    added_by ENUM('manager', 'report') NOT NULL,
+    content TEXT NOT NULL,
+    notes TEXT NULL COMMENT 'what was discussed about the item during the meeting',
+    discussed TINYINT(1) NOT NULL DEFAULT 0,
+    position INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (agenda_item_id),
+    UNIQUE INDEX agenda_item_uuid_UNIQUE (agenda_item_uuid ASC) VISIBLE,
+    INDEX idx_agenda_item_meeting (meeting_id ASC, position ASC) VISIBLE,
+
+    CONSTRAINT fk_agenda_item_meeting
+        FOREIGN KEY (meeting_id)
+        REFERENCES tab_meeting (meeting_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+-- Existing one_on_one notes become held meetings, so the 1:1 statistics are derived only from meetings
+INSERT INTO tab_meeting (meeting_uuid, company_id, person_id, user_id, status, origin, scheduled_at, held_at, note_id, created_at)
+SELECT UUID(), n.company_id, n.person_id, n.user_id, 'held', 'note', n.created_at, n.created_at, n.note_id, n.created_at
+FROM tab_note n
+WHERE n.type = 'one_on_one'
+  AND n.deleted_at IS NULL
+  AND NOT EXISTS (SELECT 1 FROM tab_meeting m WHERE m.note_id = n.note_id);
@@ -0,0 +1,65 @@
+-- ================================================
+-- Migration 000014: Action items (follow-ups) tracked across 1:1s
+-- ================================================
+
+CREATE TABLE IF NOT EXISTS tab_action_item (
+    action_item_id INT NOT NULL AUTO_INCREMENT,
+    action_item_uuid CHAR(36) NOT NULL,
+    company_id INT NOT NULL,
+    person_id INT NOT NULL,
+    user_id INT NOT NULL,
+    owner ENUM('manager', 'report') NOT NULL COMMENT 'who committed to do it',
+    description TEXT NOT NULL,
+    due_date DATE NULL,
+    status ENUM('open', 'done', 'canceled') NOT NULL DEFAULT 'open',
+    source_note_id INT NULL COMMENT 'note where the item was created',
+    source_meeting_id INT NULL COMMENT 'meeting where the item was created',
+    completed_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (action_item_id),
+    UNIQUE INDEX action_item_uuid_UNIQUE (action_item_uuid ASC) VISIBLE,
+    INDEX idx_action_item_person_status (person_id ASC, status ASC) VISIBLE,
+    INDEX idx_action_item_company_status_due (company_id ASC, status ASC, due_date ASC) VISIBLE,
+
+    CONSTRAINT fk_action_item_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_action_item_person
+        FOREIGN KEY (person_id)
+        REFERENCES tab_person (person_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_action_item_user
+        FOREIGN KEY (user_id)
+        REFERENCES tab_user (user_id)
+        ON DELETE NO ACTION
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_action_item_note
+        FOREIGN KEY (source_note_id)
+        REFERENCES tab_note (note_id)
+        ON DELETE SET NULL
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_action_item_meeting
+        FOREIGN KEY (source_meeting_id)
+        REFERENCES tab_meeting (meeting_id)
+        ON DELETE SET NULL
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+-- Open action items are carried into the agenda of the next 1:1
+ALTER TABLE tab_meeting_agenda_item
+    ADD COLUMN action_item_id INT NULL COMMENT 'action item carried into the agenda' AFTER meeting_id,
+    ADD INDEX idx_agenda_item_action_item (action_item_id ASC) VISIBLE,
+    ADD CONSTRAINT fk_agenda_item_action_item
+        FOREIGN KEY (action_item_id)
+        REFERENCES tab_action_item (action_item_id)
+        ON DELETE SET NULL
+        ON UPDATE NO ACTION;
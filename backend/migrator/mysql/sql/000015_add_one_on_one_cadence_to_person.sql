@@ -0,0 +1,7 @@
+-- ================================================
+-- Migration 000015: Recurring 1:1 cadence per person
+-- ================================================
+
+ALTER TABLE tab_person
+    ADD COLUMN one_on_one_cadence ENUM('weekly', 'biweekly', 'monthly', 'custom') NULL COMMENT 'NULL: no recurring 1:1' AFTER personality,
+    ADD COLUMN one_on_one_cadence_days INT NULL COMMENT 'interval in days of the custom cadence' AFTER one_on_one_cadence;
@@ -0,0 +1,25 @@
+-- ================================================
+-- Migration 000016: iCalendar feed and import of 1:1 meetings
+-- ================================================
+
+-- Secret token of the personal ICS feed URL of a user (only the SHA-256 hash is stored)
+CREATE TABLE IF NOT EXISTS tab_user_calendar_feed (
+    user_id INT NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id),
+    UNIQUE INDEX calendar_feed_token_hash_UNIQUE (token_hash ASC) VISIBLE,
+
+    CONSTRAINT fk_calendar_feed_user
+        FOREIGN KEY (user_id)
+        REFERENCES tab_user (user_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+-- Meetings imported from an ICS file keep the UID of the calendar event, so importing the file again
+-- updates the same meeting. Recurring events share the UID, so it is not unique
+ALTER TABLE tab_meeting
+    ADD COLUMN ical_uid VARCHAR(255) NULL COMMENT 'UID of the calendar event the meeting was imported from' AFTER note_id,
+    ADD INDEX idx_meeting_ical_uid (user_id ASC, ical_uid ASC) VISIBLE;
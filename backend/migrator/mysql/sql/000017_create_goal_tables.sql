@@ -0,0 +1,101 @@
+-- ================================================
+-- Migration 000017: Goals (OKRs) per person with key results and check-ins
+-- ================================================
+
+CREATE TABLE IF NOT EXISTS tab_goal (
+    goal_id INT NOT NULL AUTO_INCREMENT,
+    goal_uuid CHAR(36) NOT NULL,
+    company_id INT NOT NULL,
+    person_id INT NOT NULL,
+    user_id INT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    description TEXT NULL,
+    type ENUM('career', 'performance', 'learning') NOT NULL,
+    status ENUM('active', 'achieved', 'abandoned') NOT NULL DEFAULT 'active',
+    progress TINYINT UNSIGNED NOT NULL DEFAULT 0 COMMENT 'percentage, derived from the key results when the goal has any',
+    target_date DATE NULL,
+    completed_at TIMESTAMP NULL COMMENT 'when the goal was achieved or abandoned',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (goal_id),
+    UNIQUE INDEX goal_uuid_UNIQUE (goal_uuid ASC) VISIBLE,
+    INDEX idx_goal_person_status (person_id ASC, status ASC) VISIBLE,
+
+    CONSTRAINT fk_goal_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_goal_person
+        FOREIGN KEY (person_id)
+        REFERENCES tab_person (person_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_goal_user
+        FOREIGN KEY (user_id)
+        REFERENCES tab_user (user_id)
+        ON DELETE NO ACTION
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_goal_key_result (
+    key_result_id INT NOT NULL AUTO_INCREMENT,
+    key_result_uuid CHAR(36) NOT NULL,
+    goal_id INT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    start_value DECIMAL(12,2) NOT NULL DEFAULT 0,
+    target_value DECIMAL(12,2) NOT NULL,
+    current_value DECIMAL(12,2) NOT NULL DEFAULT 0,
+    unit VARCHAR(32) NULL COMMENT 'e.g. %, projects, talks',
+    position INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (key_result_id),
+    UNIQUE INDEX key_result_uuid_UNIQUE (key_result_uuid ASC) VISIBLE,
+    INDEX idx_key_result_goal (goal_id ASC, position ASC) VISIBLE,
+
+    CONSTRAINT fk_key_result_goal
+        FOREIGN KEY (goal_id)
+        REFERENCES tab_goal (goal_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_goal_check_in (
+    check_in_id INT NOT NULL AUTO_INCREMENT,
+    check_in_uuid CHAR(36) NOT NULL,
+    goal_id INT NOT NULL,
+    user_id INT NOT NULL,
+    check_in_date DATE NOT NULL,
+    progress TINYINT UNSIGNED NOT NULL COMMENT 'goal progress after the check-in',
+    confidence ENUM('on_track', 'at_risk', 'off_track') NOT NULL DEFAULT 'on_track',
+    comment TEXT NULL,
+    note_id INT NULL COMMENT 'note where the progress was discussed',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (check_in_id),
+    UNIQUE INDEX check_in_uuid_UNIQUE (check_in_uuid ASC) VISIBLE,
+    INDEX idx_check_in_goal_date (goal_id ASC, check_in_date DESC) VISIBLE,
+
+    CONSTRAINT fk_check_in_goal
+        FOREIGN KEY (goal_id)
+        REFERENCES tab_goal (goal_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_check_in_user
+        FOREIGN KEY (user_id)
+        REFERENCES tab_user (user_id)
+        ON DELETE NO ACTION
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_check_in_note
+        FOREIGN KEY (note_id)
+        REFERENCES tab_note (note_id)
+        ON DELETE SET NULL
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
@@ -0,0 +1,166 @@
+-- ================================================
+-- Migration 000018: Performance review cycles, templates and review documents
+-- ================================================
+
+CREATE TABLE IF NOT EXISTS tab_review_template (
+    template_id INT NOT NULL AUTO_INCREMENT,
+    template_uuid CHAR(36) NOT NULL,
+    company_id INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (template_id),
+    UNIQUE INDEX template_uuid_UNIQUE (template_uuid ASC) VISIBLE,
+    INDEX idx_review_template_company (company_id ASC) VISIBLE,
+
+    CONSTRAINT fk_review_template_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_review_competency (
+    competency_id INT NOT NULL AUTO_INCREMENT,
+    competency_uuid CHAR(36) NOT NULL,
+    template_id INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NULL,
+    position INT NOT NULL,
+
+    PRIMARY KEY (competency_id),
+    UNIQUE INDEX competency_uuid_UNIQUE (competency_uuid ASC) VISIBLE,
+    INDEX idx_review_competency_template (template_id ASC, position ASC) VISIBLE,
+
+    CONSTRAINT fk_review_competency_template
+        FOREIGN KEY (template_id)
+        REFERENCES tab_review_template (template_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_review_rating_level (
+    rating_level_id INT NOT NULL AUTO_INCREMENT,
+    template_id INT NOT NULL,
+    value INT NOT NULL COMMENT 'higher is better',
+    label VARCHAR(100) NOT NULL,
+    description TEXT NULL,
+
+    PRIMARY KEY (rating_level_id),
+    UNIQUE INDEX idx_rating_level_template_value (template_id ASC, value ASC) VISIBLE,
+
+    CONSTRAINT fk_rating_level_template
+        FOREIGN KEY (template_id)
+        REFERENCES tab_review_template (template_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_review_cycle (
+    cycle_id INT NOT NULL AUTO_INCREMENT,
+    cycle_uuid CHAR(36) NOT NULL,
+    company_id INT NOT NULL,
+    template_id INT NOT NULL,
+    user_id INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    period_start DATE NOT NULL,
+    period_end DATE NOT NULL COMMENT 'inclusive',
+    due_date DATE NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (cycle_id),
+    UNIQUE INDEX cycle_uuid_UNIQUE (cycle_uuid ASC) VISIBLE,
+    INDEX idx_review_cycle_company (company_id ASC, period_end DESC) VISIBLE,
+
+    CONSTRAINT fk_review_cycle_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    -- a template can only be deleted while no cycle uses it
+    CONSTRAINT fk_review_cycle_template
+        FOREIGN KEY (template_id)
+        REFERENCES tab_review_template (template_id)
+        ON DELETE RESTRICT
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_review_cycle_user
+        FOREIGN KEY (user_id)
+        REFERENCES tab_user (user_id)
+        ON DELETE NO ACTION
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_review (
+    review_id INT NOT NULL AUTO_INCREMENT,
+    review_uuid CHAR(36) NOT NULL,
+    cycle_id INT NOT NULL,
+    company_id INT NOT NULL,
+    person_id INT NOT NULL,
+    user_id INT NOT NULL COMMENT 'reviewer',
+    status ENUM('draft', 'submitted', 'shared') NOT NULL DEFAULT 'draft',
+    overall_rating INT NULL,
+    summary TEXT NULL,
+    strengths TEXT NULL,
+    improvements TEXT NULL,
+    submitted_at TIMESTAMP NULL,
+    shared_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (review_id),
+    UNIQUE INDEX review_uuid_UNIQUE (review_uuid ASC) VISIBLE,
+    UNIQUE INDEX idx_review_cycle_person (cycle_id ASC, person_id ASC) VISIBLE,
+    INDEX idx_review_person (person_id ASC) VISIBLE,
+
+    CONSTRAINT fk_review_cycle
+        FOREIGN KEY (cycle_id)
+        REFERENCES tab_review_cycle (cycle_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_review_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_review_person
+        FOREIGN KEY (person_id)
+        REFERENCES tab_person (person_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_review_user
+        FOREIGN KEY (user_id)
+        REFERENCES tab_user (user_id)
+        ON DELETE NO ACTION
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_review_rating (
+    review_rating_id INT NOT NULL AUTO_INCREMENT,
+    review_id INT NOT NULL,
+    competency_id INT NOT NULL,
+    rating INT NULL COMMENT 'value of a rating level of the template',
+    comment TEXT NULL,
+
+    PRIMARY KEY (review_rating_id),
+    UNIQUE INDEX idx_review_rating_competency (review_id ASC, competency_id ASC) VISIBLE,
+
+    CONSTRAINT fk_review_rating_review
+        FOREIGN KEY (review_id)
+        REFERENCES tab_review (review_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_review_rating_competency
+        FOREIGN KEY (competency_id)
+        REFERENCES tab_review_competency (competency_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
@@ -0,0 +1,97 @@
+-- ================================================
+-- Migration 000019: 360 feedback requests answered through expiring links
+-- ================================================
+
+CREATE TABLE IF NOT EXISTS tab_feedback_request (
+    request_id INT NOT NULL AUTO_INCREMENT,
+    request_uuid CHAR(36) NOT NULL,
+    company_id INT NOT NULL,
+    person_id INT NOT NULL COMMENT 'person the feedback is about',
+    user_id INT NOT NULL COMMENT 'manager that requested the feedback',
+    message TEXT NULL,
+    anonymous TINYINT(1) NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (request_id),
+    UNIQUE INDEX request_uuid_UNIQUE (request_uuid ASC) VISIBLE,
+    INDEX idx_feedback_request_person (person_id ASC, created_at DESC) VISIBLE,
+
+    CONSTRAINT fk_feedback_request_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_feedback_request_person
+        FOREIGN KEY (person_id)
+        REFERENCES tab_person (person_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_feedback_request_user
+        FOREIGN KEY (user_id)
+        REFERENCES tab_user (user_id)
+        ON DELETE NO ACTION
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_feedback_question (
+    question_id INT NOT NULL AUTO_INCREMENT,
+    question_uuid CHAR(36) NOT NULL,
+    request_id INT NOT NULL,
+    text VARCHAR(500) NOT NULL,
+    type ENUM('text', 'rating') NOT NULL DEFAULT 'text',
+    required TINYINT(1) NOT NULL DEFAULT 0,
+    position INT NOT NULL,
+
+    PRIMARY KEY (question_id),
+    UNIQUE INDEX question_uuid_UNIQUE (question_uuid ASC) VISIBLE,
+    INDEX idx_feedback_question_request (request_id ASC, position ASC) VISIBLE,
+
+    CONSTRAINT fk_feedback_question_request
+        FOREIGN KEY (request_id)
+        REFERENCES tab_feedback_request (request_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_feedback_respondent (
+    respondent_id INT NOT NULL AUTO_INCREMENT,
+    respondent_uuid CHAR(36) NOT NULL,
+    request_id INT NOT NULL,
+    person_id INT NULL COMMENT 'peer of the company, NULL for external emails',
+    name VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NULL,
+    token_hash CHAR(64) NOT NULL COMMENT 'sha256 of the token of the link, the token itself is not stored',
+    responded_at TIMESTAMP NULL,
+    note_id INT NULL COMMENT 'feedback note created by the response, not linked when anonymous',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (respondent_id),
+    UNIQUE INDEX respondent_uuid_UNIQUE (respondent_uuid ASC) VISIBLE,
+    UNIQUE INDEX idx_feedback_respondent_token (token_hash ASC) VISIBLE,
+    INDEX idx_feedback_respondent_request (request_id ASC) VISIBLE,
+
+    CONSTRAINT fk_feedback_respondent_request
+        FOREIGN KEY (request_id)
+        REFERENCES tab_feedback_request (request_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_feedback_respondent_person
+        FOREIGN KEY (person_id)
+        REFERENCES tab_person (person_id)
+        ON DELETE SET NULL
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_feedback_respondent_note
+        FOREIGN KEY (note_id)
+        REFERENCES tab_note (note_id)
+        ON DELETE SET NULL
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+-- Feedback notes written by respondents keep the manager as user_id and show the respondent as author
+ALTER TABLE tab_note
+    ADD COLUMN respondent_name VARCHAR(255) NULL AFTER feedback_category;
@@ -0,0 +1,180 @@
+-- ================================================
+-- Migration 000020: competency frameworks (career ladders), assessments and feedback notes tagged by competency
+-- ================================================
+
+CREATE TABLE IF NOT EXISTS tab_competency_framework (
+    framework_id INT NOT NULL AUTO_INCREMENT,
+    framework_uuid CHAR(36) NOT NULL,
+    company_id INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (framework_id),
+    UNIQUE INDEX framework_uuid_UNIQUE (framework_uuid ASC) VISIBLE,
+    INDEX idx_competency_framework_company (company_id ASC) VISIBLE,
+
+    CONSTRAINT fk_competency_framework_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_competency_level (
+    level_id INT NOT NULL AUTO_INCREMENT,
+    level_uuid CHAR(36) NOT NULL,
+    framework_id INT NOT NULL,
+    name VARCHAR(255) NOT NULL COMMENT 'role/position of the ladder, e.g. Senior Engineer',
+    description TEXT NULL,
+    position INT NOT NULL COMMENT 'higher is more senior',
+
+    PRIMARY KEY (level_id),
+    UNIQUE INDEX level_uuid_UNIQUE (level_uuid ASC) VISIBLE,
+    INDEX idx_competency_level_framework (framework_id ASC, position ASC) VISIBLE,
+
+    CONSTRAINT fk_competency_level_framework
+        FOREIGN KEY (framework_id)
+        REFERENCES tab_competency_framework (framework_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_competency (
+    competency_id INT NOT NULL AUTO_INCREMENT,
+    competency_uuid CHAR(36) NOT NULL,
+    framework_id INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NULL,
+    position INT NOT NULL,
+
+    PRIMARY KEY (competency_id),
+    UNIQUE INDEX competency_uuid_UNIQUE (competency_uuid ASC) VISIBLE,
+    INDEX idx_competency_framework (framework_id ASC, position ASC) VISIBLE,
+
+    CONSTRAINT fk_competency_framework
+        FOREIGN KEY (framework_id)
+        REFERENCES tab_competency_framework (framework_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_competency_descriptor (
+    descriptor_id INT NOT NULL AUTO_INCREMENT,
+    competency_id INT NOT NULL,
+    level_id INT NOT NULL,
+    description TEXT NOT NULL COMMENT 'what is expected of the competency at the level',
+
+    PRIMARY KEY (descriptor_id),
+    UNIQUE INDEX idx_competency_descriptor_level (competency_id ASC, level_id ASC) VISIBLE,
+
+    CONSTRAINT fk_competency_descriptor_competency
+        FOREIGN KEY (competency_id)
+        REFERENCES tab_competency (competency_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_competency_descriptor_level
+        FOREIGN KEY (level_id)
+        REFERENCES tab_competency_level (level_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_competency_assessment (
+    assessment_id INT NOT NULL AUTO_INCREMENT,
+    assessment_uuid CHAR(36) NOT NULL,
+    company_id INT NOT NULL,
+    person_id INT NOT NULL,
+    framework_id INT NOT NULL,
+    expected_level_id INT NOT NULL COMMENT 'level of the role of the person when assessed',
+    user_id INT NOT NULL COMMENT 'manager that assessed',
+    notes TEXT NULL,
+    assessed_at DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (assessment_id),
+    UNIQUE INDEX assessment_uuid_UNIQUE (assessment_uuid ASC) VISIBLE,
+    INDEX idx_competency_assessment_person (person_id ASC, framework_id ASC, assessed_at DESC) VISIBLE,
+
+    CONSTRAINT fk_competency_assessment_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_competency_assessment_person
+        FOREIGN KEY (person_id)
+        REFERENCES tab_person (person_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_competency_assessment_framework
+        FOREIGN KEY (framework_id)
+        REFERENCES tab_competency_framework (framework_id)
+        ON DELETE RESTRICT
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_competency_assessment_expected_level
+        FOREIGN KEY (expected_level_id)
+        REFERENCES tab_competency_level (level_id)
+        ON DELETE RESTRICT
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_competency_assessment_user
+        FOREIGN KEY (user_id)
+        REFERENCES tab_user (user_id)
+        ON DELETE NO ACTION
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_competency_assessment_rating (
+    rating_id INT NOT NULL AUTO_INCREMENT,
+    assessment_id INT NOT NULL,
+    competency_id INT NOT NULL,
+    level_id INT NOT NULL COMMENT 'level the person demonstrates in the competency',
+    comment TEXT NULL,
+
+    PRIMARY KEY (rating_id),
+    UNIQUE INDEX idx_assessment_rating_competency (assessment_id ASC, competency_id ASC) VISIBLE,
+
+    CONSTRAINT fk_assessment_rating_assessment
+        FOREIGN KEY (assessment_id)
+        REFERENCES tab_competency_assessment (assessment_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_assessment_rating_competency
+        FOREIGN KEY (competency_id)
+        REFERENCES tab_competency (competency_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_assessment_rating_level
+        FOREIGN KEY (level_id)
+        REFERENCES tab_competency_level (level_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_note_competency (
+    note_id INT NOT NULL,
+    competency_id INT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (note_id, competency_id),
+    INDEX idx_note_competency_competency (competency_id ASC) VISIBLE,
+
+    CONSTRAINT fk_note_competency_note
+        FOREIGN KEY (note_id)
+        REFERENCES tab_note (note_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_note_competency_competency
+        FOREIGN KEY (competency_id)
+        REFERENCES tab_competency (competency_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
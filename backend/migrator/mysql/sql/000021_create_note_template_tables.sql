@@ -0,0 +1,82 @@
+-- ================================================
+-- Migration 000021: note templates with sections and the template used by each note
+-- ================================================
+
+CREATE TABLE IF NOT EXISTS tab_note_template (
+    template_id INT NOT NULL AUTO_INCREMENT,
+    template_uuid CHAR(36) NOT NULL,
+    company_id INT NULL COMMENT 'NULL for the built-in templates available to every company',
+    user_id INT NULL COMMENT 'set for the personal templates of a user',
+    note_type VARCHAR(50) NOT NULL COMMENT 'one_on_one, feedback or observation',
+    name VARCHAR(255) NOT NULL,
+    description TEXT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (template_id),
+    UNIQUE INDEX template_uuid_UNIQUE (template_uuid ASC) VISIBLE,
+    INDEX idx_note_template_company (company_id ASC, user_id ASC) VISIBLE,
+
+    CONSTRAINT fk_note_template_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_note_template_user
+        FOREIGN KEY (user_id)
+        REFERENCES tab_user (user_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_note_template_section (
+    section_id INT NOT NULL AUTO_INCREMENT,
+    template_id INT NOT NULL,
+    title VARCHAR(255) NOT NULL,
+    placeholder TEXT NULL COMMENT 'hint shown while the section is empty',
+    content TEXT NULL COMMENT 'initial text, may use variables like {{person_name}}',
+    position INT NOT NULL,
+
+    PRIMARY KEY (section_id),
+    INDEX idx_note_template_section_template (template_id ASC, position ASC) VISIBLE,
+
+    CONSTRAINT fk_note_template_section_template
+        FOREIGN KEY (template_id)
+        REFERENCES tab_note_template (template_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+ALTER TABLE tab_note
+    ADD COLUMN template_id INT NULL AFTER respondent_name,
+    ADD INDEX idx_note_template (template_id ASC) VISIBLE,
+    ADD CONSTRAINT fk_note_template
+        FOREIGN KEY (template_id)
+        REFERENCES tab_note_template (template_id)
+        ON DELETE SET NULL
+        ON UPDATE NO ACTION;
+
+-- Built-in templates
+INSERT INTO tab_note_template (template_uuid, company_id, user_id, note_type, name, description) VALUES
+('6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a01', NULL, NULL, 'feedback', 'SBI feedback', 'Situation, Behavior and Impact: describe the facts and their effect, without judgments'),
+('6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a02', NULL, NULL, 'one_on_one', 'Career conversation', 'Talk about the goals, the strengths and the next steps of the career'),
+('6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a03', NULL, NULL, 'one_on_one', 'First 1:1 (onboarding)', 'Get to know the person and agree on how you will work together');
+
+INSERT INTO tab_note_template_section (template_id, title, placeholder, content, position)
+SELECT t.template_id, s.title, s.placeholder, s.content, s.position
+FROM tab_note_template t
+INNER JOIN (
+    SELECT '6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a01' AS template_uuid, 'Situation' AS title, 'When and where did it happen?' AS placeholder, NULL AS content, 1 AS position
+    UNION ALL SELECT '6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a01', 'Behavior', 'What did {{person_first_name}} do or say? Only observable facts', NULL, 2
+    UNION ALL SELECT '6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a01', 'Impact', 'What was the effect on the team, the clients or the results?', NULL, 3
+    UNION ALL SELECT '6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a01', 'Next steps', 'What should continue or change?', NULL, 4
+    UNION ALL SELECT '6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a02', 'Where are you today', 'What do you enjoy the most in your work? What drains your energy?', NULL, 1
+    UNION ALL SELECT '6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a02', 'Where do you want to go', 'Where do you see yourself in 1 and in 3 years?', NULL, 2
+    UNION ALL SELECT '6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a02', 'Strengths and gaps', 'Which skills will take you there? Which ones do you need to develop?', NULL, 3
+    UNION ALL SELECT '6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a02', 'Next steps', 'What will each of us do until the next conversation?', NULL, 4
+    UNION ALL SELECT '6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a03', 'Getting to know each other', 'Background, interests outside of work, what motivates {{person_first_name}}', NULL, 1
+    UNION ALL SELECT '6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a03', 'Working together', 'How do you like to receive feedback? How often should we meet?', NULL, 2
+    UNION ALL SELECT '6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a03', 'Expectations', 'What is expected in the first 30, 60 and 90 days', NULL, 3
+    UNION ALL SELECT '6f1d2c3a-0b6e-4c1f-9a51-1d8e0c2b7a03', 'Questions and concerns', NULL, NULL, 4
+) s ON s.template_uuid = t.template_uuid;
@@ -0,0 +1,38 @@
+-- ================================================
+-- Migration 000022: immutable revisions of the notes
+-- ================================================
+
+CREATE TABLE IF NOT EXISTS tab_note_revision (
+    revision_id INT NOT NULL AUTO_INCREMENT,
+    revision_uuid CHAR(36) NOT NULL,
+    note_id INT NOT NULL,
+    revision_number INT NOT NULL COMMENT 'starts at 1, the latest revision is the current content of the note',
+    user_id INT NOT NULL COMMENT 'user who wrote the revision',
+    type VARCHAR(50) NOT NULL,
+    content TEXT NOT NULL,
+    feedback_type VARCHAR(50) NULL,
+    feedback_category VARCHAR(50) NULL,
+    restored_from INT NULL COMMENT 'number of the revision restored by this revision',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (revision_id),
+    UNIQUE INDEX revision_uuid_UNIQUE (revision_uuid ASC) VISIBLE,
+    UNIQUE INDEX idx_note_revision_number (note_id ASC, revision_number ASC) VISIBLE,
+
+    CONSTRAINT fk_note_revision_note
+        FOREIGN KEY (note_id)
+        REFERENCES tab_note (note_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_note_revision_user
+        FOREIGN KEY (user_id)
+        REFERENCES tab_user (user_id)
+        ON DELETE NO ACTION
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+-- The current content of the existing notes is their first revision
+INSERT INTO tab_note_revision (revision_uuid, note_id, revision_number, user_id, type, content, feedback_type, feedback_category, created_at)
+SELECT UUID(), n.note_id, 1, n.user_id, n.type, n.content, n.feedback_type, n.feedback_category, n.updated_at
+FROM tab_note n;
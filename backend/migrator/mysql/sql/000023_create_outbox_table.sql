@@ -0,0 +1,21 @@
+-- ================================================
+-- Migration 000023: transactional outbox of the side effects
+-- ================================================
+
+CREATE TABLE IF NOT EXISTS tab_outbox_event (
+    event_id INT NOT NULL AUTO_INCREMENT,
+    event_uuid CHAR(36) NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSON NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' COMMENT 'pending, processing, done or failed',
+    attempts INT NOT NULL DEFAULT 0,
+    max_attempts INT NOT NULL,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'when a pending event is due, or when the lease of a processing event expires',
+    last_error TEXT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    processed_at TIMESTAMP NULL,
+
+    PRIMARY KEY (event_id),
+    UNIQUE INDEX event_uuid_UNIQUE (event_uuid ASC) VISIBLE,
+    INDEX idx_outbox_event_due (status ASC, next_attempt_at ASC) VISIBLE
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
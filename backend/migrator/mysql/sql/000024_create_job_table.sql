@@ -0,0 +1,26 @@
+-- ================================================
+-- Migration 000024: persistent background job queue
+-- ================================================
+
+CREATE TABLE IF NOT EXISTS tab_job (
+    job_id INT NOT NULL AUTO_INCREMENT,
+    job_uuid CHAR(36) NOT NULL COMMENT 'idempotency key, a job is enqueued only once',
+    queue VARCHAR(50) NOT NULL,
+    job_type VARCHAR(100) NOT NULL,
+    payload JSON NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' COMMENT 'pending, running or dead, finished jobs are deleted',
+    attempts INT NOT NULL DEFAULT 0,
+    max_attempts INT NOT NULL,
+    run_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT 'when a pending job is due, or when the lease of a running job expires',
+    last_error TEXT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    failed_at TIMESTAMP NULL,
+
+    PRIMARY KEY (job_id),
+    UNIQUE INDEX job_uuid_UNIQUE (job_uuid ASC) VISIBLE,
+    INDEX idx_job_due (queue ASC, status ASC, run_at ASC) VISIBLE
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+-- The cleanup of the expired AI conversations is now a scheduled job of the job queue
+DROP EVENT IF EXISTS `cleanup_old_ai_data`;
+DROP PROCEDURE IF EXISTS `cleanup_ai_conversations`;
@@ -0,0 +1,6 @@
+-- FULLTEXT indexes used by the company-wide note search
+ALTER TABLE tab_note
+ADD FULLTEXT INDEX ft_note_text (content, feedback_category);
+
+ALTER TABLE tab_person
+ADD FULLTEXT INDEX ft_person_name (name);
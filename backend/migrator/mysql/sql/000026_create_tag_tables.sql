@@ -0,0 +1,45 @@
+-- ================================================
+-- Migration 000026: tags of the notes
+-- ================================================
+
+CREATE TABLE IF NOT EXISTS tab_tag (
+    tag_id INT NOT NULL AUTO_INCREMENT,
+    tag_uuid CHAR(36) NOT NULL,
+    company_id INT NOT NULL,
+    name VARCHAR(50) NOT NULL COMMENT 'lowercase, without the #',
+    description VARCHAR(255) NOT NULL DEFAULT '',
+    curated TINYINT(1) NOT NULL DEFAULT 0 COMMENT 'created by the company to be reused, otherwise created by a note',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (tag_id),
+    UNIQUE INDEX tag_uuid_UNIQUE (tag_uuid ASC) VISIBLE,
+    UNIQUE INDEX idx_tag_company_name (company_id ASC, name ASC) VISIBLE,
+
+    CONSTRAINT fk_tag_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+CREATE TABLE IF NOT EXISTS tab_note_tag (
+    note_id INT NOT NULL,
+    tag_id INT NOT NULL,
+    source VARCHAR(20) NOT NULL COMMENT 'content: #tag written in the note, manual: set without writing it',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (note_id, tag_id, source),
+    INDEX idx_note_tag_tag (tag_id ASC) VISIBLE,
+
+    CONSTRAINT fk_note_tag_note
+        FOREIGN KEY (note_id)
+        REFERENCES tab_note (note_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_note_tag_tag
+        FOREIGN KEY (tag_id)
+        REFERENCES tab_tag (tag_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
@@ -0,0 +1,28 @@
+-- ================================================
+-- Migration 000027: files attached to the notes
+-- ================================================
+
+CREATE TABLE IF NOT EXISTS tab_note_attachment (
+    attachment_id INT NOT NULL AUTO_INCREMENT,
+    attachment_uuid CHAR(36) NOT NULL,
+    note_id INT NOT NULL,
+    company_id INT NOT NULL,
+    user_id INT NOT NULL COMMENT 'user who uploaded the file',
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    storage_key VARCHAR(255) NOT NULL COMMENT 'key of the content in the blob storage',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMP NULL DEFAULT NULL COMMENT 'deleted by the user, the content is removed by the cleanup job',
+
+    PRIMARY KEY (attachment_id),
+    UNIQUE INDEX attachment_uuid_UNIQUE (attachment_uuid ASC) VISIBLE,
+    INDEX idx_note_attachment_note (note_id ASC, deleted_at ASC) VISIBLE,
+    INDEX idx_note_attachment_deleted (deleted_at ASC) VISIBLE,
+
+    CONSTRAINT fk_note_attachment_note
+        FOREIGN KEY (note_id)
+        REFERENCES tab_note (note_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
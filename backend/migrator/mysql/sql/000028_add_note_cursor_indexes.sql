@@ -0,0 +1,3 @@
+-- Indexes of the cursor pagination of the timeline and mentions, keyed on (created_at, note_id)
+ALTER TABLE tab_note
+ADD INDEX idx_note_person_created (person_id ASC, created_at ASC, note_id ASC) VISIBLE;
@@ -0,0 +1,61 @@
+-- ================================================
+-- Migration 000029: company activity feed
+-- ================================================
+
+-- Events of the people of the company, written with the change that caused them
+CREATE TABLE IF NOT EXISTS tab_activity (
+    activity_id BIGINT NOT NULL AUTO_INCREMENT,
+    activity_uuid CHAR(36) NOT NULL,
+    company_id INT NOT NULL,
+    person_id INT NOT NULL COMMENT 'person the event is about',
+    user_id INT NULL COMMENT 'who caused the event, NULL for the AI',
+    type VARCHAR(50) NOT NULL,
+    detail VARCHAR(255) NOT NULL DEFAULT '' COMMENT 'depends on the type, e.g. the note type or the changed fields',
+    reference_uuid CHAR(36) NULL COMMENT 'note or goal of the event',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (activity_id),
+    UNIQUE INDEX activity_uuid_UNIQUE (activity_uuid ASC) VISIBLE,
+    INDEX idx_activity_company_created (company_id ASC, created_at ASC, activity_id ASC) VISIBLE,
+    INDEX idx_activity_person_created (person_id ASC, created_at ASC, activity_id ASC) VISIBLE,
+
+    CONSTRAINT fk_activity_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_activity_person
+        FOREIGN KEY (person_id)
+        REFERENCES tab_person (person_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_activity_user
+        FOREIGN KEY (user_id)
+        REFERENCES tab_user (user_id)
+        ON DELETE SET NULL
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
+
+-- Last activity of the company seen by each user, the newer ones are unread
+CREATE TABLE IF NOT EXISTS tab_activity_seen (
+    user_id INT NOT NULL,
+    company_id INT NOT NULL,
+    last_seen_activity_id BIGINT NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (user_id, company_id),
+
+    CONSTRAINT fk_activity_seen_user
+        FOREIGN KEY (user_id)
+        REFERENCES tab_user (user_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION,
+
+    CONSTRAINT fk_activity_seen_company
+        FOREIGN KEY (company_id)
+        REFERENCES tab_company (company_id)
+        ON DELETE CASCADE
+        ON UPDATE NO ACTION
+) ENGINE = InnoDB CHARACTER SET=utf8mb4;
@@ -0,0 +1,8 @@
+-- ================================================
+-- Migration 000030: people imports run by the job queue
+-- ================================================
+
+-- An import is pending from the upload until the job creates its people, the file is kept meanwhile
+ALTER TABLE tab_person_import
+    MODIFY COLUMN status ENUM('pending', 'completed', 'failed') NOT NULL,
+    ADD COLUMN file_data MEDIUMBLOB NULL COMMENT 'uploaded file, removed once the import is processed' AFTER report;
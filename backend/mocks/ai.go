@@ -57,10 +57,10 @@ func (mr *MockAIProviderMockRecorder) Chat(ctx, req, systemPrompt, contextPrompt
 }
 
 // ExtractAttributes mocks base method.
-func (m *MockAIProvider) ExtractAttributes(ctx context.Context, req entity.ExtractionRequest, extractionPrompt string) (map[string]string, entity.AIUsage, error) {
+func (m *MockAIProvider) ExtractAttributes(ctx context.Context, req entity.ExtractionRequest, extractionPrompt string) (map[string]entity.ExtractedAttribute, entity.AIUsage, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ExtractAttributes", ctx, req, extractionPrompt)
-	ret0, _ := ret[0].(map[string]string)
+	ret0, _ := ret[0].(map[string]entity.ExtractedAttribute)
 	ret1, _ := ret[1].(entity.AIUsage)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -42,6 +42,20 @@ func (m *MockInfrastructure) EXPECT() *MockInfrastructureMockRecorder {
 	return m.recorder
 }
 
+// BlobStorage mocks base method.
+func (m *MockInfrastructure) BlobStorage() contract.BlobStorage {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlobStorage")
+	ret0, _ := ret[0].(contract.BlobStorage)
+	return ret0
+}
+
+// BlobStorage indicates an expected call of BlobStorage.
+func (mr *MockInfrastructureMockRecorder) BlobStorage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlobStorage", reflect.TypeOf((*MockInfrastructure)(nil).BlobStorage))
+}
+
 // CacheManager mocks base method.
 func (m *MockInfrastructure) CacheManager() contract.CacheManager {
 	m.ctrl.T.Helper()
@@ -84,6 +98,20 @@ func (mr *MockInfrastructureMockRecorder) DataManager() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DataManager", reflect.TypeOf((*MockInfrastructure)(nil).DataManager))
 }
 
+// JobQueue mocks base method.
+func (m *MockInfrastructure) JobQueue() contract.JobQueue {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobQueue")
+	ret0, _ := ret[0].(contract.JobQueue)
+	return ret0
+}
+
+// JobQueue indicates an expected call of JobQueue.
+func (mr *MockInfrastructureMockRecorder) JobQueue() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobQueue", reflect.TypeOf((*MockInfrastructure)(nil).JobQueue))
+}
+
 // Logger mocks base method.
 func (m *MockInfrastructure) Logger() logger.Logger {
 	m.ctrl.T.Helper()
@@ -98,6 +126,20 @@ func (mr *MockInfrastructureMockRecorder) Logger() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logger", reflect.TypeOf((*MockInfrastructure)(nil).Logger))
 }
 
+// NoteSearchEngine mocks base method.
+func (m *MockInfrastructure) NoteSearchEngine() contract.NoteSearchEngine {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NoteSearchEngine")
+	ret0, _ := ret[0].(contract.NoteSearchEngine)
+	return ret0
+}
+
+// NoteSearchEngine indicates an expected call of NoteSearchEngine.
+func (mr *MockInfrastructureMockRecorder) NoteSearchEngine() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NoteSearchEngine", reflect.TypeOf((*MockInfrastructure)(nil).NoteSearchEngine))
+}
+
 // Validator mocks base method.
 func (m *MockInfrastructure) Validator() validator.Validator {
 	m.ctrl.T.Helper()
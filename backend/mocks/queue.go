@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/contract/queue.go
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -source=internal/domain/contract/queue.go -destination=mocks/queue.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/diegoclair/leaderpro/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockJobQueue is a mock of JobQueue interface.
+type MockJobQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobQueueMockRecorder
+	isgomock struct{}
+}
+
+// MockJobQueueMockRecorder is the mock recorder for MockJobQueue.
+type MockJobQueueMockRecorder struct {
+	mock *MockJobQueue
+}
+
+// NewMockJobQueue creates a new mock instance.
+func NewMockJobQueue(ctrl *gomock.Controller) *MockJobQueue {
+	mock := &MockJobQueue{ctrl: ctrl}
+	mock.recorder = &MockJobQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobQueue) EXPECT() *MockJobQueueMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockJobQueue) Complete(ctx context.Context, job entity.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockJobQueueMockRecorder) Complete(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockJobQueue)(nil).Complete), ctx, job)
+}
+
+// DeadLetter mocks base method.
+func (m *MockJobQueue) DeadLetter(ctx context.Context, job entity.Job, lastError string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeadLetter", ctx, job, lastError, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeadLetter indicates an expected call of DeadLetter.
+func (mr *MockJobQueueMockRecorder) DeadLetter(ctx, job, lastError, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeadLetter", reflect.TypeOf((*MockJobQueue)(nil).DeadLetter), ctx, job, lastError, now)
+}
+
+// Dequeue mocks base method.
+func (m *MockJobQueue) Dequeue(ctx context.Context, queue string, now, leaseUntil time.Time, limit int) ([]entity.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dequeue", ctx, queue, now, leaseUntil, limit)
+	ret0, _ := ret[0].([]entity.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Dequeue indicates an expected call of Dequeue.
+func (mr *MockJobQueueMockRecorder) Dequeue(ctx, queue, now, leaseUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dequeue", reflect.TypeOf((*MockJobQueue)(nil).Dequeue), ctx, queue, now, leaseUntil, limit)
+}
+
+// Enqueue mocks base method.
+func (m *MockJobQueue) Enqueue(ctx context.Context, job entity.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Enqueue", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockJobQueueMockRecorder) Enqueue(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockJobQueue)(nil).Enqueue), ctx, job)
+}
+
+// GetDeadJobs mocks base method.
+func (m *MockJobQueue) GetDeadJobs(ctx context.Context, queue string, limit int) ([]entity.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadJobs", ctx, queue, limit)
+	ret0, _ := ret[0].([]entity.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadJobs indicates an expected call of GetDeadJobs.
+func (mr *MockJobQueueMockRecorder) GetDeadJobs(ctx, queue, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadJobs", reflect.TypeOf((*MockJobQueue)(nil).GetDeadJobs), ctx, queue, limit)
+}
+
+// GetStats mocks base method.
+func (m *MockJobQueue) GetStats(ctx context.Context, queue string, now time.Time) (entity.JobQueueStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStats", ctx, queue, now)
+	ret0, _ := ret[0].(entity.JobQueueStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStats indicates an expected call of GetStats.
+func (mr *MockJobQueueMockRecorder) GetStats(ctx, queue, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockJobQueue)(nil).GetStats), ctx, queue, now)
+}
+
+// Requeue mocks base method.
+func (m *MockJobQueue) Requeue(ctx context.Context, jobUUID string, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Requeue", ctx, jobUUID, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Requeue indicates an expected call of Requeue.
+func (mr *MockJobQueueMockRecorder) Requeue(ctx, jobUUID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Requeue", reflect.TypeOf((*MockJobQueue)(nil).Requeue), ctx, jobUUID, now)
+}
+
+// Retry mocks base method.
+func (m *MockJobQueue) Retry(ctx context.Context, job entity.Job, lastError string, runAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Retry", ctx, job, lastError, runAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Retry indicates an expected call of Retry.
+func (mr *MockJobQueueMockRecorder) Retry(ctx, job, lastError, runAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Retry", reflect.TypeOf((*MockJobQueue)(nil).Retry), ctx, job, lastError, runAt)
+}
@@ -58,6 +58,34 @@ func (mr *MockDataManagerMockRecorder) AI() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AI", reflect.TypeOf((*MockDataManager)(nil).AI))
 }
 
+// ActionItem mocks base method.
+func (m *MockDataManager) ActionItem() contract.ActionItemRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActionItem")
+	ret0, _ := ret[0].(contract.ActionItemRepo)
+	return ret0
+}
+
+// ActionItem indicates an expected call of ActionItem.
+func (mr *MockDataManagerMockRecorder) ActionItem() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActionItem", reflect.TypeOf((*MockDataManager)(nil).ActionItem))
+}
+
+// Activity mocks base method.
+func (m *MockDataManager) Activity() contract.ActivityRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Activity")
+	ret0, _ := ret[0].(contract.ActivityRepo)
+	return ret0
+}
+
+// Activity indicates an expected call of Activity.
+func (mr *MockDataManagerMockRecorder) Activity() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Activity", reflect.TypeOf((*MockDataManager)(nil).Activity))
+}
+
 // Auth mocks base method.
 func (m *MockDataManager) Auth() contract.AuthRepo {
 	m.ctrl.T.Helper()
@@ -86,6 +114,62 @@ func (mr *MockDataManagerMockRecorder) Company() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Company", reflect.TypeOf((*MockDataManager)(nil).Company))
 }
 
+// Competency mocks base method.
+func (m *MockDataManager) Competency() contract.CompetencyRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Competency")
+	ret0, _ := ret[0].(contract.CompetencyRepo)
+	return ret0
+}
+
+// Competency indicates an expected call of Competency.
+func (mr *MockDataManagerMockRecorder) Competency() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Competency", reflect.TypeOf((*MockDataManager)(nil).Competency))
+}
+
+// FeedbackRequest mocks base method.
+func (m *MockDataManager) FeedbackRequest() contract.FeedbackRequestRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeedbackRequest")
+	ret0, _ := ret[0].(contract.FeedbackRequestRepo)
+	return ret0
+}
+
+// FeedbackRequest indicates an expected call of FeedbackRequest.
+func (mr *MockDataManagerMockRecorder) FeedbackRequest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeedbackRequest", reflect.TypeOf((*MockDataManager)(nil).FeedbackRequest))
+}
+
+// Goal mocks base method.
+func (m *MockDataManager) Goal() contract.GoalRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Goal")
+	ret0, _ := ret[0].(contract.GoalRepo)
+	return ret0
+}
+
+// Goal indicates an expected call of Goal.
+func (mr *MockDataManagerMockRecorder) Goal() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Goal", reflect.TypeOf((*MockDataManager)(nil).Goal))
+}
+
+// Meeting mocks base method.
+func (m *MockDataManager) Meeting() contract.MeetingRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Meeting")
+	ret0, _ := ret[0].(contract.MeetingRepo)
+	return ret0
+}
+
+// Meeting indicates an expected call of Meeting.
+func (mr *MockDataManagerMockRecorder) Meeting() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Meeting", reflect.TypeOf((*MockDataManager)(nil).Meeting))
+}
+
 // Note mocks base method.
 func (m *MockDataManager) Note() contract.NoteRepo {
 	m.ctrl.T.Helper()
@@ -100,6 +184,34 @@ func (mr *MockDataManagerMockRecorder) Note() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Note", reflect.TypeOf((*MockDataManager)(nil).Note))
 }
 
+// NoteTemplate mocks base method.
+func (m *MockDataManager) NoteTemplate() contract.NoteTemplateRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NoteTemplate")
+	ret0, _ := ret[0].(contract.NoteTemplateRepo)
+	return ret0
+}
+
+// NoteTemplate indicates an expected call of NoteTemplate.
+func (mr *MockDataManagerMockRecorder) NoteTemplate() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NoteTemplate", reflect.TypeOf((*MockDataManager)(nil).NoteTemplate))
+}
+
+// Outbox mocks base method.
+func (m *MockDataManager) Outbox() contract.OutboxRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Outbox")
+	ret0, _ := ret[0].(contract.OutboxRepo)
+	return ret0
+}
+
+// Outbox indicates an expected call of Outbox.
+func (mr *MockDataManagerMockRecorder) Outbox() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Outbox", reflect.TypeOf((*MockDataManager)(nil).Outbox))
+}
+
 // Person mocks base method.
 func (m *MockDataManager) Person() contract.PersonRepo {
 	m.ctrl.T.Helper()
@@ -114,6 +226,48 @@ func (mr *MockDataManagerMockRecorder) Person() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Person", reflect.TypeOf((*MockDataManager)(nil).Person))
 }
 
+// Review mocks base method.
+func (m *MockDataManager) Review() contract.ReviewRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Review")
+	ret0, _ := ret[0].(contract.ReviewRepo)
+	return ret0
+}
+
+// Review indicates an expected call of Review.
+func (mr *MockDataManagerMockRecorder) Review() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Review", reflect.TypeOf((*MockDataManager)(nil).Review))
+}
+
+// SCIM mocks base method.
+func (m *MockDataManager) SCIM() contract.SCIMRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SCIM")
+	ret0, _ := ret[0].(contract.SCIMRepo)
+	return ret0
+}
+
+// SCIM indicates an expected call of SCIM.
+func (mr *MockDataManagerMockRecorder) SCIM() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SCIM", reflect.TypeOf((*MockDataManager)(nil).SCIM))
+}
+
+// Tag mocks base method.
+func (m *MockDataManager) Tag() contract.TagRepo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Tag")
+	ret0, _ := ret[0].(contract.TagRepo)
+	return ret0
+}
+
+// Tag indicates an expected call of Tag.
+func (mr *MockDataManagerMockRecorder) Tag() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tag", reflect.TypeOf((*MockDataManager)(nil).Tag))
+}
+
 // User mocks base method.
 func (m *MockDataManager) User() contract.UserRepo {
 	m.ctrl.T.Helper()
@@ -264,6 +418,20 @@ func (mr *MockUserRepoMockRecorder) CreateUserPreferences(ctx, preferences any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserPreferences", reflect.TypeOf((*MockUserRepo)(nil).CreateUserPreferences), ctx, preferences)
 }
 
+// DeleteCalendarFeedToken mocks base method.
+func (m *MockUserRepo) DeleteCalendarFeedToken(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCalendarFeedToken", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCalendarFeedToken indicates an expected call of DeleteCalendarFeedToken.
+func (mr *MockUserRepoMockRecorder) DeleteCalendarFeedToken(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCalendarFeedToken", reflect.TypeOf((*MockUserRepo)(nil).DeleteCalendarFeedToken), ctx, userID)
+}
+
 // GetUserByEmail mocks base method.
 func (m *MockUserRepo) GetUserByEmail(ctx context.Context, email string) (entity.User, error) {
 	m.ctrl.T.Helper()
@@ -294,6 +462,21 @@ func (mr *MockUserRepoMockRecorder) GetUserByUUID(ctx, userUUID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUUID", reflect.TypeOf((*MockUserRepo)(nil).GetUserByUUID), ctx, userUUID)
 }
 
+// GetUserIDByCalendarFeedToken mocks base method.
+func (m *MockUserRepo) GetUserIDByCalendarFeedToken(ctx context.Context, tokenHash string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserIDByCalendarFeedToken", ctx, tokenHash)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserIDByCalendarFeedToken indicates an expected call of GetUserIDByCalendarFeedToken.
+func (mr *MockUserRepoMockRecorder) GetUserIDByCalendarFeedToken(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDByCalendarFeedToken", reflect.TypeOf((*MockUserRepo)(nil).GetUserIDByCalendarFeedToken), ctx, tokenHash)
+}
+
 // GetUserIDByUUID mocks base method.
 func (m *MockUserRepo) GetUserIDByUUID(ctx context.Context, userUUID string) (int64, error) {
 	m.ctrl.T.Helper()
@@ -324,6 +507,20 @@ func (mr *MockUserRepoMockRecorder) GetUserPreferences(ctx, userID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPreferences", reflect.TypeOf((*MockUserRepo)(nil).GetUserPreferences), ctx, userID)
 }
 
+// SaveCalendarFeedToken mocks base method.
+func (m *MockUserRepo) SaveCalendarFeedToken(ctx context.Context, userID int64, tokenHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveCalendarFeedToken", ctx, userID, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveCalendarFeedToken indicates an expected call of SaveCalendarFeedToken.
+func (mr *MockUserRepoMockRecorder) SaveCalendarFeedToken(ctx, userID, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveCalendarFeedToken", reflect.TypeOf((*MockUserRepo)(nil).SaveCalendarFeedToken), ctx, userID, tokenHash)
+}
+
 // UpdateLastLogin mocks base method.
 func (m *MockUserRepo) UpdateLastLogin(ctx context.Context, userID int64) error {
 	m.ctrl.T.Helper()
@@ -419,6 +616,21 @@ func (mr *MockCompanyRepoMockRecorder) DeleteCompany(ctx, companyID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCompany", reflect.TypeOf((*MockCompanyRepo)(nil).DeleteCompany), ctx, companyID)
 }
 
+// GetCompaniesAfterID mocks base method.
+func (m *MockCompanyRepo) GetCompaniesAfterID(ctx context.Context, afterID int64, limit int) ([]entity.Company, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompaniesAfterID", ctx, afterID, limit)
+	ret0, _ := ret[0].([]entity.Company)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompaniesAfterID indicates an expected call of GetCompaniesAfterID.
+func (mr *MockCompanyRepoMockRecorder) GetCompaniesAfterID(ctx, afterID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompaniesAfterID", reflect.TypeOf((*MockCompanyRepo)(nil).GetCompaniesAfterID), ctx, afterID, limit)
+}
+
 // GetCompaniesByUser mocks base method.
 func (m *MockCompanyRepo) GetCompaniesByUser(ctx context.Context, userID int64) ([]entity.Company, error) {
 	m.ctrl.T.Helper()
@@ -502,8 +714,22 @@ func (m *MockPersonRepo) EXPECT() *MockPersonRepoMockRecorder {
 	return m.recorder
 }
 
+// AddPersonAttributeHistory mocks base method.
+func (m *MockPersonRepo) AddPersonAttributeHistory(ctx context.Context, attr entity.PersonAttribute, changedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPersonAttributeHistory", ctx, attr, changedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPersonAttributeHistory indicates an expected call of AddPersonAttributeHistory.
+func (mr *MockPersonRepoMockRecorder) AddPersonAttributeHistory(ctx, attr, changedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPersonAttributeHistory", reflect.TypeOf((*MockPersonRepo)(nil).AddPersonAttributeHistory), ctx, attr, changedAt)
+}
+
 // BulkUpsertPersonAttributes mocks base method.
-func (m *MockPersonRepo) BulkUpsertPersonAttributes(ctx context.Context, personID int64, attributes map[string]string, source string, sourceNoteID *int64) error {
+func (m *MockPersonRepo) BulkUpsertPersonAttributes(ctx context.Context, personID int64, attributes map[string]entity.ExtractedAttribute, source string, sourceNoteID *int64) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "BulkUpsertPersonAttributes", ctx, personID, attributes, source, sourceNoteID)
 	ret0, _ := ret[0].(error)
@@ -516,6 +742,20 @@ func (mr *MockPersonRepoMockRecorder) BulkUpsertPersonAttributes(ctx, personID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpsertPersonAttributes", reflect.TypeOf((*MockPersonRepo)(nil).BulkUpsertPersonAttributes), ctx, personID, attributes, source, sourceNoteID)
 }
 
+// ClosePersonAttributeHistory mocks base method.
+func (m *MockPersonRepo) ClosePersonAttributeHistory(ctx context.Context, personID int64, attributeKey string, closedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClosePersonAttributeHistory", ctx, personID, attributeKey, closedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClosePersonAttributeHistory indicates an expected call of ClosePersonAttributeHistory.
+func (mr *MockPersonRepoMockRecorder) ClosePersonAttributeHistory(ctx, personID, attributeKey, closedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosePersonAttributeHistory", reflect.TypeOf((*MockPersonRepo)(nil).ClosePersonAttributeHistory), ctx, personID, attributeKey, closedAt)
+}
+
 // CreatePerson mocks base method.
 func (m *MockPersonRepo) CreatePerson(ctx context.Context, person entity.Person) (int64, error) {
 	m.ctrl.T.Helper()
@@ -531,6 +771,21 @@ func (mr *MockPersonRepoMockRecorder) CreatePerson(ctx, person any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePerson", reflect.TypeOf((*MockPersonRepo)(nil).CreatePerson), ctx, person)
 }
 
+// CreatePersonAddress mocks base method.
+func (m *MockPersonRepo) CreatePersonAddress(ctx context.Context, address entity.Address) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonAddress", ctx, address)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePersonAddress indicates an expected call of CreatePersonAddress.
+func (mr *MockPersonRepoMockRecorder) CreatePersonAddress(ctx, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonAddress", reflect.TypeOf((*MockPersonRepo)(nil).CreatePersonAddress), ctx, address)
+}
+
 // CreatePersonAttribute mocks base method.
 func (m *MockPersonRepo) CreatePersonAttribute(ctx context.Context, attr entity.PersonAttribute) (entity.PersonAttribute, error) {
 	m.ctrl.T.Helper()
@@ -546,6 +801,21 @@ func (mr *MockPersonRepoMockRecorder) CreatePersonAttribute(ctx, attr any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonAttribute", reflect.TypeOf((*MockPersonRepo)(nil).CreatePersonAttribute), ctx, attr)
 }
 
+// CreatePersonImport mocks base method.
+func (m *MockPersonRepo) CreatePersonImport(ctx context.Context, personImport entity.PersonImport) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonImport", ctx, personImport)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePersonImport indicates an expected call of CreatePersonImport.
+func (mr *MockPersonRepoMockRecorder) CreatePersonImport(ctx, personImport any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonImport", reflect.TypeOf((*MockPersonRepo)(nil).CreatePersonImport), ctx, personImport)
+}
+
 // DeletePerson mocks base method.
 func (m *MockPersonRepo) DeletePerson(ctx context.Context, personID int64) error {
 	m.ctrl.T.Helper()
@@ -560,6 +830,35 @@ func (mr *MockPersonRepoMockRecorder) DeletePerson(ctx, personID any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePerson", reflect.TypeOf((*MockPersonRepo)(nil).DeletePerson), ctx, personID)
 }
 
+// DeletePersonAttribute mocks base method.
+func (m *MockPersonRepo) DeletePersonAttribute(ctx context.Context, personID int64, attributeKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePersonAttribute", ctx, personID, attributeKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePersonAttribute indicates an expected call of DeletePersonAttribute.
+func (mr *MockPersonRepoMockRecorder) DeletePersonAttribute(ctx, personID, attributeKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePersonAttribute", reflect.TypeOf((*MockPersonRepo)(nil).DeletePersonAttribute), ctx, personID, attributeKey)
+}
+
+// GetAttributesByCompany mocks base method.
+func (m *MockPersonRepo) GetAttributesByCompany(ctx context.Context, companyID int64) ([]entity.PersonAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttributesByCompany", ctx, companyID)
+	ret0, _ := ret[0].([]entity.PersonAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttributesByCompany indicates an expected call of GetAttributesByCompany.
+func (mr *MockPersonRepoMockRecorder) GetAttributesByCompany(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttributesByCompany", reflect.TypeOf((*MockPersonRepo)(nil).GetAttributesByCompany), ctx, companyID)
+}
+
 // GetPeopleCountByCompany mocks base method.
 func (m *MockPersonRepo) GetPeopleCountByCompany(ctx context.Context, companyID int64) (int64, error) {
 	m.ctrl.T.Helper()
@@ -575,6 +874,81 @@ func (mr *MockPersonRepoMockRecorder) GetPeopleCountByCompany(ctx, companyID any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeopleCountByCompany", reflect.TypeOf((*MockPersonRepo)(nil).GetPeopleCountByCompany), ctx, companyID)
 }
 
+// GetPersonAttribute mocks base method.
+func (m *MockPersonRepo) GetPersonAttribute(ctx context.Context, personID int64, attributeKey string) (entity.PersonAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonAttribute", ctx, personID, attributeKey)
+	ret0, _ := ret[0].(entity.PersonAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonAttribute indicates an expected call of GetPersonAttribute.
+func (mr *MockPersonRepoMockRecorder) GetPersonAttribute(ctx, personID, attributeKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonAttribute", reflect.TypeOf((*MockPersonRepo)(nil).GetPersonAttribute), ctx, personID, attributeKey)
+}
+
+// GetPersonAttributeChanges mocks base method.
+func (m *MockPersonRepo) GetPersonAttributeChanges(ctx context.Context, personID int64, since time.Time, limit int64) ([]entity.PersonAttributeHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonAttributeChanges", ctx, personID, since, limit)
+	ret0, _ := ret[0].([]entity.PersonAttributeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonAttributeChanges indicates an expected call of GetPersonAttributeChanges.
+func (mr *MockPersonRepoMockRecorder) GetPersonAttributeChanges(ctx, personID, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonAttributeChanges", reflect.TypeOf((*MockPersonRepo)(nil).GetPersonAttributeChanges), ctx, personID, since, limit)
+}
+
+// GetPersonAttributeHistory mocks base method.
+func (m *MockPersonRepo) GetPersonAttributeHistory(ctx context.Context, personID int64, attributeKey string) ([]entity.PersonAttributeHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonAttributeHistory", ctx, personID, attributeKey)
+	ret0, _ := ret[0].([]entity.PersonAttributeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonAttributeHistory indicates an expected call of GetPersonAttributeHistory.
+func (mr *MockPersonRepoMockRecorder) GetPersonAttributeHistory(ctx, personID, attributeKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonAttributeHistory", reflect.TypeOf((*MockPersonRepo)(nil).GetPersonAttributeHistory), ctx, personID, attributeKey)
+}
+
+// GetPersonAttributes mocks base method.
+func (m *MockPersonRepo) GetPersonAttributes(ctx context.Context, personID int64) ([]entity.PersonAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonAttributes", ctx, personID)
+	ret0, _ := ret[0].([]entity.PersonAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonAttributes indicates an expected call of GetPersonAttributes.
+func (mr *MockPersonRepoMockRecorder) GetPersonAttributes(ctx, personID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonAttributes", reflect.TypeOf((*MockPersonRepo)(nil).GetPersonAttributes), ctx, personID)
+}
+
+// GetPersonAttributesAsOf mocks base method.
+func (m *MockPersonRepo) GetPersonAttributesAsOf(ctx context.Context, personID int64, asOf time.Time) ([]entity.PersonAttributeHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonAttributesAsOf", ctx, personID, asOf)
+	ret0, _ := ret[0].([]entity.PersonAttributeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonAttributesAsOf indicates an expected call of GetPersonAttributesAsOf.
+func (mr *MockPersonRepoMockRecorder) GetPersonAttributesAsOf(ctx, personID, asOf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonAttributesAsOf", reflect.TypeOf((*MockPersonRepo)(nil).GetPersonAttributesAsOf), ctx, personID, asOf)
+}
+
 // GetPersonAttributesMap mocks base method.
 func (m *MockPersonRepo) GetPersonAttributesMap(ctx context.Context, personID int64) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -620,6 +994,21 @@ func (mr *MockPersonRepoMockRecorder) GetPersonByUUID(ctx, personUUID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonByUUID", reflect.TypeOf((*MockPersonRepo)(nil).GetPersonByUUID), ctx, personUUID)
 }
 
+// GetPersonImportByUUID mocks base method.
+func (m *MockPersonRepo) GetPersonImportByUUID(ctx context.Context, importUUID string) (entity.PersonImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonImportByUUID", ctx, importUUID)
+	ret0, _ := ret[0].(entity.PersonImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonImportByUUID indicates an expected call of GetPersonImportByUUID.
+func (mr *MockPersonRepoMockRecorder) GetPersonImportByUUID(ctx, importUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonImportByUUID", reflect.TypeOf((*MockPersonRepo)(nil).GetPersonImportByUUID), ctx, importUUID)
+}
+
 // GetPersonsByCompany mocks base method.
 func (m *MockPersonRepo) GetPersonsByCompany(ctx context.Context, companyID int64) ([]entity.Person, error) {
 	m.ctrl.T.Helper()
@@ -635,6 +1024,20 @@ func (mr *MockPersonRepoMockRecorder) GetPersonsByCompany(ctx, companyID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonsByCompany", reflect.TypeOf((*MockPersonRepo)(nil).GetPersonsByCompany), ctx, companyID)
 }
 
+// ReactivatePerson mocks base method.
+func (m *MockPersonRepo) ReactivatePerson(ctx context.Context, personID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReactivatePerson", ctx, personID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReactivatePerson indicates an expected call of ReactivatePerson.
+func (mr *MockPersonRepoMockRecorder) ReactivatePerson(ctx, personID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReactivatePerson", reflect.TypeOf((*MockPersonRepo)(nil).ReactivatePerson), ctx, personID)
+}
+
 // SearchPeople mocks base method.
 func (m *MockPersonRepo) SearchPeople(ctx context.Context, companyID int64, search string) ([]entity.Person, error) {
 	m.ctrl.T.Helper()
@@ -664,6 +1067,62 @@ func (mr *MockPersonRepoMockRecorder) UpdatePerson(ctx, personID, person any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePerson", reflect.TypeOf((*MockPersonRepo)(nil).UpdatePerson), ctx, personID, person)
 }
 
+// UpdatePersonCadence mocks base method.
+func (m *MockPersonRepo) UpdatePersonCadence(ctx context.Context, personID int64, cadence *string, cadenceDays *int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePersonCadence", ctx, personID, cadence, cadenceDays)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePersonCadence indicates an expected call of UpdatePersonCadence.
+func (mr *MockPersonRepoMockRecorder) UpdatePersonCadence(ctx, personID, cadence, cadenceDays any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePersonCadence", reflect.TypeOf((*MockPersonRepo)(nil).UpdatePersonCadence), ctx, personID, cadence, cadenceDays)
+}
+
+// UpdatePersonImportResult mocks base method.
+func (m *MockPersonRepo) UpdatePersonImportResult(ctx context.Context, personImport entity.PersonImport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePersonImportResult", ctx, personImport)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePersonImportResult indicates an expected call of UpdatePersonImportResult.
+func (mr *MockPersonRepoMockRecorder) UpdatePersonImportResult(ctx, personImport any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePersonImportResult", reflect.TypeOf((*MockPersonRepo)(nil).UpdatePersonImportResult), ctx, personImport)
+}
+
+// UpdatePersonManager mocks base method.
+func (m *MockPersonRepo) UpdatePersonManager(ctx context.Context, personID int64, managerID *int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePersonManager", ctx, personID, managerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePersonManager indicates an expected call of UpdatePersonManager.
+func (mr *MockPersonRepoMockRecorder) UpdatePersonManager(ctx, personID, managerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePersonManager", reflect.TypeOf((*MockPersonRepo)(nil).UpdatePersonManager), ctx, personID, managerID)
+}
+
+// UpsertPersonAttribute mocks base method.
+func (m *MockPersonRepo) UpsertPersonAttribute(ctx context.Context, attr entity.PersonAttribute) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertPersonAttribute", ctx, attr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertPersonAttribute indicates an expected call of UpsertPersonAttribute.
+func (mr *MockPersonRepoMockRecorder) UpsertPersonAttribute(ctx, attr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertPersonAttribute", reflect.TypeOf((*MockPersonRepo)(nil).UpsertPersonAttribute), ctx, attr)
+}
+
 // MockNoteRepo is a mock of NoteRepo interface.
 type MockNoteRepo struct {
 	ctrl     *gomock.Controller
@@ -688,19 +1147,49 @@ func (m *MockNoteRepo) EXPECT() *MockNoteRepoMockRecorder {
 	return m.recorder
 }
 
-// CreateNote mocks base method.
-func (m *MockNoteRepo) CreateNote(ctx context.Context, note entity.Note) (int64, error) {
+// CountNoteAttachments mocks base method.
+func (m *MockNoteRepo) CountNoteAttachments(ctx context.Context, noteID int64) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateNote", ctx, note)
+	ret := m.ctrl.Call(m, "CountNoteAttachments", ctx, noteID)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateNote indicates an expected call of CreateNote.
-func (mr *MockNoteRepoMockRecorder) CreateNote(ctx, note any) *gomock.Call {
+// CountNoteAttachments indicates an expected call of CountNoteAttachments.
+func (mr *MockNoteRepoMockRecorder) CountNoteAttachments(ctx, noteID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNote", reflect.TypeOf((*MockNoteRepo)(nil).CreateNote), ctx, note)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountNoteAttachments", reflect.TypeOf((*MockNoteRepo)(nil).CountNoteAttachments), ctx, noteID)
+}
+
+// CreateNote mocks base method.
+func (m *MockNoteRepo) CreateNote(ctx context.Context, note entity.Note) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNote", ctx, note)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNote indicates an expected call of CreateNote.
+func (mr *MockNoteRepoMockRecorder) CreateNote(ctx, note any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNote", reflect.TypeOf((*MockNoteRepo)(nil).CreateNote), ctx, note)
+}
+
+// CreateNoteAttachment mocks base method.
+func (m *MockNoteRepo) CreateNoteAttachment(ctx context.Context, attachment entity.NoteAttachment) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNoteAttachment", ctx, attachment)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNoteAttachment indicates an expected call of CreateNoteAttachment.
+func (mr *MockNoteRepoMockRecorder) CreateNoteAttachment(ctx, attachment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNoteAttachment", reflect.TypeOf((*MockNoteRepo)(nil).CreateNoteAttachment), ctx, attachment)
 }
 
 // CreateNoteMention mocks base method.
@@ -718,6 +1207,21 @@ func (mr *MockNoteRepoMockRecorder) CreateNoteMention(ctx, mention any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNoteMention", reflect.TypeOf((*MockNoteRepo)(nil).CreateNoteMention), ctx, mention)
 }
 
+// CreateNoteRevision mocks base method.
+func (m *MockNoteRepo) CreateNoteRevision(ctx context.Context, revision entity.NoteRevision) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNoteRevision", ctx, revision)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNoteRevision indicates an expected call of CreateNoteRevision.
+func (mr *MockNoteRepoMockRecorder) CreateNoteRevision(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNoteRevision", reflect.TypeOf((*MockNoteRepo)(nil).CreateNoteRevision), ctx, revision)
+}
+
 // DeleteMentionsByNote mocks base method.
 func (m *MockNoteRepo) DeleteMentionsByNote(ctx context.Context, noteID int64) error {
 	m.ctrl.T.Helper()
@@ -746,34 +1250,48 @@ func (mr *MockNoteRepoMockRecorder) DeleteNote(ctx, noteID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNote", reflect.TypeOf((*MockNoteRepo)(nil).DeleteNote), ctx, noteID)
 }
 
-// GetAverageFrequencyDays mocks base method.
-func (m *MockNoteRepo) GetAverageFrequencyDays(ctx context.Context, companyID int64) (float64, error) {
+// DeleteNoteAttachment mocks base method.
+func (m *MockNoteRepo) DeleteNoteAttachment(ctx context.Context, attachmentID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAverageFrequencyDays", ctx, companyID)
-	ret0, _ := ret[0].(float64)
+	ret := m.ctrl.Call(m, "DeleteNoteAttachment", ctx, attachmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNoteAttachment indicates an expected call of DeleteNoteAttachment.
+func (mr *MockNoteRepoMockRecorder) DeleteNoteAttachment(ctx, attachmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNoteAttachment", reflect.TypeOf((*MockNoteRepo)(nil).DeleteNoteAttachment), ctx, attachmentID)
+}
+
+// GetDeletedNoteByUUID mocks base method.
+func (m *MockNoteRepo) GetDeletedNoteByUUID(ctx context.Context, noteUUID string) (entity.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedNoteByUUID", ctx, noteUUID)
+	ret0, _ := ret[0].(entity.Note)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAverageFrequencyDays indicates an expected call of GetAverageFrequencyDays.
-func (mr *MockNoteRepoMockRecorder) GetAverageFrequencyDays(ctx, companyID any) *gomock.Call {
+// GetDeletedNoteByUUID indicates an expected call of GetDeletedNoteByUUID.
+func (mr *MockNoteRepoMockRecorder) GetDeletedNoteByUUID(ctx, noteUUID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAverageFrequencyDays", reflect.TypeOf((*MockNoteRepo)(nil).GetAverageFrequencyDays), ctx, companyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedNoteByUUID", reflect.TypeOf((*MockNoteRepo)(nil).GetDeletedNoteByUUID), ctx, noteUUID)
 }
 
-// GetLastMeetingDate mocks base method.
-func (m *MockNoteRepo) GetLastMeetingDate(ctx context.Context, companyID int64) (*time.Time, error) {
+// GetExpiredNoteAttachments mocks base method.
+func (m *MockNoteRepo) GetExpiredNoteAttachments(ctx context.Context, noteDeletedBefore time.Time, limit int) ([]entity.NoteAttachment, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLastMeetingDate", ctx, companyID)
-	ret0, _ := ret[0].(*time.Time)
+	ret := m.ctrl.Call(m, "GetExpiredNoteAttachments", ctx, noteDeletedBefore, limit)
+	ret0, _ := ret[0].([]entity.NoteAttachment)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLastMeetingDate indicates an expected call of GetLastMeetingDate.
-func (mr *MockNoteRepoMockRecorder) GetLastMeetingDate(ctx, companyID any) *gomock.Call {
+// GetExpiredNoteAttachments indicates an expected call of GetExpiredNoteAttachments.
+func (mr *MockNoteRepoMockRecorder) GetExpiredNoteAttachments(ctx, noteDeletedBefore, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastMeetingDate", reflect.TypeOf((*MockNoteRepo)(nil).GetLastMeetingDate), ctx, companyID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredNoteAttachments", reflect.TypeOf((*MockNoteRepo)(nil).GetExpiredNoteAttachments), ctx, noteDeletedBefore, limit)
 }
 
 // GetMentionsByPerson mocks base method.
@@ -792,6 +1310,36 @@ func (mr *MockNoteRepoMockRecorder) GetMentionsByPerson(ctx, mentionedPersonID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMentionsByPerson", reflect.TypeOf((*MockNoteRepo)(nil).GetMentionsByPerson), ctx, mentionedPersonID, take, skip)
 }
 
+// GetNoteAttachmentByUUID mocks base method.
+func (m *MockNoteRepo) GetNoteAttachmentByUUID(ctx context.Context, attachmentUUID string) (entity.NoteAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoteAttachmentByUUID", ctx, attachmentUUID)
+	ret0, _ := ret[0].(entity.NoteAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoteAttachmentByUUID indicates an expected call of GetNoteAttachmentByUUID.
+func (mr *MockNoteRepoMockRecorder) GetNoteAttachmentByUUID(ctx, attachmentUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteAttachmentByUUID", reflect.TypeOf((*MockNoteRepo)(nil).GetNoteAttachmentByUUID), ctx, attachmentUUID)
+}
+
+// GetNoteAttachments mocks base method.
+func (m *MockNoteRepo) GetNoteAttachments(ctx context.Context, noteID int64) ([]entity.NoteAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoteAttachments", ctx, noteID)
+	ret0, _ := ret[0].([]entity.NoteAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoteAttachments indicates an expected call of GetNoteAttachments.
+func (mr *MockNoteRepoMockRecorder) GetNoteAttachments(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteAttachments", reflect.TypeOf((*MockNoteRepo)(nil).GetNoteAttachments), ctx, noteID)
+}
+
 // GetNoteByID mocks base method.
 func (m *MockNoteRepo) GetNoteByID(ctx context.Context, noteID int64) (entity.Note, error) {
 	m.ctrl.T.Helper()
@@ -822,6 +1370,36 @@ func (mr *MockNoteRepoMockRecorder) GetNoteByUUID(ctx, noteUUID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteByUUID", reflect.TypeOf((*MockNoteRepo)(nil).GetNoteByUUID), ctx, noteUUID)
 }
 
+// GetNoteRevisionByNumber mocks base method.
+func (m *MockNoteRepo) GetNoteRevisionByNumber(ctx context.Context, noteID int64, number int) (entity.NoteRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoteRevisionByNumber", ctx, noteID, number)
+	ret0, _ := ret[0].(entity.NoteRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoteRevisionByNumber indicates an expected call of GetNoteRevisionByNumber.
+func (mr *MockNoteRepoMockRecorder) GetNoteRevisionByNumber(ctx, noteID, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteRevisionByNumber", reflect.TypeOf((*MockNoteRepo)(nil).GetNoteRevisionByNumber), ctx, noteID, number)
+}
+
+// GetNoteRevisions mocks base method.
+func (m *MockNoteRepo) GetNoteRevisions(ctx context.Context, noteID int64) ([]entity.NoteRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoteRevisions", ctx, noteID)
+	ret0, _ := ret[0].([]entity.NoteRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoteRevisions indicates an expected call of GetNoteRevisions.
+func (mr *MockNoteRepoMockRecorder) GetNoteRevisions(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteRevisions", reflect.TypeOf((*MockNoteRepo)(nil).GetNoteRevisions), ctx, noteID)
+}
+
 // GetNotesByPerson mocks base method.
 func (m *MockNoteRepo) GetNotesByPerson(ctx context.Context, personID, take, skip int64) ([]entity.Note, int64, error) {
 	m.ctrl.T.Helper()
@@ -853,21 +1431,6 @@ func (mr *MockNoteRepoMockRecorder) GetNotesByPersonIDPaginated(ctx, personID, p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotesByPersonIDPaginated", reflect.TypeOf((*MockNoteRepo)(nil).GetNotesByPersonIDPaginated), ctx, personID, page, quantity)
 }
 
-// GetOneOnOnesCountThisMonth mocks base method.
-func (m *MockNoteRepo) GetOneOnOnesCountThisMonth(ctx context.Context, companyID int64) (int64, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOneOnOnesCountThisMonth", ctx, companyID)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetOneOnOnesCountThisMonth indicates an expected call of GetOneOnOnesCountThisMonth.
-func (mr *MockNoteRepoMockRecorder) GetOneOnOnesCountThisMonth(ctx, companyID any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneOnOnesCountThisMonth", reflect.TypeOf((*MockNoteRepo)(nil).GetOneOnOnesCountThisMonth), ctx, companyID)
-}
-
 // GetPersonMentions mocks base method.
 func (m *MockNoteRepo) GetPersonMentions(ctx context.Context, mentionedPersonID, take, skip int64) ([]entity.MentionEntry, int64, error) {
 	m.ctrl.T.Helper()
@@ -884,6 +1447,22 @@ func (mr *MockNoteRepoMockRecorder) GetPersonMentions(ctx, mentionedPersonID, ta
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonMentions", reflect.TypeOf((*MockNoteRepo)(nil).GetPersonMentions), ctx, mentionedPersonID, take, skip)
 }
 
+// GetPersonMentionsPage mocks base method.
+func (m *MockNoteRepo) GetPersonMentionsPage(ctx context.Context, mentionedPersonID int64, page entity.CursorPage) ([]entity.MentionEntry, entity.CursorPageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonMentionsPage", ctx, mentionedPersonID, page)
+	ret0, _ := ret[0].([]entity.MentionEntry)
+	ret1, _ := ret[1].(entity.CursorPageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPersonMentionsPage indicates an expected call of GetPersonMentionsPage.
+func (mr *MockNoteRepoMockRecorder) GetPersonMentionsPage(ctx, mentionedPersonID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonMentionsPage", reflect.TypeOf((*MockNoteRepo)(nil).GetPersonMentionsPage), ctx, mentionedPersonID, page)
+}
+
 // GetPersonTimeline mocks base method.
 func (m *MockNoteRepo) GetPersonTimeline(ctx context.Context, personID int64, filters entity.TimelineFilters, take, skip int64) ([]entity.UnifiedTimelineEntry, int64, error) {
 	m.ctrl.T.Helper()
@@ -900,6 +1479,50 @@ func (mr *MockNoteRepoMockRecorder) GetPersonTimeline(ctx, personID, filters, ta
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonTimeline", reflect.TypeOf((*MockNoteRepo)(nil).GetPersonTimeline), ctx, personID, filters, take, skip)
 }
 
+// GetPersonTimelinePage mocks base method.
+func (m *MockNoteRepo) GetPersonTimelinePage(ctx context.Context, personID int64, filters entity.TimelineFilters, page entity.CursorPage) ([]entity.UnifiedTimelineEntry, entity.CursorPageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonTimelinePage", ctx, personID, filters, page)
+	ret0, _ := ret[0].([]entity.UnifiedTimelineEntry)
+	ret1, _ := ret[1].(entity.CursorPageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPersonTimelinePage indicates an expected call of GetPersonTimelinePage.
+func (mr *MockNoteRepoMockRecorder) GetPersonTimelinePage(ctx, personID, filters, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonTimelinePage", reflect.TypeOf((*MockNoteRepo)(nil).GetPersonTimelinePage), ctx, personID, filters, page)
+}
+
+// PurgeNoteAttachment mocks base method.
+func (m *MockNoteRepo) PurgeNoteAttachment(ctx context.Context, attachmentID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeNoteAttachment", ctx, attachmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeNoteAttachment indicates an expected call of PurgeNoteAttachment.
+func (mr *MockNoteRepoMockRecorder) PurgeNoteAttachment(ctx, attachmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeNoteAttachment", reflect.TypeOf((*MockNoteRepo)(nil).PurgeNoteAttachment), ctx, attachmentID)
+}
+
+// UndeleteNote mocks base method.
+func (m *MockNoteRepo) UndeleteNote(ctx context.Context, noteID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndeleteNote", ctx, noteID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UndeleteNote indicates an expected call of UndeleteNote.
+func (mr *MockNoteRepoMockRecorder) UndeleteNote(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndeleteNote", reflect.TypeOf((*MockNoteRepo)(nil).UndeleteNote), ctx, noteID)
+}
+
 // UpdateNote mocks base method.
 func (m *MockNoteRepo) UpdateNote(ctx context.Context, noteID int64, note entity.Note) error {
 	m.ctrl.T.Helper()
@@ -914,58 +1537,2495 @@ func (mr *MockNoteRepoMockRecorder) UpdateNote(ctx, noteID, note any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNote", reflect.TypeOf((*MockNoteRepo)(nil).UpdateNote), ctx, noteID, note)
 }
 
-// MockAIRepo is a mock of AIRepo interface.
-type MockAIRepo struct {
+// MockMeetingRepo is a mock of MeetingRepo interface.
+type MockMeetingRepo struct {
 	ctrl     *gomock.Controller
-	recorder *MockAIRepoMockRecorder
+	recorder *MockMeetingRepoMockRecorder
 	isgomock struct{}
 }
 
-// MockAIRepoMockRecorder is the mock recorder for MockAIRepo.
-type MockAIRepoMockRecorder struct {
-	mock *MockAIRepo
+// MockMeetingRepoMockRecorder is the mock recorder for MockMeetingRepo.
+type MockMeetingRepoMockRecorder struct {
+	mock *MockMeetingRepo
 }
 
-// NewMockAIRepo creates a new mock instance.
-func NewMockAIRepo(ctrl *gomock.Controller) *MockAIRepo {
-	mock := &MockAIRepo{ctrl: ctrl}
-	mock.recorder = &MockAIRepoMockRecorder{mock}
+// NewMockMeetingRepo creates a new mock instance.
+func NewMockMeetingRepo(ctrl *gomock.Controller) *MockMeetingRepo {
+	mock := &MockMeetingRepo{ctrl: ctrl}
+	mock.recorder = &MockMeetingRepoMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAIRepo) EXPECT() *MockAIRepoMockRecorder {
+func (m *MockMeetingRepo) EXPECT() *MockMeetingRepoMockRecorder {
 	return m.recorder
 }
 
-// CreateConversation mocks base method.
-func (m *MockAIRepo) CreateConversation(ctx context.Context, conversation entity.AIConversation) (entity.AIConversation, error) {
+// CreateAgendaItem mocks base method.
+func (m *MockMeetingRepo) CreateAgendaItem(ctx context.Context, item entity.MeetingAgendaItem) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateConversation", ctx, conversation)
-	ret0, _ := ret[0].(entity.AIConversation)
+	ret := m.ctrl.Call(m, "CreateAgendaItem", ctx, item)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateConversation indicates an expected call of CreateConversation.
-func (mr *MockAIRepoMockRecorder) CreateConversation(ctx, conversation any) *gomock.Call {
+// CreateAgendaItem indicates an expected call of CreateAgendaItem.
+func (mr *MockMeetingRepoMockRecorder) CreateAgendaItem(ctx, item any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConversation", reflect.TypeOf((*MockAIRepo)(nil).CreateConversation), ctx, conversation)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAgendaItem", reflect.TypeOf((*MockMeetingRepo)(nil).CreateAgendaItem), ctx, item)
 }
 
-// CreateUsage mocks base method.
-func (m *MockAIRepo) CreateUsage(ctx context.Context, usage entity.AIUsageTracker) (entity.AIUsageTracker, error) {
+// CreateMeeting mocks base method.
+func (m *MockMeetingRepo) CreateMeeting(ctx context.Context, meeting entity.Meeting) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUsage", ctx, usage)
-	ret0, _ := ret[0].(entity.AIUsageTracker)
+	ret := m.ctrl.Call(m, "CreateMeeting", ctx, meeting)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreateUsage indicates an expected call of CreateUsage.
-func (mr *MockAIRepoMockRecorder) CreateUsage(ctx, usage any) *gomock.Call {
+// CreateMeeting indicates an expected call of CreateMeeting.
+func (mr *MockMeetingRepoMockRecorder) CreateMeeting(ctx, meeting any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUsage", reflect.TypeOf((*MockAIRepo)(nil).CreateUsage), ctx, usage)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMeeting", reflect.TypeOf((*MockMeetingRepo)(nil).CreateMeeting), ctx, meeting)
+}
+
+// DeleteAgendaItem mocks base method.
+func (m *MockMeetingRepo) DeleteAgendaItem(ctx context.Context, itemID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAgendaItem", ctx, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAgendaItem indicates an expected call of DeleteAgendaItem.
+func (mr *MockMeetingRepoMockRecorder) DeleteAgendaItem(ctx, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAgendaItem", reflect.TypeOf((*MockMeetingRepo)(nil).DeleteAgendaItem), ctx, itemID)
+}
+
+// DeleteMeetingsFromNote mocks base method.
+func (m *MockMeetingRepo) DeleteMeetingsFromNote(ctx context.Context, noteID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMeetingsFromNote", ctx, noteID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMeetingsFromNote indicates an expected call of DeleteMeetingsFromNote.
+func (mr *MockMeetingRepoMockRecorder) DeleteMeetingsFromNote(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMeetingsFromNote", reflect.TypeOf((*MockMeetingRepo)(nil).DeleteMeetingsFromNote), ctx, noteID)
+}
+
+// GetAgendaItemByUUID mocks base method.
+func (m *MockMeetingRepo) GetAgendaItemByUUID(ctx context.Context, meetingID int64, itemUUID string) (entity.MeetingAgendaItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgendaItemByUUID", ctx, meetingID, itemUUID)
+	ret0, _ := ret[0].(entity.MeetingAgendaItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAgendaItemByUUID indicates an expected call of GetAgendaItemByUUID.
+func (mr *MockMeetingRepoMockRecorder) GetAgendaItemByUUID(ctx, meetingID, itemUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgendaItemByUUID", reflect.TypeOf((*MockMeetingRepo)(nil).GetAgendaItemByUUID), ctx, meetingID, itemUUID)
+}
+
+// GetAgendaItemsByMeeting mocks base method.
+func (m *MockMeetingRepo) GetAgendaItemsByMeeting(ctx context.Context, meetingID int64) ([]entity.MeetingAgendaItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgendaItemsByMeeting", ctx, meetingID)
+	ret0, _ := ret[0].([]entity.MeetingAgendaItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAgendaItemsByMeeting indicates an expected call of GetAgendaItemsByMeeting.
+func (mr *MockMeetingRepoMockRecorder) GetAgendaItemsByMeeting(ctx, meetingID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgendaItemsByMeeting", reflect.TypeOf((*MockMeetingRepo)(nil).GetAgendaItemsByMeeting), ctx, meetingID)
+}
+
+// GetAverageFrequencyDays mocks base method.
+func (m *MockMeetingRepo) GetAverageFrequencyDays(ctx context.Context, companyID int64) (float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAverageFrequencyDays", ctx, companyID)
+	ret0, _ := ret[0].(float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAverageFrequencyDays indicates an expected call of GetAverageFrequencyDays.
+func (mr *MockMeetingRepoMockRecorder) GetAverageFrequencyDays(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAverageFrequencyDays", reflect.TypeOf((*MockMeetingRepo)(nil).GetAverageFrequencyDays), ctx, companyID)
+}
+
+// GetLastMeetingDate mocks base method.
+func (m *MockMeetingRepo) GetLastMeetingDate(ctx context.Context, companyID int64) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastMeetingDate", ctx, companyID)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastMeetingDate indicates an expected call of GetLastMeetingDate.
+func (mr *MockMeetingRepoMockRecorder) GetLastMeetingDate(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastMeetingDate", reflect.TypeOf((*MockMeetingRepo)(nil).GetLastMeetingDate), ctx, companyID)
+}
+
+// GetMeetingByUUID mocks base method.
+func (m *MockMeetingRepo) GetMeetingByUUID(ctx context.Context, meetingUUID string) (entity.Meeting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMeetingByUUID", ctx, meetingUUID)
+	ret0, _ := ret[0].(entity.Meeting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMeetingByUUID indicates an expected call of GetMeetingByUUID.
+func (mr *MockMeetingRepoMockRecorder) GetMeetingByUUID(ctx, meetingUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMeetingByUUID", reflect.TypeOf((*MockMeetingRepo)(nil).GetMeetingByUUID), ctx, meetingUUID)
+}
+
+// GetMeetingsByICalUID mocks base method.
+func (m *MockMeetingRepo) GetMeetingsByICalUID(ctx context.Context, userID int64, icalUID string) ([]entity.Meeting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMeetingsByICalUID", ctx, userID, icalUID)
+	ret0, _ := ret[0].([]entity.Meeting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMeetingsByICalUID indicates an expected call of GetMeetingsByICalUID.
+func (mr *MockMeetingRepoMockRecorder) GetMeetingsByICalUID(ctx, userID, icalUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMeetingsByICalUID", reflect.TypeOf((*MockMeetingRepo)(nil).GetMeetingsByICalUID), ctx, userID, icalUID)
+}
+
+// GetMeetingsByPerson mocks base method.
+func (m *MockMeetingRepo) GetMeetingsByPerson(ctx context.Context, personID int64, status string, take, skip int64) ([]entity.Meeting, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMeetingsByPerson", ctx, personID, status, take, skip)
+	ret0, _ := ret[0].([]entity.Meeting)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetMeetingsByPerson indicates an expected call of GetMeetingsByPerson.
+func (mr *MockMeetingRepoMockRecorder) GetMeetingsByPerson(ctx, personID, status, take, skip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMeetingsByPerson", reflect.TypeOf((*MockMeetingRepo)(nil).GetMeetingsByPerson), ctx, personID, status, take, skip)
+}
+
+// GetNextScheduledDatesByCompany mocks base method.
+func (m *MockMeetingRepo) GetNextScheduledDatesByCompany(ctx context.Context, companyID int64) (map[int64]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextScheduledDatesByCompany", ctx, companyID)
+	ret0, _ := ret[0].(map[int64]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextScheduledDatesByCompany indicates an expected call of GetNextScheduledDatesByCompany.
+func (mr *MockMeetingRepoMockRecorder) GetNextScheduledDatesByCompany(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextScheduledDatesByCompany", reflect.TypeOf((*MockMeetingRepo)(nil).GetNextScheduledDatesByCompany), ctx, companyID)
+}
+
+// GetNextScheduledMeeting mocks base method.
+func (m *MockMeetingRepo) GetNextScheduledMeeting(ctx context.Context, personID int64) (entity.Meeting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextScheduledMeeting", ctx, personID)
+	ret0, _ := ret[0].(entity.Meeting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextScheduledMeeting indicates an expected call of GetNextScheduledMeeting.
+func (mr *MockMeetingRepoMockRecorder) GetNextScheduledMeeting(ctx, personID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextScheduledMeeting", reflect.TypeOf((*MockMeetingRepo)(nil).GetNextScheduledMeeting), ctx, personID)
+}
+
+// GetOneOnOnesCountThisMonth mocks base method.
+func (m *MockMeetingRepo) GetOneOnOnesCountThisMonth(ctx context.Context, companyID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneOnOnesCountThisMonth", ctx, companyID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOneOnOnesCountThisMonth indicates an expected call of GetOneOnOnesCountThisMonth.
+func (mr *MockMeetingRepoMockRecorder) GetOneOnOnesCountThisMonth(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneOnOnesCountThisMonth", reflect.TypeOf((*MockMeetingRepo)(nil).GetOneOnOnesCountThisMonth), ctx, companyID)
+}
+
+// GetScheduledMeetingsByUser mocks base method.
+func (m *MockMeetingRepo) GetScheduledMeetingsByUser(ctx context.Context, userID int64, from time.Time) ([]entity.Meeting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScheduledMeetingsByUser", ctx, userID, from)
+	ret0, _ := ret[0].([]entity.Meeting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScheduledMeetingsByUser indicates an expected call of GetScheduledMeetingsByUser.
+func (mr *MockMeetingRepoMockRecorder) GetScheduledMeetingsByUser(ctx, userID, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScheduledMeetingsByUser", reflect.TypeOf((*MockMeetingRepo)(nil).GetScheduledMeetingsByUser), ctx, userID, from)
+}
+
+// UpdateAgendaItem mocks base method.
+func (m *MockMeetingRepo) UpdateAgendaItem(ctx context.Context, itemID int64, item entity.MeetingAgendaItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAgendaItem", ctx, itemID, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAgendaItem indicates an expected call of UpdateAgendaItem.
+func (mr *MockMeetingRepoMockRecorder) UpdateAgendaItem(ctx, itemID, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAgendaItem", reflect.TypeOf((*MockMeetingRepo)(nil).UpdateAgendaItem), ctx, itemID, item)
+}
+
+// UpdateMeeting mocks base method.
+func (m *MockMeetingRepo) UpdateMeeting(ctx context.Context, meetingID int64, meeting entity.Meeting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMeeting", ctx, meetingID, meeting)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMeeting indicates an expected call of UpdateMeeting.
+func (mr *MockMeetingRepoMockRecorder) UpdateMeeting(ctx, meetingID, meeting any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMeeting", reflect.TypeOf((*MockMeetingRepo)(nil).UpdateMeeting), ctx, meetingID, meeting)
+}
+
+// MockActionItemRepo is a mock of ActionItemRepo interface.
+type MockActionItemRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockActionItemRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockActionItemRepoMockRecorder is the mock recorder for MockActionItemRepo.
+type MockActionItemRepoMockRecorder struct {
+	mock *MockActionItemRepo
+}
+
+// NewMockActionItemRepo creates a new mock instance.
+func NewMockActionItemRepo(ctrl *gomock.Controller) *MockActionItemRepo {
+	mock := &MockActionItemRepo{ctrl: ctrl}
+	mock.recorder = &MockActionItemRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActionItemRepo) EXPECT() *MockActionItemRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateActionItem mocks base method.
+func (m *MockActionItemRepo) CreateActionItem(ctx context.Context, item entity.ActionItem) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateActionItem", ctx, item)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateActionItem indicates an expected call of CreateActionItem.
+func (mr *MockActionItemRepoMockRecorder) CreateActionItem(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateActionItem", reflect.TypeOf((*MockActionItemRepo)(nil).CreateActionItem), ctx, item)
+}
+
+// DeleteActionItem mocks base method.
+func (m *MockActionItemRepo) DeleteActionItem(ctx context.Context, itemID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteActionItem", ctx, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteActionItem indicates an expected call of DeleteActionItem.
+func (mr *MockActionItemRepoMockRecorder) DeleteActionItem(ctx, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActionItem", reflect.TypeOf((*MockActionItemRepo)(nil).DeleteActionItem), ctx, itemID)
+}
+
+// GetActionItemByUUID mocks base method.
+func (m *MockActionItemRepo) GetActionItemByUUID(ctx context.Context, itemUUID string) (entity.ActionItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActionItemByUUID", ctx, itemUUID)
+	ret0, _ := ret[0].(entity.ActionItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActionItemByUUID indicates an expected call of GetActionItemByUUID.
+func (mr *MockActionItemRepoMockRecorder) GetActionItemByUUID(ctx, itemUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActionItemByUUID", reflect.TypeOf((*MockActionItemRepo)(nil).GetActionItemByUUID), ctx, itemUUID)
+}
+
+// GetActionItemsByPerson mocks base method.
+func (m *MockActionItemRepo) GetActionItemsByPerson(ctx context.Context, personID int64, status string, take, skip int64) ([]entity.ActionItem, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActionItemsByPerson", ctx, personID, status, take, skip)
+	ret0, _ := ret[0].([]entity.ActionItem)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetActionItemsByPerson indicates an expected call of GetActionItemsByPerson.
+func (mr *MockActionItemRepoMockRecorder) GetActionItemsByPerson(ctx, personID, status, take, skip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActionItemsByPerson", reflect.TypeOf((*MockActionItemRepo)(nil).GetActionItemsByPerson), ctx, personID, status, take, skip)
+}
+
+// GetActionItemsToCarryOver mocks base method.
+func (m *MockActionItemRepo) GetActionItemsToCarryOver(ctx context.Context, personID int64) ([]entity.ActionItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActionItemsToCarryOver", ctx, personID)
+	ret0, _ := ret[0].([]entity.ActionItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActionItemsToCarryOver indicates an expected call of GetActionItemsToCarryOver.
+func (mr *MockActionItemRepoMockRecorder) GetActionItemsToCarryOver(ctx, personID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActionItemsToCarryOver", reflect.TypeOf((*MockActionItemRepo)(nil).GetActionItemsToCarryOver), ctx, personID)
+}
+
+// GetOpenActionItemsByPerson mocks base method.
+func (m *MockActionItemRepo) GetOpenActionItemsByPerson(ctx context.Context, personID int64) ([]entity.ActionItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenActionItemsByPerson", ctx, personID)
+	ret0, _ := ret[0].([]entity.ActionItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenActionItemsByPerson indicates an expected call of GetOpenActionItemsByPerson.
+func (mr *MockActionItemRepoMockRecorder) GetOpenActionItemsByPerson(ctx, personID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenActionItemsByPerson", reflect.TypeOf((*MockActionItemRepo)(nil).GetOpenActionItemsByPerson), ctx, personID)
+}
+
+// GetOverdueActionItemsByCompany mocks base method.
+func (m *MockActionItemRepo) GetOverdueActionItemsByCompany(ctx context.Context, companyID int64, today time.Time) ([]entity.ActionItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverdueActionItemsByCompany", ctx, companyID, today)
+	ret0, _ := ret[0].([]entity.ActionItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverdueActionItemsByCompany indicates an expected call of GetOverdueActionItemsByCompany.
+func (mr *MockActionItemRepoMockRecorder) GetOverdueActionItemsByCompany(ctx, companyID, today any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverdueActionItemsByCompany", reflect.TypeOf((*MockActionItemRepo)(nil).GetOverdueActionItemsByCompany), ctx, companyID, today)
+}
+
+// UpdateActionItem mocks base method.
+func (m *MockActionItemRepo) UpdateActionItem(ctx context.Context, itemID int64, item entity.ActionItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActionItem", ctx, itemID, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateActionItem indicates an expected call of UpdateActionItem.
+func (mr *MockActionItemRepoMockRecorder) UpdateActionItem(ctx, itemID, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActionItem", reflect.TypeOf((*MockActionItemRepo)(nil).UpdateActionItem), ctx, itemID, item)
+}
+
+// MockGoalRepo is a mock of GoalRepo interface.
+type MockGoalRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockGoalRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockGoalRepoMockRecorder is the mock recorder for MockGoalRepo.
+type MockGoalRepoMockRecorder struct {
+	mock *MockGoalRepo
+}
+
+// NewMockGoalRepo creates a new mock instance.
+func NewMockGoalRepo(ctrl *gomock.Controller) *MockGoalRepo {
+	mock := &MockGoalRepo{ctrl: ctrl}
+	mock.recorder = &MockGoalRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGoalRepo) EXPECT() *MockGoalRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateCheckIn mocks base method.
+func (m *MockGoalRepo) CreateCheckIn(ctx context.Context, checkIn entity.GoalCheckIn) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCheckIn", ctx, checkIn)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCheckIn indicates an expected call of CreateCheckIn.
+func (mr *MockGoalRepoMockRecorder) CreateCheckIn(ctx, checkIn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCheckIn", reflect.TypeOf((*MockGoalRepo)(nil).CreateCheckIn), ctx, checkIn)
+}
+
+// CreateGoal mocks base method.
+func (m *MockGoalRepo) CreateGoal(ctx context.Context, goal entity.Goal) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGoal", ctx, goal)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGoal indicates an expected call of CreateGoal.
+func (mr *MockGoalRepoMockRecorder) CreateGoal(ctx, goal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGoal", reflect.TypeOf((*MockGoalRepo)(nil).CreateGoal), ctx, goal)
+}
+
+// CreateKeyResult mocks base method.
+func (m *MockGoalRepo) CreateKeyResult(ctx context.Context, keyResult entity.GoalKeyResult) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKeyResult", ctx, keyResult)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKeyResult indicates an expected call of CreateKeyResult.
+func (mr *MockGoalRepoMockRecorder) CreateKeyResult(ctx, keyResult any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKeyResult", reflect.TypeOf((*MockGoalRepo)(nil).CreateKeyResult), ctx, keyResult)
+}
+
+// DeleteCheckIn mocks base method.
+func (m *MockGoalRepo) DeleteCheckIn(ctx context.Context, checkInID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCheckIn", ctx, checkInID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCheckIn indicates an expected call of DeleteCheckIn.
+func (mr *MockGoalRepoMockRecorder) DeleteCheckIn(ctx, checkInID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCheckIn", reflect.TypeOf((*MockGoalRepo)(nil).DeleteCheckIn), ctx, checkInID)
+}
+
+// DeleteGoal mocks base method.
+func (m *MockGoalRepo) DeleteGoal(ctx context.Context, goalID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGoal", ctx, goalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGoal indicates an expected call of DeleteGoal.
+func (mr *MockGoalRepoMockRecorder) DeleteGoal(ctx, goalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGoal", reflect.TypeOf((*MockGoalRepo)(nil).DeleteGoal), ctx, goalID)
+}
+
+// DeleteKeyResult mocks base method.
+func (m *MockGoalRepo) DeleteKeyResult(ctx context.Context, keyResultID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKeyResult", ctx, keyResultID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKeyResult indicates an expected call of DeleteKeyResult.
+func (mr *MockGoalRepoMockRecorder) DeleteKeyResult(ctx, keyResultID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKeyResult", reflect.TypeOf((*MockGoalRepo)(nil).DeleteKeyResult), ctx, keyResultID)
+}
+
+// GetCheckInsByGoal mocks base method.
+func (m *MockGoalRepo) GetCheckInsByGoal(ctx context.Context, goalID int64) ([]entity.GoalCheckIn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckInsByGoal", ctx, goalID)
+	ret0, _ := ret[0].([]entity.GoalCheckIn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCheckInsByGoal indicates an expected call of GetCheckInsByGoal.
+func (mr *MockGoalRepoMockRecorder) GetCheckInsByGoal(ctx, goalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckInsByGoal", reflect.TypeOf((*MockGoalRepo)(nil).GetCheckInsByGoal), ctx, goalID)
+}
+
+// GetCheckInsByPerson mocks base method.
+func (m *MockGoalRepo) GetCheckInsByPerson(ctx context.Context, personID int64) ([]entity.GoalCheckIn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckInsByPerson", ctx, personID)
+	ret0, _ := ret[0].([]entity.GoalCheckIn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCheckInsByPerson indicates an expected call of GetCheckInsByPerson.
+func (mr *MockGoalRepoMockRecorder) GetCheckInsByPerson(ctx, personID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckInsByPerson", reflect.TypeOf((*MockGoalRepo)(nil).GetCheckInsByPerson), ctx, personID)
+}
+
+// GetGoalByUUID mocks base method.
+func (m *MockGoalRepo) GetGoalByUUID(ctx context.Context, goalUUID string) (entity.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoalByUUID", ctx, goalUUID)
+	ret0, _ := ret[0].(entity.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoalByUUID indicates an expected call of GetGoalByUUID.
+func (mr *MockGoalRepoMockRecorder) GetGoalByUUID(ctx, goalUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoalByUUID", reflect.TypeOf((*MockGoalRepo)(nil).GetGoalByUUID), ctx, goalUUID)
+}
+
+// GetGoalsByPerson mocks base method.
+func (m *MockGoalRepo) GetGoalsByPerson(ctx context.Context, personID int64, status string) ([]entity.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoalsByPerson", ctx, personID, status)
+	ret0, _ := ret[0].([]entity.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoalsByPerson indicates an expected call of GetGoalsByPerson.
+func (mr *MockGoalRepoMockRecorder) GetGoalsByPerson(ctx, personID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoalsByPerson", reflect.TypeOf((*MockGoalRepo)(nil).GetGoalsByPerson), ctx, personID, status)
+}
+
+// GetKeyResultsByGoal mocks base method.
+func (m *MockGoalRepo) GetKeyResultsByGoal(ctx context.Context, goalID int64) ([]entity.GoalKeyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyResultsByGoal", ctx, goalID)
+	ret0, _ := ret[0].([]entity.GoalKeyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyResultsByGoal indicates an expected call of GetKeyResultsByGoal.
+func (mr *MockGoalRepoMockRecorder) GetKeyResultsByGoal(ctx, goalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyResultsByGoal", reflect.TypeOf((*MockGoalRepo)(nil).GetKeyResultsByGoal), ctx, goalID)
+}
+
+// GetKeyResultsByPerson mocks base method.
+func (m *MockGoalRepo) GetKeyResultsByPerson(ctx context.Context, personID int64) ([]entity.GoalKeyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyResultsByPerson", ctx, personID)
+	ret0, _ := ret[0].([]entity.GoalKeyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKeyResultsByPerson indicates an expected call of GetKeyResultsByPerson.
+func (mr *MockGoalRepoMockRecorder) GetKeyResultsByPerson(ctx, personID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyResultsByPerson", reflect.TypeOf((*MockGoalRepo)(nil).GetKeyResultsByPerson), ctx, personID)
+}
+
+// UpdateGoal mocks base method.
+func (m *MockGoalRepo) UpdateGoal(ctx context.Context, goalID int64, goal entity.Goal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGoal", ctx, goalID, goal)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGoal indicates an expected call of UpdateGoal.
+func (mr *MockGoalRepoMockRecorder) UpdateGoal(ctx, goalID, goal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoal", reflect.TypeOf((*MockGoalRepo)(nil).UpdateGoal), ctx, goalID, goal)
+}
+
+// UpdateKeyResult mocks base method.
+func (m *MockGoalRepo) UpdateKeyResult(ctx context.Context, keyResultID int64, keyResult entity.GoalKeyResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKeyResult", ctx, keyResultID, keyResult)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateKeyResult indicates an expected call of UpdateKeyResult.
+func (mr *MockGoalRepoMockRecorder) UpdateKeyResult(ctx, keyResultID, keyResult any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKeyResult", reflect.TypeOf((*MockGoalRepo)(nil).UpdateKeyResult), ctx, keyResultID, keyResult)
+}
+
+// MockReviewRepo is a mock of ReviewRepo interface.
+type MockReviewRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewRepoMockRecorder is the mock recorder for MockReviewRepo.
+type MockReviewRepoMockRecorder struct {
+	mock *MockReviewRepo
+}
+
+// NewMockReviewRepo creates a new mock instance.
+func NewMockReviewRepo(ctrl *gomock.Controller) *MockReviewRepo {
+	mock := &MockReviewRepo{ctrl: ctrl}
+	mock.recorder = &MockReviewRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewRepo) EXPECT() *MockReviewRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateCompetency mocks base method.
+func (m *MockReviewRepo) CreateCompetency(ctx context.Context, competency entity.ReviewCompetency) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCompetency", ctx, competency)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCompetency indicates an expected call of CreateCompetency.
+func (mr *MockReviewRepoMockRecorder) CreateCompetency(ctx, competency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompetency", reflect.TypeOf((*MockReviewRepo)(nil).CreateCompetency), ctx, competency)
+}
+
+// CreateCycle mocks base method.
+func (m *MockReviewRepo) CreateCycle(ctx context.Context, cycle entity.ReviewCycle) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCycle", ctx, cycle)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCycle indicates an expected call of CreateCycle.
+func (mr *MockReviewRepoMockRecorder) CreateCycle(ctx, cycle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCycle", reflect.TypeOf((*MockReviewRepo)(nil).CreateCycle), ctx, cycle)
+}
+
+// CreateRatingLevel mocks base method.
+func (m *MockReviewRepo) CreateRatingLevel(ctx context.Context, level entity.ReviewRatingLevel) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRatingLevel", ctx, level)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRatingLevel indicates an expected call of CreateRatingLevel.
+func (mr *MockReviewRepoMockRecorder) CreateRatingLevel(ctx, level any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRatingLevel", reflect.TypeOf((*MockReviewRepo)(nil).CreateRatingLevel), ctx, level)
+}
+
+// CreateReview mocks base method.
+func (m *MockReviewRepo) CreateReview(ctx context.Context, review entity.Review) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", ctx, review)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockReviewRepoMockRecorder) CreateReview(ctx, review any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockReviewRepo)(nil).CreateReview), ctx, review)
+}
+
+// CreateTemplate mocks base method.
+func (m *MockReviewRepo) CreateTemplate(ctx context.Context, template entity.ReviewTemplate) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplate", ctx, template)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplate indicates an expected call of CreateTemplate.
+func (mr *MockReviewRepoMockRecorder) CreateTemplate(ctx, template any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockReviewRepo)(nil).CreateTemplate), ctx, template)
+}
+
+// DeleteCycle mocks base method.
+func (m *MockReviewRepo) DeleteCycle(ctx context.Context, cycleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCycle", ctx, cycleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCycle indicates an expected call of DeleteCycle.
+func (mr *MockReviewRepoMockRecorder) DeleteCycle(ctx, cycleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCycle", reflect.TypeOf((*MockReviewRepo)(nil).DeleteCycle), ctx, cycleID)
+}
+
+// DeleteReview mocks base method.
+func (m *MockReviewRepo) DeleteReview(ctx context.Context, reviewID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, reviewID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockReviewRepoMockRecorder) DeleteReview(ctx, reviewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockReviewRepo)(nil).DeleteReview), ctx, reviewID)
+}
+
+// DeleteTemplate mocks base method.
+func (m *MockReviewRepo) DeleteTemplate(ctx context.Context, templateID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplate", ctx, templateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplate indicates an expected call of DeleteTemplate.
+func (mr *MockReviewRepoMockRecorder) DeleteTemplate(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockReviewRepo)(nil).DeleteTemplate), ctx, templateID)
+}
+
+// GetCompetenciesByTemplate mocks base method.
+func (m *MockReviewRepo) GetCompetenciesByTemplate(ctx context.Context, templateID int64) ([]entity.ReviewCompetency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompetenciesByTemplate", ctx, templateID)
+	ret0, _ := ret[0].([]entity.ReviewCompetency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompetenciesByTemplate indicates an expected call of GetCompetenciesByTemplate.
+func (mr *MockReviewRepoMockRecorder) GetCompetenciesByTemplate(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompetenciesByTemplate", reflect.TypeOf((*MockReviewRepo)(nil).GetCompetenciesByTemplate), ctx, templateID)
+}
+
+// GetCycleByUUID mocks base method.
+func (m *MockReviewRepo) GetCycleByUUID(ctx context.Context, cycleUUID string) (entity.ReviewCycle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCycleByUUID", ctx, cycleUUID)
+	ret0, _ := ret[0].(entity.ReviewCycle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCycleByUUID indicates an expected call of GetCycleByUUID.
+func (mr *MockReviewRepoMockRecorder) GetCycleByUUID(ctx, cycleUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCycleByUUID", reflect.TypeOf((*MockReviewRepo)(nil).GetCycleByUUID), ctx, cycleUUID)
+}
+
+// GetCyclesByCompany mocks base method.
+func (m *MockReviewRepo) GetCyclesByCompany(ctx context.Context, companyID int64) ([]entity.ReviewCycle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCyclesByCompany", ctx, companyID)
+	ret0, _ := ret[0].([]entity.ReviewCycle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCyclesByCompany indicates an expected call of GetCyclesByCompany.
+func (mr *MockReviewRepoMockRecorder) GetCyclesByCompany(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCyclesByCompany", reflect.TypeOf((*MockReviewRepo)(nil).GetCyclesByCompany), ctx, companyID)
+}
+
+// GetRatingLevelsByTemplate mocks base method.
+func (m *MockReviewRepo) GetRatingLevelsByTemplate(ctx context.Context, templateID int64) ([]entity.ReviewRatingLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRatingLevelsByTemplate", ctx, templateID)
+	ret0, _ := ret[0].([]entity.ReviewRatingLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRatingLevelsByTemplate indicates an expected call of GetRatingLevelsByTemplate.
+func (mr *MockReviewRepoMockRecorder) GetRatingLevelsByTemplate(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRatingLevelsByTemplate", reflect.TypeOf((*MockReviewRepo)(nil).GetRatingLevelsByTemplate), ctx, templateID)
+}
+
+// GetRatingsByReview mocks base method.
+func (m *MockReviewRepo) GetRatingsByReview(ctx context.Context, reviewID int64) ([]entity.ReviewRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRatingsByReview", ctx, reviewID)
+	ret0, _ := ret[0].([]entity.ReviewRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRatingsByReview indicates an expected call of GetRatingsByReview.
+func (mr *MockReviewRepoMockRecorder) GetRatingsByReview(ctx, reviewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRatingsByReview", reflect.TypeOf((*MockReviewRepo)(nil).GetRatingsByReview), ctx, reviewID)
+}
+
+// GetReviewByUUID mocks base method.
+func (m *MockReviewRepo) GetReviewByUUID(ctx context.Context, reviewUUID string) (entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewByUUID", ctx, reviewUUID)
+	ret0, _ := ret[0].(entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewByUUID indicates an expected call of GetReviewByUUID.
+func (mr *MockReviewRepoMockRecorder) GetReviewByUUID(ctx, reviewUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewByUUID", reflect.TypeOf((*MockReviewRepo)(nil).GetReviewByUUID), ctx, reviewUUID)
+}
+
+// GetReviewsByCycle mocks base method.
+func (m *MockReviewRepo) GetReviewsByCycle(ctx context.Context, cycleID int64) ([]entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewsByCycle", ctx, cycleID)
+	ret0, _ := ret[0].([]entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewsByCycle indicates an expected call of GetReviewsByCycle.
+func (mr *MockReviewRepoMockRecorder) GetReviewsByCycle(ctx, cycleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewsByCycle", reflect.TypeOf((*MockReviewRepo)(nil).GetReviewsByCycle), ctx, cycleID)
+}
+
+// GetReviewsByPerson mocks base method.
+func (m *MockReviewRepo) GetReviewsByPerson(ctx context.Context, personID int64) ([]entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewsByPerson", ctx, personID)
+	ret0, _ := ret[0].([]entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewsByPerson indicates an expected call of GetReviewsByPerson.
+func (mr *MockReviewRepoMockRecorder) GetReviewsByPerson(ctx, personID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewsByPerson", reflect.TypeOf((*MockReviewRepo)(nil).GetReviewsByPerson), ctx, personID)
+}
+
+// GetTemplateByID mocks base method.
+func (m *MockReviewRepo) GetTemplateByID(ctx context.Context, templateID int64) (entity.ReviewTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateByID", ctx, templateID)
+	ret0, _ := ret[0].(entity.ReviewTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateByID indicates an expected call of GetTemplateByID.
+func (mr *MockReviewRepoMockRecorder) GetTemplateByID(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByID", reflect.TypeOf((*MockReviewRepo)(nil).GetTemplateByID), ctx, templateID)
+}
+
+// GetTemplateByUUID mocks base method.
+func (m *MockReviewRepo) GetTemplateByUUID(ctx context.Context, templateUUID string) (entity.ReviewTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateByUUID", ctx, templateUUID)
+	ret0, _ := ret[0].(entity.ReviewTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateByUUID indicates an expected call of GetTemplateByUUID.
+func (mr *MockReviewRepoMockRecorder) GetTemplateByUUID(ctx, templateUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByUUID", reflect.TypeOf((*MockReviewRepo)(nil).GetTemplateByUUID), ctx, templateUUID)
+}
+
+// GetTemplatesByCompany mocks base method.
+func (m *MockReviewRepo) GetTemplatesByCompany(ctx context.Context, companyID int64) ([]entity.ReviewTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplatesByCompany", ctx, companyID)
+	ret0, _ := ret[0].([]entity.ReviewTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplatesByCompany indicates an expected call of GetTemplatesByCompany.
+func (mr *MockReviewRepoMockRecorder) GetTemplatesByCompany(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplatesByCompany", reflect.TypeOf((*MockReviewRepo)(nil).GetTemplatesByCompany), ctx, companyID)
+}
+
+// SaveRating mocks base method.
+func (m *MockReviewRepo) SaveRating(ctx context.Context, rating entity.ReviewRating) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveRating", ctx, rating)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveRating indicates an expected call of SaveRating.
+func (mr *MockReviewRepoMockRecorder) SaveRating(ctx, rating any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveRating", reflect.TypeOf((*MockReviewRepo)(nil).SaveRating), ctx, rating)
+}
+
+// UpdateReview mocks base method.
+func (m *MockReviewRepo) UpdateReview(ctx context.Context, reviewID int64, review entity.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, reviewID, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockReviewRepoMockRecorder) UpdateReview(ctx, reviewID, review any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockReviewRepo)(nil).UpdateReview), ctx, reviewID, review)
+}
+
+// MockFeedbackRequestRepo is a mock of FeedbackRequestRepo interface.
+type MockFeedbackRequestRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedbackRequestRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockFeedbackRequestRepoMockRecorder is the mock recorder for MockFeedbackRequestRepo.
+type MockFeedbackRequestRepoMockRecorder struct {
+	mock *MockFeedbackRequestRepo
+}
+
+// NewMockFeedbackRequestRepo creates a new mock instance.
+func NewMockFeedbackRequestRepo(ctrl *gomock.Controller) *MockFeedbackRequestRepo {
+	mock := &MockFeedbackRequestRepo{ctrl: ctrl}
+	mock.recorder = &MockFeedbackRequestRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedbackRequestRepo) EXPECT() *MockFeedbackRequestRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateQuestion mocks base method.
+func (m *MockFeedbackRequestRepo) CreateQuestion(ctx context.Context, question entity.FeedbackQuestion) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQuestion", ctx, question)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQuestion indicates an expected call of CreateQuestion.
+func (mr *MockFeedbackRequestRepoMockRecorder) CreateQuestion(ctx, question any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuestion", reflect.TypeOf((*MockFeedbackRequestRepo)(nil).CreateQuestion), ctx, question)
+}
+
+// CreateRequest mocks base method.
+func (m *MockFeedbackRequestRepo) CreateRequest(ctx context.Context, request entity.FeedbackRequest) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRequest", ctx, request)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRequest indicates an expected call of CreateRequest.
+func (mr *MockFeedbackRequestRepoMockRecorder) CreateRequest(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRequest", reflect.TypeOf((*MockFeedbackRequestRepo)(nil).CreateRequest), ctx, request)
+}
+
+// CreateRespondent mocks base method.
+func (m *MockFeedbackRequestRepo) CreateRespondent(ctx context.Context, respondent entity.FeedbackRespondent) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRespondent", ctx, respondent)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRespondent indicates an expected call of CreateRespondent.
+func (mr *MockFeedbackRequestRepoMockRecorder) CreateRespondent(ctx, respondent any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRespondent", reflect.TypeOf((*MockFeedbackRequestRepo)(nil).CreateRespondent), ctx, respondent)
+}
+
+// DeleteRequest mocks base method.
+func (m *MockFeedbackRequestRepo) DeleteRequest(ctx context.Context, requestID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRequest", ctx, requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRequest indicates an expected call of DeleteRequest.
+func (mr *MockFeedbackRequestRepoMockRecorder) DeleteRequest(ctx, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRequest", reflect.TypeOf((*MockFeedbackRequestRepo)(nil).DeleteRequest), ctx, requestID)
+}
+
+// GetQuestionsByRequest mocks base method.
+func (m *MockFeedbackRequestRepo) GetQuestionsByRequest(ctx context.Context, requestID int64) ([]entity.FeedbackQuestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestionsByRequest", ctx, requestID)
+	ret0, _ := ret[0].([]entity.FeedbackQuestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuestionsByRequest indicates an expected call of GetQuestionsByRequest.
+func (mr *MockFeedbackRequestRepoMockRecorder) GetQuestionsByRequest(ctx, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestionsByRequest", reflect.TypeOf((*MockFeedbackRequestRepo)(nil).GetQuestionsByRequest), ctx, requestID)
+}
+
+// GetRequestByID mocks base method.
+func (m *MockFeedbackRequestRepo) GetRequestByID(ctx context.Context, requestID int64) (entity.FeedbackRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestByID", ctx, requestID)
+	ret0, _ := ret[0].(entity.FeedbackRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequestByID indicates an expected call of GetRequestByID.
+func (mr *MockFeedbackRequestRepoMockRecorder) GetRequestByID(ctx, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestByID", reflect.TypeOf((*MockFeedbackRequestRepo)(nil).GetRequestByID), ctx, requestID)
+}
+
+// GetRequestByUUID mocks base method.
+func (m *MockFeedbackRequestRepo) GetRequestByUUID(ctx context.Context, requestUUID string) (entity.FeedbackRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestByUUID", ctx, requestUUID)
+	ret0, _ := ret[0].(entity.FeedbackRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequestByUUID indicates an expected call of GetRequestByUUID.
+func (mr *MockFeedbackRequestRepoMockRecorder) GetRequestByUUID(ctx, requestUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestByUUID", reflect.TypeOf((*MockFeedbackRequestRepo)(nil).GetRequestByUUID), ctx, requestUUID)
+}
+
+// GetRequestsByPerson mocks base method.
+func (m *MockFeedbackRequestRepo) GetRequestsByPerson(ctx context.Context, personID int64) ([]entity.FeedbackRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestsByPerson", ctx, personID)
+	ret0, _ := ret[0].([]entity.FeedbackRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequestsByPerson indicates an expected call of GetRequestsByPerson.
+func (mr *MockFeedbackRequestRepoMockRecorder) GetRequestsByPerson(ctx, personID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestsByPerson", reflect.TypeOf((*MockFeedbackRequestRepo)(nil).GetRequestsByPerson), ctx, personID)
+}
+
+// GetRespondentByTokenHash mocks base method.
+func (m *MockFeedbackRequestRepo) GetRespondentByTokenHash(ctx context.Context, tokenHash string) (entity.FeedbackRespondent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRespondentByTokenHash", ctx, tokenHash)
+	ret0, _ := ret[0].(entity.FeedbackRespondent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRespondentByTokenHash indicates an expected call of GetRespondentByTokenHash.
+func (mr *MockFeedbackRequestRepoMockRecorder) GetRespondentByTokenHash(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRespondentByTokenHash", reflect.TypeOf((*MockFeedbackRequestRepo)(nil).GetRespondentByTokenHash), ctx, tokenHash)
+}
+
+// GetRespondentsByRequest mocks base method.
+func (m *MockFeedbackRequestRepo) GetRespondentsByRequest(ctx context.Context, requestID int64) ([]entity.FeedbackRespondent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRespondentsByRequest", ctx, requestID)
+	ret0, _ := ret[0].([]entity.FeedbackRespondent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRespondentsByRequest indicates an expected call of GetRespondentsByRequest.
+func (mr *MockFeedbackRequestRepoMockRecorder) GetRespondentsByRequest(ctx, requestID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRespondentsByRequest", reflect.TypeOf((*MockFeedbackRequestRepo)(nil).GetRespondentsByRequest), ctx, requestID)
+}
+
+// MarkRespondentResponded mocks base method.
+func (m *MockFeedbackRequestRepo) MarkRespondentResponded(ctx context.Context, respondentID int64, noteID *int64, respondedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRespondentResponded", ctx, respondentID, noteID, respondedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkRespondentResponded indicates an expected call of MarkRespondentResponded.
+func (mr *MockFeedbackRequestRepoMockRecorder) MarkRespondentResponded(ctx, respondentID, noteID, respondedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRespondentResponded", reflect.TypeOf((*MockFeedbackRequestRepo)(nil).MarkRespondentResponded), ctx, respondentID, noteID, respondedAt)
+}
+
+// MockCompetencyRepo is a mock of CompetencyRepo interface.
+type MockCompetencyRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompetencyRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockCompetencyRepoMockRecorder is the mock recorder for MockCompetencyRepo.
+type MockCompetencyRepoMockRecorder struct {
+	mock *MockCompetencyRepo
+}
+
+// NewMockCompetencyRepo creates a new mock instance.
+func NewMockCompetencyRepo(ctrl *gomock.Controller) *MockCompetencyRepo {
+	mock := &MockCompetencyRepo{ctrl: ctrl}
+	mock.recorder = &MockCompetencyRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompetencyRepo) EXPECT() *MockCompetencyRepoMockRecorder {
+	return m.recorder
+}
+
+// AddNoteCompetency mocks base method.
+func (m *MockCompetencyRepo) AddNoteCompetency(ctx context.Context, noteID, competencyID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNoteCompetency", ctx, noteID, competencyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddNoteCompetency indicates an expected call of AddNoteCompetency.
+func (mr *MockCompetencyRepoMockRecorder) AddNoteCompetency(ctx, noteID, competencyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNoteCompetency", reflect.TypeOf((*MockCompetencyRepo)(nil).AddNoteCompetency), ctx, noteID, competencyID)
+}
+
+// CountAssessmentsByFramework mocks base method.
+func (m *MockCompetencyRepo) CountAssessmentsByFramework(ctx context.Context, frameworkID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountAssessmentsByFramework", ctx, frameworkID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountAssessmentsByFramework indicates an expected call of CountAssessmentsByFramework.
+func (mr *MockCompetencyRepoMockRecorder) CountAssessmentsByFramework(ctx, frameworkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountAssessmentsByFramework", reflect.TypeOf((*MockCompetencyRepo)(nil).CountAssessmentsByFramework), ctx, frameworkID)
+}
+
+// CreateAssessment mocks base method.
+func (m *MockCompetencyRepo) CreateAssessment(ctx context.Context, assessment entity.CompetencyAssessment) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAssessment", ctx, assessment)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAssessment indicates an expected call of CreateAssessment.
+func (mr *MockCompetencyRepoMockRecorder) CreateAssessment(ctx, assessment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAssessment", reflect.TypeOf((*MockCompetencyRepo)(nil).CreateAssessment), ctx, assessment)
+}
+
+// CreateCompetency mocks base method.
+func (m *MockCompetencyRepo) CreateCompetency(ctx context.Context, competency entity.Competency) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCompetency", ctx, competency)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCompetency indicates an expected call of CreateCompetency.
+func (mr *MockCompetencyRepoMockRecorder) CreateCompetency(ctx, competency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompetency", reflect.TypeOf((*MockCompetencyRepo)(nil).CreateCompetency), ctx, competency)
+}
+
+// CreateDescriptor mocks base method.
+func (m *MockCompetencyRepo) CreateDescriptor(ctx context.Context, descriptor entity.CompetencyDescriptor) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDescriptor", ctx, descriptor)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDescriptor indicates an expected call of CreateDescriptor.
+func (mr *MockCompetencyRepoMockRecorder) CreateDescriptor(ctx, descriptor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDescriptor", reflect.TypeOf((*MockCompetencyRepo)(nil).CreateDescriptor), ctx, descriptor)
+}
+
+// CreateFramework mocks base method.
+func (m *MockCompetencyRepo) CreateFramework(ctx context.Context, framework entity.CompetencyFramework) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFramework", ctx, framework)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFramework indicates an expected call of CreateFramework.
+func (mr *MockCompetencyRepoMockRecorder) CreateFramework(ctx, framework any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFramework", reflect.TypeOf((*MockCompetencyRepo)(nil).CreateFramework), ctx, framework)
+}
+
+// CreateLevel mocks base method.
+func (m *MockCompetencyRepo) CreateLevel(ctx context.Context, level entity.CompetencyLevel) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLevel", ctx, level)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLevel indicates an expected call of CreateLevel.
+func (mr *MockCompetencyRepoMockRecorder) CreateLevel(ctx, level any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLevel", reflect.TypeOf((*MockCompetencyRepo)(nil).CreateLevel), ctx, level)
+}
+
+// CreateRating mocks base method.
+func (m *MockCompetencyRepo) CreateRating(ctx context.Context, rating entity.CompetencyRating) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRating", ctx, rating)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRating indicates an expected call of CreateRating.
+func (mr *MockCompetencyRepoMockRecorder) CreateRating(ctx, rating any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRating", reflect.TypeOf((*MockCompetencyRepo)(nil).CreateRating), ctx, rating)
+}
+
+// DeleteAssessment mocks base method.
+func (m *MockCompetencyRepo) DeleteAssessment(ctx context.Context, assessmentID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAssessment", ctx, assessmentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAssessment indicates an expected call of DeleteAssessment.
+func (mr *MockCompetencyRepoMockRecorder) DeleteAssessment(ctx, assessmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAssessment", reflect.TypeOf((*MockCompetencyRepo)(nil).DeleteAssessment), ctx, assessmentID)
+}
+
+// DeleteFramework mocks base method.
+func (m *MockCompetencyRepo) DeleteFramework(ctx context.Context, frameworkID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFramework", ctx, frameworkID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFramework indicates an expected call of DeleteFramework.
+func (mr *MockCompetencyRepoMockRecorder) DeleteFramework(ctx, frameworkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFramework", reflect.TypeOf((*MockCompetencyRepo)(nil).DeleteFramework), ctx, frameworkID)
+}
+
+// DeleteNoteCompetencies mocks base method.
+func (m *MockCompetencyRepo) DeleteNoteCompetencies(ctx context.Context, noteID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNoteCompetencies", ctx, noteID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNoteCompetencies indicates an expected call of DeleteNoteCompetencies.
+func (mr *MockCompetencyRepoMockRecorder) DeleteNoteCompetencies(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNoteCompetencies", reflect.TypeOf((*MockCompetencyRepo)(nil).DeleteNoteCompetencies), ctx, noteID)
+}
+
+// GetAssessmentByUUID mocks base method.
+func (m *MockCompetencyRepo) GetAssessmentByUUID(ctx context.Context, assessmentUUID string) (entity.CompetencyAssessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssessmentByUUID", ctx, assessmentUUID)
+	ret0, _ := ret[0].(entity.CompetencyAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssessmentByUUID indicates an expected call of GetAssessmentByUUID.
+func (mr *MockCompetencyRepoMockRecorder) GetAssessmentByUUID(ctx, assessmentUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssessmentByUUID", reflect.TypeOf((*MockCompetencyRepo)(nil).GetAssessmentByUUID), ctx, assessmentUUID)
+}
+
+// GetAssessmentsByPerson mocks base method.
+func (m *MockCompetencyRepo) GetAssessmentsByPerson(ctx context.Context, personID, frameworkID int64) ([]entity.CompetencyAssessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssessmentsByPerson", ctx, personID, frameworkID)
+	ret0, _ := ret[0].([]entity.CompetencyAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssessmentsByPerson indicates an expected call of GetAssessmentsByPerson.
+func (mr *MockCompetencyRepoMockRecorder) GetAssessmentsByPerson(ctx, personID, frameworkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssessmentsByPerson", reflect.TypeOf((*MockCompetencyRepo)(nil).GetAssessmentsByPerson), ctx, personID, frameworkID)
+}
+
+// GetCompetenciesByFramework mocks base method.
+func (m *MockCompetencyRepo) GetCompetenciesByFramework(ctx context.Context, frameworkID int64) ([]entity.Competency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompetenciesByFramework", ctx, frameworkID)
+	ret0, _ := ret[0].([]entity.Competency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompetenciesByFramework indicates an expected call of GetCompetenciesByFramework.
+func (mr *MockCompetencyRepoMockRecorder) GetCompetenciesByFramework(ctx, frameworkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompetenciesByFramework", reflect.TypeOf((*MockCompetencyRepo)(nil).GetCompetenciesByFramework), ctx, frameworkID)
+}
+
+// GetCompetencyByUUID mocks base method.
+func (m *MockCompetencyRepo) GetCompetencyByUUID(ctx context.Context, competencyUUID string) (entity.Competency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompetencyByUUID", ctx, competencyUUID)
+	ret0, _ := ret[0].(entity.Competency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompetencyByUUID indicates an expected call of GetCompetencyByUUID.
+func (mr *MockCompetencyRepoMockRecorder) GetCompetencyByUUID(ctx, competencyUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompetencyByUUID", reflect.TypeOf((*MockCompetencyRepo)(nil).GetCompetencyByUUID), ctx, competencyUUID)
+}
+
+// GetCompetencyEvidence mocks base method.
+func (m *MockCompetencyRepo) GetCompetencyEvidence(ctx context.Context, personID, competencyID int64) ([]entity.UnifiedTimelineEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompetencyEvidence", ctx, personID, competencyID)
+	ret0, _ := ret[0].([]entity.UnifiedTimelineEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompetencyEvidence indicates an expected call of GetCompetencyEvidence.
+func (mr *MockCompetencyRepoMockRecorder) GetCompetencyEvidence(ctx, personID, competencyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompetencyEvidence", reflect.TypeOf((*MockCompetencyRepo)(nil).GetCompetencyEvidence), ctx, personID, competencyID)
+}
+
+// GetDescriptorsByFramework mocks base method.
+func (m *MockCompetencyRepo) GetDescriptorsByFramework(ctx context.Context, frameworkID int64) ([]entity.CompetencyDescriptor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDescriptorsByFramework", ctx, frameworkID)
+	ret0, _ := ret[0].([]entity.CompetencyDescriptor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDescriptorsByFramework indicates an expected call of GetDescriptorsByFramework.
+func (mr *MockCompetencyRepoMockRecorder) GetDescriptorsByFramework(ctx, frameworkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDescriptorsByFramework", reflect.TypeOf((*MockCompetencyRepo)(nil).GetDescriptorsByFramework), ctx, frameworkID)
+}
+
+// GetEvidenceCounts mocks base method.
+func (m *MockCompetencyRepo) GetEvidenceCounts(ctx context.Context, personID, frameworkID int64) ([]entity.CompetencyEvidenceCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEvidenceCounts", ctx, personID, frameworkID)
+	ret0, _ := ret[0].([]entity.CompetencyEvidenceCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEvidenceCounts indicates an expected call of GetEvidenceCounts.
+func (mr *MockCompetencyRepoMockRecorder) GetEvidenceCounts(ctx, personID, frameworkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvidenceCounts", reflect.TypeOf((*MockCompetencyRepo)(nil).GetEvidenceCounts), ctx, personID, frameworkID)
+}
+
+// GetFrameworkByID mocks base method.
+func (m *MockCompetencyRepo) GetFrameworkByID(ctx context.Context, frameworkID int64) (entity.CompetencyFramework, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFrameworkByID", ctx, frameworkID)
+	ret0, _ := ret[0].(entity.CompetencyFramework)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFrameworkByID indicates an expected call of GetFrameworkByID.
+func (mr *MockCompetencyRepoMockRecorder) GetFrameworkByID(ctx, frameworkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrameworkByID", reflect.TypeOf((*MockCompetencyRepo)(nil).GetFrameworkByID), ctx, frameworkID)
+}
+
+// GetFrameworkByUUID mocks base method.
+func (m *MockCompetencyRepo) GetFrameworkByUUID(ctx context.Context, frameworkUUID string) (entity.CompetencyFramework, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFrameworkByUUID", ctx, frameworkUUID)
+	ret0, _ := ret[0].(entity.CompetencyFramework)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFrameworkByUUID indicates an expected call of GetFrameworkByUUID.
+func (mr *MockCompetencyRepoMockRecorder) GetFrameworkByUUID(ctx, frameworkUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrameworkByUUID", reflect.TypeOf((*MockCompetencyRepo)(nil).GetFrameworkByUUID), ctx, frameworkUUID)
+}
+
+// GetFrameworksByCompany mocks base method.
+func (m *MockCompetencyRepo) GetFrameworksByCompany(ctx context.Context, companyID int64) ([]entity.CompetencyFramework, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFrameworksByCompany", ctx, companyID)
+	ret0, _ := ret[0].([]entity.CompetencyFramework)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFrameworksByCompany indicates an expected call of GetFrameworksByCompany.
+func (mr *MockCompetencyRepoMockRecorder) GetFrameworksByCompany(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFrameworksByCompany", reflect.TypeOf((*MockCompetencyRepo)(nil).GetFrameworksByCompany), ctx, companyID)
+}
+
+// GetLevelsByFramework mocks base method.
+func (m *MockCompetencyRepo) GetLevelsByFramework(ctx context.Context, frameworkID int64) ([]entity.CompetencyLevel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLevelsByFramework", ctx, frameworkID)
+	ret0, _ := ret[0].([]entity.CompetencyLevel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLevelsByFramework indicates an expected call of GetLevelsByFramework.
+func (mr *MockCompetencyRepoMockRecorder) GetLevelsByFramework(ctx, frameworkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLevelsByFramework", reflect.TypeOf((*MockCompetencyRepo)(nil).GetLevelsByFramework), ctx, frameworkID)
+}
+
+// GetNoteCompetencies mocks base method.
+func (m *MockCompetencyRepo) GetNoteCompetencies(ctx context.Context, noteID int64) ([]entity.Competency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoteCompetencies", ctx, noteID)
+	ret0, _ := ret[0].([]entity.Competency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoteCompetencies indicates an expected call of GetNoteCompetencies.
+func (mr *MockCompetencyRepoMockRecorder) GetNoteCompetencies(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteCompetencies", reflect.TypeOf((*MockCompetencyRepo)(nil).GetNoteCompetencies), ctx, noteID)
+}
+
+// GetRatingsByAssessment mocks base method.
+func (m *MockCompetencyRepo) GetRatingsByAssessment(ctx context.Context, assessmentID int64) ([]entity.CompetencyRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRatingsByAssessment", ctx, assessmentID)
+	ret0, _ := ret[0].([]entity.CompetencyRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRatingsByAssessment indicates an expected call of GetRatingsByAssessment.
+func (mr *MockCompetencyRepoMockRecorder) GetRatingsByAssessment(ctx, assessmentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRatingsByAssessment", reflect.TypeOf((*MockCompetencyRepo)(nil).GetRatingsByAssessment), ctx, assessmentID)
+}
+
+// MockTagRepo is a mock of TagRepo interface.
+type MockTagRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockTagRepoMockRecorder is the mock recorder for MockTagRepo.
+type MockTagRepoMockRecorder struct {
+	mock *MockTagRepo
+}
+
+// NewMockTagRepo creates a new mock instance.
+func NewMockTagRepo(ctrl *gomock.Controller) *MockTagRepo {
+	mock := &MockTagRepo{ctrl: ctrl}
+	mock.recorder = &MockTagRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagRepo) EXPECT() *MockTagRepoMockRecorder {
+	return m.recorder
+}
+
+// AddNoteTag mocks base method.
+func (m *MockTagRepo) AddNoteTag(ctx context.Context, noteID, tagID int64, source string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNoteTag", ctx, noteID, tagID, source)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddNoteTag indicates an expected call of AddNoteTag.
+func (mr *MockTagRepoMockRecorder) AddNoteTag(ctx, noteID, tagID, source any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNoteTag", reflect.TypeOf((*MockTagRepo)(nil).AddNoteTag), ctx, noteID, tagID, source)
+}
+
+// CreateTag mocks base method.
+func (m *MockTagRepo) CreateTag(ctx context.Context, tag entity.Tag) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTag", ctx, tag)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockTagRepoMockRecorder) CreateTag(ctx, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockTagRepo)(nil).CreateTag), ctx, tag)
+}
+
+// DeleteNoteTags mocks base method.
+func (m *MockTagRepo) DeleteNoteTags(ctx context.Context, noteID int64, source string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNoteTags", ctx, noteID, source)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNoteTags indicates an expected call of DeleteNoteTags.
+func (mr *MockTagRepoMockRecorder) DeleteNoteTags(ctx, noteID, source any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNoteTags", reflect.TypeOf((*MockTagRepo)(nil).DeleteNoteTags), ctx, noteID, source)
+}
+
+// DeleteTag mocks base method.
+func (m *MockTagRepo) DeleteTag(ctx context.Context, tagID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, tagID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockTagRepoMockRecorder) DeleteTag(ctx, tagID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockTagRepo)(nil).DeleteTag), ctx, tagID)
+}
+
+// GetNoteTags mocks base method.
+func (m *MockTagRepo) GetNoteTags(ctx context.Context, noteID int64) ([]entity.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoteTags", ctx, noteID)
+	ret0, _ := ret[0].([]entity.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoteTags indicates an expected call of GetNoteTags.
+func (mr *MockTagRepoMockRecorder) GetNoteTags(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteTags", reflect.TypeOf((*MockTagRepo)(nil).GetNoteTags), ctx, noteID)
+}
+
+// GetOrCreateTag mocks base method.
+func (m *MockTagRepo) GetOrCreateTag(ctx context.Context, tag entity.Tag) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrCreateTag", ctx, tag)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrCreateTag indicates an expected call of GetOrCreateTag.
+func (mr *MockTagRepoMockRecorder) GetOrCreateTag(ctx, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrCreateTag", reflect.TypeOf((*MockTagRepo)(nil).GetOrCreateTag), ctx, tag)
+}
+
+// GetTagByName mocks base method.
+func (m *MockTagRepo) GetTagByName(ctx context.Context, companyID int64, name string) (entity.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagByName", ctx, companyID, name)
+	ret0, _ := ret[0].(entity.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagByName indicates an expected call of GetTagByName.
+func (mr *MockTagRepoMockRecorder) GetTagByName(ctx, companyID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagByName", reflect.TypeOf((*MockTagRepo)(nil).GetTagByName), ctx, companyID, name)
+}
+
+// GetTagByUUID mocks base method.
+func (m *MockTagRepo) GetTagByUUID(ctx context.Context, tagUUID string) (entity.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagByUUID", ctx, tagUUID)
+	ret0, _ := ret[0].(entity.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagByUUID indicates an expected call of GetTagByUUID.
+func (mr *MockTagRepoMockRecorder) GetTagByUUID(ctx, tagUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagByUUID", reflect.TypeOf((*MockTagRepo)(nil).GetTagByUUID), ctx, tagUUID)
+}
+
+// GetTagCountsByPerson mocks base method.
+func (m *MockTagRepo) GetTagCountsByPerson(ctx context.Context, companyID int64, filters entity.TagAnalyticsFilters) ([]entity.TagPersonCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagCountsByPerson", ctx, companyID, filters)
+	ret0, _ := ret[0].([]entity.TagPersonCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagCountsByPerson indicates an expected call of GetTagCountsByPerson.
+func (mr *MockTagRepoMockRecorder) GetTagCountsByPerson(ctx, companyID, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagCountsByPerson", reflect.TypeOf((*MockTagRepo)(nil).GetTagCountsByPerson), ctx, companyID, filters)
+}
+
+// GetTagCountsOverTime mocks base method.
+func (m *MockTagRepo) GetTagCountsOverTime(ctx context.Context, companyID int64, filters entity.TagAnalyticsFilters) ([]entity.TagPeriodCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagCountsOverTime", ctx, companyID, filters)
+	ret0, _ := ret[0].([]entity.TagPeriodCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagCountsOverTime indicates an expected call of GetTagCountsOverTime.
+func (mr *MockTagRepoMockRecorder) GetTagCountsOverTime(ctx, companyID, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagCountsOverTime", reflect.TypeOf((*MockTagRepo)(nil).GetTagCountsOverTime), ctx, companyID, filters)
+}
+
+// GetTagNamesByNotes mocks base method.
+func (m *MockTagRepo) GetTagNamesByNotes(ctx context.Context, noteUUIDs []string) (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagNamesByNotes", ctx, noteUUIDs)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagNamesByNotes indicates an expected call of GetTagNamesByNotes.
+func (mr *MockTagRepoMockRecorder) GetTagNamesByNotes(ctx, noteUUIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagNamesByNotes", reflect.TypeOf((*MockTagRepo)(nil).GetTagNamesByNotes), ctx, noteUUIDs)
+}
+
+// GetTagsByCompany mocks base method.
+func (m *MockTagRepo) GetTagsByCompany(ctx context.Context, companyID int64) ([]entity.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagsByCompany", ctx, companyID)
+	ret0, _ := ret[0].([]entity.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagsByCompany indicates an expected call of GetTagsByCompany.
+func (mr *MockTagRepoMockRecorder) GetTagsByCompany(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagsByCompany", reflect.TypeOf((*MockTagRepo)(nil).GetTagsByCompany), ctx, companyID)
+}
+
+// UpdateTag mocks base method.
+func (m *MockTagRepo) UpdateTag(ctx context.Context, tagID int64, tag entity.Tag) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTag", ctx, tagID, tag)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTag indicates an expected call of UpdateTag.
+func (mr *MockTagRepoMockRecorder) UpdateTag(ctx, tagID, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTag", reflect.TypeOf((*MockTagRepo)(nil).UpdateTag), ctx, tagID, tag)
+}
+
+// MockActivityRepo is a mock of ActivityRepo interface.
+type MockActivityRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityRepoMockRecorder is the mock recorder for MockActivityRepo.
+type MockActivityRepoMockRecorder struct {
+	mock *MockActivityRepo
+}
+
+// NewMockActivityRepo creates a new mock instance.
+func NewMockActivityRepo(ctrl *gomock.Controller) *MockActivityRepo {
+	mock := &MockActivityRepo{ctrl: ctrl}
+	mock.recorder = &MockActivityRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityRepo) EXPECT() *MockActivityRepoMockRecorder {
+	return m.recorder
+}
+
+// CountActivitiesAfter mocks base method.
+func (m *MockActivityRepo) CountActivitiesAfter(ctx context.Context, companyID, activityID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActivitiesAfter", ctx, companyID, activityID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActivitiesAfter indicates an expected call of CountActivitiesAfter.
+func (mr *MockActivityRepoMockRecorder) CountActivitiesAfter(ctx, companyID, activityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActivitiesAfter", reflect.TypeOf((*MockActivityRepo)(nil).CountActivitiesAfter), ctx, companyID, activityID)
+}
+
+// CreateActivity mocks base method.
+func (m *MockActivityRepo) CreateActivity(ctx context.Context, activity entity.Activity) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateActivity", ctx, activity)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateActivity indicates an expected call of CreateActivity.
+func (mr *MockActivityRepoMockRecorder) CreateActivity(ctx, activity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateActivity", reflect.TypeOf((*MockActivityRepo)(nil).CreateActivity), ctx, activity)
+}
+
+// GetActivities mocks base method.
+func (m *MockActivityRepo) GetActivities(ctx context.Context, query entity.ActivityQuery, page entity.CursorPage) ([]entity.Activity, entity.CursorPageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivities", ctx, query, page)
+	ret0, _ := ret[0].([]entity.Activity)
+	ret1, _ := ret[1].(entity.CursorPageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetActivities indicates an expected call of GetActivities.
+func (mr *MockActivityRepoMockRecorder) GetActivities(ctx, query, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivities", reflect.TypeOf((*MockActivityRepo)(nil).GetActivities), ctx, query, page)
+}
+
+// GetActivityByUUID mocks base method.
+func (m *MockActivityRepo) GetActivityByUUID(ctx context.Context, activityUUID string) (entity.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityByUUID", ctx, activityUUID)
+	ret0, _ := ret[0].(entity.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityByUUID indicates an expected call of GetActivityByUUID.
+func (mr *MockActivityRepoMockRecorder) GetActivityByUUID(ctx, activityUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityByUUID", reflect.TypeOf((*MockActivityRepo)(nil).GetActivityByUUID), ctx, activityUUID)
+}
+
+// GetLastSeenActivityID mocks base method.
+func (m *MockActivityRepo) GetLastSeenActivityID(ctx context.Context, userID, companyID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastSeenActivityID", ctx, userID, companyID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastSeenActivityID indicates an expected call of GetLastSeenActivityID.
+func (mr *MockActivityRepoMockRecorder) GetLastSeenActivityID(ctx, userID, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastSeenActivityID", reflect.TypeOf((*MockActivityRepo)(nil).GetLastSeenActivityID), ctx, userID, companyID)
+}
+
+// GetLatestActivityID mocks base method.
+func (m *MockActivityRepo) GetLatestActivityID(ctx context.Context, companyID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestActivityID", ctx, companyID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestActivityID indicates an expected call of GetLatestActivityID.
+func (mr *MockActivityRepoMockRecorder) GetLatestActivityID(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestActivityID", reflect.TypeOf((*MockActivityRepo)(nil).GetLatestActivityID), ctx, companyID)
+}
+
+// SetLastSeenActivityID mocks base method.
+func (m *MockActivityRepo) SetLastSeenActivityID(ctx context.Context, userID, companyID, activityID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLastSeenActivityID", ctx, userID, companyID, activityID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLastSeenActivityID indicates an expected call of SetLastSeenActivityID.
+func (mr *MockActivityRepoMockRecorder) SetLastSeenActivityID(ctx, userID, companyID, activityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLastSeenActivityID", reflect.TypeOf((*MockActivityRepo)(nil).SetLastSeenActivityID), ctx, userID, companyID, activityID)
+}
+
+// MockSCIMRepo is a mock of SCIMRepo interface.
+type MockSCIMRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockSCIMRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockSCIMRepoMockRecorder is the mock recorder for MockSCIMRepo.
+type MockSCIMRepoMockRecorder struct {
+	mock *MockSCIMRepo
+}
+
+// NewMockSCIMRepo creates a new mock instance.
+func NewMockSCIMRepo(ctrl *gomock.Controller) *MockSCIMRepo {
+	mock := &MockSCIMRepo{ctrl: ctrl}
+	mock.recorder = &MockSCIMRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSCIMRepo) EXPECT() *MockSCIMRepoMockRecorder {
+	return m.recorder
+}
+
+// CreatePersonSync mocks base method.
+func (m *MockSCIMRepo) CreatePersonSync(ctx context.Context, personSync entity.PersonSync) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePersonSync", ctx, personSync)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePersonSync indicates an expected call of CreatePersonSync.
+func (mr *MockSCIMRepoMockRecorder) CreatePersonSync(ctx, personSync any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePersonSync", reflect.TypeOf((*MockSCIMRepo)(nil).CreatePersonSync), ctx, personSync)
+}
+
+// CreateSCIMGroup mocks base method.
+func (m *MockSCIMRepo) CreateSCIMGroup(ctx context.Context, group entity.SCIMGroup) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSCIMGroup", ctx, group)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSCIMGroup indicates an expected call of CreateSCIMGroup.
+func (mr *MockSCIMRepoMockRecorder) CreateSCIMGroup(ctx, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSCIMGroup", reflect.TypeOf((*MockSCIMRepo)(nil).CreateSCIMGroup), ctx, group)
+}
+
+// DeleteSCIMGroup mocks base method.
+func (m *MockSCIMRepo) DeleteSCIMGroup(ctx context.Context, groupID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSCIMGroup", ctx, groupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSCIMGroup indicates an expected call of DeleteSCIMGroup.
+func (mr *MockSCIMRepoMockRecorder) DeleteSCIMGroup(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSCIMGroup", reflect.TypeOf((*MockSCIMRepo)(nil).DeleteSCIMGroup), ctx, groupID)
+}
+
+// DeleteSCIMToken mocks base method.
+func (m *MockSCIMRepo) DeleteSCIMToken(ctx context.Context, companyID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSCIMToken", ctx, companyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSCIMToken indicates an expected call of DeleteSCIMToken.
+func (mr *MockSCIMRepoMockRecorder) DeleteSCIMToken(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSCIMToken", reflect.TypeOf((*MockSCIMRepo)(nil).DeleteSCIMToken), ctx, companyID)
+}
+
+// GetPendingManagerSyncs mocks base method.
+func (m *MockSCIMRepo) GetPendingManagerSyncs(ctx context.Context, companyID int64, managerRefs []string) ([]entity.PersonSync, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingManagerSyncs", ctx, companyID, managerRefs)
+	ret0, _ := ret[0].([]entity.PersonSync)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingManagerSyncs indicates an expected call of GetPendingManagerSyncs.
+func (mr *MockSCIMRepoMockRecorder) GetPendingManagerSyncs(ctx, companyID, managerRefs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingManagerSyncs", reflect.TypeOf((*MockSCIMRepo)(nil).GetPendingManagerSyncs), ctx, companyID, managerRefs)
+}
+
+// GetPersonSyncByExternalID mocks base method.
+func (m *MockSCIMRepo) GetPersonSyncByExternalID(ctx context.Context, companyID int64, externalID string) (entity.PersonSync, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonSyncByExternalID", ctx, companyID, externalID)
+	ret0, _ := ret[0].(entity.PersonSync)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonSyncByExternalID indicates an expected call of GetPersonSyncByExternalID.
+func (mr *MockSCIMRepoMockRecorder) GetPersonSyncByExternalID(ctx, companyID, externalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonSyncByExternalID", reflect.TypeOf((*MockSCIMRepo)(nil).GetPersonSyncByExternalID), ctx, companyID, externalID)
+}
+
+// GetPersonSyncByPersonID mocks base method.
+func (m *MockSCIMRepo) GetPersonSyncByPersonID(ctx context.Context, personID int64) (entity.PersonSync, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonSyncByPersonID", ctx, personID)
+	ret0, _ := ret[0].(entity.PersonSync)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonSyncByPersonID indicates an expected call of GetPersonSyncByPersonID.
+func (mr *MockSCIMRepoMockRecorder) GetPersonSyncByPersonID(ctx, personID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonSyncByPersonID", reflect.TypeOf((*MockSCIMRepo)(nil).GetPersonSyncByPersonID), ctx, personID)
+}
+
+// GetSCIMGroupByExternalID mocks base method.
+func (m *MockSCIMRepo) GetSCIMGroupByExternalID(ctx context.Context, companyID int64, externalID string) (entity.SCIMGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMGroupByExternalID", ctx, companyID, externalID)
+	ret0, _ := ret[0].(entity.SCIMGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSCIMGroupByExternalID indicates an expected call of GetSCIMGroupByExternalID.
+func (mr *MockSCIMRepoMockRecorder) GetSCIMGroupByExternalID(ctx, companyID, externalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMGroupByExternalID", reflect.TypeOf((*MockSCIMRepo)(nil).GetSCIMGroupByExternalID), ctx, companyID, externalID)
+}
+
+// GetSCIMGroupByUUID mocks base method.
+func (m *MockSCIMRepo) GetSCIMGroupByUUID(ctx context.Context, companyID int64, groupUUID string) (entity.SCIMGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMGroupByUUID", ctx, companyID, groupUUID)
+	ret0, _ := ret[0].(entity.SCIMGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSCIMGroupByUUID indicates an expected call of GetSCIMGroupByUUID.
+func (mr *MockSCIMRepoMockRecorder) GetSCIMGroupByUUID(ctx, companyID, groupUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMGroupByUUID", reflect.TypeOf((*MockSCIMRepo)(nil).GetSCIMGroupByUUID), ctx, companyID, groupUUID)
+}
+
+// GetSCIMGroupMembers mocks base method.
+func (m *MockSCIMRepo) GetSCIMGroupMembers(ctx context.Context, groupID int64) ([]entity.SCIMGroupMember, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMGroupMembers", ctx, groupID)
+	ret0, _ := ret[0].([]entity.SCIMGroupMember)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSCIMGroupMembers indicates an expected call of GetSCIMGroupMembers.
+func (mr *MockSCIMRepoMockRecorder) GetSCIMGroupMembers(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMGroupMembers", reflect.TypeOf((*MockSCIMRepo)(nil).GetSCIMGroupMembers), ctx, groupID)
+}
+
+// GetSCIMGroups mocks base method.
+func (m *MockSCIMRepo) GetSCIMGroups(ctx context.Context, companyID int64, filter *entity.SCIMFilter, take, skip int64) ([]entity.SCIMGroup, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMGroups", ctx, companyID, filter, take, skip)
+	ret0, _ := ret[0].([]entity.SCIMGroup)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSCIMGroups indicates an expected call of GetSCIMGroups.
+func (mr *MockSCIMRepoMockRecorder) GetSCIMGroups(ctx, companyID, filter, take, skip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMGroups", reflect.TypeOf((*MockSCIMRepo)(nil).GetSCIMGroups), ctx, companyID, filter, take, skip)
+}
+
+// GetSCIMTokenHash mocks base method.
+func (m *MockSCIMRepo) GetSCIMTokenHash(ctx context.Context, companyID int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMTokenHash", ctx, companyID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSCIMTokenHash indicates an expected call of GetSCIMTokenHash.
+func (mr *MockSCIMRepoMockRecorder) GetSCIMTokenHash(ctx, companyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMTokenHash", reflect.TypeOf((*MockSCIMRepo)(nil).GetSCIMTokenHash), ctx, companyID)
+}
+
+// GetSCIMUserByPersonID mocks base method.
+func (m *MockSCIMRepo) GetSCIMUserByPersonID(ctx context.Context, personID int64) (entity.SCIMUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMUserByPersonID", ctx, personID)
+	ret0, _ := ret[0].(entity.SCIMUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSCIMUserByPersonID indicates an expected call of GetSCIMUserByPersonID.
+func (mr *MockSCIMRepoMockRecorder) GetSCIMUserByPersonID(ctx, personID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMUserByPersonID", reflect.TypeOf((*MockSCIMRepo)(nil).GetSCIMUserByPersonID), ctx, personID)
+}
+
+// GetSCIMUserByPersonUUID mocks base method.
+func (m *MockSCIMRepo) GetSCIMUserByPersonUUID(ctx context.Context, companyID int64, personUUID string) (entity.SCIMUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMUserByPersonUUID", ctx, companyID, personUUID)
+	ret0, _ := ret[0].(entity.SCIMUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSCIMUserByPersonUUID indicates an expected call of GetSCIMUserByPersonUUID.
+func (mr *MockSCIMRepoMockRecorder) GetSCIMUserByPersonUUID(ctx, companyID, personUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMUserByPersonUUID", reflect.TypeOf((*MockSCIMRepo)(nil).GetSCIMUserByPersonUUID), ctx, companyID, personUUID)
+}
+
+// GetSCIMUsers mocks base method.
+func (m *MockSCIMRepo) GetSCIMUsers(ctx context.Context, companyID int64, filter *entity.SCIMFilter, take, skip int64) ([]entity.SCIMUser, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMUsers", ctx, companyID, filter, take, skip)
+	ret0, _ := ret[0].([]entity.SCIMUser)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSCIMUsers indicates an expected call of GetSCIMUsers.
+func (mr *MockSCIMRepoMockRecorder) GetSCIMUsers(ctx, companyID, filter, take, skip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMUsers", reflect.TypeOf((*MockSCIMRepo)(nil).GetSCIMUsers), ctx, companyID, filter, take, skip)
+}
+
+// GetUnsyncedPersonIDByEmail mocks base method.
+func (m *MockSCIMRepo) GetUnsyncedPersonIDByEmail(ctx context.Context, companyID int64, email string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnsyncedPersonIDByEmail", ctx, companyID, email)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnsyncedPersonIDByEmail indicates an expected call of GetUnsyncedPersonIDByEmail.
+func (mr *MockSCIMRepoMockRecorder) GetUnsyncedPersonIDByEmail(ctx, companyID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnsyncedPersonIDByEmail", reflect.TypeOf((*MockSCIMRepo)(nil).GetUnsyncedPersonIDByEmail), ctx, companyID, email)
+}
+
+// ReplaceSCIMGroupMembers mocks base method.
+func (m *MockSCIMRepo) ReplaceSCIMGroupMembers(ctx context.Context, groupID int64, personIDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceSCIMGroupMembers", ctx, groupID, personIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceSCIMGroupMembers indicates an expected call of ReplaceSCIMGroupMembers.
+func (mr *MockSCIMRepoMockRecorder) ReplaceSCIMGroupMembers(ctx, groupID, personIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceSCIMGroupMembers", reflect.TypeOf((*MockSCIMRepo)(nil).ReplaceSCIMGroupMembers), ctx, groupID, personIDs)
+}
+
+// SaveSCIMToken mocks base method.
+func (m *MockSCIMRepo) SaveSCIMToken(ctx context.Context, companyID int64, tokenHash string, createdBy int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSCIMToken", ctx, companyID, tokenHash, createdBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSCIMToken indicates an expected call of SaveSCIMToken.
+func (mr *MockSCIMRepoMockRecorder) SaveSCIMToken(ctx, companyID, tokenHash, createdBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSCIMToken", reflect.TypeOf((*MockSCIMRepo)(nil).SaveSCIMToken), ctx, companyID, tokenHash, createdBy)
+}
+
+// UpdatePersonSync mocks base method.
+func (m *MockSCIMRepo) UpdatePersonSync(ctx context.Context, personSync entity.PersonSync) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePersonSync", ctx, personSync)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePersonSync indicates an expected call of UpdatePersonSync.
+func (mr *MockSCIMRepoMockRecorder) UpdatePersonSync(ctx, personSync any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePersonSync", reflect.TypeOf((*MockSCIMRepo)(nil).UpdatePersonSync), ctx, personSync)
+}
+
+// UpdateSCIMGroup mocks base method.
+func (m *MockSCIMRepo) UpdateSCIMGroup(ctx context.Context, groupID int64, group entity.SCIMGroup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSCIMGroup", ctx, groupID, group)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSCIMGroup indicates an expected call of UpdateSCIMGroup.
+func (mr *MockSCIMRepoMockRecorder) UpdateSCIMGroup(ctx, groupID, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSCIMGroup", reflect.TypeOf((*MockSCIMRepo)(nil).UpdateSCIMGroup), ctx, groupID, group)
+}
+
+// MockNoteTemplateRepo is a mock of NoteTemplateRepo interface.
+type MockNoteTemplateRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteTemplateRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockNoteTemplateRepoMockRecorder is the mock recorder for MockNoteTemplateRepo.
+type MockNoteTemplateRepoMockRecorder struct {
+	mock *MockNoteTemplateRepo
+}
+
+// NewMockNoteTemplateRepo creates a new mock instance.
+func NewMockNoteTemplateRepo(ctrl *gomock.Controller) *MockNoteTemplateRepo {
+	mock := &MockNoteTemplateRepo{ctrl: ctrl}
+	mock.recorder = &MockNoteTemplateRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNoteTemplateRepo) EXPECT() *MockNoteTemplateRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateSection mocks base method.
+func (m *MockNoteTemplateRepo) CreateSection(ctx context.Context, section entity.NoteTemplateSection) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSection", ctx, section)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSection indicates an expected call of CreateSection.
+func (mr *MockNoteTemplateRepoMockRecorder) CreateSection(ctx, section any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSection", reflect.TypeOf((*MockNoteTemplateRepo)(nil).CreateSection), ctx, section)
+}
+
+// CreateTemplate mocks base method.
+func (m *MockNoteTemplateRepo) CreateTemplate(ctx context.Context, template entity.NoteTemplate) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplate", ctx, template)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplate indicates an expected call of CreateTemplate.
+func (mr *MockNoteTemplateRepoMockRecorder) CreateTemplate(ctx, template any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockNoteTemplateRepo)(nil).CreateTemplate), ctx, template)
+}
+
+// DeleteTemplate mocks base method.
+func (m *MockNoteTemplateRepo) DeleteTemplate(ctx context.Context, templateID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplate", ctx, templateID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplate indicates an expected call of DeleteTemplate.
+func (mr *MockNoteTemplateRepoMockRecorder) DeleteTemplate(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockNoteTemplateRepo)(nil).DeleteTemplate), ctx, templateID)
+}
+
+// GetAvailableTemplates mocks base method.
+func (m *MockNoteTemplateRepo) GetAvailableTemplates(ctx context.Context, companyID, userID int64) ([]entity.NoteTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailableTemplates", ctx, companyID, userID)
+	ret0, _ := ret[0].([]entity.NoteTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailableTemplates indicates an expected call of GetAvailableTemplates.
+func (mr *MockNoteTemplateRepoMockRecorder) GetAvailableTemplates(ctx, companyID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableTemplates", reflect.TypeOf((*MockNoteTemplateRepo)(nil).GetAvailableTemplates), ctx, companyID, userID)
+}
+
+// GetSectionsByTemplate mocks base method.
+func (m *MockNoteTemplateRepo) GetSectionsByTemplate(ctx context.Context, templateID int64) ([]entity.NoteTemplateSection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSectionsByTemplate", ctx, templateID)
+	ret0, _ := ret[0].([]entity.NoteTemplateSection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSectionsByTemplate indicates an expected call of GetSectionsByTemplate.
+func (mr *MockNoteTemplateRepoMockRecorder) GetSectionsByTemplate(ctx, templateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSectionsByTemplate", reflect.TypeOf((*MockNoteTemplateRepo)(nil).GetSectionsByTemplate), ctx, templateID)
+}
+
+// GetTemplateByUUID mocks base method.
+func (m *MockNoteTemplateRepo) GetTemplateByUUID(ctx context.Context, templateUUID string) (entity.NoteTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplateByUUID", ctx, templateUUID)
+	ret0, _ := ret[0].(entity.NoteTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplateByUUID indicates an expected call of GetTemplateByUUID.
+func (mr *MockNoteTemplateRepoMockRecorder) GetTemplateByUUID(ctx, templateUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByUUID", reflect.TypeOf((*MockNoteTemplateRepo)(nil).GetTemplateByUUID), ctx, templateUUID)
+}
+
+// MockOutboxRepo is a mock of OutboxRepo interface.
+type MockOutboxRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxRepoMockRecorder is the mock recorder for MockOutboxRepo.
+type MockOutboxRepoMockRecorder struct {
+	mock *MockOutboxRepo
+}
+
+// NewMockOutboxRepo creates a new mock instance.
+func NewMockOutboxRepo(ctrl *gomock.Controller) *MockOutboxRepo {
+	mock := &MockOutboxRepo{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepo) EXPECT() *MockOutboxRepoMockRecorder {
+	return m.recorder
+}
+
+// ClaimEvent mocks base method.
+func (m *MockOutboxRepo) ClaimEvent(ctx context.Context, event entity.OutboxEvent, now, leaseUntil time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimEvent", ctx, event, now, leaseUntil)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClaimEvent indicates an expected call of ClaimEvent.
+func (mr *MockOutboxRepoMockRecorder) ClaimEvent(ctx, event, now, leaseUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimEvent", reflect.TypeOf((*MockOutboxRepo)(nil).ClaimEvent), ctx, event, now, leaseUntil)
+}
+
+// CreateEvent mocks base method.
+func (m *MockOutboxRepo) CreateEvent(ctx context.Context, event entity.OutboxEvent) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvent", ctx, event)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvent indicates an expected call of CreateEvent.
+func (mr *MockOutboxRepoMockRecorder) CreateEvent(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockOutboxRepo)(nil).CreateEvent), ctx, event)
+}
+
+// GetDueEvents mocks base method.
+func (m *MockOutboxRepo) GetDueEvents(ctx context.Context, now time.Time, limit int) ([]entity.OutboxEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueEvents", ctx, now, limit)
+	ret0, _ := ret[0].([]entity.OutboxEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueEvents indicates an expected call of GetDueEvents.
+func (mr *MockOutboxRepoMockRecorder) GetDueEvents(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueEvents", reflect.TypeOf((*MockOutboxRepo)(nil).GetDueEvents), ctx, now, limit)
+}
+
+// MarkEventDone mocks base method.
+func (m *MockOutboxRepo) MarkEventDone(ctx context.Context, eventID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEventDone", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEventDone indicates an expected call of MarkEventDone.
+func (mr *MockOutboxRepoMockRecorder) MarkEventDone(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEventDone", reflect.TypeOf((*MockOutboxRepo)(nil).MarkEventDone), ctx, eventID)
+}
+
+// MarkEventFailed mocks base method.
+func (m *MockOutboxRepo) MarkEventFailed(ctx context.Context, eventID int64, lastError string, nextAttemptAt *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEventFailed", ctx, eventID, lastError, nextAttemptAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEventFailed indicates an expected call of MarkEventFailed.
+func (mr *MockOutboxRepoMockRecorder) MarkEventFailed(ctx, eventID, lastError, nextAttemptAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEventFailed", reflect.TypeOf((*MockOutboxRepo)(nil).MarkEventFailed), ctx, eventID, lastError, nextAttemptAt)
+}
+
+// MockAIRepo is a mock of AIRepo interface.
+type MockAIRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockAIRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockAIRepoMockRecorder is the mock recorder for MockAIRepo.
+type MockAIRepoMockRecorder struct {
+	mock *MockAIRepo
+}
+
+// NewMockAIRepo creates a new mock instance.
+func NewMockAIRepo(ctrl *gomock.Controller) *MockAIRepo {
+	mock := &MockAIRepo{ctrl: ctrl}
+	mock.recorder = &MockAIRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAIRepo) EXPECT() *MockAIRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateConversation mocks base method.
+func (m *MockAIRepo) CreateConversation(ctx context.Context, conversation entity.AIConversation) (entity.AIConversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConversation", ctx, conversation)
+	ret0, _ := ret[0].(entity.AIConversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateConversation indicates an expected call of CreateConversation.
+func (mr *MockAIRepoMockRecorder) CreateConversation(ctx, conversation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConversation", reflect.TypeOf((*MockAIRepo)(nil).CreateConversation), ctx, conversation)
+}
+
+// CreateUsage mocks base method.
+func (m *MockAIRepo) CreateUsage(ctx context.Context, usage entity.AIUsageTracker) (entity.AIUsageTracker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUsage", ctx, usage)
+	ret0, _ := ret[0].(entity.AIUsageTracker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUsage indicates an expected call of CreateUsage.
+func (mr *MockAIRepoMockRecorder) CreateUsage(ctx, usage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUsage", reflect.TypeOf((*MockAIRepo)(nil).CreateUsage), ctx, usage)
+}
+
+// DeleteExpiredConversations mocks base method.
+func (m *MockAIRepo) DeleteExpiredConversations(ctx context.Context, now time.Time, limit int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredConversations", ctx, now, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpiredConversations indicates an expected call of DeleteExpiredConversations.
+func (mr *MockAIRepoMockRecorder) DeleteExpiredConversations(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredConversations", reflect.TypeOf((*MockAIRepo)(nil).DeleteExpiredConversations), ctx, now, limit)
 }
 
 // GetActivePromptByType mocks base method.
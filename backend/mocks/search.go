@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/contract/search.go
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -source=internal/domain/contract/search.go -destination=mocks/search.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/diegoclair/leaderpro/internal/domain/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockNoteSearchEngine is a mock of NoteSearchEngine interface.
+type MockNoteSearchEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteSearchEngineMockRecorder
+	isgomock struct{}
+}
+
+// MockNoteSearchEngineMockRecorder is the mock recorder for MockNoteSearchEngine.
+type MockNoteSearchEngineMockRecorder struct {
+	mock *MockNoteSearchEngine
+}
+
+// NewMockNoteSearchEngine creates a new mock instance.
+func NewMockNoteSearchEngine(ctrl *gomock.Controller) *MockNoteSearchEngine {
+	mock := &MockNoteSearchEngine{ctrl: ctrl}
+	mock.recorder = &MockNoteSearchEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNoteSearchEngine) EXPECT() *MockNoteSearchEngineMockRecorder {
+	return m.recorder
+}
+
+// SearchNotes mocks base method.
+func (m *MockNoteSearchEngine) SearchNotes(ctx context.Context, query entity.NoteSearchQuery) ([]entity.NoteSearchHit, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchNotes", ctx, query)
+	ret0, _ := ret[0].([]entity.NoteSearchHit)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchNotes indicates an expected call of SearchNotes.
+func (mr *MockNoteSearchEngineMockRecorder) SearchNotes(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNotes", reflect.TypeOf((*MockNoteSearchEngine)(nil).SearchNotes), ctx, query)
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	dto "github.com/diegoclair/leaderpro/internal/application/dto"
 	entity "github.com/diegoclair/leaderpro/internal/domain/entity"
@@ -539,6 +540,20 @@ func (mr *MockPersonAppMockRecorder) DeletePerson(ctx, personUUID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePerson", reflect.TypeOf((*MockPersonApp)(nil).DeletePerson), ctx, personUUID)
 }
 
+// DeletePersonAttribute mocks base method.
+func (m *MockPersonApp) DeletePersonAttribute(ctx context.Context, personUUID, attributeKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePersonAttribute", ctx, personUUID, attributeKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePersonAttribute indicates an expected call of DeletePersonAttribute.
+func (mr *MockPersonAppMockRecorder) DeletePersonAttribute(ctx, personUUID, attributeKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePersonAttribute", reflect.TypeOf((*MockPersonApp)(nil).DeletePersonAttribute), ctx, personUUID, attributeKey)
+}
+
 // GetCompanyPeople mocks base method.
 func (m *MockPersonApp) GetCompanyPeople(ctx context.Context) ([]entity.Person, error) {
 	m.ctrl.T.Helper()
@@ -554,6 +569,81 @@ func (mr *MockPersonAppMockRecorder) GetCompanyPeople(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyPeople", reflect.TypeOf((*MockPersonApp)(nil).GetCompanyPeople), ctx)
 }
 
+// GetNoteRevisionDiff mocks base method.
+func (m *MockPersonApp) GetNoteRevisionDiff(ctx context.Context, noteUUID string, from, to int) (entity.NoteRevisionDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoteRevisionDiff", ctx, noteUUID, from, to)
+	ret0, _ := ret[0].(entity.NoteRevisionDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoteRevisionDiff indicates an expected call of GetNoteRevisionDiff.
+func (mr *MockPersonAppMockRecorder) GetNoteRevisionDiff(ctx, noteUUID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteRevisionDiff", reflect.TypeOf((*MockPersonApp)(nil).GetNoteRevisionDiff), ctx, noteUUID, from, to)
+}
+
+// GetNoteRevisions mocks base method.
+func (m *MockPersonApp) GetNoteRevisions(ctx context.Context, noteUUID string) ([]entity.NoteRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoteRevisions", ctx, noteUUID)
+	ret0, _ := ret[0].([]entity.NoteRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoteRevisions indicates an expected call of GetNoteRevisions.
+func (mr *MockPersonAppMockRecorder) GetNoteRevisions(ctx, noteUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteRevisions", reflect.TypeOf((*MockPersonApp)(nil).GetNoteRevisions), ctx, noteUUID)
+}
+
+// GetPersonAttributeHistory mocks base method.
+func (m *MockPersonApp) GetPersonAttributeHistory(ctx context.Context, personUUID, attributeKey string) ([]entity.PersonAttributeHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonAttributeHistory", ctx, personUUID, attributeKey)
+	ret0, _ := ret[0].([]entity.PersonAttributeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonAttributeHistory indicates an expected call of GetPersonAttributeHistory.
+func (mr *MockPersonAppMockRecorder) GetPersonAttributeHistory(ctx, personUUID, attributeKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonAttributeHistory", reflect.TypeOf((*MockPersonApp)(nil).GetPersonAttributeHistory), ctx, personUUID, attributeKey)
+}
+
+// GetPersonAttributes mocks base method.
+func (m *MockPersonApp) GetPersonAttributes(ctx context.Context, personUUID string) ([]entity.PersonAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonAttributes", ctx, personUUID)
+	ret0, _ := ret[0].([]entity.PersonAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonAttributes indicates an expected call of GetPersonAttributes.
+func (mr *MockPersonAppMockRecorder) GetPersonAttributes(ctx, personUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonAttributes", reflect.TypeOf((*MockPersonApp)(nil).GetPersonAttributes), ctx, personUUID)
+}
+
+// GetPersonAttributesAsOf mocks base method.
+func (m *MockPersonApp) GetPersonAttributesAsOf(ctx context.Context, personUUID string, asOf time.Time) ([]entity.PersonAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonAttributesAsOf", ctx, personUUID, asOf)
+	ret0, _ := ret[0].([]entity.PersonAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonAttributesAsOf indicates an expected call of GetPersonAttributesAsOf.
+func (mr *MockPersonAppMockRecorder) GetPersonAttributesAsOf(ctx, personUUID, asOf any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonAttributesAsOf", reflect.TypeOf((*MockPersonApp)(nil).GetPersonAttributesAsOf), ctx, personUUID, asOf)
+}
+
 // GetPersonByUUID mocks base method.
 func (m *MockPersonApp) GetPersonByUUID(ctx context.Context, personUUID string) (entity.Person, error) {
 	m.ctrl.T.Helper()
@@ -569,6 +659,21 @@ func (mr *MockPersonAppMockRecorder) GetPersonByUUID(ctx, personUUID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonByUUID", reflect.TypeOf((*MockPersonApp)(nil).GetPersonByUUID), ctx, personUUID)
 }
 
+// GetPersonImport mocks base method.
+func (m *MockPersonApp) GetPersonImport(ctx context.Context, importUUID string) (entity.PersonImport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonImport", ctx, importUUID)
+	ret0, _ := ret[0].(entity.PersonImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonImport indicates an expected call of GetPersonImport.
+func (mr *MockPersonAppMockRecorder) GetPersonImport(ctx, importUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonImport", reflect.TypeOf((*MockPersonApp)(nil).GetPersonImport), ctx, importUUID)
+}
+
 // GetPersonMentions mocks base method.
 func (m *MockPersonApp) GetPersonMentions(ctx context.Context, personUUID string, take, skip int64) ([]entity.MentionEntry, int64, error) {
 	m.ctrl.T.Helper()
@@ -585,6 +690,22 @@ func (mr *MockPersonAppMockRecorder) GetPersonMentions(ctx, personUUID, take, sk
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonMentions", reflect.TypeOf((*MockPersonApp)(nil).GetPersonMentions), ctx, personUUID, take, skip)
 }
 
+// GetPersonMentionsPage mocks base method.
+func (m *MockPersonApp) GetPersonMentionsPage(ctx context.Context, personUUID string, page entity.CursorPage) ([]entity.MentionEntry, entity.CursorPageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonMentionsPage", ctx, personUUID, page)
+	ret0, _ := ret[0].([]entity.MentionEntry)
+	ret1, _ := ret[1].(entity.CursorPageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPersonMentionsPage indicates an expected call of GetPersonMentionsPage.
+func (mr *MockPersonAppMockRecorder) GetPersonMentionsPage(ctx, personUUID, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonMentionsPage", reflect.TypeOf((*MockPersonApp)(nil).GetPersonMentionsPage), ctx, personUUID, page)
+}
+
 // GetPersonTimeline mocks base method.
 func (m *MockPersonApp) GetPersonTimeline(ctx context.Context, personUUID string, filters entity.TimelineFilters, take, skip int64) ([]entity.UnifiedTimelineEntry, int64, error) {
 	m.ctrl.T.Helper()
@@ -601,6 +722,68 @@ func (mr *MockPersonAppMockRecorder) GetPersonTimeline(ctx, personUUID, filters,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonTimeline", reflect.TypeOf((*MockPersonApp)(nil).GetPersonTimeline), ctx, personUUID, filters, take, skip)
 }
 
+// GetPersonTimelinePage mocks base method.
+func (m *MockPersonApp) GetPersonTimelinePage(ctx context.Context, personUUID string, filters entity.TimelineFilters, page entity.CursorPage) ([]entity.UnifiedTimelineEntry, entity.CursorPageInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonTimelinePage", ctx, personUUID, filters, page)
+	ret0, _ := ret[0].([]entity.UnifiedTimelineEntry)
+	ret1, _ := ret[1].(entity.CursorPageInfo)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPersonTimelinePage indicates an expected call of GetPersonTimelinePage.
+func (mr *MockPersonAppMockRecorder) GetPersonTimelinePage(ctx, personUUID, filters, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonTimelinePage", reflect.TypeOf((*MockPersonApp)(nil).GetPersonTimelinePage), ctx, personUUID, filters, page)
+}
+
+// ImportPeople mocks base method.
+func (m *MockPersonApp) ImportPeople(ctx context.Context, input entity.PersonImportInput) (entity.PersonImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportPeople", ctx, input)
+	ret0, _ := ret[0].(entity.PersonImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportPeople indicates an expected call of ImportPeople.
+func (mr *MockPersonAppMockRecorder) ImportPeople(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportPeople", reflect.TypeOf((*MockPersonApp)(nil).ImportPeople), ctx, input)
+}
+
+// RestoreNoteRevision mocks base method.
+func (m *MockPersonApp) RestoreNoteRevision(ctx context.Context, noteUUID string, number int) (entity.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreNoteRevision", ctx, noteUUID, number)
+	ret0, _ := ret[0].(entity.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreNoteRevision indicates an expected call of RestoreNoteRevision.
+func (mr *MockPersonAppMockRecorder) RestoreNoteRevision(ctx, noteUUID, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreNoteRevision", reflect.TypeOf((*MockPersonApp)(nil).RestoreNoteRevision), ctx, noteUUID, number)
+}
+
+// SearchNotes mocks base method.
+func (m *MockPersonApp) SearchNotes(ctx context.Context, search string, filters entity.NoteSearchFilters, take, skip int64) ([]entity.NoteSearchResult, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchNotes", ctx, search, filters, take, skip)
+	ret0, _ := ret[0].([]entity.NoteSearchResult)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SearchNotes indicates an expected call of SearchNotes.
+func (mr *MockPersonAppMockRecorder) SearchNotes(ctx, search, filters, take, skip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchNotes", reflect.TypeOf((*MockPersonApp)(nil).SearchNotes), ctx, search, filters, take, skip)
+}
+
 // SearchPeople mocks base method.
 func (m *MockPersonApp) SearchPeople(ctx context.Context, search string) ([]entity.Person, error) {
 	m.ctrl.T.Helper()
@@ -616,6 +799,36 @@ func (mr *MockPersonAppMockRecorder) SearchPeople(ctx, search any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPeople", reflect.TypeOf((*MockPersonApp)(nil).SearchPeople), ctx, search)
 }
 
+// SearchPeopleRanked mocks base method.
+func (m *MockPersonApp) SearchPeopleRanked(ctx context.Context, search string, limit int) ([]entity.PersonSearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchPeopleRanked", ctx, search, limit)
+	ret0, _ := ret[0].([]entity.PersonSearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchPeopleRanked indicates an expected call of SearchPeopleRanked.
+func (mr *MockPersonAppMockRecorder) SearchPeopleRanked(ctx, search, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPeopleRanked", reflect.TypeOf((*MockPersonApp)(nil).SearchPeopleRanked), ctx, search, limit)
+}
+
+// UndeleteNote mocks base method.
+func (m *MockPersonApp) UndeleteNote(ctx context.Context, noteUUID string) (entity.Note, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndeleteNote", ctx, noteUUID)
+	ret0, _ := ret[0].(entity.Note)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UndeleteNote indicates an expected call of UndeleteNote.
+func (mr *MockPersonAppMockRecorder) UndeleteNote(ctx, noteUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndeleteNote", reflect.TypeOf((*MockPersonApp)(nil).UndeleteNote), ctx, noteUUID)
+}
+
 // UpdateNote mocks base method.
 func (m *MockPersonApp) UpdateNote(ctx context.Context, noteUUID string, note entity.Note) error {
 	m.ctrl.T.Helper()
@@ -644,139 +857,2140 @@ func (mr *MockPersonAppMockRecorder) UpdatePerson(ctx, personUUID, person any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePerson", reflect.TypeOf((*MockPersonApp)(nil).UpdatePerson), ctx, personUUID, person)
 }
 
-// MockDashboardApp is a mock of DashboardApp interface.
-type MockDashboardApp struct {
+// UpdatePersonAttribute mocks base method.
+func (m *MockPersonApp) UpdatePersonAttribute(ctx context.Context, personUUID, attributeKey, value string) (entity.PersonAttribute, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePersonAttribute", ctx, personUUID, attributeKey, value)
+	ret0, _ := ret[0].(entity.PersonAttribute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePersonAttribute indicates an expected call of UpdatePersonAttribute.
+func (mr *MockPersonAppMockRecorder) UpdatePersonAttribute(ctx, personUUID, attributeKey, value any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePersonAttribute", reflect.TypeOf((*MockPersonApp)(nil).UpdatePersonAttribute), ctx, personUUID, attributeKey, value)
+}
+
+// MockMeetingApp is a mock of MeetingApp interface.
+type MockMeetingApp struct {
 	ctrl     *gomock.Controller
-	recorder *MockDashboardAppMockRecorder
+	recorder *MockMeetingAppMockRecorder
 	isgomock struct{}
 }
 
-// MockDashboardAppMockRecorder is the mock recorder for MockDashboardApp.
-type MockDashboardAppMockRecorder struct {
-	mock *MockDashboardApp
+// MockMeetingAppMockRecorder is the mock recorder for MockMeetingApp.
+type MockMeetingAppMockRecorder struct {
+	mock *MockMeetingApp
 }
 
-// NewMockDashboardApp creates a new mock instance.
-func NewMockDashboardApp(ctrl *gomock.Controller) *MockDashboardApp {
-	mock := &MockDashboardApp{ctrl: ctrl}
-	mock.recorder = &MockDashboardAppMockRecorder{mock}
+// NewMockMeetingApp creates a new mock instance.
+func NewMockMeetingApp(ctrl *gomock.Controller) *MockMeetingApp {
+	mock := &MockMeetingApp{ctrl: ctrl}
+	mock.recorder = &MockMeetingAppMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockDashboardApp) EXPECT() *MockDashboardAppMockRecorder {
+func (m *MockMeetingApp) EXPECT() *MockMeetingAppMockRecorder {
 	return m.recorder
 }
 
-// GetDashboardData mocks base method.
-func (m *MockDashboardApp) GetDashboardData(ctx context.Context, companyUUID string) (entity.Dashboard, error) {
+// AddAgendaItem mocks base method.
+func (m *MockMeetingApp) AddAgendaItem(ctx context.Context, meetingUUID string, item entity.MeetingAgendaItem) (entity.MeetingAgendaItem, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDashboardData", ctx, companyUUID)
-	ret0, _ := ret[0].(entity.Dashboard)
+	ret := m.ctrl.Call(m, "AddAgendaItem", ctx, meetingUUID, item)
+	ret0, _ := ret[0].(entity.MeetingAgendaItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDashboardData indicates an expected call of GetDashboardData.
-func (mr *MockDashboardAppMockRecorder) GetDashboardData(ctx, companyUUID any) *gomock.Call {
+// AddAgendaItem indicates an expected call of AddAgendaItem.
+func (mr *MockMeetingAppMockRecorder) AddAgendaItem(ctx, meetingUUID, item any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDashboardData", reflect.TypeOf((*MockDashboardApp)(nil).GetDashboardData), ctx, companyUUID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAgendaItem", reflect.TypeOf((*MockMeetingApp)(nil).AddAgendaItem), ctx, meetingUUID, item)
 }
 
-// MockAIApp is a mock of AIApp interface.
-type MockAIApp struct {
-	ctrl     *gomock.Controller
-	recorder *MockAIAppMockRecorder
-	isgomock struct{}
+// CancelMeeting mocks base method.
+func (m *MockMeetingApp) CancelMeeting(ctx context.Context, meetingUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelMeeting", ctx, meetingUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// MockAIAppMockRecorder is the mock recorder for MockAIApp.
-type MockAIAppMockRecorder struct {
-	mock *MockAIApp
+// CancelMeeting indicates an expected call of CancelMeeting.
+func (mr *MockMeetingAppMockRecorder) CancelMeeting(ctx, meetingUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMeeting", reflect.TypeOf((*MockMeetingApp)(nil).CancelMeeting), ctx, meetingUUID)
 }
 
-// NewMockAIApp creates a new mock instance.
-func NewMockAIApp(ctrl *gomock.Controller) *MockAIApp {
-	mock := &MockAIApp{ctrl: ctrl}
-	mock.recorder = &MockAIAppMockRecorder{mock}
-	return mock
+// CompleteMeeting mocks base method.
+func (m *MockMeetingApp) CompleteMeeting(ctx context.Context, meetingUUID string, completion entity.MeetingCompletion) (entity.Meeting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteMeeting", ctx, meetingUUID, completion)
+	ret0, _ := ret[0].(entity.Meeting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAIApp) EXPECT() *MockAIAppMockRecorder {
-	return m.recorder
+// CompleteMeeting indicates an expected call of CompleteMeeting.
+func (mr *MockMeetingAppMockRecorder) CompleteMeeting(ctx, meetingUUID, completion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteMeeting", reflect.TypeOf((*MockMeetingApp)(nil).CompleteMeeting), ctx, meetingUUID, completion)
 }
 
-// ChatWithLeadershipCoach mocks base method.
-func (m *MockAIApp) ChatWithLeadershipCoach(ctx context.Context, req entity.ChatRequest) (entity.ChatResponse, error) {
+// DeleteAgendaItem mocks base method.
+func (m *MockMeetingApp) DeleteAgendaItem(ctx context.Context, meetingUUID, itemUUID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChatWithLeadershipCoach", ctx, req)
-	ret0, _ := ret[0].(entity.ChatResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteAgendaItem", ctx, meetingUUID, itemUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ChatWithLeadershipCoach indicates an expected call of ChatWithLeadershipCoach.
-func (mr *MockAIAppMockRecorder) ChatWithLeadershipCoach(ctx, req any) *gomock.Call {
+// DeleteAgendaItem indicates an expected call of DeleteAgendaItem.
+func (mr *MockMeetingAppMockRecorder) DeleteAgendaItem(ctx, meetingUUID, itemUUID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChatWithLeadershipCoach", reflect.TypeOf((*MockAIApp)(nil).ChatWithLeadershipCoach), ctx, req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAgendaItem", reflect.TypeOf((*MockMeetingApp)(nil).DeleteAgendaItem), ctx, meetingUUID, itemUUID)
 }
 
-// ExtractAttributesFromNote mocks base method.
-func (m *MockAIApp) ExtractAttributesFromNote(ctx context.Context, noteID int64) (entity.AttributesResponse, error) {
+// GetMeeting mocks base method.
+func (m *MockMeetingApp) GetMeeting(ctx context.Context, meetingUUID string) (entity.Meeting, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ExtractAttributesFromNote", ctx, noteID)
-	ret0, _ := ret[0].(entity.AttributesResponse)
+	ret := m.ctrl.Call(m, "GetMeeting", ctx, meetingUUID)
+	ret0, _ := ret[0].(entity.Meeting)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ExtractAttributesFromNote indicates an expected call of ExtractAttributesFromNote.
-func (mr *MockAIAppMockRecorder) ExtractAttributesFromNote(ctx, noteID any) *gomock.Call {
+// GetMeeting indicates an expected call of GetMeeting.
+func (mr *MockMeetingAppMockRecorder) GetMeeting(ctx, meetingUUID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractAttributesFromNote", reflect.TypeOf((*MockAIApp)(nil).ExtractAttributesFromNote), ctx, noteID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMeeting", reflect.TypeOf((*MockMeetingApp)(nil).GetMeeting), ctx, meetingUUID)
 }
 
-// GetPersonContext mocks base method.
-func (m *MockAIApp) GetPersonContext(ctx context.Context, personID int64) (entity.PersonAIContext, error) {
+// GetPersonMeetings mocks base method.
+func (m *MockMeetingApp) GetPersonMeetings(ctx context.Context, personUUID, status string, take, skip int64) ([]entity.Meeting, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPersonContext", ctx, personID)
-	ret0, _ := ret[0].(entity.PersonAIContext)
+	ret := m.ctrl.Call(m, "GetPersonMeetings", ctx, personUUID, status, take, skip)
+	ret0, _ := ret[0].([]entity.Meeting)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPersonMeetings indicates an expected call of GetPersonMeetings.
+func (mr *MockMeetingAppMockRecorder) GetPersonMeetings(ctx, personUUID, status, take, skip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonMeetings", reflect.TypeOf((*MockMeetingApp)(nil).GetPersonMeetings), ctx, personUUID, status, take, skip)
+}
+
+// ScheduleMeeting mocks base method.
+func (m *MockMeetingApp) ScheduleMeeting(ctx context.Context, personUUID string, meeting entity.Meeting) (entity.Meeting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleMeeting", ctx, personUUID, meeting)
+	ret0, _ := ret[0].(entity.Meeting)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPersonContext indicates an expected call of GetPersonContext.
-func (mr *MockAIAppMockRecorder) GetPersonContext(ctx, personID any) *gomock.Call {
+// ScheduleMeeting indicates an expected call of ScheduleMeeting.
+func (mr *MockMeetingAppMockRecorder) ScheduleMeeting(ctx, personUUID, meeting any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonContext", reflect.TypeOf((*MockAIApp)(nil).GetPersonContext), ctx, personID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleMeeting", reflect.TypeOf((*MockMeetingApp)(nil).ScheduleMeeting), ctx, personUUID, meeting)
 }
 
-// GetUsageReport mocks base method.
-func (m *MockAIApp) GetUsageReport(ctx context.Context, period string) (entity.AIUsageReport, error) {
+// UpdateAgendaItem mocks base method.
+func (m *MockMeetingApp) UpdateAgendaItem(ctx context.Context, meetingUUID, itemUUID string, item entity.MeetingAgendaItem) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUsageReport", ctx, period)
-	ret0, _ := ret[0].(entity.AIUsageReport)
+	ret := m.ctrl.Call(m, "UpdateAgendaItem", ctx, meetingUUID, itemUUID, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAgendaItem indicates an expected call of UpdateAgendaItem.
+func (mr *MockMeetingAppMockRecorder) UpdateAgendaItem(ctx, meetingUUID, itemUUID, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAgendaItem", reflect.TypeOf((*MockMeetingApp)(nil).UpdateAgendaItem), ctx, meetingUUID, itemUUID, item)
+}
+
+// UpdateMeeting mocks base method.
+func (m *MockMeetingApp) UpdateMeeting(ctx context.Context, meetingUUID string, meeting entity.Meeting) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMeeting", ctx, meetingUUID, meeting)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMeeting indicates an expected call of UpdateMeeting.
+func (mr *MockMeetingAppMockRecorder) UpdateMeeting(ctx, meetingUUID, meeting any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMeeting", reflect.TypeOf((*MockMeetingApp)(nil).UpdateMeeting), ctx, meetingUUID, meeting)
+}
+
+// MockActionItemApp is a mock of ActionItemApp interface.
+type MockActionItemApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockActionItemAppMockRecorder
+	isgomock struct{}
+}
+
+// MockActionItemAppMockRecorder is the mock recorder for MockActionItemApp.
+type MockActionItemAppMockRecorder struct {
+	mock *MockActionItemApp
+}
+
+// NewMockActionItemApp creates a new mock instance.
+func NewMockActionItemApp(ctrl *gomock.Controller) *MockActionItemApp {
+	mock := &MockActionItemApp{ctrl: ctrl}
+	mock.recorder = &MockActionItemAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActionItemApp) EXPECT() *MockActionItemAppMockRecorder {
+	return m.recorder
+}
+
+// CreateActionItem mocks base method.
+func (m *MockActionItemApp) CreateActionItem(ctx context.Context, personUUID string, item entity.ActionItem) (entity.ActionItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateActionItem", ctx, personUUID, item)
+	ret0, _ := ret[0].(entity.ActionItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUsageReport indicates an expected call of GetUsageReport.
-func (mr *MockAIAppMockRecorder) GetUsageReport(ctx, period any) *gomock.Call {
+// CreateActionItem indicates an expected call of CreateActionItem.
+func (mr *MockActionItemAppMockRecorder) CreateActionItem(ctx, personUUID, item any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsageReport", reflect.TypeOf((*MockAIApp)(nil).GetUsageReport), ctx, period)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateActionItem", reflect.TypeOf((*MockActionItemApp)(nil).CreateActionItem), ctx, personUUID, item)
 }
 
-// SendFeedback mocks base method.
-func (m *MockAIApp) SendFeedback(ctx context.Context, usageID int64, feedback, comment string) error {
+// DeleteActionItem mocks base method.
+func (m *MockActionItemApp) DeleteActionItem(ctx context.Context, itemUUID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendFeedback", ctx, usageID, feedback, comment)
+	ret := m.ctrl.Call(m, "DeleteActionItem", ctx, itemUUID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SendFeedback indicates an expected call of SendFeedback.
-func (mr *MockAIAppMockRecorder) SendFeedback(ctx, usageID, feedback, comment any) *gomock.Call {
+// DeleteActionItem indicates an expected call of DeleteActionItem.
+func (mr *MockActionItemAppMockRecorder) DeleteActionItem(ctx, itemUUID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFeedback", reflect.TypeOf((*MockAIApp)(nil).SendFeedback), ctx, usageID, feedback, comment)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActionItem", reflect.TypeOf((*MockActionItemApp)(nil).DeleteActionItem), ctx, itemUUID)
+}
+
+// GetOverdueActionItems mocks base method.
+func (m *MockActionItemApp) GetOverdueActionItems(ctx context.Context) ([]entity.ActionItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverdueActionItems", ctx)
+	ret0, _ := ret[0].([]entity.ActionItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverdueActionItems indicates an expected call of GetOverdueActionItems.
+func (mr *MockActionItemAppMockRecorder) GetOverdueActionItems(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverdueActionItems", reflect.TypeOf((*MockActionItemApp)(nil).GetOverdueActionItems), ctx)
+}
+
+// GetPersonActionItems mocks base method.
+func (m *MockActionItemApp) GetPersonActionItems(ctx context.Context, personUUID, status string, take, skip int64) ([]entity.ActionItem, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonActionItems", ctx, personUUID, status, take, skip)
+	ret0, _ := ret[0].([]entity.ActionItem)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPersonActionItems indicates an expected call of GetPersonActionItems.
+func (mr *MockActionItemAppMockRecorder) GetPersonActionItems(ctx, personUUID, status, take, skip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonActionItems", reflect.TypeOf((*MockActionItemApp)(nil).GetPersonActionItems), ctx, personUUID, status, take, skip)
+}
+
+// UpdateActionItem mocks base method.
+func (m *MockActionItemApp) UpdateActionItem(ctx context.Context, itemUUID string, item entity.ActionItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateActionItem", ctx, itemUUID, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateActionItem indicates an expected call of UpdateActionItem.
+func (mr *MockActionItemAppMockRecorder) UpdateActionItem(ctx, itemUUID, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateActionItem", reflect.TypeOf((*MockActionItemApp)(nil).UpdateActionItem), ctx, itemUUID, item)
+}
+
+// MockGoalApp is a mock of GoalApp interface.
+type MockGoalApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockGoalAppMockRecorder
+	isgomock struct{}
+}
+
+// MockGoalAppMockRecorder is the mock recorder for MockGoalApp.
+type MockGoalAppMockRecorder struct {
+	mock *MockGoalApp
+}
+
+// NewMockGoalApp creates a new mock instance.
+func NewMockGoalApp(ctrl *gomock.Controller) *MockGoalApp {
+	mock := &MockGoalApp{ctrl: ctrl}
+	mock.recorder = &MockGoalAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGoalApp) EXPECT() *MockGoalAppMockRecorder {
+	return m.recorder
+}
+
+// AddKeyResult mocks base method.
+func (m *MockGoalApp) AddKeyResult(ctx context.Context, goalUUID string, keyResult entity.GoalKeyResult) (entity.GoalKeyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddKeyResult", ctx, goalUUID, keyResult)
+	ret0, _ := ret[0].(entity.GoalKeyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddKeyResult indicates an expected call of AddKeyResult.
+func (mr *MockGoalAppMockRecorder) AddKeyResult(ctx, goalUUID, keyResult any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddKeyResult", reflect.TypeOf((*MockGoalApp)(nil).AddKeyResult), ctx, goalUUID, keyResult)
+}
+
+// CheckIn mocks base method.
+func (m *MockGoalApp) CheckIn(ctx context.Context, goalUUID string, checkIn entity.GoalCheckIn) (entity.GoalCheckIn, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckIn", ctx, goalUUID, checkIn)
+	ret0, _ := ret[0].(entity.GoalCheckIn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckIn indicates an expected call of CheckIn.
+func (mr *MockGoalAppMockRecorder) CheckIn(ctx, goalUUID, checkIn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockGoalApp)(nil).CheckIn), ctx, goalUUID, checkIn)
+}
+
+// CreateGoal mocks base method.
+func (m *MockGoalApp) CreateGoal(ctx context.Context, personUUID string, goal entity.Goal) (entity.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGoal", ctx, personUUID, goal)
+	ret0, _ := ret[0].(entity.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGoal indicates an expected call of CreateGoal.
+func (mr *MockGoalAppMockRecorder) CreateGoal(ctx, personUUID, goal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGoal", reflect.TypeOf((*MockGoalApp)(nil).CreateGoal), ctx, personUUID, goal)
+}
+
+// DeleteCheckIn mocks base method.
+func (m *MockGoalApp) DeleteCheckIn(ctx context.Context, goalUUID, checkInUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCheckIn", ctx, goalUUID, checkInUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCheckIn indicates an expected call of DeleteCheckIn.
+func (mr *MockGoalAppMockRecorder) DeleteCheckIn(ctx, goalUUID, checkInUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCheckIn", reflect.TypeOf((*MockGoalApp)(nil).DeleteCheckIn), ctx, goalUUID, checkInUUID)
+}
+
+// DeleteGoal mocks base method.
+func (m *MockGoalApp) DeleteGoal(ctx context.Context, goalUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGoal", ctx, goalUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGoal indicates an expected call of DeleteGoal.
+func (mr *MockGoalAppMockRecorder) DeleteGoal(ctx, goalUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGoal", reflect.TypeOf((*MockGoalApp)(nil).DeleteGoal), ctx, goalUUID)
+}
+
+// DeleteKeyResult mocks base method.
+func (m *MockGoalApp) DeleteKeyResult(ctx context.Context, goalUUID, keyResultUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKeyResult", ctx, goalUUID, keyResultUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteKeyResult indicates an expected call of DeleteKeyResult.
+func (mr *MockGoalAppMockRecorder) DeleteKeyResult(ctx, goalUUID, keyResultUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKeyResult", reflect.TypeOf((*MockGoalApp)(nil).DeleteKeyResult), ctx, goalUUID, keyResultUUID)
+}
+
+// GetGoal mocks base method.
+func (m *MockGoalApp) GetGoal(ctx context.Context, goalUUID string) (entity.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGoal", ctx, goalUUID)
+	ret0, _ := ret[0].(entity.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGoal indicates an expected call of GetGoal.
+func (mr *MockGoalAppMockRecorder) GetGoal(ctx, goalUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGoal", reflect.TypeOf((*MockGoalApp)(nil).GetGoal), ctx, goalUUID)
+}
+
+// GetPersonGoalTimeline mocks base method.
+func (m *MockGoalApp) GetPersonGoalTimeline(ctx context.Context, personUUID string) ([]entity.GoalTimelineEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonGoalTimeline", ctx, personUUID)
+	ret0, _ := ret[0].([]entity.GoalTimelineEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonGoalTimeline indicates an expected call of GetPersonGoalTimeline.
+func (mr *MockGoalAppMockRecorder) GetPersonGoalTimeline(ctx, personUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonGoalTimeline", reflect.TypeOf((*MockGoalApp)(nil).GetPersonGoalTimeline), ctx, personUUID)
+}
+
+// GetPersonGoals mocks base method.
+func (m *MockGoalApp) GetPersonGoals(ctx context.Context, personUUID, status string) ([]entity.Goal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonGoals", ctx, personUUID, status)
+	ret0, _ := ret[0].([]entity.Goal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonGoals indicates an expected call of GetPersonGoals.
+func (mr *MockGoalAppMockRecorder) GetPersonGoals(ctx, personUUID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonGoals", reflect.TypeOf((*MockGoalApp)(nil).GetPersonGoals), ctx, personUUID, status)
+}
+
+// UpdateGoal mocks base method.
+func (m *MockGoalApp) UpdateGoal(ctx context.Context, goalUUID string, goal entity.Goal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGoal", ctx, goalUUID, goal)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGoal indicates an expected call of UpdateGoal.
+func (mr *MockGoalAppMockRecorder) UpdateGoal(ctx, goalUUID, goal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGoal", reflect.TypeOf((*MockGoalApp)(nil).UpdateGoal), ctx, goalUUID, goal)
+}
+
+// UpdateKeyResult mocks base method.
+func (m *MockGoalApp) UpdateKeyResult(ctx context.Context, goalUUID, keyResultUUID string, keyResult entity.GoalKeyResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKeyResult", ctx, goalUUID, keyResultUUID, keyResult)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateKeyResult indicates an expected call of UpdateKeyResult.
+func (mr *MockGoalAppMockRecorder) UpdateKeyResult(ctx, goalUUID, keyResultUUID, keyResult any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKeyResult", reflect.TypeOf((*MockGoalApp)(nil).UpdateKeyResult), ctx, goalUUID, keyResultUUID, keyResult)
+}
+
+// MockReviewApp is a mock of ReviewApp interface.
+type MockReviewApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewAppMockRecorder
+	isgomock struct{}
+}
+
+// MockReviewAppMockRecorder is the mock recorder for MockReviewApp.
+type MockReviewAppMockRecorder struct {
+	mock *MockReviewApp
+}
+
+// NewMockReviewApp creates a new mock instance.
+func NewMockReviewApp(ctrl *gomock.Controller) *MockReviewApp {
+	mock := &MockReviewApp{ctrl: ctrl}
+	mock.recorder = &MockReviewAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewApp) EXPECT() *MockReviewAppMockRecorder {
+	return m.recorder
+}
+
+// CreateCycleReviews mocks base method.
+func (m *MockReviewApp) CreateCycleReviews(ctx context.Context, cycleUUID string, personUUIDs []string) ([]entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCycleReviews", ctx, cycleUUID, personUUIDs)
+	ret0, _ := ret[0].([]entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCycleReviews indicates an expected call of CreateCycleReviews.
+func (mr *MockReviewAppMockRecorder) CreateCycleReviews(ctx, cycleUUID, personUUIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCycleReviews", reflect.TypeOf((*MockReviewApp)(nil).CreateCycleReviews), ctx, cycleUUID, personUUIDs)
+}
+
+// CreateReviewCycle mocks base method.
+func (m *MockReviewApp) CreateReviewCycle(ctx context.Context, cycle entity.ReviewCycle) (entity.ReviewCycle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReviewCycle", ctx, cycle)
+	ret0, _ := ret[0].(entity.ReviewCycle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReviewCycle indicates an expected call of CreateReviewCycle.
+func (mr *MockReviewAppMockRecorder) CreateReviewCycle(ctx, cycle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReviewCycle", reflect.TypeOf((*MockReviewApp)(nil).CreateReviewCycle), ctx, cycle)
+}
+
+// CreateReviewTemplate mocks base method.
+func (m *MockReviewApp) CreateReviewTemplate(ctx context.Context, template entity.ReviewTemplate) (entity.ReviewTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReviewTemplate", ctx, template)
+	ret0, _ := ret[0].(entity.ReviewTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReviewTemplate indicates an expected call of CreateReviewTemplate.
+func (mr *MockReviewAppMockRecorder) CreateReviewTemplate(ctx, template any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReviewTemplate", reflect.TypeOf((*MockReviewApp)(nil).CreateReviewTemplate), ctx, template)
+}
+
+// DeleteReview mocks base method.
+func (m *MockReviewApp) DeleteReview(ctx context.Context, reviewUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, reviewUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReview indicates an expected call of DeleteReview.
+func (mr *MockReviewAppMockRecorder) DeleteReview(ctx, reviewUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockReviewApp)(nil).DeleteReview), ctx, reviewUUID)
+}
+
+// DeleteReviewCycle mocks base method.
+func (m *MockReviewApp) DeleteReviewCycle(ctx context.Context, cycleUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReviewCycle", ctx, cycleUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReviewCycle indicates an expected call of DeleteReviewCycle.
+func (mr *MockReviewAppMockRecorder) DeleteReviewCycle(ctx, cycleUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReviewCycle", reflect.TypeOf((*MockReviewApp)(nil).DeleteReviewCycle), ctx, cycleUUID)
+}
+
+// DeleteReviewTemplate mocks base method.
+func (m *MockReviewApp) DeleteReviewTemplate(ctx context.Context, templateUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReviewTemplate", ctx, templateUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteReviewTemplate indicates an expected call of DeleteReviewTemplate.
+func (mr *MockReviewAppMockRecorder) DeleteReviewTemplate(ctx, templateUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReviewTemplate", reflect.TypeOf((*MockReviewApp)(nil).DeleteReviewTemplate), ctx, templateUUID)
+}
+
+// GetCycleReviews mocks base method.
+func (m *MockReviewApp) GetCycleReviews(ctx context.Context, cycleUUID string) ([]entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCycleReviews", ctx, cycleUUID)
+	ret0, _ := ret[0].([]entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCycleReviews indicates an expected call of GetCycleReviews.
+func (mr *MockReviewAppMockRecorder) GetCycleReviews(ctx, cycleUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCycleReviews", reflect.TypeOf((*MockReviewApp)(nil).GetCycleReviews), ctx, cycleUUID)
+}
+
+// GetPersonReviews mocks base method.
+func (m *MockReviewApp) GetPersonReviews(ctx context.Context, personUUID string) ([]entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonReviews", ctx, personUUID)
+	ret0, _ := ret[0].([]entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonReviews indicates an expected call of GetPersonReviews.
+func (mr *MockReviewAppMockRecorder) GetPersonReviews(ctx, personUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonReviews", reflect.TypeOf((*MockReviewApp)(nil).GetPersonReviews), ctx, personUUID)
+}
+
+// GetReview mocks base method.
+func (m *MockReviewApp) GetReview(ctx context.Context, reviewUUID string) (entity.Review, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReview", ctx, reviewUUID)
+	ret0, _ := ret[0].(entity.Review)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReview indicates an expected call of GetReview.
+func (mr *MockReviewAppMockRecorder) GetReview(ctx, reviewUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockReviewApp)(nil).GetReview), ctx, reviewUUID)
+}
+
+// GetReviewCycle mocks base method.
+func (m *MockReviewApp) GetReviewCycle(ctx context.Context, cycleUUID string) (entity.ReviewCycle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewCycle", ctx, cycleUUID)
+	ret0, _ := ret[0].(entity.ReviewCycle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewCycle indicates an expected call of GetReviewCycle.
+func (mr *MockReviewAppMockRecorder) GetReviewCycle(ctx, cycleUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewCycle", reflect.TypeOf((*MockReviewApp)(nil).GetReviewCycle), ctx, cycleUUID)
+}
+
+// GetReviewCycles mocks base method.
+func (m *MockReviewApp) GetReviewCycles(ctx context.Context) ([]entity.ReviewCycle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewCycles", ctx)
+	ret0, _ := ret[0].([]entity.ReviewCycle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewCycles indicates an expected call of GetReviewCycles.
+func (mr *MockReviewAppMockRecorder) GetReviewCycles(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewCycles", reflect.TypeOf((*MockReviewApp)(nil).GetReviewCycles), ctx)
+}
+
+// GetReviewTemplate mocks base method.
+func (m *MockReviewApp) GetReviewTemplate(ctx context.Context, templateUUID string) (entity.ReviewTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewTemplate", ctx, templateUUID)
+	ret0, _ := ret[0].(entity.ReviewTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewTemplate indicates an expected call of GetReviewTemplate.
+func (mr *MockReviewAppMockRecorder) GetReviewTemplate(ctx, templateUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewTemplate", reflect.TypeOf((*MockReviewApp)(nil).GetReviewTemplate), ctx, templateUUID)
+}
+
+// GetReviewTemplates mocks base method.
+func (m *MockReviewApp) GetReviewTemplates(ctx context.Context) ([]entity.ReviewTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewTemplates", ctx)
+	ret0, _ := ret[0].([]entity.ReviewTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewTemplates indicates an expected call of GetReviewTemplates.
+func (mr *MockReviewAppMockRecorder) GetReviewTemplates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewTemplates", reflect.TypeOf((*MockReviewApp)(nil).GetReviewTemplates), ctx)
+}
+
+// ReopenReview mocks base method.
+func (m *MockReviewApp) ReopenReview(ctx context.Context, reviewUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenReview", ctx, reviewUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReopenReview indicates an expected call of ReopenReview.
+func (mr *MockReviewAppMockRecorder) ReopenReview(ctx, reviewUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenReview", reflect.TypeOf((*MockReviewApp)(nil).ReopenReview), ctx, reviewUUID)
+}
+
+// ShareReview mocks base method.
+func (m *MockReviewApp) ShareReview(ctx context.Context, reviewUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShareReview", ctx, reviewUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ShareReview indicates an expected call of ShareReview.
+func (mr *MockReviewAppMockRecorder) ShareReview(ctx, reviewUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShareReview", reflect.TypeOf((*MockReviewApp)(nil).ShareReview), ctx, reviewUUID)
+}
+
+// SubmitReview mocks base method.
+func (m *MockReviewApp) SubmitReview(ctx context.Context, reviewUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitReview", ctx, reviewUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitReview indicates an expected call of SubmitReview.
+func (mr *MockReviewAppMockRecorder) SubmitReview(ctx, reviewUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitReview", reflect.TypeOf((*MockReviewApp)(nil).SubmitReview), ctx, reviewUUID)
+}
+
+// UpdateReview mocks base method.
+func (m *MockReviewApp) UpdateReview(ctx context.Context, reviewUUID string, review entity.Review) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, reviewUUID, review)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReview indicates an expected call of UpdateReview.
+func (mr *MockReviewAppMockRecorder) UpdateReview(ctx, reviewUUID, review any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockReviewApp)(nil).UpdateReview), ctx, reviewUUID, review)
+}
+
+// MockFeedbackRequestApp is a mock of FeedbackRequestApp interface.
+type MockFeedbackRequestApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeedbackRequestAppMockRecorder
+	isgomock struct{}
+}
+
+// MockFeedbackRequestAppMockRecorder is the mock recorder for MockFeedbackRequestApp.
+type MockFeedbackRequestAppMockRecorder struct {
+	mock *MockFeedbackRequestApp
+}
+
+// NewMockFeedbackRequestApp creates a new mock instance.
+func NewMockFeedbackRequestApp(ctrl *gomock.Controller) *MockFeedbackRequestApp {
+	mock := &MockFeedbackRequestApp{ctrl: ctrl}
+	mock.recorder = &MockFeedbackRequestAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeedbackRequestApp) EXPECT() *MockFeedbackRequestAppMockRecorder {
+	return m.recorder
+}
+
+// CreateFeedbackRequest mocks base method.
+func (m *MockFeedbackRequestApp) CreateFeedbackRequest(ctx context.Context, personUUID string, request entity.FeedbackRequest, expiresInDays int) (entity.FeedbackRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeedbackRequest", ctx, personUUID, request, expiresInDays)
+	ret0, _ := ret[0].(entity.FeedbackRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeedbackRequest indicates an expected call of CreateFeedbackRequest.
+func (mr *MockFeedbackRequestAppMockRecorder) CreateFeedbackRequest(ctx, personUUID, request, expiresInDays any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeedbackRequest", reflect.TypeOf((*MockFeedbackRequestApp)(nil).CreateFeedbackRequest), ctx, personUUID, request, expiresInDays)
+}
+
+// DeleteFeedbackRequest mocks base method.
+func (m *MockFeedbackRequestApp) DeleteFeedbackRequest(ctx context.Context, requestUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeedbackRequest", ctx, requestUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFeedbackRequest indicates an expected call of DeleteFeedbackRequest.
+func (mr *MockFeedbackRequestAppMockRecorder) DeleteFeedbackRequest(ctx, requestUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeedbackRequest", reflect.TypeOf((*MockFeedbackRequestApp)(nil).DeleteFeedbackRequest), ctx, requestUUID)
+}
+
+// GetFeedbackForm mocks base method.
+func (m *MockFeedbackRequestApp) GetFeedbackForm(ctx context.Context, token string) (entity.FeedbackForm, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedbackForm", ctx, token)
+	ret0, _ := ret[0].(entity.FeedbackForm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedbackForm indicates an expected call of GetFeedbackForm.
+func (mr *MockFeedbackRequestAppMockRecorder) GetFeedbackForm(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedbackForm", reflect.TypeOf((*MockFeedbackRequestApp)(nil).GetFeedbackForm), ctx, token)
+}
+
+// GetFeedbackRequest mocks base method.
+func (m *MockFeedbackRequestApp) GetFeedbackRequest(ctx context.Context, requestUUID string) (entity.FeedbackRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedbackRequest", ctx, requestUUID)
+	ret0, _ := ret[0].(entity.FeedbackRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedbackRequest indicates an expected call of GetFeedbackRequest.
+func (mr *MockFeedbackRequestAppMockRecorder) GetFeedbackRequest(ctx, requestUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedbackRequest", reflect.TypeOf((*MockFeedbackRequestApp)(nil).GetFeedbackRequest), ctx, requestUUID)
+}
+
+// GetPersonFeedbackRequests mocks base method.
+func (m *MockFeedbackRequestApp) GetPersonFeedbackRequests(ctx context.Context, personUUID string) ([]entity.FeedbackRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonFeedbackRequests", ctx, personUUID)
+	ret0, _ := ret[0].([]entity.FeedbackRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonFeedbackRequests indicates an expected call of GetPersonFeedbackRequests.
+func (mr *MockFeedbackRequestAppMockRecorder) GetPersonFeedbackRequests(ctx, personUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonFeedbackRequests", reflect.TypeOf((*MockFeedbackRequestApp)(nil).GetPersonFeedbackRequests), ctx, personUUID)
+}
+
+// SubmitFeedbackResponse mocks base method.
+func (m *MockFeedbackRequestApp) SubmitFeedbackResponse(ctx context.Context, token string, response entity.FeedbackResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitFeedbackResponse", ctx, token, response)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SubmitFeedbackResponse indicates an expected call of SubmitFeedbackResponse.
+func (mr *MockFeedbackRequestAppMockRecorder) SubmitFeedbackResponse(ctx, token, response any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitFeedbackResponse", reflect.TypeOf((*MockFeedbackRequestApp)(nil).SubmitFeedbackResponse), ctx, token, response)
+}
+
+// MockCompetencyApp is a mock of CompetencyApp interface.
+type MockCompetencyApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompetencyAppMockRecorder
+	isgomock struct{}
+}
+
+// MockCompetencyAppMockRecorder is the mock recorder for MockCompetencyApp.
+type MockCompetencyAppMockRecorder struct {
+	mock *MockCompetencyApp
+}
+
+// NewMockCompetencyApp creates a new mock instance.
+func NewMockCompetencyApp(ctrl *gomock.Controller) *MockCompetencyApp {
+	mock := &MockCompetencyApp{ctrl: ctrl}
+	mock.recorder = &MockCompetencyAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompetencyApp) EXPECT() *MockCompetencyAppMockRecorder {
+	return m.recorder
+}
+
+// CreateCompetencyAssessment mocks base method.
+func (m *MockCompetencyApp) CreateCompetencyAssessment(ctx context.Context, personUUID string, assessment entity.CompetencyAssessment) (entity.CompetencyAssessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCompetencyAssessment", ctx, personUUID, assessment)
+	ret0, _ := ret[0].(entity.CompetencyAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCompetencyAssessment indicates an expected call of CreateCompetencyAssessment.
+func (mr *MockCompetencyAppMockRecorder) CreateCompetencyAssessment(ctx, personUUID, assessment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompetencyAssessment", reflect.TypeOf((*MockCompetencyApp)(nil).CreateCompetencyAssessment), ctx, personUUID, assessment)
+}
+
+// CreateCompetencyFramework mocks base method.
+func (m *MockCompetencyApp) CreateCompetencyFramework(ctx context.Context, framework entity.CompetencyFramework) (entity.CompetencyFramework, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCompetencyFramework", ctx, framework)
+	ret0, _ := ret[0].(entity.CompetencyFramework)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCompetencyFramework indicates an expected call of CreateCompetencyFramework.
+func (mr *MockCompetencyAppMockRecorder) CreateCompetencyFramework(ctx, framework any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCompetencyFramework", reflect.TypeOf((*MockCompetencyApp)(nil).CreateCompetencyFramework), ctx, framework)
+}
+
+// DeleteCompetencyAssessment mocks base method.
+func (m *MockCompetencyApp) DeleteCompetencyAssessment(ctx context.Context, assessmentUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCompetencyAssessment", ctx, assessmentUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCompetencyAssessment indicates an expected call of DeleteCompetencyAssessment.
+func (mr *MockCompetencyAppMockRecorder) DeleteCompetencyAssessment(ctx, assessmentUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCompetencyAssessment", reflect.TypeOf((*MockCompetencyApp)(nil).DeleteCompetencyAssessment), ctx, assessmentUUID)
+}
+
+// DeleteCompetencyFramework mocks base method.
+func (m *MockCompetencyApp) DeleteCompetencyFramework(ctx context.Context, frameworkUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCompetencyFramework", ctx, frameworkUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCompetencyFramework indicates an expected call of DeleteCompetencyFramework.
+func (mr *MockCompetencyAppMockRecorder) DeleteCompetencyFramework(ctx, frameworkUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCompetencyFramework", reflect.TypeOf((*MockCompetencyApp)(nil).DeleteCompetencyFramework), ctx, frameworkUUID)
+}
+
+// GetCompetencyAssessment mocks base method.
+func (m *MockCompetencyApp) GetCompetencyAssessment(ctx context.Context, assessmentUUID string) (entity.CompetencyAssessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompetencyAssessment", ctx, assessmentUUID)
+	ret0, _ := ret[0].(entity.CompetencyAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompetencyAssessment indicates an expected call of GetCompetencyAssessment.
+func (mr *MockCompetencyAppMockRecorder) GetCompetencyAssessment(ctx, assessmentUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompetencyAssessment", reflect.TypeOf((*MockCompetencyApp)(nil).GetCompetencyAssessment), ctx, assessmentUUID)
+}
+
+// GetCompetencyEvidence mocks base method.
+func (m *MockCompetencyApp) GetCompetencyEvidence(ctx context.Context, personUUID, competencyUUID string) ([]entity.UnifiedTimelineEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompetencyEvidence", ctx, personUUID, competencyUUID)
+	ret0, _ := ret[0].([]entity.UnifiedTimelineEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompetencyEvidence indicates an expected call of GetCompetencyEvidence.
+func (mr *MockCompetencyAppMockRecorder) GetCompetencyEvidence(ctx, personUUID, competencyUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompetencyEvidence", reflect.TypeOf((*MockCompetencyApp)(nil).GetCompetencyEvidence), ctx, personUUID, competencyUUID)
+}
+
+// GetCompetencyFramework mocks base method.
+func (m *MockCompetencyApp) GetCompetencyFramework(ctx context.Context, frameworkUUID string) (entity.CompetencyFramework, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompetencyFramework", ctx, frameworkUUID)
+	ret0, _ := ret[0].(entity.CompetencyFramework)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompetencyFramework indicates an expected call of GetCompetencyFramework.
+func (mr *MockCompetencyAppMockRecorder) GetCompetencyFramework(ctx, frameworkUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompetencyFramework", reflect.TypeOf((*MockCompetencyApp)(nil).GetCompetencyFramework), ctx, frameworkUUID)
+}
+
+// GetCompetencyFrameworks mocks base method.
+func (m *MockCompetencyApp) GetCompetencyFrameworks(ctx context.Context) ([]entity.CompetencyFramework, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompetencyFrameworks", ctx)
+	ret0, _ := ret[0].([]entity.CompetencyFramework)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompetencyFrameworks indicates an expected call of GetCompetencyFrameworks.
+func (mr *MockCompetencyAppMockRecorder) GetCompetencyFrameworks(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompetencyFrameworks", reflect.TypeOf((*MockCompetencyApp)(nil).GetCompetencyFrameworks), ctx)
+}
+
+// GetPersonCompetencyAssessments mocks base method.
+func (m *MockCompetencyApp) GetPersonCompetencyAssessments(ctx context.Context, personUUID, frameworkUUID string) ([]entity.CompetencyAssessment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonCompetencyAssessments", ctx, personUUID, frameworkUUID)
+	ret0, _ := ret[0].([]entity.CompetencyAssessment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonCompetencyAssessments indicates an expected call of GetPersonCompetencyAssessments.
+func (mr *MockCompetencyAppMockRecorder) GetPersonCompetencyAssessments(ctx, personUUID, frameworkUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonCompetencyAssessments", reflect.TypeOf((*MockCompetencyApp)(nil).GetPersonCompetencyAssessments), ctx, personUUID, frameworkUUID)
+}
+
+// GetPersonCompetencyGaps mocks base method.
+func (m *MockCompetencyApp) GetPersonCompetencyGaps(ctx context.Context, personUUID, frameworkUUID, targetLevelUUID string) (entity.CompetencyGapAnalysis, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonCompetencyGaps", ctx, personUUID, frameworkUUID, targetLevelUUID)
+	ret0, _ := ret[0].(entity.CompetencyGapAnalysis)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonCompetencyGaps indicates an expected call of GetPersonCompetencyGaps.
+func (mr *MockCompetencyAppMockRecorder) GetPersonCompetencyGaps(ctx, personUUID, frameworkUUID, targetLevelUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonCompetencyGaps", reflect.TypeOf((*MockCompetencyApp)(nil).GetPersonCompetencyGaps), ctx, personUUID, frameworkUUID, targetLevelUUID)
+}
+
+// SetNoteCompetencies mocks base method.
+func (m *MockCompetencyApp) SetNoteCompetencies(ctx context.Context, noteUUID string, competencyUUIDs []string) ([]entity.Competency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNoteCompetencies", ctx, noteUUID, competencyUUIDs)
+	ret0, _ := ret[0].([]entity.Competency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNoteCompetencies indicates an expected call of SetNoteCompetencies.
+func (mr *MockCompetencyAppMockRecorder) SetNoteCompetencies(ctx, noteUUID, competencyUUIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNoteCompetencies", reflect.TypeOf((*MockCompetencyApp)(nil).SetNoteCompetencies), ctx, noteUUID, competencyUUIDs)
+}
+
+// MockTagApp is a mock of TagApp interface.
+type MockTagApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockTagAppMockRecorder
+	isgomock struct{}
+}
+
+// MockTagAppMockRecorder is the mock recorder for MockTagApp.
+type MockTagAppMockRecorder struct {
+	mock *MockTagApp
+}
+
+// NewMockTagApp creates a new mock instance.
+func NewMockTagApp(ctrl *gomock.Controller) *MockTagApp {
+	mock := &MockTagApp{ctrl: ctrl}
+	mock.recorder = &MockTagAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTagApp) EXPECT() *MockTagAppMockRecorder {
+	return m.recorder
+}
+
+// CreateTag mocks base method.
+func (m *MockTagApp) CreateTag(ctx context.Context, tag entity.Tag) (entity.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTag", ctx, tag)
+	ret0, _ := ret[0].(entity.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTag indicates an expected call of CreateTag.
+func (mr *MockTagAppMockRecorder) CreateTag(ctx, tag any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTag", reflect.TypeOf((*MockTagApp)(nil).CreateTag), ctx, tag)
+}
+
+// DeleteTag mocks base method.
+func (m *MockTagApp) DeleteTag(ctx context.Context, tagUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTag", ctx, tagUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTag indicates an expected call of DeleteTag.
+func (mr *MockTagAppMockRecorder) DeleteTag(ctx, tagUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTag", reflect.TypeOf((*MockTagApp)(nil).DeleteTag), ctx, tagUUID)
+}
+
+// GetCompanyTags mocks base method.
+func (m *MockTagApp) GetCompanyTags(ctx context.Context) ([]entity.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompanyTags", ctx)
+	ret0, _ := ret[0].([]entity.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompanyTags indicates an expected call of GetCompanyTags.
+func (mr *MockTagAppMockRecorder) GetCompanyTags(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyTags", reflect.TypeOf((*MockTagApp)(nil).GetCompanyTags), ctx)
+}
+
+// GetTagAnalytics mocks base method.
+func (m *MockTagApp) GetTagAnalytics(ctx context.Context, filters entity.TagAnalyticsFilters) (entity.TagAnalytics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTagAnalytics", ctx, filters)
+	ret0, _ := ret[0].(entity.TagAnalytics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTagAnalytics indicates an expected call of GetTagAnalytics.
+func (mr *MockTagAppMockRecorder) GetTagAnalytics(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTagAnalytics", reflect.TypeOf((*MockTagApp)(nil).GetTagAnalytics), ctx, filters)
+}
+
+// SetNoteTags mocks base method.
+func (m *MockTagApp) SetNoteTags(ctx context.Context, noteUUID string, names []string) ([]entity.Tag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNoteTags", ctx, noteUUID, names)
+	ret0, _ := ret[0].([]entity.Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetNoteTags indicates an expected call of SetNoteTags.
+func (mr *MockTagAppMockRecorder) SetNoteTags(ctx, noteUUID, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNoteTags", reflect.TypeOf((*MockTagApp)(nil).SetNoteTags), ctx, noteUUID, names)
+}
+
+// MockActivityApp is a mock of ActivityApp interface.
+type MockActivityApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityAppMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityAppMockRecorder is the mock recorder for MockActivityApp.
+type MockActivityAppMockRecorder struct {
+	mock *MockActivityApp
+}
+
+// NewMockActivityApp creates a new mock instance.
+func NewMockActivityApp(ctrl *gomock.Controller) *MockActivityApp {
+	mock := &MockActivityApp{ctrl: ctrl}
+	mock.recorder = &MockActivityAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityApp) EXPECT() *MockActivityAppMockRecorder {
+	return m.recorder
+}
+
+// GetActivityFeed mocks base method.
+func (m *MockActivityApp) GetActivityFeed(ctx context.Context, filters entity.ActivityFeedFilters, page entity.CursorPage) (entity.ActivityFeed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityFeed", ctx, filters, page)
+	ret0, _ := ret[0].(entity.ActivityFeed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityFeed indicates an expected call of GetActivityFeed.
+func (mr *MockActivityAppMockRecorder) GetActivityFeed(ctx, filters, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityFeed", reflect.TypeOf((*MockActivityApp)(nil).GetActivityFeed), ctx, filters, page)
+}
+
+// MarkActivitySeen mocks base method.
+func (m *MockActivityApp) MarkActivitySeen(ctx context.Context, activityUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkActivitySeen", ctx, activityUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkActivitySeen indicates an expected call of MarkActivitySeen.
+func (mr *MockActivityAppMockRecorder) MarkActivitySeen(ctx, activityUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkActivitySeen", reflect.TypeOf((*MockActivityApp)(nil).MarkActivitySeen), ctx, activityUUID)
+}
+
+// MockNoteAttachmentApp is a mock of NoteAttachmentApp interface.
+type MockNoteAttachmentApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteAttachmentAppMockRecorder
+	isgomock struct{}
+}
+
+// MockNoteAttachmentAppMockRecorder is the mock recorder for MockNoteAttachmentApp.
+type MockNoteAttachmentAppMockRecorder struct {
+	mock *MockNoteAttachmentApp
+}
+
+// NewMockNoteAttachmentApp creates a new mock instance.
+func NewMockNoteAttachmentApp(ctrl *gomock.Controller) *MockNoteAttachmentApp {
+	mock := &MockNoteAttachmentApp{ctrl: ctrl}
+	mock.recorder = &MockNoteAttachmentAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNoteAttachmentApp) EXPECT() *MockNoteAttachmentAppMockRecorder {
+	return m.recorder
+}
+
+// CreateDownloadURL mocks base method.
+func (m *MockNoteAttachmentApp) CreateDownloadURL(ctx context.Context, noteUUID, attachmentUUID string) (entity.NoteAttachmentDownloadURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDownloadURL", ctx, noteUUID, attachmentUUID)
+	ret0, _ := ret[0].(entity.NoteAttachmentDownloadURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDownloadURL indicates an expected call of CreateDownloadURL.
+func (mr *MockNoteAttachmentAppMockRecorder) CreateDownloadURL(ctx, noteUUID, attachmentUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDownloadURL", reflect.TypeOf((*MockNoteAttachmentApp)(nil).CreateDownloadURL), ctx, noteUUID, attachmentUUID)
+}
+
+// DeleteAttachment mocks base method.
+func (m *MockNoteAttachmentApp) DeleteAttachment(ctx context.Context, noteUUID, attachmentUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAttachment", ctx, noteUUID, attachmentUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAttachment indicates an expected call of DeleteAttachment.
+func (mr *MockNoteAttachmentAppMockRecorder) DeleteAttachment(ctx, noteUUID, attachmentUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAttachment", reflect.TypeOf((*MockNoteAttachmentApp)(nil).DeleteAttachment), ctx, noteUUID, attachmentUUID)
+}
+
+// DownloadAttachment mocks base method.
+func (m *MockNoteAttachmentApp) DownloadAttachment(ctx context.Context, token string) (entity.NoteAttachmentDownload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadAttachment", ctx, token)
+	ret0, _ := ret[0].(entity.NoteAttachmentDownload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadAttachment indicates an expected call of DownloadAttachment.
+func (mr *MockNoteAttachmentAppMockRecorder) DownloadAttachment(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadAttachment", reflect.TypeOf((*MockNoteAttachmentApp)(nil).DownloadAttachment), ctx, token)
+}
+
+// GetNoteAttachments mocks base method.
+func (m *MockNoteAttachmentApp) GetNoteAttachments(ctx context.Context, noteUUID string) ([]entity.NoteAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoteAttachments", ctx, noteUUID)
+	ret0, _ := ret[0].([]entity.NoteAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoteAttachments indicates an expected call of GetNoteAttachments.
+func (mr *MockNoteAttachmentAppMockRecorder) GetNoteAttachments(ctx, noteUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteAttachments", reflect.TypeOf((*MockNoteAttachmentApp)(nil).GetNoteAttachments), ctx, noteUUID)
+}
+
+// UploadAttachment mocks base method.
+func (m *MockNoteAttachmentApp) UploadAttachment(ctx context.Context, noteUUID string, upload entity.NoteAttachmentUpload) (entity.NoteAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAttachment", ctx, noteUUID, upload)
+	ret0, _ := ret[0].(entity.NoteAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAttachment indicates an expected call of UploadAttachment.
+func (mr *MockNoteAttachmentAppMockRecorder) UploadAttachment(ctx, noteUUID, upload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAttachment", reflect.TypeOf((*MockNoteAttachmentApp)(nil).UploadAttachment), ctx, noteUUID, upload)
+}
+
+// MockNoteTemplateApp is a mock of NoteTemplateApp interface.
+type MockNoteTemplateApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockNoteTemplateAppMockRecorder
+	isgomock struct{}
+}
+
+// MockNoteTemplateAppMockRecorder is the mock recorder for MockNoteTemplateApp.
+type MockNoteTemplateAppMockRecorder struct {
+	mock *MockNoteTemplateApp
+}
+
+// NewMockNoteTemplateApp creates a new mock instance.
+func NewMockNoteTemplateApp(ctrl *gomock.Controller) *MockNoteTemplateApp {
+	mock := &MockNoteTemplateApp{ctrl: ctrl}
+	mock.recorder = &MockNoteTemplateAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNoteTemplateApp) EXPECT() *MockNoteTemplateAppMockRecorder {
+	return m.recorder
+}
+
+// CreateNoteTemplate mocks base method.
+func (m *MockNoteTemplateApp) CreateNoteTemplate(ctx context.Context, template entity.NoteTemplate, personal bool) (entity.NoteTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNoteTemplate", ctx, template, personal)
+	ret0, _ := ret[0].(entity.NoteTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNoteTemplate indicates an expected call of CreateNoteTemplate.
+func (mr *MockNoteTemplateAppMockRecorder) CreateNoteTemplate(ctx, template, personal any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNoteTemplate", reflect.TypeOf((*MockNoteTemplateApp)(nil).CreateNoteTemplate), ctx, template, personal)
+}
+
+// DeleteNoteTemplate mocks base method.
+func (m *MockNoteTemplateApp) DeleteNoteTemplate(ctx context.Context, templateUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNoteTemplate", ctx, templateUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNoteTemplate indicates an expected call of DeleteNoteTemplate.
+func (mr *MockNoteTemplateAppMockRecorder) DeleteNoteTemplate(ctx, templateUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNoteTemplate", reflect.TypeOf((*MockNoteTemplateApp)(nil).DeleteNoteTemplate), ctx, templateUUID)
+}
+
+// GetNoteTemplate mocks base method.
+func (m *MockNoteTemplateApp) GetNoteTemplate(ctx context.Context, templateUUID string) (entity.NoteTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoteTemplate", ctx, templateUUID)
+	ret0, _ := ret[0].(entity.NoteTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoteTemplate indicates an expected call of GetNoteTemplate.
+func (mr *MockNoteTemplateAppMockRecorder) GetNoteTemplate(ctx, templateUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteTemplate", reflect.TypeOf((*MockNoteTemplateApp)(nil).GetNoteTemplate), ctx, templateUUID)
+}
+
+// GetNoteTemplates mocks base method.
+func (m *MockNoteTemplateApp) GetNoteTemplates(ctx context.Context, noteType string) ([]entity.NoteTemplate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNoteTemplates", ctx, noteType)
+	ret0, _ := ret[0].([]entity.NoteTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNoteTemplates indicates an expected call of GetNoteTemplates.
+func (mr *MockNoteTemplateAppMockRecorder) GetNoteTemplates(ctx, noteType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNoteTemplates", reflect.TypeOf((*MockNoteTemplateApp)(nil).GetNoteTemplates), ctx, noteType)
+}
+
+// InstantiateNoteTemplate mocks base method.
+func (m *MockNoteTemplateApp) InstantiateNoteTemplate(ctx context.Context, templateUUID, personUUID string) (entity.NoteTemplateInstance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstantiateNoteTemplate", ctx, templateUUID, personUUID)
+	ret0, _ := ret[0].(entity.NoteTemplateInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InstantiateNoteTemplate indicates an expected call of InstantiateNoteTemplate.
+func (mr *MockNoteTemplateAppMockRecorder) InstantiateNoteTemplate(ctx, templateUUID, personUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstantiateNoteTemplate", reflect.TypeOf((*MockNoteTemplateApp)(nil).InstantiateNoteTemplate), ctx, templateUUID, personUUID)
+}
+
+// MockCadenceApp is a mock of CadenceApp interface.
+type MockCadenceApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockCadenceAppMockRecorder
+	isgomock struct{}
+}
+
+// MockCadenceAppMockRecorder is the mock recorder for MockCadenceApp.
+type MockCadenceAppMockRecorder struct {
+	mock *MockCadenceApp
+}
+
+// NewMockCadenceApp creates a new mock instance.
+func NewMockCadenceApp(ctrl *gomock.Controller) *MockCadenceApp {
+	mock := &MockCadenceApp{ctrl: ctrl}
+	mock.recorder = &MockCadenceAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCadenceApp) EXPECT() *MockCadenceAppMockRecorder {
+	return m.recorder
+}
+
+// GetCompanyOneOnOnesDue mocks base method.
+func (m *MockCadenceApp) GetCompanyOneOnOnesDue(ctx context.Context, companyID int64, loc *time.Location, days int) ([]entity.OneOnOneDue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompanyOneOnOnesDue", ctx, companyID, loc, days)
+	ret0, _ := ret[0].([]entity.OneOnOneDue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompanyOneOnOnesDue indicates an expected call of GetCompanyOneOnOnesDue.
+func (mr *MockCadenceAppMockRecorder) GetCompanyOneOnOnesDue(ctx, companyID, loc, days any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyOneOnOnesDue", reflect.TypeOf((*MockCadenceApp)(nil).GetCompanyOneOnOnesDue), ctx, companyID, loc, days)
+}
+
+// GetOneOnOnesDue mocks base method.
+func (m *MockCadenceApp) GetOneOnOnesDue(ctx context.Context, days int) ([]entity.OneOnOneDue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneOnOnesDue", ctx, days)
+	ret0, _ := ret[0].([]entity.OneOnOneDue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOneOnOnesDue indicates an expected call of GetOneOnOnesDue.
+func (mr *MockCadenceAppMockRecorder) GetOneOnOnesDue(ctx, days any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneOnOnesDue", reflect.TypeOf((*MockCadenceApp)(nil).GetOneOnOnesDue), ctx, days)
+}
+
+// UpdatePersonCadence mocks base method.
+func (m *MockCadenceApp) UpdatePersonCadence(ctx context.Context, personUUID string, cadence entity.OneOnOneCadence) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePersonCadence", ctx, personUUID, cadence)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePersonCadence indicates an expected call of UpdatePersonCadence.
+func (mr *MockCadenceAppMockRecorder) UpdatePersonCadence(ctx, personUUID, cadence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePersonCadence", reflect.TypeOf((*MockCadenceApp)(nil).UpdatePersonCadence), ctx, personUUID, cadence)
+}
+
+// MockCalendarApp is a mock of CalendarApp interface.
+type MockCalendarApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockCalendarAppMockRecorder
+	isgomock struct{}
+}
+
+// MockCalendarAppMockRecorder is the mock recorder for MockCalendarApp.
+type MockCalendarAppMockRecorder struct {
+	mock *MockCalendarApp
+}
+
+// NewMockCalendarApp creates a new mock instance.
+func NewMockCalendarApp(ctrl *gomock.Controller) *MockCalendarApp {
+	mock := &MockCalendarApp{ctrl: ctrl}
+	mock.recorder = &MockCalendarAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCalendarApp) EXPECT() *MockCalendarAppMockRecorder {
+	return m.recorder
+}
+
+// GenerateFeedToken mocks base method.
+func (m *MockCalendarApp) GenerateFeedToken(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateFeedToken", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateFeedToken indicates an expected call of GenerateFeedToken.
+func (mr *MockCalendarAppMockRecorder) GenerateFeedToken(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateFeedToken", reflect.TypeOf((*MockCalendarApp)(nil).GenerateFeedToken), ctx)
+}
+
+// GetFeed mocks base method.
+func (m *MockCalendarApp) GetFeed(ctx context.Context, token string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, token)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockCalendarAppMockRecorder) GetFeed(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockCalendarApp)(nil).GetFeed), ctx, token)
+}
+
+// ImportMeetings mocks base method.
+func (m *MockCalendarApp) ImportMeetings(ctx context.Context, input entity.CalendarImportInput) (entity.CalendarImportResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportMeetings", ctx, input)
+	ret0, _ := ret[0].(entity.CalendarImportResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportMeetings indicates an expected call of ImportMeetings.
+func (mr *MockCalendarAppMockRecorder) ImportMeetings(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportMeetings", reflect.TypeOf((*MockCalendarApp)(nil).ImportMeetings), ctx, input)
+}
+
+// RevokeFeedToken mocks base method.
+func (m *MockCalendarApp) RevokeFeedToken(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeFeedToken", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeFeedToken indicates an expected call of RevokeFeedToken.
+func (mr *MockCalendarAppMockRecorder) RevokeFeedToken(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFeedToken", reflect.TypeOf((*MockCalendarApp)(nil).RevokeFeedToken), ctx)
+}
+
+// MockReminderApp is a mock of ReminderApp interface.
+type MockReminderApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockReminderAppMockRecorder
+	isgomock struct{}
+}
+
+// MockReminderAppMockRecorder is the mock recorder for MockReminderApp.
+type MockReminderAppMockRecorder struct {
+	mock *MockReminderApp
+}
+
+// NewMockReminderApp creates a new mock instance.
+func NewMockReminderApp(ctrl *gomock.Controller) *MockReminderApp {
+	mock := &MockReminderApp{ctrl: ctrl}
+	mock.recorder = &MockReminderAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReminderApp) EXPECT() *MockReminderAppMockRecorder {
+	return m.recorder
+}
+
+// GetCompanyReminders mocks base method.
+func (m *MockReminderApp) GetCompanyReminders(ctx context.Context, companyID int64, loc *time.Location, days int) ([]entity.PersonReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompanyReminders", ctx, companyID, loc, days)
+	ret0, _ := ret[0].([]entity.PersonReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompanyReminders indicates an expected call of GetCompanyReminders.
+func (mr *MockReminderAppMockRecorder) GetCompanyReminders(ctx, companyID, loc, days any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyReminders", reflect.TypeOf((*MockReminderApp)(nil).GetCompanyReminders), ctx, companyID, loc, days)
+}
+
+// GetUpcomingReminders mocks base method.
+func (m *MockReminderApp) GetUpcomingReminders(ctx context.Context, days int) ([]entity.PersonReminder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpcomingReminders", ctx, days)
+	ret0, _ := ret[0].([]entity.PersonReminder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpcomingReminders indicates an expected call of GetUpcomingReminders.
+func (mr *MockReminderAppMockRecorder) GetUpcomingReminders(ctx, days any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingReminders", reflect.TypeOf((*MockReminderApp)(nil).GetUpcomingReminders), ctx, days)
+}
+
+// MockDashboardApp is a mock of DashboardApp interface.
+type MockDashboardApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockDashboardAppMockRecorder
+	isgomock struct{}
+}
+
+// MockDashboardAppMockRecorder is the mock recorder for MockDashboardApp.
+type MockDashboardAppMockRecorder struct {
+	mock *MockDashboardApp
+}
+
+// NewMockDashboardApp creates a new mock instance.
+func NewMockDashboardApp(ctrl *gomock.Controller) *MockDashboardApp {
+	mock := &MockDashboardApp{ctrl: ctrl}
+	mock.recorder = &MockDashboardAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDashboardApp) EXPECT() *MockDashboardAppMockRecorder {
+	return m.recorder
+}
+
+// GetDashboardData mocks base method.
+func (m *MockDashboardApp) GetDashboardData(ctx context.Context, companyUUID string) (entity.Dashboard, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDashboardData", ctx, companyUUID)
+	ret0, _ := ret[0].(entity.Dashboard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDashboardData indicates an expected call of GetDashboardData.
+func (mr *MockDashboardAppMockRecorder) GetDashboardData(ctx, companyUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDashboardData", reflect.TypeOf((*MockDashboardApp)(nil).GetDashboardData), ctx, companyUUID)
+}
+
+// MockAIApp is a mock of AIApp interface.
+type MockAIApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockAIAppMockRecorder
+	isgomock struct{}
+}
+
+// MockAIAppMockRecorder is the mock recorder for MockAIApp.
+type MockAIAppMockRecorder struct {
+	mock *MockAIApp
+}
+
+// NewMockAIApp creates a new mock instance.
+func NewMockAIApp(ctrl *gomock.Controller) *MockAIApp {
+	mock := &MockAIApp{ctrl: ctrl}
+	mock.recorder = &MockAIAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAIApp) EXPECT() *MockAIAppMockRecorder {
+	return m.recorder
+}
+
+// ChatWithLeadershipCoach mocks base method.
+func (m *MockAIApp) ChatWithLeadershipCoach(ctx context.Context, req entity.ChatRequest) (entity.ChatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChatWithLeadershipCoach", ctx, req)
+	ret0, _ := ret[0].(entity.ChatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChatWithLeadershipCoach indicates an expected call of ChatWithLeadershipCoach.
+func (mr *MockAIAppMockRecorder) ChatWithLeadershipCoach(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChatWithLeadershipCoach", reflect.TypeOf((*MockAIApp)(nil).ChatWithLeadershipCoach), ctx, req)
+}
+
+// ExtractAttributesFromNote mocks base method.
+func (m *MockAIApp) ExtractAttributesFromNote(ctx context.Context, noteID int64) (entity.AttributesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractAttributesFromNote", ctx, noteID)
+	ret0, _ := ret[0].(entity.AttributesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractAttributesFromNote indicates an expected call of ExtractAttributesFromNote.
+func (mr *MockAIAppMockRecorder) ExtractAttributesFromNote(ctx, noteID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractAttributesFromNote", reflect.TypeOf((*MockAIApp)(nil).ExtractAttributesFromNote), ctx, noteID)
+}
+
+// GetPersonContext mocks base method.
+func (m *MockAIApp) GetPersonContext(ctx context.Context, personID int64) (entity.PersonAIContext, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPersonContext", ctx, personID)
+	ret0, _ := ret[0].(entity.PersonAIContext)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPersonContext indicates an expected call of GetPersonContext.
+func (mr *MockAIAppMockRecorder) GetPersonContext(ctx, personID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonContext", reflect.TypeOf((*MockAIApp)(nil).GetPersonContext), ctx, personID)
+}
+
+// GetUsageReport mocks base method.
+func (m *MockAIApp) GetUsageReport(ctx context.Context, period string) (entity.AIUsageReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsageReport", ctx, period)
+	ret0, _ := ret[0].(entity.AIUsageReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsageReport indicates an expected call of GetUsageReport.
+func (mr *MockAIAppMockRecorder) GetUsageReport(ctx, period any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsageReport", reflect.TypeOf((*MockAIApp)(nil).GetUsageReport), ctx, period)
+}
+
+// SendFeedback mocks base method.
+func (m *MockAIApp) SendFeedback(ctx context.Context, usageID int64, feedback, comment string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendFeedback", ctx, usageID, feedback, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendFeedback indicates an expected call of SendFeedback.
+func (mr *MockAIAppMockRecorder) SendFeedback(ctx, usageID, feedback, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendFeedback", reflect.TypeOf((*MockAIApp)(nil).SendFeedback), ctx, usageID, feedback, comment)
+}
+
+// MockSCIMApp is a mock of SCIMApp interface.
+type MockSCIMApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockSCIMAppMockRecorder
+	isgomock struct{}
+}
+
+// MockSCIMAppMockRecorder is the mock recorder for MockSCIMApp.
+type MockSCIMAppMockRecorder struct {
+	mock *MockSCIMApp
+}
+
+// NewMockSCIMApp creates a new mock instance.
+func NewMockSCIMApp(ctrl *gomock.Controller) *MockSCIMApp {
+	mock := &MockSCIMApp{ctrl: ctrl}
+	mock.recorder = &MockSCIMAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSCIMApp) EXPECT() *MockSCIMAppMockRecorder {
+	return m.recorder
+}
+
+// CreateGroup mocks base method.
+func (m *MockSCIMApp) CreateGroup(ctx context.Context, group entity.SCIMGroup) (entity.SCIMGroup, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroup", ctx, group)
+	ret0, _ := ret[0].(entity.SCIMGroup)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateGroup indicates an expected call of CreateGroup.
+func (mr *MockSCIMAppMockRecorder) CreateGroup(ctx, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockSCIMApp)(nil).CreateGroup), ctx, group)
+}
+
+// CreateUser mocks base method.
+func (m *MockSCIMApp) CreateUser(ctx context.Context, user entity.SCIMUser) (entity.SCIMUser, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUser", ctx, user)
+	ret0, _ := ret[0].(entity.SCIMUser)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockSCIMAppMockRecorder) CreateUser(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockSCIMApp)(nil).CreateUser), ctx, user)
+}
+
+// DeactivateUser mocks base method.
+func (m *MockSCIMApp) DeactivateUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *MockSCIMAppMockRecorder) DeactivateUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*MockSCIMApp)(nil).DeactivateUser), ctx, userID)
+}
+
+// DeleteGroup mocks base method.
+func (m *MockSCIMApp) DeleteGroup(ctx context.Context, groupID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroup", ctx, groupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteGroup indicates an expected call of DeleteGroup.
+func (mr *MockSCIMAppMockRecorder) DeleteGroup(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockSCIMApp)(nil).DeleteGroup), ctx, groupID)
+}
+
+// GenerateSCIMToken mocks base method.
+func (m *MockSCIMApp) GenerateSCIMToken(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSCIMToken", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSCIMToken indicates an expected call of GenerateSCIMToken.
+func (mr *MockSCIMAppMockRecorder) GenerateSCIMToken(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSCIMToken", reflect.TypeOf((*MockSCIMApp)(nil).GenerateSCIMToken), ctx)
+}
+
+// GetGroup mocks base method.
+func (m *MockSCIMApp) GetGroup(ctx context.Context, groupID string) (entity.SCIMGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroup", ctx, groupID)
+	ret0, _ := ret[0].(entity.SCIMGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGroup indicates an expected call of GetGroup.
+func (mr *MockSCIMAppMockRecorder) GetGroup(ctx, groupID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockSCIMApp)(nil).GetGroup), ctx, groupID)
+}
+
+// GetGroups mocks base method.
+func (m *MockSCIMApp) GetGroups(ctx context.Context, params entity.SCIMListParams) ([]entity.SCIMGroup, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroups", ctx, params)
+	ret0, _ := ret[0].([]entity.SCIMGroup)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGroups indicates an expected call of GetGroups.
+func (mr *MockSCIMAppMockRecorder) GetGroups(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroups", reflect.TypeOf((*MockSCIMApp)(nil).GetGroups), ctx, params)
+}
+
+// GetUser mocks base method.
+func (m *MockSCIMApp) GetUser(ctx context.Context, userID string) (entity.SCIMUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
+	ret0, _ := ret[0].(entity.SCIMUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockSCIMAppMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockSCIMApp)(nil).GetUser), ctx, userID)
+}
+
+// GetUsers mocks base method.
+func (m *MockSCIMApp) GetUsers(ctx context.Context, params entity.SCIMListParams) ([]entity.SCIMUser, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsers", ctx, params)
+	ret0, _ := ret[0].([]entity.SCIMUser)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUsers indicates an expected call of GetUsers.
+func (mr *MockSCIMAppMockRecorder) GetUsers(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsers", reflect.TypeOf((*MockSCIMApp)(nil).GetUsers), ctx, params)
+}
+
+// ReplaceGroup mocks base method.
+func (m *MockSCIMApp) ReplaceGroup(ctx context.Context, groupID string, group entity.SCIMGroup) (entity.SCIMGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceGroup", ctx, groupID, group)
+	ret0, _ := ret[0].(entity.SCIMGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceGroup indicates an expected call of ReplaceGroup.
+func (mr *MockSCIMAppMockRecorder) ReplaceGroup(ctx, groupID, group any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceGroup", reflect.TypeOf((*MockSCIMApp)(nil).ReplaceGroup), ctx, groupID, group)
+}
+
+// ReplaceUser mocks base method.
+func (m *MockSCIMApp) ReplaceUser(ctx context.Context, userID string, user entity.SCIMUser) (entity.SCIMUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceUser", ctx, userID, user)
+	ret0, _ := ret[0].(entity.SCIMUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceUser indicates an expected call of ReplaceUser.
+func (mr *MockSCIMAppMockRecorder) ReplaceUser(ctx, userID, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceUser", reflect.TypeOf((*MockSCIMApp)(nil).ReplaceUser), ctx, userID, user)
+}
+
+// RevokeSCIMToken mocks base method.
+func (m *MockSCIMApp) RevokeSCIMToken(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSCIMToken", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSCIMToken indicates an expected call of RevokeSCIMToken.
+func (mr *MockSCIMAppMockRecorder) RevokeSCIMToken(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSCIMToken", reflect.TypeOf((*MockSCIMApp)(nil).RevokeSCIMToken), ctx)
+}
+
+// ValidateSCIMToken mocks base method.
+func (m *MockSCIMApp) ValidateSCIMToken(ctx context.Context, companyUUID, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateSCIMToken", ctx, companyUUID, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateSCIMToken indicates an expected call of ValidateSCIMToken.
+func (mr *MockSCIMAppMockRecorder) ValidateSCIMToken(ctx, companyUUID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateSCIMToken", reflect.TypeOf((*MockSCIMApp)(nil).ValidateSCIMToken), ctx, companyUUID, token)
+}
+
+// MockOutboxApp is a mock of OutboxApp interface.
+type MockOutboxApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxAppMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxAppMockRecorder is the mock recorder for MockOutboxApp.
+type MockOutboxAppMockRecorder struct {
+	mock *MockOutboxApp
+}
+
+// NewMockOutboxApp creates a new mock instance.
+func NewMockOutboxApp(ctrl *gomock.Controller) *MockOutboxApp {
+	mock := &MockOutboxApp{ctrl: ctrl}
+	mock.recorder = &MockOutboxAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxApp) EXPECT() *MockOutboxAppMockRecorder {
+	return m.recorder
+}
+
+// ProcessOutbox mocks base method.
+func (m *MockOutboxApp) ProcessOutbox(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessOutbox", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProcessOutbox indicates an expected call of ProcessOutbox.
+func (mr *MockOutboxAppMockRecorder) ProcessOutbox(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessOutbox", reflect.TypeOf((*MockOutboxApp)(nil).ProcessOutbox), ctx)
+}
+
+// MockJobApp is a mock of JobApp interface.
+type MockJobApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobAppMockRecorder
+	isgomock struct{}
+}
+
+// MockJobAppMockRecorder is the mock recorder for MockJobApp.
+type MockJobAppMockRecorder struct {
+	mock *MockJobApp
+}
+
+// NewMockJobApp creates a new mock instance.
+func NewMockJobApp(ctrl *gomock.Controller) *MockJobApp {
+	mock := &MockJobApp{ctrl: ctrl}
+	mock.recorder = &MockJobAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobApp) EXPECT() *MockJobAppMockRecorder {
+	return m.recorder
+}
+
+// DequeueJobs mocks base method.
+func (m *MockJobApp) DequeueJobs(ctx context.Context, queue string, limit int) ([]entity.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DequeueJobs", ctx, queue, limit)
+	ret0, _ := ret[0].([]entity.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DequeueJobs indicates an expected call of DequeueJobs.
+func (mr *MockJobAppMockRecorder) DequeueJobs(ctx, queue, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DequeueJobs", reflect.TypeOf((*MockJobApp)(nil).DequeueJobs), ctx, queue, limit)
+}
+
+// EnqueueJob mocks base method.
+func (m *MockJobApp) EnqueueJob(ctx context.Context, job entity.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueJob", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueJob indicates an expected call of EnqueueJob.
+func (mr *MockJobAppMockRecorder) EnqueueJob(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueJob", reflect.TypeOf((*MockJobApp)(nil).EnqueueJob), ctx, job)
+}
+
+// EnqueueScheduledJob mocks base method.
+func (m *MockJobApp) EnqueueScheduledJob(ctx context.Context, schedule entity.JobSchedule, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueScheduledJob", ctx, schedule, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueScheduledJob indicates an expected call of EnqueueScheduledJob.
+func (mr *MockJobAppMockRecorder) EnqueueScheduledJob(ctx, schedule, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueScheduledJob", reflect.TypeOf((*MockJobApp)(nil).EnqueueScheduledJob), ctx, schedule, at)
+}
+
+// GetDeadJobs mocks base method.
+func (m *MockJobApp) GetDeadJobs(ctx context.Context, queue string) ([]entity.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadJobs", ctx, queue)
+	ret0, _ := ret[0].([]entity.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadJobs indicates an expected call of GetDeadJobs.
+func (mr *MockJobAppMockRecorder) GetDeadJobs(ctx, queue any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadJobs", reflect.TypeOf((*MockJobApp)(nil).GetDeadJobs), ctx, queue)
+}
+
+// GetJobQueueState mocks base method.
+func (m *MockJobApp) GetJobQueueState(ctx context.Context) (entity.JobQueueState, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobQueueState", ctx)
+	ret0, _ := ret[0].(entity.JobQueueState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobQueueState indicates an expected call of GetJobQueueState.
+func (mr *MockJobAppMockRecorder) GetJobQueueState(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobQueueState", reflect.TypeOf((*MockJobApp)(nil).GetJobQueueState), ctx)
+}
+
+// GetQueues mocks base method.
+func (m *MockJobApp) GetQueues() []entity.JobQueueConfig {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQueues")
+	ret0, _ := ret[0].([]entity.JobQueueConfig)
+	return ret0
+}
+
+// GetQueues indicates an expected call of GetQueues.
+func (mr *MockJobAppMockRecorder) GetQueues() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQueues", reflect.TypeOf((*MockJobApp)(nil).GetQueues))
+}
+
+// GetSchedules mocks base method.
+func (m *MockJobApp) GetSchedules() []entity.JobSchedule {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedules")
+	ret0, _ := ret[0].([]entity.JobSchedule)
+	return ret0
+}
+
+// GetSchedules indicates an expected call of GetSchedules.
+func (mr *MockJobAppMockRecorder) GetSchedules() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedules", reflect.TypeOf((*MockJobApp)(nil).GetSchedules))
+}
+
+// RequeueDeadJob mocks base method.
+func (m *MockJobApp) RequeueDeadJob(ctx context.Context, jobUUID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueDeadJob", ctx, jobUUID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequeueDeadJob indicates an expected call of RequeueDeadJob.
+func (mr *MockJobAppMockRecorder) RequeueDeadJob(ctx, jobUUID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDeadJob", reflect.TypeOf((*MockJobApp)(nil).RequeueDeadJob), ctx, jobUUID)
+}
+
+// RunJob mocks base method.
+func (m *MockJobApp) RunJob(ctx context.Context, job entity.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunJob", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunJob indicates an expected call of RunJob.
+func (mr *MockJobAppMockRecorder) RunJob(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunJob", reflect.TypeOf((*MockJobApp)(nil).RunJob), ctx, job)
+}
+
+// ValidateAdminToken mocks base method.
+func (m *MockJobApp) ValidateAdminToken(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateAdminToken", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateAdminToken indicates an expected call of ValidateAdminToken.
+func (mr *MockJobAppMockRecorder) ValidateAdminToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateAdminToken", reflect.TypeOf((*MockJobApp)(nil).ValidateAdminToken), ctx, token)
 }
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/contract/storage.go
+//
+// Generated by this command:
+//
+//	mockgen -package mocks -source=internal/domain/contract/storage.go -destination=mocks/storage.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBlobStorage is a mock of BlobStorage interface.
+type MockBlobStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlobStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockBlobStorageMockRecorder is the mock recorder for MockBlobStorage.
+type MockBlobStorageMockRecorder struct {
+	mock *MockBlobStorage
+}
+
+// NewMockBlobStorage creates a new mock instance.
+func NewMockBlobStorage(ctrl *gomock.Controller) *MockBlobStorage {
+	mock := &MockBlobStorage{ctrl: ctrl}
+	mock.recorder = &MockBlobStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlobStorage) EXPECT() *MockBlobStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockBlobStorage) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockBlobStorageMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockBlobStorage)(nil).Delete), ctx, key)
+}
+
+// Get mocks base method.
+func (m *MockBlobStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockBlobStorageMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockBlobStorage)(nil).Get), ctx, key)
+}
+
+// Put mocks base method.
+func (m *MockBlobStorage) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, content, size, contentType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockBlobStorageMockRecorder) Put(ctx, key, content, size, contentType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockBlobStorage)(nil).Put), ctx, key, content, size, contentType)
+}
@@ -0,0 +1,17 @@
+package backoff
+
+import "time"
+
+// Exponential returns the delay before the next try after the given failed attempt (starting at 1),
+// doubling the base delay on each attempt up to the max delay
+func Exponential(attempt int, base, max time.Duration) time.Duration {
+	delay := base
+	for i := 1; i < attempt && delay < max; i++ {
+		delay *= 2
+	}
+
+	if delay > max {
+		return max
+	}
+	return delay
+}
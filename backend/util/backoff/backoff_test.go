@@ -0,0 +1,19 @@
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExponential(t *testing.T) {
+	base := 30 * time.Second
+
+	require.Equal(t, base, Exponential(0, base, time.Hour))
+	require.Equal(t, base, Exponential(1, base, time.Hour))
+	require.Equal(t, 2*base, Exponential(2, base, time.Hour))
+	require.Equal(t, 8*base, Exponential(4, base, time.Hour))
+	require.Equal(t, time.Hour, Exponential(10, base, time.Hour))
+	require.Equal(t, time.Hour, Exponential(1000, base, time.Hour))
+}
@@ -0,0 +1,169 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxSearchYears bounds the search of the next time, so impossible dates like "0 0 30 2 *" end
+const maxSearchYears = 5
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 6},
+}
+
+// Schedule is a parsed cron expression with the five standard fields: minute, hour, day of month, month and day of week
+type Schedule struct {
+	minutes, hours, days, months, weekdays map[int]bool
+
+	// As in standard cron, when both days are restricted a time matches either of them
+	daysRestricted, weekdaysRestricted bool
+}
+
+// Parse parses a cron expression like "*/15 2-4 * * 1,3". Each field accepts "*", numbers,
+// ranges ("a-b"), steps ("*/n" or "a-b/n") and lists of them separated by commas. Sunday is 0 or 7
+func Parse(spec string) (Schedule, error) {
+	parts := strings.Fields(spec)
+	if len(parts) != len(fields) {
+		return Schedule{}, fmt.Errorf("cron expression %q must have %d fields", spec, len(fields))
+	}
+
+	sets := make([]map[int]bool, len(fields))
+	for i, part := range parts {
+		limit := fields[i]
+		if i == 4 {
+			limit.max = 7 // 7 is also sunday
+		}
+
+		set, err := parseField(part, limit)
+		if err != nil {
+			return Schedule{}, fmt.Errorf("invalid %s in cron expression %q: %w", fields[i].name, spec, err)
+		}
+		sets[i] = set
+	}
+
+	if sets[4][7] {
+		delete(sets[4], 7)
+		sets[4][0] = true
+	}
+
+	return Schedule{
+		minutes:            sets[0],
+		hours:              sets[1],
+		days:               sets[2],
+		months:             sets[3],
+		weekdays:           sets[4],
+		daysRestricted:     parts[2] != "*",
+		weekdaysRestricted: parts[4] != "*",
+	}, nil
+}
+
+func parseField(value string, limit field) (map[int]bool, error) {
+	set := make(map[int]bool)
+
+	for _, item := range strings.Split(value, ",") {
+		rangePart, stepPart, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step <= 0 {
+				return nil, fmt.Errorf("invalid step %q", stepPart)
+			}
+		}
+
+		start, end := limit.min, limit.max
+		switch {
+		case rangePart == "*":
+		case strings.Contains(rangePart, "-"):
+			first, last, _ := strings.Cut(rangePart, "-")
+			var err error
+			if start, err = parseNumber(first, limit); err != nil {
+				return nil, err
+			}
+			if end, err = parseNumber(last, limit); err != nil {
+				return nil, err
+			}
+			if start > end {
+				return nil, fmt.Errorf("invalid range %q", rangePart)
+			}
+		default:
+			number, err := parseNumber(rangePart, limit)
+			if err != nil {
+				return nil, err
+			}
+			start = number
+			if !hasStep {
+				end = number
+			}
+		}
+
+		for n := start; n <= end; n += step {
+			set[n] = true
+		}
+	}
+
+	return set, nil
+}
+
+func parseNumber(value string, limit field) (int, error) {
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid number %q", value)
+	}
+	if number < limit.min || number > limit.max {
+		return 0, fmt.Errorf("%d is out of the range %d-%d", number, limit.min, limit.max)
+	}
+	return number, nil
+}
+
+// Next returns the first time after the given time that matches the schedule, in the location of the given time.
+// It returns the zero time when no time matches in the next years
+func (s Schedule) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := after.AddDate(maxSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if !s.months[int(t.Month())] {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.hours[t.Hour()] {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if !s.minutes[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s Schedule) matchDay(t time.Time) bool {
+	dayMatch := s.days[t.Day()]
+	weekdayMatch := s.weekdays[int(t.Weekday())]
+
+	if s.daysRestricted && s.weekdaysRestricted {
+		return dayMatch || weekdayMatch
+	}
+	return dayMatch && weekdayMatch
+}
@@ -0,0 +1,63 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	invalid := []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"a * * * *",
+	}
+	for _, spec := range invalid {
+		_, err := Parse(spec)
+		require.Error(t, err, spec)
+	}
+
+	_, err := Parse("*/15 2-4 1,15 * 1-5/2")
+	require.NoError(t, err)
+}
+
+func TestScheduleNext(t *testing.T) {
+	from := time.Date(2026, 10, 19, 10, 30, 45, 0, time.UTC) // monday
+
+	tests := []struct {
+		spec string
+		want time.Time
+	}{
+		{spec: "* * * * *", want: time.Date(2026, 10, 19, 10, 31, 0, 0, time.UTC)},
+		{spec: "*/15 * * * *", want: time.Date(2026, 10, 19, 10, 45, 0, 0, time.UTC)},
+		{spec: "0 2 * * *", want: time.Date(2026, 10, 20, 2, 0, 0, 0, time.UTC)},
+		{spec: "30 10 * * *", want: time.Date(2026, 10, 20, 10, 30, 0, 0, time.UTC)},
+		{spec: "0 9 * * 0", want: time.Date(2026, 10, 25, 9, 0, 0, 0, time.UTC)},
+		{spec: "0 9 * * 7", want: time.Date(2026, 10, 25, 9, 0, 0, 0, time.UTC)},
+		{spec: "0 0 1 * *", want: time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 1 1 *", want: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{spec: "0 0 29 2 *", want: time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// either the day of month or the day of week
+		{spec: "0 12 25 * 3", want: time.Date(2026, 10, 21, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			schedule, err := Parse(tt.spec)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, schedule.Next(from))
+		})
+	}
+
+	schedule, err := Parse("0 0 30 2 *")
+	require.NoError(t, err)
+	require.True(t, schedule.Next(from).IsZero())
+}
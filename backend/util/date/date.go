@@ -0,0 +1,87 @@
+package date
+
+import (
+	"time"
+)
+
+// Day returns the calendar day of t as midnight UTC, dropping the time and the location.
+// It is used to compare dates stored without time (birthday, start date) with the current day of a timezone
+func Day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// Today returns the current calendar day in the given location as midnight UTC
+func Today(now time.Time, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return Day(now.In(loc))
+}
+
+// IsLeapYear reports whether year is a leap year
+func IsLeapYear(year int) bool {
+	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
+}
+
+// LastDayOfMonth returns the last day of the month of t
+func LastDayOfMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// AnniversaryInYear returns the anniversary of date in the given year.
+// Dates on February 29 fall on February 28 in non leap years
+func AnniversaryInYear(date time.Time, year int) time.Time {
+	month, day := date.Month(), date.Day()
+	if month == time.February && day == 29 && !IsLeapYear(year) {
+		day = 28
+	}
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// NextAnniversary returns the first anniversary of date on or after the day from
+func NextAnniversary(date, from time.Time) time.Time {
+	from = Day(from)
+	next := AnniversaryInYear(date, from.Year())
+	if next.Before(from) {
+		next = AnniversaryInYear(date, from.Year()+1)
+	}
+	return next
+}
+
+// YearsBetween returns the number of complete years from the day from until the day to
+func YearsBetween(from, to time.Time) int {
+	from, to = Day(from), Day(to)
+	years := to.Year() - from.Year()
+	if to.Before(AnniversaryInYear(from, to.Year())) {
+		years--
+	}
+	return years
+}
+
+// MonthsBetween returns the number of complete calendar months from the day from until the day to.
+// A month is complete when the same day of month is reached, or the last day of a shorter month
+func MonthsBetween(from, to time.Time) int {
+	from, to = Day(from), Day(to)
+	months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+	if to.Day() < from.Day() && to.Day() != LastDayOfMonth(to) {
+		months--
+	}
+	return months
+}
+
+// DaysBetween returns the number of calendar days from the day from until the day to
+func DaysBetween(from, to time.Time) int {
+	return int(Day(to).Sub(Day(from)).Hours() / 24)
+}
+
+// AddMonths adds months to the day of t. When the day does not exist in the resulting month,
+// the last day of that month is used, so January 31 plus one month is February 28 (or 29)
+func AddMonths(t time.Time, months int) time.Time {
+	t = Day(t)
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	d := t.Day()
+	if last := LastDayOfMonth(first); d > last {
+		d = last
+	}
+	return time.Date(first.Year(), first.Month(), d, 0, 0, 0, 0, time.UTC)
+}
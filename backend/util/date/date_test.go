@@ -0,0 +1,105 @@
+package date
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestToday(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	now := time.Date(2025, time.March, 10, 1, 30, 0, 0, time.UTC)
+
+	require.Equal(t, day(2025, time.March, 10), Today(now, nil))
+	require.Equal(t, day(2025, time.March, 9), Today(now, saoPaulo))
+	require.Equal(t, day(2025, time.March, 10), Today(now, tokyo))
+}
+
+func TestAnniversaryInYear(t *testing.T) {
+	leapDay := day(2000, time.February, 29)
+
+	require.Equal(t, day(2024, time.February, 29), AnniversaryInYear(leapDay, 2024))
+	require.Equal(t, day(2025, time.February, 28), AnniversaryInYear(leapDay, 2025))
+	require.Equal(t, day(2100, time.February, 28), AnniversaryInYear(leapDay, 2100))
+	require.Equal(t, day(2025, time.July, 15), AnniversaryInYear(day(1990, time.July, 15), 2025))
+}
+
+func TestNextAnniversary(t *testing.T) {
+	tests := []struct {
+		name string
+		date time.Time
+		from time.Time
+		want time.Time
+	}{
+		{"later this year", day(1990, time.July, 15), day(2025, time.March, 10), day(2025, time.July, 15)},
+		{"today", day(1990, time.March, 10), day(2025, time.March, 10), day(2025, time.March, 10)},
+		{"already passed", day(1990, time.January, 5), day(2025, time.March, 10), day(2026, time.January, 5)},
+		{"leap day in non leap year", day(2000, time.February, 29), day(2025, time.February, 1), day(2025, time.February, 28)},
+		{"leap day in leap year", day(2000, time.February, 29), day(2028, time.February, 1), day(2028, time.February, 29)},
+		{"leap day after february 28", day(2000, time.February, 29), day(2025, time.March, 1), day(2026, time.February, 28)},
+		{"year boundary", day(1990, time.January, 2), day(2025, time.December, 30), day(2026, time.January, 2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, NextAnniversary(tt.date, tt.from))
+		})
+	}
+}
+
+func TestYearsBetween(t *testing.T) {
+	require.Equal(t, 35, YearsBetween(day(1990, time.March, 10), day(2025, time.March, 10)))
+	require.Equal(t, 34, YearsBetween(day(1990, time.March, 11), day(2025, time.March, 10)))
+	require.Equal(t, 35, YearsBetween(day(1990, time.January, 1), day(2025, time.December, 31)))
+	require.Equal(t, 25, YearsBetween(day(2000, time.February, 29), day(2025, time.February, 28)))
+	require.Equal(t, 24, YearsBetween(day(2000, time.February, 29), day(2025, time.February, 27)))
+	require.Equal(t, 0, YearsBetween(day(2025, time.March, 10), day(2025, time.March, 10)))
+}
+
+func TestMonthsBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+		want int
+	}{
+		{"same day", day(2025, time.March, 10), day(2025, time.March, 10), 0},
+		{"day before first month", day(2025, time.January, 10), day(2025, time.February, 9), 0},
+		{"exactly one month", day(2025, time.January, 10), day(2025, time.February, 10), 1},
+		{"end of month into shorter month", day(2025, time.January, 31), day(2025, time.February, 28), 1},
+		{"end of month before last day", day(2025, time.January, 31), day(2025, time.February, 27), 0},
+		{"across years", day(2022, time.November, 15), day(2025, time.March, 14), 27},
+		{"three years", day(2022, time.March, 14), day(2025, time.March, 14), 36},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, MonthsBetween(tt.from, tt.to))
+		})
+	}
+}
+
+func TestDaysBetween(t *testing.T) {
+	require.Equal(t, 0, DaysBetween(day(2025, time.March, 10), day(2025, time.March, 10)))
+	require.Equal(t, 7, DaysBetween(day(2025, time.February, 25), day(2025, time.March, 4)))
+	require.Equal(t, 366, DaysBetween(day(2024, time.January, 1), day(2025, time.January, 1)))
+	require.Equal(t, 1, DaysBetween(time.Date(2025, time.March, 10, 23, 0, 0, 0, time.UTC), day(2025, time.March, 11)))
+}
+
+func TestAddMonths(t *testing.T) {
+	require.Equal(t, day(2025, time.April, 10), AddMonths(day(2025, time.March, 10), 1))
+	require.Equal(t, day(2025, time.February, 28), AddMonths(day(2025, time.January, 31), 1))
+	require.Equal(t, day(2024, time.February, 29), AddMonths(day(2024, time.January, 31), 1))
+	require.Equal(t, day(2026, time.January, 15), AddMonths(day(2025, time.December, 15), 1))
+	require.Equal(t, day(2025, time.April, 30), AddMonths(day(2025, time.March, 31), 1))
+	require.Equal(t, day(2025, time.March, 10), AddMonths(time.Date(2025, time.February, 10, 22, 30, 0, 0, time.UTC), 1))
+}
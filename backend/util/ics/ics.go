@@ -0,0 +1,561 @@
+// Package ics reads and writes iCalendar (RFC 5545) files, supporting the event properties used by 1:1 meetings
+package ics
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/diegoclair/leaderpro/util/date"
+)
+
+var (
+	ErrInvalidCalendar = errors.New("invalid iCalendar file, it must start with BEGIN:VCALENDAR")
+)
+
+const (
+	dateLayout     = "20060102"
+	dateTimeLayout = "20060102T150405"
+	utcLayout      = "20060102T150405Z"
+
+	maxLineOctets = 75
+
+	// maxOccurrences stops the expansion of rules that never reach the requested date
+	maxOccurrences = 10000
+)
+
+const (
+	FreqDaily   = "DAILY"
+	FreqWeekly  = "WEEKLY"
+	FreqMonthly = "MONTHLY"
+	FreqYearly  = "YEARLY"
+)
+
+// Attendee is a participant of an event, the email comes from the mailto: address
+type Attendee struct {
+	Email string
+	Name  string
+}
+
+// RRule is the recurrence rule of an event. The BY* parts are not supported, occurrences repeat
+// the weekday, day of month and time of DTSTART, which is how recurring 1:1s are created by calendar apps
+type RRule struct {
+	Freq     string
+	Interval int
+	Count    int        // 0 means no limit
+	Until    *time.Time // inclusive
+}
+
+// Event is a VEVENT. All day events have Start and End at midnight of the default location,
+// with End being the day after the last day of the event
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Status      string // TENTATIVE, CONFIRMED, CANCELLED
+	URL         string
+	Start       time.Time
+	End         time.Time
+	AllDay      bool
+	Organizer   *Attendee
+	Attendees   []Attendee
+	RRule       *RRule
+}
+
+// IsCancelled returns true when the organizer canceled the event
+func (e Event) IsCancelled() bool {
+	return strings.EqualFold(e.Status, "CANCELLED")
+}
+
+// Duration returns the time between the start and the end of the event, zero when the end is not set
+func (e Event) Duration() time.Duration {
+	if e.End.Before(e.Start) {
+		return 0
+	}
+	return e.End.Sub(e.Start)
+}
+
+// NextOccurrence returns the first start of the event on or after from. It returns false when the
+// event, or all the occurrences of a recurring event, started before from
+func (e Event) NextOccurrence(from time.Time) (time.Time, bool) {
+	if e.Start.IsZero() {
+		return time.Time{}, false
+	}
+
+	if e.RRule == nil {
+		return e.Start, !e.Start.Before(from)
+	}
+
+	interval := e.RRule.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	for n := 0; n < maxOccurrences; n++ {
+		if e.RRule.Count > 0 && n >= e.RRule.Count {
+			return time.Time{}, false
+		}
+
+		occurrence, ok := e.occurrence(n * interval)
+		if !ok {
+			return time.Time{}, false
+		}
+		if e.RRule.Until != nil && occurrence.After(*e.RRule.Until) {
+			return time.Time{}, false
+		}
+		if !occurrence.Before(from) {
+			return occurrence, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// occurrence returns the start of the event after the given number of FREQ periods, keeping the wall clock
+// time of DTSTART across daylight saving changes
+func (e Event) occurrence(periods int) (time.Time, bool) {
+	switch e.RRule.Freq {
+	case FreqDaily:
+		return e.Start.AddDate(0, 0, periods), true
+	case FreqWeekly:
+		return e.Start.AddDate(0, 0, 7*periods), true
+	case FreqMonthly, FreqYearly:
+		months := periods
+		if e.RRule.Freq == FreqYearly {
+			months *= 12
+		}
+		day := date.AddMonths(e.Start, months)
+		return time.Date(day.Year(), day.Month(), day.Day(),
+			e.Start.Hour(), e.Start.Minute(), e.Start.Second(), 0, e.Start.Location()), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// Calendar is a VCALENDAR published by the application
+type Calendar struct {
+	ProdID string
+	Name   string
+	// RefreshInterval is how often subscribed calendar apps should fetch the feed again
+	RefreshInterval time.Duration
+	Events          []Event
+}
+
+// contentLine is a property of the file already unfolded, like DTSTART;TZID=America/Sao_Paulo:20250310T140000
+type contentLine struct {
+	Name   string
+	Params map[string]string
+	Value  string
+}
+
+// Parse reads the events of an iCalendar file. Floating times and times with an unknown TZID
+// are read in defaultLoc. Properties of nested components (like VALARM) are ignored
+func Parse(data []byte, defaultLoc *time.Location) ([]Event, error) {
+	if defaultLoc == nil {
+		defaultLoc = time.UTC
+	}
+
+	lines := unfold(data)
+	if len(lines) == 0 || !strings.EqualFold(strings.TrimSpace(lines[0]), "BEGIN:VCALENDAR") {
+		return nil, ErrInvalidCalendar
+	}
+
+	var (
+		events   []Event
+		current  *Event
+		duration time.Duration
+		hasEnd   bool
+		depth    int // nested components inside the current event
+	)
+
+	for i, raw := range lines {
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+
+		line, err := parseContentLine(raw)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		switch {
+		case line.Name == "BEGIN" && strings.EqualFold(line.Value, "VEVENT") && current == nil:
+			current = &Event{}
+			duration, hasEnd, depth = 0, false, 0
+			continue
+		case line.Name == "END" && strings.EqualFold(line.Value, "VEVENT") && current != nil && depth == 0:
+			if !hasEnd && !current.Start.IsZero() {
+				current.End = current.Start.Add(duration)
+				if current.AllDay && duration == 0 {
+					current.End = current.Start.AddDate(0, 0, 1)
+				}
+			}
+			events = append(events, *current)
+			current = nil
+			continue
+		case current == nil:
+			continue
+		case line.Name == "BEGIN":
+			depth++
+			continue
+		case line.Name == "END":
+			depth--
+			continue
+		case depth > 0:
+			continue
+		}
+
+		switch line.Name {
+		case "UID":
+			current.UID = line.Value
+		case "SUMMARY":
+			current.Summary = unescapeText(line.Value)
+		case "DESCRIPTION":
+			current.Description = unescapeText(line.Value)
+		case "LOCATION":
+			current.Location = unescapeText(line.Value)
+		case "STATUS":
+			current.Status = strings.ToUpper(line.Value)
+		case "URL":
+			current.URL = line.Value
+		case "DTSTART":
+			current.Start, current.AllDay = parseDateTime(line, defaultLoc)
+		case "DTEND":
+			current.End, _ = parseDateTime(line, defaultLoc)
+			hasEnd = !current.End.IsZero()
+		case "DURATION":
+			duration = parseDuration(line.Value)
+		case "ORGANIZER":
+			organizer := parseAttendee(line)
+			current.Organizer = &organizer
+		case "ATTENDEE":
+			current.Attendees = append(current.Attendees, parseAttendee(line))
+		case "RRULE":
+			current.RRule = parseRRule(line.Value, defaultLoc)
+		}
+	}
+
+	return events, nil
+}
+
+// unfold joins the lines split by the 75 octets limit, continuation lines start with a space or a tab
+func unfold(data []byte) []string {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\r", "\n")
+
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+// parseContentLine splits name, parameters and value. Colons and semicolons inside quoted parameter values
+// are part of the value, like in CN="Silva; Ana"
+func parseContentLine(raw string) (contentLine, error) {
+	line := contentLine{Params: map[string]string{}}
+
+	inQuotes := false
+	separator := -1
+	for i, r := range raw {
+		if r == '"' {
+			inQuotes = !inQuotes
+		}
+		if r == ':' && !inQuotes {
+			separator = i
+			break
+		}
+	}
+	if separator < 0 {
+		return line, fmt.Errorf("invalid content line %q", raw)
+	}
+
+	line.Value = raw[separator+1:]
+
+	parts := splitOutsideQuotes(raw[:separator], ';')
+	line.Name = strings.ToUpper(strings.TrimSpace(parts[0]))
+	for _, param := range parts[1:] {
+		key, value, _ := strings.Cut(param, "=")
+		line.Params[strings.ToUpper(strings.TrimSpace(key))] = strings.Trim(value, `"`)
+	}
+
+	return line, nil
+}
+
+func splitOutsideQuotes(value string, separator rune) []string {
+	var (
+		parts    []string
+		start    int
+		inQuotes bool
+	)
+
+	for i, r := range value {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+		case r == separator && !inQuotes:
+			parts = append(parts, value[start:i])
+			start = i + utf8.RuneLen(r)
+		}
+	}
+
+	return append(parts, value[start:])
+}
+
+// parseDateTime reads DATE and DATE-TIME values, returning a zero time when the value is invalid
+func parseDateTime(line contentLine, defaultLoc *time.Location) (time.Time, bool) {
+	value := strings.TrimSpace(line.Value)
+
+	if strings.EqualFold(line.Params["VALUE"], "DATE") || len(value) == len(dateLayout) {
+		day, err := time.ParseInLocation(dateLayout, value, defaultLoc)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return day, true
+	}
+
+	if strings.HasSuffix(strings.ToUpper(value), "Z") {
+		t, err := time.Parse(utcLayout, strings.ToUpper(value))
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, false
+	}
+
+	loc := defaultLoc
+	if tzid := line.Params["TZID"]; tzid != "" {
+		if tzLoc, err := time.LoadLocation(strings.TrimPrefix(tzid, "/")); err == nil {
+			loc = tzLoc
+		}
+	}
+
+	t, err := time.ParseInLocation(dateTimeLayout, value, loc)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, false
+}
+
+// parseDuration reads durations like PT30M, PT1H30M, P1D or P1W. Invalid values are zero
+func parseDuration(value string) time.Duration {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	sign := time.Duration(1)
+	if strings.HasPrefix(value, "-") {
+		sign = -1
+	}
+	value = strings.TrimLeft(value, "+-")
+
+	if !strings.HasPrefix(value, "P") {
+		return 0
+	}
+
+	var (
+		total  time.Duration
+		number string
+		inTime bool
+	)
+
+	for _, r := range value[1:] {
+		if r >= '0' && r <= '9' {
+			number += string(r)
+			continue
+		}
+		if r == 'T' {
+			inTime = true
+			continue
+		}
+
+		n, err := strconv.Atoi(number)
+		if err != nil {
+			return 0
+		}
+		number = ""
+
+		switch {
+		case r == 'W':
+			total += time.Duration(n) * 7 * 24 * time.Hour
+		case r == 'D':
+			total += time.Duration(n) * 24 * time.Hour
+		case r == 'H' && inTime:
+			total += time.Duration(n) * time.Hour
+		case r == 'M' && inTime:
+			total += time.Duration(n) * time.Minute
+		case r == 'S' && inTime:
+			total += time.Duration(n) * time.Second
+		default:
+			return 0
+		}
+	}
+
+	return sign * total
+}
+
+func parseAttendee(line contentLine) Attendee {
+	email := strings.TrimSpace(line.Value)
+	if len(email) >= len("mailto:") && strings.EqualFold(email[:len("mailto:")], "mailto:") {
+		email = email[len("mailto:"):]
+	}
+
+	return Attendee{
+		Email: strings.ToLower(strings.TrimSpace(email)),
+		Name:  unescapeText(line.Params["CN"]),
+	}
+}
+
+// parseRRule reads the FREQ, INTERVAL, COUNT and UNTIL parts. Unsupported frequencies return nil,
+// so the event is handled as a single occurrence
+func parseRRule(value string, defaultLoc *time.Location) *RRule {
+	rule := &RRule{Interval: 1}
+
+	for _, part := range strings.Split(value, ";") {
+		key, val, _ := strings.Cut(part, "=")
+		switch strings.ToUpper(strings.TrimSpace(key)) {
+		case "FREQ":
+			rule.Freq = strings.ToUpper(val)
+		case "INTERVAL":
+			if n, err := strconv.Atoi(val); err == nil && n > 0 {
+				rule.Interval = n
+			}
+		case "COUNT":
+			if n, err := strconv.Atoi(val); err == nil && n > 0 {
+				rule.Count = n
+			}
+		case "UNTIL":
+			until, allDay := parseDateTime(contentLine{Value: val}, defaultLoc)
+			if !until.IsZero() {
+				if allDay {
+					until = until.AddDate(0, 0, 1).Add(-time.Second)
+				}
+				rule.Until = &until
+			}
+		}
+	}
+
+	switch rule.Freq {
+	case FreqDaily, FreqWeekly, FreqMonthly, FreqYearly:
+		return rule
+	default:
+		return nil
+	}
+}
+
+var textUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, `;`, `\,`, `,`, `\n`, "\n", `\N`, "\n")
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func unescapeText(value string) string {
+	return textUnescaper.Replace(value)
+}
+
+func escapeText(value string) string {
+	return textEscaper.Replace(value)
+}
+
+// Marshal writes the calendar with CRLF line endings and lines folded at 75 octets.
+// Times are written in UTC, stamp is the DTSTAMP of the events
+func (c Calendar) Marshal(stamp time.Time) []byte {
+	var buf bytes.Buffer
+
+	write := func(line string) {
+		writeFolded(&buf, line)
+	}
+
+	write("BEGIN:VCALENDAR")
+	write("VERSION:2.0")
+	write("PRODID:" + c.ProdID)
+	write("CALSCALE:GREGORIAN")
+	write("METHOD:PUBLISH")
+	if c.Name != "" {
+		write("X-WR-CALNAME:" + escapeText(c.Name))
+	}
+	if c.RefreshInterval > 0 {
+		interval := formatDuration(c.RefreshInterval)
+		write("REFRESH-INTERVAL;VALUE=DURATION:" + interval)
+		write("X-PUBLISHED-TTL:" + interval)
+	}
+
+	for _, event := range c.Events {
+		write("BEGIN:VEVENT")
+		write("UID:" + event.UID)
+		write("DTSTAMP:" + stamp.UTC().Format(utcLayout))
+		if event.AllDay {
+			end := event.End
+			if !end.After(event.Start) {
+				end = event.Start.AddDate(0, 0, 1)
+			}
+			write("DTSTART;VALUE=DATE:" + event.Start.Format(dateLayout))
+			write("DTEND;VALUE=DATE:" + end.Format(dateLayout))
+		} else {
+			write("DTSTART:" + event.Start.UTC().Format(utcLayout))
+			if event.End.After(event.Start) {
+				write("DTEND:" + event.End.UTC().Format(utcLayout))
+			}
+		}
+		write("SUMMARY:" + escapeText(event.Summary))
+		if event.Description != "" {
+			write("DESCRIPTION:" + escapeText(event.Description))
+		}
+		if event.Location != "" {
+			write("LOCATION:" + escapeText(event.Location))
+		}
+		if event.URL != "" {
+			write("URL:" + event.URL)
+		}
+		if event.Status != "" {
+			write("STATUS:" + event.Status)
+		}
+		if event.AllDay {
+			write("TRANSP:TRANSPARENT")
+		}
+		write("END:VEVENT")
+	}
+
+	write("END:VCALENDAR")
+
+	return buf.Bytes()
+}
+
+// writeFolded writes the line splitting it every 75 octets without breaking UTF-8 characters
+func writeFolded(buf *bytes.Buffer, line string) {
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		buf.WriteString(line[:cut])
+		buf.WriteString("\r\n ")
+		line = line[cut:]
+		limit = maxLineOctets - 1 // the leading space counts
+	}
+	buf.WriteString(line)
+	buf.WriteString("\r\n")
+}
+
+// formatDuration writes positive durations with hour precision or more, like PT1H or P1D
+func formatDuration(d time.Duration) string {
+	if d%(24*time.Hour) == 0 {
+		return fmt.Sprintf("P%dD", d/(24*time.Hour))
+	}
+	hours := d / time.Hour
+	minutes := (d % time.Hour) / time.Minute
+	if minutes == 0 {
+		return fmt.Sprintf("PT%dH", hours)
+	}
+	if hours == 0 {
+		return fmt.Sprintf("PT%dM", minutes)
+	}
+	return fmt.Sprintf("PT%dH%dM", hours, minutes)
+}
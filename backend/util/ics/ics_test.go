@@ -0,0 +1,195 @@
+package ics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const googleCalendarExport = "BEGIN:VCALENDAR\r\n" +
+	"PRODID:-//Google Inc//Google Calendar 70.9054//EN\r\n" +
+	"VERSION:2.0\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:America/Sao_Paulo\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;TZID=America/Sao_Paulo:20250310T140000\r\n" +
+	"DTEND;TZID=America/Sao_Paulo:20250310T143000\r\n" +
+	"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO\r\n" +
+	"UID:1on1-ana@google.com\r\n" +
+	"ORGANIZER;CN=Diego:mailto:diego@empresa.com\r\n" +
+	"ATTENDEE;CUTYPE=INDIVIDUAL;ROLE=REQ-PARTICIPANT;PARTSTAT=ACCEPTED;CN=\"Silva; An\r\n" +
+	" a\";X-NUM-GUESTS=0:mailto:Ana.Silva@empresa.com\r\n" +
+	"ATTENDEE;CN=Diego:MAILTO:diego@empresa.com\r\n" +
+	"SUMMARY:1:1 Diego / Ana\r\n" +
+	"DESCRIPTION:Pauta:\\n- carreira\\, feedback\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"DESCRIPTION:This is an event reminder\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART;VALUE=DATE:20250314\r\n" +
+	"UID:holiday@google.com\r\n" +
+	"SUMMARY:Holiday\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTSTART:20250311T170000Z\r\n" +
+	"DURATION:PT45M\r\n" +
+	"UID:canceled@google.com\r\n" +
+	"STATUS:CANCELLED\r\n" +
+	"SUMMARY:Sync\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+func TestParse(t *testing.T) {
+	saoPaulo, err := time.LoadLocation("America/Sao_Paulo")
+	require.NoError(t, err)
+
+	t.Run("Should read the events of a calendar export", func(t *testing.T) {
+		events, err := Parse([]byte(googleCalendarExport), time.UTC)
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+
+		oneOnOne := events[0]
+		require.Equal(t, "1on1-ana@google.com", oneOnOne.UID)
+		require.Equal(t, "1:1 Diego / Ana", oneOnOne.Summary)
+		require.Equal(t, "Pauta:\n- carreira, feedback", oneOnOne.Description)
+		require.True(t, time.Date(2025, time.March, 10, 14, 0, 0, 0, saoPaulo).Equal(oneOnOne.Start))
+		require.Equal(t, 30*time.Minute, oneOnOne.Duration())
+		require.False(t, oneOnOne.AllDay)
+		require.Equal(t, &Attendee{Email: "diego@empresa.com", Name: "Diego"}, oneOnOne.Organizer)
+		require.Equal(t, []Attendee{
+			{Email: "ana.silva@empresa.com", Name: "Silva; Ana"},
+			{Email: "diego@empresa.com", Name: "Diego"},
+		}, oneOnOne.Attendees)
+		require.Equal(t, &RRule{Freq: FreqWeekly, Interval: 2}, oneOnOne.RRule)
+
+		holiday := events[1]
+		require.True(t, holiday.AllDay)
+		require.Equal(t, time.Date(2025, time.March, 15, 0, 0, 0, 0, time.UTC), holiday.End)
+
+		canceled := events[2]
+		require.True(t, canceled.IsCancelled())
+		require.Equal(t, 45*time.Minute, canceled.Duration())
+		require.Equal(t, time.UTC, canceled.Start.Location())
+	})
+
+	t.Run("Should read floating times in the default location", func(t *testing.T) {
+		data := "BEGIN:VCALENDAR\nBEGIN:VEVENT\nUID:a\nDTSTART:20250310T090000\nEND:VEVENT\nEND:VCALENDAR\n"
+
+		events, err := Parse([]byte(data), saoPaulo)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.True(t, time.Date(2025, time.March, 10, 9, 0, 0, 0, saoPaulo).Equal(events[0].Start))
+	})
+
+	t.Run("Should return error when the file is not a calendar", func(t *testing.T) {
+		_, err := Parse([]byte("name,email\n"), time.UTC)
+		require.ErrorIs(t, err, ErrInvalidCalendar)
+	})
+}
+
+func TestEvent_NextOccurrence(t *testing.T) {
+	start := time.Date(2025, time.January, 31, 14, 0, 0, 0, time.UTC)
+	until := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		rule     *RRule
+		from     time.Time
+		expected time.Time
+		ok       bool
+	}{
+		{
+			name:     "Should return the start of a single event in the future",
+			from:     start.Add(-time.Hour),
+			expected: start,
+			ok:       true,
+		},
+		{
+			name: "Should not return a single event in the past",
+			from: start.Add(time.Minute),
+		},
+		{
+			name:     "Should return the next biweekly occurrence",
+			rule:     &RRule{Freq: FreqWeekly, Interval: 2},
+			from:     time.Date(2025, time.February, 10, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, time.February, 14, 14, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		{
+			name:     "Should clamp monthly occurrences to the last day of the month",
+			rule:     &RRule{Freq: FreqMonthly, Interval: 1},
+			from:     time.Date(2025, time.February, 1, 0, 0, 0, 0, time.UTC),
+			expected: time.Date(2025, time.February, 28, 14, 0, 0, 0, time.UTC),
+			ok:       true,
+		},
+		{
+			name: "Should stop at the count limit",
+			rule: &RRule{Freq: FreqWeekly, Interval: 1, Count: 2},
+			from: time.Date(2025, time.February, 8, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Should stop at the until date",
+			rule: &RRule{Freq: FreqWeekly, Interval: 1, Until: &until},
+			from: time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event := Event{Start: start, RRule: tt.rule}
+
+			occurrence, ok := event.NextOccurrence(tt.from)
+			require.Equal(t, tt.ok, ok)
+			if tt.ok {
+				require.Equal(t, tt.expected, occurrence)
+			}
+		})
+	}
+}
+
+func TestCalendar_Marshal(t *testing.T) {
+	stamp := time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+	start := time.Date(2025, time.March, 10, 14, 0, 0, 0, time.UTC)
+
+	calendar := Calendar{
+		ProdID:          "-//LeaderPro//Calendar//EN",
+		Name:            "LeaderPro",
+		RefreshInterval: time.Hour,
+		Events: []Event{
+			{
+				UID:         "meeting-uuid@leaderpro",
+				Summary:     "1:1 with Ana",
+				Description: "Agenda:\n- career, feedback; " + strings.Repeat("ação ", 20),
+				Start:       start,
+				End:         start.Add(30 * time.Minute),
+			},
+			{
+				UID:     "birthday@leaderpro",
+				Summary: "Ana's birthday",
+				Start:   time.Date(2025, time.March, 12, 0, 0, 0, 0, time.UTC),
+				AllDay:  true,
+			},
+		},
+	}
+
+	data := calendar.Marshal(stamp)
+
+	for _, line := range strings.Split(strings.TrimSuffix(string(data), "\r\n"), "\r\n") {
+		require.LessOrEqual(t, len(line), maxLineOctets)
+	}
+	require.Contains(t, string(data), "DTSTART:20250310T140000Z\r\n")
+	require.Contains(t, string(data), "DTSTART;VALUE=DATE:20250312\r\nDTEND;VALUE=DATE:20250313\r\n")
+	require.Contains(t, string(data), "REFRESH-INTERVAL;VALUE=DURATION:PT1H\r\n")
+
+	events, err := Parse(data, time.UTC)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, calendar.Events[0].Description, events[0].Description)
+	require.Equal(t, start.Add(30*time.Minute), events[0].End)
+	require.True(t, events[1].AllDay)
+}
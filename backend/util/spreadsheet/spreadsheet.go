@@ -0,0 +1,347 @@
+// Package spreadsheet reads the rows of CSV and XLSX files as plain strings
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported file format, use .csv or .xlsx")
+	ErrEmptyFile         = errors.New("file has no rows")
+	ErrTooManyColumns    = errors.New("invalid xlsx file: cell past the last column (XFD)")
+	ErrInvalidCellRef    = errors.New("invalid xlsx file: invalid cell reference")
+)
+
+// maxXLSXColumns is the number of columns of a XLSX worksheet, A to XFD
+const maxXLSXColumns = 16384
+
+// excelEpoch is the day 0 of the Excel date serial numbers (with the 1900 leap year bug already compensated)
+var excelEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// Read returns all the rows of a CSV or XLSX file, choosing the format by the file extension.
+// Rows are padded so all of them have the same number of columns as the widest one
+func Read(fileName string, data []byte) ([][]string, error) {
+	var (
+		rows [][]string
+		err  error
+	)
+
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case ".csv", ".txt":
+		rows, err = ReadCSV(data)
+	case ".xlsx":
+		rows, err = ReadXLSX(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rows = removeEmptyRows(rows)
+	if len(rows) == 0 {
+		return nil, ErrEmptyFile
+	}
+
+	return padRows(rows), nil
+}
+
+// ReadCSV reads a CSV file separated by comma or semicolon (the default of spreadsheets in pt-BR)
+func ReadCSV(data []byte) ([][]string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")) // UTF-8 BOM added by Excel
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = detectDelimiter(data)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid csv file: %w", err)
+	}
+
+	return rows, nil
+}
+
+func detectDelimiter(data []byte) rune {
+	firstLine := data
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		firstLine = data[:i]
+	}
+
+	if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		return ';'
+	}
+	return ','
+}
+
+// ReadXLSX reads the first worksheet of a XLSX file
+func ReadXLSX(data []byte) ([][]string, error) {
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid xlsx file: %w", err)
+	}
+
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		files[file.Name] = file
+	}
+
+	sharedStrings, err := readSharedStrings(files)
+	if err != nil {
+		return nil, err
+	}
+
+	sheetPath, err := firstSheetPath(files)
+	if err != nil {
+		return nil, err
+	}
+
+	var sheet xlsxWorksheet
+	err = decodeXMLFile(files, sheetPath, &sheet)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([][]string, 0, len(sheet.Rows))
+	for _, row := range sheet.Rows {
+		values := []string{}
+		for i, cell := range row.Cells {
+			column := i
+			if cell.Ref != "" {
+				column, err = columnIndex(cell.Ref)
+				if err != nil {
+					return nil, err
+				}
+			}
+			if column >= maxXLSXColumns {
+				return nil, ErrTooManyColumns
+			}
+			for len(values) <= column {
+				values = append(values, "")
+			}
+			values[column] = cell.value(sharedStrings)
+		}
+		rows = append(rows, values)
+	}
+
+	return rows, nil
+}
+
+// ExcelSerialToTime converts a date stored by Excel as a number of days since 1899-12-30
+func ExcelSerialToTime(value string) (time.Time, error) {
+	days, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return excelEpoch.Add(time.Duration(days * 24 * float64(time.Hour))).Truncate(24 * time.Hour), nil
+}
+
+type xlsxWorksheet struct {
+	Rows []struct {
+		Cells []xlsxCell `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+type xlsxCell struct {
+	Ref       string `xml:"r,attr"`
+	Type      string `xml:"t,attr"`
+	Value     string `xml:"v"`
+	InlineStr struct {
+		Text string `xml:"t"`
+		Runs []struct {
+			Text string `xml:"t"`
+		} `xml:"r"`
+	} `xml:"is"`
+}
+
+func (c xlsxCell) value(sharedStrings []string) string {
+	switch c.Type {
+	case "s":
+		index, err := strconv.Atoi(c.Value)
+		if err != nil || index < 0 || index >= len(sharedStrings) {
+			return ""
+		}
+		return sharedStrings[index]
+	case "inlineStr":
+		if c.InlineStr.Text != "" {
+			return c.InlineStr.Text
+		}
+		var sb strings.Builder
+		for _, run := range c.InlineStr.Runs {
+			sb.WriteString(run.Text)
+		}
+		return sb.String()
+	case "b":
+		if c.Value == "1" {
+			return "true"
+		}
+		return "false"
+	default:
+		return c.Value
+	}
+}
+
+type xlsxSharedStrings struct {
+	Items []struct {
+		Text string `xml:"t"`
+		Runs []struct {
+			Text string `xml:"t"`
+		} `xml:"r"`
+	} `xml:"si"`
+}
+
+func readSharedStrings(files map[string]*zip.File) ([]string, error) {
+	if _, ok := files["xl/sharedStrings.xml"]; !ok {
+		return nil, nil
+	}
+
+	var shared xlsxSharedStrings
+	err := decodeXMLFile(files, "xl/sharedStrings.xml", &shared)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, len(shared.Items))
+	for _, item := range shared.Items {
+		if len(item.Runs) == 0 {
+			result = append(result, item.Text)
+			continue
+		}
+
+		// rich text, the value is split in formatted runs
+		var sb strings.Builder
+		for _, run := range item.Runs {
+			sb.WriteString(run.Text)
+		}
+		result = append(result, sb.String())
+	}
+
+	return result, nil
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelationID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+// firstSheetPath resolves the file of the first sheet through the workbook relationships,
+// falling back to the default name when the workbook doesn't have them
+func firstSheetPath(files map[string]*zip.File) (string, error) {
+	const defaultSheet = "xl/worksheets/sheet1.xml"
+
+	var workbook xlsxWorkbook
+	var rels xlsxRelationships
+	if decodeXMLFile(files, "xl/workbook.xml", &workbook) == nil &&
+		decodeXMLFile(files, "xl/_rels/workbook.xml.rels", &rels) == nil &&
+		len(workbook.Sheets) > 0 {
+
+		for _, rel := range rels.Relationships {
+			if rel.ID != workbook.Sheets[0].RelationID {
+				continue
+			}
+
+			target := strings.TrimPrefix(rel.Target, "/")
+			if !strings.HasPrefix(target, "xl/") {
+				target = path.Join("xl", target)
+			}
+			if _, ok := files[target]; ok {
+				return target, nil
+			}
+		}
+	}
+
+	if _, ok := files[defaultSheet]; ok {
+		return defaultSheet, nil
+	}
+
+	return "", errors.New("invalid xlsx file: worksheet not found")
+}
+
+func decodeXMLFile(files map[string]*zip.File, name string, v any) error {
+	file, ok := files[name]
+	if !ok {
+		return fmt.Errorf("invalid xlsx file: %s not found", name)
+	}
+
+	reader, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("invalid xlsx file: %w", err)
+	}
+	defer reader.Close()
+
+	err = xml.NewDecoder(io.LimitReader(reader, 50<<20)).Decode(v)
+	if err != nil {
+		return fmt.Errorf("invalid xlsx file: %w", err)
+	}
+
+	return nil
+}
+
+// columnIndex converts a cell reference like "C12" to its zero based column index (2).
+// The references past the last column are refused before the index can grow, so it never overflows,
+// and so are the references without a column, like "a1" or "5"
+func columnIndex(ref string) (int, error) {
+	index := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		index = index*26 + int(r-'A'+1)
+		if index > maxXLSXColumns {
+			return 0, ErrTooManyColumns
+		}
+	}
+	if index == 0 {
+		return 0, ErrInvalidCellRef
+	}
+	return index - 1, nil
+}
+
+func removeEmptyRows(rows [][]string) [][]string {
+	result := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		for _, value := range row {
+			if strings.TrimSpace(value) != "" {
+				result = append(result, row)
+				break
+			}
+		}
+	}
+	return result
+}
+
+func padRows(rows [][]string) [][]string {
+	width := 0
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+
+	for i, row := range rows {
+		for len(row) < width {
+			row = append(row, "")
+		}
+		rows[i] = row
+	}
+	return rows
+}
@@ -0,0 +1,138 @@
+package spreadsheet
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func buildXLSX(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	for name, content := range files {
+		f, err := w.Create(name)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func TestRead(t *testing.T) {
+	t.Run("Should read csv separated by comma", func(t *testing.T) {
+		rows, err := Read("people.csv", []byte("name,email\nJoão,joao@empresa.com\n"))
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"name", "email"}, {"João", "joao@empresa.com"}}, rows)
+	})
+
+	t.Run("Should read csv separated by semicolon with BOM", func(t *testing.T) {
+		rows, err := Read("people.csv", []byte("\xef\xbb\xbfnome;cargo\nMaria;Designer, UX\n"))
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"nome", "cargo"}, {"Maria", "Designer, UX"}}, rows)
+	})
+
+	t.Run("Should skip empty rows and pad short rows", func(t *testing.T) {
+		rows, err := Read("people.csv", []byte("name,email,position\n,,\nAna\n"))
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"name", "email", "position"}, {"Ana", "", ""}}, rows)
+	})
+
+	t.Run("Should return error for unsupported format", func(t *testing.T) {
+		_, err := Read("people.pdf", []byte("x"))
+		require.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+
+	t.Run("Should return error for empty file", func(t *testing.T) {
+		_, err := Read("people.csv", []byte(""))
+		require.ErrorIs(t, err, ErrEmptyFile)
+	})
+
+	t.Run("Should read xlsx with shared and inline strings", func(t *testing.T) {
+		data := buildXLSX(t, map[string]string{
+			"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="People" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+			"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/people.xml"/></Relationships>`,
+			"xl/sharedStrings.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><si><t>name</t></si><si><t>start_date</t></si><si><r><t>Jo</t></r><r><t>ão</t></r></si></sst>`,
+			"xl/worksheets/people.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="C1" t="s"><v>1</v></c></row>
+<row r="2"><c r="A2" t="s"><v>2</v></c><c r="B2" t="inlineStr"><is><t>x</t></is></c><c r="C2"><v>45292</v></c></row>
+</sheetData></worksheet>`,
+		})
+
+		rows, err := Read("people.xlsx", data)
+		require.NoError(t, err)
+		require.Equal(t, [][]string{{"name", "", "start_date"}, {"João", "x", "45292"}}, rows)
+	})
+
+	t.Run("Should return error for invalid cell references", func(t *testing.T) {
+		// ZZZZZZ is far past XFD and the long reference would overflow the column index
+		refs := map[string]error{
+			"ZZZZZZ1":                     ErrTooManyColumns,
+			strings.Repeat("Z", 30) + "1": ErrTooManyColumns,
+			"a1":                          ErrInvalidCellRef,
+			"5":                           ErrInvalidCellRef,
+		}
+		for ref, expected := range refs {
+			data := buildXLSX(t, map[string]string{
+				"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="People" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+				"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/people.xml"/></Relationships>`,
+				"xl/worksheets/people.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="` + ref + `" t="inlineStr"><is><t>x</t></is></c></row>
+</sheetData></worksheet>`,
+			})
+
+			_, err := Read("people.xlsx", data)
+			require.ErrorIs(t, err, expected, ref)
+		}
+	})
+
+	t.Run("Should return error for invalid xlsx", func(t *testing.T) {
+		_, err := Read("people.xlsx", []byte("not a zip"))
+		require.Error(t, err)
+	})
+}
+
+func TestExcelSerialToTime(t *testing.T) {
+	date, err := ExcelSerialToTime("45292")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), date)
+
+	_, err = ExcelSerialToTime("abc")
+	require.Error(t, err)
+}
+
+func Test_columnIndex(t *testing.T) {
+	for ref, expected := range map[string]int{"A1": 0, "C12": 2, "AA3": 26, "XFD1": 16383} {
+		index, err := columnIndex(ref)
+		require.NoError(t, err)
+		require.Equal(t, expected, index, ref)
+	}
+
+	for _, ref := range []string{"XFE1", "ZZZZZZ1", strings.Repeat("Z", 30) + "1"} {
+		_, err := columnIndex(ref)
+		require.ErrorIs(t, err, ErrTooManyColumns, ref)
+	}
+
+	for _, ref := range []string{"a1", "5", ""} {
+		_, err := columnIndex(ref)
+		require.ErrorIs(t, err, ErrInvalidCellRef, ref)
+	}
+}
@@ -0,0 +1,315 @@
+package text
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalize lowercases the value, removes accents and replaces punctuation with spaces,
+// so "João Pedro-Silva" becomes "joao pedro silva". Emails keep the @ and the dots
+func Normalize(value string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	result, _, err := transform.String(t, value)
+	if err != nil {
+		result = value
+	}
+
+	result = strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == '@', r == '.':
+			return unicode.ToLower(r)
+		default:
+			return ' '
+		}
+	}, result)
+
+	return strings.Join(strings.Fields(result), " ")
+}
+
+// Distance returns the Damerau-Levenshtein distance (optimal string alignment) between a and b,
+// counting insertions, deletions, substitutions and transpositions of adjacent characters
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) == 0 {
+		return len(rb)
+	}
+	if len(rb) == 0 {
+		return len(ra)
+	}
+
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(ra)][len(rb)]
+}
+
+// MaxTypos returns how many typos are tolerated for a term of the given length.
+// Short terms must match exactly, otherwise everything would match everything
+func MaxTypos(term string) int {
+	length := len([]rune(term))
+	switch {
+	case length <= 3:
+		return 0
+	case length <= 6:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// MatchScore returns how well the query matches the value, from 0 (no match) to 1 (exact match).
+// Both must already be normalized. Every query term must match a value term, and the last query
+// term is also compared as a prefix, so it works while the user is still typing
+func MatchScore(query, value string) float64 {
+	if query == "" || value == "" {
+		return 0
+	}
+	if query == value {
+		return 1
+	}
+	if strings.HasPrefix(value, query) {
+		return 0.9
+	}
+
+	queryTerms := strings.Fields(query)
+	valueTerms := strings.Fields(value)
+
+	var total float64
+	for _, queryTerm := range queryTerms {
+		best := 0.0
+		for _, valueTerm := range valueTerms {
+			best = max(best, termScore(queryTerm, valueTerm))
+		}
+		if best == 0 {
+			return 0
+		}
+		total += best
+	}
+
+	return total / float64(len(queryTerms)) * 0.85
+}
+
+func termScore(queryTerm, valueTerm string) float64 {
+	switch {
+	case queryTerm == valueTerm:
+		return 1
+	case strings.HasPrefix(valueTerm, queryTerm):
+		return 0.9
+	case len(queryTerm) > 2 && strings.Contains(valueTerm, queryTerm):
+		return 0.6
+	}
+
+	maxTypos := MaxTypos(queryTerm)
+	if maxTypos == 0 {
+		return 0
+	}
+
+	distance := Distance(queryTerm, valueTerm)
+
+	// compare with the beginning of the value term too, to tolerate typos while typing
+	valueRunes := []rune(valueTerm)
+	if queryLength := len([]rune(queryTerm)); len(valueRunes) > queryLength {
+		distance = min(distance, Distance(queryTerm, string(valueRunes[:queryLength])))
+	}
+
+	if distance > maxTypos {
+		return 0
+	}
+
+	return 0.7 - 0.15*float64(distance)
+}
+
+// Operations of the lines returned by DiffLines
+const (
+	DiffEqual   = "equal"
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+)
+
+// DiffLine is a line of a diff between two texts
+type DiffLine struct {
+	Op   string
+	Text string
+}
+
+// DiffLines compares the texts line by line using their longest common subsequence,
+// returning the lines of b with the lines removed from a right before the ones added in their place
+func DiffLines(a, b string) []DiffLine {
+	linesA, linesB := splitLines(a), splitLines(b)
+
+	// lcs[i][j] is the length of the longest common subsequence of linesA[i:] and linesB[j:]
+	lcs := make([][]int, len(linesA)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(linesB)+1)
+	}
+	for i := len(linesA) - 1; i >= 0; i-- {
+		for j := len(linesB) - 1; j >= 0; j-- {
+			if linesA[i] == linesB[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	diff := make([]DiffLine, 0, max(len(linesA), len(linesB)))
+	i, j := 0, 0
+	for i < len(linesA) && j < len(linesB) {
+		switch {
+		case linesA[i] == linesB[j]:
+			diff = append(diff, DiffLine{Op: DiffEqual, Text: linesA[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			diff = append(diff, DiffLine{Op: DiffRemoved, Text: linesA[i]})
+			i++
+		default:
+			diff = append(diff, DiffLine{Op: DiffAdded, Text: linesB[j]})
+			j++
+		}
+	}
+	for ; i < len(linesA); i++ {
+		diff = append(diff, DiffLine{Op: DiffRemoved, Text: linesA[i]})
+	}
+	for ; j < len(linesB); j++ {
+		diff = append(diff, DiffLine{Op: DiffAdded, Text: linesB[j]})
+	}
+
+	return diff
+}
+
+func splitLines(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return strings.Split(strings.ReplaceAll(value, "\r\n", "\n"), "\n")
+}
+
+// SnippetPart is a piece of the text of a snippet, Highlighted when it is a word matching the query
+type SnippetPart struct {
+	Text        string
+	Highlighted bool
+}
+
+type snippetWord struct {
+	start, end int // rune offsets, end is exclusive
+	match      bool
+}
+
+// Snippet returns an excerpt of about size characters of the value around the first word matching the query,
+// with every matching word highlighted. A word matches when it starts with a query term, ignoring case and
+// accents. When nothing matches the excerpt is the beginning of the value. "…" marks the text cut on each side
+func Snippet(value, query string, size int) []SnippetPart {
+	terms := strings.FieldsFunc(Normalize(query), func(r rune) bool {
+		return !isWordRune(r)
+	})
+	runes := []rune(value)
+
+	var words []snippetWord
+	first := -1
+	for i := 0; i < len(runes); {
+		if !isWordRune(runes[i]) {
+			i++
+			continue
+		}
+
+		word := snippetWord{start: i}
+		for i < len(runes) && isWordRune(runes[i]) {
+			i++
+		}
+		word.end = i
+
+		normalized := Normalize(string(runes[word.start:word.end]))
+		for _, term := range terms {
+			if strings.HasPrefix(normalized, term) {
+				word.match = true
+				break
+			}
+		}
+		if word.match && first < 0 {
+			first = len(words)
+		}
+		words = append(words, word)
+	}
+
+	start, end := 0, len(runes)
+	if len(runes) > size {
+		if first >= 0 {
+			// keep some context before the match, starting at a word
+			start = max(0, words[first].start-size/4)
+			for _, word := range words {
+				if word.start >= start {
+					start = word.start
+					break
+				}
+			}
+		}
+
+		end = min(len(runes), start+size)
+		for i := len(words) - 1; i >= 0 && end < len(runes); i-- {
+			if words[i].end <= end && words[i].end > start {
+				end = words[i].end
+				break
+			}
+		}
+	}
+
+	var parts []SnippetPart
+	appendText := func(text string, highlighted bool) {
+		if text == "" {
+			return
+		}
+		if last := len(parts) - 1; last >= 0 && !highlighted && !parts[last].Highlighted {
+			parts[last].Text += text
+			return
+		}
+		parts = append(parts, SnippetPart{Text: text, Highlighted: highlighted})
+	}
+
+	if start > 0 {
+		appendText("…", false)
+	}
+	position := start
+	for _, word := range words {
+		if !word.match || word.start < start || word.end > end {
+			continue
+		}
+		appendText(string(runes[position:word.start]), false)
+		appendText(string(runes[word.start:word.end]), true)
+		position = word.end
+	}
+	appendText(string(runes[position:end]), false)
+	if end < len(runes) {
+		appendText("…", false)
+	}
+
+	return parts
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
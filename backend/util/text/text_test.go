@@ -0,0 +1,185 @@
+package text
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "removes accents", value: "João Conceição", want: "joao conceicao"},
+		{name: "lowercases", value: "MARIA Eduarda", want: "maria eduarda"},
+		{name: "replaces punctuation", value: "Pedro-Silva (Tech Lead)", want: "pedro silva tech lead"},
+		{name: "keeps email characters", value: "Ana.Souza@Empresa.com", want: "ana.souza@empresa.com"},
+		{name: "collapses spaces", value: "  ana   paula ", want: "ana paula"},
+		{name: "empty value", value: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Normalize(tt.value))
+		})
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "joao", b: "joao", want: 0},
+		{a: "joao", b: "jaoo", want: 1}, // transposition
+		{a: "joao", b: "joa", want: 1},
+		{a: "maria", b: "mario", want: 1},
+		{a: "", b: "abc", want: 3},
+		{a: "kitten", b: "sitting", want: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			require.Equal(t, tt.want, Distance(tt.a, tt.b))
+		})
+	}
+}
+
+func TestMatchScore(t *testing.T) {
+	t.Run("exact match has the highest score", func(t *testing.T) {
+		require.Equal(t, 1.0, MatchScore("joao silva", "joao silva"))
+	})
+
+	t.Run("matches accent insensitive after normalize", func(t *testing.T) {
+		require.Greater(t, MatchScore(Normalize("joao"), Normalize("João Silva")), 0.0)
+	})
+
+	t.Run("prefix ranks above typo", func(t *testing.T) {
+		prefix := MatchScore("mar", "maria souza")
+		typo := MatchScore("mraia", "maria souza")
+		require.Greater(t, typo, 0.0)
+		require.Greater(t, prefix, typo)
+	})
+
+	t.Run("tolerates typos while typing", func(t *testing.T) {
+		require.Greater(t, MatchScore("fernd", "fernanda lima"), 0.0)
+	})
+
+	t.Run("every query term must match", func(t *testing.T) {
+		require.Equal(t, 0.0, MatchScore("joao pereira", "joao silva"))
+	})
+
+	t.Run("short terms must match exactly", func(t *testing.T) {
+		require.Equal(t, 0.0, MatchScore("ana", "ada lovelace"))
+	})
+
+	t.Run("second name match", func(t *testing.T) {
+		require.Greater(t, MatchScore("silva", "joao silva"), 0.0)
+	})
+}
+
+func TestDiffLines(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want []DiffLine
+	}{
+		{
+			name: "same texts",
+			a:    "wins\nblockers",
+			b:    "wins\nblockers",
+			want: []DiffLine{{Op: DiffEqual, Text: "wins"}, {Op: DiffEqual, Text: "blockers"}},
+		},
+		{
+			name: "changed line",
+			a:    "wins\nshipped v1\nblockers",
+			b:    "wins\nshipped v2\nblockers",
+			want: []DiffLine{
+				{Op: DiffEqual, Text: "wins"},
+				{Op: DiffRemoved, Text: "shipped v1"},
+				{Op: DiffAdded, Text: "shipped v2"},
+				{Op: DiffEqual, Text: "blockers"},
+			},
+		},
+		{
+			name: "added lines at the end",
+			a:    "wins",
+			b:    "wins\r\nblockers",
+			want: []DiffLine{{Op: DiffEqual, Text: "wins"}, {Op: DiffAdded, Text: "blockers"}},
+		},
+		{
+			name: "from an empty text",
+			a:    "",
+			b:    "wins",
+			want: []DiffLine{{Op: DiffAdded, Text: "wins"}},
+		},
+		{
+			name: "to an empty text",
+			a:    "wins",
+			b:    "",
+			want: []DiffLine{{Op: DiffRemoved, Text: "wins"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, DiffLines(tt.a, tt.b))
+		})
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		query string
+		size  int
+		want  []SnippetPart
+	}{
+		{
+			name:  "highlights the words starting with the terms, ignoring case and accents",
+			value: "Falou sobre a Migração do projeto",
+			query: "migracao proj",
+			size:  100,
+			want: []SnippetPart{
+				{Text: "Falou sobre a "},
+				{Text: "Migração", Highlighted: true},
+				{Text: " do "},
+				{Text: "projeto", Highlighted: true},
+			},
+		},
+		{
+			name:  "cuts the text around the first match",
+			value: "one two three four five six seven eight nine migration ten eleven twelve thirteen",
+			query: "migration",
+			size:  30,
+			want: []SnippetPart{
+				{Text: "…nine "},
+				{Text: "migration", Highlighted: true},
+				{Text: " ten eleven…"},
+			},
+		},
+		{
+			name:  "starts at the beginning when nothing matches",
+			value: "one two three four five six",
+			query: "migration",
+			size:  10,
+			want:  []SnippetPart{{Text: "one two…"}},
+		},
+		{
+			name:  "keeps the whole text when it is short",
+			value: "short note",
+			query: "",
+			size:  100,
+			want:  []SnippetPart{{Text: "short note"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, Snippet(tt.value, tt.query, tt.size))
+		})
+	}
+}